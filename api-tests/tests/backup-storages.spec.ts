@@ -58,7 +58,9 @@ test('add/list/get/delete backup storage success', async ({ request }) => {
   const one = await request.get(`/v1/backup-storages/${name}`)
 
   expect(one.ok()).toBeTruthy()
-  expect((await one.json()).name).toBe(payload.name)
+  const stored = await one.json()
+
+  expect(stored.name).toBe(payload.name)
 
   // update
   const updatePayload = {
@@ -66,6 +68,7 @@ test('add/list/get/delete backup storage success', async ({ request }) => {
     bucketName: 'percona-test-backup-storage1',
     accessKey: 'otherAccessKey',
     secretKey: 'otherSecret',
+    version: stored.version,
   }
   const updated = await request.patch(`/v1/backup-storages/${name}`, {
     data: updatePayload,
@@ -78,6 +81,14 @@ test('add/list/get/delete backup storage success', async ({ request }) => {
   expect(result.region).toBe(created.region)
   expect(result.type).toBe(created.type)
   expect(result.description).toBe(updatePayload.description)
+  expect(result.version).toBe(stored.version + 1)
+
+  // update based on the outdated version
+  const outdated = await request.patch(`/v1/backup-storages/${name}`, {
+    data: { description: 'other description', version: stored.version },
+  })
+
+  expect(outdated.status()).toBe(409)
 
   // backup storage already exists
   const createAgain = await request.post('/v1/backup-storages', {
@@ -226,3 +237,43 @@ test('get: backup storage not found', async ({ request }) => {
 
   expect(response.status()).toBe(404)
 })
+
+test('delete/restore backup storage from the trash', async ({ request }) => {
+  const payload = {
+    type: 's3',
+    name: 'backup-storage-trash',
+    bucketName: 'percona-test-backup-storage',
+    region: 'us-east-2',
+    accessKey: 'sdfs',
+    secretKey: 'sdfsdfsd',
+  }
+
+  let response = await request.post('/v1/backup-storages', { data: payload })
+
+  expect(response.ok()).toBeTruthy()
+
+  response = await request.delete(`/v1/backup-storages/${payload.name}`)
+  expect(response.ok()).toBeTruthy()
+
+  response = await request.get(`/v1/backup-storages/${payload.name}`)
+  expect(response.status()).toBe(404)
+
+  response = await request.get('/v1/backup-storages?deleted=true')
+  expect(response.ok()).toBeTruthy()
+  const trash = await response.json()
+  const deleted = trash.find((s) => s.name === payload.name)
+
+  expect(deleted.deletedAt).toBeTruthy()
+
+  response = await request.post('/v1/backup-storages', { data: payload })
+  expect(response.status()).toBe(409)
+
+  response = await request.post(`/v1/backup-storages/${payload.name}/restore`)
+  expect(response.ok()).toBeTruthy()
+  expect((await response.json()).deletedAt).toBeUndefined()
+
+  response = await request.get(`/v1/backup-storages/${payload.name}`)
+  expect(response.ok()).toBeTruthy()
+
+  await request.delete(`/v1/backup-storages/${payload.name}`)
+})
@@ -208,7 +208,7 @@ test('patch monitoring instance', async ({ request }) => {
   expect(response.ok()).toBeTruthy()
   const created = await response.json()
 
-  const patchData = { url: 'http://monitoring' }
+  const patchData = { url: 'http://monitoring', version: created.version }
   const updated = await request.patch(`/v1/monitoring-instances/${name}`, { data: patchData })
 
   expect(updated.ok()).toBeTruthy()
@@ -232,6 +232,7 @@ test('patch monitoring instance secret key changes', async ({ request }) => {
     pmm: {
       apiKey: 'asd',
     },
+    version: created.version,
   }
   const updated = await request.patch(`/v1/monitoring-instances/${name}`, { data: patchData })
 
@@ -248,12 +249,14 @@ test('patch monitoring instance type updates properly', async ({ request }) => {
   const response = await request.get(`/v1/monitoring-instances/${name}`)
 
   expect(response.ok()).toBeTruthy()
+  const created = await response.json()
 
   const patchData = {
     type: 'pmm',
     pmm: {
       apiKey: 'asd',
     },
+    version: created.version,
   }
   const updated = await request.patch(`/v1/monitoring-instances/${name}`, { data: patchData })
 
@@ -0,0 +1,597 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/pmm"
+)
+
+// alertRuleFolder is the title of the PMM folder the alert rules are created in.
+const alertRuleFolder = "Everest"
+
+//nolint:gochecknoglobals
+var (
+	// alertRuleExpressions are the expressions of the alert rules by the metric and
+	// the database engine. They are compared with the threshold of the alert rule template.
+	alertRuleExpressions = map[string]map[everestv1alpha1.EngineType]string{
+		model.AlertRuleMetricCPU: {
+			everestv1alpha1.DatabaseEnginePXC:        cpuUsageExpression,
+			everestv1alpha1.DatabaseEnginePSMDB:      cpuUsageExpression,
+			everestv1alpha1.DatabaseEnginePostgresql: cpuUsageExpression,
+		},
+		model.AlertRuleMetricDiskUsage: {
+			everestv1alpha1.DatabaseEnginePXC:        diskUsageExpression,
+			everestv1alpha1.DatabaseEnginePSMDB:      diskUsageExpression,
+			everestv1alpha1.DatabaseEnginePostgresql: diskUsageExpression,
+		},
+		model.AlertRuleMetricReplicationLag: {
+			everestv1alpha1.DatabaseEnginePXC:        `max by (node_name) (mysql_slave_status_seconds_behind_master)`,
+			everestv1alpha1.DatabaseEnginePSMDB:      `max by (node_name) (mongodb_mongod_replset_member_replication_lag)`,
+			everestv1alpha1.DatabaseEnginePostgresql: `max by (node_name) (pg_replication_lag)`,
+		},
+	}
+	alertRuleUnits = map[string]string{
+		model.AlertRuleMetricCPU:            "%",
+		model.AlertRuleMetricDiskUsage:      "%",
+		model.AlertRuleMetricReplicationLag: "s",
+	}
+)
+
+const (
+	cpuUsageExpression  = `(1 - avg by (node_name) (rate(node_cpu_seconds_total{mode="idle"}[5m]))) * 100`
+	diskUsageExpression = `max by (node_name) ((1 - node_filesystem_avail_bytes{fstype!~"tmpfs|overlay|squashfs"}` +
+		` / node_filesystem_size_bytes{fstype!~"tmpfs|overlay|squashfs"}) * 100)`
+)
+
+// ListAlertRuleTemplates lists the alert rule templates.
+func (e *EverestServer) ListAlertRuleTemplates(ctx echo.Context) error {
+	templates, err := e.storage.ListAlertRuleTemplates(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list alert rule templates")})
+	}
+
+	res := make([]AlertRuleTemplate, 0, len(templates))
+	for _, t := range templates {
+		t := t
+		res = append(res, alertRuleTemplateToAPI(&t))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// CreateAlertRuleTemplate creates an alert rule template.
+func (e *EverestServer) CreateAlertRuleTemplate(ctx echo.Context) error {
+	var params CreateAlertRuleTemplateParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := validateCreateAlertRuleTemplate(params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	if _, err := e.storage.GetAlertRuleTemplate(c, *params.Name); err == nil {
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("Alert rule template with the same name already exists")})
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get alert rule template")})
+	}
+
+	t := &model.AlertRuleTemplate{
+		Name:       *params.Name,
+		Metric:     string(*params.Metric),
+		Threshold:  *params.Threshold,
+		ForMinutes: 5,
+		Severity:   string(AlertRuleTemplateSpecSeverityWarning),
+		Enabled:    true,
+	}
+	if params.Enabled != nil {
+		t.Enabled = *params.Enabled
+	}
+	if params.ForMinutes != nil {
+		t.ForMinutes = *params.ForMinutes
+	}
+	if params.Severity != nil {
+		t.Severity = string(*params.Severity)
+	}
+	if err := e.storage.CreateAlertRuleTemplate(c, t); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not create alert rule template")})
+	}
+
+	e.requestAlertRuleSync()
+	return ctx.JSON(http.StatusOK, alertRuleTemplateToAPI(t))
+}
+
+// GetAlertRuleTemplate returns an alert rule template.
+func (e *EverestServer) GetAlertRuleTemplate(ctx echo.Context, name string) error {
+	t, err := e.storage.GetAlertRuleTemplate(ctx.Request().Context(), name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Alert rule template not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get alert rule template")})
+	}
+
+	return ctx.JSON(http.StatusOK, alertRuleTemplateToAPI(t))
+}
+
+// UpdateAlertRuleTemplate updates the provided fields of an alert rule template.
+func (e *EverestServer) UpdateAlertRuleTemplate(ctx echo.Context, name string) error {
+	var params UpdateAlertRuleTemplateParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	t, err := e.storage.GetAlertRuleTemplate(c, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Alert rule template not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get alert rule template")})
+	}
+	if err := validateAlertRuleTemplateSpec(t.Metric, params.Threshold, params.ForMinutes); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	err = e.storage.UpdateAlertRuleTemplate(c, name, model.UpdateAlertRuleTemplateParams{
+		Threshold:  params.Threshold,
+		ForMinutes: params.ForMinutes,
+		Severity:   (*string)(params.Severity),
+		Enabled:    params.Enabled,
+	})
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not update alert rule template")})
+	}
+
+	e.requestAlertRuleSync()
+	return e.GetAlertRuleTemplate(ctx, name)
+}
+
+// DeleteAlertRuleTemplate deletes an alert rule template.
+func (e *EverestServer) DeleteAlertRuleTemplate(ctx echo.Context, name string) error {
+	if err := e.storage.DeleteAlertRuleTemplate(ctx.Request().Context(), name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Alert rule template not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete alert rule template")})
+	}
+
+	e.requestAlertRuleSync()
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListAlertRules lists the alert rules pushed for the monitored database clusters.
+func (e *EverestServer) ListAlertRules(ctx echo.Context) error {
+	syncs, err := e.storage.ListAlertRuleSyncs(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list alert rules")})
+	}
+
+	res := make([]AlertRuleSync, 0, len(syncs))
+	for _, s := range syncs {
+		r := AlertRuleSync{
+			KubernetesId:           s.KubernetesID,
+			DbClusterName:          s.DBClusterName,
+			MonitoringInstanceName: s.MonitoringInstanceName,
+			Rules:                  s.Rules,
+			SyncedAt:               s.SyncedAt,
+		}
+		if s.LastError != "" {
+			r.LastError = pointer.ToString(s.LastError)
+		}
+		res = append(res, r)
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func validateCreateAlertRuleTemplate(params CreateAlertRuleTemplateParams) error {
+	if params.Name == nil || params.Metric == nil || params.Threshold == nil {
+		return errors.New("name, metric and threshold are required")
+	}
+	if err := validateRFC1035(*params.Name, "name"); err != nil {
+		return err
+	}
+	if _, ok := alertRuleExpressions[string(*params.Metric)]; !ok {
+		return fmt.Errorf("metric %s is not supported", *params.Metric)
+	}
+	return validateAlertRuleTemplateSpec(string(*params.Metric), params.Threshold, params.ForMinutes)
+}
+
+func validateAlertRuleTemplateSpec(metric string, threshold *float64, forMinutes *int) error {
+	if forMinutes != nil && *forMinutes < 1 {
+		return errors.New("forMinutes shall be at least 1")
+	}
+	if threshold == nil {
+		return nil
+	}
+	if *threshold <= 0 {
+		return errors.New("threshold shall be positive")
+	}
+	if alertRuleUnits[metric] == "%" && *threshold > 100 {
+		return fmt.Errorf("threshold of the %s metric is a percentage and shall not exceed 100", metric)
+	}
+	return nil
+}
+
+func alertRuleTemplateToAPI(t *model.AlertRuleTemplate) AlertRuleTemplate {
+	return AlertRuleTemplate{
+		Name:       t.Name,
+		Metric:     t.Metric,
+		Threshold:  t.Threshold,
+		ForMinutes: t.ForMinutes,
+		Severity:   t.Severity,
+		Enabled:    t.Enabled,
+		CreatedAt:  t.CreatedAt,
+		UpdatedAt:  t.UpdatedAt,
+	}
+}
+
+// requestAlertRuleSync makes the alert rule syncer run without waiting for its interval.
+func (e *EverestServer) requestAlertRuleSync() {
+	select {
+	case e.alertRuleSyncRequests <- struct{}{}:
+	default:
+	}
+}
+
+func (e *EverestServer) runAlertRuleSyncer(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.AlertRuleSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.syncAlertRules(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-e.alertRuleSyncRequests:
+		}
+	}
+}
+
+// monitoredDatabaseCluster is a database cluster with a monitoring config.
+type monitoredDatabaseCluster struct {
+	engine             everestv1alpha1.EngineType
+	monitoringInstance string
+}
+
+// syncAlertRules pushes the alert rules of the enabled templates to the monitoring instance
+// of every monitored database cluster whose alert rules have changed and removes the alert rules
+// of the database clusters which are not monitored anymore.
+func (e *EverestServer) syncAlertRules(ctx context.Context) {
+	templates, err := e.storage.ListAlertRuleTemplates(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list alert rule templates")))
+		return
+	}
+	syncs, err := e.storage.ListAlertRuleSyncs(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list alert rule syncs")))
+		return
+	}
+	ks, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list Kubernetes clusters")))
+		return
+	}
+
+	previous := make(map[string]model.AlertRuleSync, len(syncs))
+	for _, s := range syncs {
+		previous[s.KubernetesID+"/"+s.DBClusterName] = s
+	}
+
+	for _, k := range ks {
+		monitored, err := e.monitoredDatabaseClusters(ctx, k.ID)
+		if err != nil {
+			e.l.Warn(errors.Join(err, fmt.Errorf("could not list monitored database clusters of Kubernetes cluster %s", k.ID)))
+			continue
+		}
+
+		for name, db := range monitored {
+			key := k.ID + "/" + name
+			var prev *model.AlertRuleSync
+			if s, ok := previous[key]; ok {
+				prev = &s
+			}
+			e.pushAlertRules(ctx, k.ID, name, db, templates, prev)
+		}
+		for key, s := range previous {
+			if _, ok := monitored[s.DBClusterName]; ok || s.KubernetesID != k.ID {
+				continue
+			}
+			if err := e.removeAlertRules(ctx, s); err != nil {
+				e.l.Warn(errors.Join(err, fmt.Errorf("could not remove alert rules of database cluster %s", key)))
+			}
+		}
+	}
+}
+
+func (e *EverestServer) monitoredDatabaseClusters(ctx context.Context, kubernetesID string) (map[string]monitoredDatabaseCluster, error) {
+	_, kubeClient, _, err := e.initKubeClient(ctx, kubernetesID)
+	if err != nil {
+		return nil, err
+	}
+	dbs, err := kubeClient.ListDatabaseClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]monitoredDatabaseCluster, len(dbs.Items))
+	for _, db := range dbs.Items {
+		if db.Spec.Monitoring == nil || db.Spec.Monitoring.MonitoringConfigName == "" {
+			continue
+		}
+		res[db.Name] = monitoredDatabaseCluster{
+			engine:             db.Spec.Engine.Type,
+			monitoringInstance: db.Spec.Monitoring.MonitoringConfigName,
+		}
+	}
+	return res, nil
+}
+
+// pushAlertRules replaces the alert rules of the database cluster in its monitoring instance
+// unless the alert rules pushed last time are the same.
+func (e *EverestServer) pushAlertRules(
+	ctx context.Context, kubernetesID, dbClusterName string, db monitoredDatabaseCluster,
+	templates []model.AlertRuleTemplate, prev *model.AlertRuleSync,
+) {
+	sync := &model.AlertRuleSync{
+		KubernetesID:           kubernetesID,
+		DBClusterName:          dbClusterName,
+		MonitoringInstanceName: db.monitoringInstance,
+	}
+	if prev != nil {
+		sync.CreatedAt = prev.CreatedAt
+		sync.SyncedAt = prev.SyncedAt
+	}
+
+	mi, err := e.storage.GetMonitoringInstance(ctx, db.monitoringInstance)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			e.l.Error(err)
+			return
+		}
+		sync.LastError = "Monitoring instance is not registered in Everest"
+	} else if mi.Type != model.PMMMonitoringInstanceType {
+		sync.LastError = "Alert rules are pushed to the PMM monitoring instances only"
+	}
+
+	rules := alertRules(templates, kubernetesID, dbClusterName, db.engine)
+	if mi != nil {
+		sync.Fingerprint = alertRulesFingerprint(mi, rules)
+	}
+	if prev != nil && prev.LastError == "" && sync.LastError == "" && prev.Fingerprint == sync.Fingerprint {
+		return
+	}
+
+	// The alert rules do not stay behind when the database cluster switches to another monitoring instance.
+	if prev != nil && (prev.MonitoringInstanceName != sync.MonitoringInstanceName || sync.LastError != "") {
+		if err := e.deleteAlertRuleGroup(ctx, *prev); err != nil {
+			e.l.Warn(errors.Join(err, errors.New("could not remove alert rules from the previous monitoring instance")))
+		}
+	}
+	if sync.LastError == "" {
+		err := e.replaceAlertRules(ctx, mi, alertRuleGroup(kubernetesID, dbClusterName), db.engine, rules)
+		if err != nil {
+			e.l.Warn(errors.Join(err, fmt.Errorf("could not push alert rules of database cluster %s", dbClusterName)))
+			sync.LastError = err.Error()
+		} else {
+			sync.Rules = len(rules)
+			sync.SyncedAt = pointer.ToTime(time.Now().UTC())
+		}
+	}
+
+	if err := e.storage.SaveAlertRuleSync(ctx, sync); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not save alert rule sync")))
+	}
+}
+
+func (e *EverestServer) replaceAlertRules(
+	ctx context.Context, mi *model.MonitoringInstance, group string,
+	engine everestv1alpha1.EngineType, rules []pmm.AlertRule,
+) error {
+	apiKey, err := e.secretsStorage.GetSecret(ctx, mi.APIKeySecretID)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get PMM API key"))
+	}
+	folderUID, err := pmm.EnsureAlertFolder(ctx, mi.URL, apiKey, alertRuleFolder)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get PMM alerting folder"))
+	}
+
+	used := make(map[string]struct{}, len(rules))
+	for _, r := range rules {
+		used[r.TemplateName] = struct{}{}
+	}
+	for metric := range alertRuleExpressions {
+		name := pmmAlertTemplateName(metric, engine)
+		if _, ok := used[name]; !ok {
+			continue
+		}
+		template, err := pmmAlertTemplate(metric, engine)
+		if err != nil {
+			return err
+		}
+		if err := pmm.SaveAlertTemplate(ctx, mi.URL, apiKey, name, template); err != nil {
+			return errors.Join(err, fmt.Errorf("could not save PMM alert template %s", name))
+		}
+	}
+
+	if err := pmm.DeleteAlertRuleGroup(ctx, mi.URL, apiKey, folderUID, group); err != nil {
+		return errors.Join(err, errors.New("could not delete the previous alert rules"))
+	}
+	for _, r := range rules {
+		r.FolderUID = folderUID
+		if err := pmm.CreateAlertRule(ctx, mi.URL, apiKey, r); err != nil {
+			return errors.Join(err, fmt.Errorf("could not create alert rule %s", r.Name))
+		}
+	}
+	return nil
+}
+
+// removeAlertRules removes the alert rules of a database cluster which is not monitored anymore.
+func (e *EverestServer) removeAlertRules(ctx context.Context, sync model.AlertRuleSync) error {
+	if err := e.deleteAlertRuleGroup(ctx, sync); err != nil {
+		return err
+	}
+	return e.storage.DeleteAlertRuleSync(ctx, sync.KubernetesID, sync.DBClusterName)
+}
+
+func (e *EverestServer) deleteAlertRuleGroup(ctx context.Context, sync model.AlertRuleSync) error {
+	if sync.Rules == 0 {
+		return nil
+	}
+	mi, err := e.storage.GetMonitoringInstance(ctx, sync.MonitoringInstanceName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	apiKey, err := e.secretsStorage.GetSecret(ctx, mi.APIKeySecretID)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get PMM API key"))
+	}
+	folderUID, err := pmm.EnsureAlertFolder(ctx, mi.URL, apiKey, alertRuleFolder)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get PMM alerting folder"))
+	}
+	return pmm.DeleteAlertRuleGroup(ctx, mi.URL, apiKey, folderUID, alertRuleGroup(sync.KubernetesID, sync.DBClusterName))
+}
+
+// alertRules returns the alert rules of the enabled templates for the database cluster ordered by name.
+func alertRules(
+	templates []model.AlertRuleTemplate, kubernetesID, dbClusterName string, engine everestv1alpha1.EngineType,
+) []pmm.AlertRule {
+	rules := make([]pmm.AlertRule, 0, len(templates))
+	for _, t := range templates {
+		if _, ok := alertRuleExpressions[t.Metric][engine]; !ok || !t.Enabled {
+			continue
+		}
+		rules = append(rules, pmm.AlertRule{
+			TemplateName: pmmAlertTemplateName(t.Metric, engine),
+			Name:         fmt.Sprintf("%s %s", dbClusterName, t.Name),
+			Group:        alertRuleGroup(kubernetesID, dbClusterName),
+			Threshold:    t.Threshold,
+			ForSeconds:   t.ForMinutes * 60,
+			Severity:     t.Severity,
+			// The monitored nodes are named after the pods of the database cluster.
+			Filters: map[string]string{"node_name": "^" + regexp.QuoteMeta(dbClusterName) + "-.+$"},
+		})
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+	return rules
+}
+
+func pmmAlertTemplateName(metric string, engine everestv1alpha1.EngineType) string {
+	return fmt.Sprintf("everest_%s_%s", metric, engine)
+}
+
+func alertRuleGroup(kubernetesID, dbClusterName string) string {
+	return fmt.Sprintf("everest-%s-%s", kubernetesID, dbClusterName)
+}
+
+// alertRulesFingerprint identifies the alert rules pushed to a generation of the monitoring instance.
+func alertRulesFingerprint(mi *model.MonitoringInstance, rules []pmm.AlertRule) string {
+	b, err := json.Marshal(struct {
+		MonitoringInstance string
+		Generation         int64
+		Rules              []pmm.AlertRule
+	}{mi.Name, mi.SecretGeneration, rules})
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// pmmAlertTemplate returns the YAML document of the PMM alert template of the metric and the engine.
+func pmmAlertTemplate(metric string, engine everestv1alpha1.EngineType) (string, error) {
+	expr, ok := alertRuleExpressions[metric][engine]
+	if !ok {
+		return "", fmt.Errorf("metric %s is not supported for engine %s", metric, engine)
+	}
+
+	type param struct {
+		Name    string `json:"name"`
+		Summary string `json:"summary"`
+		Unit    string `json:"unit"`
+		Type    string `json:"type"`
+	}
+	type template struct {
+		Name        string            `json:"name"`
+		Version     int               `json:"version"`
+		Summary     string            `json:"summary"`
+		Expr        string            `json:"expr"`
+		Params      []param           `json:"params"`
+		For         string            `json:"for"`
+		Severity    string            `json:"severity"`
+		Annotations map[string]string `json:"annotations"`
+	}
+	b, err := yaml.Marshal(map[string][]template{
+		"templates": {{
+			Name:    pmmAlertTemplateName(metric, engine),
+			Version: 1,
+			Summary: fmt.Sprintf("Everest %s of %s", metric, engine),
+			Expr:    expr + " > [[ .threshold ]]",
+			Params: []param{{
+				Name: "threshold", Summary: "Threshold", Unit: alertRuleUnits[metric], Type: "float",
+			}},
+			For:      "5m",
+			Severity: "warning",
+			Annotations: map[string]string{
+				"summary":     fmt.Sprintf("%s of {{ $labels.node_name }} is above the threshold", metric),
+				"description": fmt.Sprintf("%s of {{ $labels.node_name }} is {{ $value }}%s", metric, alertRuleUnits[metric]),
+			},
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,79 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/pmm"
+)
+
+func TestAlertRules(t *testing.T) {
+	t.Parallel()
+
+	templates := []model.AlertRuleTemplate{
+		{Name: "high-cpu", Metric: model.AlertRuleMetricCPU, Threshold: 80, ForMinutes: 5, Severity: "warning", Enabled: true},
+		{Name: "lag", Metric: model.AlertRuleMetricReplicationLag, Threshold: 60, ForMinutes: 2, Severity: "critical", Enabled: true},
+		{Name: "disk", Metric: model.AlertRuleMetricDiskUsage, Threshold: 85, ForMinutes: 5, Severity: "critical"},
+	}
+
+	rules := alertRules(templates, "k8s-id", "my.db", everestv1alpha1.DatabaseEnginePSMDB)
+	require.Len(t, rules, 2)
+	assert.Equal(t, pmm.AlertRule{
+		TemplateName: "everest_cpu_psmdb",
+		Name:         "my.db high-cpu",
+		Group:        "everest-k8s-id-my.db",
+		Threshold:    80,
+		ForSeconds:   300,
+		Severity:     "warning",
+		Filters:      map[string]string{"node_name": `^my\.db-.+$`},
+	}, rules[0])
+	assert.Equal(t, "everest_replicationLag_psmdb", rules[1].TemplateName)
+	assert.Equal(t, 120, rules[1].ForSeconds)
+
+	mi := &model.MonitoringInstance{Name: "pmm", SecretGeneration: 1}
+	fingerprint := alertRulesFingerprint(mi, rules)
+	assert.Equal(t, fingerprint, alertRulesFingerprint(mi, alertRules(templates, "k8s-id", "my.db", everestv1alpha1.DatabaseEnginePSMDB)))
+	mi.SecretGeneration++
+	assert.NotEqual(t, fingerprint, alertRulesFingerprint(mi, rules))
+}
+
+func TestPMMAlertTemplate(t *testing.T) {
+	t.Parallel()
+
+	template, err := pmmAlertTemplate(model.AlertRuleMetricReplicationLag, everestv1alpha1.DatabaseEnginePostgresql)
+	require.NoError(t, err)
+	assert.Contains(t, template, "name: everest_replicationLag_postgresql")
+	assert.Contains(t, template, "expr: max by (node_name) (pg_replication_lag) > [[ .threshold ]]")
+
+	_, err = pmmAlertTemplate("connections", everestv1alpha1.DatabaseEnginePXC)
+	require.Error(t, err)
+}
+
+func TestValidateAlertRuleTemplateSpec(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, validateAlertRuleTemplateSpec(model.AlertRuleMetricReplicationLag, pointer.ToFloat64(300), nil))
+	require.Error(t, validateAlertRuleTemplateSpec(model.AlertRuleMetricCPU, pointer.ToFloat64(120), nil))
+	require.Error(t, validateAlertRuleTemplateSpec(model.AlertRuleMetricCPU, pointer.ToFloat64(0), nil))
+	require.Error(t, validateAlertRuleTemplateSpec(model.AlertRuleMetricCPU, nil, new(int)))
+}
@@ -0,0 +1,260 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+const (
+	apiTokenPrefix = "evt_"
+	apiTokenLength = 40
+)
+
+//nolint:gochecknoglobals
+var (
+	// unauthenticatedOperations are served without an API token even if it is required
+	// since they are protected on their own.
+	unauthenticatedOperations = map[string]struct{}{
+		"GET /status":               {},
+		"GET /replication/snapshot": {},
+		"POST /session/login":       {},
+		"POST /session/refresh":     {},
+		// The admin credentials can only be set once on the first boot.
+		"GET /setup":        {},
+		"POST /setup/admin": {},
+	}
+
+	// dashboardOperations are the aggregate, non-secret read endpoints the dashboard API tokens may access.
+	dashboardOperations = map[string]struct{}{
+		"GET /kubernetes":                                                   {},
+		"GET /kubernetes/:kubernetes-id":                                    {},
+		"GET /kubernetes/:kubernetes-id/cluster-info":                       {},
+		"GET /kubernetes/:kubernetes-id/status":                             {},
+		"GET /kubernetes/:kubernetes-id/backup-slos":                        {},
+		"GET /kubernetes/:kubernetes-id/database-clusters":                  {},
+		"GET /kubernetes/:kubernetes-id/database-clusters/:name":            {},
+		"GET /kubernetes/:kubernetes-id/database-clusters/:name/backups":    {},
+		"GET /kubernetes/:kubernetes-id/database-clusters/:name/restores":   {},
+		"GET /kubernetes/:kubernetes-id/database-clusters/:name/backup-slo": {},
+		"GET /kubernetes/:kubernetes-id/database-engines":                   {},
+		"GET /kubernetes/:kubernetes-id/database-engines-availability":      {},
+		"GET /backup-storages/:name/sync-status":                            {},
+		"GET /monitoring-instances/:name/sync-status":                       {},
+		"GET /config-rollouts":                                              {},
+		"GET /inventory":                                                    {},
+		"GET /search":                                                       {},
+		"GET /summary":                                                      {},
+		"GET /replication/status":                                           {},
+		"GET /status":                                                       {},
+	}
+)
+
+// CreateAPIToken creates an API token and returns it once.
+func (e *EverestServer) CreateAPIToken(ctx echo.Context) error {
+	var params CreateAPITokenParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if params.Name == "" {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("name cannot be empty")})
+	}
+	switch params.Scope {
+	case model.APITokenScopeAdmin, model.APITokenScopeDashboard, model.APITokenScopeProject:
+	default:
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("scope shall be one of %s, %s or %s",
+				model.APITokenScopeAdmin, model.APITokenScopeDashboard, model.APITokenScopeProject)),
+		})
+	}
+
+	secret, err := randomString(apiTokenLength)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not generate API token")})
+	}
+	value := apiTokenPrefix + secret
+	token := &model.APIToken{
+		Name:      params.Name,
+		Scope:     params.Scope,
+		TokenHash: hashAPIToken(value),
+		Team:      pointer.GetString(params.Team),
+	}
+	if err := e.storage.CreateAPIToken(ctx.Request().Context(), token); err != nil {
+		if _, ok := model.UniqueViolation(err); ok {
+			return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("API token with the same name already exists")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not create API token")})
+	}
+
+	return ctx.JSON(http.StatusOK, CreatedAPIToken{
+		Name:      token.Name,
+		Scope:     token.Scope,
+		Team:      apiTokenTeam(token),
+		Token:     value,
+		CreatedAt: token.CreatedAt,
+	})
+}
+
+// ListAPITokens lists the API tokens without their values.
+func (e *EverestServer) ListAPITokens(ctx echo.Context) error {
+	tokens, err := e.storage.ListAPITokens(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list API tokens")})
+	}
+
+	res := make(APITokenList, 0, len(tokens))
+	for _, t := range tokens {
+		t := t
+		res = append(res, APIToken{Name: t.Name, Scope: t.Scope, Team: apiTokenTeam(&t), CreatedAt: t.CreatedAt})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// DeleteAPIToken revokes an API token.
+func (e *EverestServer) DeleteAPIToken(ctx echo.Context, name string) error {
+	if err := e.storage.DeleteAPIToken(ctx.Request().Context(), name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("API token is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete API token")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// authenticateAPIToken is a middleware which authenticates the requests sent with an API token,
+// the access token of a session or a verified client certificate and limits them to the operations
+// allowed by the scope of the token. The requests without a token are rejected only if it is required.
+func (e *EverestServer) authenticateAPIToken(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		operation := apiOperation(ctx)
+		value, ok := bearerToken(ctx.Request().Header)
+		if !ok {
+			if id, ok := clientCertIdentity(ctx.Request().TLS, e.config.ClientCertScope); ok {
+				if !apiTokenAllows(e.config.ClientCertScope, operation) {
+					return ctx.JSON(http.StatusForbidden, Error{
+						Message: pointer.ToString("The client certificate scope does not allow this operation"),
+					})
+				}
+				setUserIdentity(ctx, id)
+				return next(ctx)
+			}
+			if _, exempt := unauthenticatedOperations[operation]; e.config.RequireAPIToken && !exempt {
+				return ctx.JSON(http.StatusUnauthorized, Error{Message: pointer.ToString("API token is required")})
+			}
+			return next(ctx)
+		}
+		if strings.HasPrefix(value, sessionAccessTokenPrefix) {
+			return e.authorizeSession(ctx, next, value, operation)
+		}
+
+		token, err := e.storage.GetAPITokenByHash(ctx.Request().Context(), hashAPIToken(value))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ctx.JSON(http.StatusUnauthorized, Error{Message: pointer.ToString("Invalid API token")})
+			}
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not check API token")})
+		}
+		if !apiTokenAllows(token.Scope, operation) {
+			return ctx.JSON(http.StatusForbidden, Error{
+				Message: pointer.ToString("The API token scope does not allow this operation"),
+			})
+		}
+
+		id := userIdentity{Username: "token:" + token.Name, ProjectScoped: token.Scope == model.APITokenScopeProject}
+		if token.Team != "" {
+			id.Groups = []string{token.Team}
+		}
+		setUserIdentity(ctx, id)
+		return next(ctx)
+	}
+}
+
+func (e *EverestServer) authorizeSession(ctx echo.Context, next echo.HandlerFunc, value, operation string) error {
+	id, scope, err := e.authenticateSession(ctx, value)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusUnauthorized, Error{Message: pointer.ToString("Invalid or expired session")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not check session")})
+	}
+	// Logging out is allowed in every scope.
+	if operation != "POST /session/logout" && !apiTokenAllows(scope, operation) {
+		return ctx.JSON(http.StatusForbidden, Error{
+			Message: pointer.ToString("The session scope does not allow this operation"),
+		})
+	}
+
+	setUserIdentity(ctx, id)
+	return next(ctx)
+}
+
+// apiOperation returns the method and the route of the request relative to the API base path.
+func apiOperation(ctx echo.Context) string {
+	return ctx.Request().Method + " " + strings.TrimPrefix(ctx.Path(), "/v1")
+}
+
+func apiTokenTeam(token *model.APIToken) *string {
+	if token.Team == "" {
+		return nil
+	}
+	return pointer.ToString(token.Team)
+}
+
+func apiTokenAllows(scope, operation string) bool {
+	switch scope {
+	case model.APITokenScopeAdmin:
+		return true
+	case model.APITokenScopeDashboard:
+		_, ok := dashboardOperations[operation]
+		return ok
+	case model.APITokenScopeProject:
+		return projectScopeAllows(operation)
+	default:
+		return false
+	}
+}
+
+func bearerToken(h http.Header) (string, bool) {
+	value, ok := strings.CutPrefix(h.Get(echo.HeaderAuthorization), "Bearer ")
+	if !ok || value == "" {
+		return "", false
+	}
+	return value, true
+}
+
+func hashAPIToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
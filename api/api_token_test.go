@@ -0,0 +1,101 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// apiTokenStorageStub knows the tokens by their hashes.
+type apiTokenStorageStub struct {
+	storage
+	tokens map[string]model.APIToken
+}
+
+func (s apiTokenStorageStub) GetAPITokenByHash(_ context.Context, hash string) (*model.APIToken, error) {
+	t, ok := s.tokens[hash]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &t, nil
+}
+
+func TestAuthenticateAPIToken(t *testing.T) {
+	t.Parallel()
+
+	stub := apiTokenStorageStub{tokens: map[string]model.APIToken{
+		hashAPIToken("evt_admin"):     {Name: "admin", Scope: model.APITokenScopeAdmin},
+		hashAPIToken("evt_dashboard"): {Name: "grafana", Scope: model.APITokenScopeDashboard},
+	}}
+
+	cases := []struct {
+		name     string
+		required bool
+		method   string
+		path     string
+		token    string
+		code     int
+	}{
+		{name: "no token", method: http.MethodDelete, path: "/v1/kubernetes/123", code: http.StatusNoContent},
+		{name: "no token required", required: true, method: http.MethodGet, path: "/v1/kubernetes", code: http.StatusUnauthorized},
+		{name: "public status", required: true, method: http.MethodGet, path: "/v1/status", code: http.StatusNoContent},
+		{name: "first boot admin", required: true, method: http.MethodPost, path: "/v1/setup/admin", code: http.StatusNoContent},
+		{name: "invalid token", method: http.MethodGet, path: "/v1/kubernetes", token: "evt_other", code: http.StatusUnauthorized},
+		{name: "admin", required: true, method: http.MethodDelete, path: "/v1/kubernetes/123", token: "evt_admin", code: http.StatusNoContent},
+		{name: "dashboard read", required: true, method: http.MethodGet, path: "/v1/kubernetes", token: "evt_dashboard", code: http.StatusNoContent},
+		{name: "dashboard write", method: http.MethodDelete, path: "/v1/kubernetes/123", token: "evt_dashboard", code: http.StatusForbidden},
+		{name: "dashboard secret", method: http.MethodGet, path: "/v1/kubernetes/123/database-clusters/db/credentials", token: "evt_dashboard", code: http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			e := &EverestServer{
+				config:  &config.EverestConfig{RequireAPIToken: tc.required},
+				l:       zap.NewNop().Sugar(),
+				storage: stub,
+			}
+			router := echo.New()
+			g := router.Group("/v1")
+			g.Use(e.authenticateAPIToken)
+			handler := func(ctx echo.Context) error { return ctx.NoContent(http.StatusNoContent) }
+			g.GET("/kubernetes", handler)
+			g.DELETE("/kubernetes/:kubernetes-id", handler)
+			g.GET("/kubernetes/:kubernetes-id/database-clusters/:name/credentials", handler)
+			g.GET("/status", handler)
+			g.POST("/setup/admin", handler)
+
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			if tc.token != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+tc.token)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			assert.Equal(t, tc.code, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// Audited actions.
+const (
+	auditActionBackupDeletionOverride  = "backup-deletion-override"
+	auditActionLegalHoldSet            = "legal-hold-set"
+	auditActionLegalHoldReleased       = "legal-hold-released"
+	auditActionRestoreCreated          = "restore-created"
+	auditActionTemporaryAccessGranted  = "temporary-access-granted"
+	auditActionEngineUpgrade           = "engine-upgrade"
+	auditActionBackupRetentionPruned   = "backup-retention-pruned"
+	auditActionOrphanDeleted           = "orphan-deleted"
+	auditActionBackupDownloadURLIssued = "backup-download-url-issued"
+)
+
+// ListAuditEntries lists the audit entries.
+func (e *EverestServer) ListAuditEntries(ctx echo.Context, params ListAuditEntriesParams) error {
+	list, total, err := e.storage.ListAuditEntries(ctx.Request().Context(), model.ListAuditEntriesParams{
+		Action: pointer.GetString(params.Action),
+		Pagination: model.Pagination{
+			Limit:  pointer.GetInt(params.Limit),
+			Offset: pointer.GetInt(params.Offset),
+		},
+	})
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not list audit entries"),
+		})
+	}
+
+	result := make(AuditEntryList, 0, len(list))
+	for _, entry := range list {
+		result = append(result, AuditEntry{
+			Action:       entry.Action,
+			Resource:     entry.Resource,
+			KubernetesId: pointer.ToStringOrNil(entry.KubernetesID),
+			Actor:        pointer.ToStringOrNil(entry.Actor),
+			Reason:       pointer.ToStringOrNil(entry.Reason),
+			CreatedAt:    entry.CreatedAt,
+		})
+	}
+
+	ctx.Response().Header().Set(totalCountHeader, strconv.Itoa(total))
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// recordAudit stores an audit entry for the action performed by the user of the request.
+func (e *EverestServer) recordAudit(ctx echo.Context, action, kubernetesID, resource, reason string) error {
+	entry := &model.AuditEntry{
+		Action:       action,
+		Resource:     resource,
+		KubernetesID: kubernetesID,
+		Reason:       reason,
+	}
+	if id, ok := userIdentityFrom(ctx); ok {
+		entry.Actor = id.Username
+	}
+	if err := e.storage.CreateAuditEntry(ctx.Request().Context(), entry); err != nil {
+		return errors.Join(err, errors.New("could not create audit entry"))
+	}
+	return nil
+}
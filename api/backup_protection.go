@@ -0,0 +1,110 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	legalHoldAnnotation       = "everest.percona.com/legal-hold"
+	legalHoldReasonAnnotation = "everest.percona.com/legal-hold-reason"
+)
+
+// SetDatabaseClusterBackupLegalHold places a backup under legal hold or releases it.
+func (e *EverestServer) SetDatabaseClusterBackupLegalHold(ctx echo.Context, kubernetesID string, name string, _ SetDatabaseClusterBackupLegalHoldParams) error {
+	var params LegalHold
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	backup, err := kubeClient.GetDatabaseClusterBackup(ctx.Request().Context(), name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster backup not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not get database cluster backup"),
+		})
+	}
+
+	if backup.Annotations == nil {
+		backup.Annotations = map[string]string{}
+	}
+	action := auditActionLegalHoldReleased
+	if params.Enabled {
+		action = auditActionLegalHoldSet
+		backup.Annotations[legalHoldAnnotation] = "true"
+		backup.Annotations[legalHoldReasonAnnotation] = pointer.GetString(params.Reason)
+	} else {
+		delete(backup.Annotations, legalHoldAnnotation)
+		delete(backup.Annotations, legalHoldReasonAnnotation)
+	}
+
+	if _, err := kubeClient.UpdateDatabaseClusterBackup(ctx.Request().Context(), backup); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not update database cluster backup"),
+		})
+	}
+
+	if err := e.recordAudit(ctx, action, kubernetesID, backupAuditResource(name), pointer.GetString(params.Reason)); err != nil {
+		e.l.Error(err)
+	}
+
+	return ctx.JSON(http.StatusOK, params)
+}
+
+// backupProtection returns the reason the backup cannot be deleted or an empty string if it can be.
+// Backups under legal hold and backups younger than the compliance age are protected.
+func backupProtection(backup *everestv1alpha1.DatabaseClusterBackup, complianceAge time.Duration, now time.Time) string {
+	if backup.Annotations[legalHoldAnnotation] == "true" {
+		if reason := backup.Annotations[legalHoldReasonAnnotation]; reason != "" {
+			return fmt.Sprintf("the backup is under legal hold: %s", reason)
+		}
+		return "the backup is under legal hold"
+	}
+
+	if complianceAge > 0 {
+		createdAt := backup.CreationTimestamp.Time
+		if backup.Status.CreatedAt != nil {
+			createdAt = backup.Status.CreatedAt.Time
+		}
+		if age := now.Sub(createdAt); age < complianceAge {
+			return fmt.Sprintf("the backup is younger than the compliance age of %s", complianceAge)
+		}
+	}
+
+	return ""
+}
+
+func backupAuditResource(name string) string {
+	return "database-cluster-backups/" + name
+}
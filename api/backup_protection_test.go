@@ -0,0 +1,67 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBackupProtection(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	backup := func(age time.Duration, annotations map[string]string) *everestv1alpha1.DatabaseClusterBackup {
+		return &everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations:       annotations,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+		}
+	}
+
+	cases := []struct {
+		name          string
+		backup        *everestv1alpha1.DatabaseClusterBackup
+		complianceAge time.Duration
+		protected     bool
+	}{
+		{name: "no protection", backup: backup(time.Hour, nil)},
+		{name: "older than compliance age", backup: backup(48*time.Hour, nil), complianceAge: 24 * time.Hour},
+		{name: "younger than compliance age", backup: backup(time.Hour, nil), complianceAge: 24 * time.Hour, protected: true},
+		{
+			name:      "legal hold",
+			backup:    backup(48*time.Hour, map[string]string{legalHoldAnnotation: "true", legalHoldReasonAnnotation: "case 42"}),
+			protected: true,
+		},
+		{
+			name:   "released legal hold",
+			backup: backup(time.Hour, map[string]string{legalHoldAnnotation: "false"}),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			reason := backupProtection(tc.backup, tc.complianceAge, now)
+			assert.Equal(t, tc.protected, reason != "", reason)
+		})
+	}
+}
@@ -0,0 +1,273 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// backupSLOAtRiskRatio defines which part of the SLO interval may pass without
+// a successful backup before the SLO is considered at risk.
+const backupSLOAtRiskRatio = 0.8
+
+// successfulBackupStates contains the lowercased states reported by the operators for successful backups.
+var successfulBackupStates = map[string]struct{}{ //nolint:gochecknoglobals
+	"succeeded": {},
+	"ready":     {},
+}
+
+// ListBackupSLOs lists the backup SLOs of the database clusters on the specified kubernetes cluster.
+func (e *EverestServer) ListBackupSLOs(ctx echo.Context, kubernetesID string) error {
+	c := ctx.Request().Context()
+	slos, err := e.storage.ListBackupSLOs(c, kubernetesID)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list backup SLOs")})
+	}
+
+	backups, code, err := e.listBackupsForSLOs(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	now := time.Now()
+	result := make([]BackupSLO, 0, len(slos))
+	for _, slo := range slos {
+		result = append(result, backupSLOCompliance(slo, backups, now))
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// GetDatabaseClusterBackupSLO returns the backup SLO of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterBackupSLO(ctx echo.Context, kubernetesID string, name string, _ GetDatabaseClusterBackupSLOParams) error {
+	c := ctx.Request().Context()
+	slo, err := e.storage.GetBackupSLO(c, kubernetesID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup SLO")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup SLO")})
+	}
+
+	backups, code, err := e.listBackupsForSLOs(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	return ctx.JSON(http.StatusOK, backupSLOCompliance(*slo, backups, time.Now()))
+}
+
+// SetDatabaseClusterBackupSLO creates or updates the backup SLO of the specified database cluster.
+func (e *EverestServer) SetDatabaseClusterBackupSLO(ctx echo.Context, kubernetesID string, name string, _ SetDatabaseClusterBackupSLOParams) error {
+	var params BackupSLOParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if params.IntervalHours < 1 {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("intervalHours shall be at least 1")})
+	}
+
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if _, err := kubeClient.GetDatabaseCluster(c, name); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(fmt.Sprintf("DatabaseCluster '%s' is not found", name))})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	slo, err := e.storage.SaveBackupSLO(c, model.SaveBackupSLOParams{
+		KubernetesID:  kubernetesID,
+		DBClusterName: name,
+		IntervalHours: params.IntervalHours,
+	})
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save backup SLO")})
+	}
+
+	backups, err := kubeClient.ListDatabaseClusterBackups(c)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list database cluster backups")})
+	}
+
+	return ctx.JSON(http.StatusOK, backupSLOCompliance(*slo, backups.Items, time.Now()))
+}
+
+// DeleteDatabaseClusterBackupSLO deletes the backup SLO of the specified database cluster.
+func (e *EverestServer) DeleteDatabaseClusterBackupSLO(ctx echo.Context, kubernetesID string, name string, _ DeleteDatabaseClusterBackupSLOParams) error {
+	c := ctx.Request().Context()
+	if _, err := e.storage.GetBackupSLO(c, kubernetesID, name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup SLO")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup SLO")})
+	}
+
+	if err := e.storage.DeleteBackupSLO(c, kubernetesID, name); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete backup SLO")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (e *EverestServer) listBackupsForSLOs(ctx context.Context, kubernetesID string) ([]everestv1alpha1.DatabaseClusterBackup, int, error) {
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx, kubernetesID)
+	if err != nil {
+		return nil, code, err
+	}
+
+	backups, err := kubeClient.ListDatabaseClusterBackups(ctx)
+	if err != nil {
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not list database cluster backups")
+	}
+
+	return backups.Items, 0, nil
+}
+
+// runBackupSLOChecker periodically checks the compliance of all backup SLOs
+// until the context is canceled.
+func (e *EverestServer) runBackupSLOChecker(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.BackupSLOCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.checkBackupSLOs(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) checkBackupSLOs(ctx context.Context) {
+	slos, err := e.storage.ListAllBackupSLOs(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list backup SLOs")))
+		return
+	}
+
+	byCluster := make(map[string][]model.BackupSLO)
+	for _, slo := range slos {
+		byCluster[slo.KubernetesID] = append(byCluster[slo.KubernetesID], slo)
+	}
+
+	for kubernetesID, slos := range byCluster {
+		if ctx.Err() != nil {
+			return
+		}
+
+		backups, _, err := e.listBackupsForSLOs(ctx, kubernetesID)
+		if err != nil {
+			e.l.Warn(errors.Join(err, fmt.Errorf("could not check backup SLOs of Kubernetes cluster %s", kubernetesID)))
+			continue
+		}
+
+		now := time.Now()
+		for _, slo := range slos {
+			res := backupSLOCompliance(slo, backups, now)
+			if string(res.Status) == slo.LastStatus {
+				continue
+			}
+
+			if res.Status != Compliant {
+				e.alertBackupSLO(kubernetesID, res)
+			}
+			if err := e.storage.SetBackupSLOLastStatus(ctx, kubernetesID, slo.DBClusterName, string(res.Status)); err != nil {
+				e.l.Error(errors.Join(err, errors.New("could not store backup SLO status")))
+			}
+		}
+	}
+}
+
+// alertBackupSLO reports a backup SLO which is at risk or breached.
+func (e *EverestServer) alertBackupSLO(kubernetesID string, slo BackupSLO) {
+	e.l.Warnf(
+		"Backup SLO of database cluster %s on Kubernetes cluster %s is %s: a successful backup was due at %s",
+		slo.DbClusterName, kubernetesID, slo.Status, slo.DueAt.Format(time.RFC3339),
+	)
+}
+
+// backupSLOCompliance computes the compliance of a backup SLO based on the backups of the database cluster.
+// If there was no successful backup yet, the SLO interval is counted from the SLO creation.
+func backupSLOCompliance(slo model.BackupSLO, backups []everestv1alpha1.DatabaseClusterBackup, now time.Time) BackupSLO {
+	res := BackupSLO{
+		DbClusterName: slo.DBClusterName,
+		IntervalHours: slo.IntervalHours,
+	}
+
+	for _, b := range backups {
+		if b.Spec.DBClusterName != slo.DBClusterName || b.Status.CompletedAt == nil {
+			continue
+		}
+		if _, ok := successfulBackupStates[strings.ToLower(string(b.Status.State))]; !ok {
+			continue
+		}
+		completedAt := b.Status.CompletedAt.Time
+		if res.LastSuccessfulBackupAt == nil || completedAt.After(*res.LastSuccessfulBackupAt) {
+			res.LastSuccessfulBackupAt = &completedAt
+		}
+	}
+
+	since := slo.CreatedAt
+	if res.LastSuccessfulBackupAt != nil {
+		since = *res.LastSuccessfulBackupAt
+	}
+	res.DueAt = since.Add(slo.Interval())
+
+	elapsed := now.Sub(since)
+	switch {
+	case elapsed > slo.Interval():
+		res.Status = Breached
+	case elapsed > time.Duration(float64(slo.Interval())*backupSLOAtRiskRatio):
+		res.Status = AtRisk
+	default:
+		res.Status = Compliant
+	}
+
+	return res
+}
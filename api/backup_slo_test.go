@@ -0,0 +1,116 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestBackupSLOCompliance(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 10, 10, 12, 0, 0, 0, time.UTC)
+	slo := model.BackupSLO{
+		DBClusterName: "db",
+		IntervalHours: 24,
+		CreatedAt:     now.Add(-72 * time.Hour),
+	}
+	backup := func(cluster string, state everestv1alpha1.BackupState, completedAgo time.Duration) everestv1alpha1.DatabaseClusterBackup {
+		return everestv1alpha1.DatabaseClusterBackup{
+			Spec: everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: cluster},
+			Status: everestv1alpha1.DatabaseClusterBackupStatus{
+				State:       state,
+				CompletedAt: &metav1.Time{Time: now.Add(-completedAgo)},
+			},
+		}
+	}
+
+	type tCase struct {
+		name       string
+		slo        model.BackupSLO
+		backups    []everestv1alpha1.DatabaseClusterBackup
+		status     BackupSLOStatus
+		lastBackup *time.Time
+		dueAt      time.Time
+	}
+
+	cases := []tCase{
+		{
+			name:       "recent successful backup",
+			slo:        slo,
+			backups:    []everestv1alpha1.DatabaseClusterBackup{backup("db", "Succeeded", 2*time.Hour)},
+			status:     Compliant,
+			lastBackup: pointer.ToTime(now.Add(-2 * time.Hour)),
+			dueAt:      now.Add(22 * time.Hour),
+		},
+		{
+			name: "latest successful backup is used",
+			slo:  slo,
+			backups: []everestv1alpha1.DatabaseClusterBackup{
+				backup("db", "ready", 30*time.Hour),
+				backup("db", "ready", 20*time.Hour),
+				backup("db", "Failed", time.Hour),
+				backup("other", "Succeeded", time.Hour),
+			},
+			status:     AtRisk,
+			lastBackup: pointer.ToTime(now.Add(-20 * time.Hour)),
+			dueAt:      now.Add(4 * time.Hour),
+		},
+		{
+			name:       "no recent successful backup",
+			slo:        slo,
+			backups:    []everestv1alpha1.DatabaseClusterBackup{backup("db", "Succeeded", 25*time.Hour)},
+			status:     Breached,
+			lastBackup: pointer.ToTime(now.Add(-25 * time.Hour)),
+			dueAt:      now.Add(-time.Hour),
+		},
+		{
+			name: "no backups since a recent SLO creation",
+			slo: model.BackupSLO{
+				DBClusterName: "db",
+				IntervalHours: 24,
+				CreatedAt:     now.Add(-time.Hour),
+			},
+			status: Compliant,
+			dueAt:  now.Add(23 * time.Hour),
+		},
+		{
+			name:   "no backups at all",
+			slo:    slo,
+			status: Breached,
+			dueAt:  now.Add(-48 * time.Hour),
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res := backupSLOCompliance(tc.slo, tc.backups, now)
+			assert.Equal(t, tc.status, res.Status)
+			assert.Equal(t, tc.lastBackup, res.LastSuccessfulBackupAt)
+			assert.Equal(t, tc.dueAt, res.DueAt)
+		})
+	}
+}
@@ -21,20 +21,33 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/AlekSi/pointer"
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
 	"github.com/labstack/echo/v4"
-	"github.com/lib/pq"
 
 	"github.com/percona/percona-everest-backend/model"
 	"github.com/percona/percona-everest-backend/pkg/kubernetes"
 )
 
 // ListBackupStorages lists backup storages.
-func (e *EverestServer) ListBackupStorages(ctx echo.Context) error {
-	list, err := e.storage.ListBackupStorages(ctx.Request().Context())
+func (e *EverestServer) ListBackupStorages(ctx echo.Context, params ListBackupStoragesParams) error {
+	list, total, err := e.storage.ListBackupStorages(ctx.Request().Context(), model.ListBackupStoragesParams{
+		SortBy:     string(pointer.Get(params.SortBy)),
+		Order:      string(pointer.Get(params.Order)),
+		Type:       pointer.GetString(params.Type),
+		Region:     pointer.GetString(params.Region),
+		NamePrefix: pointer.GetString(params.NamePrefix),
+		Projects:   callerProjects(ctx),
+		Deleted:    pointer.GetBool(params.Deleted),
+		Pagination: model.Pagination{
+			Limit:  pointer.GetInt(params.Limit),
+			Offset: pointer.GetInt(params.Offset),
+		},
+	})
 	if err != nil {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, Error{
@@ -45,16 +58,10 @@ func (e *EverestServer) ListBackupStorages(ctx echo.Context) error {
 	result := make([]BackupStorage, 0, len(list))
 	for _, bs := range list {
 		s := bs
-		result = append(result, BackupStorage{
-			Type:        BackupStorageType(bs.Type),
-			Name:        s.Name,
-			Description: &s.Description,
-			BucketName:  s.BucketName,
-			Region:      s.Region,
-			Url:         &s.URL,
-		})
+		result = append(result, backupStorageToAPIJson(&s))
 	}
 
+	ctx.Response().Header().Set(totalCountHeader, strconv.Itoa(total))
 	return ctx.JSON(http.StatusOK, result)
 }
 
@@ -67,6 +74,9 @@ func (e *EverestServer) CreateBackupStorage(ctx echo.Context) error {
 	}
 
 	c := ctx.Request().Context()
+	if code, err := e.checkProjectExists(c, pointer.GetString(params.Project)); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
 
 	existingStorage, err := e.storage.GetBackupStorage(c, nil, params.Name)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
@@ -80,23 +90,47 @@ func (e *EverestServer) CreateBackupStorage(ctx echo.Context) error {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(err.Error())})
 	}
+	if code, err := e.checkBackupStorageNotInTrash(c, params.Name); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
 
-	var accessKeyID, secretKeyID *string
-	defer e.cleanUpNewSecretsOnUpdateError(err, accessKeyID, secretKeyID)
+	var expireAt *time.Time
+	if credentialSourceOf(*params) == model.CredentialSourceSTS {
+		t, err := e.stsCredentialsFor(c, params)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusBadRequest, Error{
+				Message: pointer.ToString(fmt.Sprintf("Could not access the backup storage with the assumed role: %s", err)),
+			})
+		}
+		expireAt = &t
+	}
 
-	accessKeyID, secretKeyID, err = e.createSecrets(c, &params.AccessKey, &params.SecretKey)
+	var ids backupStorageSecretIDs
+	defer func() {
+		e.cleanUpNewSecretsOnUpdateError(err, ids.accessKey, ids.secretKey)
+		e.cleanUpNewSecretsOnUpdateError(err, ids.sessionToken, ids.caCert)
+	}()
+
+	// No keys are stored for the IAM credentials.
+	ids.accessKey, ids.secretKey, err = e.createSecrets(c, params.AccessKey, params.SecretKey)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
 	}
-	s, err := e.createBackupStorage(c, params, accessKeyID, secretKeyID)
+	ids.sessionToken, err = e.createOptionalSecret(c, "session token", params.SessionToken)
 	if err != nil {
-		var pgErr *pq.Error
-		if errors.As(err, &pgErr) {
-			if pgErr.Code.Name() == pgErrUniqueViolation {
-				return ctx.JSON(http.StatusBadRequest, Error{
-					Message: pointer.ToString("Backup storage with the same name already exists. " + pgErr.Detail),
-				})
-			}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
+	}
+	ids.caCert, err = e.createOptionalSecret(c, "CA certificate", params.CaCert)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
+	}
+	s, err := e.createBackupStorage(c, params, ids, expireAt, creatorFrom(ctx))
+	if err != nil {
+		if detail, ok := model.UniqueViolation(err); ok {
+			return ctx.JSON(http.StatusBadRequest, Error{
+				Message: pointer.ToString("Backup storage with the same name already exists. " + detail),
+			})
 		}
 
 		e.l.Error(err)
@@ -105,19 +139,24 @@ func (e *EverestServer) CreateBackupStorage(ctx echo.Context) error {
 		})
 	}
 
-	result := BackupStorage{
-		Type:        BackupStorageType(s.Type),
-		Name:        s.Name,
-		Description: &s.Description,
-		BucketName:  s.BucketName,
-		Region:      s.Region,
-		Url:         &s.URL,
-	}
+	e.emitWebhookEvent(ctx, BackupStorageCreated, "", "backup-storages/"+s.Name)
+	result := backupStorageToAPIJson(s)
 
 	return ctx.JSON(http.StatusOK, result)
 }
 
-func (e *EverestServer) createBackupStorage(c context.Context, params *CreateBackupStorageParams, accessKeyID, secretKeyID *string) (*model.BackupStorage, error) {
+// backupStorageSecretIDs are the ids of the backup storage secrets stored in the secrets storage.
+// The ids are nil for the secrets which are not set.
+type backupStorageSecretIDs struct {
+	accessKey    *string
+	secretKey    *string
+	sessionToken *string
+	caCert       *string
+}
+
+func (e *EverestServer) createBackupStorage(
+	c context.Context, params *CreateBackupStorageParams, ids backupStorageSecretIDs, expireAt *time.Time, createdBy string,
+) (*model.BackupStorage, error) {
 	var url string
 	if params.Url != nil {
 		url = *params.Url
@@ -129,14 +168,23 @@ func (e *EverestServer) createBackupStorage(c context.Context, params *CreateBac
 	}
 
 	return e.storage.CreateBackupStorage(c, model.CreateBackupStorageParams{
-		Name:        params.Name,
-		Description: description,
-		Type:        string(params.Type),
-		BucketName:  params.BucketName,
-		URL:         url,
-		Region:      params.Region,
-		AccessKeyID: *accessKeyID,
-		SecretKeyID: *secretKeyID,
+		Name:                params.Name,
+		Description:         description,
+		Type:                string(params.Type),
+		BucketName:          params.BucketName,
+		URL:                 url,
+		Region:              params.Region,
+		AccessKeyID:         pointer.GetString(ids.accessKey),
+		SecretKeyID:         pointer.GetString(ids.secretKey),
+		SessionTokenID:      pointer.GetString(ids.sessionToken),
+		CredentialSource:    credentialSourceOf(*params),
+		RoleARN:             pointer.GetString(params.RoleArn),
+		CredentialsExpireAt: expireAt,
+		CACertID:            pointer.GetString(ids.caCert),
+		SkipTLSVerify:       params.VerifyTLS != nil && !*params.VerifyTLS,
+		ForcePathStyle:      pointer.GetBool(params.ForcePathStyle),
+		Project:             pointer.GetString(params.Project),
+		CreatedBy:           createdBy,
 	})
 }
 
@@ -154,24 +202,7 @@ func (e *EverestServer) DeleteBackupStorage(ctx echo.Context, backupStorageName
 		})
 	}
 
-	ks, err := e.storage.ListKubernetesClusters(c)
-	if err != nil {
-		e.l.Error(errors.Join(err, errors.New("could not list Kubernetes clusters")))
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list Kubernetes clusters")})
-	}
-	if len(ks) == 0 {
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("No registered kubernetes clusters available")})
-	}
-	// FIXME: Revisit it once multi k8s support will be enabled
-	_, kubeClient, _, err := e.initKubeClient(ctx.Request().Context(), ks[0].ID)
-	if err != nil {
-		e.l.Error(errors.Join(err, errors.New("could not init kube client for config")))
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not connect to the Kubernetes cluster")})
-	}
-
-	err = kubeClient.DeleteConfig(ctx.Request().Context(), bs, func(ctx context.Context, name string) (bool, error) {
-		return kubernetes.IsBackupStorageConfigInUse(ctx, name, kubeClient)
-	})
+	pending, statuses, err := e.deleteConfigFromClusters(c, model.ConfigKindBackupStorage, bs.Name, bs)
 	if err != nil {
 		e.l.Error(errors.Join(err, errors.New("could not delete config")))
 		if errors.Is(err, kubernetes.ErrConfigInUse) {
@@ -179,34 +210,74 @@ func (e *EverestServer) DeleteBackupStorage(ctx echo.Context, backupStorageName
 		}
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
 	}
-	err = e.deleteBackupStorage(c, bs)
-
-	if err != nil {
+	if err := e.deleteBackupStorage(c, bs, pending); err != nil {
 		return ctx.JSON(http.StatusInternalServerError, Error{
 			Message: pointer.ToString(err.Error()),
 		})
 	}
+	e.emitWebhookEvent(ctx, BackupStorageDeleted, "", "backup-storages/"+backupStorageName)
 
+	if len(pending) != 0 {
+		return ctx.JSON(http.StatusAccepted, statuses)
+	}
 	return ctx.NoContent(http.StatusNoContent)
 }
 
-func (e *EverestServer) deleteBackupStorage(c context.Context, bs *model.BackupStorage) error {
+func (e *EverestServer) deleteBackupStorage(c context.Context, bs *model.BackupStorage, pending []model.ConfigDeletion) error {
 	return e.storage.Transaction(func(tx *gorm.DB) error {
 		err := e.storage.DeleteBackupStorage(c, bs.Name, tx)
 		if err != nil {
 			e.l.Error(err)
 			return errors.New("could not delete backup storage")
 		}
-		if _, err := e.secretsStorage.DeleteSecret(c, bs.AccessKeyID); err != nil {
-			return errors.Join(err, errors.New("could not delete access key from secrets storage"))
+		if err := e.storage.DeleteConfigSyncs(c, model.ConfigKindBackupStorage, bs.Name, tx); err != nil {
+			e.l.Error(err)
+			return errors.New("could not delete backup storage sync status")
 		}
-
-		if _, err := e.secretsStorage.DeleteSecret(c, bs.SecretKeyID); err != nil {
-			return errors.Join(err, errors.New("could not delete secret key from secrets storage"))
+		if err := e.savePendingConfigDeletions(c, pending, tx); err != nil {
+			return err
+		}
+		if err := e.storage.DeleteConfigRollout(c, model.ConfigKindBackupStorage, bs.Name, tx); err != nil {
+			e.l.Error(err)
+			return errors.New("could not delete backup storage rollout")
 		}
+		// The secrets are kept for the backup storage to be restored from the trash.
+		if e.config.TrashRetention != 0 {
+			return nil
+		}
+		return e.purgeBackupStorage(c, bs, tx)
+	})
+}
 
+// purgeBackupStorage deletes the backup storage and its secrets permanently.
+func (e *EverestServer) purgeBackupStorage(c context.Context, bs *model.BackupStorage, tx *gorm.DB) error {
+	if err := e.storage.PurgeBackupStorage(c, bs.Name, tx); err != nil {
+		e.l.Error(err)
+		return errors.New("could not purge backup storage")
+	}
+	if bs.CACertID != "" {
+		if _, err := e.secretsStorage.DeleteSecret(c, bs.CACertID); err != nil {
+			return errors.Join(err, errors.New("could not delete CA certificate from secrets storage"))
+		}
+	}
+	if bs.UsesIAM() {
 		return nil
-	})
+	}
+	if _, err := e.secretsStorage.DeleteSecret(c, bs.AccessKeyID); err != nil {
+		return errors.Join(err, errors.New("could not delete access key from secrets storage"))
+	}
+
+	if _, err := e.secretsStorage.DeleteSecret(c, bs.SecretKeyID); err != nil {
+		return errors.Join(err, errors.New("could not delete secret key from secrets storage"))
+	}
+	if bs.SessionTokenID != "" {
+		if _, err := e.secretsStorage.DeleteSecret(c, bs.SessionTokenID); err != nil {
+			return errors.Join(err, errors.New("could not delete session token from secrets storage"))
+		}
+	}
+	e.deletePreviousCredentials(c, bs)
+
+	return nil
 }
 
 // GetBackupStorage retrieves the specified backup storage.
@@ -222,15 +293,9 @@ func (e *EverestServer) GetBackupStorage(ctx echo.Context, backupStorageID strin
 		})
 	}
 
-	result := BackupStorage{
-		Description: &s.Description,
-		Type:        BackupStorageType(s.Type),
-		BucketName:  s.BucketName,
-		Name:        s.Name,
-		Region:      s.Region,
-		Url:         &s.URL,
-	}
+	result := backupStorageToAPIJson(s)
 
+	ctx.Response().Header().Set("ETag", versionETag(s.Version))
 	return ctx.JSON(http.StatusOK, result)
 }
 
@@ -243,6 +308,21 @@ func (e *EverestServer) UpdateBackupStorage(ctx echo.Context, backupStorageName
 
 	c := ctx.Request().Context()
 
+	// The stale updates are rejected before the backup storage is accessed with the new credentials.
+	current, err := e.storage.GetBackupStorage(c, nil, backupStorageName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup storage")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup storage")})
+	}
+	version, code, err := checkVersion(ctx, "backup storage", params.Version, current.Version)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	params.Version = version
+
 	// check data access
 	s, err := e.checkStorageAccessByUpdate(c, backupStorageName, *params)
 	if err != nil {
@@ -255,28 +335,33 @@ func (e *EverestServer) UpdateBackupStorage(ctx echo.Context, backupStorageName
 		})
 	}
 
-	var newAccessKeyID, newSecretKeyID *string
-	defer e.cleanUpNewSecretsOnUpdateError(err, newAccessKeyID, newSecretKeyID)
+	var ids backupStorageSecretIDs
+	// The secrets created before a failure are cleaned up, hence the ids are read once the handler returns.
+	defer func() {
+		e.cleanUpNewSecretsOnUpdateError(err, ids.accessKey, ids.secretKey)
+		e.cleanUpNewSecretsOnUpdateError(err, ids.sessionToken, ids.caCert)
+	}()
 
-	newAccessKeyID, newSecretKeyID, err = e.createSecrets(c, params.AccessKey, params.SecretKey)
+	ids.accessKey, ids.secretKey, err = e.createSecrets(c, params.AccessKey, params.SecretKey)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed to create secrets")})
+	}
+	ids.sessionToken, err = e.createOptionalSecret(c, "session token", params.SessionToken)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed to create secrets")})
+	}
+	ids.caCert, err = e.createOptionalSecret(c, "CA certificate", params.CaCert)
 	if err != nil {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed to create secrets")})
 	}
-
-	return e.performBackupStorageUpdate(ctx, backupStorageName, params, newAccessKeyID, newSecretKeyID, s)
-}
-
-func (e *EverestServer) performBackupStorageUpdate(
-	ctx echo.Context, backupStorageName string, params *UpdateBackupStorageParams,
-	newAccessKeyID, newSecretKeyID *string, s *model.BackupStorage,
-) error {
-	c := ctx.Request().Context()
 
 	httpStatusCode := http.StatusInternalServerError
-	err := e.storage.Transaction(func(tx *gorm.DB) error {
+	err = e.storage.Transaction(func(tx *gorm.DB) error {
 		var err error
-		httpStatusCode, err = e.updateBackupStorage(c, tx, backupStorageName, params, newAccessKeyID, newSecretKeyID)
+		httpStatusCode, err = e.updateBackupStorage(c, tx, backupStorageName, params, ids)
 		if err != nil {
 			return err
 		}
@@ -288,42 +373,36 @@ func (e *EverestServer) performBackupStorageUpdate(
 			Message: pointer.ToString(err.Error()),
 		})
 	}
+
+	return e.respondBackupStorageUpdated(ctx, backupStorageName, params, s)
+}
+
+// respondBackupStorageUpdated syncs the committed backup storage update and responds with the updated backup storage.
+func (e *EverestServer) respondBackupStorageUpdated(
+	ctx echo.Context, backupStorageName string, params *UpdateBackupStorageParams, s *model.BackupStorage,
+) error {
+	c := ctx.Request().Context()
+
 	bs, err := e.storage.GetBackupStorage(c, nil, backupStorageName)
 	if err != nil {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not find updated backup storage")})
 	}
-	ks, err := e.storage.ListKubernetesClusters(c)
+	// The Kubernetes clusters which could not be synced now are retried by the config syncer.
+	cfg := backupStorageSyncedConfig(bs)
+	syncs, err := e.syncConfig(c, cfg, false)
 	if err != nil {
-		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list Kubernetes clusters")})
-	}
-	if len(ks) == 0 {
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("No registered kubernetes clusters available")})
-	}
-	// FIXME: Revisit it once multi k8s support will be enabled
-	_, kubeClient, _, err := e.initKubeClient(ctx.Request().Context(), ks[0].ID)
-	if err != nil {
-		e.l.Error(errors.Join(err, errors.New("could not init kube client to update config")))
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not init kubernetes client to update config")})
-	}
-
-	if err := kubeClient.UpdateConfig(ctx.Request().Context(), bs, e.secretsStorage.GetSecret); err != nil {
-		e.l.Error(errors.Join(err, errors.New("could not update config")))
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not update config on the kubernetes cluster")})
+		e.l.Error(errors.Join(err, errors.New("could not sync config")))
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not sync config to the kubernetes clusters")})
 	}
 
 	e.deleteOldSecretsAfterUpdate(c, params, s)
+	e.emitWebhookEvent(ctx, BackupStorageUpdated, "", "backup-storages/"+backupStorageName)
 
-	result := BackupStorage{
-		Type:        BackupStorageType(bs.Type),
-		Name:        bs.Name,
-		Description: &bs.Description,
-		BucketName:  bs.BucketName,
-		Region:      bs.Region,
-		Url:         &bs.URL,
-	}
+	result := backupStorageToAPIJson(bs)
+	result.SyncStatus = pointer.To(configSyncsToAPIJson(cfg, syncs))
 
+	ctx.Response().Header().Set("ETag", versionETag(bs.Version))
 	return ctx.JSON(http.StatusOK, result)
 }
 
@@ -359,6 +438,20 @@ func (e *EverestServer) createSecrets(
 	return newAccessKeyID, newSecretKeyID, nil
 }
 
+// createOptionalSecret stores the value if it is set and not empty.
+func (e *EverestServer) createOptionalSecret(ctx context.Context, name string, value *string) (*string, error) {
+	if value == nil || *value == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	id := uuid.NewString()
+	if err := e.secretsStorage.CreateSecret(ctx, id, *value); err != nil {
+		e.l.Error(err)
+		return nil, fmt.Errorf("could not store %s in secrets storage", name)
+	}
+	return &id, nil
+}
+
 func (e *EverestServer) deleteOldSecretsAfterUpdate(ctx context.Context, params *UpdateBackupStorageParams, s *model.BackupStorage) {
 	// delete old AccessKey
 	if params.AccessKey != nil {
@@ -375,6 +468,22 @@ func (e *EverestServer) deleteOldSecretsAfterUpdate(ctx context.Context, params
 			e.l.Errorf("Failed to delete unused secret, please delete it manually. id = %s", s.SecretKeyID)
 		}
 	}
+
+	// delete old SessionToken
+	if params.SessionToken != nil && s.SessionTokenID != "" {
+		_, cErr := e.secretsStorage.DeleteSecret(ctx, s.SessionTokenID)
+		if cErr != nil {
+			e.l.Errorf("Failed to delete unused secret, please delete it manually. id = %s", s.SessionTokenID)
+		}
+	}
+
+	// delete old CACert
+	if params.CaCert != nil && s.CACertID != "" {
+		_, cErr := e.secretsStorage.DeleteSecret(ctx, s.CACertID)
+		if cErr != nil {
+			e.l.Errorf("Failed to delete unused secret, please delete it manually. id = %s", s.CACertID)
+		}
+	}
 }
 
 func (e *EverestServer) cleanUpNewSecretsOnUpdateError(err error, newAccessKeyID, newSecretKeyID *string) {
@@ -406,20 +515,30 @@ func (e *EverestServer) checkStorageAccessByUpdate(ctx context.Context, storageN
 		return nil, err
 	}
 
-	accessKey, err := e.secretsStorage.GetSecret(ctx, s.AccessKeyID)
-	if err != nil {
-		return nil, err
+	oldData := &storageData{storage: *s}
+	if s.CACertID != "" {
+		oldData.caCert, err = e.secretsStorage.GetSecret(ctx, s.CACertID)
+		if err != nil {
+			return nil, err
+		}
 	}
+	if !s.UsesIAM() {
+		oldData.accessKey, err = e.secretsStorage.GetSecret(ctx, s.AccessKeyID)
+		if err != nil {
+			return nil, err
+		}
 
-	secretKey, err := e.secretsStorage.GetSecret(ctx, s.SecretKeyID)
-	if err != nil {
-		return nil, err
-	}
+		oldData.secretKey, err = e.secretsStorage.GetSecret(ctx, s.SecretKeyID)
+		if err != nil {
+			return nil, err
+		}
 
-	oldData := &storageData{
-		accessKey: accessKey,
-		secretKey: secretKey,
-		storage:   *s,
+		if s.SessionTokenID != "" {
+			oldData.sessionToken, err = e.secretsStorage.GetSecret(ctx, s.SessionTokenID)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	err = validateStorageAccessByUpdate(oldData, params, e.l)
@@ -432,23 +551,34 @@ func (e *EverestServer) checkStorageAccessByUpdate(ctx context.Context, storageN
 
 func (e *EverestServer) updateBackupStorage(
 	ctx context.Context, tx *gorm.DB, backupStorageName string, params *UpdateBackupStorageParams,
-	newAccessKeyID, newSecretKeyID *string,
+	ids backupStorageSecretIDs,
 ) (int, error) {
-	err := e.storage.UpdateBackupStorage(ctx, tx, model.UpdateBackupStorageParams{
-		Name:        backupStorageName,
-		Description: params.Description,
-		BucketName:  params.BucketName,
-		URL:         params.Url,
-		Region:      params.Region,
-		AccessKeyID: newAccessKeyID,
-		SecretKeyID: newSecretKeyID,
-	})
+	update := model.UpdateBackupStorageParams{
+		Name:           backupStorageName,
+		Description:    params.Description,
+		BucketName:     params.BucketName,
+		URL:            params.Url,
+		Region:         params.Region,
+		AccessKeyID:    ids.accessKey,
+		SecretKeyID:    ids.secretKey,
+		SessionTokenID: ids.sessionToken,
+		ForcePathStyle: params.ForcePathStyle,
+		Version:        params.Version,
+	}
+	if params.CaCert != nil {
+		// An empty CA certificate removes the CA bundle.
+		update.CACertID = pointer.ToString(pointer.GetString(ids.caCert))
+	}
+	if params.VerifyTLS != nil {
+		update.SkipTLSVerify = pointer.ToBool(!*params.VerifyTLS)
+	}
+	err := e.storage.UpdateBackupStorage(ctx, tx, update)
 	if err != nil {
-		var pgErr *pq.Error
-		if errors.As(err, &pgErr) {
-			if pgErr.Code.Name() == pgErrUniqueViolation {
-				return http.StatusBadRequest, errors.New("backup storage with the same name already exists. " + pgErr.Detail)
-			}
+		if errors.Is(err, model.ErrVersionMismatch) {
+			return http.StatusConflict, versionConflictError("backup storage")
+		}
+		if detail, ok := model.UniqueViolation(err); ok {
+			return http.StatusBadRequest, errors.New("backup storage with the same name already exists. " + detail)
 		}
 
 		e.l.Error(err)
@@ -457,3 +587,31 @@ func (e *EverestServer) updateBackupStorage(
 
 	return 0, nil
 }
+
+func backupStorageToAPIJson(bs *model.BackupStorage) BackupStorage {
+	res := BackupStorage{
+		Type:                BackupStorageType(bs.Type),
+		Name:                bs.Name,
+		Description:         &bs.Description,
+		BucketName:          bs.BucketName,
+		Region:              bs.Region,
+		Url:                 &bs.URL,
+		CredentialSource:    &bs.CredentialSource,
+		CredentialsExpireAt: bs.CredentialsExpireAt,
+		VerifyTLS:           pointer.ToBool(!bs.SkipTLSVerify),
+		HasCaCert:           pointer.ToBool(bs.CACertID != ""),
+		ForcePathStyle:      pointer.ToBool(bs.ForcePathStyle),
+		Version:             pointer.ToInt64(bs.Version),
+		DeletedAt:           bs.DeletedAt,
+		CreatedAt:           &bs.CreatedAt,
+		UpdatedAt:           &bs.UpdatedAt,
+		CreatedBy:           pointer.ToStringOrNil(bs.CreatedBy),
+	}
+	if bs.Project != "" {
+		res.Project = &bs.Project
+	}
+	if bs.RoleARN != "" {
+		res.RoleArn = &bs.RoleARN
+	}
+	return res
+}
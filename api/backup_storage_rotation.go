@@ -0,0 +1,132 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// RotateBackupStorageCredentials replaces the credentials of the specified backup storage
+// and pushes them to all the registered kubernetes clusters.
+func (e *EverestServer) RotateBackupStorageCredentials(ctx echo.Context, name string) error {
+	var params BackupStorageCredentials
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if params.AccessKey == "" || params.SecretKey == "" {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("accessKey and secretKey shall not be empty")})
+	}
+
+	c := ctx.Request().Context()
+	_, err := e.checkStorageAccessByUpdate(c, name, UpdateBackupStorageParams{
+		AccessKey: &params.AccessKey,
+		SecretKey: &params.SecretKey,
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup storage")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("Could not connect to the backup storage, please check the new credentials are correct: %s", err)),
+		})
+	}
+
+	accessKeyID, secretKeyID, err := e.createSecrets(c, &params.AccessKey, &params.SecretKey)
+	if err != nil {
+		e.cleanUpNewSecretsOnUpdateError(err, accessKeyID, secretKeyID)
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed to create secrets")})
+	}
+	if err := e.storage.RotateBackupStorageCredentials(c, nil, name, *accessKeyID, *secretKeyID); err != nil {
+		e.cleanUpNewSecretsOnUpdateError(err, accessKeyID, secretKeyID)
+		if errors.Is(err, model.ErrCredentialsRotationInProgress) {
+			return ctx.JSON(http.StatusConflict, Error{
+				Message: pointer.ToString("The previous credentials are not retired yet, please resync the backup storage first"),
+			})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not update backup storage")})
+	}
+
+	bs, err := e.storage.GetBackupStorage(c, nil, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not find updated backup storage")})
+	}
+	cfg := backupStorageSyncedConfig(bs)
+	// The Kubernetes clusters which could not be synced now are retried by the config syncer
+	// which retires the previous credentials afterwards.
+	syncs, err := e.syncConfig(c, cfg, false)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not sync config")))
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not sync config to the kubernetes clusters")})
+	}
+
+	return ctx.JSON(http.StatusOK, BackupStorageCredentialsRotation{
+		Retired:  e.retirePreviousCredentials(c, bs, syncs),
+		Clusters: configSyncsToAPIJson(cfg, syncs),
+	})
+}
+
+// retirePreviousCredentials deletes the credentials replaced by a rotation once all the Kubernetes clusters
+// are synced with the new ones. Returns true if there are no previous credentials left.
+func (e *EverestServer) retirePreviousCredentials(ctx context.Context, bs *model.BackupStorage, syncs []model.ConfigSync) bool {
+	if bs.PreviousAccessKeyID == "" && bs.PreviousSecretKeyID == "" {
+		return true
+	}
+	if !allSynced(bs.SecretGeneration, syncs) {
+		return false
+	}
+
+	e.deletePreviousCredentials(ctx, bs)
+	if err := e.storage.ClearBackupStoragePreviousCredentials(ctx, bs.Name); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not clear previous backup storage credentials")))
+		return false
+	}
+	return true
+}
+
+func (e *EverestServer) deletePreviousCredentials(ctx context.Context, bs *model.BackupStorage) {
+	for _, id := range []string{bs.PreviousAccessKeyID, bs.PreviousSecretKeyID} {
+		if id == "" {
+			continue
+		}
+		if _, err := e.secretsStorage.DeleteSecret(ctx, id); err != nil {
+			e.l.Errorf("Failed to delete unused secret, please delete it manually. id = %s", id)
+		}
+	}
+}
+
+// allSynced returns true if all the Kubernetes clusters are synced with the generation of a config.
+func allSynced(generation int64, syncs []model.ConfigSync) bool {
+	for _, s := range syncs {
+		if s.SyncedGeneration < generation || s.LastError != "" {
+			return false
+		}
+	}
+	return true
+}
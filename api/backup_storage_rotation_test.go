@@ -0,0 +1,63 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestAllSynced(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		syncs    []model.ConfigSync
+		expected bool
+	}
+	cases := []testCase{
+		{
+			name:     "no clusters",
+			expected: true,
+		},
+		{
+			name:     "synced",
+			syncs:    []model.ConfigSync{{SyncedGeneration: 3}, {SyncedGeneration: 3}},
+			expected: true,
+		},
+		{
+			name:     "lagging",
+			syncs:    []model.ConfigSync{{SyncedGeneration: 3}, {SyncedGeneration: 2}},
+			expected: false,
+		},
+		{
+			name:     "failed",
+			syncs:    []model.ConfigSync{{SyncedGeneration: 3, LastError: "unavailable"}},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, allSynced(3, tc.syncs))
+		})
+	}
+}
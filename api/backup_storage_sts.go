@@ -0,0 +1,168 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// stsRoleSessionName identifies the sessions of the roles assumed by Everest.
+const stsRoleSessionName = "everest-backup-storage"
+
+// stsCredentials are the temporary credentials of an assumed role.
+type stsCredentials struct {
+	accessKey    string
+	secretKey    string
+	sessionToken string
+	expireAt     time.Time
+}
+
+// assumeRole returns the temporary credentials of the role.
+// The role is assumed with the credentials Everest runs with, e.g. its own pod identity.
+func assumeRole(ctx context.Context, roleARN, region string, duration time.Duration) (*stsCredentials, error) {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(region)})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not initialize AWS session"))
+	}
+	out, err := sts.New(sess).AssumeRoleWithContext(ctx, &sts.AssumeRoleInput{
+		RoleArn:         aws.String(roleARN),
+		RoleSessionName: aws.String(stsRoleSessionName),
+		DurationSeconds: aws.Int64(int64(duration.Seconds())),
+	})
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("could not assume role %s", roleARN))
+	}
+	if out.Credentials == nil {
+		return nil, fmt.Errorf("no credentials returned for role %s", roleARN)
+	}
+
+	return &stsCredentials{
+		accessKey:    aws.StringValue(out.Credentials.AccessKeyId),
+		secretKey:    aws.StringValue(out.Credentials.SecretAccessKey),
+		sessionToken: aws.StringValue(out.Credentials.SessionToken),
+		expireAt:     aws.TimeValue(out.Credentials.Expiration).UTC(),
+	}, nil
+}
+
+// runSTSCredentialsRefresher periodically refreshes the sts credentials of the backup storages
+// expiring soon and pushes them to the Kubernetes clusters until the context is canceled.
+func (e *EverestServer) runSTSCredentialsRefresher(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.STSRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.refreshExpiringSTSCredentials(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) refreshExpiringSTSCredentials(ctx context.Context) {
+	storages, err := e.storage.ListBackupStoragesExpiringBefore(ctx, time.Now().UTC().Add(e.config.STSRefreshBefore))
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list backup storages with expiring credentials")))
+		return
+	}
+
+	for _, bs := range storages {
+		if ctx.Err() != nil {
+			return
+		}
+		bs := bs
+		if err := e.refreshSTSCredentials(ctx, &bs); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not refresh the credentials of backup storage %s", bs.Name)))
+		}
+	}
+}
+
+// refreshSTSCredentials assumes the role of the backup storage again, replaces its stored credentials
+// and pushes them to the Kubernetes clusters. The clusters which could not be synced now
+// are retried by the config syncer.
+func (e *EverestServer) refreshSTSCredentials(ctx context.Context, bs *model.BackupStorage) error {
+	creds, err := assumeRole(ctx, bs.RoleARN, bs.Region, e.config.STSSessionDuration)
+	if err != nil {
+		return err
+	}
+
+	secrets := map[string]string{
+		bs.AccessKeyID:    creds.accessKey,
+		bs.SecretKeyID:    creds.secretKey,
+		bs.SessionTokenID: creds.sessionToken,
+	}
+	for id, value := range secrets {
+		if err := e.secretsStorage.UpdateSecret(ctx, id, value); err != nil {
+			return errors.Join(err, errors.New("could not update secret"))
+		}
+	}
+	if err := e.storage.RefreshBackupStorageCredentials(ctx, bs.Name, creds.expireAt); err != nil {
+		return errors.Join(err, errors.New("could not save refreshed credentials"))
+	}
+
+	refreshed, err := e.storage.GetBackupStorage(ctx, nil, bs.Name)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get backup storage"))
+	}
+	cfg := backupStorageSyncedConfig(refreshed)
+	// The expiring credentials cannot wait for the canaries.
+	cfg.bypassCanary = true
+	if _, err := e.syncConfig(ctx, cfg, false); err != nil {
+		return errors.Join(err, errors.New("could not sync config"))
+	}
+	return nil
+}
+
+// stsCredentialsFor assumes the role of the new backup storage, checks the access with the temporary
+// credentials and sets them as the keys of the backup storage to be stored like the static ones.
+func (e *EverestServer) stsCredentialsFor(ctx context.Context, params *CreateBackupStorageParams) (time.Time, error) {
+	creds, err := assumeRole(ctx, pointer.GetString(params.RoleArn), params.Region, e.config.STSSessionDuration)
+	if err != nil {
+		return time.Time{}, err
+	}
+	opts, err := s3OptionsOf(*params)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := s3Access(
+		e.l, params.Url, creds.accessKey, creds.secretKey, creds.sessionToken, params.BucketName, params.Region, opts,
+	); err != nil {
+		return time.Time{}, err
+	}
+
+	params.AccessKey = &creds.accessKey
+	params.SecretKey = &creds.secretKey
+	params.SessionToken = &creds.sessionToken
+	return creds.expireAt, nil
+}
@@ -0,0 +1,435 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const (
+	// backupVerificationLabel marks the temporary database clusters with the name of the verified database cluster.
+	backupVerificationLabel = "everest.percona.com/backup-verification"
+	// backupVerificationPrefix keeps the temporary database cluster names within the 22 characters
+	// the PXC operator allows.
+	backupVerificationPrefix = "verify-"
+	// backupVerificationTeardownTimeout limits deleting the temporary database cluster once the verification is over.
+	backupVerificationTeardownTimeout = time.Minute
+
+	backupVerificationEventFailed = "backup-verification-failed"
+)
+
+// GetDatabaseClusterBackupVerificationPolicy returns the backup verification policy of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterBackupVerificationPolicy(ctx echo.Context, kubernetesID string, name string, _ GetDatabaseClusterBackupVerificationPolicyParams) error {
+	policy, err := e.storage.GetBackupVerificationPolicy(ctx.Request().Context(), kubernetesID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup verification policy")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup verification policy")})
+	}
+	return ctx.JSON(http.StatusOK, backupVerificationPolicyFrom(policy))
+}
+
+// SetDatabaseClusterBackupVerificationPolicy opts the specified database cluster in the backup verification.
+func (e *EverestServer) SetDatabaseClusterBackupVerificationPolicy(ctx echo.Context, kubernetesID string, name string, _ SetDatabaseClusterBackupVerificationPolicyParams) error {
+	var params BackupVerificationPolicy
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if params.IntervalHours < 1 {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("intervalHours shall be at least 1")})
+	}
+
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if _, err := kubeClient.GetDatabaseCluster(c, name); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString(fmt.Sprintf("DatabaseCluster '%s' is not found", name))})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	policy, err := e.storage.SaveBackupVerificationPolicy(c, kubernetesID, name, params.IntervalHours)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save backup verification policy")})
+	}
+	return ctx.JSON(http.StatusOK, backupVerificationPolicyFrom(policy))
+}
+
+// DeleteDatabaseClusterBackupVerificationPolicy opts the specified database cluster out of the backup verification.
+func (e *EverestServer) DeleteDatabaseClusterBackupVerificationPolicy(ctx echo.Context, kubernetesID string, name string, _ DeleteDatabaseClusterBackupVerificationPolicyParams) error {
+	if err := e.storage.DeleteBackupVerificationPolicy(ctx.Request().Context(), kubernetesID, name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup verification policy")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete backup verification policy")})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListBackupVerifications lists the backup verifications.
+func (e *EverestServer) ListBackupVerifications(ctx echo.Context, params ListBackupVerificationsParams) error {
+	list, total, err := e.storage.ListBackupVerifications(ctx.Request().Context(), model.ListBackupVerificationsParams{
+		KubernetesID:  pointer.GetString(params.KubernetesId),
+		DBClusterName: pointer.GetString(params.DbClusterName),
+		State:         pointer.GetString(params.State),
+		Pagination: model.Pagination{
+			Limit:  pointer.GetInt(params.Limit),
+			Offset: pointer.GetInt(params.Offset),
+		},
+	})
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not list backup verifications"),
+		})
+	}
+
+	result := make(BackupVerificationList, 0, len(list))
+	for _, v := range list {
+		result = append(result, BackupVerification{
+			KubernetesId:              v.KubernetesID,
+			DbClusterName:             v.DBClusterName,
+			BackupName:                pointer.ToStringOrNil(v.BackupName),
+			VerificationDbClusterName: pointer.ToStringOrNil(v.VerificationDBName),
+			State:                     v.State,
+			Message:                   pointer.ToStringOrNil(v.Message),
+			CreatedAt:                 v.CreatedAt,
+			FinishedAt:                v.FinishedAt,
+		})
+	}
+	ctx.Response().Header().Set(totalCountHeader, strconv.Itoa(total))
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func backupVerificationPolicyFrom(policy *model.BackupVerificationPolicy) BackupVerificationPolicy {
+	return BackupVerificationPolicy{
+		IntervalHours: policy.IntervalHours,
+		LastRunAt:     policy.LastRunAt,
+	}
+}
+
+// runBackupVerifier periodically starts the verifications of the database clusters opted in
+// until the context is canceled.
+func (e *EverestServer) runBackupVerifier(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.BackupVerificationCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.failInterruptedBackupVerifications(ctx)
+			e.startDueBackupVerifications(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) startDueBackupVerifications(ctx context.Context) {
+	policies, err := e.storage.ListBackupVerificationPolicies(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list backup verification policies")))
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, p := range policies {
+		if ctx.Err() != nil {
+			return
+		}
+		if !p.Due(now) {
+			continue
+		}
+		_, running, err := e.storage.ListBackupVerifications(ctx, model.ListBackupVerificationsParams{
+			KubernetesID:  p.KubernetesID,
+			DBClusterName: p.DBClusterName,
+			State:         model.BackupVerificationStateRunning,
+		})
+		if err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not list backup verifications")))
+			continue
+		}
+		if running != 0 {
+			continue
+		}
+		if err := e.storage.SetBackupVerificationPolicyLastRun(ctx, p.KubernetesID, p.DBClusterName, now); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not save backup verification policy")))
+			continue
+		}
+
+		v := &model.BackupVerification{
+			KubernetesID:  p.KubernetesID,
+			DBClusterName: p.DBClusterName,
+			State:         model.BackupVerificationStateRunning,
+		}
+		if err := e.storage.SaveBackupVerification(ctx, v); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not save backup verification")))
+			continue
+		}
+		e.waitGroup.Add(1)
+		go e.runBackupVerification(ctx, v)
+	}
+}
+
+// failInterruptedBackupVerifications fails the verifications which were running longer than the timeout
+// and the teardown, i.e. were interrupted by a restart, and deletes their temporary database clusters.
+func (e *EverestServer) failInterruptedBackupVerifications(ctx context.Context) {
+	running, _, err := e.storage.ListBackupVerifications(ctx, model.ListBackupVerificationsParams{
+		State: model.BackupVerificationStateRunning,
+	})
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list backup verifications")))
+		return
+	}
+
+	for i := range running {
+		v := &running[i]
+		if time.Since(v.UpdatedAt) < e.config.BackupVerificationTimeout+backupVerificationTeardownTimeout {
+			continue
+		}
+		if v.VerificationDBName != "" {
+			_, kubeClient, _, err := e.initKubeClient(ctx, v.KubernetesID)
+			if err != nil {
+				e.l.Error(errors.Join(err, fmt.Errorf("could not delete database cluster %s", v.VerificationDBName)))
+				continue
+			}
+			if err := e.teardownBackupVerification(kubeClient, v.VerificationDBName); err != nil {
+				e.l.Error(err)
+				continue
+			}
+		}
+		e.finishBackupVerification(ctx, v, errors.New("the verification was interrupted"))
+	}
+}
+
+// runBackupVerification restores the latest successful backup of the database cluster into a temporary
+// database cluster, checks it and deletes it, and persists the result.
+func (e *EverestServer) runBackupVerification(ctx context.Context, v *model.BackupVerification) {
+	defer e.waitGroup.Done()
+
+	ctx, cancel := context.WithTimeout(ctx, e.config.BackupVerificationTimeout)
+	defer cancel()
+
+	_, kubeClient, _, err := e.initKubeClient(ctx, v.KubernetesID)
+	if err == nil {
+		err = e.verifyBackup(ctx, kubeClient, v)
+		if v.VerificationDBName != "" {
+			err = errors.Join(err, e.teardownBackupVerification(kubeClient, v.VerificationDBName))
+		}
+	}
+	// The verification context may have expired already.
+	e.finishBackupVerification(context.Background(), v, err)
+}
+
+func (e *EverestServer) verifyBackup(ctx context.Context, kubeClient *kubernetes.Kubernetes, v *model.BackupVerification) error {
+	db, err := kubeClient.GetDatabaseCluster(ctx, v.DBClusterName)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get database cluster"))
+	}
+	backups, err := kubeClient.ListDatabaseClusterBackups(ctx)
+	if err != nil {
+		return errors.Join(err, errors.New("could not list backups"))
+	}
+	backup := latestSuccessfulBackup(backups.Items, v.DBClusterName)
+	if backup == nil {
+		return errors.New("there is no successful backup to verify")
+	}
+	secret, err := kubeClient.GetSecret(ctx, db.Spec.Engine.UserSecretsName, db.Namespace)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get database cluster credentials"))
+	}
+	admin, err := adminFromSecret(db.Spec.Engine.Type, secret)
+	if err != nil {
+		return err
+	}
+
+	suffix, err := randomString(8)
+	if err != nil {
+		return err
+	}
+	v.BackupName = backup.Name
+	v.VerificationDBName = backupVerificationPrefix + strings.ToLower(suffix)
+	if err := e.storage.SaveBackupVerification(ctx, v); err != nil {
+		return errors.Join(err, errors.New("could not save backup verification"))
+	}
+
+	// The restored users keep the passwords of the source database cluster, so its credentials are reused.
+	verificationDB := verificationDatabaseCluster(db, backup.Name, v.VerificationDBName)
+	_, err = kubeClient.CreateSecret(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      verificationDB.Spec.Engine.UserSecretsName,
+			Namespace: db.Namespace,
+			Labels:    verificationDB.Labels,
+		},
+		Data: secret.Data,
+	})
+	if err != nil {
+		return errors.Join(err, errors.New("could not create database cluster credentials"))
+	}
+	if _, err := kubeClient.CreateDatabaseCluster(ctx, verificationDB); err != nil {
+		return errors.Join(err, errors.New("could not create database cluster"))
+	}
+
+	verificationDB, err = waitForDatabaseCluster(ctx, kubeClient, v.VerificationDBName)
+	if err != nil {
+		return err
+	}
+	if err := kubeClient.CheckDatabase(ctx, verificationDB, admin); err != nil {
+		return errors.Join(err, errors.New("the restored database did not pass the integrity check"))
+	}
+	return nil
+}
+
+// teardownBackupVerification deletes the temporary database cluster and its credentials.
+func (e *EverestServer) teardownBackupVerification(kubeClient *kubernetes.Kubernetes, name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), backupVerificationTeardownTimeout)
+	defer cancel()
+
+	db, err := kubeClient.GetDatabaseCluster(ctx, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Join(err, fmt.Errorf("could not get database cluster %s", name))
+	}
+	if err := kubeClient.DeleteDatabaseCluster(ctx, name); err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Join(err, fmt.Errorf("could not delete database cluster %s", name))
+	}
+	err = kubeClient.DeleteSecret(ctx, db.Spec.Engine.UserSecretsName, db.Namespace)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Join(err, fmt.Errorf("could not delete the credentials of database cluster %s", name))
+	}
+	return nil
+}
+
+func (e *EverestServer) finishBackupVerification(ctx context.Context, v *model.BackupVerification, err error) {
+	now := time.Now().UTC()
+	v.FinishedAt = &now
+	v.State = model.BackupVerificationStateSucceeded
+	if err != nil {
+		v.State = model.BackupVerificationStateFailed
+		v.Message = err.Error()
+		e.notify(ctx, notificationEvent{
+			Type:         backupVerificationEventFailed,
+			Severity:     notificationSeverityCritical,
+			KubernetesID: v.KubernetesID,
+			Resource:     "database-clusters/" + v.DBClusterName,
+			Message:      fmt.Sprintf("The verification of the backup of database cluster %s failed: %s", v.DBClusterName, v.Message),
+		})
+	}
+	if err := e.storage.SaveBackupVerification(ctx, v); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not save backup verification")))
+	}
+}
+
+// waitForDatabaseCluster waits for the database cluster to become ready.
+func waitForDatabaseCluster(ctx context.Context, kubeClient *kubernetes.Kubernetes, name string) (*everestv1alpha1.DatabaseCluster, error) {
+	ticker := time.NewTicker(engineUpgradePollInterval)
+	defer ticker.Stop()
+
+	for {
+		db, err := kubeClient.GetDatabaseCluster(ctx, name)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("could not get database cluster %s", name))
+		}
+		switch db.Status.Status { //nolint:exhaustive
+		case everestv1alpha1.AppStateReady:
+			return db, nil
+		case everestv1alpha1.AppStateError:
+			return nil, fmt.Errorf("database cluster %s has failed", name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("database cluster %s is not ready: %w", name, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// latestSuccessfulBackup returns the most recent successful backup of the database cluster if any.
+func latestSuccessfulBackup(backups []everestv1alpha1.DatabaseClusterBackup, dbClusterName string) *everestv1alpha1.DatabaseClusterBackup {
+	var latest *everestv1alpha1.DatabaseClusterBackup
+	for i := range backups {
+		b := &backups[i]
+		if b.Spec.DBClusterName != dbClusterName {
+			continue
+		}
+		if _, ok := successfulBackupStates[strings.ToLower(string(b.Status.State))]; !ok {
+			continue
+		}
+		if latest == nil || backupCreatedAt(b).After(backupCreatedAt(latest)) {
+			latest = b
+		}
+	}
+	return latest
+}
+
+// verificationDatabaseCluster returns a single replica copy of the database cluster restored from the backup.
+// It is neither backed up, monitored nor exposed outside of the Kubernetes cluster.
+func verificationDatabaseCluster(db *everestv1alpha1.DatabaseCluster, backupName, name string) *everestv1alpha1.DatabaseCluster {
+	spec := db.Spec.DeepCopy()
+	spec.Engine.Replicas = 1
+	spec.Engine.UserSecretsName = "everest-secrets-" + name
+	spec.Proxy.Replicas = pointer.ToInt32(1)
+	spec.Proxy.Expose = everestv1alpha1.Expose{Type: everestv1alpha1.ExposeTypeInternal}
+	spec.AllowUnsafeConfiguration = true
+	spec.Paused = false
+	spec.Backup = everestv1alpha1.Backup{}
+	spec.Monitoring = nil
+	spec.DataSource = &everestv1alpha1.DataSource{DBClusterBackupName: backupName}
+
+	return &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: db.Namespace,
+			Labels:    map[string]string{backupVerificationLabel: db.Name},
+		},
+		Spec: *spec,
+	}
+}
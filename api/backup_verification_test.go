@@ -0,0 +1,128 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLatestSuccessfulBackup(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	backup := func(name, db string, age time.Duration, state everestv1alpha1.BackupState) everestv1alpha1.DatabaseClusterBackup {
+		return everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(now.Add(-age))},
+			Spec:       everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: db},
+			Status:     everestv1alpha1.DatabaseClusterBackupStatus{State: state},
+		}
+	}
+
+	cases := []struct {
+		name    string
+		backups []everestv1alpha1.DatabaseClusterBackup
+		latest  string
+	}{
+		{
+			name:    "no backups",
+			backups: nil,
+		},
+		{
+			name: "no successful backups",
+			backups: []everestv1alpha1.DatabaseClusterBackup{
+				backup("b1", "db1", time.Hour, "Failed"),
+				backup("b2", "db1", 2*time.Hour, "Running"),
+			},
+		},
+		{
+			name: "latest successful backup",
+			backups: []everestv1alpha1.DatabaseClusterBackup{
+				backup("b1", "db1", 3*time.Hour, "Succeeded"),
+				backup("b2", "db1", 2*time.Hour, "ready"),
+				backup("b3", "db1", time.Hour, "Failed"),
+				backup("b4", "db2", time.Minute, "Succeeded"),
+			},
+			latest: "b2",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			latest := latestSuccessfulBackup(tc.backups, "db1")
+			if tc.latest == "" {
+				assert.Nil(t, latest)
+				return
+			}
+			require.NotNil(t, latest)
+			assert.Equal(t, tc.latest, latest.Name)
+		})
+	}
+}
+
+func TestVerificationDatabaseCluster(t *testing.T) {
+	t.Parallel()
+
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "db1", Namespace: "everest", Labels: map[string]string{"team": "a"}},
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{
+				Type:            everestv1alpha1.DatabaseEnginePXC,
+				Version:         "8.0.32",
+				Replicas:        3,
+				UserSecretsName: "everest-secrets-db1",
+			},
+			Proxy: everestv1alpha1.Proxy{
+				Replicas: pointer.ToInt32(3),
+				Expose:   everestv1alpha1.Expose{Type: everestv1alpha1.ExposeTypeExternal},
+			},
+			Backup: everestv1alpha1.Backup{
+				Enabled:   true,
+				Schedules: []everestv1alpha1.BackupSchedule{{Enabled: true, Name: "daily", Schedule: "0 0 * * *"}},
+			},
+			Monitoring: &everestv1alpha1.Monitoring{MonitoringConfigName: "pmm"},
+		},
+	}
+
+	v := verificationDatabaseCluster(db, "db1-backup", "verify-abc")
+	assert.Equal(t, "verify-abc", v.Name)
+	assert.Equal(t, "everest", v.Namespace)
+	assert.Equal(t, map[string]string{backupVerificationLabel: "db1"}, v.Labels)
+	assert.Equal(t, "8.0.32", v.Spec.Engine.Version)
+	assert.Equal(t, int32(1), v.Spec.Engine.Replicas)
+	assert.Equal(t, "everest-secrets-verify-abc", v.Spec.Engine.UserSecretsName)
+	assert.Equal(t, int32(1), *v.Spec.Proxy.Replicas)
+	assert.Equal(t, everestv1alpha1.ExposeTypeInternal, v.Spec.Proxy.Expose.Type)
+	assert.True(t, v.Spec.AllowUnsafeConfiguration)
+	assert.False(t, v.Spec.Backup.Enabled)
+	assert.Empty(t, v.Spec.Backup.Schedules)
+	assert.Nil(t, v.Spec.Monitoring)
+	assert.Equal(t, "db1-backup", v.Spec.DataSource.DBClusterBackupName)
+
+	// The source database cluster is left intact.
+	assert.Equal(t, int32(3), db.Spec.Engine.Replicas)
+	assert.True(t, db.Spec.Backup.Enabled)
+	assert.Nil(t, db.Spec.DataSource)
+}
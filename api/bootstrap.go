@@ -0,0 +1,229 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const bootstrapPollInterval = 5 * time.Second
+
+// BootstrapKubernetesCluster starts the installation of Everest into a kubernetes cluster.
+func (e *EverestServer) BootstrapKubernetesCluster(ctx echo.Context, kubernetesID string) error {
+	var params BootstrapParams
+	if ctx.Request().ContentLength != 0 {
+		if err := ctx.Bind(&params); err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		}
+	}
+	version := e.config.EverestOperatorVersion
+	if params.OperatorVersion != nil {
+		version = *params.OperatorVersion
+	}
+	if _, err := goversion.NewVersion(version); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Invalid operator version")})
+	}
+
+	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	b, err := e.storage.GetBootstrap(ctx.Request().Context(), kubernetesID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get bootstrap")})
+	}
+	// A bootstrap which has not been updated for longer than the timeout was interrupted by a restart.
+	if err == nil && b.InProgress() && time.Since(b.UpdatedAt) < e.config.BootstrapTimeout {
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("The kubernetes cluster is being bootstrapped")})
+	}
+
+	b = &model.Bootstrap{
+		KubernetesID:    kubernetesID,
+		State:           model.BootstrapStatePending,
+		OperatorVersion: version,
+		CreatedAt:       time.Now().UTC(),
+	}
+	if err := e.storage.SaveBootstrap(ctx.Request().Context(), b); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save bootstrap")})
+	}
+
+	e.waitGroup.Add(1)
+	go e.runBootstrap(kubeClient, b)
+
+	return ctx.JSON(http.StatusAccepted, bootstrapToAPIJson(b))
+}
+
+// GetKubernetesClusterBootstrap returns the progress of the latest bootstrap of a kubernetes cluster.
+func (e *EverestServer) GetKubernetesClusterBootstrap(ctx echo.Context, kubernetesID string) error {
+	b, err := e.storage.GetBootstrap(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("The kubernetes cluster has not been bootstrapped")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get bootstrap")})
+	}
+
+	return ctx.JSON(http.StatusOK, bootstrapToAPIJson(b))
+}
+
+// runBootstrap installs Everest into the kubernetes cluster and persists the progress.
+func (e *EverestServer) runBootstrap(kubeClient *kubernetes.Kubernetes, b *model.Bootstrap) {
+	defer e.waitGroup.Done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.config.BootstrapTimeout)
+	defer cancel()
+
+	err := e.bootstrap(ctx, kubeClient, b)
+	now := time.Now().UTC()
+	b.FinishedAt = &now
+	b.State = model.BootstrapStateCompleted
+	if err != nil {
+		e.l.Error(errors.Join(err, fmt.Errorf("could not bootstrap Kubernetes cluster %s", b.KubernetesID)))
+		b.State = model.BootstrapStateFailed
+		b.Message = err.Error()
+	}
+	// The bootstrap context may have expired already.
+	if err := e.storage.SaveBootstrap(context.Background(), b); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not save bootstrap")))
+	}
+
+	if b.State == model.BootstrapStateCompleted {
+		if k, err := e.storage.GetKubernetesCluster(context.Background(), b.KubernetesID); err != nil {
+			e.l.Error(err)
+		} else if err := e.checkCompatibility(context.Background(), k, kubeClient); err != nil {
+			e.l.Error(err)
+		}
+		if _, err := e.refreshDatabaseEngines(context.Background(), b.KubernetesID); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not refresh database engines")))
+		}
+	}
+}
+
+func (e *EverestServer) bootstrap(ctx context.Context, kubeClient *kubernetes.Kubernetes, b *model.Bootstrap) error {
+	if err := e.setBootstrapState(ctx, b, model.BootstrapStateCreatingNamespace); err != nil {
+		return err
+	}
+	if err := kubeClient.EnsureNamespace(ctx); err != nil {
+		return errors.Join(err, errors.New("could not create namespace"))
+	}
+
+	bundle, err := e.fetchOperatorBundle(ctx, b.OperatorVersion)
+	if err != nil {
+		return err
+	}
+	objs, err := kubernetes.ParseManifests(bundle)
+	if err != nil {
+		return err
+	}
+	crds, rest := kubernetes.SplitOperatorBundle(objs, kubeClient.Namespace())
+
+	if err := e.setBootstrapState(ctx, b, model.BootstrapStateInstallingCRDs); err != nil {
+		return err
+	}
+	if err := kubeClient.ApplyManifests(ctx, crds); err != nil {
+		return errors.Join(err, errors.New("could not install CRDs"))
+	}
+
+	if err := e.setBootstrapState(ctx, b, model.BootstrapStateInstallingOperator); err != nil {
+		return err
+	}
+	if err := kubeClient.ApplyManifests(ctx, rest); err != nil {
+		return errors.Join(err, errors.New("could not install everest operator"))
+	}
+
+	if err := e.setBootstrapState(ctx, b, model.BootstrapStateWaitingForOperator); err != nil {
+		return err
+	}
+	return waitForDeployment(ctx, kubeClient, kubernetes.OperatorDeployments[kubernetes.EverestOperatorName])
+}
+
+func (e *EverestServer) setBootstrapState(ctx context.Context, b *model.Bootstrap, state string) error {
+	b.State = state
+	if err := e.storage.SaveBootstrap(ctx, b); err != nil {
+		return errors.Join(err, errors.New("could not save bootstrap"))
+	}
+	return nil
+}
+
+func (e *EverestServer) fetchOperatorBundle(ctx context.Context, version string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(e.config.EverestOperatorBundleURL, version), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not download everest operator bundle"))
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not download everest operator bundle"))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not download everest operator bundle: HTTP status code %d", resp.StatusCode)
+	}
+	return data, nil
+}
+
+func waitForDeployment(ctx context.Context, kubeClient *kubernetes.Kubernetes, name string) error {
+	ticker := time.NewTicker(bootstrapPollInterval)
+	defer ticker.Stop()
+
+	for {
+		ready, err := kubeClient.IsDeploymentReady(ctx, name)
+		if err != nil {
+			return errors.Join(err, fmt.Errorf("could not get deployment %s", name))
+		}
+		if ready {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("deployment %s is not ready: %w", name, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func bootstrapToAPIJson(b *model.Bootstrap) Bootstrap {
+	return Bootstrap{
+		State:           BootstrapState(b.State),
+		OperatorVersion: b.OperatorVersion,
+		Message:         pointer.ToStringOrNil(b.Message),
+		StartedAt:       b.CreatedAt,
+		FinishedAt:      b.FinishedAt,
+	}
+}
@@ -0,0 +1,192 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// Types of the capacity notification events.
+const (
+	capacityEventResourceQuota = "resource-quota-capacity"
+	capacityEventVolume        = "volume-capacity"
+)
+
+// capacityAlertState keeps the last reported severity of every resource watched for capacity
+// so that an event is emitted only when it changes.
+type capacityAlertState struct {
+	mu       sync.Mutex
+	severity map[string]string
+}
+
+// capacityUsage is the usage of a resource limited by a capacity.
+type capacityUsage struct {
+	eventType string
+	resource  string
+	used      float64
+	capacity  float64
+}
+
+// runCapacityChecker periodically checks the resource quotas and the volumes of the namespaces
+// of all Kubernetes clusters until the context is canceled.
+func (e *EverestServer) runCapacityChecker(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.CapacityCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.checkAllCapacity(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) checkAllCapacity(ctx context.Context) {
+	clusters, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list Kubernetes clusters")))
+		return
+	}
+
+	for i := range clusters {
+		if ctx.Err() != nil {
+			return
+		}
+		k := &clusters[i]
+		kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not create Kubernetes client for %s", k.ID)))
+			continue
+		}
+		usage, err := capacityUsageOf(ctx, kubeClient, k.Namespace)
+		if err != nil {
+			e.l.Warn(errors.Join(err, fmt.Errorf("could not check capacity of Kubernetes cluster %s", k.ID)))
+			continue
+		}
+		for _, u := range usage {
+			e.reportCapacity(ctx, k.ID, u)
+		}
+	}
+}
+
+func capacityUsageOf(ctx context.Context, kubeClient *kubernetes.Kubernetes, namespace string) ([]capacityUsage, error) {
+	quotas, err := kubeClient.ListResourceQuotas(ctx, namespace)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list resource quotas"))
+	}
+	usage := resourceQuotaUsage(quotas)
+
+	volumes, err := kubeClient.GetVolumeUsage(ctx, namespace)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not get volume usage"))
+	}
+	for _, v := range volumes {
+		usage = append(usage, capacityUsage{
+			eventType: capacityEventVolume,
+			resource:  "persistentvolumeclaims/" + v.PVCName,
+			used:      float64(v.UsedBytes),
+			capacity:  float64(v.CapacityBytes),
+		})
+	}
+	return usage, nil
+}
+
+func resourceQuotaUsage(quotas []corev1.ResourceQuota) []capacityUsage {
+	var usage []capacityUsage
+	for _, q := range quotas {
+		for name, hard := range q.Status.Hard {
+			used, ok := q.Status.Used[name]
+			if !ok {
+				continue
+			}
+			usage = append(usage, capacityUsage{
+				eventType: capacityEventResourceQuota,
+				resource:  fmt.Sprintf("resourcequotas/%s/%s", q.Name, name),
+				used:      used.AsApproximateFloat64(),
+				capacity:  hard.AsApproximateFloat64(),
+			})
+		}
+	}
+	return usage
+}
+
+// capacitySeverity returns the severity of the usage, or an empty string if it is below the thresholds.
+func capacitySeverity(u capacityUsage, warning, critical float64) string {
+	if u.capacity <= 0 {
+		return ""
+	}
+	ratio := u.used / u.capacity
+	switch {
+	case ratio >= critical:
+		return notificationSeverityCritical
+	case ratio >= warning:
+		return notificationSeverityWarning
+	default:
+		return ""
+	}
+}
+
+// reportCapacity emits an event if the severity of the usage has changed since the last check.
+func (e *EverestServer) reportCapacity(ctx context.Context, kubernetesID string, u capacityUsage) {
+	severity := capacitySeverity(u, e.config.CapacityWarningThreshold, e.config.CapacityCriticalThreshold)
+	key := kubernetesID + "/" + u.resource
+
+	e.capacityAlerts.mu.Lock()
+	last := e.capacityAlerts.severity[key]
+	if severity == "" {
+		delete(e.capacityAlerts.severity, key)
+	} else {
+		e.capacityAlerts.severity[key] = severity
+	}
+	e.capacityAlerts.mu.Unlock()
+
+	if severity == last {
+		return
+	}
+
+	event := notificationEvent{
+		Type:         u.eventType,
+		Severity:     severity,
+		KubernetesID: kubernetesID,
+		Resource:     u.resource,
+		Message: fmt.Sprintf(
+			"%s on Kubernetes cluster %s is at %.0f%% of its capacity",
+			u.resource, kubernetesID, 100*u.used/u.capacity,
+		),
+	}
+	if severity == "" {
+		event.Severity = notificationSeverityInfo
+		event.Message = fmt.Sprintf("%s on Kubernetes cluster %s is back below the capacity thresholds", u.resource, kubernetesID)
+	}
+	e.notify(ctx, event)
+}
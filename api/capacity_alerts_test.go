@@ -0,0 +1,73 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCapacitySeverity(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		usage    capacityUsage
+		expected string
+	}
+	cases := []testCase{
+		{name: "below", usage: capacityUsage{used: 79, capacity: 100}, expected: ""},
+		{name: "warning", usage: capacityUsage{used: 80, capacity: 100}, expected: notificationSeverityWarning},
+		{name: "critical", usage: capacityUsage{used: 100, capacity: 100}, expected: notificationSeverityCritical},
+		{name: "no capacity", usage: capacityUsage{used: 1}, expected: ""},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, capacitySeverity(tc.usage, 0.8, 0.95))
+		})
+	}
+}
+
+func TestResourceQuotaUsage(t *testing.T) {
+	t.Parallel()
+
+	quotas := []corev1.ResourceQuota{{
+		ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsCPU:    resource.MustParse("4"),
+				corev1.ResourceRequestsMemory: resource.MustParse("8Gi"),
+			},
+			Used: corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse("3500m"),
+			},
+		},
+	}}
+
+	assert.Equal(t, []capacityUsage{{
+		eventType: capacityEventResourceQuota,
+		resource:  "resourcequotas/quota/requests.cpu",
+		used:      3.5,
+		capacity:  4,
+	}}, resourceQuotaUsage(quotas))
+}
@@ -0,0 +1,219 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+// maxExampleDepth limits how deep the nested schemas are followed to assemble an example
+// so that the recursive schemas do not loop.
+const maxExampleDepth = 5
+
+//nolint:gochecknoglobals
+var supportedEngineTypes = []everestv1alpha1.EngineType{
+	everestv1alpha1.DatabaseEnginePXC,
+	everestv1alpha1.DatabaseEnginePSMDB,
+	everestv1alpha1.DatabaseEnginePostgresql,
+}
+
+// GetCatalog returns the options and the example payloads the clients build their forms from.
+func (e *EverestServer) GetCatalog(ctx echo.Context) error {
+	swagger, err := GetSwagger()
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not load the API specification")})
+	}
+	engineTypes, err := e.catalogEngineTypes(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list database engines")})
+	}
+
+	enums := specEnums(swagger)
+	return ctx.JSON(http.StatusOK, Catalog{
+		EngineTypes:             engineTypes,
+		BackupStorageTypes:      enums["BackupStorage.type"],
+		MonitoringInstanceTypes: enums["MonitoringInstanceBase.type"],
+		Regions:                 s3Regions(),
+		Enums:                   enums,
+		Examples:                specExamples(swagger),
+	})
+}
+
+// catalogEngineTypes returns the supported engine types along with the kubernetes clusters they are installed on.
+func (e *EverestServer) catalogEngineTypes(ctx context.Context) ([]CatalogEngineType, error) {
+	clusters, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list Kubernetes clusters"))
+	}
+
+	installedOn := make(map[string][]string)
+	for _, k := range clusters {
+		engines, err := e.storage.ListDatabaseEngines(ctx, k.ID)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("could not list database engines of Kubernetes cluster %s", k.ID))
+		}
+		for _, engine := range engines {
+			if engine.State == string(everestv1alpha1.DBEngineStateInstalled) {
+				installedOn[engine.Type] = append(installedOn[engine.Type], k.ID)
+			}
+		}
+	}
+
+	res := make([]CatalogEngineType, 0, len(supportedEngineTypes))
+	for _, t := range supportedEngineTypes {
+		ids := installedOn[string(t)]
+		if ids == nil {
+			ids = []string{}
+		}
+		res = append(res, CatalogEngineType{Type: string(t), InstalledOn: ids})
+	}
+	return res, nil
+}
+
+// s3Regions returns the regions of all the AWS partitions S3 is available in.
+func s3Regions() []string {
+	var regions []string
+	for _, p := range endpoints.DefaultPartitions() {
+		svc, ok := p.Services()[endpoints.S3ServiceID]
+		if !ok {
+			continue
+		}
+		for id := range svc.Regions() {
+			regions = append(regions, id)
+		}
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// specEnums collects the allowed values of the enumerated schema properties and operation parameters.
+func specEnums(swagger *openapi3.T) map[string][]string {
+	enums := make(map[string][]string)
+	for name, ref := range swagger.Components.Schemas {
+		if ref.Value == nil {
+			continue
+		}
+		for prop, propRef := range schemaProperties(ref.Value) {
+			if values := enumValues(propRef); values != nil {
+				enums[name+"."+prop] = values
+			}
+		}
+	}
+	for _, item := range swagger.Paths {
+		for _, op := range item.Operations() {
+			for _, p := range op.Parameters {
+				if p.Value == nil {
+					continue
+				}
+				if values := enumValues(p.Value.Schema); values != nil {
+					enums[operationID(op)+"."+p.Value.Name] = values
+				}
+			}
+		}
+	}
+	return enums
+}
+
+// operationID returns the operation ID as written in the specification. The embedded specification
+// has the IDs capitalized by the code generator.
+func operationID(op *openapi3.Operation) string {
+	if op.OperationID == "" {
+		return ""
+	}
+	return strings.ToLower(op.OperationID[:1]) + op.OperationID[1:]
+}
+
+// schemaProperties returns the properties of the schema including the ones of its allOf schemas.
+func schemaProperties(schema *openapi3.Schema) openapi3.Schemas {
+	props := make(openapi3.Schemas, len(schema.Properties))
+	for _, ref := range schema.AllOf {
+		if ref.Value != nil {
+			for name, p := range schemaProperties(ref.Value) {
+				props[name] = p
+			}
+		}
+	}
+	for name, p := range schema.Properties {
+		props[name] = p
+	}
+	return props
+}
+
+func enumValues(ref *openapi3.SchemaRef) []string {
+	if ref == nil || ref.Value == nil || len(ref.Value.Enum) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(ref.Value.Enum))
+	for _, v := range ref.Value.Enum {
+		values = append(values, fmt.Sprint(v))
+	}
+	return values
+}
+
+// specExamples assembles an example payload of every schema which has examples.
+func specExamples(swagger *openapi3.T) map[string]interface{} {
+	examples := make(map[string]interface{})
+	for name, ref := range swagger.Components.Schemas {
+		if example := schemaExample(ref, maxExampleDepth); example != nil {
+			examples[name] = example
+		}
+	}
+	return examples
+}
+
+// schemaExample returns the example of the schema or assembles it from the examples of its properties
+// or items. It returns nil if there are none.
+func schemaExample(ref *openapi3.SchemaRef, depth int) interface{} {
+	if ref == nil || ref.Value == nil || depth == 0 {
+		return nil
+	}
+	schema := ref.Value
+	if schema.Example != nil {
+		return schema.Example
+	}
+
+	if schema.Items != nil {
+		if item := schemaExample(schema.Items, depth-1); item != nil {
+			return []interface{}{item}
+		}
+		return nil
+	}
+
+	example := make(map[string]interface{})
+	for name, prop := range schemaProperties(schema) {
+		if v := schemaExample(prop, depth-1); v != nil {
+			example[name] = v
+		}
+	}
+	if len(example) == 0 {
+		return nil
+	}
+	return example
+}
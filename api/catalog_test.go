@@ -0,0 +1,56 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecCatalog(t *testing.T) {
+	t.Parallel()
+
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+
+	enums := specEnums(swagger)
+	assert.Equal(t, []string{"s3", "azure", "gcs"}, enums["BackupStorage.type"])
+	assert.Equal(t, []string{"pmm", "prometheus"}, enums["MonitoringInstanceBase.type"])
+	assert.Equal(t, []string{"asc", "desc"}, enums["listBackupStorages.order"])
+
+	examples := specExamples(swagger)
+	assert.Equal(t, map[string]interface{}{"intervalHours": float64(24)}, examples["BackupSLOParams"])
+
+	assert.Contains(t, s3Regions(), "us-east-1")
+}
+
+func TestSchemaExampleRecursion(t *testing.T) {
+	t.Parallel()
+
+	node := &openapi3.Schema{Properties: openapi3.Schemas{
+		"name": {Value: &openapi3.Schema{Example: "root"}},
+	}}
+	node.Properties["children"] = &openapi3.SchemaRef{Value: &openapi3.Schema{Items: &openapi3.SchemaRef{Value: node}}}
+
+	example := schemaExample(&openapi3.SchemaRef{Value: node}, 4)
+	assert.Equal(t, map[string]interface{}{
+		"name":     "root",
+		"children": []interface{}{map[string]interface{}{"name": "root"}},
+	}, example)
+}
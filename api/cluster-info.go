@@ -3,6 +3,7 @@ package api
 import (
 	"net/http"
 	"reflect"
+	"sort"
 
 	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
@@ -48,3 +49,35 @@ func storageClasses(storagesList *storagev1.StorageClassList) []string {
 	}
 	return classNames
 }
+
+// ListKubernetesClusterStorageClasses returns the storage classes of a kubernetes cluster.
+func (e *EverestServer) ListKubernetesClusterStorageClasses(ctx echo.Context, kubernetesID string) error {
+	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	storagesList, err := kubeClient.GetStorageClasses(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Failed getting storage classes")})
+	}
+
+	res := make([]StorageClass, 0, len(storagesList.Items))
+	for _, sc := range storagesList.Items {
+		res = append(res, StorageClass{
+			Name:                 sc.Name,
+			Provisioner:          sc.Provisioner,
+			Default:              isDefaultStorageClass(sc),
+			AllowVolumeExpansion: pointer.GetBool(sc.AllowVolumeExpansion),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func isDefaultStorageClass(sc storagev1.StorageClass) bool {
+	return sc.Annotations[annotationStorageClassDefault] == "true"
+}
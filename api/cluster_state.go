@@ -0,0 +1,117 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// Headers of the responses aggregated from all the kubernetes clusters which have no room for
+// the unreachable clusters in the body.
+const (
+	partialResultHeader       = "X-Everest-Partial-Result"
+	unreachableClustersHeader = "X-Everest-Unreachable-Clusters"
+)
+
+// clusterStateCache keeps the last state fetched from every kubernetes cluster by the aggregate endpoints
+// so that they keep answering with it while a cluster is unreachable.
+type clusterStateCache struct {
+	mu     sync.Mutex
+	states map[string]cachedClusterState
+}
+
+type cachedClusterState struct {
+	value     interface{}
+	fetchedAt time.Time
+}
+
+func newClusterStateCache() *clusterStateCache {
+	return &clusterStateCache{states: make(map[string]cachedClusterState)}
+}
+
+func (c *clusterStateCache) store(resource, kubernetesID string, value interface{}, fetchedAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.states[resource+"/"+kubernetesID] = cachedClusterState{value: value, fetchedAt: fetchedAt}
+}
+
+func (c *clusterStateCache) load(resource, kubernetesID string) (cachedClusterState, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.states[resource+"/"+kubernetesID]
+	return s, ok
+}
+
+// clusterState fetches the state of the resource from a single kubernetes cluster within the cluster request timeout.
+// If the cluster cannot be reached, the last known state is returned instead, or nil if there is none,
+// together with the unreachable cluster describing the failure and the staleness of the state.
+func (e *EverestServer) clusterState(
+	ctx context.Context, k model.KubernetesCluster, resource string,
+	fetch func(ctx context.Context) (interface{}, error),
+) (interface{}, *UnreachableCluster) {
+	fetchCtx, cancel := context.WithTimeout(ctx, e.config.ClusterRequestTimeout)
+	defer cancel()
+
+	value, err := fetch(fetchCtx)
+	if err == nil {
+		e.clusterStates.store(resource, k.ID, value, time.Now().UTC())
+		return value, nil
+	}
+
+	e.l.Warn(errors.Join(err, fmt.Errorf("could not get %s of Kubernetes cluster %s", resource, k.ID)))
+	unreachable := &UnreachableCluster{
+		KubernetesId:   k.ID,
+		KubernetesName: k.Name,
+		Error:          unreachableClusterError(err),
+	}
+	cached, ok := e.clusterStates.load(resource, k.ID)
+	if !ok {
+		return nil, unreachable
+	}
+	unreachable.LastSeenAt = &cached.fetchedAt
+	return cached.value, unreachable
+}
+
+func unreachableClusterError(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "The Kubernetes cluster did not respond in time"
+	}
+	return "The Kubernetes cluster is unavailable"
+}
+
+// setPartialResultHeaders flags the aggregate response as partial if any kubernetes cluster is unreachable.
+func setPartialResultHeaders(ctx echo.Context, unreachable []UnreachableCluster) {
+	if len(unreachable) == 0 {
+		return
+	}
+	ids := make([]string, 0, len(unreachable))
+	for _, u := range unreachable {
+		ids = append(ids, u.KubernetesId)
+	}
+	ctx.Response().Header().Set(partialResultHeader, strconv.FormatBool(true))
+	ctx.Response().Header().Set(unreachableClustersHeader, strings.Join(ids, ","))
+}
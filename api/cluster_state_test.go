@@ -0,0 +1,73 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestClusterState(t *testing.T) {
+	t.Parallel()
+
+	e := &EverestServer{
+		config:        &config.EverestConfig{ClusterRequestTimeout: 50 * time.Millisecond},
+		l:             zap.NewNop().Sugar(),
+		clusterStates: newClusterStateCache(),
+	}
+	k := model.KubernetesCluster{ID: "k8s", Name: "prod"}
+	failing := func(context.Context) (interface{}, error) {
+		return nil, errors.New("connection refused")
+	}
+	hanging := func(ctx context.Context) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	value, unreachable := e.clusterState(context.Background(), k, "never-fetched", failing)
+	assert.Nil(t, value)
+	require.NotNil(t, unreachable)
+	assert.Equal(t, "k8s", unreachable.KubernetesId)
+	assert.Equal(t, "prod", unreachable.KubernetesName)
+	assert.Nil(t, unreachable.LastSeenAt)
+
+	value, unreachable = e.clusterState(context.Background(), k, "dbs", func(context.Context) (interface{}, error) {
+		return []string{"db-1"}, nil
+	})
+	assert.Equal(t, []string{"db-1"}, value)
+	assert.Nil(t, unreachable)
+
+	value, unreachable = e.clusterState(context.Background(), k, "dbs", hanging)
+	assert.Equal(t, []string{"db-1"}, value)
+	require.NotNil(t, unreachable)
+	assert.Equal(t, "The Kubernetes cluster did not respond in time", unreachable.Error)
+	require.NotNil(t, unreachable.LastSeenAt)
+	assert.WithinDuration(t, time.Now(), *unreachable.LastSeenAt, time.Minute)
+
+	value, unreachable = e.clusterState(context.Background(), k, "dbs", failing)
+	assert.Equal(t, []string{"db-1"}, value)
+	require.NotNil(t, unreachable)
+	assert.Equal(t, "The Kubernetes cluster is unavailable", unreachable.Error)
+}
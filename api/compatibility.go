@@ -0,0 +1,138 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// checkCompatibility checks whether the kubernetes cluster serves the everest operator APIs
+// and persists the result into the cluster record.
+func (e *EverestServer) checkCompatibility(ctx context.Context, k *model.KubernetesCluster, kubeClient *kubernetes.Kubernetes) error {
+	status, message := compatibilityOf(kubeClient.MissingEverestKinds())
+	if err := e.storage.UpdateKubernetesClusterCompatibility(ctx, k.ID, status, message); err != nil {
+		return errors.Join(err, errors.New("could not update Kubernetes cluster compatibility"))
+	}
+
+	now := time.Now().UTC()
+	k.CompatibilityStatus = status
+	k.CompatibilityMessage = message
+	k.CompatibilityCheckedAt = &now
+	return nil
+}
+
+func compatibilityOf(missing []string, err error) (string, string) {
+	if err != nil {
+		return model.CompatibilityStatusUnknown, err.Error()
+	}
+	if len(missing) != 0 {
+		return model.CompatibilityStatusIncompatible, fmt.Sprintf(
+			"The everest operator APIs for %s are not installed. Install or upgrade the everest operator, e.g. by bootstrapping the Kubernetes cluster",
+			strings.Join(missing, ", "),
+		)
+	}
+	return model.CompatibilityStatusCompatible, ""
+}
+
+// ensureCompatible verifies again a kubernetes cluster found incompatible before requests are proxied to it,
+// so that a missing everest operator results in an actionable error instead of a bare not found.
+func (e *EverestServer) ensureCompatible(ctx context.Context, k *model.KubernetesCluster) (int, error) {
+	if k.CompatibilityStatus != model.CompatibilityStatusIncompatible {
+		return 0, nil
+	}
+
+	kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+	if err != nil {
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not create Kubernetes client from kubeconfig")
+	}
+	if err := e.checkCompatibility(ctx, k, kubeClient); err != nil {
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not check Kubernetes cluster compatibility")
+	}
+	if k.CompatibilityStatus == model.CompatibilityStatusIncompatible {
+		return http.StatusFailedDependency, fmt.Errorf("%s kubernetes cluster is not compatible. %s", k.Name, k.CompatibilityMessage)
+	}
+	return 0, nil
+}
+
+// runCompatibilityChecker periodically checks the compatibility of all Kubernetes clusters
+// until the context is canceled.
+func (e *EverestServer) runCompatibilityChecker(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.CompatibilityCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.checkAllCompatibility(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) checkAllCompatibility(ctx context.Context) {
+	clusters, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list Kubernetes clusters")))
+		return
+	}
+
+	for i := range clusters {
+		if ctx.Err() != nil {
+			return
+		}
+		k := &clusters[i]
+		kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not create Kubernetes client for %s", k.ID)))
+			continue
+		}
+		if err := e.checkCompatibility(ctx, k, kubeClient); err != nil {
+			e.l.Error(err)
+		}
+	}
+}
+
+func compatibilityToAPIJson(k *model.KubernetesCluster) *KubernetesClusterCompatibility {
+	c := &KubernetesClusterCompatibility{
+		Status:    KubernetesClusterCompatibilityStatus(k.CompatibilityStatus),
+		CheckedAt: k.CompatibilityCheckedAt,
+	}
+	if c.Status == "" {
+		c.Status = KubernetesClusterCompatibilityStatusUnknown
+	}
+	if k.CompatibilityMessage != "" {
+		c.Message = &k.CompatibilityMessage
+	}
+	return c
+}
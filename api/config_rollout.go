@@ -0,0 +1,284 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// Types of the config rollout notification events.
+const (
+	rolloutEventCompleted = "config-rollout-completed"
+	rolloutEventHalted    = "config-rollout-halted"
+)
+
+// ListConfigRollouts returns the latest canary rollout of every backup storage and monitoring instance.
+func (e *EverestServer) ListConfigRollouts(ctx echo.Context) error {
+	rollouts, err := e.storage.ListConfigRollouts(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list config rollouts")})
+	}
+
+	res := make(ConfigRolloutList, 0, len(rollouts))
+	for _, r := range rollouts {
+		canaries, err := rolloutCanaries(&r)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list config rollouts")})
+		}
+		rollout := ConfigRollout{
+			Kind:                r.ConfigKind,
+			Name:                r.ConfigName,
+			Generation:          r.Generation,
+			State:               r.State,
+			CanaryKubernetesIds: canaries,
+			VerifyAt:            r.VerifyAt,
+		}
+		if r.Message != "" {
+			rollout.Message = pointer.ToString(r.Message)
+		}
+		res = append(res, rollout)
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// rolloutTargets returns the Kubernetes clusters the latest generation of the config may be pushed to.
+// A nil result means all of them. A forced sync or a config bypassing the canaries completes the rollout.
+func (e *EverestServer) rolloutTargets(
+	ctx context.Context, cfg syncedConfig, syncs []model.ConfigSync, force bool,
+) (map[string]struct{}, error) {
+	rollout, err := e.storage.GetConfigRollout(ctx, cfg.kind, cfg.name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Join(err, errors.New("could not get config rollout"))
+	}
+	if rollout != nil && rollout.Generation != cfg.generation {
+		rollout = nil
+	}
+
+	if rollout == nil {
+		if !force && !cfg.bypassCanary {
+			return e.startRollout(ctx, cfg, syncs)
+		}
+		if e.config.CanaryClusters <= 0 {
+			return nil, nil
+		}
+		// The completed rollout keeps the later syncs of the generation from starting one.
+		rollout = &model.ConfigRollout{
+			ConfigKind:        cfg.kind,
+			ConfigName:        cfg.name,
+			Generation:        cfg.generation,
+			CanaryClusters:    "[]",
+			BaselineUnhealthy: "[]",
+			VerifyAt:          time.Now().UTC(),
+		}
+	}
+	if rollout.State == model.RolloutStateCompleted {
+		return nil, nil
+	}
+	if force || cfg.bypassCanary {
+		rollout.State = model.RolloutStateCompleted
+		rollout.Message = ""
+		if err := e.storage.SaveConfigRollout(ctx, rollout); err != nil {
+			return nil, errors.Join(err, errors.New("could not save config rollout"))
+		}
+		return nil, nil
+	}
+
+	canaries, err := rolloutCanaries(rollout)
+	if err != nil {
+		return nil, err
+	}
+	targets := make(map[string]struct{}, len(canaries))
+	for _, id := range canaries {
+		targets[id] = struct{}{}
+	}
+	if rollout.State == model.RolloutStateHalted || time.Now().UTC().Before(rollout.VerifyAt) {
+		return targets, nil
+	}
+
+	if err := e.verifyRollout(ctx, rollout, canaries, syncs); err != nil {
+		rollout.State = model.RolloutStateHalted
+		rollout.Message = err.Error()
+		if err := e.storage.SaveConfigRollout(ctx, rollout); err != nil {
+			return nil, errors.Join(err, errors.New("could not save config rollout"))
+		}
+		e.notify(ctx, notificationEvent{
+			Type:     rolloutEventHalted,
+			Severity: notificationSeverityCritical,
+			Resource: cfg.kind + "/" + cfg.name,
+			Message:  fmt.Sprintf("The rollout of %s %s was halted: %s", cfg.kind, cfg.name, rollout.Message),
+		})
+		return targets, nil
+	}
+
+	rollout.State = model.RolloutStateCompleted
+	if err := e.storage.SaveConfigRollout(ctx, rollout); err != nil {
+		return nil, errors.Join(err, errors.New("could not save config rollout"))
+	}
+	e.notify(ctx, notificationEvent{
+		Type:     rolloutEventCompleted,
+		Severity: notificationSeverityInfo,
+		Resource: cfg.kind + "/" + cfg.name,
+		Message:  fmt.Sprintf("The canary Kubernetes clusters are healthy, %s %s is rolled out to the rest of them", cfg.kind, cfg.name),
+	})
+	return nil, nil
+}
+
+// startRollout picks the canary Kubernetes clusters among the ones lagging behind the latest generation
+// of the config and records the database clusters on them which are already unhealthy.
+// There is no rollout if the canaries are disabled or there are not more lagging clusters than canaries.
+func (e *EverestServer) startRollout(ctx context.Context, cfg syncedConfig, syncs []model.ConfigSync) (map[string]struct{}, error) {
+	var lagging []string
+	for _, s := range syncs {
+		if s.SyncedGeneration < cfg.generation {
+			lagging = append(lagging, s.KubernetesID)
+		}
+	}
+	if e.config.CanaryClusters <= 0 || len(lagging) <= e.config.CanaryClusters {
+		return nil, nil
+	}
+	sort.Strings(lagging)
+	canaries := lagging[:e.config.CanaryClusters]
+
+	unhealthy, err := e.unhealthyDatabaseClusters(ctx, canaries)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not get the database clusters health of the canary Kubernetes clusters"))
+	}
+	canariesJSON, err := json.Marshal(canaries)
+	if err != nil {
+		return nil, err
+	}
+	unhealthyJSON, err := json.Marshal(unhealthy)
+	if err != nil {
+		return nil, err
+	}
+	rollout := &model.ConfigRollout{
+		ConfigKind:        cfg.kind,
+		ConfigName:        cfg.name,
+		Generation:        cfg.generation,
+		CanaryClusters:    string(canariesJSON),
+		BaselineUnhealthy: string(unhealthyJSON),
+		State:             model.RolloutStateCanary,
+		VerifyAt:          time.Now().UTC().Add(e.config.CanaryBakeTime),
+	}
+	if err := e.storage.SaveConfigRollout(ctx, rollout); err != nil {
+		return nil, errors.Join(err, errors.New("could not save config rollout"))
+	}
+
+	targets := make(map[string]struct{}, len(canaries))
+	for _, id := range canaries {
+		targets[id] = struct{}{}
+	}
+	return targets, nil
+}
+
+// verifyRollout returns an error if the config is not synced to a canary Kubernetes cluster
+// or a database cluster on them became unhealthy since the rollout started.
+func (e *EverestServer) verifyRollout(
+	ctx context.Context, rollout *model.ConfigRollout, canaries []string, syncs []model.ConfigSync,
+) error {
+	isCanary := make(map[string]struct{}, len(canaries))
+	for _, id := range canaries {
+		isCanary[id] = struct{}{}
+	}
+	for _, s := range syncs {
+		if _, ok := isCanary[s.KubernetesID]; !ok {
+			continue
+		}
+		if s.SyncedGeneration < rollout.Generation || s.LastError != "" {
+			return fmt.Errorf("the config could not be synced to Kubernetes cluster %s", s.KubernetesID)
+		}
+	}
+
+	var baseline []string
+	if err := json.Unmarshal([]byte(rollout.BaselineUnhealthy), &baseline); err != nil {
+		return errors.Join(err, errors.New("could not decode the database clusters unhealthy before the rollout"))
+	}
+	unhealthy, err := e.unhealthyDatabaseClusters(ctx, canaries)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get the database clusters health of the canary Kubernetes clusters"))
+	}
+	if degraded := newlyUnhealthy(baseline, unhealthy); len(degraded) != 0 {
+		return fmt.Errorf("database clusters %s became unhealthy", strings.Join(degraded, ", "))
+	}
+	return nil
+}
+
+// unhealthyDatabaseClusters returns the database clusters on the Kubernetes clusters which are not ready
+// as kubernetesID/name.
+func (e *EverestServer) unhealthyDatabaseClusters(ctx context.Context, kubernetesIDs []string) ([]string, error) {
+	unhealthy := []string{}
+	for _, id := range kubernetesIDs {
+		k, err := e.storage.GetKubernetesCluster(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+		if err != nil {
+			return nil, err
+		}
+		dbs, err := kubeClient.ListDatabaseClusters(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, db := range dbs.Items {
+			if db.Status.Status != everestv1alpha1.AppStateReady {
+				unhealthy = append(unhealthy, id+"/"+db.Name)
+			}
+		}
+	}
+	return unhealthy, nil
+}
+
+// newlyUnhealthy returns the unhealthy database clusters which were healthy at the baseline.
+func newlyUnhealthy(baseline, unhealthy []string) []string {
+	before := make(map[string]struct{}, len(baseline))
+	for _, db := range baseline {
+		before[db] = struct{}{}
+	}
+	var res []string
+	for _, db := range unhealthy {
+		if _, ok := before[db]; !ok {
+			res = append(res, db)
+		}
+	}
+	return res
+}
+
+func rolloutCanaries(rollout *model.ConfigRollout) ([]string, error) {
+	var canaries []string
+	if err := json.Unmarshal([]byte(rollout.CanaryClusters), &canaries); err != nil {
+		return nil, errors.Join(err, errors.New("could not decode the canary Kubernetes clusters"))
+	}
+	return canaries, nil
+}
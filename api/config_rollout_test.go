@@ -0,0 +1,66 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewlyUnhealthy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		baseline  []string
+		unhealthy []string
+		expected  []string
+	}{
+		{
+			name:      "all healthy",
+			baseline:  []string{},
+			unhealthy: []string{},
+			expected:  nil,
+		},
+		{
+			name:      "still unhealthy",
+			baseline:  []string{"k1/db1"},
+			unhealthy: []string{"k1/db1"},
+			expected:  nil,
+		},
+		{
+			name:      "recovered",
+			baseline:  []string{"k1/db1"},
+			unhealthy: []string{},
+			expected:  nil,
+		},
+		{
+			name:      "became unhealthy",
+			baseline:  []string{"k1/db1"},
+			unhealthy: []string{"k1/db1", "k2/db1"},
+			expected:  []string{"k2/db1"},
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, newlyUnhealthy(tc.baseline, tc.unhealthy))
+		})
+	}
+}
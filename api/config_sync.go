@@ -0,0 +1,478 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// syncedConfig is a config which secrets are synced to all the registered Kubernetes clusters.
+type syncedConfig struct {
+	kind       string
+	name       string
+	generation int64
+	resource   kubernetes.ConfigK8sResourcer
+	// bypassCanary is set for the changes which shall reach all the Kubernetes clusters at once,
+	// like refreshed credentials which are about to expire.
+	bypassCanary bool
+}
+
+func backupStorageSyncedConfig(bs *model.BackupStorage) syncedConfig {
+	return syncedConfig{
+		kind:       model.ConfigKindBackupStorage,
+		name:       bs.Name,
+		generation: bs.SecretGeneration,
+		resource:   bs,
+	}
+}
+
+func monitoringInstanceSyncedConfig(i *model.MonitoringInstance) syncedConfig {
+	return syncedConfig{
+		kind:       model.ConfigKindMonitoringInstance,
+		name:       i.Name,
+		generation: i.SecretGeneration,
+		resource:   i,
+	}
+}
+
+// GetBackupStorageSyncStatus returns the sync status of the backup storage on the registered Kubernetes clusters.
+func (e *EverestServer) GetBackupStorageSyncStatus(ctx echo.Context, name string) error {
+	bs, err := e.storage.GetBackupStorage(ctx.Request().Context(), nil, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup storage")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup storage")})
+	}
+
+	return e.configSyncStatus(ctx, backupStorageSyncedConfig(bs))
+}
+
+// ResyncBackupStorage pushes the backup storage and its credentials to all the registered Kubernetes clusters.
+func (e *EverestServer) ResyncBackupStorage(ctx echo.Context, name string) error {
+	bs, err := e.storage.GetBackupStorage(ctx.Request().Context(), nil, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup storage")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup storage")})
+	}
+
+	return e.resyncConfig(ctx, backupStorageSyncedConfig(bs))
+}
+
+// GetMonitoringInstanceSyncStatus returns the sync status of the monitoring instance on the registered Kubernetes clusters.
+func (e *EverestServer) GetMonitoringInstanceSyncStatus(ctx echo.Context, name string) error {
+	i, err := e.storage.GetMonitoringInstance(ctx.Request().Context(), name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Monitoring instance not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not find monitoring instance")})
+	}
+
+	return e.configSyncStatus(ctx, monitoringInstanceSyncedConfig(i))
+}
+
+// ResyncMonitoringInstance pushes the monitoring instance and its credentials to all the registered Kubernetes clusters.
+func (e *EverestServer) ResyncMonitoringInstance(ctx echo.Context, name string) error {
+	i, err := e.storage.GetMonitoringInstance(ctx.Request().Context(), name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Monitoring instance not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not find monitoring instance")})
+	}
+
+	return e.resyncConfig(ctx, monitoringInstanceSyncedConfig(i))
+}
+
+func (e *EverestServer) configSyncStatus(ctx echo.Context, cfg syncedConfig) error {
+	syncs, err := e.configSyncs(ctx.Request().Context(), cfg)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get sync status")})
+	}
+
+	return ctx.JSON(http.StatusOK, configSyncsToAPIJson(cfg, syncs))
+}
+
+func (e *EverestServer) resyncConfig(ctx echo.Context, cfg syncedConfig) error {
+	syncs, err := e.syncConfig(ctx.Request().Context(), cfg, true)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not sync the config to the Kubernetes clusters")})
+	}
+	if bs, ok := cfg.resource.(*model.BackupStorage); ok {
+		e.retirePreviousCredentials(ctx.Request().Context(), bs, syncs)
+	}
+
+	return ctx.JSON(http.StatusOK, configSyncsToAPIJson(cfg, syncs))
+}
+
+// configSyncs returns the sync state of the config on every registered Kubernetes cluster.
+// A Kubernetes cluster without a sync record has never been synced after the config
+// was created, so it holds the initial generation of the config.
+func (e *EverestServer) configSyncs(ctx context.Context, cfg syncedConfig) ([]model.ConfigSync, error) {
+	ks, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list Kubernetes clusters"))
+	}
+	existing, err := e.storage.ListConfigSyncs(ctx, cfg.kind, cfg.name)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list config syncs"))
+	}
+	byCluster := make(map[string]model.ConfigSync, len(existing))
+	for _, s := range existing {
+		byCluster[s.KubernetesID] = s
+	}
+
+	res := make([]model.ConfigSync, 0, len(ks))
+	for _, k := range ks {
+		s, ok := byCluster[k.ID]
+		if !ok {
+			s = model.ConfigSync{
+				KubernetesID:     k.ID,
+				ConfigKind:       cfg.kind,
+				ConfigName:       cfg.name,
+				SyncedGeneration: 1,
+			}
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+// syncConfig pushes the config to the Kubernetes clusters lagging behind its latest generation
+// or to all of them if force is set. Failures are recorded per Kubernetes cluster and retried later.
+// Unless forced, a new generation is pushed to the canary Kubernetes clusters only until the rollout is verified.
+func (e *EverestServer) syncConfig(ctx context.Context, cfg syncedConfig, force bool) ([]model.ConfigSync, error) {
+	syncs, err := e.configSyncs(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := e.rolloutTargets(ctx, cfg, syncs, force)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, s := range syncs {
+		if !force && s.SyncedGeneration >= cfg.generation && s.LastError == "" {
+			continue
+		}
+		if _, ok := targets[s.KubernetesID]; targets != nil && !ok {
+			continue
+		}
+
+		if err := e.pushConfig(ctx, s.KubernetesID, cfg); err != nil {
+			e.l.Warn(errors.Join(err, errors.New("could not sync config to Kubernetes cluster")))
+			s.LastError = err.Error()
+		} else {
+			s.SyncedGeneration = cfg.generation
+			s.LastError = ""
+			s.SyncedAt = pointer.ToTime(time.Now().UTC())
+		}
+
+		if err := e.storage.SaveConfigSync(ctx, &s); err != nil {
+			return nil, errors.Join(err, errors.New("could not save config sync"))
+		}
+		syncs[i] = s
+	}
+
+	return syncs, nil
+}
+
+func (e *EverestServer) pushConfig(ctx context.Context, kubernetesID string, cfg syncedConfig) error {
+	_, kubeClient, _, err := e.initKubeClient(ctx, kubernetesID)
+	if err != nil {
+		return errors.Join(err, errors.New("could not init kube client"))
+	}
+	return kubeClient.UpdateConfig(ctx, cfg.resource, e.secretsStorage.GetSecret)
+}
+
+// runConfigSyncer periodically syncs the backup storages and monitoring instances
+// to the Kubernetes clusters lagging behind until the context is canceled.
+func (e *EverestServer) runConfigSyncer(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.ConfigSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// The standby instance leaves it to the primary one.
+			if !e.isStandby() {
+				e.syncAllConfigs(ctx)
+			}
+		}
+	}
+}
+
+func (e *EverestServer) syncAllConfigs(ctx context.Context) {
+	e.retryConfigDeletions(ctx)
+
+	var configs []syncedConfig
+
+	storages, _, err := e.storage.ListBackupStorages(ctx, model.ListBackupStoragesParams{})
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list backup storages")))
+		return
+	}
+	for _, bs := range storages {
+		bs := bs
+		configs = append(configs, backupStorageSyncedConfig(&bs))
+	}
+
+	instances, _, err := e.storage.ListMonitoringInstances(ctx, model.ListMonitoringInstancesParams{})
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list monitoring instances")))
+		return
+	}
+	for _, i := range instances {
+		i := i
+		configs = append(configs, monitoringInstanceSyncedConfig(&i))
+	}
+
+	for _, cfg := range configs {
+		if ctx.Err() != nil {
+			return
+		}
+		syncs, err := e.syncConfig(ctx, cfg, false)
+		if err != nil {
+			e.l.Error(err)
+			continue
+		}
+		if bs, ok := cfg.resource.(*model.BackupStorage); ok {
+			e.retirePreviousCredentials(ctx, bs, syncs)
+		}
+	}
+}
+
+// deletedConfig is a config deleted from Everest which is still to be deleted from a Kubernetes cluster.
+type deletedConfig struct {
+	model.ConfigDeletion
+}
+
+// K8sResource returns the Kubernetes object of the deleted config.
+func (d deletedConfig) K8sResource(namespace string) (runtime.Object, error) { //nolint:ireturn
+	meta := metav1.ObjectMeta{Name: d.ConfigName, Namespace: namespace}
+	switch d.ConfigKind {
+	case model.ConfigKindBackupStorage:
+		return &everestv1alpha1.BackupStorage{ObjectMeta: meta}, nil
+	case model.ConfigKindMonitoringInstance:
+		return &everestv1alpha1.MonitoringConfig{ObjectMeta: meta}, nil
+	default:
+		return nil, fmt.Errorf("unknown config kind %s", d.ConfigKind)
+	}
+}
+
+// Secrets returns no secrets because the deleted config is not stored anymore.
+func (d deletedConfig) Secrets(context.Context, func(ctx context.Context, id string) (string, error)) (map[string]string, error) {
+	return nil, nil //nolint:nilnil
+}
+
+// SecretName returns the name of the Kubernetes secret of the deleted config.
+func (d deletedConfig) SecretName() string {
+	return d.ConfigDeletion.SecretName
+}
+
+// configInUse returns the function checking whether a config of the kind is used on the Kubernetes cluster.
+func configInUse(kind string, kubeClient *kubernetes.Kubernetes) func(ctx context.Context, name string) (bool, error) {
+	return func(ctx context.Context, name string) (bool, error) {
+		switch kind {
+		case model.ConfigKindBackupStorage:
+			return kubernetes.IsBackupStorageConfigInUse(ctx, name, kubeClient)
+		case model.ConfigKindMonitoringInstance:
+			return kubernetes.IsMonitoringConfigInUse(ctx, name, kubeClient)
+		default:
+			return false, fmt.Errorf("unknown config kind %s", kind)
+		}
+	}
+}
+
+// deleteConfigFromClusters deletes the config from all the registered Kubernetes clusters.
+// Nothing is deleted if the config is used on a reachable Kubernetes cluster. The Kubernetes clusters
+// which could not be reached are returned as the pending deletions to be retried by the config syncer.
+func (e *EverestServer) deleteConfigFromClusters(
+	ctx context.Context, kind, name string, cfg kubernetes.ConfigK8sResourcer,
+) ([]model.ConfigDeletion, ConfigDeletionStatusList, error) {
+	ks, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		return nil, nil, errors.Join(err, errors.New("could not list Kubernetes clusters"))
+	}
+
+	var pending []model.ConfigDeletion
+	statuses := make(ConfigDeletionStatusList, 0, len(ks))
+	fail := func(kubernetesID string, err error) {
+		e.l.Warn(errors.Join(err, fmt.Errorf("could not delete %s %s from Kubernetes cluster %s", kind, name, kubernetesID)))
+		pending = append(pending, model.ConfigDeletion{
+			KubernetesID: kubernetesID,
+			ConfigKind:   kind,
+			ConfigName:   name,
+			SecretName:   cfg.SecretName(),
+			LastError:    err.Error(),
+		})
+		statuses = append(statuses, ConfigDeletionStatus{KubernetesId: kubernetesID, Error: pointer.ToString(err.Error())})
+	}
+
+	// The config is checked on all the Kubernetes clusters first not to delete it partially.
+	reachable := make(map[string]*kubernetes.Kubernetes, len(ks))
+	for _, k := range ks {
+		_, kubeClient, _, err := e.initKubeClient(ctx, k.ID)
+		if err != nil {
+			fail(k.ID, err)
+			continue
+		}
+		used, err := configInUse(kind, kubeClient)(ctx, name)
+		if err != nil {
+			fail(k.ID, err)
+			continue
+		}
+		if used {
+			return nil, nil, errors.Join(kubernetes.ErrConfigInUse, fmt.Errorf("config %s in use on Kubernetes cluster %s", name, k.Name))
+		}
+		reachable[k.ID] = kubeClient
+	}
+
+	for _, k := range ks {
+		kubeClient, ok := reachable[k.ID]
+		if !ok {
+			continue
+		}
+		if err := kubeClient.DeleteConfig(ctx, cfg, configInUse(kind, kubeClient)); err != nil {
+			fail(k.ID, err)
+			continue
+		}
+		statuses = append(statuses, ConfigDeletionStatus{KubernetesId: k.ID, Deleted: true})
+	}
+
+	return pending, statuses, nil
+}
+
+// savePendingConfigDeletions stores the deletions of a config to be retried by the config syncer.
+func (e *EverestServer) savePendingConfigDeletions(ctx context.Context, pending []model.ConfigDeletion, tx *gorm.DB) error {
+	for i := range pending {
+		if err := e.storage.SaveConfigDeletion(ctx, &pending[i], tx); err != nil {
+			e.l.Error(err)
+			return errors.New("could not save pending config deletion")
+		}
+	}
+	return nil
+}
+
+// retryConfigDeletions deletes the configs deleted from Everest
+// from the Kubernetes clusters which could not be reached at the time.
+func (e *EverestServer) retryConfigDeletions(ctx context.Context) {
+	deletions, err := e.storage.ListConfigDeletions(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list config deletions")))
+		return
+	}
+
+	for _, d := range deletions {
+		if ctx.Err() != nil {
+			return
+		}
+
+		recreated, err := e.configExists(ctx, d.ConfigKind, d.ConfigName)
+		if err != nil {
+			e.l.Error(err)
+			continue
+		}
+		if !recreated {
+			err = e.deleteConfigFromCluster(ctx, d)
+		}
+		if err != nil {
+			e.l.Warn(errors.Join(err, fmt.Errorf("could not delete %s %s from Kubernetes cluster %s", d.ConfigKind, d.ConfigName, d.KubernetesID)))
+			d := d
+			d.LastError = err.Error()
+			if err := e.storage.SaveConfigDeletion(ctx, &d, nil); err != nil {
+				e.l.Error(errors.Join(err, errors.New("could not save config deletion")))
+			}
+			continue
+		}
+
+		// A config created again with the same name is synced to the Kubernetes cluster instead.
+		if err := e.storage.DeleteConfigDeletion(ctx, d.KubernetesID, d.ConfigKind, d.ConfigName); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not delete config deletion")))
+		}
+	}
+}
+
+func (e *EverestServer) deleteConfigFromCluster(ctx context.Context, d model.ConfigDeletion) error {
+	_, kubeClient, _, err := e.initKubeClient(ctx, d.KubernetesID)
+	if err != nil {
+		return errors.Join(err, errors.New("could not init kube client"))
+	}
+	return kubeClient.DeleteConfig(ctx, deletedConfig{d}, configInUse(d.ConfigKind, kubeClient))
+}
+
+// configExists returns true if a config of the kind with the name is stored in Everest.
+func (e *EverestServer) configExists(ctx context.Context, kind, name string) (bool, error) {
+	var err error
+	switch kind {
+	case model.ConfigKindBackupStorage:
+		_, err = e.storage.GetBackupStorage(ctx, nil, name)
+	case model.ConfigKindMonitoringInstance:
+		_, err = e.storage.GetMonitoringInstance(ctx, name)
+	default:
+		return false, fmt.Errorf("unknown config kind %s", kind)
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func configSyncsToAPIJson(cfg syncedConfig, syncs []model.ConfigSync) ConfigSyncStatusList {
+	res := make(ConfigSyncStatusList, 0, len(syncs))
+	for _, s := range syncs {
+		status := ConfigSyncStatus{
+			KubernetesId:     s.KubernetesID,
+			Generation:       cfg.generation,
+			SyncedGeneration: s.SyncedGeneration,
+			InSync:           s.SyncedGeneration >= cfg.generation && s.LastError == "",
+			SyncedAt:         s.SyncedAt,
+		}
+		if s.LastError != "" {
+			status.LastError = pointer.ToString(s.LastError)
+		}
+		res = append(res, status)
+	}
+	return res
+}
@@ -0,0 +1,124 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestDeletedConfigK8sResource(t *testing.T) {
+	t.Parallel()
+
+	bs := deletedConfig{model.ConfigDeletion{ConfigKind: model.ConfigKindBackupStorage, ConfigName: "s3", SecretName: "s3-secret"}}
+	obj, err := bs.K8sResource("everest")
+	require.NoError(t, err)
+	require.IsType(t, &everestv1alpha1.BackupStorage{}, obj)
+	assert.Equal(t, "s3", obj.(*everestv1alpha1.BackupStorage).Name)
+	assert.Equal(t, "everest", obj.(*everestv1alpha1.BackupStorage).Namespace)
+	assert.Equal(t, "s3-secret", bs.SecretName())
+
+	mi := deletedConfig{model.ConfigDeletion{ConfigKind: model.ConfigKindMonitoringInstance, ConfigName: "pmm"}}
+	obj, err = mi.K8sResource("everest")
+	require.NoError(t, err)
+	require.IsType(t, &everestv1alpha1.MonitoringConfig{}, obj)
+	assert.Equal(t, "pmm", obj.(*everestv1alpha1.MonitoringConfig).Name)
+	assert.Empty(t, mi.SecretName())
+
+	_, err = deletedConfig{model.ConfigDeletion{ConfigKind: "unknown"}}.K8sResource("everest")
+	require.Error(t, err)
+}
+
+// configSyncStorageStub knows the registered Kubernetes clusters and records the config syncs.
+type configSyncStorageStub struct {
+	storage
+	clusters []model.KubernetesCluster
+	syncs    []model.ConfigSync
+	saved    map[string]model.ConfigSync
+}
+
+func (s *configSyncStorageStub) ListKubernetesClusters(_ context.Context) ([]model.KubernetesCluster, error) {
+	return s.clusters, nil
+}
+
+func (s *configSyncStorageStub) GetKubernetesCluster(_ context.Context, id string) (*model.KubernetesCluster, error) {
+	for _, k := range s.clusters {
+		if k.ID == id {
+			return &k, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (s *configSyncStorageStub) ListConfigSyncs(_ context.Context, _, _ string) ([]model.ConfigSync, error) {
+	return s.syncs, nil
+}
+
+func (s *configSyncStorageStub) SaveConfigSync(_ context.Context, sync *model.ConfigSync) error {
+	s.saved[sync.KubernetesID] = *sync
+	return nil
+}
+
+func (s *configSyncStorageStub) GetConfigRollout(_ context.Context, _, _ string) (*model.ConfigRollout, error) {
+	return nil, gorm.ErrRecordNotFound
+}
+
+func TestSyncConfigMultipleClusters(t *testing.T) {
+	t.Parallel()
+
+	stub := &configSyncStorageStub{
+		clusters: []model.KubernetesCluster{{ID: "synced"}, {ID: "lagging"}, {ID: "new"}},
+		syncs: []model.ConfigSync{
+			{KubernetesID: "synced", ConfigKind: model.ConfigKindBackupStorage, ConfigName: "s3", SyncedGeneration: 2},
+			{KubernetesID: "lagging", ConfigKind: model.ConfigKindBackupStorage, ConfigName: "s3", SyncedGeneration: 1},
+		},
+		saved: make(map[string]model.ConfigSync),
+	}
+	e := &EverestServer{
+		config:  &config.EverestConfig{},
+		l:       zap.NewNop().Sugar(),
+		storage: stub,
+		// The kubeconfigs are missing, so the config cannot be pushed to any Kubernetes cluster.
+		secretsStorage: &countingSecretsStorage{values: map[string]string{}},
+	}
+
+	cfg := backupStorageSyncedConfig(&model.BackupStorage{Name: "s3", SecretGeneration: 2})
+	syncs, err := e.syncConfig(context.Background(), cfg, false)
+	require.NoError(t, err)
+	require.Len(t, syncs, 3)
+
+	// Every Kubernetes cluster lagging behind is tried and its failure is recorded for the retries
+	// while the synced one is left alone.
+	saved := make([]string, 0, len(stub.saved))
+	for id, s := range stub.saved {
+		saved = append(saved, id)
+		assert.NotEmpty(t, s.LastError, id)
+		assert.Equal(t, int64(1), s.SyncedGeneration, id)
+	}
+	sort.Strings(saved)
+	assert.Equal(t, []string{"lagging", "new"}, saved)
+}
@@ -0,0 +1,250 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+const (
+	// regionLabel is the label of the kubernetes clusters the prices of the region are used for.
+	regionLabel     = "region"
+	defaultCurrency = "USD"
+	gibibyte        = 1 << 30
+)
+
+// clusterPrices are the prices the costs of the database clusters of a kubernetes cluster are estimated with.
+type clusterPrices struct {
+	currency string
+	region   string
+	rates    PriceRates
+}
+
+// GetPricing returns the prices the costs of the database clusters are estimated with.
+func (e *EverestServer) GetPricing(ctx echo.Context) error {
+	p, err := e.storage.GetPricing(ctx.Request().Context())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("No prices are set")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the prices")})
+	}
+	res, err := pricingToAPIJson(p)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the prices")})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// SetPricing sets the prices the costs of the database clusters are estimated with.
+func (e *EverestServer) SetPricing(ctx echo.Context) error {
+	var params Pricing
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := validatePricing(params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	regions, err := json.Marshal(pointer.Get(params.Regions))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not set the prices")})
+	}
+	currency := pointer.GetString(params.Currency)
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	p := &model.Pricing{
+		Currency:     currency,
+		CPUPrice:     params.Default.Cpu,
+		MemoryPrice:  params.Default.Memory,
+		StoragePrice: params.Default.Storage,
+		Regions:      string(regions),
+	}
+	if err := e.storage.SavePricing(ctx.Request().Context(), p); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not set the prices")})
+	}
+
+	res, err := pricingToAPIJson(p)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not set the prices")})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// EstimateDatabaseClusterCost estimates the monthly cost of the database cluster from the request body.
+func (e *EverestServer) EstimateDatabaseClusterCost(ctx echo.Context, kubernetesID string) error {
+	db := &everestv1alpha1.DatabaseCluster{}
+	if err := ctx.Bind(db); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	return e.respondWithCostEstimate(ctx, kubernetesID, db)
+}
+
+// GetDatabaseClusterCostEstimate estimates the monthly cost of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterCostEstimate(ctx echo.Context, kubernetesID string, name string, _ GetDatabaseClusterCostEstimateParams) error {
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	db, err := kubeClient.GetDatabaseCluster(c, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	return e.respondWithCostEstimate(ctx, kubernetesID, db)
+}
+
+func (e *EverestServer) respondWithCostEstimate(ctx echo.Context, kubernetesID string, db *everestv1alpha1.DatabaseCluster) error {
+	prices, code, err := e.kubernetesClusterPrices(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if prices == nil {
+		return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("No prices are set")})
+	}
+	return ctx.JSON(http.StatusOK, estimateCost(db.Spec, *prices))
+}
+
+// kubernetesClusterPrices returns the prices of the region of the kubernetes cluster, or nil if no prices are set.
+func (e *EverestServer) kubernetesClusterPrices(ctx context.Context, kubernetesID string) (*clusterPrices, int, error) {
+	k, err := e.storage.GetKubernetesCluster(ctx, kubernetesID)
+	if err != nil {
+		e.l.Error(err)
+		return nil, http.StatusBadRequest, errors.New("could not find Kubernetes cluster")
+	}
+	p, err := e.storage.GetPricing(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0, nil
+		}
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not get the prices")
+	}
+	prices, err := pricesFor(p, k)
+	if err != nil {
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not get the prices")
+	}
+	return prices, 0, nil
+}
+
+// pricesFor returns the prices of the region the kubernetes cluster is labeled with.
+// The default prices are returned if the region has no prices of its own.
+func pricesFor(p *model.Pricing, k *model.KubernetesCluster) (*clusterPrices, error) {
+	regions := map[string]PriceRates{}
+	if p.Regions != "" {
+		if err := json.Unmarshal([]byte(p.Regions), &regions); err != nil {
+			return nil, errors.Join(err, errors.New("could not decode regional prices"))
+		}
+	}
+	labels, err := kubernetesClusterLabels(k)
+	if err != nil {
+		return nil, err
+	}
+
+	region := labels[regionLabel]
+	if rates, ok := regions[region]; ok && region != "" {
+		return &clusterPrices{currency: p.Currency, region: region, rates: rates}, nil
+	}
+	return &clusterPrices{
+		currency: p.Currency,
+		rates:    PriceRates{Cpu: p.CPUPrice, Memory: p.MemoryPrice, Storage: p.StoragePrice},
+	}, nil
+}
+
+// estimateCost estimates the monthly cost of the resources requested by the engine and the proxy replicas.
+func estimateCost(spec everestv1alpha1.DatabaseClusterSpec, prices clusterPrices) CostEstimate {
+	r := databaseClusterRequests(spec)
+	res := CostEstimate{
+		Currency: prices.currency,
+		Cpu:      roundCost(float64(r.cpuMillis) / 1000 * prices.rates.Cpu),
+		Memory:   roundCost(float64(r.memoryBytes) / gibibyte * prices.rates.Memory),
+		Storage:  roundCost(float64(r.storageBytes) / gibibyte * prices.rates.Storage),
+	}
+	res.Total = roundCost(res.Cpu + res.Memory + res.Storage)
+	if prices.region != "" {
+		res.Region = pointer.ToString(prices.region)
+	}
+	return res
+}
+
+func roundCost(cost float64) float64 {
+	return math.Round(cost*100) / 100
+}
+
+func validatePricing(p Pricing) error {
+	check := func(name string, rates PriceRates) error {
+		if rates.Cpu < 0 || rates.Memory < 0 || rates.Storage < 0 {
+			return fmt.Errorf("%s prices shall not be negative", name)
+		}
+		return nil
+	}
+	if err := check("default", p.Default); err != nil {
+		return err
+	}
+	for region, rates := range pointer.Get(p.Regions) {
+		if region == "" {
+			return errors.New("region shall not be empty")
+		}
+		if err := validateLabels(map[string]string{regionLabel: region}); err != nil {
+			return fmt.Errorf("region %q is not a valid label value", region)
+		}
+		if err := check(fmt.Sprintf("region %q", region), rates); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pricingToAPIJson(p *model.Pricing) (*Pricing, error) {
+	regions := map[string]PriceRates{}
+	if p.Regions != "" {
+		if err := json.Unmarshal([]byte(p.Regions), &regions); err != nil {
+			return nil, errors.Join(err, errors.New("could not decode regional prices"))
+		}
+	}
+	return &Pricing{
+		Currency: pointer.ToString(p.Currency),
+		Default:  PriceRates{Cpu: p.CPUPrice, Memory: p.MemoryPrice, Storage: p.StoragePrice},
+		Regions:  &regions,
+	}, nil
+}
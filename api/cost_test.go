@@ -0,0 +1,85 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestEstimateCost(t *testing.T) {
+	t.Parallel()
+
+	spec := everestv1alpha1.DatabaseClusterSpec{}
+	spec.Engine.Replicas = 3
+	spec.Engine.Resources.CPU = resource.MustParse("500m")
+	spec.Engine.Resources.Memory = resource.MustParse("2Gi")
+	spec.Engine.Storage.Size = resource.MustParse("10Gi")
+	spec.Proxy.Replicas = pointer.ToInt32(2)
+	spec.Proxy.Resources.CPU = resource.MustParse("250m")
+
+	prices := clusterPrices{currency: "EUR", rates: PriceRates{Cpu: 20, Memory: 3, Storage: 0.1}}
+	assert.Equal(t, CostEstimate{Currency: "EUR", Cpu: 40, Memory: 18, Storage: 3, Total: 61}, estimateCost(spec, prices))
+
+	// The proxy has as many replicas as the engine if they are not set.
+	spec.Proxy.Replicas = nil
+	prices.region = "eu-west-1"
+	assert.Equal(t, CostEstimate{
+		Currency: "EUR", Region: pointer.ToString("eu-west-1"), Cpu: 45, Memory: 18, Storage: 3, Total: 66,
+	}, estimateCost(spec, prices))
+}
+
+func TestPricesFor(t *testing.T) {
+	t.Parallel()
+
+	p := &model.Pricing{
+		Currency:     "USD",
+		CPUPrice:     20,
+		MemoryPrice:  3,
+		StoragePrice: 0.1,
+		Regions:      `{"eu-west-1":{"cpu":25,"memory":4,"storage":0.2}}`,
+	}
+
+	prices, err := pricesFor(p, &model.KubernetesCluster{Labels: `{"region":"eu-west-1"}`})
+	require.NoError(t, err)
+	assert.Equal(t, &clusterPrices{currency: "USD", region: "eu-west-1", rates: PriceRates{Cpu: 25, Memory: 4, Storage: 0.2}}, prices)
+
+	for _, labels := range []string{"", `{"region":"us-east-1"}`} {
+		prices, err = pricesFor(p, &model.KubernetesCluster{Labels: labels})
+		require.NoError(t, err)
+		assert.Equal(t, &clusterPrices{currency: "USD", rates: PriceRates{Cpu: 20, Memory: 3, Storage: 0.1}}, prices)
+	}
+}
+
+func TestValidatePricing(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, validatePricing(Pricing{
+		Default: PriceRates{Cpu: 20},
+		Regions: &map[string]PriceRates{"eu-west-1": {Cpu: 25}},
+	}))
+	assert.Error(t, validatePricing(Pricing{Default: PriceRates{Memory: -1}}))
+	assert.Error(t, validatePricing(Pricing{Regions: &map[string]PriceRates{"": {}}}))
+	assert.Error(t, validatePricing(Pricing{Regions: &map[string]PriceRates{"eu west": {}}}))
+	assert.Error(t, validatePricing(Pricing{Regions: &map[string]PriceRates{"eu-west-1": {Storage: -1}}}))
+}
@@ -0,0 +1,135 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed cron expression of 5 fields.
+type cronSchedule struct {
+	minutes  []bool
+	hours    []bool
+	days     []bool
+	months   []bool
+	weekdays []bool
+	// anyDay and anyWeekday are set if the fields are not restricted. The day matches either field
+	// if both of them are restricted like cron does.
+	anyDay     bool
+	anyWeekday bool
+}
+
+// parseCron parses a cron expression of 5 fields. The fields may be lists of values,
+// ranges and steps. Sunday is either 0 or 7.
+func parseCron(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errInvalidCronSchedule
+	}
+
+	s := &cronSchedule{
+		anyDay:     fields[2] == "*",
+		anyWeekday: fields[4] == "*",
+	}
+	var err error
+	if s.minutes, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if s.hours, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if s.days, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if s.months, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if s.weekdays, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+	s.weekdays[0] = s.weekdays[0] || s.weekdays[7]
+	return s, nil
+}
+
+// parseCronField returns the values in [0, max] matched by the field.
+func parseCronField(field string, min, max int) ([]bool, error) {
+	res := make([]bool, max+1)
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if r, s, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(s)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid cron field '%s'", field)
+			}
+			rng, step = r, n
+		}
+
+		from, to := min, max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			f, t, _ := strings.Cut(rng, "-")
+			var err1, err2 error
+			from, err1 = strconv.Atoi(f)
+			to, err2 = strconv.Atoi(t)
+			if err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("invalid cron field '%s'", field)
+			}
+		default:
+			n, err := strconv.Atoi(rng)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cron field '%s'", field)
+			}
+			from, to = n, n
+			if step != 1 {
+				to = max
+			}
+		}
+		if from < min || to > max || from > to {
+			return nil, fmt.Errorf("cron field '%s' is out of range %d-%d", field, min, max)
+		}
+		for i := from; i <= to; i += step {
+			res[i] = true
+		}
+	}
+	return res, nil
+}
+
+// matches returns true if the schedule fires at the minute of the time.
+func (s *cronSchedule) matches(t time.Time) bool {
+	return s.minutes[t.Minute()] && s.hours[t.Hour()] && s.matchesDay(t)
+}
+
+func (s *cronSchedule) matchesDay(t time.Time) bool {
+	if !s.months[int(t.Month())] {
+		return false
+	}
+	day, weekday := s.days[t.Day()], s.weekdays[int(t.Weekday())]
+	switch {
+	case s.anyDay && s.anyWeekday:
+		return true
+	case s.anyDay:
+		return weekday
+	case s.anyWeekday:
+		return day
+	default:
+		return day || weekday
+	}
+}
@@ -25,7 +25,9 @@ import (
 	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/percona/percona-everest-backend/model"
 	"github.com/percona/percona-everest-backend/pkg/kubernetes"
 )
 
@@ -39,15 +41,45 @@ func (e *EverestServer) CreateDatabaseCluster(ctx echo.Context, kubernetesID str
 		})
 	}
 
+	if code, err := e.applyDefaultMonitoring(ctx, kubernetesID, dbc); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
 	if err := e.validateDatabaseClusterCR(ctx, kubernetesID, dbc); err != nil {
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
 	}
+	if err := convertScheduleTimeZones(ctx); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if code, err := e.enforceQuotas(ctx, kubernetesID, nil); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
 
 	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
 	if err != nil {
 		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 	}
 
+	if e.config.PreflightCapacityCheck {
+		if code, err := e.preflightDatabaseClusterCreation(ctx, kubeClient); err != nil {
+			return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+		}
+	}
+
+	namespace, code, err := e.ensureProjectNamespace(ctx.Request().Context(), kubernetesID, kubeClient, dbc)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if namespace != "" {
+		// Backup storages and monitoring configs shall live next to the database cluster.
+		kubeClient, err = kubernetes.NewFromSecretsStorage(ctx.Request().Context(), e.secretsStorage, kubernetesID, namespace, e.l)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString("Could not create Kubernetes client from kubeconfig"),
+			})
+		}
+	}
+
 	backupNames := backupStorageNamesFrom(dbc)
 	err = e.createK8SBackupStorages(ctx.Request().Context(), kubeClient, backupNames)
 	if err != nil {
@@ -58,7 +90,7 @@ func (e *EverestServer) CreateDatabaseCluster(ctx echo.Context, kubernetesID str
 	}
 
 	if monitoringName := monitoringNameFrom(dbc); monitoringName != "" {
-		i, err := e.storage.GetMonitoringInstance(monitoringName)
+		i, err := e.storage.GetMonitoringInstance(ctx.Request().Context(), monitoringName)
 		if err != nil {
 			return ctx.JSON(http.StatusBadRequest, Error{
 				Message: pointer.ToString("Could not find monitoring instance"),
@@ -74,17 +106,92 @@ func (e *EverestServer) CreateDatabaseCluster(ctx echo.Context, kubernetesID str
 		}
 	}
 
-	return e.proxyKubernetes(ctx, kubernetesID, "")
+	if err := e.proxyKubernetesNamespace(ctx, kubernetesID, namespace, ""); err != nil {
+		return err
+	}
+	if ctx.Response().Status < http.StatusMultipleChoices {
+		if name, ok := pointer.Get(dbc.Metadata)["name"].(string); ok {
+			e.emitWebhookEvent(ctx, DatabaseClusterCreated, kubernetesID, "database-clusters/"+name)
+		}
+	}
+	return nil
 }
 
 // ListDatabaseClusters lists the created database clusters on the specified kubernetes cluster.
-func (e *EverestServer) ListDatabaseClusters(ctx echo.Context, kubernetesID string) error {
-	return e.proxyKubernetes(ctx, kubernetesID, "")
+func (e *EverestServer) ListDatabaseClusters(ctx echo.Context, kubernetesID string, params ListDatabaseClustersParams) error {
+	// Kubernetes does not support field selectors on custom resources besides the metadata ones,
+	// so filtering by engine type or state is done on our side. A label selector alone is
+	// passed through to Kubernetes as a query parameter by the proxy.
+	namespace := pointer.GetString(params.Namespace)
+	// The callers limited to their projects get only the database clusters of the projects.
+	if projects := callerProjects(ctx); projects != nil {
+		if len(projects) == 0 {
+			return ctx.JSON(http.StatusOK, &everestv1alpha1.DatabaseClusterList{
+				TypeMeta: metav1.TypeMeta{APIVersion: everestv1alpha1.GroupVersion.String(), Kind: "DatabaseClusterList"},
+				Items:    []everestv1alpha1.DatabaseCluster{},
+			})
+		}
+		selector := projectsLabelSelector(projects)
+		if s := pointer.GetString(params.LabelSelector); s != "" {
+			selector = s + "," + selector
+		}
+		params.LabelSelector = &selector
+		query := ctx.Request().URL.Query()
+		query.Set("labelSelector", selector)
+		ctx.Request().URL.RawQuery = query.Encode()
+	}
+	if params.EngineType == nil && params.State == nil {
+		return e.proxyKubernetesNamespace(ctx, kubernetesID, namespace, "")
+	}
+
+	k, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if namespace != "" && namespace != k.Namespace {
+		kubeClient, err = kubernetes.NewFromSecretsStorage(ctx.Request().Context(), e.secretsStorage, kubernetesID, namespace, e.l)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString("Could not create Kubernetes client from kubeconfig"),
+			})
+		}
+	}
+
+	list, err := kubeClient.ListDatabaseClustersBySelector(ctx.Request().Context(), pointer.GetString(params.LabelSelector))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not list database clusters"),
+		})
+	}
+
+	list.APIVersion = everestv1alpha1.GroupVersion.String()
+	list.Kind = "DatabaseClusterList"
+	list.Items = filterDatabaseClusters(list.Items, pointer.GetString(params.EngineType), pointer.GetString(params.State))
+
+	return ctx.JSON(http.StatusOK, list)
+}
+
+// filterDatabaseClusters returns the database clusters with the given engine type and state.
+// Empty filter values match any database cluster.
+func filterDatabaseClusters(dbs []everestv1alpha1.DatabaseCluster, engineType, state string) []everestv1alpha1.DatabaseCluster {
+	res := make([]everestv1alpha1.DatabaseCluster, 0, len(dbs))
+	for _, db := range dbs {
+		if engineType != "" && string(db.Spec.Engine.Type) != engineType {
+			continue
+		}
+		if state != "" && string(db.Status.Status) != state {
+			continue
+		}
+		res = append(res, db)
+	}
+	return res
 }
 
 // DeleteDatabaseCluster deletes a database cluster on the specified kubernetes cluster.
-func (e *EverestServer) DeleteDatabaseCluster(ctx echo.Context, kubernetesID string, name string) error {
-	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+func (e *EverestServer) DeleteDatabaseCluster(ctx echo.Context, kubernetesID string, name string, _ DeleteDatabaseClusterParams) error {
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx.Request().Context(), kubernetesID)
 	if err != nil {
 		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 	}
@@ -107,6 +214,18 @@ func (e *EverestServer) DeleteDatabaseCluster(ctx echo.Context, kubernetesID str
 	if ctx.Response().Status >= http.StatusMultipleChoices {
 		return nil
 	}
+	e.cleanUpDeletedDatabaseCluster(ctx, kubeClient, kubernetesID, db)
+
+	return nil
+}
+
+// cleanUpDeletedDatabaseCluster deletes the state of a deleted database cluster and the backup
+// storages and the monitoring config which are not used by other database clusters anymore.
+func (e *EverestServer) cleanUpDeletedDatabaseCluster(
+	ctx echo.Context, kubeClient *kubernetes.Kubernetes, kubernetesID string, db *everestv1alpha1.DatabaseCluster,
+) {
+	e.emitWebhookEvent(ctx, DatabaseClusterDeleted, kubernetesID, "database-clusters/"+db.Name)
+	e.deleteMaintenanceState(ctx.Request().Context(), kubernetesID, db.Name)
 
 	names := kubernetes.BackupStorageNamesFromDBCluster(db)
 	e.waitGroup.Add(1)
@@ -116,17 +235,15 @@ func (e *EverestServer) DeleteDatabaseCluster(ctx echo.Context, kubernetesID str
 		e.waitGroup.Add(1)
 		go e.deleteK8SMonitoringConfig(context.Background(), kubeClient, db.Spec.Monitoring.MonitoringConfigName)
 	}
-
-	return nil
 }
 
 // GetDatabaseCluster retrieves the specified database cluster on the specified kubernetes cluster.
-func (e *EverestServer) GetDatabaseCluster(ctx echo.Context, kubernetesID string, name string) error {
-	return e.proxyKubernetes(ctx, kubernetesID, name)
+func (e *EverestServer) GetDatabaseCluster(ctx echo.Context, kubernetesID string, name string, params GetDatabaseClusterParams) error {
+	return e.proxyKubernetesTagged(ctx, kubernetesID, pointer.GetString(params.Namespace), name)
 }
 
 // UpdateDatabaseCluster replaces the specified database cluster on the specified kubernetes cluster.
-func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, kubernetesID string, name string) error {
+func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, kubernetesID string, name string, params UpdateDatabaseClusterParams) error {
 	dbc := &DatabaseCluster{}
 	if err := e.getBodyFromContext(ctx, dbc); err != nil {
 		e.l.Error(err)
@@ -138,8 +255,11 @@ func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, kubernetesID str
 	if err := e.validateDatabaseClusterCR(ctx, kubernetesID, dbc); err != nil {
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
 	}
+	if err := convertScheduleTimeZones(ctx); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
 
-	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx.Request().Context(), kubernetesID)
 	if err != nil {
 		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 	}
@@ -148,9 +268,29 @@ func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, kubernetesID str
 	if err != nil {
 		return errors.Join(err, errors.New("could not get old Database Cluster"))
 	}
+	if !etagMatches(params.IfMatch, resourceVersionETag(oldDB.ResourceVersion)) {
+		return ctx.JSON(http.StatusConflict, Error{
+			Message: pointer.ToString("The database cluster has changed since it was read. Get it again and retry the update"),
+		})
+	}
 	if err := validateDatabaseClusterOnUpdate(dbc, oldDB); err != nil {
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
 	}
+	// The access to the database cluster is authorized by its current project only.
+	if code, err := e.checkProjectChange(ctx, oldDB.Labels[projectLabel], databaseClusterLabel(dbc, projectLabel)); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if code, err := e.enforceQuotas(ctx, kubernetesID, oldDB); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	// The restarts and the resizes are deferred to the maintenance window of the database cluster.
+	op, code, err := e.deferDatabaseClusterUpdate(ctx, kubernetesID, oldDB, pointer.GetBool(params.OverrideMaintenanceWindow))
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if op != nil {
+		return ctx.JSON(http.StatusAccepted, pendingOperationToAPI(op))
+	}
 	newMonitoringName := monitoringNameFrom(dbc)
 	newBackupNames := backupStorageNamesFrom(dbc)
 	err = e.createResources(ctx.Request().Context(), oldDB, kubeClient, newMonitoringName, newBackupNames)
@@ -158,7 +298,7 @@ func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, kubernetesID str
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
 	}
 
-	proxyErr := e.proxyKubernetes(ctx, kubernetesID, name)
+	proxyErr := e.proxyKubernetesTagged(ctx, kubernetesID, "", name)
 	if proxyErr != nil {
 		return proxyErr
 	}
@@ -168,6 +308,7 @@ func (e *EverestServer) UpdateDatabaseCluster(ctx echo.Context, kubernetesID str
 	if ctx.Response().Status >= http.StatusMultipleChoices {
 		return nil
 	}
+	e.emitWebhookEvent(ctx, DatabaseClusterUpdated, kubernetesID, "database-clusters/"+name)
 	e.waitGroup.Add(1)
 	go e.deleteBackupStoragesOnUpdate(context.Background(), kubeClient, oldDB, newBackupNames)
 	e.waitGroup.Add(1)
@@ -193,8 +334,8 @@ func (e *EverestServer) createResources(c context.Context, oldDB *everestv1alpha
 }
 
 // GetDatabaseClusterCredentials returns credentials for the specified database cluster on the specified kubernetes cluster.
-func (e *EverestServer) GetDatabaseClusterCredentials(ctx echo.Context, kubernetesID string, name string) error {
-	k, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+func (e *EverestServer) GetDatabaseClusterCredentials(ctx echo.Context, kubernetesID string, name string, _ GetDatabaseClusterCredentialsParams) error {
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx.Request().Context(), kubernetesID)
 	if err != nil {
 		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 	}
@@ -204,27 +345,20 @@ func (e *EverestServer) GetDatabaseClusterCredentials(ctx echo.Context, kubernet
 		e.l.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
 	}
-	secret, err := kubeClient.GetSecret(ctx.Request().Context(), databaseCluster.Spec.Engine.UserSecretsName, k.Namespace)
+	secret, err := kubeClient.GetSecret(ctx.Request().Context(), databaseCluster.Spec.Engine.UserSecretsName, kubeClient.Namespace())
 	if err != nil {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
 	}
-	response := &DatabaseClusterCredential{}
-	switch databaseCluster.Spec.Engine.Type {
-	case everestv1alpha1.DatabaseEnginePXC:
-		response.Username = pointer.ToString("root")
-		response.Password = pointer.ToString(string(secret.Data["root"]))
-	case everestv1alpha1.DatabaseEnginePSMDB:
-		response.Username = pointer.ToString(string(secret.Data["MONGODB_USER_ADMIN_USER"]))
-		response.Password = pointer.ToString(string(secret.Data["MONGODB_USER_ADMIN_PASSWORD"]))
-	case everestv1alpha1.DatabaseEnginePostgresql:
-		response.Username = pointer.ToString("postgres")
-		response.Password = pointer.ToString(string(secret.Data["password"]))
-	default:
+	admin, err := adminFromSecret(databaseCluster.Spec.Engine.Type, secret)
+	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Unsupported database engine")})
 	}
 
-	return ctx.JSON(http.StatusOK, response)
+	return ctx.JSON(http.StatusOK, &DatabaseClusterCredential{
+		Username: pointer.ToString(admin.Username),
+		Password: pointer.ToString(admin.Password),
+	})
 }
 
 func (e *EverestServer) createK8SBackupStorages(ctx context.Context, kubeClient *kubernetes.Kubernetes, names map[string]struct{}) error {
@@ -232,19 +366,34 @@ func (e *EverestServer) createK8SBackupStorages(ctx context.Context, kubeClient
 		return nil
 	}
 
-	processed := make([]string, 0, len(names))
+	// The credentials of all backup storages are fetched at once to save the secrets storage round trips.
+	storages := make([]*model.BackupStorage, 0, len(names))
+	secretIDs := make([]string, 0, 2*len(names))
 	for name := range names {
 		bs, err := e.storage.GetBackupStorage(ctx, nil, name)
 		if err != nil {
 			return errors.Join(err, errors.New("could not get backup storage"))
 		}
+		storages = append(storages, bs)
+		if !bs.UsesIAM() {
+			secretIDs = append(secretIDs, bs.AccessKeyID, bs.SecretKeyID)
+		}
+	}
+	if len(secretIDs) > 2 {
+		// The secrets are cached for the request, the failures are left to the individual retrievals.
+		if _, err := e.secretsStorage.GetSecrets(ctx, secretIDs); err != nil {
+			e.l.Debug(errors.Join(err, errors.New("could not prefetch backup storage secrets")))
+		}
+	}
 
-		err = kubeClient.EnsureConfigExists(ctx, bs, e.secretsStorage.GetSecret)
+	processed := make([]string, 0, len(storages))
+	for _, bs := range storages {
+		err := kubeClient.EnsureConfigExists(ctx, bs, e.secretsStorage.GetSecret)
 		if err != nil {
 			e.rollbackCreatedBackupStorages(ctx, kubeClient, processed)
-			return errors.Join(err, fmt.Errorf("could not create CRs for %s", name))
+			return errors.Join(err, fmt.Errorf("could not create CRs for %s", bs.Name))
 		}
-		processed = append(processed, name)
+		processed = append(processed, bs.Name)
 	}
 	return nil
 }
@@ -271,7 +420,7 @@ func (e *EverestServer) deleteK8SMonitoringConfig(
 	ctx context.Context, kubeClient *kubernetes.Kubernetes, name string,
 ) {
 	defer e.waitGroup.Done()
-	i, err := e.storage.GetMonitoringInstance(name)
+	i, err := e.storage.GetMonitoringInstance(ctx, name)
 	if err != nil {
 		e.l.Error(errors.Join(err, errors.New("could get monitoring instance")))
 		return
@@ -332,23 +481,7 @@ func (e *EverestServer) createBackupStoragesOnUpdate(
 	newNames map[string]struct{},
 ) error {
 	// try to create all storages that are new
-	toCreate := uniqueKeys(oldNames, newNames)
-	processed := make([]string, 0, len(toCreate))
-	for name := range toCreate {
-		bs, err := e.storage.GetBackupStorage(ctx, nil, name)
-		if err != nil {
-			return errors.Join(err, errors.New("could not get backup storage"))
-		}
-
-		err = kubeClient.EnsureConfigExists(ctx, bs, e.secretsStorage.GetSecret)
-		if err != nil {
-			e.rollbackCreatedBackupStorages(ctx, kubeClient, processed)
-			return errors.Join(err, fmt.Errorf("could not create CRs for %s", name))
-		}
-		processed = append(processed, name)
-	}
-
-	return nil
+	return e.createK8SBackupStorages(ctx, kubeClient, uniqueKeys(oldNames, newNames))
 }
 
 func (e *EverestServer) deleteBackupStoragesOnUpdate(
@@ -380,7 +513,7 @@ func (e *EverestServer) createMonitoringInstanceOnUpdate(
 	}
 
 	if newName != "" && newName != oldName {
-		i, err := e.storage.GetMonitoringInstance(newName)
+		i, err := e.storage.GetMonitoringInstance(ctx, newName)
 		if err != nil {
 			return errors.Join(err, errors.New("could not get monitoring instance"))
 		}
@@ -407,7 +540,7 @@ func (e *EverestServer) deleteMonitoringInstanceOnUpdate(
 	}
 
 	if oldName != "" && newName != oldName {
-		i, err := e.storage.GetMonitoringInstance(oldName)
+		i, err := e.storage.GetMonitoringInstance(ctx, oldName)
 		if err != nil {
 			e.l.Error(errors.Join(err, errors.New("could not get monitoring instance")))
 			return
@@ -443,6 +576,52 @@ func backupStorageNamesFrom(dbc *DatabaseCluster) map[string]struct{} {
 	return names
 }
 
+// applyDefaultMonitoring attaches the database cluster to the default monitoring instance
+// of the Kubernetes cluster if it has no monitoring section.
+func (e *EverestServer) applyDefaultMonitoring(ctx echo.Context, kubernetesID string, dbc *DatabaseCluster) (int, error) {
+	if dbc.Spec == nil || dbc.Spec.Monitoring != nil {
+		return 0, nil
+	}
+	k, err := e.storage.GetKubernetesCluster(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not get Kubernetes cluster")
+	}
+	if k.DefaultMonitoringInstanceName == nil {
+		return 0, nil
+	}
+
+	var obj map[string]interface{}
+	if err := e.getBodyFromContext(ctx, &obj); err != nil {
+		return http.StatusBadRequest, err
+	}
+	if !setDefaultMonitoring(obj, *k.DefaultMonitoringInstanceName) {
+		return 0, nil
+	}
+	if err := replaceRequestBody(ctx.Request(), obj); err != nil {
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not set the default monitoring instance")
+	}
+	if err := e.getBodyFromContext(ctx, dbc); err != nil {
+		return http.StatusBadRequest, err
+	}
+	return 0, nil
+}
+
+// setDefaultMonitoring sets the monitoring config of the unstructured database cluster unless
+// the monitoring section is present, possibly empty to opt out. It returns true if it was set.
+func setDefaultMonitoring(obj map[string]interface{}, monitoringConfigName string) bool {
+	spec, ok := obj["spec"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	if _, ok := spec["monitoring"]; ok {
+		return false
+	}
+	spec["monitoring"] = map[string]interface{}{"monitoringConfigName": monitoringConfigName}
+	return true
+}
+
 func monitoringNameFrom(db *DatabaseCluster) string {
 	if db.Spec == nil {
 		return ""
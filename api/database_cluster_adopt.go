@@ -0,0 +1,191 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const (
+	adoptedConfigKindBackupStorage      = "backupStorage"
+	adoptedConfigKindMonitoringInstance = "monitoringInstance"
+
+	adoptedConfigStatusManaged  = "managed"
+	adoptedConfigStatusImported = "imported"
+	adoptedConfigStatusUnknown  = "unknown"
+)
+
+// AdoptDatabaseCluster brings a database cluster created outside of Everest under Everest management.
+// The referenced configs are imported one by one, so the ones imported before a flagged one stay imported.
+func (e *EverestServer) AdoptDatabaseCluster(ctx echo.Context, kubernetesID string, name string, _ AdoptDatabaseClusterParams) error {
+	params := DatabaseClusterAdoption{}
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	db, err := kubeClient.GetDatabaseCluster(c, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	result := DatabaseClusterAdoptionResult{Configs: make([]AdoptedConfig, 0)}
+	storageParams := make(map[string]ImportBackupStorageParams, len(params.BackupStorages))
+	for _, p := range params.BackupStorages {
+		storageParams[p.Name] = p
+	}
+	storageNames := make([]string, 0)
+	for n := range kubernetes.BackupStorageNamesFromDBCluster(db) {
+		storageNames = append(storageNames, n)
+	}
+	sort.Strings(storageNames)
+	for _, n := range storageNames {
+		p, ok := storageParams[n]
+		if !ok {
+			p = ImportBackupStorageParams{Name: n}
+		}
+		cfg, err := e.adoptBackupStorage(c, kubeClient, kubeClient.Namespace(), p, creatorFrom(ctx))
+		if err != nil {
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
+		}
+		result.Configs = append(result.Configs, cfg)
+	}
+
+	if db.Spec.Monitoring != nil && db.Spec.Monitoring.MonitoringConfigName != "" {
+		n := db.Spec.Monitoring.MonitoringConfigName
+		p := ImportMonitoringInstanceParams{Name: n}
+		for _, mp := range params.MonitoringInstances {
+			if mp.Name == n {
+				p = mp
+			}
+		}
+		cfg, err := e.adoptMonitoringConfig(c, kubeClient, kubeClient.Namespace(), p, creatorFrom(ctx))
+		if err != nil {
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
+		}
+		result.Configs = append(result.Configs, cfg)
+	}
+
+	for _, cfg := range result.Configs {
+		if cfg.Status == adoptedConfigStatusUnknown {
+			return ctx.JSON(http.StatusOK, result)
+		}
+	}
+
+	annotations := map[string]string{}
+	if db.Annotations[ownerAnnotation] == "" && db.Annotations[teamAnnotation] == "" {
+		if id, ok := userIdentityFrom(ctx); ok {
+			annotations[ownerAnnotation] = id.Username
+			if len(id.Groups) != 0 {
+				annotations[teamAnnotation] = id.Groups[0]
+			}
+		}
+	}
+	if _, err := kubeClient.AdoptDatabaseCluster(c, db, annotations); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not label database cluster as managed")))
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not update database cluster in Kubernetes"),
+		})
+	}
+	result.Adopted = true
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// adoptBackupStorage imports the backup storage referenced by an adopted database cluster unless it is
+// managed already. The backup storage is flagged as unknown if it cannot be imported.
+func (e *EverestServer) adoptBackupStorage(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, namespace string, params ImportBackupStorageParams, createdBy string,
+) (AdoptedConfig, error) {
+	cfg := AdoptedConfig{Kind: adoptedConfigKindBackupStorage, Name: params.Name}
+	bs, err := e.storage.GetBackupStorage(ctx, nil, params.Name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return cfg, errors.New("could not get backup storage")
+	}
+	if bs != nil {
+		// The database cluster references it already, so it only needs to be created if it is missing.
+		if err := kubeClient.EnsureConfigExists(ctx, bs, e.secretsStorage.GetSecret); err != nil {
+			e.l.Error(err)
+			return cfg, fmt.Errorf("could not create backup storage %s in Kubernetes", bs.Name)
+		}
+		cfg.Status = adoptedConfigStatusManaged
+		return cfg, nil
+	}
+
+	_, code, err := e.importBackupStorage(ctx, kubeClient, namespace, params, createdBy)
+	return flagAdoptedConfig(cfg, code, err)
+}
+
+// adoptMonitoringConfig imports the monitoring config referenced by an adopted database cluster unless it is
+// managed already. The monitoring config is flagged as unknown if it cannot be imported.
+func (e *EverestServer) adoptMonitoringConfig(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, namespace string, params ImportMonitoringInstanceParams, createdBy string,
+) (AdoptedConfig, error) {
+	cfg := AdoptedConfig{Kind: adoptedConfigKindMonitoringInstance, Name: params.Name}
+	i, err := e.storage.GetMonitoringInstance(ctx, params.Name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return cfg, errors.New("could not get monitoring instance")
+	}
+	if i != nil {
+		if err := kubeClient.EnsureConfigExists(ctx, i, e.secretsStorage.GetSecret); err != nil {
+			e.l.Error(err)
+			return cfg, fmt.Errorf("could not create monitoring config %s in Kubernetes", i.Name)
+		}
+		cfg.Status = adoptedConfigStatusManaged
+		return cfg, nil
+	}
+
+	_, code, err := e.importMonitoringConfig(ctx, kubeClient, namespace, params, createdBy)
+	return flagAdoptedConfig(cfg, code, err)
+}
+
+// flagAdoptedConfig sets the status of a config from the result of its import. The configs which are missing
+// in the Kubernetes cluster or whose credentials are missing are flagged as unknown, other failures are returned.
+func flagAdoptedConfig(cfg AdoptedConfig, code int, err error) (AdoptedConfig, error) {
+	switch {
+	case err == nil:
+		cfg.Status = adoptedConfigStatusImported
+	case code >= http.StatusBadRequest && code < http.StatusInternalServerError:
+		cfg.Status = adoptedConfigStatusUnknown
+		cfg.Reason = pointer.ToString(err.Error())
+	default:
+		return cfg, err
+	}
+	return cfg, nil
+}
@@ -0,0 +1,45 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlagAdoptedConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := AdoptedConfig{Kind: adoptedConfigKindBackupStorage, Name: "s3"}
+
+	res, err := flagAdoptedConfig(cfg, http.StatusOK, nil)
+	require.NoError(t, err)
+	assert.Equal(t, adoptedConfigStatusImported, res.Status)
+	assert.Nil(t, res.Reason)
+
+	res, err = flagAdoptedConfig(cfg, http.StatusNotFound, errors.New("backup storage s3 not found in the Kubernetes cluster"))
+	require.NoError(t, err)
+	assert.Equal(t, adoptedConfigStatusUnknown, res.Status)
+	assert.Equal(t, "backup storage s3 not found in the Kubernetes cluster", pointer.GetString(res.Reason))
+
+	_, err = flagAdoptedConfig(cfg, http.StatusInternalServerError, errors.New("could not create a new backup storage"))
+	require.Error(t, err)
+}
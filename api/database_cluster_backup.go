@@ -22,13 +22,14 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
 )
 
 // ListDatabaseClusterBackups returns list of the created database cluster backups on the specified kubernetes cluster.
-func (e *EverestServer) ListDatabaseClusterBackups(ctx echo.Context, kubernetesID string, name string) error {
+func (e *EverestServer) ListDatabaseClusterBackups(ctx echo.Context, kubernetesID string, name string, _ ListDatabaseClusterBackupsParams) error {
 	req := ctx.Request()
 	if err := validateRFC1035(name, "name"); err != nil {
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
@@ -43,11 +44,11 @@ func (e *EverestServer) ListDatabaseClusterBackups(ctx echo.Context, kubernetesI
 	path = strings.TrimSuffix(path, name)
 	path = strings.ReplaceAll(path, "database-clusters", "database-cluster-backups")
 	req.URL.Path = path
-	return e.proxyKubernetes(ctx, kubernetesID, "")
+	return e.proxyKubernetesModified(ctx, kubernetesID, "", "", e.backupEstimates(kubernetesID))
 }
 
 // CreateDatabaseClusterBackup creates a database cluster backup on the specified kubernetes cluster.
-func (e *EverestServer) CreateDatabaseClusterBackup(ctx echo.Context, kubernetesID string) error {
+func (e *EverestServer) CreateDatabaseClusterBackup(ctx echo.Context, kubernetesID string, _ CreateDatabaseClusterBackupParams) error {
 	backup := &DatabaseClusterBackup{}
 	if err := e.getBodyFromContext(ctx, backup); err != nil {
 		e.l.Error(err)
@@ -60,7 +61,7 @@ func (e *EverestServer) CreateDatabaseClusterBackup(ctx echo.Context, kubernetes
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("'Spec' field should not be empty")})
 	}
 	if backup.Spec.BackupStorageName != "" {
-		_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+		_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx.Request().Context(), kubernetesID)
 		if err != nil {
 			return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 		}
@@ -84,8 +85,16 @@ func (e *EverestServer) CreateDatabaseClusterBackup(ctx echo.Context, kubernetes
 }
 
 // DeleteDatabaseClusterBackup deletes the specified cluster backup on the specified kubernetes cluster.
-func (e *EverestServer) DeleteDatabaseClusterBackup(ctx echo.Context, kubernetesID string, name string) error {
-	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+func (e *EverestServer) DeleteDatabaseClusterBackup(
+	ctx echo.Context, kubernetesID string, name string, params DeleteDatabaseClusterBackupParams,
+) error {
+	force := pointer.GetBool(params.Force)
+	reason := pointer.GetString(params.Reason)
+	if force && reason == "" {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("A reason is required to force the deletion")})
+	}
+
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx.Request().Context(), kubernetesID)
 	if err != nil {
 		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 	}
@@ -98,6 +107,26 @@ func (e *EverestServer) DeleteDatabaseClusterBackup(ctx echo.Context, kubernetes
 		})
 	}
 
+	if protection := backupProtection(backup, e.config.BackupComplianceAge, time.Now()); protection != "" {
+		if !force {
+			return ctx.JSON(http.StatusConflict, Error{
+				Message: pointer.ToString("The backup cannot be deleted because " + protection),
+			})
+		}
+		// The override is recorded before the deletion so that no protected backup disappears unaudited.
+		err := e.recordAudit(ctx, auditActionBackupDeletionOverride, kubernetesID, backupAuditResource(name),
+			fmt.Sprintf("%s (%s)", reason, protection))
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not record the audit entry")})
+		}
+	}
+
+	query := ctx.Request().URL.Query()
+	query.Del("force")
+	query.Del("reason")
+	ctx.Request().URL.RawQuery = query.Encode()
+
 	proxyErr := e.proxyKubernetes(ctx, kubernetesID, name)
 	if proxyErr != nil {
 		return proxyErr
@@ -121,6 +150,6 @@ func (e *EverestServer) DeleteDatabaseClusterBackup(ctx echo.Context, kubernetes
 }
 
 // GetDatabaseClusterBackup returns the specified cluster backup on the specified kubernetes cluster.
-func (e *EverestServer) GetDatabaseClusterBackup(ctx echo.Context, kubernetesID string, name string) error {
-	return e.proxyKubernetes(ctx, kubernetesID, name)
+func (e *EverestServer) GetDatabaseClusterBackup(ctx echo.Context, kubernetesID string, name string, _ GetDatabaseClusterBackupParams) error {
+	return e.proxyKubernetesModified(ctx, kubernetesID, "", name, e.backupEstimates(kubernetesID))
 }
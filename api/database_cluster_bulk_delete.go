@@ -0,0 +1,135 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+const (
+	bulkDeleteStatusMatched = "matched"
+	bulkDeleteStatusDeleted = "deleted"
+	bulkDeleteStatusFailed  = "failed"
+)
+
+// BulkDeleteDatabaseClusters deletes the database clusters matching a label selector and/or a name prefix.
+// The matching database clusters must be previewed with a dry run first and the deletion is only
+// done if they have not changed since.
+func (e *EverestServer) BulkDeleteDatabaseClusters(ctx echo.Context, kubernetesID string, _ BulkDeleteDatabaseClustersParams) error {
+	var params DatabaseClusterBulkDelete
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	selector := pointer.GetString(params.LabelSelector)
+	prefix := pointer.GetString(params.NamePrefix)
+	if selector == "" && prefix == "" {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("A label selector or a name prefix is required"),
+		})
+	}
+	if !params.DryRun && pointer.GetString(params.Confirmation) == "" {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("The confirmation returned by the dry run is required"),
+		})
+	}
+
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	list, err := kubeClient.ListDatabaseClustersBySelector(ctx.Request().Context(), selector)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list database clusters")))
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not list database clusters"),
+		})
+	}
+	matched := filterDatabaseClustersByPrefix(list.Items, prefix)
+	confirmation := bulkDeleteConfirmation(kubernetesID, selector, prefix, matched)
+
+	result := DatabaseClusterBulkDeleteResult{
+		DryRun: params.DryRun,
+		Items:  make([]DatabaseClusterBulkDeleteItem, 0, len(matched)),
+	}
+	if params.DryRun {
+		result.Confirmation = pointer.ToString(confirmation)
+		for _, db := range matched {
+			result.Items = append(result.Items, DatabaseClusterBulkDeleteItem{Name: db.Name, Status: bulkDeleteStatusMatched})
+		}
+		return ctx.JSON(http.StatusOK, result)
+	}
+
+	if pointer.GetString(params.Confirmation) != confirmation {
+		return ctx.JSON(http.StatusConflict, Error{
+			Message: pointer.ToString("The matching database clusters have changed since the dry run. Run the dry run again"),
+		})
+	}
+
+	for i := range matched {
+		db := &matched[i]
+		item := DatabaseClusterBulkDeleteItem{Name: db.Name, Status: bulkDeleteStatusDeleted}
+		if err := kubeClient.DeleteDatabaseCluster(ctx.Request().Context(), db.Name); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not delete database cluster "+db.Name)))
+			item.Status = bulkDeleteStatusFailed
+			item.Error = pointer.ToString(err.Error())
+		} else {
+			e.cleanUpDeletedDatabaseCluster(ctx, kubeClient, kubernetesID, db)
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// filterDatabaseClustersByPrefix returns the database clusters whose names start with the prefix sorted by name.
+func filterDatabaseClustersByPrefix(dbs []everestv1alpha1.DatabaseCluster, prefix string) []everestv1alpha1.DatabaseCluster {
+	matched := make([]everestv1alpha1.DatabaseCluster, 0, len(dbs))
+	for _, db := range dbs {
+		if strings.HasPrefix(db.Name, prefix) {
+			matched = append(matched, db)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].Name < matched[j].Name })
+	return matched
+}
+
+// bulkDeleteConfirmation identifies the set of database clusters a dry run has matched.
+// A database cluster recreated under the same name changes the confirmation as well.
+func bulkDeleteConfirmation(kubernetesID, selector, prefix string, dbs []everestv1alpha1.DatabaseCluster) string {
+	h := sha256.New()
+	for _, s := range []string{kubernetesID, selector, prefix} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	for _, db := range dbs {
+		h.Write([]byte(db.Name))
+		h.Write([]byte{0})
+		h.Write([]byte(db.UID))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
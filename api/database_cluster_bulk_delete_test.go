@@ -0,0 +1,51 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestBulkDeleteMatching(t *testing.T) {
+	t.Parallel()
+
+	db := func(name, uid string) everestv1alpha1.DatabaseCluster {
+		return everestv1alpha1.DatabaseCluster{ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(uid)}}
+	}
+	dbs := []everestv1alpha1.DatabaseCluster{db("ci-b", "1"), db("prod", "2"), db("ci-a", "3")}
+
+	matched := filterDatabaseClustersByPrefix(dbs, "ci-")
+	names := make([]string, 0, len(matched))
+	for _, m := range matched {
+		names = append(names, m.Name)
+	}
+	assert.Equal(t, []string{"ci-a", "ci-b"}, names)
+	assert.Len(t, filterDatabaseClustersByPrefix(dbs, ""), 3)
+
+	confirmation := bulkDeleteConfirmation("k8s", "env=ci", "ci-", matched)
+	reordered := filterDatabaseClustersByPrefix([]everestv1alpha1.DatabaseCluster{db("ci-a", "3"), db("ci-b", "1")}, "ci-")
+	assert.Equal(t, confirmation, bulkDeleteConfirmation("k8s", "env=ci", "ci-", reordered))
+
+	recreated := []everestv1alpha1.DatabaseCluster{db("ci-a", "4"), db("ci-b", "1")}
+	assert.NotEqual(t, confirmation, bulkDeleteConfirmation("k8s", "env=ci", "ci-", recreated))
+	assert.NotEqual(t, confirmation, bulkDeleteConfirmation("k8s", "env=ci", "ci-", matched[:1]))
+	assert.NotEqual(t, confirmation, bulkDeleteConfirmation("other", "env=ci", "ci-", matched))
+}
@@ -0,0 +1,186 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// specFieldImpacts defines the impact of changing the spec fields. The fields are matched by prefix
+// and the first match wins. Changes of the fields not listed have no impact on the running pods.
+//
+//nolint:gochecknoglobals
+var specFieldImpacts = []struct {
+	prefix string
+	impact DatabaseClusterFieldChangeImpact
+}{
+	{prefix: "spec.engine.replicas", impact: Resize},
+	{prefix: "spec.engine.storage.size", impact: Resize},
+	{prefix: "spec.proxy.replicas", impact: Resize},
+	{prefix: "spec.engine.version", impact: Restart},
+	{prefix: "spec.engine.config", impact: Restart},
+	{prefix: "spec.engine.resources", impact: Restart},
+	{prefix: "spec.engine.storage.class", impact: Restart},
+	{prefix: "spec.proxy.type", impact: Restart},
+	{prefix: "spec.proxy.config", impact: Restart},
+	{prefix: "spec.proxy.resources", impact: Restart},
+	{prefix: "spec.monitoring", impact: Restart},
+	{prefix: "spec.paused", impact: Restart},
+}
+
+// DiffDatabaseCluster compares the proposed database cluster spec with the live one.
+func (e *EverestServer) DiffDatabaseCluster(ctx echo.Context, kubernetesID string, name string, _ DiffDatabaseClusterParams) error {
+	proposed := &everestv1alpha1.DatabaseCluster{}
+	if err := ctx.Bind(proposed); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	live, err := kubeClient.GetDatabaseCluster(c, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString(fmt.Sprintf("DatabaseCluster '%s' is not found", name))})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	diff, err := diffDatabaseClusterSpecs(live, proposed)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not compare the database clusters")})
+	}
+
+	return ctx.JSON(http.StatusOK, diff)
+}
+
+func diffDatabaseClusterSpecs(live, proposed *everestv1alpha1.DatabaseCluster) (*DatabaseClusterDiff, error) {
+	liveFields, err := flattenDatabaseClusterSpec(live.Spec)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not flatten the live spec"))
+	}
+	proposedFields, err := flattenDatabaseClusterSpec(proposed.Spec)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not flatten the proposed spec"))
+	}
+
+	fields := make(map[string]struct{}, len(liveFields)+len(proposedFields))
+	for f := range liveFields {
+		fields[f] = struct{}{}
+	}
+	for f := range proposedFields {
+		fields[f] = struct{}{}
+	}
+	names := make([]string, 0, len(fields))
+	for f := range fields {
+		names = append(names, f)
+	}
+	sort.Strings(names)
+
+	diff := &DatabaseClusterDiff{Changes: []DatabaseClusterFieldChange{}}
+	for _, f := range names {
+		from, hasFrom := liveFields[f]
+		to, hasTo := proposedFields[f]
+		if hasFrom && hasTo && from == to {
+			continue
+		}
+
+		change := DatabaseClusterFieldChange{Field: f, Impact: specFieldImpact(f)}
+		if hasFrom {
+			change.From = pointer.ToString(from)
+		}
+		if hasTo {
+			change.To = pointer.ToString(to)
+		}
+		diff.Changes = append(diff.Changes, change)
+
+		switch change.Impact {
+		case Restart:
+			diff.RequiresRestart = true
+		case Resize:
+			diff.RequiresResize = true
+		case None:
+		}
+	}
+
+	return diff, nil
+}
+
+func specFieldImpact(field string) DatabaseClusterFieldChangeImpact {
+	for _, i := range specFieldImpacts {
+		if field == i.prefix || strings.HasPrefix(field, i.prefix+".") || strings.HasPrefix(field, i.prefix+"[") {
+			return i.impact
+		}
+	}
+	return None
+}
+
+// flattenDatabaseClusterSpec returns the JSON encoded leaf values of the spec by their paths.
+func flattenDatabaseClusterSpec(spec everestv1alpha1.DatabaseClusterSpec) (map[string]string, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string)
+	if err := flattenJSON("spec", v, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func flattenJSON(path string, v interface{}, res map[string]string) error {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, val := range t {
+			if err := flattenJSON(path+"."+k, val, res); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for i, val := range t {
+			if err := flattenJSON(fmt.Sprintf("%s[%d]", path, i), val, res); err != nil {
+				return err
+			}
+		}
+	default:
+		b, err := json.Marshal(t)
+		if err != nil {
+			return err
+		}
+		res[path] = string(b)
+	}
+	return nil
+}
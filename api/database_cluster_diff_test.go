@@ -0,0 +1,69 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffDatabaseClusterSpecs(t *testing.T) {
+	t.Parallel()
+
+	live := &everestv1alpha1.DatabaseCluster{}
+	require.NoError(t, json.Unmarshal([]byte(`{"spec": {
+		"engine": {"type": "pxc", "replicas": 3, "resources": {"cpu": "1", "memory": "2G"}, "storage": {"size": "10G"}},
+		"proxy": {"type": "haproxy", "replicas": 3},
+		"backup": {"enabled": false}
+	}}`), live))
+
+	t.Run("no changes", func(t *testing.T) {
+		t.Parallel()
+		proposed := live.DeepCopy()
+		proposed.Spec.Engine.Resources.CPU.Set(1)
+
+		diff, err := diffDatabaseClusterSpecs(live, proposed)
+		require.NoError(t, err)
+		assert.Equal(t, &DatabaseClusterDiff{Changes: []DatabaseClusterFieldChange{}}, diff)
+	})
+
+	t.Run("disruptive changes", func(t *testing.T) {
+		t.Parallel()
+		proposed := &everestv1alpha1.DatabaseCluster{}
+		require.NoError(t, json.Unmarshal([]byte(`{"spec": {
+			"engine": {"type": "pxc", "replicas": 5, "resources": {"cpu": "2", "memory": "2G"}, "storage": {"size": "10G"}},
+			"proxy": {"type": "haproxy", "replicas": 3},
+			"backup": {"enabled": true}
+		}}`), proposed))
+
+		diff, err := diffDatabaseClusterSpecs(live, proposed)
+		require.NoError(t, err)
+		assert.Equal(t, &DatabaseClusterDiff{
+			Changes: []DatabaseClusterFieldChange{
+				{Field: "spec.backup.enabled", From: pointer.ToString("false"), To: pointer.ToString("true"), Impact: None},
+				{Field: "spec.engine.replicas", From: pointer.ToString("3"), To: pointer.ToString("5"), Impact: Resize},
+				{Field: "spec.engine.resources.cpu", From: pointer.ToString(`"1"`), To: pointer.ToString(`"2"`), Impact: Restart},
+			},
+			RequiresRestart: true,
+			RequiresResize:  true,
+		}, diff)
+	})
+}
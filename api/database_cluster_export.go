@@ -0,0 +1,284 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const (
+	exportFormatYAML = "yaml"
+	exportFormatJSON = "json"
+)
+
+// serverMetadataFields are the metadata fields populated by the Kubernetes API server and the operators.
+//
+//nolint:gochecknoglobals
+var serverMetadataFields = []string{
+	"creationTimestamp", "deletionGracePeriodSeconds", "deletionTimestamp", "finalizers",
+	"generateName", "generation", "managedFields", "ownerReferences", "resourceVersion", "selfLink", "uid",
+}
+
+// lastAppliedConfigAnnotation is set by kubectl apply and is not part of the resource definition.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// ExportDatabaseCluster returns the specified database cluster as a clean manifest.
+func (e *EverestServer) ExportDatabaseCluster(ctx echo.Context, kubernetesID string, name string, params ExportDatabaseClusterParams) error {
+	format, err := exportFormat(params.Format)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	_, db, code, err := e.exportedDatabaseCluster(ctx.Request().Context(), kubernetesID, name)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	manifest, err := exportManifest(db)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not export the database cluster")})
+	}
+	if format == exportFormatJSON {
+		return ctx.JSON(http.StatusOK, manifest)
+	}
+	b, err := yaml.Marshal(manifest)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not marshal the manifest")))
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not export the database cluster")})
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s.yaml"`, name))
+	return ctx.Blob(http.StatusOK, "application/yaml", b)
+}
+
+// ExportDatabaseClusterBundle returns the specified database cluster along with the backup storages,
+// the monitoring config and their secrets as a bundle of clean manifests. The secret values are templated.
+func (e *EverestServer) ExportDatabaseClusterBundle(
+	ctx echo.Context, kubernetesID string, name string, params ExportDatabaseClusterBundleParams,
+) error {
+	format, err := exportFormat(params.Format)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	c := ctx.Request().Context()
+	kubeClient, db, code, err := e.exportedDatabaseCluster(c, kubernetesID, name)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	bundle := &manifestBundle{}
+	storageNames := make([]string, 0)
+	for n := range kubernetes.BackupStorageNamesFromDBCluster(db) {
+		storageNames = append(storageNames, n)
+	}
+	sort.Strings(storageNames)
+	for _, n := range storageNames {
+		bs, err := e.storage.GetBackupStorage(c, nil, n)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ctx.JSON(http.StatusConflict, Error{
+					Message: pointer.ToString(fmt.Sprintf("Backup storage %s is not managed by Everest. Adopt the database cluster first", n)),
+				})
+			}
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup storage")})
+		}
+		config, secret, _, err := kubeClient.ConfigManifests(c, bs, e.secretsStorage.GetSecret)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not export backup storage " + n)})
+		}
+		config.GetObjectKind().SetGroupVersionKind(everestv1alpha1.GroupVersion.WithKind("BackupStorage"))
+		bundle.addSecret(secret)
+		bundle.add(config)
+	}
+
+	if db.Spec.Monitoring != nil && db.Spec.Monitoring.MonitoringConfigName != "" {
+		n := db.Spec.Monitoring.MonitoringConfigName
+		i, err := e.storage.GetMonitoringInstance(c, n)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ctx.JSON(http.StatusConflict, Error{
+					Message: pointer.ToString(fmt.Sprintf("Monitoring instance %s is not managed by Everest. Adopt the database cluster first", n)),
+				})
+			}
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get monitoring instance")})
+		}
+		config, secret, _, err := kubeClient.ConfigManifests(c, i, e.secretsStorage.GetSecret)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not export monitoring config")})
+		}
+		config.GetObjectKind().SetGroupVersionKind(everestv1alpha1.GroupVersion.WithKind("MonitoringConfig"))
+		bundle.addSecret(secret)
+		bundle.add(config)
+	}
+	bundle.add(db)
+
+	if bundle.err != nil {
+		e.l.Error(bundle.err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not export the database cluster")})
+	}
+	if format == exportFormatJSON {
+		return ctx.JSON(http.StatusOK, map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "List",
+			"items":      bundle.manifests,
+		})
+	}
+	b, err := bundle.yaml()
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not export the database cluster")})
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s-bundle.yaml"`, name))
+	return ctx.Blob(http.StatusOK, "application/yaml", b)
+}
+
+func exportFormat(format *string) (string, error) {
+	f := pointer.GetString(format)
+	if f == "" {
+		return exportFormatYAML, nil
+	}
+	if f != exportFormatYAML && f != exportFormatJSON {
+		return "", fmt.Errorf("format shall be either %s or %s", exportFormatYAML, exportFormatJSON)
+	}
+	return f, nil
+}
+
+// exportedDatabaseCluster returns the database cluster to export and the client of its Kubernetes cluster.
+func (e *EverestServer) exportedDatabaseCluster(
+	ctx context.Context, kubernetesID, name string,
+) (*kubernetes.Kubernetes, *everestv1alpha1.DatabaseCluster, int, error) {
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx, kubernetesID)
+	if err != nil {
+		return nil, nil, code, err
+	}
+	db, err := kubeClient.GetDatabaseCluster(ctx, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil, http.StatusNotFound, errors.New("database cluster not found")
+		}
+		e.l.Error(err)
+		return nil, nil, http.StatusInternalServerError, errors.New("could not get database cluster")
+	}
+	db.GetObjectKind().SetGroupVersionKind(everestv1alpha1.GroupVersion.WithKind("DatabaseCluster"))
+	return kubeClient, db, http.StatusOK, nil
+}
+
+// manifestBundle collects the clean manifests of an exported bundle. The first error is kept and stops the collection.
+type manifestBundle struct {
+	manifests []map[string]interface{}
+	err       error
+}
+
+func (b *manifestBundle) add(obj runtime.Object) {
+	if b.err != nil {
+		return
+	}
+	manifest, err := exportManifest(obj)
+	if err != nil {
+		b.err = err
+		return
+	}
+	b.manifests = append(b.manifests, manifest)
+}
+
+// addSecret adds the secret with its values replaced by placeholders. Nil secrets are skipped.
+func (b *manifestBundle) addSecret(secret *corev1.Secret) {
+	if secret == nil {
+		return
+	}
+	for key := range secret.StringData {
+		secret.StringData[key] = secretPlaceholder(secret.Name, key)
+	}
+	b.add(secret)
+}
+
+// yaml returns the manifests as a multi-document YAML stream.
+func (b *manifestBundle) yaml() ([]byte, error) {
+	var buf bytes.Buffer
+	for i, manifest := range b.manifests {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		doc, err := yaml.Marshal(manifest)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not marshal the manifest"))
+		}
+		buf.Write(doc)
+	}
+	return buf.Bytes(), nil
+}
+
+// secretPlaceholder returns the placeholder of a secret value in the format of envsubst, e.g. ${S3_SECRET_AWS_ACCESS_KEY_ID}.
+func secretPlaceholder(secretName, key string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return unicode.ToUpper(r)
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, secretName+"_"+key)
+	return "${" + name + "}"
+}
+
+// exportManifest returns the manifest of a Kubernetes resource without its status and the metadata
+// populated by the server, so that it can be applied to another Kubernetes cluster.
+func exportManifest(obj runtime.Object) (map[string]interface{}, error) {
+	manifest, err := manifestOf(obj)
+	if err != nil {
+		return nil, err
+	}
+	delete(manifest, "status")
+
+	metadata, _ := manifest["metadata"].(map[string]interface{})
+	if metadata == nil {
+		return manifest, nil
+	}
+	for _, field := range serverMetadataFields {
+		delete(metadata, field)
+	}
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		delete(annotations, lastAppliedConfigAnnotation)
+		if len(annotations) == 0 {
+			delete(metadata, "annotations")
+		}
+	}
+	return manifest, nil
+}
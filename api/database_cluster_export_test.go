@@ -0,0 +1,87 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"strings"
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExportManifest(t *testing.T) {
+	t.Parallel()
+
+	db := &everestv1alpha1.DatabaseCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "db",
+			Namespace:         "everest",
+			Labels:            map[string]string{"env": "prod"},
+			Annotations:       map[string]string{lastAppliedConfigAnnotation: "{}"},
+			UID:               "b2f5",
+			ResourceVersion:   "42",
+			Generation:        3,
+			CreationTimestamp: metav1.Now(),
+			Finalizers:        []string{"everest.percona.com/upstream-cluster-cleanup"},
+			ManagedFields:     []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Spec:   everestv1alpha1.DatabaseClusterSpec{Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC, Replicas: 3}},
+		Status: everestv1alpha1.DatabaseClusterStatus{Status: everestv1alpha1.AppStateReady},
+	}
+	db.GetObjectKind().SetGroupVersionKind(everestv1alpha1.GroupVersion.WithKind("DatabaseCluster"))
+
+	manifest, err := exportManifest(db)
+	require.NoError(t, err)
+	assert.Equal(t, "DatabaseCluster", manifest["kind"])
+	assert.NotContains(t, manifest, "status")
+	assert.Equal(t, map[string]interface{}{
+		"name":      "db",
+		"namespace": "everest",
+		"labels":    map[string]interface{}{"env": "prod"},
+	}, manifest["metadata"])
+	spec, ok := manifest["spec"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "pxc", spec["engine"].(map[string]interface{})["type"])
+}
+
+func TestManifestBundle(t *testing.T) {
+	t.Parallel()
+
+	bundle := &manifestBundle{}
+	bundle.addSecret(nil)
+	bundle.addSecret(&corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: "s3-backup", Namespace: "everest"},
+		StringData: map[string]string{"AWS_ACCESS_KEY_ID": "<redacted>"},
+	})
+	bs := &everestv1alpha1.BackupStorage{ObjectMeta: metav1.ObjectMeta{Name: "s3", Namespace: "everest"}}
+	bs.GetObjectKind().SetGroupVersionKind(everestv1alpha1.GroupVersion.WithKind("BackupStorage"))
+	bundle.add(bs)
+	require.NoError(t, bundle.err)
+	require.Len(t, bundle.manifests, 2)
+	assert.Equal(t, map[string]interface{}{"AWS_ACCESS_KEY_ID": "${S3_BACKUP_AWS_ACCESS_KEY_ID}"}, bundle.manifests[0]["stringData"])
+
+	b, err := bundle.yaml()
+	require.NoError(t, err)
+	docs := strings.Split(string(b), "---\n")
+	require.Len(t, docs, 2)
+	assert.Contains(t, docs[0], "kind: Secret")
+	assert.Contains(t, docs[1], "kind: BackupStorage")
+}
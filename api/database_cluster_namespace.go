@@ -0,0 +1,103 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+type namespaceKey struct{}
+
+func withNamespace(ctx context.Context, namespace string) context.Context {
+	return context.WithValue(ctx, namespaceKey{}, namespace)
+}
+
+// namespaceFrom returns the namespace of the database cluster the context belongs to.
+// Empty namespace stands for the namespace the kubernetes cluster was registered with.
+func namespaceFrom(ctx context.Context) string {
+	namespace, _ := ctx.Value(namespaceKey{}).(string)
+	return namespace
+}
+
+// scopeDatabaseClusterNamespace resolves the namespace query parameter of the database cluster requests
+// and attaches it to the context of the request. Only the namespace of the kubernetes cluster and the
+// namespaces created from the namespace template are accepted.
+func (e *EverestServer) scopeDatabaseClusterNamespace(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		route := strings.TrimPrefix(ctx.Path(), "/v1")
+		namespace := ctx.QueryParam("namespace")
+		if namespace == "" || !strings.HasPrefix(route, "/kubernetes/:kubernetes-id/database-cluster") {
+			return next(ctx)
+		}
+
+		c := ctx.Request().Context()
+		k, kubeClient, code, err := e.initKubeClient(c, ctx.Param("kubernetes-id"))
+		if err != nil {
+			return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+		}
+		if namespace != k.Namespace {
+			ns, err := kubeClient.GetNamespace(c, namespace)
+			if err != nil {
+				if k8serrors.IsNotFound(err) {
+					return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString(fmt.Sprintf("Namespace %s is not found", namespace))})
+				}
+				e.l.Error(err)
+				return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get namespace")})
+			}
+			if _, ok := ns.Labels[projectLabel]; !ok {
+				return ctx.JSON(http.StatusBadRequest, Error{
+					Message: pointer.ToString(fmt.Sprintf("Namespace %s is not managed by Everest", namespace)),
+				})
+			}
+		}
+
+		ctx.SetRequest(ctx.Request().WithContext(withNamespace(c, namespace)))
+		return next(ctx)
+	}
+}
+
+// initDatabaseClusterKubeClient returns the client of the kubernetes cluster operating in the namespace
+// of the database cluster the context belongs to.
+func (e *EverestServer) initDatabaseClusterKubeClient(
+	ctx context.Context, kubernetesID string,
+) (*model.KubernetesCluster, *kubernetes.Kubernetes, int, error) {
+	k, kubeClient, code, err := e.initKubeClient(ctx, kubernetesID)
+	if err != nil {
+		return k, kubeClient, code, err
+	}
+	namespace := namespaceFrom(ctx)
+	if namespace == "" || namespace == k.Namespace {
+		return k, kubeClient, 0, nil
+	}
+	kubeClient, err = kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, namespace, e.l)
+	if err != nil {
+		e.l.Error(err)
+		return k, nil, http.StatusInternalServerError, errors.New("could not create Kubernetes client from kubeconfig")
+	}
+	return k, kubeClient, 0, nil
+}
@@ -0,0 +1,186 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// PreviewDatabaseCluster returns the Kubernetes resources CreateDatabaseCluster would create.
+func (e *EverestServer) PreviewDatabaseCluster(ctx echo.Context, kubernetesID string) error {
+	dbc := &DatabaseCluster{}
+	if err := e.getBodyFromContext(ctx, dbc); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Could not get DatabaseCluster from the request body"),
+		})
+	}
+
+	if err := e.validateDatabaseClusterCR(ctx, kubernetesID, dbc); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	k, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	preview := &manifestsPreview{}
+	namespace := k.Namespace
+	plan, code, err := e.planProjectNamespace(ctx.Request().Context(), kubernetesID, kubeClient, dbc)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if plan != nil {
+		namespace = plan.name
+		if !plan.exists {
+			plan.namespace.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"}
+			preview.add(plan.namespace, false)
+			if plan.quota != nil {
+				plan.quota.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"}
+				preview.add(plan.quota, false)
+			}
+		}
+		// Backup storages and monitoring configs are created next to the database cluster.
+		kubeClient, err = kubernetes.NewFromSecretsStorage(ctx.Request().Context(), e.secretsStorage, kubernetesID, namespace, e.l)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString("Could not create Kubernetes client from kubeconfig"),
+			})
+		}
+	}
+
+	backupNames := make([]string, 0)
+	for name := range backupStorageNamesFrom(dbc) {
+		backupNames = append(backupNames, name)
+	}
+	sort.Strings(backupNames)
+	for _, name := range backupNames {
+		bs, err := e.storage.GetBackupStorage(ctx.Request().Context(), nil, name)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not find backup storage " + name)})
+		}
+		config, secret, exists, err := kubeClient.ConfigManifests(ctx.Request().Context(), bs, e.secretsStorage.GetSecret)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not preview backup storage " + name)})
+		}
+		config.GetObjectKind().SetGroupVersionKind(everestv1alpha1.GroupVersion.WithKind("BackupStorage"))
+		if secret != nil {
+			preview.add(secret, exists)
+		}
+		preview.add(config, exists)
+	}
+
+	if monitoringName := monitoringNameFrom(dbc); monitoringName != "" {
+		i, err := e.storage.GetMonitoringInstance(ctx.Request().Context(), monitoringName)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{
+				Message: pointer.ToString("Could not find monitoring instance"),
+			})
+		}
+		config, secret, exists, err := kubeClient.ConfigManifests(ctx.Request().Context(), i, e.secretsStorage.GetSecret)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not preview monitoring config")})
+		}
+		config.GetObjectKind().SetGroupVersionKind(everestv1alpha1.GroupVersion.WithKind("MonitoringConfig"))
+		preview.add(secret, exists)
+		preview.add(config, exists)
+	}
+
+	if dbc.Metadata == nil {
+		dbc.Metadata = &map[string]interface{}{}
+	}
+	(*dbc.Metadata)["namespace"] = namespace
+	dbc.ApiVersion = pointer.ToString(everestv1alpha1.GroupVersion.String())
+	dbc.Kind = pointer.ToString("DatabaseCluster")
+	preview.add(dbc, false)
+
+	if preview.err != nil {
+		e.l.Error(preview.err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not preview the manifests")})
+	}
+	res := DatabaseClusterPreview{Manifests: preview.manifests}
+
+	prices, code, err := e.kubernetesClusterPrices(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if prices != nil {
+		db := &everestv1alpha1.DatabaseCluster{}
+		if err := e.getBodyFromContext(ctx, db); err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusBadRequest, Error{
+				Message: pointer.ToString("Could not get DatabaseCluster from the request body"),
+			})
+		}
+		res.CostEstimate = pointer.To(estimateCost(db.Spec, *prices))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// manifestsPreview collects the manifests of a preview. The first error is kept and stops the collection.
+type manifestsPreview struct {
+	manifests []ManifestPreview
+	err       error
+}
+
+func (p *manifestsPreview) add(obj any, exists bool) {
+	if p.err != nil {
+		return
+	}
+	manifest, err := manifestOf(obj)
+	if err != nil {
+		p.err = err
+		return
+	}
+	p.manifests = append(p.manifests, ManifestPreview{Manifest: manifest, Exists: exists})
+}
+
+// manifestOf returns the JSON representation of a Kubernetes resource.
+func manifestOf(obj any) (map[string]interface{}, error) {
+	if o, ok := obj.(runtime.Object); ok {
+		res, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not convert the resource"))
+		}
+		return res, nil
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not marshal the resource"))
+	}
+	res := map[string]interface{}{}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return nil, errors.Join(err, errors.New("could not unmarshal the resource"))
+	}
+	return res, nil
+}
@@ -0,0 +1,54 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestManifestsPreview(t *testing.T) {
+	t.Parallel()
+
+	bs := &everestv1alpha1.BackupStorage{
+		ObjectMeta: metav1.ObjectMeta{Name: "s3", Namespace: "everest"},
+		Spec:       everestv1alpha1.BackupStorageSpec{Bucket: "backups"},
+	}
+	bs.GetObjectKind().SetGroupVersionKind(everestv1alpha1.GroupVersion.WithKind("BackupStorage"))
+	dbc := &DatabaseCluster{
+		Kind:     pointer.ToString("DatabaseCluster"),
+		Metadata: &map[string]interface{}{"name": "db"},
+	}
+
+	p := &manifestsPreview{}
+	p.add(bs, true)
+	p.add(dbc, false)
+	require.NoError(t, p.err)
+	require.Len(t, p.manifests, 2)
+
+	assert.True(t, p.manifests[0].Exists)
+	assert.Equal(t, "BackupStorage", p.manifests[0].Manifest["kind"])
+	assert.Equal(t, "backups", p.manifests[0].Manifest["spec"].(map[string]interface{})["bucket"])
+
+	assert.False(t, p.manifests[1].Exists)
+	assert.Equal(t, "DatabaseCluster", p.manifests[1].Manifest["kind"])
+	assert.Equal(t, "db", p.manifests[1].Manifest["metadata"].(map[string]interface{})["name"])
+}
@@ -32,7 +32,7 @@ import (
 )
 
 // ListDatabaseClusterRestores List of the created database cluster restores on the specified kubernetes cluster.
-func (e *EverestServer) ListDatabaseClusterRestores(ctx echo.Context, kubernetesID string, name string) error {
+func (e *EverestServer) ListDatabaseClusterRestores(ctx echo.Context, kubernetesID string, name string, _ ListDatabaseClusterRestoresParams) error {
 	req := ctx.Request()
 	if err := validateRFC1035(name, "name"); err != nil {
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
@@ -47,11 +47,11 @@ func (e *EverestServer) ListDatabaseClusterRestores(ctx echo.Context, kubernetes
 	path = strings.TrimSuffix(path, name)
 	path = strings.ReplaceAll(path, "database-clusters", "database-cluster-restores")
 	req.URL.Path = path
-	return e.proxyKubernetes(ctx, kubernetesID, "")
+	return e.proxyKubernetesModified(ctx, kubernetesID, "", "", e.restoreEstimates(kubernetesID))
 }
 
 // CreateDatabaseClusterRestore Create a database cluster restore on the specified kubernetes cluster.
-func (e *EverestServer) CreateDatabaseClusterRestore(ctx echo.Context, kubernetesID string) error {
+func (e *EverestServer) CreateDatabaseClusterRestore(ctx echo.Context, kubernetesID string, _ CreateDatabaseClusterRestoreParams) error {
 	restore := &DatabaseClusterRestore{}
 	if err := e.getBodyFromContext(ctx, restore); err != nil {
 		e.l.Error(err)
@@ -65,7 +65,7 @@ func (e *EverestServer) CreateDatabaseClusterRestore(ctx echo.Context, kubernete
 	}
 
 	if restore.Spec.DataSource.BackupSource != nil && restore.Spec.DataSource.BackupSource.BackupStorageName != "" {
-		_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+		_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx.Request().Context(), kubernetesID)
 		if err != nil {
 			return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 		}
@@ -85,12 +85,27 @@ func (e *EverestServer) CreateDatabaseClusterRestore(ctx echo.Context, kubernete
 		return err
 	}
 
-	return e.proxyKubernetes(ctx, kubernetesID, "")
+	if err := e.proxyKubernetes(ctx, kubernetesID, ""); err != nil {
+		return err
+	}
+
+	// At this point the proxy already sent a response to the API user.
+	// The creator is recorded for the restore history only if the restore was created.
+	if ctx.Response().Status >= http.StatusMultipleChoices {
+		return nil
+	}
+	if name, ok := pointer.Get(restore.Metadata)["name"].(string); ok {
+		e.emitWebhookEvent(ctx, DatabaseClusterRestoreCreated, kubernetesID, restoreAuditResource(name))
+		if err := e.recordAudit(ctx, auditActionRestoreCreated, kubernetesID, restoreAuditResource(name), ""); err != nil {
+			e.l.Error(err)
+		}
+	}
+	return nil
 }
 
 // DeleteDatabaseClusterRestore Delete the specified cluster restore on the specified kubernetes cluster.
-func (e *EverestServer) DeleteDatabaseClusterRestore(ctx echo.Context, kubernetesID string, name string) error {
-	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+func (e *EverestServer) DeleteDatabaseClusterRestore(ctx echo.Context, kubernetesID string, name string, _ DeleteDatabaseClusterRestoreParams) error {
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx.Request().Context(), kubernetesID)
 	if err != nil {
 		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 	}
@@ -113,6 +128,7 @@ func (e *EverestServer) DeleteDatabaseClusterRestore(ctx echo.Context, kubernete
 	if ctx.Response().Status >= http.StatusMultipleChoices {
 		return nil
 	}
+	e.emitWebhookEvent(ctx, DatabaseClusterRestoreDeleted, kubernetesID, restoreAuditResource(name))
 
 	if restore.Spec.DataSource.BackupSource != nil && restore.Spec.DataSource.BackupSource.BackupStorageName != "" {
 		bsNames := map[string]struct{}{
@@ -126,13 +142,13 @@ func (e *EverestServer) DeleteDatabaseClusterRestore(ctx echo.Context, kubernete
 }
 
 // GetDatabaseClusterRestore Returns the specified cluster restore on the specified kubernetes cluster.
-func (e *EverestServer) GetDatabaseClusterRestore(ctx echo.Context, kubernetesID string, name string) error {
-	return e.proxyKubernetes(ctx, kubernetesID, name)
+func (e *EverestServer) GetDatabaseClusterRestore(ctx echo.Context, kubernetesID string, name string, _ GetDatabaseClusterRestoreParams) error {
+	return e.proxyKubernetesModified(ctx, kubernetesID, "", name, e.restoreEstimates(kubernetesID))
 }
 
 // UpdateDatabaseClusterRestore Replace the specified cluster restore on the specified kubernetes cluster.
-func (e *EverestServer) UpdateDatabaseClusterRestore(ctx echo.Context, kubernetesID string, name string) error {
-	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+func (e *EverestServer) UpdateDatabaseClusterRestore(ctx echo.Context, kubernetesID string, name string, _ UpdateDatabaseClusterRestoreParams) error {
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx.Request().Context(), kubernetesID)
 	if err != nil {
 		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 	}
@@ -167,6 +183,10 @@ func (e *EverestServer) UpdateDatabaseClusterRestore(ctx echo.Context, kubernete
 	if proxyErr != nil {
 		return proxyErr
 	}
+	if ctx.Response().Status >= http.StatusMultipleChoices {
+		return nil
+	}
+	e.emitWebhookEvent(ctx, DatabaseClusterRestoreUpdated, kubernetesID, restoreAuditResource(name))
 
 	// if there were no changes in BackupStorageName field - do nothing
 	if oldRestore.Spec.DataSource.BackupSource == nil || newRestore.Spec.DataSource.BackupSource == nil ||
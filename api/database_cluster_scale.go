@@ -0,0 +1,189 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// pxcMinReplicas is the minimum number of nodes of a PXC cluster running more than one node.
+// Two nodes can not keep the quorum if one of them fails.
+const pxcMinReplicas = 3
+
+// ScaleDatabaseCluster sets the number of replicas of the database engine and of the proxy of the database cluster.
+func (e *EverestServer) ScaleDatabaseCluster(
+	ctx echo.Context, kubernetesID string, name string, query ScaleDatabaseClusterParams,
+) error {
+	var params DatabaseClusterScale
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	oldDB, err := kubeClient.GetDatabaseCluster(c, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString(fmt.Sprintf("DatabaseCluster '%s' is not found", name))})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+	if err := validateDatabaseClusterScale(oldDB, params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	db := scaledDatabaseCluster(oldDB, params)
+	// The scaled database cluster is validated like the updated one.
+	dbc, err := databaseClusterToAPI(db)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not validate the scaled database cluster")})
+	}
+	if err := e.validateDatabaseClusterCR(ctx, kubernetesID, dbc); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := validateDatabaseClusterOnUpdate(dbc, oldDB); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	quotas, err := e.quotasOf(c, oldDB.Annotations[ownerAnnotation], oldDB.Annotations[teamAnnotation])
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get quota")})
+	}
+	if code, err := e.checkQuotas(c, kubernetesID, quotas, oldDB, db); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	res := databaseClusterScaleToAPIJson(db)
+	if db.Spec.Engine.Replicas == oldDB.Spec.Engine.Replicas &&
+		pointer.GetInt32(db.Spec.Proxy.Replicas) == pointer.GetInt32(oldDB.Spec.Proxy.Replicas) {
+		return ctx.JSON(http.StatusOK, res)
+	}
+
+	deferred, code, err := e.deferToMaintenanceWindow(ctx, kubernetesID, name, pointer.GetBool(query.OverrideMaintenanceWindow))
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if deferred {
+		// The scaled database cluster is applied in the maintenance window like a deferred update.
+		payload, err := json.Marshal(db)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not defer scaling the database cluster")})
+		}
+		op, err := e.queuePendingOperation(ctx, kubernetesID, name, model.PendingOperationUpdateDatabaseCluster, string(payload))
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not defer scaling the database cluster")})
+		}
+		return ctx.JSON(http.StatusAccepted, pendingOperationToAPI(op))
+	}
+
+	if _, err := kubeClient.UpdateDatabaseCluster(c, db); err != nil {
+		if k8serrors.IsConflict(err) {
+			return ctx.JSON(http.StatusConflict, Error{
+				Message: pointer.ToString("The database cluster has changed while it was scaled. Retry scaling it"),
+			})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not scale the database cluster")})
+	}
+	e.emitWebhookEvent(ctx, DatabaseClusterUpdated, kubernetesID, "database-clusters/"+name)
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// validateDatabaseClusterScale checks the database engine supports the requested number of replicas.
+func validateDatabaseClusterScale(db *everestv1alpha1.DatabaseCluster, params DatabaseClusterScale) error {
+	if params.EngineReplicas == nil && params.ProxyReplicas == nil {
+		return errors.New("either engineReplicas or proxyReplicas shall be set")
+	}
+	if params.ProxyReplicas != nil && *params.ProxyReplicas < 1 {
+		return errors.New("proxyReplicas shall be positive")
+	}
+	if params.EngineReplicas == nil {
+		return nil
+	}
+
+	replicas := *params.EngineReplicas
+	if replicas < 1 {
+		return errors.New("engineReplicas shall be positive")
+	}
+	if replicas == 1 && db.Spec.Engine.Replicas > 1 {
+		// See validateDatabaseClusterOnUpdate.
+		return fmt.Errorf("cannot scale down %d node cluster to 1. The operation is not supported", db.Spec.Engine.Replicas)
+	}
+	switch db.Spec.Engine.Type {
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		if replicas%2 == 0 {
+			return fmt.Errorf("MongoDB replica sets need an odd number of members, got %d", replicas)
+		}
+	case everestv1alpha1.DatabaseEnginePXC:
+		if replicas != 1 && replicas < pxcMinReplicas {
+			return fmt.Errorf("PXC clusters need at least %d nodes, got %d", pxcMinReplicas, replicas)
+		}
+	case everestv1alpha1.DatabaseEnginePostgresql:
+	}
+	return nil
+}
+
+// scaledDatabaseCluster returns a copy of the database cluster with the requested number of replicas.
+func scaledDatabaseCluster(db *everestv1alpha1.DatabaseCluster, params DatabaseClusterScale) *everestv1alpha1.DatabaseCluster {
+	scaled := db.DeepCopy()
+	if params.EngineReplicas != nil {
+		scaled.Spec.Engine.Replicas = int32(*params.EngineReplicas)
+	}
+	if params.ProxyReplicas != nil {
+		scaled.Spec.Proxy.Replicas = pointer.ToInt32(int32(*params.ProxyReplicas))
+	}
+	return scaled
+}
+
+// databaseClusterToAPI converts the database cluster custom resource to the database cluster of the API.
+func databaseClusterToAPI(db *everestv1alpha1.DatabaseCluster) (*DatabaseCluster, error) {
+	b, err := json.Marshal(db)
+	if err != nil {
+		return nil, err
+	}
+	dbc := &DatabaseCluster{}
+	if err := json.Unmarshal(b, dbc); err != nil {
+		return nil, err
+	}
+	return dbc, nil
+}
+
+func databaseClusterScaleToAPIJson(db *everestv1alpha1.DatabaseCluster) DatabaseClusterScale {
+	res := DatabaseClusterScale{EngineReplicas: pointer.ToInt(int(db.Spec.Engine.Replicas))}
+	if db.Spec.Proxy.Replicas != nil {
+		res.ProxyReplicas = pointer.ToInt(int(*db.Spec.Proxy.Replicas))
+	}
+	return res
+}
@@ -0,0 +1,146 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestValidateDatabaseClusterScale(t *testing.T) {
+	t.Parallel()
+
+	cluster := func(engineType everestv1alpha1.EngineType, replicas int32) *everestv1alpha1.DatabaseCluster {
+		return &everestv1alpha1.DatabaseCluster{
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Type: engineType, Replicas: replicas},
+			},
+		}
+	}
+
+	cases := []struct {
+		name   string
+		db     *everestv1alpha1.DatabaseCluster
+		params DatabaseClusterScale
+		err    string
+	}{
+		{
+			name: "nothing to scale",
+			db:   cluster(everestv1alpha1.DatabaseEnginePXC, 3),
+			err:  "either engineReplicas or proxyReplicas shall be set",
+		},
+		{
+			name:   "no proxy",
+			db:     cluster(everestv1alpha1.DatabaseEnginePXC, 3),
+			params: DatabaseClusterScale{ProxyReplicas: pointer.ToInt(0)},
+			err:    "proxyReplicas shall be positive",
+		},
+		{
+			name:   "no engine",
+			db:     cluster(everestv1alpha1.DatabaseEnginePostgresql, 1),
+			params: DatabaseClusterScale{EngineReplicas: pointer.ToInt(0)},
+			err:    "engineReplicas shall be positive",
+		},
+		{
+			name:   "down to a single node",
+			db:     cluster(everestv1alpha1.DatabaseEnginePostgresql, 3),
+			params: DatabaseClusterScale{EngineReplicas: pointer.ToInt(1)},
+			err:    "cannot scale down 3 node cluster to 1. The operation is not supported",
+		},
+		{
+			name:   "even psmdb members",
+			db:     cluster(everestv1alpha1.DatabaseEnginePSMDB, 3),
+			params: DatabaseClusterScale{EngineReplicas: pointer.ToInt(4)},
+			err:    "MongoDB replica sets need an odd number of members, got 4",
+		},
+		{
+			name:   "odd psmdb members",
+			db:     cluster(everestv1alpha1.DatabaseEnginePSMDB, 3),
+			params: DatabaseClusterScale{EngineReplicas: pointer.ToInt(5)},
+		},
+		{
+			name:   "two pxc nodes",
+			db:     cluster(everestv1alpha1.DatabaseEnginePXC, 1),
+			params: DatabaseClusterScale{EngineReplicas: pointer.ToInt(2)},
+			err:    "PXC clusters need at least 3 nodes, got 2",
+		},
+		{
+			name:   "three pxc nodes",
+			db:     cluster(everestv1alpha1.DatabaseEnginePXC, 1),
+			params: DatabaseClusterScale{EngineReplicas: pointer.ToInt(3), ProxyReplicas: pointer.ToInt(2)},
+		},
+		{
+			name:   "two postgresql nodes",
+			db:     cluster(everestv1alpha1.DatabaseEnginePostgresql, 1),
+			params: DatabaseClusterScale{EngineReplicas: pointer.ToInt(2)},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validateDatabaseClusterScale(tc.db, tc.params)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestScaledDatabaseCluster(t *testing.T) {
+	t.Parallel()
+
+	db := &everestv1alpha1.DatabaseCluster{
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC, Replicas: 3},
+		},
+	}
+
+	scaled := scaledDatabaseCluster(db, DatabaseClusterScale{ProxyReplicas: pointer.ToInt(2)})
+	assert.Equal(t, DatabaseClusterScale{EngineReplicas: pointer.ToInt(3), ProxyReplicas: pointer.ToInt(2)}, databaseClusterScaleToAPIJson(scaled))
+	assert.Nil(t, db.Spec.Proxy.Replicas, "the database cluster is copied")
+
+	scaled = scaledDatabaseCluster(db, DatabaseClusterScale{EngineReplicas: pointer.ToInt(5)})
+	assert.Equal(t, DatabaseClusterScale{EngineReplicas: pointer.ToInt(5)}, databaseClusterScaleToAPIJson(scaled))
+	assert.Equal(t, int32(3), db.Spec.Engine.Replicas)
+}
+
+func TestScaledDatabaseClusterGuardrail(t *testing.T) {
+	t.Parallel()
+
+	db := &everestv1alpha1.DatabaseCluster{
+		Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{Type: everestv1alpha1.DatabaseEnginePXC, Replicas: 3},
+		},
+	}
+	g := &model.Guardrail{EngineType: string(everestv1alpha1.DatabaseEnginePXC), MaxReplicas: 3, AllowedStorageClasses: "[]"}
+
+	dbc, err := databaseClusterToAPI(scaledDatabaseCluster(db, DatabaseClusterScale{EngineReplicas: pointer.ToInt(3)}))
+	require.NoError(t, err)
+	require.NoError(t, checkGuardrail(g, dbc))
+
+	dbc, err = databaseClusterToAPI(scaledDatabaseCluster(db, DatabaseClusterScale{EngineReplicas: pointer.ToInt(5)}))
+	require.NoError(t, err)
+	assert.EqualError(t, checkGuardrail(g, dbc), "pxc database clusters may have at most 3 replicas")
+}
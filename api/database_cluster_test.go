@@ -0,0 +1,143 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFilterDatabaseClusters(t *testing.T) {
+	t.Parallel()
+
+	newDB := func(name string, engineType everestv1alpha1.EngineType, state everestv1alpha1.AppState) everestv1alpha1.DatabaseCluster {
+		return everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: everestv1alpha1.DatabaseClusterSpec{
+				Engine: everestv1alpha1.Engine{Type: engineType},
+			},
+			Status: everestv1alpha1.DatabaseClusterStatus{Status: state},
+		}
+	}
+	dbs := []everestv1alpha1.DatabaseCluster{
+		newDB("pxc-ready", everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateReady),
+		newDB("psmdb-ready", everestv1alpha1.DatabaseEnginePSMDB, everestv1alpha1.AppStateReady),
+		newDB("psmdb-error", everestv1alpha1.DatabaseEnginePSMDB, everestv1alpha1.AppStateError),
+	}
+
+	type tCase struct {
+		name       string
+		engineType string
+		state      string
+		expected   []string
+	}
+
+	cases := []tCase{
+		{
+			name:     "no filters",
+			expected: []string{"pxc-ready", "psmdb-ready", "psmdb-error"},
+		},
+		{
+			name:       "engine type",
+			engineType: "psmdb",
+			expected:   []string{"psmdb-ready", "psmdb-error"},
+		},
+		{
+			name:     "state",
+			state:    "ready",
+			expected: []string{"pxc-ready", "psmdb-ready"},
+		},
+		{
+			name:       "engine type and state",
+			engineType: "psmdb",
+			state:      "error",
+			expected:   []string{"psmdb-error"},
+		},
+		{
+			name:       "no matches",
+			engineType: "postgresql",
+			expected:   []string{},
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res := filterDatabaseClusters(dbs, tc.engineType, tc.state)
+			names := make([]string, 0, len(res))
+			for _, db := range res {
+				names = append(names, db.Name)
+			}
+			require.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestSetDefaultMonitoring(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		obj     map[string]interface{}
+		changed bool
+		result  map[string]interface{}
+	}{
+		{
+			name:    "no spec",
+			obj:     map[string]interface{}{},
+			changed: false,
+			result:  map[string]interface{}{},
+		},
+		{
+			name:    "no monitoring",
+			obj:     map[string]interface{}{"spec": map[string]interface{}{}},
+			changed: true,
+			result: map[string]interface{}{"spec": map[string]interface{}{
+				"monitoring": map[string]interface{}{"monitoringConfigName": "pmm"},
+			}},
+		},
+		{
+			name:    "opted out",
+			obj:     map[string]interface{}{"spec": map[string]interface{}{"monitoring": map[string]interface{}{}}},
+			changed: false,
+			result:  map[string]interface{}{"spec": map[string]interface{}{"monitoring": map[string]interface{}{}}},
+		},
+		{
+			name: "other monitoring",
+			obj: map[string]interface{}{"spec": map[string]interface{}{
+				"monitoring": map[string]interface{}{"monitoringConfigName": "other"},
+			}},
+			changed: false,
+			result: map[string]interface{}{"spec": map[string]interface{}{
+				"monitoring": map[string]interface{}{"monitoringConfigName": "other"},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.changed, setDefaultMonitoring(tc.obj, "pmm"))
+			require.Equal(t, tc.result, tc.obj)
+		})
+	}
+}
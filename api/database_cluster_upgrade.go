@@ -0,0 +1,580 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+	"github.com/percona/percona-everest-backend/pkg/versionservice"
+)
+
+const engineUpgradePollInterval = 10 * time.Second
+
+// Actions of the database engine upgrade plan.
+const (
+	upgradeActionOperator = "upgrade-operator"
+	upgradeActionEngine   = "upgrade-engine"
+)
+
+// failedBackupStates contains the lowercased states reported by the operators for failed backups.
+var failedBackupStates = map[string]struct{}{ //nolint:gochecknoglobals
+	"failed": {},
+	"error":  {},
+}
+
+// UpgradeDatabaseClusterEngine upgrades the database engine of the specified database cluster in place.
+func (e *EverestServer) UpgradeDatabaseClusterEngine(
+	ctx echo.Context, kubernetesID string, name string, query UpgradeDatabaseClusterEngineParams,
+) error {
+	var params DatabaseClusterUpgradeRequest
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	u, err := e.storage.GetEngineUpgrade(c, kubernetesID, name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database engine upgrade")})
+	}
+	if err == nil && u.State == model.EngineUpgradeStateDeferred {
+		return ctx.JSON(http.StatusConflict, Error{
+			Message: pointer.ToString("The database engine upgrade is deferred to the maintenance window, cancel the pending operation first"),
+		})
+	}
+	// An upgrade which has not been updated for longer than the timeout was interrupted by a restart.
+	if err == nil && u.InProgress() && time.Since(u.UpdatedAt) < e.config.EngineUpgradeTimeout {
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("The database engine is being upgraded")})
+	}
+
+	db, engine, code, err := e.upgradedDatabaseCluster(c, kubeClient, kubernetesID, name)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	operatorVersions, code, err := e.planEngineUpgrade(c, kubeClient, engine, db, params.TargetVersion)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if len(operatorVersions) != 0 && !pointer.GetBool(params.UpgradeOperator) {
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(fmt.Sprintf(
+			"%s version %s requires upgrading the %s operator to %s first, set upgradeOperator to upgrade it together with the database engine",
+			engine.Spec.Type, params.TargetVersion, engine.Spec.Type, strings.Join(operatorVersions, ", then "),
+		))})
+	}
+	if params.BackupStorageName != nil {
+		if _, err := e.storage.GetBackupStorage(c, nil, *params.BackupStorageName); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ctx.JSON(http.StatusBadRequest, Error{
+					Message: pointer.ToString(fmt.Sprintf("BackupStorage '%s' is not found", *params.BackupStorageName)),
+				})
+			}
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup storage")})
+		}
+	}
+
+	versions, err := json.Marshal(append([]string{}, operatorVersions...))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save database engine upgrade")})
+	}
+	u = &model.EngineUpgrade{
+		KubernetesID:      kubernetesID,
+		DBClusterName:     name,
+		FromVersion:       db.Spec.Engine.Version,
+		TargetVersion:     params.TargetVersion,
+		OperatorVersions:  string(versions),
+		BackupStorageName: pointer.GetString(params.BackupStorageName),
+		State:             model.EngineUpgradeStatePending,
+		CreatedAt:         time.Now().UTC(),
+	}
+	if err := e.recordAudit(ctx, auditActionEngineUpgrade, kubernetesID, "database-clusters/"+name,
+		fmt.Sprintf("%s -> %s", u.FromVersion, u.TargetVersion)); err != nil {
+		e.l.Error(err)
+	}
+
+	deferred, code, err := e.deferToMaintenanceWindow(ctx, kubernetesID, name, pointer.GetBool(query.OverrideMaintenanceWindow))
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if deferred {
+		u.State = model.EngineUpgradeStateDeferred
+		if err := e.storage.SaveEngineUpgrade(c, u); err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save database engine upgrade")})
+		}
+		if _, err := e.queuePendingOperation(ctx, kubernetesID, name, model.PendingOperationUpgradeEngine, ""); err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not defer the database engine upgrade")})
+		}
+		return ctx.JSON(http.StatusAccepted, engineUpgradeToAPIJson(u))
+	}
+
+	async, err := e.startEngineUpgrade(c, kubeClient, u)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not upgrade the database engine")})
+	}
+	if async {
+		return ctx.JSON(http.StatusAccepted, engineUpgradeToAPIJson(u))
+	}
+	return ctx.JSON(http.StatusOK, engineUpgradeToAPIJson(u))
+}
+
+// startEngineUpgrade applies the upgrade right away if it needs neither an operator upgrade nor a backup.
+// Otherwise, it runs the upgrade in the background and returns true.
+func (e *EverestServer) startEngineUpgrade(ctx context.Context, kubeClient *kubernetes.Kubernetes, u *model.EngineUpgrade) (bool, error) {
+	if u.OperatorVersions == "[]" && u.BackupStorageName == "" {
+		if err := applyEngineUpgrade(ctx, kubeClient, u.DBClusterName, u.TargetVersion); err != nil {
+			return false, err
+		}
+		now := time.Now().UTC()
+		u.State = model.EngineUpgradeStateCompleted
+		u.FinishedAt = &now
+		if err := e.storage.SaveEngineUpgrade(ctx, u); err != nil {
+			e.l.Error(err)
+		}
+		return false, nil
+	}
+
+	u.State = model.EngineUpgradeStatePending
+	if err := e.storage.SaveEngineUpgrade(ctx, u); err != nil {
+		return false, errors.Join(err, errors.New("could not save database engine upgrade"))
+	}
+	e.waitGroup.Add(1)
+	go e.runEngineUpgrade(kubeClient, u)
+	return true, nil
+}
+
+// GetDatabaseClusterEngineUpgrade returns the progress of the latest database engine upgrade of a database cluster.
+func (e *EverestServer) GetDatabaseClusterEngineUpgrade(ctx echo.Context, kubernetesID string, name string, _ GetDatabaseClusterEngineUpgradeParams) error {
+	u, err := e.storage.GetEngineUpgrade(ctx.Request().Context(), kubernetesID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("The database engine has not been upgraded")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database engine upgrade")})
+	}
+
+	return ctx.JSON(http.StatusOK, engineUpgradeToAPIJson(u))
+}
+
+// GetDatabaseClusterEngineUpgradePlan lists the steps of upgrading the database engine to the target version.
+func (e *EverestServer) GetDatabaseClusterEngineUpgradePlan(
+	ctx echo.Context, kubernetesID string, name string, params GetDatabaseClusterEngineUpgradePlanParams,
+) error {
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	db, engine, code, err := e.upgradedDatabaseCluster(c, kubeClient, kubernetesID, name)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	operatorVersions, code, err := e.planEngineUpgrade(c, kubeClient, engine, db, params.TargetVersion)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	steps := make([]DatabaseClusterUpgradeStep, 0, len(operatorVersions)+1)
+	for _, v := range operatorVersions {
+		steps = append(steps, DatabaseClusterUpgradeStep{Action: upgradeActionOperator, TargetVersion: v})
+	}
+	steps = append(steps, DatabaseClusterUpgradeStep{Action: upgradeActionEngine, TargetVersion: params.TargetVersion})
+	return ctx.JSON(http.StatusOK, DatabaseClusterUpgradePlan{Steps: steps})
+}
+
+// upgradedDatabaseCluster returns the database cluster and its database engine.
+func (e *EverestServer) upgradedDatabaseCluster(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, kubernetesID, name string,
+) (*everestv1alpha1.DatabaseCluster, *everestv1alpha1.DatabaseEngine, int, error) {
+	db, err := kubeClient.GetDatabaseCluster(ctx, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil, http.StatusNotFound, fmt.Errorf("DatabaseCluster '%s' is not found", name)
+		}
+		e.l.Error(err)
+		return nil, nil, http.StatusInternalServerError, errors.New("could not get database cluster")
+	}
+
+	engineName, ok := operatorEngine[db.Spec.Engine.Type]
+	if !ok {
+		return nil, nil, http.StatusBadRequest, errors.New("unsupported database engine")
+	}
+	cached, err := e.cachedDatabaseEngine(ctx, kubernetesID, engineName)
+	if err != nil {
+		e.l.Error(err)
+		return nil, nil, http.StatusInternalServerError, errors.New("could not get database engine")
+	}
+	engine, err := cached.K8sResource("")
+	if err != nil {
+		e.l.Error(err)
+		return nil, nil, http.StatusInternalServerError, errors.New("could not get database engine")
+	}
+	return db, engine, 0, nil
+}
+
+// planEngineUpgrade checks the database engine can be upgraded to the target version and returns
+// the versions the operator shall be upgraded to beforehand if the installed operator does not support it.
+func (e *EverestServer) planEngineUpgrade(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes,
+	engine *everestv1alpha1.DatabaseEngine, db *everestv1alpha1.DatabaseCluster, targetVersion string,
+) ([]string, int, error) {
+	var operatorVersions []string
+	if err := validateVersion(&targetVersion, engine); err != nil {
+		if len(engine.Spec.AllowedVersions) != 0 {
+			return nil, http.StatusBadRequest, err
+		}
+		// The version may be supported by a newer operator.
+		path, pathErr := e.operatorVersionsFor(ctx, engine, targetVersion)
+		if pathErr != nil {
+			e.l.Debug(pathErr)
+			return nil, http.StatusBadRequest, err
+		}
+		operatorVersions = path
+	} else if err := e.validateVersionService(ctx, &targetVersion, engine); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	problems := engineUpgradeProblems(engine, db, targetVersion)
+	if len(operatorVersions) != 0 {
+		dbs, err := kubeClient.ListDatabaseClusters(ctx)
+		if err != nil {
+			e.l.Error(err)
+			return nil, http.StatusInternalServerError, errors.New("could not list database clusters")
+		}
+		// The following operator upgrades are checked by the path already.
+		p, err := operatorUpgradeProblems(string(engine.Spec.Type), engine.Status.OperatorVersion, operatorVersions[0], dbs.Items)
+		if err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+		problems = append(problems, p...)
+	}
+	if len(problems) != 0 {
+		return nil, http.StatusConflict, errors.New("the database engine cannot be upgraded: " + strings.Join(problems, "; "))
+	}
+	return operatorVersions, 0, nil
+}
+
+// operatorVersionsFor returns the operator versions supporting the engine version to upgrade the operator through.
+func (e *EverestServer) operatorVersionsFor(ctx context.Context, engine *everestv1alpha1.DatabaseEngine, engineVersion string) ([]string, error) {
+	product, ok := versionservice.Products[engine.Spec.Type]
+	if !ok || !e.versionService.Enabled() || engine.Status.OperatorVersion == "" {
+		return nil, errors.New("operator releases are unknown")
+	}
+	releases, err := e.versionService.Releases(ctx, product.Operator)
+	if err != nil {
+		return nil, err
+	}
+	return operatorUpgradePath(releases, product.Component, engine.Status.OperatorVersion, engineVersion)
+}
+
+// operatorUpgradePath returns the operator versions to upgrade the operator through, in order, to the first
+// release supporting the engine version. Percona operators are upgraded one minor version at a time,
+// so the latest release of every minor version in between is picked.
+func operatorUpgradePath(releases map[string]versionservice.Matrix, component, currentOperator, engineVersion string) ([]string, error) {
+	current, err := goversion.NewVersion(currentOperator)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the operator version '%s'", currentOperator)
+	}
+	cur := current.Segments()
+
+	var newer goversion.Collection
+	for release := range releases {
+		v, err := goversion.NewVersion(release)
+		if err != nil || !v.GreaterThan(current) || v.Segments()[0] != cur[0] {
+			continue
+		}
+		newer = append(newer, v)
+	}
+	sort.Sort(newer)
+
+	var required *goversion.Version
+	latest := make(map[int]*goversion.Version)
+	for _, v := range newer {
+		if c, ok := releases[v.Original()][component][engineVersion]; ok && c.Status != versionservice.StatusDisabled {
+			required = v
+			break
+		}
+		latest[v.Segments()[1]] = v
+	}
+	if required == nil {
+		return nil, fmt.Errorf("no operator release supports version %s", engineVersion)
+	}
+
+	var path []string
+	for minor := cur[1] + 1; minor < required.Segments()[1]; minor++ {
+		v, ok := latest[minor]
+		if !ok {
+			return nil, fmt.Errorf("there is no %d.%d operator release to upgrade through", cur[0], minor)
+		}
+		path = append(path, v.Original())
+	}
+	return append(path, required.Original()), nil
+}
+
+// runEngineUpgrade upgrades the operator and takes the backup if requested before it upgrades
+// the database engine, and persists the progress.
+func (e *EverestServer) runEngineUpgrade(kubeClient *kubernetes.Kubernetes, u *model.EngineUpgrade) {
+	defer e.waitGroup.Done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.config.EngineUpgradeTimeout)
+	defer cancel()
+
+	err := e.upgradeEngine(ctx, kubeClient, u)
+	now := time.Now().UTC()
+	u.FinishedAt = &now
+	u.State = model.EngineUpgradeStateCompleted
+	if err != nil {
+		e.l.Error(errors.Join(err, fmt.Errorf("could not upgrade database cluster %s to %s", u.DBClusterName, u.TargetVersion)))
+		u.State = model.EngineUpgradeStateFailed
+		u.Message = err.Error()
+	}
+	// The upgrade context may have expired already.
+	if err := e.storage.SaveEngineUpgrade(context.Background(), u); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not save database engine upgrade")))
+	}
+
+	if u.OperatorVersions != "[]" {
+		if _, err := e.refreshDatabaseEngines(context.Background(), u.KubernetesID); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not refresh database engines")))
+		}
+	}
+}
+
+func (e *EverestServer) upgradeEngine(ctx context.Context, kubeClient *kubernetes.Kubernetes, u *model.EngineUpgrade) error {
+	var operatorVersions []string
+	if err := json.Unmarshal([]byte(u.OperatorVersions), &operatorVersions); err != nil {
+		return errors.Join(err, errors.New("could not decode operator versions"))
+	}
+	db, err := kubeClient.GetDatabaseCluster(ctx, u.DBClusterName)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get database cluster"))
+	}
+
+	for _, v := range operatorVersions {
+		if err := e.setEngineUpgradeState(ctx, u, model.EngineUpgradeStateUpgradingOperator); err != nil {
+			return err
+		}
+		if err := kubeClient.UpgradeOperator(ctx, string(db.Spec.Engine.Type), v); err != nil {
+			return errors.Join(err, fmt.Errorf("could not upgrade the operator to %s", v))
+		}
+		if err := waitForEngineOperator(ctx, kubeClient, operatorEngine[db.Spec.Engine.Type], v); err != nil {
+			return err
+		}
+	}
+
+	if u.BackupStorageName != "" {
+		if err := e.setEngineUpgradeState(ctx, u, model.EngineUpgradeStateWaitingForBackup); err != nil {
+			return err
+		}
+		if err := e.createK8SBackupStorages(ctx, kubeClient, map[string]struct{}{u.BackupStorageName: {}}); err != nil {
+			return err
+		}
+		backup, err := kubeClient.CreateDatabaseClusterBackup(ctx, &everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-pre-upgrade-%d", u.DBClusterName, time.Now().Unix())},
+			Spec: everestv1alpha1.DatabaseClusterBackupSpec{
+				DBClusterName:     u.DBClusterName,
+				BackupStorageName: u.BackupStorageName,
+			},
+		})
+		if err != nil {
+			return errors.Join(err, errors.New("could not create backup"))
+		}
+		u.BackupName = backup.Name
+		if err := e.storage.SaveEngineUpgrade(ctx, u); err != nil {
+			return errors.Join(err, errors.New("could not save database engine upgrade"))
+		}
+		if err := waitForBackup(ctx, kubeClient, backup.Name); err != nil {
+			return err
+		}
+	}
+
+	if err := e.setEngineUpgradeState(ctx, u, model.EngineUpgradeStateUpgradingEngine); err != nil {
+		return err
+	}
+	return applyEngineUpgrade(ctx, kubeClient, u.DBClusterName, u.TargetVersion)
+}
+
+func (e *EverestServer) setEngineUpgradeState(ctx context.Context, u *model.EngineUpgrade, state string) error {
+	u.State = state
+	if err := e.storage.SaveEngineUpgrade(ctx, u); err != nil {
+		return errors.Join(err, errors.New("could not save database engine upgrade"))
+	}
+	return nil
+}
+
+// waitForEngineOperator waits for the database engine to report the operator version.
+// The everest operator updates the status of the database engine once the operator is rolled out.
+func waitForEngineOperator(ctx context.Context, kubeClient *kubernetes.Kubernetes, engineName, version string) error {
+	ticker := time.NewTicker(engineUpgradePollInterval)
+	defer ticker.Stop()
+
+	for {
+		engine, err := kubeClient.GetDatabaseEngine(ctx, engineName)
+		if err != nil {
+			return errors.Join(err, fmt.Errorf("could not get database engine %s", engineName))
+		}
+		if engine.Status.OperatorVersion == version {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the operator has not been upgraded to %s: %w", version, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForBackup waits for the backup to succeed.
+func waitForBackup(ctx context.Context, kubeClient *kubernetes.Kubernetes, name string) error {
+	ticker := time.NewTicker(engineUpgradePollInterval)
+	defer ticker.Stop()
+
+	for {
+		backup, err := kubeClient.GetDatabaseClusterBackup(ctx, name)
+		if err != nil {
+			return errors.Join(err, fmt.Errorf("could not get backup %s", name))
+		}
+		state := strings.ToLower(string(backup.Status.State))
+		if _, ok := successfulBackupStates[state]; ok {
+			return nil
+		}
+		if _, ok := failedBackupStates[state]; ok {
+			return fmt.Errorf("backup %s has failed", name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("backup %s has not succeeded: %w", name, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func applyEngineUpgrade(ctx context.Context, kubeClient *kubernetes.Kubernetes, name, targetVersion string) error {
+	db, err := kubeClient.GetDatabaseCluster(ctx, name)
+	if err != nil {
+		return err
+	}
+	db.Spec.Engine.Version = targetVersion
+	_, err = kubeClient.UpdateDatabaseCluster(ctx, db)
+	return err
+}
+
+func engineUpgradeToAPIJson(u *model.EngineUpgrade) DatabaseClusterUpgrade {
+	var operatorVersions []string
+	_ = json.Unmarshal([]byte(u.OperatorVersions), &operatorVersions)
+	return DatabaseClusterUpgrade{
+		FromVersion:      u.FromVersion,
+		TargetVersion:    u.TargetVersion,
+		OperatorVersions: &operatorVersions,
+		BackupName:       pointer.ToStringOrNil(u.BackupName),
+		State:            u.State,
+		Message:          pointer.ToStringOrNil(u.Message),
+		FinishedAt:       u.FinishedAt,
+	}
+}
+
+// engineUpgradeProblems returns the reasons the database engine cannot be upgraded to the target version.
+// Downgrades are not supported by the engines, and the upgrades shall go through every major version
+// in turn. MySQL and MongoDB release major versions as X.Y while PostgreSQL does it as X.
+func engineUpgradeProblems(engine *everestv1alpha1.DatabaseEngine, db *everestv1alpha1.DatabaseCluster, targetVersion string) []string {
+	var problems []string
+	if db.Status.Status != everestv1alpha1.AppStateReady {
+		state := db.Status.Status
+		if state == "" {
+			state = everestv1alpha1.AppStateUnknown
+		}
+		problems = append(problems, fmt.Sprintf("the database cluster is %s", state))
+	}
+
+	target, err := goversion.NewVersion(targetVersion)
+	if err != nil {
+		return append(problems, fmt.Sprintf("invalid target version '%s'", targetVersion))
+	}
+	current, err := goversion.NewVersion(db.Spec.Engine.Version)
+	if err != nil {
+		return append(problems, fmt.Sprintf("could not parse the current version '%s'", db.Spec.Engine.Version))
+	}
+	if !target.GreaterThan(current) {
+		return append(problems, fmt.Sprintf("the target version %s is not newer than the current version %s", targetVersion, db.Spec.Engine.Version))
+	}
+
+	versions := append(engine.Status.AvailableVersions.Engine.GetSortedVersions(), engine.Spec.AllowedVersions...)
+	lines := majorLines(engine.Spec.Type, append(versions, db.Spec.Engine.Version, targetVersion))
+	from := slices.Index(lines, majorLine(engine.Spec.Type, current))
+	to := slices.Index(lines, majorLine(engine.Spec.Type, target))
+	if to > from+1 {
+		problems = append(problems, fmt.Sprintf("the database engine shall be upgraded to %s first", lines[from+1]))
+	}
+	return problems
+}
+
+// majorLines returns the sorted unique major versions of the given versions.
+func majorLines(engineType everestv1alpha1.EngineType, versions []string) []string {
+	unique := make(map[string]struct{})
+	for _, v := range versions {
+		version, err := goversion.NewVersion(v)
+		if err != nil {
+			continue
+		}
+		unique[majorLine(engineType, version)] = struct{}{}
+	}
+
+	lines := make([]string, 0, len(unique))
+	for line := range unique {
+		lines = append(lines, line)
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		return versionGreater(lines[j], lines[i])
+	})
+	return lines
+}
+
+func majorLine(engineType everestv1alpha1.EngineType, v *goversion.Version) string {
+	s := v.Segments()
+	if engineType == everestv1alpha1.DatabaseEnginePostgresql {
+		return fmt.Sprintf("%d", s[0])
+	}
+	return fmt.Sprintf("%d.%d", s[0], s[1])
+}
@@ -0,0 +1,182 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/percona-everest-backend/pkg/versionservice"
+)
+
+func TestEngineUpgradeProblems(t *testing.T) {
+	t.Parallel()
+
+	engine := func(engineType everestv1alpha1.EngineType, versions ...string) *everestv1alpha1.DatabaseEngine {
+		available := everestv1alpha1.ComponentsMap{}
+		for _, v := range versions {
+			available[v] = &everestv1alpha1.Component{}
+		}
+		return &everestv1alpha1.DatabaseEngine{
+			Spec:   everestv1alpha1.DatabaseEngineSpec{Type: engineType},
+			Status: everestv1alpha1.DatabaseEngineStatus{AvailableVersions: everestv1alpha1.Versions{Engine: available}},
+		}
+	}
+	db := func(engineType everestv1alpha1.EngineType, version string, state everestv1alpha1.AppState) *everestv1alpha1.DatabaseCluster {
+		return &everestv1alpha1.DatabaseCluster{
+			Spec:   everestv1alpha1.DatabaseClusterSpec{Engine: everestv1alpha1.Engine{Type: engineType, Version: version}},
+			Status: everestv1alpha1.DatabaseClusterStatus{Status: state},
+		}
+	}
+	psmdb := engine(everestv1alpha1.DatabaseEnginePSMDB, "4.4.24-23", "5.0.20-17", "6.0.9-7", "6.0.5-4")
+	pg := engine(everestv1alpha1.DatabaseEnginePostgresql, "13.11", "14.9", "15.4", "15.2")
+
+	type tCase struct {
+		name     string
+		engine   *everestv1alpha1.DatabaseEngine
+		db       *everestv1alpha1.DatabaseCluster
+		target   string
+		problems []string
+	}
+
+	cases := []tCase{
+		{
+			name:   "minor upgrade",
+			engine: psmdb,
+			db:     db(everestv1alpha1.DatabaseEnginePSMDB, "6.0.5-4", everestv1alpha1.AppStateReady),
+			target: "6.0.9-7",
+		},
+		{
+			name:   "next major version",
+			engine: psmdb,
+			db:     db(everestv1alpha1.DatabaseEnginePSMDB, "5.0.20-17", everestv1alpha1.AppStateReady),
+			target: "6.0.9-7",
+		},
+		{
+			name:     "major version skipped",
+			engine:   psmdb,
+			db:       db(everestv1alpha1.DatabaseEnginePSMDB, "4.4.24-23", everestv1alpha1.AppStateReady),
+			target:   "6.0.9-7",
+			problems: []string{"the database engine shall be upgraded to 5.0 first"},
+		},
+		{
+			name:     "downgrade",
+			engine:   psmdb,
+			db:       db(everestv1alpha1.DatabaseEnginePSMDB, "6.0.9-7", everestv1alpha1.AppStateReady),
+			target:   "6.0.5-4",
+			problems: []string{"the target version 6.0.5-4 is not newer than the current version 6.0.9-7"},
+		},
+		{
+			name:   "postgresql major version",
+			engine: pg,
+			db:     db(everestv1alpha1.DatabaseEnginePostgresql, "14.9", everestv1alpha1.AppStateReady),
+			target: "15.4",
+		},
+		{
+			name:     "postgresql major version skipped",
+			engine:   pg,
+			db:       db(everestv1alpha1.DatabaseEnginePostgresql, "13.11", everestv1alpha1.AppStateReady),
+			target:   "15.2",
+			problems: []string{"the database engine shall be upgraded to 14 first"},
+		},
+		{
+			name:     "not ready",
+			engine:   pg,
+			db:       db(everestv1alpha1.DatabaseEnginePostgresql, "15.2", everestv1alpha1.AppStateInit),
+			target:   "15.4",
+			problems: []string{"the database cluster is initializing"},
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.problems, engineUpgradeProblems(tc.engine, tc.db, tc.target))
+		})
+	}
+}
+
+func TestOperatorUpgradePath(t *testing.T) {
+	t.Parallel()
+
+	release := func(versions ...string) versionservice.Matrix {
+		mongod := map[string]versionservice.Component{}
+		for _, v := range versions {
+			mongod[v] = versionservice.Component{Status: versionservice.StatusAvailable}
+		}
+		return versionservice.Matrix{"mongod": mongod}
+	}
+	releases := map[string]versionservice.Matrix{
+		"1.13.0": release("5.0.15-13", "6.0.4-3"),
+		"1.14.0": release("5.0.15-13", "6.0.5-4"),
+		"1.14.1": release("5.0.15-13", "6.0.5-4"),
+		"1.15.0": release("6.0.9-7", "7.0.2-1"),
+		"2.0.0":  release("7.0.2-1", "8.0.1-1"),
+	}
+
+	type tCase struct {
+		name     string
+		operator string
+		version  string
+		path     []string
+		err      string
+	}
+
+	cases := []tCase{
+		{
+			name:     "next minor release",
+			operator: "1.14.1",
+			version:  "7.0.2-1",
+			path:     []string{"1.15.0"},
+		},
+		{
+			name:     "through the latest patch releases",
+			operator: "1.13.0",
+			version:  "6.0.9-7",
+			path:     []string{"1.14.1", "1.15.0"},
+		},
+		{
+			name:     "first supporting release",
+			operator: "1.13.0",
+			version:  "6.0.5-4",
+			path:     []string{"1.14.0"},
+		},
+		{
+			name:     "major release",
+			operator: "1.15.0",
+			version:  "8.0.1-1",
+			err:      "no operator release supports version 8.0.1-1",
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			path, err := operatorUpgradePath(releases, "mongod", tc.operator, tc.version)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.path, path)
+		})
+	}
+}
@@ -16,11 +16,111 @@
 // Package api ...
 package api
 
-import "github.com/labstack/echo/v4"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+var errDatabaseEngineNotFound = errors.New("database engine not found")
 
 // ListDatabaseEngines List of the available database engines on the specified kubernetes cluster.
 func (e *EverestServer) ListDatabaseEngines(ctx echo.Context, kubernetesID string) error {
-	return e.proxyKubernetes(ctx, kubernetesID, "")
+	c := ctx.Request().Context()
+	k, err := e.storage.GetKubernetesCluster(c, kubernetesID)
+	if err != nil {
+		e.l.Error(err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Kubernetes cluster not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not find Kubernetes cluster")})
+	}
+
+	engines, err := e.cachedDatabaseEngines(c, kubernetesID)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database engines")})
+	}
+
+	list := &everestv1alpha1.DatabaseEngineList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: everestv1alpha1.GroupVersion.String(),
+			Kind:       "DatabaseEngineList",
+		},
+		Items: make([]everestv1alpha1.DatabaseEngine, 0, len(engines)),
+	}
+	for _, engine := range engines {
+		item, err := engine.K8sResource(k.Namespace)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database engines")})
+		}
+		list.Items = append(list.Items, *item)
+	}
+
+	return ctx.JSON(http.StatusOK, list)
+}
+
+// ListDatabaseEngineVersions lists the versions of an engine type available on the specified kubernetes cluster.
+func (e *EverestServer) ListDatabaseEngineVersions(ctx echo.Context, kubernetesID string, engineType string) error {
+	engines, err := e.cachedDatabaseEngines(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database engines")})
+	}
+
+	for _, engine := range engines {
+		if engine.Type != engineType {
+			continue
+		}
+		item, err := engine.K8sResource("")
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database engine")})
+		}
+		return ctx.JSON(http.StatusOK, databaseEngineVersions(item))
+	}
+
+	return ctx.JSON(http.StatusNotFound, Error{
+		Message: pointer.ToString(fmt.Sprintf("Database engine %s is not installed", engineType)),
+	})
+}
+
+// databaseEngineVersions returns the available engine versions starting with the most recent one.
+func databaseEngineVersions(engine *everestv1alpha1.DatabaseEngine) DatabaseEngineVersions {
+	versions := make([]DatabaseEngineVersion, 0, len(engine.Status.AvailableVersions.Engine))
+	for v, c := range engine.Status.AvailableVersions.Engine {
+		_, tag := kubernetes.SplitImage(c.ImagePath)
+		versions = append(versions, DatabaseEngineVersion{
+			Version:   v,
+			ImagePath: c.ImagePath,
+			ImageTag:  tag,
+			Status:    pointer.ToStringOrNil(string(c.Status)),
+			Critical:  pointer.ToBool(c.Critical),
+			Allowed:   len(engine.Spec.AllowedVersions) == 0 || containsVersion(v, engine.Spec.AllowedVersions),
+		})
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versionGreater(versions[i].Version, versions[j].Version)
+	})
+
+	return DatabaseEngineVersions{
+		EngineType:      string(engine.Spec.Type),
+		OperatorVersion: pointer.ToStringOrNil(engine.Status.OperatorVersion),
+		Versions:        versions,
+	}
 }
 
 // GetDatabaseEngine Get the specified database cluster on the specified kubernetes cluster.
@@ -30,5 +130,126 @@ func (e *EverestServer) GetDatabaseEngine(ctx echo.Context, kubernetesID string,
 
 // UpdateDatabaseEngine Get the specified database cluster on the specified kubernetes cluster.
 func (e *EverestServer) UpdateDatabaseEngine(ctx echo.Context, kubernetesID string, name string) error {
-	return e.proxyKubernetes(ctx, kubernetesID, name)
+	if err := e.proxyKubernetes(ctx, kubernetesID, name); err != nil {
+		return err
+	}
+	if ctx.Response().Status >= http.StatusBadRequest {
+		return nil
+	}
+
+	// The engine has changed, so the cache shall not wait for the next scheduled refresh.
+	e.waitGroup.Add(1)
+	go func() {
+		defer e.waitGroup.Done()
+		if _, err := e.refreshDatabaseEngines(context.Background(), kubernetesID); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not refresh database engines")))
+		}
+	}()
+
+	return nil
+}
+
+// runDatabaseEngineCacheRefresher periodically refreshes the cached database engines
+// of all registered Kubernetes clusters until the context is canceled.
+func (e *EverestServer) runDatabaseEngineCacheRefresher(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.DatabaseEngineRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		e.refreshAllDatabaseEngines(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) refreshAllDatabaseEngines(ctx context.Context) {
+	ks, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list Kubernetes clusters")))
+		return
+	}
+
+	for _, k := range ks {
+		if ctx.Err() != nil {
+			return
+		}
+		if _, err := e.refreshDatabaseEngines(ctx, k.ID); err != nil {
+			e.l.Warn(errors.Join(err, fmt.Errorf("could not refresh database engines of Kubernetes cluster %s", k.ID)))
+		}
+	}
+}
+
+// refreshDatabaseEngines fetches the database engines from a Kubernetes cluster and replaces
+// the cached ones with them.
+func (e *EverestServer) refreshDatabaseEngines(ctx context.Context, kubernetesID string) ([]model.DatabaseEngine, error) {
+	_, kubeClient, _, err := e.initKubeClient(ctx, kubernetesID)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := kubeClient.ListDatabaseEngines(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list database engines"))
+	}
+
+	engines := make([]model.DatabaseEngine, 0, len(list.Items))
+	for _, item := range list.Items {
+		item := item
+		engine, err := model.NewDatabaseEngine(kubernetesID, &item)
+		if err != nil {
+			return nil, err
+		}
+		engines = append(engines, *engine)
+	}
+
+	if err := e.storage.SetDatabaseEngines(ctx, kubernetesID, engines); err != nil {
+		return nil, errors.Join(err, errors.New("could not cache database engines"))
+	}
+
+	return engines, nil
+}
+
+// cachedDatabaseEngines returns the cached database engines of a Kubernetes cluster.
+// If nothing has been cached yet, the engines are fetched from the Kubernetes cluster.
+func (e *EverestServer) cachedDatabaseEngines(ctx context.Context, kubernetesID string) ([]model.DatabaseEngine, error) {
+	engines, err := e.storage.ListDatabaseEngines(ctx, kubernetesID)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list cached database engines"))
+	}
+	if len(engines) != 0 {
+		return engines, nil
+	}
+
+	return e.refreshDatabaseEngines(ctx, kubernetesID)
+}
+
+// cachedDatabaseEngine returns the cached database engine of a Kubernetes cluster.
+// If the engine has not been cached yet, the engines are fetched from the Kubernetes cluster.
+func (e *EverestServer) cachedDatabaseEngine(ctx context.Context, kubernetesID, name string) (*model.DatabaseEngine, error) {
+	engine, err := e.storage.GetDatabaseEngine(ctx, kubernetesID, name)
+	if err == nil {
+		return engine, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.Join(err, errors.New("could not get cached database engine"))
+	}
+
+	engines, err := e.refreshDatabaseEngines(ctx, kubernetesID)
+	if err != nil {
+		return nil, err
+	}
+	for _, engine := range engines {
+		if engine.Name == name {
+			engine := engine
+			return &engine, nil
+		}
+	}
+
+	return nil, errDatabaseEngineNotFound
 }
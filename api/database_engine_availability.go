@@ -0,0 +1,186 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/versionservice"
+)
+
+// ListDatabaseEnginesAvailability lists whether the database engines of the kubernetes cluster can be used.
+func (e *EverestServer) ListDatabaseEnginesAvailability(ctx echo.Context, kubernetesID string) error {
+	c := ctx.Request().Context()
+	if _, err := e.storage.GetKubernetesCluster(c, kubernetesID); err != nil {
+		e.l.Error(err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Kubernetes cluster not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not find Kubernetes cluster")})
+	}
+
+	engines, err := e.cachedDatabaseEngines(c, kubernetesID)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database engines")})
+	}
+
+	deployed := e.deployedOperatorVersions(c, kubernetesID, engines)
+	res := make(DatabaseEngineAvailabilityList, 0, len(engines))
+	for i := range engines {
+		a, err := e.databaseEngineAvailability(c, &engines[i], deployed)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database engines")})
+		}
+		res = append(res, a)
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// GetDatabaseEngineAvailability returns whether the database engine can be used.
+func (e *EverestServer) GetDatabaseEngineAvailability(ctx echo.Context, kubernetesID string, name string) error {
+	c := ctx.Request().Context()
+	if _, err := e.storage.GetKubernetesCluster(c, kubernetesID); err != nil {
+		e.l.Error(err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Kubernetes cluster not found")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not find Kubernetes cluster")})
+	}
+
+	engine, err := e.cachedDatabaseEngine(c, kubernetesID, name)
+	if err != nil {
+		if errors.Is(err, errDatabaseEngineNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database engine not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database engine")})
+	}
+
+	deployed := e.deployedOperatorVersions(c, kubernetesID, []model.DatabaseEngine{*engine})
+	res, err := e.databaseEngineAvailability(c, engine, deployed)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database engine")})
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// deployedOperatorVersions returns the versions set in the deployments of the operators of the engines
+// by the engine type. The engines are still reported from the cache if the kubernetes cluster can not
+// be reached, just without the pending operator upgrades.
+func (e *EverestServer) deployedOperatorVersions(ctx context.Context, kubernetesID string, engines []model.DatabaseEngine) map[string]string {
+	versions := make(map[string]string, len(engines))
+	if len(engines) == 0 {
+		return versions
+	}
+
+	_, kubeClient, _, err := e.initKubeClient(ctx, kubernetesID)
+	if err != nil {
+		e.l.Warn(errors.Join(err, errors.New("could not check the engine operators")))
+		return versions
+	}
+	for _, engine := range engines {
+		op, err := kubeClient.GetOperator(ctx, engine.Type)
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				e.l.Warn(errors.Join(err, fmt.Errorf("could not get %s operator", engine.Type)))
+			}
+			continue
+		}
+		versions[engine.Type] = op.Version
+	}
+	return versions
+}
+
+func (e *EverestServer) databaseEngineAvailability(
+	ctx context.Context, engine *model.DatabaseEngine, deployed map[string]string,
+) (DatabaseEngineAvailability, error) {
+	item, err := engine.K8sResource("")
+	if err != nil {
+		return DatabaseEngineAvailability{}, err
+	}
+
+	versions := databaseEngineVersions(item).Versions
+	allowed := false
+	for _, v := range versions {
+		allowed = allowed || v.Allowed
+	}
+
+	res := DatabaseEngineAvailability{
+		Name:            item.Name,
+		Type:            string(item.Spec.Type),
+		State:           string(item.Status.State),
+		Usable:          item.Status.State == everestv1alpha1.DBEngineStateInstalled && allowed,
+		OperatorVersion: pointer.ToStringOrNil(item.Status.OperatorVersion),
+		Versions:        versions,
+	}
+	if v, ok := deployed[engine.Type]; ok && item.Status.OperatorVersion != "" && v != item.Status.OperatorVersion {
+		res.PendingOperatorVersion = pointer.ToString(v)
+	}
+
+	product, ok := versionservice.Products[item.Spec.Type]
+	if !ok || !e.versionService.Enabled() || item.Status.OperatorVersion == "" {
+		return res, nil
+	}
+	releases, err := e.versionService.Releases(ctx, product.Operator)
+	if err != nil {
+		e.l.Warn(errors.Join(err, fmt.Errorf("could not get %s operator releases", product.Operator)))
+		return res, nil
+	}
+	res.AvailableOperatorVersion = pointer.ToStringOrNil(latestOperatorRelease(releases, item.Status.OperatorVersion))
+
+	return res, nil
+}
+
+// latestOperatorRelease returns the latest release of the same major version newer than the current
+// operator version, or an empty string if there is none.
+func latestOperatorRelease(releases map[string]versionservice.Matrix, currentOperator string) string {
+	current, err := goversion.NewVersion(currentOperator)
+	if err != nil {
+		return ""
+	}
+
+	var latest *goversion.Version
+	for release := range releases {
+		v, err := goversion.NewVersion(release)
+		if err != nil || !v.GreaterThan(current) || v.Segments()[0] != current.Segments()[0] {
+			continue
+		}
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+	return latest.Original()
+}
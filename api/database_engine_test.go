@@ -0,0 +1,119 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/versionservice"
+)
+
+func TestDatabaseEngineVersions(t *testing.T) {
+	t.Parallel()
+
+	engine := &everestv1alpha1.DatabaseEngine{
+		Spec: everestv1alpha1.DatabaseEngineSpec{
+			Type:            everestv1alpha1.DatabaseEnginePXC,
+			AllowedVersions: []string{"8.0.32-24.2"},
+		},
+		Status: everestv1alpha1.DatabaseEngineStatus{
+			OperatorVersion: "1.13.0",
+			AvailableVersions: everestv1alpha1.Versions{
+				Engine: everestv1alpha1.ComponentsMap{
+					"8.0.31-23.2":  {ImagePath: "percona/percona-xtradb-cluster:8.0.31-23.2", Status: "available"},
+					"8.0.32-24.2":  {ImagePath: "percona/percona-xtradb-cluster:8.0.32-24.2", Status: "recommended"},
+					"5.7.42-31.65": {ImagePath: "percona/percona-xtradb-cluster:5.7.42-31.65", Status: "available", Critical: true},
+				},
+			},
+		},
+	}
+
+	res := databaseEngineVersions(engine)
+	assert.Equal(t, "pxc", res.EngineType)
+	assert.Equal(t, "1.13.0", pointer.GetString(res.OperatorVersion))
+	require.Len(t, res.Versions, 3)
+
+	versions := make([]string, 0, len(res.Versions))
+	for _, v := range res.Versions {
+		versions = append(versions, v.Version)
+	}
+	assert.Equal(t, []string{"8.0.32-24.2", "8.0.31-23.2", "5.7.42-31.65"}, versions)
+	assert.Equal(t, "8.0.32-24.2", res.Versions[0].ImageTag)
+	assert.True(t, res.Versions[0].Allowed)
+	assert.False(t, res.Versions[1].Allowed)
+	assert.True(t, pointer.GetBool(res.Versions[2].Critical))
+}
+
+func TestDatabaseEngineAvailability(t *testing.T) {
+	t.Parallel()
+
+	e := &EverestServer{l: zap.NewNop().Sugar(), versionService: versionservice.New("")}
+	engine := &model.DatabaseEngine{
+		Name:              "percona-xtradb-cluster",
+		Type:              "pxc",
+		State:             "installed",
+		OperatorVersion:   "1.13.0",
+		AllowedVersions:   `["8.0.32-24.2"]`,
+		AvailableVersions: `{"engine":{"8.0.32-24.2":{"imagePath":"percona/percona-xtradb-cluster:8.0.32-24.2"}}}`,
+	}
+
+	res, err := e.databaseEngineAvailability(context.Background(), engine, map[string]string{"pxc": "1.13.0"})
+	require.NoError(t, err)
+	assert.True(t, res.Usable)
+	assert.Equal(t, "installed", res.State)
+	assert.Len(t, res.Versions, 1)
+	assert.Nil(t, res.PendingOperatorVersion)
+	assert.Nil(t, res.AvailableOperatorVersion)
+
+	res, err = e.databaseEngineAvailability(context.Background(), engine, map[string]string{"pxc": "1.14.0"})
+	require.NoError(t, err)
+	assert.Equal(t, "1.14.0", pointer.GetString(res.PendingOperatorVersion))
+
+	engine.AllowedVersions = `["8.0.33-25.1"]`
+	res, err = e.databaseEngineAvailability(context.Background(), engine, nil)
+	require.NoError(t, err)
+	assert.False(t, res.Usable, "no available version is allowed")
+
+	engine.AllowedVersions = ""
+	engine.State = "not installed"
+	res, err = e.databaseEngineAvailability(context.Background(), engine, nil)
+	require.NoError(t, err)
+	assert.False(t, res.Usable)
+}
+
+func TestLatestOperatorRelease(t *testing.T) {
+	t.Parallel()
+
+	releases := map[string]versionservice.Matrix{
+		"1.12.0": {},
+		"1.13.0": {},
+		"1.14.0": {},
+		"1.14.1": {},
+		"2.0.0":  {},
+	}
+	assert.Equal(t, "1.14.1", latestOperatorRelease(releases, "1.13.0"))
+	assert.Equal(t, "", latestOperatorRelease(releases, "1.14.1"))
+	assert.Equal(t, "", latestOperatorRelease(releases, "2.0.0"))
+	assert.Equal(t, "", latestOperatorRelease(releases, "unknown"))
+}
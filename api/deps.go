@@ -18,17 +18,17 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/jinzhu/gorm"
 
 	"github.com/percona/percona-everest-backend/model"
 )
 
-const pgErrUniqueViolation = "unique_violation"
-
 type secretsStorage interface {
 	CreateSecret(ctx context.Context, id, value string) error
 	GetSecret(ctx context.Context, id string) (string, error)
+	GetSecrets(ctx context.Context, ids []string) (map[string]string, error)
 	UpdateSecret(ctx context.Context, id, value string) error
 	DeleteSecret(ctx context.Context, id string) (string, error)
 
@@ -39,6 +39,30 @@ type storage interface {
 	backupStorageStorage
 	kubernetesClusterStorage
 	monitoringInstanceStorage
+	databaseEngineStorage
+	backupSLOStorage
+	retentionPolicyStorage
+	backupVerificationStorage
+	diagnosticSessionStorage
+	configSyncStorage
+	replicationStorage
+	namespaceTemplateStorage
+	auditEntryStorage
+	bootstrapStorage
+	deregistrationStorage
+	guardrailStorage
+	temporaryAccessStorage
+	setupStorage
+	pricingStorage
+	sessionStorage
+	quotaStorage
+	projectStorage
+	engineUpgradeStorage
+	apiTokenStorage
+	alertRuleStorage
+	notificationStorage
+	webhookStorage
+	maintenanceStorage
 
 	Begin(ctx context.Context) *gorm.DB
 	Close() error
@@ -49,21 +73,231 @@ type kubernetesClusterStorage interface {
 	CreateKubernetesCluster(ctx context.Context, params model.CreateKubernetesClusterParams) (*model.KubernetesCluster, error)
 	ListKubernetesClusters(ctx context.Context) ([]model.KubernetesCluster, error)
 	GetKubernetesCluster(ctx context.Context, id string) (*model.KubernetesCluster, error)
+	UpdateKubernetesClusterCompatibility(ctx context.Context, id, status, message string) error
+	UpdateKubernetesCluster(ctx context.Context, id string, params model.UpdateKubernetesClusterParams) error
+	SetKubernetesClusterKubeconfigUpdated(ctx context.Context, id string) error
 	DeleteKubernetesCluster(ctx context.Context, id string) error
 }
 
 type backupStorageStorage interface {
 	CreateBackupStorage(ctx context.Context, params model.CreateBackupStorageParams) (*model.BackupStorage, error)
-	ListBackupStorages(ctx context.Context) ([]model.BackupStorage, error)
+	ListBackupStorages(ctx context.Context, params model.ListBackupStoragesParams) ([]model.BackupStorage, int, error)
 	GetBackupStorage(ctx context.Context, tx *gorm.DB, name string) (*model.BackupStorage, error)
 	UpdateBackupStorage(ctx context.Context, tx *gorm.DB, params model.UpdateBackupStorageParams) error
 	DeleteBackupStorage(ctx context.Context, name string, tx *gorm.DB) error
+	GetDeletedBackupStorage(ctx context.Context, name string) (*model.BackupStorage, error)
+	ListBackupStoragesDeletedBefore(ctx context.Context, before time.Time) ([]model.BackupStorage, error)
+	RestoreBackupStorage(ctx context.Context, name string) error
+	PurgeBackupStorage(ctx context.Context, name string, tx *gorm.DB) error
+	RotateBackupStorageCredentials(ctx context.Context, tx *gorm.DB, name, accessKeyID, secretKeyID string) error
+	ClearBackupStoragePreviousCredentials(ctx context.Context, name string) error
+	ListBackupStoragesExpiringBefore(ctx context.Context, before time.Time) ([]model.BackupStorage, error)
+	RefreshBackupStorageCredentials(ctx context.Context, name string, expireAt time.Time) error
 }
 
 type monitoringInstanceStorage interface {
-	CreateMonitoringInstance(pmm *model.MonitoringInstance) (*model.MonitoringInstance, error)
-	ListMonitoringInstances() ([]model.MonitoringInstance, error)
-	GetMonitoringInstance(name string) (*model.MonitoringInstance, error)
-	DeleteMonitoringInstance(name string, tx *gorm.DB) error
-	UpdateMonitoringInstance(name string, params model.UpdateMonitoringInstanceParams) error
+	CreateMonitoringInstance(ctx context.Context, pmm *model.MonitoringInstance) (*model.MonitoringInstance, error)
+	ListMonitoringInstances(ctx context.Context, params model.ListMonitoringInstancesParams) ([]model.MonitoringInstance, int, error)
+	GetMonitoringInstance(ctx context.Context, name string) (*model.MonitoringInstance, error)
+	DeleteMonitoringInstance(ctx context.Context, name string, tx *gorm.DB) error
+	GetDeletedMonitoringInstance(ctx context.Context, name string) (*model.MonitoringInstance, error)
+	ListMonitoringInstancesDeletedBefore(ctx context.Context, before time.Time) ([]model.MonitoringInstance, error)
+	RestoreMonitoringInstance(ctx context.Context, name string) error
+	PurgeMonitoringInstance(ctx context.Context, name string, tx *gorm.DB) error
+	UpdateMonitoringInstance(ctx context.Context, name string, params model.UpdateMonitoringInstanceParams) error
+}
+
+type databaseEngineStorage interface {
+	ListDatabaseEngines(ctx context.Context, kubernetesID string) ([]model.DatabaseEngine, error)
+	GetDatabaseEngine(ctx context.Context, kubernetesID, name string) (*model.DatabaseEngine, error)
+	SaveDatabaseEngine(ctx context.Context, engine *model.DatabaseEngine) error
+	SetDatabaseEngines(ctx context.Context, kubernetesID string, engines []model.DatabaseEngine) error
+}
+
+type backupSLOStorage interface {
+	SaveBackupSLO(ctx context.Context, params model.SaveBackupSLOParams) (*model.BackupSLO, error)
+	ListBackupSLOs(ctx context.Context, kubernetesID string) ([]model.BackupSLO, error)
+	ListAllBackupSLOs(ctx context.Context) ([]model.BackupSLO, error)
+	GetBackupSLO(ctx context.Context, kubernetesID, dbClusterName string) (*model.BackupSLO, error)
+	SetBackupSLOLastStatus(ctx context.Context, kubernetesID, dbClusterName, status string) error
+	DeleteBackupSLO(ctx context.Context, kubernetesID, dbClusterName string) error
+}
+
+type retentionPolicyStorage interface {
+	SaveBackupStorageRetentionPolicy(
+		ctx context.Context, backupStorageName string, limits model.RetentionLimits,
+	) (*model.BackupStorageRetentionPolicy, error)
+	ListBackupStorageRetentionPolicies(ctx context.Context) ([]model.BackupStorageRetentionPolicy, error)
+	GetBackupStorageRetentionPolicy(ctx context.Context, backupStorageName string) (*model.BackupStorageRetentionPolicy, error)
+	DeleteBackupStorageRetentionPolicy(ctx context.Context, backupStorageName string) error
+	SaveDBClusterRetentionPolicy(
+		ctx context.Context, kubernetesID, dbClusterName string, limits model.RetentionLimits,
+	) (*model.DBClusterRetentionPolicy, error)
+	ListDBClusterRetentionPolicies(ctx context.Context, kubernetesID string) ([]model.DBClusterRetentionPolicy, error)
+	GetDBClusterRetentionPolicy(ctx context.Context, kubernetesID, dbClusterName string) (*model.DBClusterRetentionPolicy, error)
+	DeleteDBClusterRetentionPolicy(ctx context.Context, kubernetesID, dbClusterName string) error
+}
+
+type backupVerificationStorage interface {
+	SaveBackupVerificationPolicy(
+		ctx context.Context, kubernetesID, dbClusterName string, intervalHours int,
+	) (*model.BackupVerificationPolicy, error)
+	ListBackupVerificationPolicies(ctx context.Context) ([]model.BackupVerificationPolicy, error)
+	GetBackupVerificationPolicy(ctx context.Context, kubernetesID, dbClusterName string) (*model.BackupVerificationPolicy, error)
+	SetBackupVerificationPolicyLastRun(ctx context.Context, kubernetesID, dbClusterName string, at time.Time) error
+	DeleteBackupVerificationPolicy(ctx context.Context, kubernetesID, dbClusterName string) error
+	SaveBackupVerification(ctx context.Context, verification *model.BackupVerification) error
+	ListBackupVerifications(ctx context.Context, params model.ListBackupVerificationsParams) ([]model.BackupVerification, int, error)
+}
+
+type diagnosticSessionStorage interface {
+	SaveDiagnosticSession(ctx context.Context, session *model.DiagnosticSession) error
+	GetDiagnosticSession(ctx context.Context, kubernetesID, dbClusterName string) (*model.DiagnosticSession, error)
+	ListExpiredDiagnosticSessions(ctx context.Context, before time.Time) ([]model.DiagnosticSession, error)
+	DeleteDiagnosticSession(ctx context.Context, kubernetesID, dbClusterName string) error
+}
+
+type configSyncStorage interface {
+	ListConfigSyncs(ctx context.Context, kind, name string) ([]model.ConfigSync, error)
+	SaveConfigSync(ctx context.Context, sync *model.ConfigSync) error
+	DeleteConfigSyncs(ctx context.Context, kind, name string, tx *gorm.DB) error
+	ListConfigDeletions(ctx context.Context) ([]model.ConfigDeletion, error)
+	SaveConfigDeletion(ctx context.Context, deletion *model.ConfigDeletion, tx *gorm.DB) error
+	DeleteConfigDeletion(ctx context.Context, kubernetesID, kind, name string) error
+	DeleteConfigDeletions(ctx context.Context, kind, name string) error
+	ListConfigRollouts(ctx context.Context) ([]model.ConfigRollout, error)
+	GetConfigRollout(ctx context.Context, kind, name string) (*model.ConfigRollout, error)
+	SaveConfigRollout(ctx context.Context, rollout *model.ConfigRollout) error
+	DeleteConfigRollout(ctx context.Context, kind, name string, tx *gorm.DB) error
+}
+
+type replicationStorage interface {
+	GetReplicationSnapshot(ctx context.Context) (*model.ReplicationSnapshot, error)
+	ApplyReplicationSnapshot(ctx context.Context, s *model.ReplicationSnapshot) error
+}
+
+type namespaceTemplateStorage interface {
+	SaveNamespaceTemplate(ctx context.Context, template *model.NamespaceTemplate) error
+	GetNamespaceTemplate(ctx context.Context, kubernetesID string) (*model.NamespaceTemplate, error)
+	DeleteNamespaceTemplate(ctx context.Context, kubernetesID string) error
+}
+
+type auditEntryStorage interface {
+	CreateAuditEntry(ctx context.Context, entry *model.AuditEntry) error
+	ListAuditEntries(ctx context.Context, params model.ListAuditEntriesParams) ([]model.AuditEntry, int, error)
+}
+
+type bootstrapStorage interface {
+	SaveBootstrap(ctx context.Context, bootstrap *model.Bootstrap) error
+	GetBootstrap(ctx context.Context, kubernetesID string) (*model.Bootstrap, error)
+}
+
+type deregistrationStorage interface {
+	SaveDeregistration(ctx context.Context, deregistration *model.Deregistration) error
+	GetDeregistration(ctx context.Context, kubernetesID string) (*model.Deregistration, error)
+}
+
+type guardrailStorage interface {
+	SaveGuardrail(ctx context.Context, guardrail *model.Guardrail) error
+	ListGuardrails(ctx context.Context, kubernetesID string) ([]model.Guardrail, error)
+	GetGuardrail(ctx context.Context, kubernetesID, engineType string) (*model.Guardrail, error)
+	DeleteGuardrail(ctx context.Context, kubernetesID, engineType string) error
+}
+
+type temporaryAccessStorage interface {
+	CreateTemporaryAccess(ctx context.Context, access *model.TemporaryAccess) error
+	ListExpiredTemporaryAccesses(ctx context.Context, before time.Time) ([]model.TemporaryAccess, error)
+	DeleteTemporaryAccess(ctx context.Context, kubernetesID, dbClusterName, username string) error
+}
+
+type setupStorage interface {
+	GetSetup(ctx context.Context) (*model.Setup, error)
+	SetSetupAdmin(ctx context.Context, username, passwordHash string) error
+	SetSetupSecretsBackend(ctx context.Context, backend string) error
+	SetSetupDefaultBackupStorage(ctx context.Context, name string) error
+}
+
+type sessionStorage interface {
+	CreateSession(ctx context.Context, session *model.Session) error
+	GetSessionByAccessTokenHash(ctx context.Context, hash string) (*model.Session, error)
+	RotateSessionTokens(ctx context.Context, refreshTokenHash string, session *model.Session) error
+	RevokeSession(ctx context.Context, id string) error
+	DeleteExpiredSessions(ctx context.Context, before time.Time) error
+}
+
+type pricingStorage interface {
+	GetPricing(ctx context.Context) (*model.Pricing, error)
+	SavePricing(ctx context.Context, pricing *model.Pricing) error
+}
+
+type quotaStorage interface {
+	SaveQuota(ctx context.Context, quota *model.Quota) error
+	ListQuotas(ctx context.Context) ([]model.Quota, error)
+	GetQuota(ctx context.Context, subjectKind, subject string) (*model.Quota, error)
+	DeleteQuota(ctx context.Context, subjectKind, subject string) error
+}
+
+type projectStorage interface {
+	CreateProject(ctx context.Context, project *model.Project) error
+	ListProjects(ctx context.Context, names []string) ([]model.Project, error)
+	GetProject(ctx context.Context, name string) (*model.Project, error)
+	DeleteProject(ctx context.Context, name string) error
+	SaveProjectMember(ctx context.Context, member *model.ProjectMember) error
+	ListProjectMembers(ctx context.Context, project string) ([]model.ProjectMember, error)
+	ListProjectMemberships(ctx context.Context, user string, teams []string) ([]model.ProjectMember, error)
+	DeleteProjectMember(ctx context.Context, project, subjectKind, subject string) error
+}
+
+type engineUpgradeStorage interface {
+	SaveEngineUpgrade(ctx context.Context, upgrade *model.EngineUpgrade) error
+	GetEngineUpgrade(ctx context.Context, kubernetesID, dbClusterName string) (*model.EngineUpgrade, error)
+}
+
+type apiTokenStorage interface {
+	CreateAPIToken(ctx context.Context, token *model.APIToken) error
+	ListAPITokens(ctx context.Context) ([]model.APIToken, error)
+	GetAPITokenByHash(ctx context.Context, hash string) (*model.APIToken, error)
+	DeleteAPIToken(ctx context.Context, name string) error
+}
+
+type alertRuleStorage interface {
+	CreateAlertRuleTemplate(ctx context.Context, template *model.AlertRuleTemplate) error
+	ListAlertRuleTemplates(ctx context.Context) ([]model.AlertRuleTemplate, error)
+	GetAlertRuleTemplate(ctx context.Context, name string) (*model.AlertRuleTemplate, error)
+	UpdateAlertRuleTemplate(ctx context.Context, name string, params model.UpdateAlertRuleTemplateParams) error
+	DeleteAlertRuleTemplate(ctx context.Context, name string) error
+	ListAlertRuleSyncs(ctx context.Context) ([]model.AlertRuleSync, error)
+	SaveAlertRuleSync(ctx context.Context, sync *model.AlertRuleSync) error
+	DeleteAlertRuleSync(ctx context.Context, kubernetesID, dbClusterName string) error
+}
+
+type notificationStorage interface {
+	CreateNotificationChannel(ctx context.Context, channel *model.NotificationChannel) error
+	ListNotificationChannels(ctx context.Context) ([]model.NotificationChannel, error)
+	GetNotificationChannel(ctx context.Context, name string) (*model.NotificationChannel, error)
+	UpdateNotificationChannel(ctx context.Context, name string, params model.UpdateNotificationChannelParams) error
+	DeleteNotificationChannel(ctx context.Context, name string) error
+	CreateNotificationRule(ctx context.Context, rule *model.NotificationRule) error
+	ListNotificationRules(ctx context.Context) ([]model.NotificationRule, error)
+	GetNotificationRule(ctx context.Context, name string) (*model.NotificationRule, error)
+	DeleteNotificationRule(ctx context.Context, name string) error
+}
+
+type webhookStorage interface {
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) error
+	ListWebhooks(ctx context.Context) ([]model.Webhook, error)
+	GetWebhook(ctx context.Context, name string) (*model.Webhook, error)
+	UpdateWebhook(ctx context.Context, name string, params model.UpdateWebhookParams) error
+	DeleteWebhook(ctx context.Context, name string) error
+}
+
+type maintenanceStorage interface {
+	SaveMaintenanceWindow(ctx context.Context, window *model.MaintenanceWindow) error
+	GetMaintenanceWindow(ctx context.Context, kubernetesID, dbClusterName string) (*model.MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, kubernetesID, dbClusterName string) error
+	CreatePendingOperation(ctx context.Context, op *model.PendingOperation) error
+	ListPendingOperations(ctx context.Context, kubernetesID, dbClusterName string) ([]model.PendingOperation, error)
+	UpdatePendingOperationState(ctx context.Context, id, state, message string) error
+	DeletePendingOperation(ctx context.Context, kubernetesID, dbClusterName, id string) error
+	DeletePendingOperations(ctx context.Context, kubernetesID, dbClusterName string) error
 }
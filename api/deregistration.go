@@ -0,0 +1,226 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const deregistrationPollInterval = 5 * time.Second
+
+// GetKubernetesClusterDeregistration returns the progress of the cascade removal of a kubernetes cluster.
+func (e *EverestServer) GetKubernetesClusterDeregistration(ctx echo.Context, kubernetesID string) error {
+	d, err := e.storage.GetDeregistration(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("The kubernetes cluster has not been removed with cascade")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get deregistration")})
+	}
+
+	return ctx.JSON(http.StatusOK, deregistrationToAPIJson(d))
+}
+
+// startDeregistration starts the cascade removal of the kubernetes cluster in the background.
+func (e *EverestServer) startDeregistration(
+	ctx echo.Context, kubeClient *kubernetes.Kubernetes, kubernetesID string, orphanDatabaseClusters bool,
+) error {
+	d, err := e.storage.GetDeregistration(ctx.Request().Context(), kubernetesID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get deregistration")})
+	}
+	// A deregistration which has not been updated for longer than the timeout was interrupted by a restart.
+	if err == nil && d.InProgress() && time.Since(d.UpdatedAt) < e.config.DeregistrationTimeout {
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("The kubernetes cluster is being removed")})
+	}
+
+	d = &model.Deregistration{
+		KubernetesID:           kubernetesID,
+		State:                  model.DeregistrationStatePending,
+		OrphanDatabaseClusters: orphanDatabaseClusters,
+		CreatedAt:              time.Now().UTC(),
+	}
+	if err := e.storage.SaveDeregistration(ctx.Request().Context(), d); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save deregistration")})
+	}
+
+	e.waitGroup.Add(1)
+	go e.runDeregistration(kubeClient, d)
+
+	return ctx.JSON(http.StatusAccepted, deregistrationToAPIJson(d))
+}
+
+// runDeregistration cleans up the kubernetes cluster, removes it from Everest and persists the progress.
+func (e *EverestServer) runDeregistration(kubeClient *kubernetes.Kubernetes, d *model.Deregistration) {
+	defer e.waitGroup.Done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.config.DeregistrationTimeout)
+	defer cancel()
+
+	err := e.deregister(ctx, kubeClient, d)
+	now := time.Now().UTC()
+	d.FinishedAt = &now
+	d.State = model.DeregistrationStateCompleted
+	if err != nil {
+		e.l.Error(errors.Join(err, fmt.Errorf("could not remove Kubernetes cluster %s", d.KubernetesID)))
+		d.State = model.DeregistrationStateFailed
+		d.Message = err.Error()
+	}
+	// The deregistration context may have expired already.
+	if err := e.storage.SaveDeregistration(context.Background(), d); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not save deregistration")))
+	}
+}
+
+func (e *EverestServer) deregister(ctx context.Context, kubeClient *kubernetes.Kubernetes, d *model.Deregistration) error {
+	dbs, err := kubeClient.ListDatabaseClusters(ctx)
+	if err != nil {
+		return errors.Join(err, errors.New("could not list database clusters"))
+	}
+	d.DatabaseClusters = len(dbs.Items)
+
+	if !d.OrphanDatabaseClusters {
+		if err := e.setDeregistrationState(ctx, d, model.DeregistrationStateDeletingDatabaseClusters); err != nil {
+			return err
+		}
+		for _, db := range dbs.Items {
+			if err := kubeClient.DeleteDatabaseCluster(ctx, db.Name); err != nil && !k8serrors.IsNotFound(err) {
+				return errors.Join(err, fmt.Errorf("could not delete database cluster %s", db.Name))
+			}
+		}
+		if err := e.waitForDatabaseClustersDeletion(ctx, kubeClient, d); err != nil {
+			return err
+		}
+	}
+
+	if err := e.setDeregistrationState(ctx, d, model.DeregistrationStateDeletingConfigs); err != nil {
+		return err
+	}
+	if err := e.deleteClusterConfigs(ctx, kubeClient, d); err != nil {
+		return err
+	}
+
+	if err := e.setDeregistrationState(ctx, d, model.DeregistrationStateRemovingCluster); err != nil {
+		return err
+	}
+	if err := e.removeK8sCluster(ctx, d.KubernetesID); err != nil {
+		return err
+	}
+	e.clusterHealth.delete(d.KubernetesID)
+	return nil
+}
+
+// waitForDatabaseClustersDeletion waits until the operators have deleted all the database clusters.
+func (e *EverestServer) waitForDatabaseClustersDeletion(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, d *model.Deregistration,
+) error {
+	ticker := time.NewTicker(deregistrationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		dbs, err := kubeClient.ListDatabaseClusters(ctx)
+		if err != nil {
+			return errors.Join(err, errors.New("could not list database clusters"))
+		}
+		if len(dbs.Items) != d.RemainingDatabaseClusters {
+			d.RemainingDatabaseClusters = len(dbs.Items)
+			if err := e.storage.SaveDeregistration(ctx, d); err != nil {
+				return errors.Join(err, errors.New("could not save deregistration"))
+			}
+		}
+		if len(dbs.Items) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d database clusters are not deleted: %w", d.RemainingDatabaseClusters, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// deleteClusterConfigs deletes the backup storages and the monitoring configs pushed by Everest
+// from the kubernetes cluster. The configs used by the orphaned database clusters are kept.
+func (e *EverestServer) deleteClusterConfigs(ctx context.Context, kubeClient *kubernetes.Kubernetes, d *model.Deregistration) error {
+	storages, _, err := e.storage.ListBackupStorages(ctx, model.ListBackupStoragesParams{})
+	if err != nil {
+		return errors.Join(err, errors.New("could not list backup storages"))
+	}
+	instances, _, err := e.storage.ListMonitoringInstances(ctx, model.ListMonitoringInstancesParams{})
+	if err != nil {
+		return errors.Join(err, errors.New("could not list monitoring instances"))
+	}
+
+	configs := make([]syncedConfig, 0, len(storages)+len(instances))
+	for i := range storages {
+		configs = append(configs, backupStorageSyncedConfig(&storages[i]))
+	}
+	for i := range instances {
+		configs = append(configs, monitoringInstanceSyncedConfig(&instances[i]))
+	}
+
+	for _, cfg := range configs {
+		err := kubeClient.DeleteConfig(ctx, cfg.resource, configInUse(cfg.kind, kubeClient))
+		switch {
+		case errors.Is(err, kubernetes.ErrConfigInUse):
+			d.KeptConfigs++
+		case err != nil:
+			return errors.Join(err, fmt.Errorf("could not delete %s %s", cfg.kind, cfg.name))
+		default:
+			d.DeletedConfigs++
+		}
+	}
+	return nil
+}
+
+func (e *EverestServer) setDeregistrationState(ctx context.Context, d *model.Deregistration, state string) error {
+	d.State = state
+	if err := e.storage.SaveDeregistration(ctx, d); err != nil {
+		return errors.Join(err, errors.New("could not save deregistration"))
+	}
+	return nil
+}
+
+func deregistrationToAPIJson(d *model.Deregistration) Deregistration {
+	return Deregistration{
+		State:                     DeregistrationState(d.State),
+		OrphanDatabaseClusters:    d.OrphanDatabaseClusters,
+		DatabaseClusters:          d.DatabaseClusters,
+		RemainingDatabaseClusters: d.RemainingDatabaseClusters,
+		DeletedConfigs:            d.DeletedConfigs,
+		KeptConfigs:               d.KeptConfigs,
+		Message:                   pointer.ToStringOrNil(d.Message),
+		StartedAt:                 d.CreatedAt,
+		FinishedAt:                d.FinishedAt,
+	}
+}
@@ -0,0 +1,326 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const maxDiagnosticsTTLMinutes = 24 * 60
+
+// psmdbProfilingModes maps the profiling levels to the mongod operationProfiling modes.
+var psmdbProfilingModes = []string{"off", "slowOp", "all"} //nolint:gochecknoglobals
+
+// GetDatabaseClusterDiagnostics returns the diagnostic settings active on the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterDiagnostics(ctx echo.Context, kubernetesID string, name string, _ GetDatabaseClusterDiagnosticsParams) error {
+	session, err := e.storage.GetDiagnosticSession(ctx.Request().Context(), kubernetesID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("No diagnostic settings are active on the database cluster")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get diagnostic settings")})
+	}
+
+	return ctx.JSON(http.StatusOK, diagnosticSessionToAPIJson(session))
+}
+
+// SetDatabaseClusterDiagnostics temporarily enables diagnostic settings on the specified database cluster.
+// If diagnostic settings are already active, they are replaced and the TTL is restarted.
+func (e *EverestServer) SetDatabaseClusterDiagnostics(ctx echo.Context, kubernetesID string, name string, _ SetDatabaseClusterDiagnosticsParams) error { //nolint:funlen,cyclop
+	var params DiagnosticSettings
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	db, err := kubeClient.GetDatabaseCluster(c, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString(fmt.Sprintf("DatabaseCluster '%s' is not found", name))})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+	if err := validateDiagnosticSettings(db.Spec.Engine.Type, params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	session, err := e.storage.GetDiagnosticSession(c, kubernetesID, name)
+	isNew := errors.Is(err, gorm.ErrRecordNotFound)
+	if err != nil && !isNew {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get diagnostic settings")})
+	}
+	if isNew {
+		session = &model.DiagnosticSession{
+			KubernetesID:   kubernetesID,
+			DBClusterName:  name,
+			OriginalConfig: db.Spec.Engine.Config,
+		}
+	}
+	session.GeneralLog = pointer.GetBool(params.GeneralLog)
+	session.SlowQueryThresholdMs = params.SlowQueryThresholdMs
+	session.ProfilingLevel = params.ProfilingLevel
+	session.ExpiresAt = time.Now().UTC().Add(time.Duration(params.TtlMinutes) * time.Minute)
+
+	config, err := applyDiagnosticSettings(db.Spec.Engine.Type, session.OriginalConfig, session)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	// The session is stored before the config is changed so that the original config
+	// is never lost even if the server stops right after the update.
+	if err := e.storage.SaveDiagnosticSession(c, session); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save diagnostic settings")})
+	}
+
+	db.Spec.Engine.Config = config
+	if _, err := kubeClient.UpdateDatabaseCluster(c, db); err != nil {
+		e.l.Error(err)
+		if isNew {
+			if err := e.storage.DeleteDiagnosticSession(c, kubernetesID, name); err != nil {
+				e.l.Error(errors.Join(err, errors.New("could not delete diagnostic settings")))
+			}
+		}
+		if k8serrors.IsConflict(err) {
+			return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("The database cluster was modified concurrently, please try again")})
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not update database cluster")})
+	}
+
+	return ctx.JSON(http.StatusOK, diagnosticSessionToAPIJson(session))
+}
+
+// RevertDatabaseClusterDiagnostics reverts the diagnostic settings of the specified database cluster immediately.
+func (e *EverestServer) RevertDatabaseClusterDiagnostics(ctx echo.Context, kubernetesID string, name string, _ RevertDatabaseClusterDiagnosticsParams) error {
+	c := ctx.Request().Context()
+	session, err := e.storage.GetDiagnosticSession(c, kubernetesID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("No diagnostic settings are active on the database cluster")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get diagnostic settings")})
+	}
+
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := e.revertDiagnosticSession(c, kubeClient, session); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not revert diagnostic settings")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// revertDiagnosticSession restores the original engine config of the database cluster
+// and deletes the session. Changes to the engine config made while the session was active are discarded.
+func (e *EverestServer) revertDiagnosticSession(ctx context.Context, kubeClient *kubernetes.Kubernetes, session *model.DiagnosticSession) error {
+	db, err := kubeClient.GetDatabaseCluster(ctx, session.DBClusterName)
+	switch {
+	case k8serrors.IsNotFound(err):
+		// The database cluster is gone, there is nothing to revert.
+	case err != nil:
+		return errors.Join(err, errors.New("could not get database cluster"))
+	default:
+		db.Spec.Engine.Config = session.OriginalConfig
+		if _, err := kubeClient.UpdateDatabaseCluster(ctx, db); err != nil {
+			return errors.Join(err, errors.New("could not update database cluster"))
+		}
+	}
+
+	return e.storage.DeleteDiagnosticSession(ctx, session.KubernetesID, session.DBClusterName)
+}
+
+// runDiagnosticsReverter periodically reverts the expired diagnostic settings
+// until the context is canceled.
+func (e *EverestServer) runDiagnosticsReverter(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.DiagnosticsRevertInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.revertExpiredDiagnostics(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) revertExpiredDiagnostics(ctx context.Context) {
+	sessions, err := e.storage.ListExpiredDiagnosticSessions(ctx, time.Now().UTC())
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list expired diagnostic settings")))
+		return
+	}
+
+	for _, session := range sessions {
+		session := session
+		if ctx.Err() != nil {
+			return
+		}
+
+		_, kubeClient, _, err := e.initKubeClient(ctx, session.KubernetesID)
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not revert diagnostic settings of database cluster %s", session.DBClusterName)))
+			continue
+		}
+		if err := e.revertDiagnosticSession(ctx, kubeClient, &session); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not revert diagnostic settings of database cluster %s", session.DBClusterName)))
+			continue
+		}
+		e.l.Infof("Diagnostic settings of database cluster %s were reverted", session.DBClusterName)
+	}
+}
+
+func validateDiagnosticSettings(engineType everestv1alpha1.EngineType, params DiagnosticSettings) error {
+	if params.TtlMinutes < 1 || params.TtlMinutes > maxDiagnosticsTTLMinutes {
+		return fmt.Errorf("ttlMinutes shall be between 1 and %d", maxDiagnosticsTTLMinutes)
+	}
+	if params.SlowQueryThresholdMs != nil && *params.SlowQueryThresholdMs < 0 {
+		return errors.New("slowQueryThresholdMs shall not be negative")
+	}
+	if params.GeneralLog == nil && params.SlowQueryThresholdMs == nil && params.ProfilingLevel == nil {
+		return errors.New("at least one diagnostic setting shall be specified")
+	}
+
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.DatabaseEnginePostgresql:
+		if params.ProfilingLevel != nil {
+			return fmt.Errorf("profilingLevel is not supported by %s", engineType)
+		}
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		if params.GeneralLog != nil {
+			return fmt.Errorf("generalLog is not supported by %s", engineType)
+		}
+		if params.ProfilingLevel != nil && (*params.ProfilingLevel < 0 || *params.ProfilingLevel >= len(psmdbProfilingModes)) {
+			return errors.New("profilingLevel shall be between 0 and 2")
+		}
+	default:
+		return fmt.Errorf("diagnostic settings are not supported by %s", engineType)
+	}
+
+	return nil
+}
+
+// applyDiagnosticSettings returns the engine config with the diagnostic settings of the session applied.
+func applyDiagnosticSettings(engineType everestv1alpha1.EngineType, config string, session *model.DiagnosticSession) (string, error) {
+	var lines []string
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePXC:
+		// Options of a later [mysqld] group override the earlier ones.
+		lines = append(lines, "[mysqld]")
+		if session.GeneralLog {
+			lines = append(lines, "general_log=ON")
+		}
+		if session.SlowQueryThresholdMs != nil {
+			seconds := strconv.FormatFloat(float64(*session.SlowQueryThresholdMs)/1000, 'f', -1, 64)
+			lines = append(lines, "slow_query_log=ON", "long_query_time="+seconds)
+		}
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		if session.GeneralLog {
+			lines = append(lines, "log_statement = 'all'")
+		}
+		if session.SlowQueryThresholdMs != nil {
+			lines = append(lines, fmt.Sprintf("log_min_duration_statement = %d", *session.SlowQueryThresholdMs))
+		}
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		return applyPSMDBDiagnosticSettings(config, session)
+	default:
+		return "", fmt.Errorf("diagnostic settings are not supported by %s", engineType)
+	}
+
+	if strings.TrimSpace(config) == "" {
+		return strings.Join(lines, "\n") + "\n", nil
+	}
+	return strings.TrimRight(config, "\n") + "\n" + strings.Join(lines, "\n") + "\n", nil
+}
+
+func applyPSMDBDiagnosticSettings(config string, session *model.DiagnosticSession) (string, error) {
+	cfg := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(config), &cfg); err != nil {
+		return "", errors.Join(err, errors.New("could not parse the engine config"))
+	}
+	if cfg == nil {
+		cfg = make(map[string]interface{})
+	}
+
+	profiling, ok := cfg["operationProfiling"].(map[string]interface{})
+	if !ok {
+		profiling = make(map[string]interface{})
+	}
+	switch {
+	case session.ProfilingLevel != nil:
+		profiling["mode"] = psmdbProfilingModes[*session.ProfilingLevel]
+	case session.SlowQueryThresholdMs != nil:
+		profiling["mode"] = "slowOp"
+	}
+	if session.SlowQueryThresholdMs != nil {
+		profiling["slowOpThresholdMs"] = *session.SlowQueryThresholdMs
+	}
+	cfg["operationProfiling"] = profiling
+
+	res, err := yaml.Marshal(cfg)
+	if err != nil {
+		return "", errors.Join(err, errors.New("could not marshal the engine config"))
+	}
+	return string(res), nil
+}
+
+func diagnosticSessionToAPIJson(session *model.DiagnosticSession) *DiagnosticSession {
+	res := &DiagnosticSession{
+		DbClusterName:        session.DBClusterName,
+		SlowQueryThresholdMs: session.SlowQueryThresholdMs,
+		ProfilingLevel:       session.ProfilingLevel,
+		ExpiresAt:            session.ExpiresAt,
+	}
+	if session.GeneralLog {
+		res.GeneralLog = pointer.ToBool(true)
+	}
+	return res
+}
@@ -0,0 +1,85 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestApplyDiagnosticSettings(t *testing.T) {
+	t.Parallel()
+
+	type tCase struct {
+		name       string
+		engineType everestv1alpha1.EngineType
+		config     string
+		session    *model.DiagnosticSession
+		expected   string
+	}
+
+	cases := []tCase{
+		{
+			name:       "pxc empty config",
+			engineType: everestv1alpha1.DatabaseEnginePXC,
+			session:    &model.DiagnosticSession{GeneralLog: true, SlowQueryThresholdMs: pointer.ToInt(500)},
+			expected:   "[mysqld]\ngeneral_log=ON\nslow_query_log=ON\nlong_query_time=0.5\n",
+		},
+		{
+			name:       "pxc existing config",
+			engineType: everestv1alpha1.DatabaseEnginePXC,
+			config:     "[mysqld]\nmax_connections=100\n",
+			session:    &model.DiagnosticSession{SlowQueryThresholdMs: pointer.ToInt(2000)},
+			expected:   "[mysqld]\nmax_connections=100\n[mysqld]\nslow_query_log=ON\nlong_query_time=2\n",
+		},
+		{
+			name:       "postgresql",
+			engineType: everestv1alpha1.DatabaseEnginePostgresql,
+			config:     "max_connections = 100",
+			session:    &model.DiagnosticSession{GeneralLog: true, SlowQueryThresholdMs: pointer.ToInt(100)},
+			expected:   "max_connections = 100\nlog_statement = 'all'\nlog_min_duration_statement = 100\n",
+		},
+		{
+			name:       "psmdb slow operations",
+			engineType: everestv1alpha1.DatabaseEnginePSMDB,
+			config:     "operationProfiling:\n  rateLimit: 2\n",
+			session:    &model.DiagnosticSession{SlowQueryThresholdMs: pointer.ToInt(100)},
+			expected:   "operationProfiling:\n  mode: slowOp\n  rateLimit: 2\n  slowOpThresholdMs: 100\n",
+		},
+		{
+			name:       "psmdb profiling level",
+			engineType: everestv1alpha1.DatabaseEnginePSMDB,
+			session:    &model.DiagnosticSession{ProfilingLevel: pointer.ToInt(2)},
+			expected:   "operationProfiling:\n  mode: all\n",
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := applyDiagnosticSettings(tc.engineType, tc.config, tc.session)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// estimateSampleSize is the number of the latest finished backups or restores an estimate is based on.
+const estimateSampleSize = 10
+
+// backupEstimates returns a modifier adding the estimated completion time to the status
+// of the in-progress backups in a proxied backup or backup list response.
+func (e *EverestServer) backupEstimates(kubernetesID string) responseBodyModifier {
+	return func(ctx context.Context, body []byte) ([]byte, error) {
+		var history []everestv1alpha1.DatabaseClusterBackup
+		return addEstimates(body, func(item []byte) (*time.Time, error) {
+			backup := everestv1alpha1.DatabaseClusterBackup{}
+			if err := json.Unmarshal(item, &backup); err != nil {
+				return nil, err
+			}
+			if !inProgress(string(backup.Status.State), backup.Status.CompletedAt) {
+				return nil, nil //nolint:nilnil
+			}
+			if history == nil {
+				backups, err := e.listBackups(ctx, kubernetesID)
+				if err != nil {
+					return nil, err
+				}
+				history = backups
+			}
+			return estimateBackupCompletion(backup, history), nil
+		})
+	}
+}
+
+// restoreEstimates returns a modifier adding the estimated completion time to the status
+// of the in-progress restores in a proxied restore or restore list response.
+func (e *EverestServer) restoreEstimates(kubernetesID string) responseBodyModifier {
+	return func(ctx context.Context, body []byte) ([]byte, error) {
+		var kubeClient *kubernetes.Kubernetes
+		var history []everestv1alpha1.DatabaseClusterRestore
+		return addEstimates(body, func(item []byte) (*time.Time, error) {
+			restore := everestv1alpha1.DatabaseClusterRestore{}
+			if err := json.Unmarshal(item, &restore); err != nil {
+				return nil, err
+			}
+			if !inProgress(string(restore.Status.State), restore.Status.CompletedAt) {
+				return nil, nil //nolint:nilnil
+			}
+			if kubeClient == nil {
+				_, k, _, err := e.initKubeClient(ctx, kubernetesID)
+				if err != nil {
+					return nil, err
+				}
+				restores, err := k.ListDatabaseClusterRestores(ctx)
+				if err != nil {
+					return nil, err
+				}
+				kubeClient, history = k, restores.Items
+			}
+			var source *everestv1alpha1.DatabaseClusterBackup
+			if name := restore.Spec.DataSource.DBClusterBackupName; name != "" {
+				var err error
+				if source, err = kubeClient.GetDatabaseClusterBackup(ctx, name); err != nil {
+					e.l.Debug(errors.Join(err, errors.New("could not get the source backup of restore")))
+				}
+			}
+			return estimateRestoreCompletion(restore, history, source), nil
+		})
+	}
+}
+
+func (e *EverestServer) listBackups(ctx context.Context, kubernetesID string) ([]everestv1alpha1.DatabaseClusterBackup, error) {
+	_, kubeClient, _, err := e.initKubeClient(ctx, kubernetesID)
+	if err != nil {
+		return nil, err
+	}
+	backups, err := kubeClient.ListDatabaseClusterBackups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return backups.Items, nil
+}
+
+// addEstimates sets status.estimatedCompletion of the object or of every item of the list in the body
+// to the time returned by estimate. The objects estimate returns no time for are left untouched.
+func addEstimates(body []byte, estimate func(item []byte) (*time.Time, error)) ([]byte, error) {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return nil, err
+	}
+	items, ok := obj["items"].([]interface{})
+	if !ok {
+		items = []interface{}{obj}
+	}
+
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		raw, err := json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		t, err := estimate(raw)
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			continue
+		}
+		status, ok := m["status"].(map[string]interface{})
+		if !ok {
+			status = map[string]interface{}{}
+			m["status"] = status
+		}
+		status["estimatedCompletion"] = t.UTC().Format(time.RFC3339)
+	}
+	return json.Marshal(obj)
+}
+
+// inProgress returns true if a backup or a restore in the state has neither succeeded nor failed yet.
+func inProgress(state string, completedAt *metav1.Time) bool {
+	state = strings.ToLower(state)
+	if _, ok := successfulBackupStates[state]; ok {
+		return false
+	}
+	if _, ok := failedBackupStates[state]; ok {
+		return false
+	}
+	return completedAt.IsZero()
+}
+
+// estimateBackupCompletion estimates when the backup completes from the durations of the latest successful
+// backups of the same database cluster to the same backup storage. The backups of the database cluster
+// to any backup storage are used if there are none.
+func estimateBackupCompletion(backup everestv1alpha1.DatabaseClusterBackup, history []everestv1alpha1.DatabaseClusterBackup) *time.Time {
+	var sameStorage, sameCluster []finishedJob
+	for _, b := range history {
+		if b.Spec.DBClusterName != backup.Spec.DBClusterName || b.Status.CompletedAt == nil {
+			continue
+		}
+		if _, ok := successfulBackupStates[strings.ToLower(string(b.Status.State))]; !ok {
+			continue
+		}
+		job := finishedJob{startedAt: backupStartedAt(b), completedAt: b.Status.CompletedAt.Time}
+		sameCluster = append(sameCluster, job)
+		if b.Spec.BackupStorageName == backup.Spec.BackupStorageName {
+			sameStorage = append(sameStorage, job)
+		}
+	}
+
+	samples := sameStorage
+	if len(samples) == 0 {
+		samples = sameCluster
+	}
+	return estimateCompletion(backupStartedAt(backup), samples)
+}
+
+// estimateRestoreCompletion estimates when the restore completes from the durations of the latest successful
+// restores of the same database cluster. The duration of the source backup is used if there are none.
+func estimateRestoreCompletion(
+	restore everestv1alpha1.DatabaseClusterRestore,
+	history []everestv1alpha1.DatabaseClusterRestore,
+	source *everestv1alpha1.DatabaseClusterBackup,
+) *time.Time {
+	var samples []finishedJob
+	for _, r := range history {
+		if r.Spec.DBClusterName != restore.Spec.DBClusterName || r.Status.CompletedAt == nil {
+			continue
+		}
+		if _, ok := successfulBackupStates[strings.ToLower(string(r.Status.State))]; !ok {
+			continue
+		}
+		samples = append(samples, finishedJob{startedAt: r.CreationTimestamp.Time, completedAt: r.Status.CompletedAt.Time})
+	}
+	if len(samples) == 0 && source != nil && source.Status.CompletedAt != nil {
+		samples = append(samples, finishedJob{startedAt: backupStartedAt(*source), completedAt: source.Status.CompletedAt.Time})
+	}
+	return estimateCompletion(restore.CreationTimestamp.Time, samples)
+}
+
+// finishedJob is a backup or a restore which has finished.
+type finishedJob struct {
+	startedAt   time.Time
+	completedAt time.Time
+}
+
+// estimateCompletion adds the median duration of the latest jobs to the start time.
+func estimateCompletion(startedAt time.Time, jobs []finishedJob) *time.Time {
+	if len(jobs) == 0 || startedAt.IsZero() {
+		return nil
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].completedAt.After(jobs[j].completedAt)
+	})
+	if len(jobs) > estimateSampleSize {
+		jobs = jobs[:estimateSampleSize]
+	}
+
+	durations := make([]time.Duration, 0, len(jobs))
+	for _, job := range jobs {
+		durations = append(durations, job.completedAt.Sub(job.startedAt))
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	completion := startedAt.Add(durations[len(durations)/2]).Truncate(time.Second)
+	return &completion
+}
+
+func backupStartedAt(b everestv1alpha1.DatabaseClusterBackup) time.Time {
+	if b.Status.CreatedAt != nil {
+		return b.Status.CreatedAt.Time
+	}
+	return b.CreationTimestamp.Time
+}
@@ -0,0 +1,196 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func finishedBackup(cluster, storage string, start time.Time, d time.Duration) everestv1alpha1.DatabaseClusterBackup {
+	b := everestv1alpha1.DatabaseClusterBackup{}
+	b.Spec.DBClusterName = cluster
+	b.Spec.BackupStorageName = storage
+	b.Status.State = "Succeeded"
+	b.Status.CreatedAt = &metav1.Time{Time: start}
+	b.Status.CompletedAt = &metav1.Time{Time: start.Add(d)}
+	return b
+}
+
+func TestEstimateBackupCompletion(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	running := everestv1alpha1.DatabaseClusterBackup{}
+	running.Spec.DBClusterName = "db"
+	running.Spec.BackupStorageName = "s3"
+	running.Status.CreatedAt = &metav1.Time{Time: start}
+
+	failed := finishedBackup("db", "s3", start.Add(-time.Hour), time.Second)
+	failed.Status.State = "Failed"
+
+	type testCase struct {
+		name     string
+		history  []everestv1alpha1.DatabaseClusterBackup
+		expected *time.Time
+	}
+	cases := []testCase{
+		{
+			name:     "no history",
+			expected: nil,
+		},
+		{
+			name: "median of the same storage",
+			history: []everestv1alpha1.DatabaseClusterBackup{
+				finishedBackup("db", "s3", start.Add(-5*time.Hour), 10*time.Minute),
+				finishedBackup("db", "s3", start.Add(-4*time.Hour), 30*time.Minute),
+				finishedBackup("db", "s3", start.Add(-3*time.Hour), 20*time.Minute),
+				finishedBackup("db", "gcs", start.Add(-2*time.Hour), time.Hour),
+				finishedBackup("other", "s3", start.Add(-2*time.Hour), time.Hour),
+				failed,
+			},
+			expected: pointer.ToTime(start.Add(20 * time.Minute)),
+		},
+		{
+			name: "other storages of the same cluster",
+			history: []everestv1alpha1.DatabaseClusterBackup{
+				finishedBackup("db", "gcs", start.Add(-2*time.Hour), time.Hour),
+				finishedBackup("other", "s3", start.Add(-2*time.Hour), time.Minute),
+			},
+			expected: pointer.ToTime(start.Add(time.Hour)),
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, estimateBackupCompletion(running, tc.history))
+		})
+	}
+}
+
+func TestEstimateRestoreCompletion(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	restore := func(start time.Time, d time.Duration) everestv1alpha1.DatabaseClusterRestore {
+		r := everestv1alpha1.DatabaseClusterRestore{}
+		r.Spec.DBClusterName = "db"
+		r.CreationTimestamp = metav1.Time{Time: start}
+		if d != 0 {
+			r.Status.State = "Succeeded"
+			r.Status.CompletedAt = &metav1.Time{Time: start.Add(d)}
+		}
+		return r
+	}
+	running := restore(start, 0)
+	source := finishedBackup("db", "s3", start.Add(-time.Hour), 15*time.Minute)
+
+	type testCase struct {
+		name     string
+		history  []everestv1alpha1.DatabaseClusterRestore
+		source   *everestv1alpha1.DatabaseClusterBackup
+		expected *time.Time
+	}
+	cases := []testCase{
+		{
+			name:     "no history",
+			expected: nil,
+		},
+		{
+			name:     "source backup",
+			source:   &source,
+			expected: pointer.ToTime(start.Add(15 * time.Minute)),
+		},
+		{
+			name:     "previous restores",
+			history:  []everestv1alpha1.DatabaseClusterRestore{restore(start.Add(-time.Hour), 5*time.Minute), running},
+			source:   &source,
+			expected: pointer.ToTime(start.Add(5 * time.Minute)),
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, estimateRestoreCompletion(running, tc.history, tc.source))
+		})
+	}
+}
+
+func TestAddEstimates(t *testing.T) {
+	t.Parallel()
+
+	eta := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	estimate := func(item []byte) (*time.Time, error) {
+		var obj struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		}
+		if err := json.Unmarshal(item, &obj); err != nil {
+			return nil, err
+		}
+		if obj.Metadata.Name != "running" {
+			return nil, nil //nolint:nilnil
+		}
+		return &eta, nil
+	}
+
+	type testCase struct {
+		name     string
+		body     string
+		expected string
+	}
+	cases := []testCase{
+		{
+			name:     "object",
+			body:     `{"metadata":{"name":"running"},"status":{"state":"Running"}}`,
+			expected: `{"metadata":{"name":"running"},"status":{"estimatedCompletion":"2023-10-01T12:00:00Z","state":"Running"}}`,
+		},
+		{
+			name:     "object without status",
+			body:     `{"metadata":{"name":"running"}}`,
+			expected: `{"metadata":{"name":"running"},"status":{"estimatedCompletion":"2023-10-01T12:00:00Z"}}`,
+		},
+		{
+			name: "list",
+			body: `{"items":[{"metadata":{"name":"done"}},{"metadata":{"name":"running"}}]}`,
+			expected: `{"items":[{"metadata":{"name":"done"}},` +
+				`{"metadata":{"name":"running"},"status":{"estimatedCompletion":"2023-10-01T12:00:00Z"}}]}`,
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			b, err := addEstimates([]byte(tc.body), estimate)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(b))
+		})
+	}
+}
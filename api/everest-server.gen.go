@@ -20,6 +20,19 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for AlertRuleTemplateSpecSeverity.
+const (
+	AlertRuleTemplateSpecSeverityCritical AlertRuleTemplateSpecSeverity = "critical"
+	AlertRuleTemplateSpecSeverityWarning  AlertRuleTemplateSpecSeverity = "warning"
+)
+
+// Defines values for BackupSLOStatus.
+const (
+	AtRisk    BackupSLOStatus = "atRisk"
+	Breached  BackupSLOStatus = "breached"
+	Compliant BackupSLOStatus = "compliant"
+)
+
 // Defines values for BackupStorageType.
 const (
 	BackupStorageTypeAzure BackupStorageType = "azure"
@@ -27,6 +40,30 @@ const (
 	BackupStorageTypeS3    BackupStorageType = "s3"
 )
 
+// Defines values for BootstrapState.
+const (
+	BootstrapStateCompleted          BootstrapState = "completed"
+	BootstrapStateCreatingNamespace  BootstrapState = "creating-namespace"
+	BootstrapStateFailed             BootstrapState = "failed"
+	BootstrapStateInstallingCrds     BootstrapState = "installing-crds"
+	BootstrapStateInstallingOperator BootstrapState = "installing-operator"
+	BootstrapStatePending            BootstrapState = "pending"
+	BootstrapStateWaitingForOperator BootstrapState = "waiting-for-operator"
+)
+
+// Defines values for CreateAlertRuleTemplateParamsMetric.
+const (
+	Cpu            CreateAlertRuleTemplateParamsMetric = "cpu"
+	DiskUsage      CreateAlertRuleTemplateParamsMetric = "diskUsage"
+	ReplicationLag CreateAlertRuleTemplateParamsMetric = "replicationLag"
+)
+
+// Defines values for CreateAlertRuleTemplateParamsSeverity.
+const (
+	CreateAlertRuleTemplateParamsSeverityCritical CreateAlertRuleTemplateParamsSeverity = "critical"
+	CreateAlertRuleTemplateParamsSeverityWarning  CreateAlertRuleTemplateParamsSeverity = "warning"
+)
+
 // Defines values for CreateBackupStorageParamsType.
 const (
 	CreateBackupStorageParamsTypeAzure CreateBackupStorageParamsType = "azure"
@@ -34,6 +71,29 @@ const (
 	CreateBackupStorageParamsTypeS3    CreateBackupStorageParamsType = "s3"
 )
 
+// Defines values for CreateNotificationChannelParamsType.
+const (
+	CreateNotificationChannelParamsTypeEmail     CreateNotificationChannelParamsType = "email"
+	CreateNotificationChannelParamsTypePagerduty CreateNotificationChannelParamsType = "pagerduty"
+	CreateNotificationChannelParamsTypeSlack     CreateNotificationChannelParamsType = "slack"
+	CreateNotificationChannelParamsTypeWebhook   CreateNotificationChannelParamsType = "webhook"
+)
+
+// Defines values for CreateWebhookParamsEventTypes.
+const (
+	CreateWebhookParamsEventTypesBackupStorageCreated          CreateWebhookParamsEventTypes = "backup-storage.created"
+	CreateWebhookParamsEventTypesBackupStorageDeleted          CreateWebhookParamsEventTypes = "backup-storage.deleted"
+	CreateWebhookParamsEventTypesBackupStorageRestored         CreateWebhookParamsEventTypes = "backup-storage.restored"
+	CreateWebhookParamsEventTypesBackupStorageUpdated          CreateWebhookParamsEventTypes = "backup-storage.updated"
+	CreateWebhookParamsEventTypesDatabaseClusterCreated        CreateWebhookParamsEventTypes = "database-cluster.created"
+	CreateWebhookParamsEventTypesDatabaseClusterDeleted        CreateWebhookParamsEventTypes = "database-cluster.deleted"
+	CreateWebhookParamsEventTypesDatabaseClusterRestoreCreated CreateWebhookParamsEventTypes = "database-cluster-restore.created"
+	CreateWebhookParamsEventTypesDatabaseClusterRestoreDeleted CreateWebhookParamsEventTypes = "database-cluster-restore.deleted"
+	CreateWebhookParamsEventTypesDatabaseClusterRestoreUpdated CreateWebhookParamsEventTypes = "database-cluster-restore.updated"
+	CreateWebhookParamsEventTypesDatabaseClusterUpdated        CreateWebhookParamsEventTypes = "database-cluster.updated"
+	CreateWebhookParamsEventTypesMonitoringInstanceRestored    CreateWebhookParamsEventTypes = "monitoring-instance.restored"
+)
+
 // Defines values for DatabaseClusterSpecProxyExposeType.
 const (
 	External DatabaseClusterSpecProxyExposeType = "external"
@@ -48,56 +108,619 @@ const (
 	Proxysql  DatabaseClusterSpecProxyType = "proxysql"
 )
 
+// Defines values for DatabaseClusterFieldChangeImpact.
+const (
+	None    DatabaseClusterFieldChangeImpact = "none"
+	Resize  DatabaseClusterFieldChangeImpact = "resize"
+	Restart DatabaseClusterFieldChangeImpact = "restart"
+)
+
+// Defines values for DeregistrationState.
+const (
+	DeregistrationStateCompleted                DeregistrationState = "completed"
+	DeregistrationStateDeletingConfigs          DeregistrationState = "deleting-configs"
+	DeregistrationStateDeletingDatabaseClusters DeregistrationState = "deleting-database-clusters"
+	DeregistrationStateFailed                   DeregistrationState = "failed"
+	DeregistrationStatePending                  DeregistrationState = "pending"
+	DeregistrationStateRemovingCluster          DeregistrationState = "removing-cluster"
+)
+
+// Defines values for KubernetesClusterCompatibilityStatus.
+const (
+	KubernetesClusterCompatibilityStatusCompatible   KubernetesClusterCompatibilityStatus = "compatible"
+	KubernetesClusterCompatibilityStatusIncompatible KubernetesClusterCompatibilityStatus = "incompatible"
+	KubernetesClusterCompatibilityStatusUnknown      KubernetesClusterCompatibilityStatus = "unknown"
+)
+
+// Defines values for KubernetesClusterStatusStatus.
+const (
+	Degraded    KubernetesClusterStatusStatus = "degraded"
+	Healthy     KubernetesClusterStatusStatus = "healthy"
+	Unreachable KubernetesClusterStatusStatus = "unreachable"
+)
+
+// Defines values for LintFindingSeverity.
+const (
+	LintFindingSeverityCritical LintFindingSeverity = "critical"
+	LintFindingSeverityInfo     LintFindingSeverity = "info"
+	LintFindingSeverityWarning  LintFindingSeverity = "warning"
+)
+
 // Defines values for MonitoringInstanceBaseType.
 const (
-	MonitoringInstanceBaseTypePmm MonitoringInstanceBaseType = "pmm"
+	MonitoringInstanceBaseTypePmm        MonitoringInstanceBaseType = "pmm"
+	MonitoringInstanceBaseTypePrometheus MonitoringInstanceBaseType = "prometheus"
 )
 
 // Defines values for MonitoringInstanceBaseWithNameType.
 const (
-	MonitoringInstanceBaseWithNameTypePmm MonitoringInstanceBaseWithNameType = "pmm"
+	MonitoringInstanceBaseWithNameTypePmm        MonitoringInstanceBaseWithNameType = "pmm"
+	MonitoringInstanceBaseWithNameTypePrometheus MonitoringInstanceBaseWithNameType = "prometheus"
+)
+
+// Defines values for MonitoringInstanceClusterStatusReachability.
+const (
+	MonitoringInstanceClusterStatusReachabilityReachable    MonitoringInstanceClusterStatusReachability = "reachable"
+	MonitoringInstanceClusterStatusReachabilityUnauthorized MonitoringInstanceClusterStatusReachability = "unauthorized"
+	MonitoringInstanceClusterStatusReachabilityUnknown      MonitoringInstanceClusterStatusReachability = "unknown"
+	MonitoringInstanceClusterStatusReachabilityUnreachable  MonitoringInstanceClusterStatusReachability = "unreachable"
 )
 
 // Defines values for MonitoringInstanceCreateParamsType.
 const (
-	MonitoringInstanceCreateParamsTypePmm MonitoringInstanceCreateParamsType = "pmm"
+	MonitoringInstanceCreateParamsTypePmm        MonitoringInstanceCreateParamsType = "pmm"
+	MonitoringInstanceCreateParamsTypePrometheus MonitoringInstanceCreateParamsType = "prometheus"
 )
 
 // Defines values for MonitoringInstanceUpdateParamsType.
 const (
-	MonitoringInstanceUpdateParamsTypePmm MonitoringInstanceUpdateParamsType = "pmm"
+	MonitoringInstanceUpdateParamsTypePmm        MonitoringInstanceUpdateParamsType = "pmm"
+	MonitoringInstanceUpdateParamsTypePrometheus MonitoringInstanceUpdateParamsType = "prometheus"
+)
+
+// Defines values for NotificationChannelType.
+const (
+	NotificationChannelTypeEmail     NotificationChannelType = "email"
+	NotificationChannelTypePagerduty NotificationChannelType = "pagerduty"
+	NotificationChannelTypeSlack     NotificationChannelType = "slack"
+	NotificationChannelTypeWebhook   NotificationChannelType = "webhook"
+)
+
+// Defines values for NotificationRuleMinSeverity.
+const (
+	NotificationRuleMinSeverityCritical NotificationRuleMinSeverity = "critical"
+	NotificationRuleMinSeverityInfo     NotificationRuleMinSeverity = "info"
+	NotificationRuleMinSeverityWarning  NotificationRuleMinSeverity = "warning"
+)
+
+// Defines values for NotificationRuleParamsMinSeverity.
+const (
+	Critical NotificationRuleParamsMinSeverity = "critical"
+	Info     NotificationRuleParamsMinSeverity = "info"
+	Warning  NotificationRuleParamsMinSeverity = "warning"
+)
+
+// Defines values for ReplicationStatusRole.
+const (
+	Primary ReplicationStatusRole = "primary"
+	Standby ReplicationStatusRole = "standby"
+)
+
+// Defines values for SearchResultKind.
+const (
+	SearchResultKindBackupStorage      SearchResultKind = "backupStorage"
+	SearchResultKindDatabaseCluster    SearchResultKind = "databaseCluster"
+	SearchResultKindKubernetesCluster  SearchResultKind = "kubernetesCluster"
+	SearchResultKindMonitoringInstance SearchResultKind = "monitoringInstance"
+)
+
+// Defines values for SizingPresetName.
+const (
+	Large  SizingPresetName = "large"
+	Medium SizingPresetName = "medium"
+	Small  SizingPresetName = "small"
+)
+
+// Defines values for UpdateWebhookParamsEventTypes.
+const (
+	UpdateWebhookParamsEventTypesBackupStorageCreated          UpdateWebhookParamsEventTypes = "backup-storage.created"
+	UpdateWebhookParamsEventTypesBackupStorageDeleted          UpdateWebhookParamsEventTypes = "backup-storage.deleted"
+	UpdateWebhookParamsEventTypesBackupStorageRestored         UpdateWebhookParamsEventTypes = "backup-storage.restored"
+	UpdateWebhookParamsEventTypesBackupStorageUpdated          UpdateWebhookParamsEventTypes = "backup-storage.updated"
+	UpdateWebhookParamsEventTypesDatabaseClusterCreated        UpdateWebhookParamsEventTypes = "database-cluster.created"
+	UpdateWebhookParamsEventTypesDatabaseClusterDeleted        UpdateWebhookParamsEventTypes = "database-cluster.deleted"
+	UpdateWebhookParamsEventTypesDatabaseClusterRestoreCreated UpdateWebhookParamsEventTypes = "database-cluster-restore.created"
+	UpdateWebhookParamsEventTypesDatabaseClusterRestoreDeleted UpdateWebhookParamsEventTypes = "database-cluster-restore.deleted"
+	UpdateWebhookParamsEventTypesDatabaseClusterRestoreUpdated UpdateWebhookParamsEventTypes = "database-cluster-restore.updated"
+	UpdateWebhookParamsEventTypesDatabaseClusterUpdated        UpdateWebhookParamsEventTypes = "database-cluster.updated"
+	UpdateWebhookParamsEventTypesMonitoringInstanceRestored    UpdateWebhookParamsEventTypes = "monitoring-instance.restored"
+)
+
+// Defines values for WebhookEventTypes.
+const (
+	BackupStorageCreated          WebhookEventTypes = "backup-storage.created"
+	BackupStorageDeleted          WebhookEventTypes = "backup-storage.deleted"
+	BackupStorageRestored         WebhookEventTypes = "backup-storage.restored"
+	BackupStorageUpdated          WebhookEventTypes = "backup-storage.updated"
+	DatabaseClusterCreated        WebhookEventTypes = "database-cluster.created"
+	DatabaseClusterDeleted        WebhookEventTypes = "database-cluster.deleted"
+	DatabaseClusterRestoreCreated WebhookEventTypes = "database-cluster-restore.created"
+	DatabaseClusterRestoreDeleted WebhookEventTypes = "database-cluster-restore.deleted"
+	DatabaseClusterRestoreUpdated WebhookEventTypes = "database-cluster-restore.updated"
+	DatabaseClusterUpdated        WebhookEventTypes = "database-cluster.updated"
+	MonitoringInstanceRestored    WebhookEventTypes = "monitoring-instance.restored"
+)
+
+// Defines values for WeeklyMaintenanceWindowDays.
+const (
+	Friday    WeeklyMaintenanceWindowDays = "friday"
+	Monday    WeeklyMaintenanceWindowDays = "monday"
+	Saturday  WeeklyMaintenanceWindowDays = "saturday"
+	Sunday    WeeklyMaintenanceWindowDays = "sunday"
+	Thursday  WeeklyMaintenanceWindowDays = "thursday"
+	Tuesday   WeeklyMaintenanceWindowDays = "tuesday"
+	Wednesday WeeklyMaintenanceWindowDays = "wednesday"
+)
+
+// Defines values for ListBackupStoragesParamsSortBy.
+const (
+	ListBackupStoragesParamsSortByCreatedAt ListBackupStoragesParamsSortBy = "createdAt"
+	ListBackupStoragesParamsSortByName      ListBackupStoragesParamsSortBy = "name"
+	ListBackupStoragesParamsSortByRegion    ListBackupStoragesParamsSortBy = "region"
+	ListBackupStoragesParamsSortByType      ListBackupStoragesParamsSortBy = "type"
+)
+
+// Defines values for ListBackupStoragesParamsOrder.
+const (
+	ListBackupStoragesParamsOrderAsc  ListBackupStoragesParamsOrder = "asc"
+	ListBackupStoragesParamsOrderDesc ListBackupStoragesParamsOrder = "desc"
+)
+
+// Defines values for ListMonitoringInstancesParamsSortBy.
+const (
+	ListMonitoringInstancesParamsSortByCreatedAt ListMonitoringInstancesParamsSortBy = "createdAt"
+	ListMonitoringInstancesParamsSortByName      ListMonitoringInstancesParamsSortBy = "name"
+	ListMonitoringInstancesParamsSortByType      ListMonitoringInstancesParamsSortBy = "type"
+)
+
+// Defines values for ListMonitoringInstancesParamsOrder.
+const (
+	ListMonitoringInstancesParamsOrderAsc  ListMonitoringInstancesParamsOrder = "asc"
+	ListMonitoringInstancesParamsOrderDesc ListMonitoringInstancesParamsOrder = "desc"
+)
+
+// Defines values for ListSizingPresetsParamsEngineType.
+const (
+	Postgresql ListSizingPresetsParamsEngineType = "postgresql"
+	Psmdb      ListSizingPresetsParamsEngineType = "psmdb"
+	Pxc        ListSizingPresetsParamsEngineType = "pxc"
 )
 
+// APIToken API token without its value
+type APIToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Name      string    `json:"name"`
+	Scope     string    `json:"scope"`
+	Team      *string   `json:"team,omitempty"`
+}
+
+// APITokenList defines model for APITokenList.
+type APITokenList = []APIToken
+
+// AdoptedConfig defines model for AdoptedConfig.
+type AdoptedConfig struct {
+	// Kind Either backupStorage or monitoringInstance
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+
+	// Reason Why the config could not be imported
+	Reason *string `json:"reason,omitempty"`
+
+	// Status One of managed if it was already managed by Everest, imported or unknown
+	Status string `json:"status"`
+}
+
+// AlertRuleSync The alert rules pushed to the monitoring instance of a database cluster
+type AlertRuleSync struct {
+	DbClusterName string `json:"dbClusterName"`
+	KubernetesId  string `json:"kubernetesId"`
+
+	// LastError The error of the last push
+	LastError              *string `json:"lastError,omitempty"`
+	MonitoringInstanceName string  `json:"monitoringInstanceName"`
+
+	// Rules The number of the pushed alert rules
+	Rules    int        `json:"rules"`
+	SyncedAt *time.Time `json:"syncedAt,omitempty"`
+}
+
+// AlertRuleSyncList defines model for AlertRuleSyncList.
+type AlertRuleSyncList = []AlertRuleSync
+
+// AlertRuleTemplate defines model for AlertRuleTemplate.
+type AlertRuleTemplate struct {
+	CreatedAt  time.Time `json:"createdAt"`
+	Enabled    bool      `json:"enabled"`
+	ForMinutes int       `json:"forMinutes"`
+	Metric     string    `json:"metric"`
+	Name       string    `json:"name"`
+	Severity   string    `json:"severity"`
+	Threshold  float64   `json:"threshold"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// AlertRuleTemplateList defines model for AlertRuleTemplateList.
+type AlertRuleTemplateList = []AlertRuleTemplate
+
+// AlertRuleTemplateSpec defines model for AlertRuleTemplateSpec.
+type AlertRuleTemplateSpec struct {
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ForMinutes How long the threshold has to be exceeded before the alert fires
+	ForMinutes *int                           `json:"forMinutes,omitempty"`
+	Severity   *AlertRuleTemplateSpecSeverity `json:"severity,omitempty"`
+
+	// Threshold A percentage for the cpu and diskUsage metrics and seconds for the replicationLag metric
+	Threshold *float64 `json:"threshold,omitempty"`
+}
+
+// AlertRuleTemplateSpecSeverity defines model for AlertRuleTemplateSpec.Severity.
+type AlertRuleTemplateSpecSeverity string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Action Action which was performed, e.g. backup-deletion-override
+	Action string `json:"action"`
+
+	// Actor User who performed the action if known
+	Actor        *string   `json:"actor,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+	KubernetesId *string   `json:"kubernetesId,omitempty"`
+	Reason       *string   `json:"reason,omitempty"`
+
+	// Resource Resource the action was performed on
+	Resource string `json:"resource"`
+}
+
+// AuditEntryList defines model for AuditEntryList.
+type AuditEntryList = []AuditEntry
+
+// BackupDownload Pre-signed URLs to download a stored backup
+type BackupDownload struct {
+	ExpiresAt time.Time              `json:"expiresAt"`
+	Objects   []BackupDownloadObject `json:"objects"`
+}
+
+// BackupDownloadObject Pre-signed URL to download an object of a stored backup
+type BackupDownloadObject struct {
+	Key string `json:"key"`
+
+	// Size The size of the object in bytes
+	Size int64  `json:"size"`
+	Url  string `json:"url"`
+}
+
+// BackupDownloadURLParams Backup download options
+type BackupDownloadURLParams struct {
+	// ExpiresInMinutes For how long the URLs are valid. The URLs signed with temporary credentials expire together with the credentials
+	ExpiresInMinutes *int `json:"expiresInMinutes,omitempty"`
+}
+
+// BackupSLO Backup SLO of a database cluster and its compliance
+type BackupSLO struct {
+	DbClusterName string `json:"dbClusterName"`
+
+	// DueAt The time by which the next successful backup is expected
+	DueAt                  time.Time  `json:"dueAt"`
+	IntervalHours          int        `json:"intervalHours"`
+	LastSuccessfulBackupAt *time.Time `json:"lastSuccessfulBackupAt,omitempty"`
+
+	// Status Compliance of the SLO. The SLO is at risk once most of the interval has passed without a successful backup
+	Status BackupSLOStatus `json:"status"`
+}
+
+// BackupSLOStatus Compliance of the SLO. The SLO is at risk once most of the interval has passed without a successful backup
+type BackupSLOStatus string
+
+// BackupSLOList defines model for BackupSLOList.
+type BackupSLOList = []BackupSLO
+
+// BackupSLOParams Backup SLO parameters
+type BackupSLOParams struct {
+	// IntervalHours A successful backup is expected at least once per this number of hours
+	IntervalHours int `json:"intervalHours"`
+}
+
 // BackupStorage Backup storage information
 type BackupStorage struct {
-	BucketName  string            `json:"bucketName"`
-	Description *string           `json:"description,omitempty"`
-	Name        string            `json:"name"`
-	Region      string            `json:"region"`
-	Type        BackupStorageType `json:"type"`
-	Url         *string           `json:"url,omitempty"`
+	BucketName string `json:"bucketName"`
+
+	// CreatedAt When the backup storage was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// CreatedBy The Everest user who created the backup storage. It is not set if unknown, e.g. for the backup storages created before it was recorded
+	CreatedBy *string `json:"createdBy,omitempty"`
+
+	// CredentialSource One of static, iam or sts
+	CredentialSource *string `json:"credentialSource,omitempty"`
+
+	// CredentialsExpireAt When the current sts credentials expire
+	CredentialsExpireAt *time.Time `json:"credentialsExpireAt,omitempty"`
+
+	// DeletedAt When the backup storage was moved to the trash. It is only set for the backup storages in the trash
+	DeletedAt      *time.Time `json:"deletedAt,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	ForcePathStyle *bool      `json:"forcePathStyle,omitempty"`
+
+	// HasCaCert Whether a custom CA bundle is trusted
+	HasCaCert *bool   `json:"hasCaCert,omitempty"`
+	Name      string  `json:"name"`
+	Project   *string `json:"project,omitempty"`
+	Region    string  `json:"region"`
+
+	// RoleArn The role assumed for the sts credentials
+	RoleArn    *string               `json:"roleArn,omitempty"`
+	SyncStatus *ConfigSyncStatusList `json:"syncStatus,omitempty"`
+	Type       BackupStorageType     `json:"type"`
+
+	// UpdatedAt When the backup storage was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	Url       *string    `json:"url,omitempty"`
+	VerifyTLS *bool      `json:"verifyTLS,omitempty"`
+
+	// Version Incremented on every update. The updates shall be based on the current version
+	Version *int64 `json:"version,omitempty"`
 }
 
 // BackupStorageType defines model for BackupStorage.Type.
 type BackupStorageType string
 
+// BackupStorageCredentials Backup storage credentials
+type BackupStorageCredentials struct {
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+}
+
+// BackupStorageCredentialsRotation Result of a backup storage credentials rotation
+type BackupStorageCredentialsRotation struct {
+	Clusters ConfigSyncStatusList `json:"clusters"`
+
+	// Retired Whether the previous credentials are retired. If not, they are retired by the config syncer once the remaining kubernetes clusters are synced.
+	Retired bool `json:"retired"`
+}
+
 // BackupStoragesList defines model for BackupStoragesList.
 type BackupStoragesList = []BackupStorage
 
+// BackupVerification Verification of a backup restored into a temporary database cluster
+type BackupVerification struct {
+	// BackupName Name of the verified backup
+	BackupName *string   `json:"backupName,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+
+	// DbClusterName Name of the database cluster the backup belongs to
+	DbClusterName string     `json:"dbClusterName"`
+	FinishedAt    *time.Time `json:"finishedAt,omitempty"`
+	KubernetesId  string     `json:"kubernetesId"`
+
+	// Message The reason the verification failed
+	Message *string `json:"message,omitempty"`
+
+	// State One of running, succeeded or failed
+	State string `json:"state"`
+
+	// VerificationDbClusterName Name of the temporary database cluster the backup is restored into
+	VerificationDbClusterName *string `json:"verificationDbClusterName,omitempty"`
+}
+
+// BackupVerificationList defines model for BackupVerificationList.
+type BackupVerificationList = []BackupVerification
+
+// BackupVerificationPolicy Backup verification policy of a database cluster
+type BackupVerificationPolicy struct {
+	// IntervalHours Verify the latest successful backup every this number of hours
+	IntervalHours int `json:"intervalHours"`
+
+	// LastRunAt When the latest verification was started
+	LastRunAt *time.Time `json:"lastRunAt,omitempty"`
+}
+
+// Bootstrap Progress of the installation of Everest into a kubernetes cluster
+type Bootstrap struct {
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Message Reason of the failure
+	Message         *string        `json:"message,omitempty"`
+	OperatorVersion string         `json:"operatorVersion"`
+	StartedAt       time.Time      `json:"startedAt"`
+	State           BootstrapState `json:"state"`
+}
+
+// BootstrapState defines model for Bootstrap.State.
+type BootstrapState string
+
+// BootstrapParams defines model for BootstrapParams.
+type BootstrapParams struct {
+	// OperatorVersion Version of the everest operator to install. Defaults to the version the server is configured with
+	OperatorVersion *string `json:"operatorVersion,omitempty"`
+}
+
+// Catalog defines model for Catalog.
+type Catalog struct {
+	BackupStorageTypes []string            `json:"backupStorageTypes"`
+	EngineTypes        []CatalogEngineType `json:"engineTypes"`
+
+	// Enums Allowed values by the schema property, like BackupStorage.type, or by the operation parameter, like listBackupStorages.sort_by
+	Enums map[string][]string `json:"enums"`
+
+	// Examples Example payloads by the schema name assembled from the examples of the schema properties
+	Examples                map[string]interface{} `json:"examples"`
+	MonitoringInstanceTypes []string               `json:"monitoringInstanceTypes"`
+
+	// Regions The AWS regions S3 is available in
+	Regions []string `json:"regions"`
+}
+
+// CatalogEngineType defines model for CatalogEngineType.
+type CatalogEngineType struct {
+	// InstalledOn Ids of the kubernetes clusters the engine operator is installed on
+	InstalledOn []string `json:"installedOn"`
+	Type        string   `json:"type"`
+}
+
+// ConfigDeletionStatus Deletion status of a config on a Kubernetes cluster
+type ConfigDeletionStatus struct {
+	// Deleted Whether the config is deleted from the Kubernetes cluster. The deletion is retried in the background otherwise
+	Deleted      bool    `json:"deleted"`
+	Error        *string `json:"error,omitempty"`
+	KubernetesId string  `json:"kubernetesId"`
+}
+
+// ConfigDeletionStatusList defines model for ConfigDeletionStatusList.
+type ConfigDeletionStatusList = []ConfigDeletionStatus
+
+// ConfigRollout Canary rollout of a config generation to the kubernetes clusters
+type ConfigRollout struct {
+	CanaryKubernetesIds []string `json:"canaryKubernetesIds"`
+
+	// Generation The secret generation of the config being rolled out
+	Generation int64 `json:"generation"`
+
+	// Kind Either backupStorage or monitoringInstance
+	Kind string `json:"kind"`
+
+	// Message The reason the rollout was halted
+	Message *string `json:"message,omitempty"`
+	Name    string  `json:"name"`
+
+	// State One of canary, completed or halted
+	State string `json:"state"`
+
+	// VerifyAt The time the canary kubernetes clusters are verified at
+	VerifyAt time.Time `json:"verifyAt"`
+}
+
+// ConfigRolloutList defines model for ConfigRolloutList.
+type ConfigRolloutList = []ConfigRollout
+
+// ConfigSyncStatus Sync status of a config on a kubernetes cluster
+type ConfigSyncStatus struct {
+	// Generation The latest secret generation of the config
+	Generation   int64  `json:"generation"`
+	InSync       bool   `json:"inSync"`
+	KubernetesId string `json:"kubernetesId"`
+
+	// LastError The error of the last sync attempt
+	LastError *string    `json:"lastError,omitempty"`
+	SyncedAt  *time.Time `json:"syncedAt,omitempty"`
+
+	// SyncedGeneration The secret generation last pushed to the kubernetes cluster
+	SyncedGeneration int64 `json:"syncedGeneration"`
+}
+
+// ConfigSyncStatusList defines model for ConfigSyncStatusList.
+type ConfigSyncStatusList = []ConfigSyncStatus
+
+// CostEstimate Estimated monthly cost of the resources requested by a database cluster. Resources without requests are not accounted for
+type CostEstimate struct {
+	// Cpu Monthly cost of the requested CPU
+	Cpu      float64 `json:"cpu"`
+	Currency string  `json:"currency"`
+
+	// Memory Monthly cost of the requested memory
+	Memory float64 `json:"memory"`
+
+	// Region The region the prices of which were used. Absent if the default prices were used
+	Region *string `json:"region,omitempty"`
+
+	// Storage Monthly cost of the requested storage
+	Storage float64 `json:"storage"`
+
+	// Total Total monthly cost
+	Total float64 `json:"total"`
+}
+
+// CreateAPITokenParams API token parameters
+type CreateAPITokenParams struct {
+	Name string `json:"name"`
+
+	// Scope One of admin, dashboard or project. The project tokens may access only the resources of the projects the token or its team is a member of
+	Scope string `json:"scope"`
+
+	// Team The team the database clusters created with the token are accounted to in the quotas
+	Team *string `json:"team,omitempty"`
+}
+
+// CreateAlertRuleTemplateParams defines model for CreateAlertRuleTemplateParams.
+type CreateAlertRuleTemplateParams struct {
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ForMinutes How long the threshold has to be exceeded before the alert fires
+	ForMinutes *int `json:"forMinutes,omitempty"`
+
+	// Metric The replicationLag metric covers the asynchronous replication of MySQL only
+	Metric *CreateAlertRuleTemplateParamsMetric `json:"metric,omitempty"`
+
+	// Name A name in the DNS label format
+	Name     *string                                `json:"name,omitempty"`
+	Severity *CreateAlertRuleTemplateParamsSeverity `json:"severity,omitempty"`
+
+	// Threshold A percentage for the cpu and diskUsage metrics and seconds for the replicationLag metric
+	Threshold *float64 `json:"threshold,omitempty"`
+}
+
+// CreateAlertRuleTemplateParamsMetric The replicationLag metric covers the asynchronous replication of MySQL only
+type CreateAlertRuleTemplateParamsMetric string
+
+// CreateAlertRuleTemplateParamsSeverity defines model for CreateAlertRuleTemplateParams.Severity.
+type CreateAlertRuleTemplateParamsSeverity string
+
 // CreateBackupStorageParams Backup storage parameters
 type CreateBackupStorageParams struct {
-	AccessKey string `json:"accessKey"`
+	// AccessKey Required for the static credentials.
+	AccessKey *string `json:"accessKey,omitempty"`
 
 	// BucketName The cloud storage bucket/container name
-	BucketName  string  `json:"bucketName"`
-	Description *string `json:"description,omitempty"`
+	BucketName string `json:"bucketName"`
+
+	// BucketVersioning Whether versioning is enabled on the bucket created because of createBucketIfMissing. Defaults to false.
+	BucketVersioning *bool `json:"bucketVersioning,omitempty"`
+
+	// CaCert The PEM encoded CA bundle trusted by the S3-compatible storage.
+	CaCert *string `json:"caCert,omitempty"`
+
+	// CreateBucketIfMissing Whether the bucket is created in the region if it does not exist. Defaults to false.
+	CreateBucketIfMissing *bool `json:"createBucketIfMissing,omitempty"`
+
+	// CredentialSource One of static (the default), iam or sts. The static credentials are set by accessKey, secretKey and optionally sessionToken. With iam no keys are stored and the database clusters access the storage with the pod identity (IRSA on EKS, workload identity on GKE). With sts Everest assumes roleArn to get temporary credentials and refreshes them before they expire.
+	CredentialSource *string `json:"credentialSource,omitempty"`
+	Description      *string `json:"description,omitempty"`
+
+	// ForcePathStyle Whether the bucket is addressed in the path instead of the host name. Required by the S3-compatible storages serving the path-style requests only, like MinIO or Ceph RGW. Defaults to false.
+	ForcePathStyle *bool `json:"forcePathStyle,omitempty"`
 
 	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
-	Name      string                        `json:"name"`
-	Region    string                        `json:"region"`
-	SecretKey string                        `json:"secretKey"`
-	Type      CreateBackupStorageParamsType `json:"type"`
-	Url       *string                       `json:"url,omitempty"`
+	Name string `json:"name"`
+
+	// Project The project the backup storage belongs to
+	Project *string `json:"project,omitempty"`
+	Region  string  `json:"region"`
+
+	// RoleArn The role assumed for the sts credentials. Required for the sts credentials.
+	RoleArn *string `json:"roleArn,omitempty"`
+
+	// SecretKey Required for the static credentials.
+	SecretKey *string `json:"secretKey,omitempty"`
+
+	// SessionToken The session token of temporary static credentials.
+	SessionToken *string                       `json:"sessionToken,omitempty"`
+	Type         CreateBackupStorageParamsType `json:"type"`
+
+	// Url The endpoint of an S3-compatible storage. It shall be an absolute http or https URL.
+	Url *string `json:"url,omitempty"`
+
+	// VerifyTLS Whether the certificate of the storage is verified. Defaults to true.
+	VerifyTLS *bool `json:"verifyTLS,omitempty"`
 }
 
 // CreateBackupStorageParamsType defines model for CreateBackupStorageParams.Type.
@@ -105,9 +728,63 @@ type CreateBackupStorageParamsType string
 
 // CreateKubernetesClusterParams kubernetes object
 type CreateKubernetesClusterParams struct {
-	Kubeconfig string  `json:"kubeconfig"`
-	Name       string  `json:"name"`
-	Namespace  *string `json:"namespace,omitempty"`
+	// DefaultMonitoringInstanceName The monitoring instance the new database clusters are attached to unless they opt out
+	DefaultMonitoringInstanceName *string `json:"defaultMonitoringInstanceName,omitempty"`
+
+	// InCluster Register the kubernetes cluster Everest runs in. It is accessed with the service account of Everest so no credentials are stored
+	InCluster *bool `json:"inCluster,omitempty"`
+
+	// Kubeconfig Base64 encoded kubeconfig. It is required unless inCluster is set
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+
+	// Labels Arbitrary key/value labels organizing the kubernetes clusters, e.g. env=prod
+	Labels    *map[string]string `json:"labels,omitempty"`
+	Name      string             `json:"name"`
+	Namespace *string            `json:"namespace,omitempty"`
+
+	// SkipOperatorCheck Register the kubernetes cluster without the everest operator installed so that it can be bootstrapped afterwards
+	SkipOperatorCheck *bool `json:"skipOperatorCheck,omitempty"`
+}
+
+// CreateNotificationChannelParams defines model for CreateNotificationChannelParams.
+type CreateNotificationChannelParams struct {
+	// Name A name in the DNS label format
+	Name string `json:"name"`
+
+	// Secret The incoming webhook URL for the slack type and the routing key for the pagerduty type. It is never returned
+	Secret *string `json:"secret,omitempty"`
+
+	// Target The comma separated email recipients for the email type and the URL for the webhook type
+	Target *string                             `json:"target,omitempty"`
+	Type   CreateNotificationChannelParamsType `json:"type"`
+}
+
+// CreateNotificationChannelParamsType defines model for CreateNotificationChannelParams.Type.
+type CreateNotificationChannelParamsType string
+
+// CreateWebhookParams defines model for CreateWebhookParams.
+type CreateWebhookParams struct {
+	// EventTypes The lifecycle events posted to the webhook. All events are posted if it is empty
+	EventTypes *[]CreateWebhookParamsEventTypes `json:"eventTypes,omitempty"`
+
+	// Name A name in the DNS label format
+	Name string `json:"name"`
+
+	// Secret The key the payloads are signed with. The hex encoded HMAC-SHA256 signature is sent in the X-Everest-Signature header. It is never returned
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// CreateWebhookParamsEventTypes defines model for CreateWebhookParams.EventTypes.
+type CreateWebhookParamsEventTypes string
+
+// CreatedAPIToken API token with its value which is returned once
+type CreatedAPIToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Name      string    `json:"name"`
+	Scope     string    `json:"scope"`
+	Team      *string   `json:"team,omitempty"`
+	Token     string    `json:"token"`
 }
 
 // DatabaseCluster DatabaseCluster is the Schema for the databaseclusters API.
@@ -145,6 +822,9 @@ type DatabaseCluster struct {
 
 				// Schedule Schedule is the cron schedule
 				Schedule string `json:"schedule"`
+
+				// TimeZone TimeZone is the IANA time zone the schedule is in. Everest converts the schedule to UTC, keeps the original in the everest.percona.com/backup-schedule-timezones annotation and converts it again once the UTC offset changes. Defaults to UTC.
+				TimeZone *string `json:"timeZone,omitempty"`
 			} `json:"schedules,omitempty"`
 		} `json:"backup,omitempty"`
 
@@ -361,6 +1041,19 @@ type DatabaseCluster_Spec_Proxy_Resources_Memory struct {
 // DatabaseClusterSpecProxyType Type is the proxy type
 type DatabaseClusterSpecProxyType string
 
+// DatabaseClusterAdoption Credentials of the referenced configs which cannot be captured from the kubernetes cluster
+type DatabaseClusterAdoption struct {
+	BackupStorages      []ImportBackupStorageParams      `json:"backupStorages,omitempty"`
+	MonitoringInstances []ImportMonitoringInstanceParams `json:"monitoringInstances,omitempty"`
+}
+
+// DatabaseClusterAdoptionResult defines model for DatabaseClusterAdoptionResult.
+type DatabaseClusterAdoptionResult struct {
+	// Adopted Whether the database cluster is managed by Everest. It is false if any referenced config is flagged as unknown
+	Adopted bool            `json:"adopted"`
+	Configs []AdoptedConfig `json:"configs"`
+}
+
 // DatabaseClusterBackup DatabaseClusterBackup is the Schema for the databaseclusterbackups API.
 type DatabaseClusterBackup struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -406,12 +1099,64 @@ type DatabaseClusterBackupList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DatabaseClusterBulkDelete The database clusters matching both the label selector and the name prefix are deleted. At least one of them is required
+type DatabaseClusterBulkDelete struct {
+	// Confirmation The confirmation returned by the dry run. It is required unless it is a dry run
+	Confirmation *string `json:"confirmation,omitempty"`
+
+	// DryRun Only list the database clusters which would be deleted
+	DryRun        bool    `json:"dryRun"`
+	LabelSelector *string `json:"labelSelector,omitempty"`
+	NamePrefix    *string `json:"namePrefix,omitempty"`
+}
+
+// DatabaseClusterBulkDeleteItem defines model for DatabaseClusterBulkDeleteItem.
+type DatabaseClusterBulkDeleteItem struct {
+	Error *string `json:"error,omitempty"`
+	Name  string  `json:"name"`
+
+	// Status One of matched for the dry run, deleted or failed
+	Status string `json:"status"`
+}
+
+// DatabaseClusterBulkDeleteResult defines model for DatabaseClusterBulkDeleteResult.
+type DatabaseClusterBulkDeleteResult struct {
+	// Confirmation The confirmation the deletion of the listed database clusters shall be sent with. It is only returned by the dry run
+	Confirmation *string                         `json:"confirmation,omitempty"`
+	DryRun       bool                            `json:"dryRun"`
+	Items        []DatabaseClusterBulkDeleteItem `json:"items"`
+}
+
 // DatabaseClusterCredential kubernetes object
 type DatabaseClusterCredential struct {
 	Password *string `json:"password,omitempty"`
 	Username *string `json:"username,omitempty"`
 }
 
+// DatabaseClusterDiff Difference between the live and the proposed database cluster spec
+type DatabaseClusterDiff struct {
+	Changes         []DatabaseClusterFieldChange `json:"changes"`
+	RequiresResize  bool                         `json:"requiresResize"`
+	RequiresRestart bool                         `json:"requiresRestart"`
+}
+
+// DatabaseClusterFieldChange A changed field of the database cluster spec
+type DatabaseClusterFieldChange struct {
+	Field string `json:"field"`
+
+	// From JSON encoded live value. Not set if the field is added
+	From *string `json:"from,omitempty"`
+
+	// Impact restart means the database pods are restarted, resize means pods or volumes are added, removed or resized
+	Impact DatabaseClusterFieldChangeImpact `json:"impact"`
+
+	// To JSON encoded proposed value. Not set if the field is removed
+	To *string `json:"to,omitempty"`
+}
+
+// DatabaseClusterFieldChangeImpact restart means the database pods are restarted, resize means pods or volumes are added, removed or resized
+type DatabaseClusterFieldChangeImpact string
+
 // DatabaseClusterList DatabaseClusterList is an object that contains the list of the existing database clusters.
 type DatabaseClusterList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -423,16 +1168,48 @@ type DatabaseClusterList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
-// DatabaseClusterRestore DatabaseClusterRestore is the Schema for the databaseclusterrestores API.
-type DatabaseClusterRestore struct {
-	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-	ApiVersion *string `json:"apiVersion,omitempty"`
+// DatabaseClusterPreview defines model for DatabaseClusterPreview.
+type DatabaseClusterPreview struct {
+	// CostEstimate Estimated monthly cost of the resources requested by a database cluster. Resources without requests are not accounted for
+	CostEstimate *CostEstimate `json:"costEstimate,omitempty"`
 
-	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-	Kind     *string                 `json:"kind,omitempty"`
-	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+	// Manifests Kubernetes resources in the order they are applied
+	Manifests []ManifestPreview `json:"manifests"`
+}
 
-	// Spec DatabaseClusterRestoreSpec defines the desired state of DatabaseClusterRestore.
+// DatabaseClusterResourceUsage Resources used and requested by the pods of a database cluster
+type DatabaseClusterResourceUsage struct {
+	CheckedAt time.Time `json:"checkedAt"`
+
+	// DiskCapacityBytes Capacity of the persistent volumes of the database cluster
+	DiskCapacityBytes uint64          `json:"diskCapacityBytes"`
+	Requested         ResourceAmounts `json:"requested"`
+
+	// Source Where the CPU and memory usage comes from, either metrics-server or pmm. Absent if neither of them provides it
+	Source *string         `json:"source,omitempty"`
+	Used   ResourceAmounts `json:"used"`
+
+	// Utilization The used part of the requested CPU and memory and of the disk capacity. A value close to zero suggests an over-provisioned database cluster and a value above one an under-provisioned one
+	Utilization ResourceUtilization `json:"utilization"`
+}
+
+// ResourceUtilization The used part of the requested CPU and memory and of the disk capacity. A value close to zero suggests an over-provisioned database cluster and a value above one an under-provisioned one
+type ResourceUtilization struct {
+	Cpu    *float64 `json:"cpu,omitempty"`
+	Disk   *float64 `json:"disk,omitempty"`
+	Memory *float64 `json:"memory,omitempty"`
+}
+
+// DatabaseClusterRestore DatabaseClusterRestore is the Schema for the databaseclusterrestores API.
+type DatabaseClusterRestore struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+	ApiVersion *string `json:"apiVersion,omitempty"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind     *string                 `json:"kind,omitempty"`
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
+	// Spec DatabaseClusterRestoreSpec defines the desired state of DatabaseClusterRestore.
 	Spec *struct {
 		// DataSource DataSource defines a data source for restoration.
 		DataSource struct {
@@ -474,6 +1251,54 @@ type DatabaseClusterRestoreList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DatabaseClusterScale Number of replicas of the database engine and of the proxy. The replicas which are not set are left unchanged
+type DatabaseClusterScale struct {
+	EngineReplicas *int `json:"engineReplicas,omitempty"`
+	ProxyReplicas  *int `json:"proxyReplicas,omitempty"`
+}
+
+// DatabaseClusterUpgrade defines model for DatabaseClusterUpgrade.
+type DatabaseClusterUpgrade struct {
+	// BackupName Name of the backup taken before the database engine is upgraded
+	BackupName  *string    `json:"backupName,omitempty"`
+	FinishedAt  *time.Time `json:"finishedAt,omitempty"`
+	FromVersion string     `json:"fromVersion"`
+
+	// Message Describes the failure if the upgrade failed
+	Message *string `json:"message,omitempty"`
+
+	// OperatorVersions Versions the operator is upgraded to before the database engine in order
+	OperatorVersions *[]string `json:"operatorVersions,omitempty"`
+
+	// State One of deferred, pending, upgrading-operator, waiting-for-backup, upgrading-engine, completed or failed
+	State         string `json:"state"`
+	TargetVersion string `json:"targetVersion"`
+}
+
+// DatabaseClusterUpgradePlan defines model for DatabaseClusterUpgradePlan.
+type DatabaseClusterUpgradePlan struct {
+	Steps []DatabaseClusterUpgradeStep `json:"steps"`
+}
+
+// DatabaseClusterUpgradeRequest defines model for DatabaseClusterUpgradeRequest.
+type DatabaseClusterUpgradeRequest struct {
+	// BackupStorageName Backup storage to take a backup to before upgrading
+	BackupStorageName *string `json:"backupStorageName,omitempty"`
+
+	// TargetVersion Engine version to upgrade to
+	TargetVersion string `json:"targetVersion"`
+
+	// UpgradeOperator Upgrade the operator first if the target version requires a newer operator
+	UpgradeOperator *bool `json:"upgradeOperator,omitempty"`
+}
+
+// DatabaseClusterUpgradeStep defines model for DatabaseClusterUpgradeStep.
+type DatabaseClusterUpgradeStep struct {
+	// Action Either upgrade-operator or upgrade-engine
+	Action        string `json:"action"`
+	TargetVersion string `json:"targetVersion"`
+}
+
 // DatabaseEngine DatabaseEngine is the Schema for the databaseengines API.
 type DatabaseEngine struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -527,6 +1352,28 @@ type DatabaseEngine struct {
 	} `json:"status,omitempty"`
 }
 
+// DatabaseEngineAvailability defines model for DatabaseEngineAvailability.
+type DatabaseEngineAvailability struct {
+	// AvailableOperatorVersion Latest operator release of the same major version newer than the installed one, if the version service is enabled
+	AvailableOperatorVersion *string `json:"availableOperatorVersion,omitempty"`
+	Name                     string  `json:"name"`
+	OperatorVersion          *string `json:"operatorVersion,omitempty"`
+
+	// PendingOperatorVersion Version the operator deployment is being upgraded to while the engine still reports the previous one
+	PendingOperatorVersion *string `json:"pendingOperatorVersion,omitempty"`
+
+	// State State of the database engine reported by the everest operator, e.g. installed
+	State string `json:"state"`
+	Type  string `json:"type"`
+
+	// Usable Whether the engine is installed and allows at least one version to create database clusters with
+	Usable   bool                    `json:"usable"`
+	Versions []DatabaseEngineVersion `json:"versions"`
+}
+
+// DatabaseEngineAvailabilityList defines model for DatabaseEngineAvailabilityList.
+type DatabaseEngineAvailabilityList = []DatabaseEngineAvailability
+
 // DatabaseEngineList DatabaseEngineList is an object that contains the list of the existing database engines.
 type DatabaseEngineList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
@@ -538,19 +1385,225 @@ type DatabaseEngineList struct {
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// DatabaseEngineVersion defines model for DatabaseEngineVersion.
+type DatabaseEngineVersion struct {
+	// Allowed Whether the version is allowed by the database engine
+	Allowed   bool    `json:"allowed"`
+	Critical  *bool   `json:"critical,omitempty"`
+	ImagePath string  `json:"imagePath"`
+	ImageTag  string  `json:"imageTag"`
+	Status    *string `json:"status,omitempty"`
+	Version   string  `json:"version"`
+}
+
+// DatabaseEngineVersions defines model for DatabaseEngineVersions.
+type DatabaseEngineVersions struct {
+	EngineType      string                  `json:"engineType"`
+	OperatorVersion *string                 `json:"operatorVersion,omitempty"`
+	Versions        []DatabaseEngineVersion `json:"versions"`
+}
+
+// Deregistration Progress of the cascade removal of a kubernetes cluster from Everest
+type Deregistration struct {
+	// DatabaseClusters Number of the database clusters found on the kubernetes cluster
+	DatabaseClusters int `json:"databaseClusters"`
+
+	// DeletedConfigs Number of the backup storages and monitoring configs deleted from the kubernetes cluster
+	DeletedConfigs int        `json:"deletedConfigs"`
+	FinishedAt     *time.Time `json:"finishedAt,omitempty"`
+
+	// KeptConfigs Number of the backup storages and monitoring configs kept because they are in use
+	KeptConfigs int `json:"keptConfigs"`
+
+	// Message Reason of the failure
+	Message                *string `json:"message,omitempty"`
+	OrphanDatabaseClusters bool    `json:"orphanDatabaseClusters"`
+
+	// RemainingDatabaseClusters Number of the database clusters still being deleted
+	RemainingDatabaseClusters int                 `json:"remainingDatabaseClusters"`
+	StartedAt                 time.Time           `json:"startedAt"`
+	State                     DeregistrationState `json:"state"`
+}
+
+// DeregistrationState defines model for Deregistration.State.
+type DeregistrationState string
+
+// DiagnosticSession Diagnostic settings active on a database cluster
+type DiagnosticSession struct {
+	DbClusterName string `json:"dbClusterName"`
+
+	// ExpiresAt The time the settings are reverted at
+	ExpiresAt            time.Time `json:"expiresAt"`
+	GeneralLog           *bool     `json:"generalLog,omitempty"`
+	ProfilingLevel       *int      `json:"profilingLevel,omitempty"`
+	SlowQueryThresholdMs *int      `json:"slowQueryThresholdMs,omitempty"`
+}
+
+// DiagnosticSettings Engine diagnostic settings. Only the settings supported by the engine of the database cluster can be set
+type DiagnosticSettings struct {
+	// GeneralLog Log all the statements. Supported by pxc and postgresql
+	GeneralLog *bool `json:"generalLog,omitempty"`
+
+	// ProfilingLevel Database profiler level, 0 is off, 1 profiles the slow operations and 2 profiles all the operations. Supported by psmdb
+	ProfilingLevel *int `json:"profilingLevel,omitempty"`
+
+	// SlowQueryThresholdMs Log the queries running longer than this number of milliseconds. Supported by all the engines
+	SlowQueryThresholdMs *int `json:"slowQueryThresholdMs,omitempty"`
+
+	// TtlMinutes The settings are reverted automatically after this number of minutes
+	TtlMinutes int `json:"ttlMinutes"`
+}
+
+// EngineRecommendedVersions defines model for EngineRecommendedVersions.
+type EngineRecommendedVersions struct {
+	EngineType      string               `json:"engineType"`
+	OperatorVersion string               `json:"operatorVersion"`
+	Versions        []RecommendedVersion `json:"versions"`
+}
+
 // Error Error response
 type Error struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// Guardrail Limits enforced on the database clusters of an engine type. Unset limits are not enforced
+type Guardrail struct {
+	// AllowedStorageClasses Storage classes the database clusters may use
+	AllowedStorageClasses *[]string `json:"allowedStorageClasses,omitempty"`
+	EngineType            *string   `json:"engineType,omitempty"`
+
+	// MaxConnections Maximum number of connections the engine configuration may allow
+	MaxConnections *int `json:"maxConnections,omitempty"`
+
+	// MaxReplicas Maximum number of engine replicas
+	MaxReplicas *int `json:"maxReplicas,omitempty"`
+}
+
+// GuardrailList defines model for GuardrailList.
+type GuardrailList = []Guardrail
+
+// HealthCount Number of the healthy and degraded items
+type HealthCount struct {
+	Degraded int `json:"degraded"`
+	Healthy  int `json:"healthy"`
+}
+
+// ImportBackupStorageParams Backup storage to import. The credentials are captured from the kubernetes cluster if not provided
+type ImportBackupStorageParams struct {
+	AccessKey   *string `json:"accessKey,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	SecretKey   *string `json:"secretKey,omitempty"`
+}
+
+// ImportMonitoringInstanceParams Monitoring instance to import. The API key is captured from the kubernetes cluster if not provided
+type ImportMonitoringInstanceParams struct {
+	ApiKey *string `json:"apiKey,omitempty"`
+	Name   string  `json:"name"`
+}
+
+// ImportUnmanagedConfigsParams Configs to import into Everest
+type ImportUnmanagedConfigsParams struct {
+	BackupStorages      []ImportBackupStorageParams      `json:"backupStorages,omitempty"`
+	MonitoringInstances []ImportMonitoringInstanceParams `json:"monitoringInstances,omitempty"`
+}
+
+// ImportedConfigs Configs imported into Everest
+type ImportedConfigs struct {
+	BackupStorages      []BackupStorage      `json:"backupStorages"`
+	MonitoringInstances []MonitoringInstance `json:"monitoringInstances"`
+}
+
+// Inventory Component images and versions of the database clusters
+type Inventory struct {
+	DatabaseClusters []InventoryDatabaseCluster `json:"databaseClusters"`
+
+	// Partial Whether the inventory of some kubernetes clusters is stale or missing
+	Partial bool `json:"partial"`
+
+	// UnavailableClusters Ids of the kubernetes clusters which could not be inventoried
+	UnavailableClusters []string `json:"unavailableClusters"`
+
+	// UnreachableClusters Kubernetes clusters which could not be reached. The last known inventory of them is returned if any
+	UnreachableClusters []UnreachableCluster `json:"unreachableClusters"`
+}
+
+// InventoryComponent defines model for InventoryComponent.
+type InventoryComponent struct {
+	Container string `json:"container"`
+	Image     string `json:"image"`
+
+	// Kind One of engine, proxy, backup or other
+	Kind    string `json:"kind"`
+	Version string `json:"version"`
+}
+
+// InventoryDatabaseCluster defines model for InventoryDatabaseCluster.
+type InventoryDatabaseCluster struct {
+	Components    []InventoryComponent `json:"components"`
+	EngineType    string               `json:"engineType"`
+	EngineVersion string               `json:"engineVersion"`
+
+	// EstimatedMonthlyCost Estimated monthly cost of the database cluster. Absent if no prices are set
+	EstimatedMonthlyCost *float64 `json:"estimatedMonthlyCost,omitempty"`
+	KubernetesId         string   `json:"kubernetesId"`
+	KubernetesName       string   `json:"kubernetesName"`
+	Name                 string   `json:"name"`
+	OperatorVersion      string   `json:"operatorVersion"`
+}
+
+// KubeconfigParams Kubeconfig of a kubernetes cluster
+type KubeconfigParams struct {
+	// Kubeconfig Base64 encoded kubeconfig
+	Kubeconfig string `json:"kubeconfig"`
+
+	// SkipOperatorCheck Accept the kubeconfig even if the everest operator is not installed
+	SkipOperatorCheck *bool `json:"skipOperatorCheck,omitempty"`
+}
+
 // KubernetesCluster kubernetes object
 type KubernetesCluster struct {
-	Id        string `json:"id"`
-	Name      string `json:"name"`
-	Namespace string `json:"namespace"`
-	Uid       string `json:"uid"`
+	// Compatibility Whether the kubernetes cluster serves the everest operator APIs
+	Compatibility *KubernetesClusterCompatibility `json:"compatibility,omitempty"`
+
+	// CreatedAt When the kubernetes cluster was registered
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// CreatedBy The Everest user who registered the kubernetes cluster. It is not set if unknown, e.g. for the kubernetes clusters registered before it was recorded
+	CreatedBy *string `json:"createdBy,omitempty"`
+
+	// DefaultMonitoringInstanceName The monitoring instance the new database clusters are attached to unless they opt out
+	DefaultMonitoringInstanceName *string `json:"defaultMonitoringInstanceName,omitempty"`
+	Id                            string  `json:"id"`
+
+	// InCluster Whether it is the kubernetes cluster Everest runs in
+	InCluster *bool `json:"inCluster,omitempty"`
+
+	// Labels Arbitrary key/value labels organizing the kubernetes clusters, e.g. env=prod
+	Labels    *map[string]string `json:"labels,omitempty"`
+	Name      string             `json:"name"`
+	Namespace string             `json:"namespace"`
+	Uid       string             `json:"uid"`
+
+	// UpdatedAt When the kubernetes cluster was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// Version Incremented on every update. The updates shall be based on the current version
+	Version *int64 `json:"version,omitempty"`
+}
+
+// KubernetesClusterCompatibility Whether the kubernetes cluster serves the everest operator APIs
+type KubernetesClusterCompatibility struct {
+	CheckedAt *time.Time `json:"checkedAt,omitempty"`
+
+	// Message Describes the missing everest operator APIs if any
+	Message *string                              `json:"message,omitempty"`
+	Status  KubernetesClusterCompatibilityStatus `json:"status"`
 }
 
+// KubernetesClusterCompatibilityStatus defines model for KubernetesClusterCompatibility.Status.
+type KubernetesClusterCompatibilityStatus string
+
 // KubernetesClusterInfo kubernetes cluster info
 type KubernetesClusterInfo struct {
 	ClusterType       string   `json:"clusterType"`
@@ -572,6 +1625,9 @@ type KubernetesClusterMonitoring struct {
 type KubernetesClusterResources struct {
 	Available ResourcesAvailable `json:"available"`
 	Capacity  ResourcesCapacity  `json:"capacity"`
+
+	// Nodes Allocatable and requested resources of the worker nodes
+	Nodes *[]NodeResources `json:"nodes,omitempty"`
 }
 
 // ResourcesAvailable defines model for .
@@ -588,13 +1644,162 @@ type ResourcesCapacity struct {
 	MemoryBytes *uint64 `json:"memoryBytes,omitempty"`
 }
 
+// KubernetesClusterStatus Health status of a kubernetes cluster
+type KubernetesClusterStatus struct {
+	ApiServer            KubernetesAPIServerStatus `json:"apiServer"`
+	CheckedAt            time.Time                 `json:"checkedAt"`
+	KubeconfigAgeSeconds *int64                    `json:"kubeconfigAgeSeconds,omitempty"`
+
+	// KubeconfigStoredAt When the kubeconfig of the kubernetes cluster was stored
+	KubeconfigStoredAt *time.Time                  `json:"kubeconfigStoredAt,omitempty"`
+	KubernetesId       string                      `json:"kubernetesId"`
+	Nodes              *KubernetesNodesStatus      `json:"nodes,omitempty"`
+	Operators          *[]KubernetesOperatorStatus `json:"operators,omitempty"`
+
+	// Stale Whether the status was checked longer ago than the checks are expected to run
+	Stale bool `json:"stale"`
+
+	// Status Unreachable if the API server cannot be reached, degraded if an operator or a node is not ready
+	Status KubernetesClusterStatusStatus `json:"status"`
+}
+
+// KubernetesAPIServerStatus defines model for .
+type KubernetesAPIServerStatus struct {
+	Error     *string `json:"error,omitempty"`
+	Reachable bool    `json:"reachable"`
+	Version   *string `json:"version,omitempty"`
+}
+
+// KubernetesNodesStatus defines model for .
+type KubernetesNodesStatus struct {
+	Ready int `json:"ready"`
+	Total int `json:"total"`
+}
+
+// KubernetesOperatorStatus defines model for .
+type KubernetesOperatorStatus struct {
+	Installed bool    `json:"installed"`
+	Name      string  `json:"name"`
+	Ready     bool    `json:"ready"`
+	Version   *string `json:"version,omitempty"`
+}
+
+// KubernetesClusterStatusStatus Unreachable if the API server cannot be reached, degraded if an operator or a node is not ready
+type KubernetesClusterStatusStatus string
+
+// KubernetesResyncItem defines model for KubernetesResyncItem.
+type KubernetesResyncItem struct {
+	Error *string `json:"error,omitempty"`
+
+	// Kind Either BackupStorage or MonitoringConfig
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+
+	// Result One of created, updated or failed
+	Result string `json:"result"`
+}
+
+// KubernetesResyncResult Summary of the configs re-applied to a kubernetes cluster
+type KubernetesResyncResult struct {
+	Created int                    `json:"created"`
+	Failed  int                    `json:"failed"`
+	Items   []KubernetesResyncItem `json:"items"`
+	Updated int                    `json:"updated"`
+}
+
+// LegalHold defines model for LegalHold.
+type LegalHold struct {
+	Enabled bool `json:"enabled"`
+
+	// Reason Reason of the legal hold
+	Reason *string `json:"reason,omitempty"`
+}
+
+// LintFinding A best practice the linted spec does not follow
+type LintFinding struct {
+	// Field Path of the spec field the finding relates to
+	Field    string              `json:"field"`
+	Message  string              `json:"message"`
+	Rule     string              `json:"rule"`
+	Severity LintFindingSeverity `json:"severity"`
+}
+
+// LintFindingSeverity defines model for LintFinding.Severity.
+type LintFindingSeverity string
+
+// LintResult Result of linting a database cluster spec
+type LintResult struct {
+	Findings []LintFinding `json:"findings"`
+
+	// Score 100 means no findings, every finding lowers the score according to its severity
+	Score int `json:"score"`
+}
+
+// LogLevel defines model for LogLevel.
+type LogLevel struct {
+	// Level One of debug, info or warn
+	Level string `json:"level"`
+}
+
+// MaintenanceWindow defines model for MaintenanceWindow.
+type MaintenanceWindow struct {
+	DurationMinutes int `json:"durationMinutes"`
+
+	// NextStart The start of the next window. Unset if the schedule does not start within a year
+	NextStart *time.Time `json:"nextStart,omitempty"`
+
+	// Open Whether the window is open now
+	Open bool `json:"open"`
+
+	// Schedule The cron expression of 5 fields the window starts at
+	Schedule string `json:"schedule"`
+	TimeZone string `json:"timeZone"`
+}
+
+// MaintenanceWindowParams The window starts at the times given either by the cron schedule or by the weekly days and start time
+type MaintenanceWindowParams struct {
+	DurationMinutes int `json:"durationMinutes"`
+
+	// Schedule The cron expression of 5 fields the window starts at
+	Schedule *string `json:"schedule,omitempty"`
+
+	// TimeZone The IANA time zone the window is given in. Defaults to UTC
+	TimeZone *string                  `json:"timeZone,omitempty"`
+	Weekly   *WeeklyMaintenanceWindow `json:"weekly,omitempty"`
+}
+
+// ManifestPreview defines model for ManifestPreview.
+type ManifestPreview struct {
+	// Exists The resource already exists in the kubernetes cluster and is left as is
+	Exists bool `json:"exists"`
+
+	// Manifest The Kubernetes resource
+	Manifest map[string]interface{} `json:"manifest"`
+}
+
 // MonitoringInstance Monitoring instance information
 type MonitoringInstance = MonitoringInstanceBaseWithName
 
 // MonitoringInstanceBase Monitoring instance information
 type MonitoringInstanceBase struct {
-	Type MonitoringInstanceBaseType `json:"type,omitempty"`
-	Url  string                     `json:"url,omitempty"`
+	// CreatedAt When the monitoring instance was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// CreatedBy The Everest user who created the monitoring instance. It is not set if unknown, e.g. for the monitoring instances created before it was recorded
+	CreatedBy *string `json:"createdBy,omitempty"`
+
+	// DeletedAt When the monitoring instance was moved to the trash. It is only set for the monitoring instances in the trash
+	DeletedAt *time.Time                 `json:"deletedAt,omitempty"`
+	Type      MonitoringInstanceBaseType `json:"type,omitempty"`
+
+	// UpdatedAt When the monitoring instance was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// Url The PMM address for the pmm type or the full remote write endpoint for the prometheus type, e.g. http://vmsingle:8428/api/v1/write
+	Url string `json:"url,omitempty"`
+
+	// Version The version of the monitoring instance. It is incremented on every update and ignored on creation. The updates shall be based on the current version passed either in this field or in the If-Match header and are rejected with 409 if the monitoring instance has been updated since
+	Version *int64 `json:"version,omitempty"`
 }
 
 // MonitoringInstanceBaseType defines model for MonitoringInstanceBase.Type.
@@ -602,22 +1807,87 @@ type MonitoringInstanceBaseType string
 
 // MonitoringInstanceBaseWithName defines model for MonitoringInstanceBaseWithName.
 type MonitoringInstanceBaseWithName struct {
+	// CreatedAt When the monitoring instance was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// CreatedBy The Everest user who created the monitoring instance. It is not set if unknown, e.g. for the monitoring instances created before it was recorded
+	CreatedBy *string `json:"createdBy,omitempty"`
+
+	// DeletedAt When the monitoring instance was moved to the trash. It is only set for the monitoring instances in the trash
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
 	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
-	Name string                             `json:"name,omitempty"`
-	Type MonitoringInstanceBaseWithNameType `json:"type,omitempty"`
-	Url  string                             `json:"url,omitempty"`
+	Name string `json:"name,omitempty"`
+
+	// Project The project the monitoring instance belongs to
+	Project *string                            `json:"project,omitempty"`
+	Type    MonitoringInstanceBaseWithNameType `json:"type,omitempty"`
+
+	// UpdatedAt When the monitoring instance was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// Url The PMM address for the pmm type or the full remote write endpoint for the prometheus type, e.g. http://vmsingle:8428/api/v1/write
+	Url string `json:"url,omitempty"`
+
+	// Version The version of the monitoring instance. It is incremented on every update and ignored on creation. The updates shall be based on the current version passed either in this field or in the If-Match header and are rejected with 409 if the monitoring instance has been updated since
+	Version *int64 `json:"version,omitempty"`
 }
 
 // MonitoringInstanceBaseWithNameType defines model for MonitoringInstanceBaseWithName.Type.
 type MonitoringInstanceBaseWithNameType string
 
+// MonitoringInstanceClusterStatus Reachability of a monitoring instance from a kubernetes cluster having its monitoring config
+type MonitoringInstanceClusterStatus struct {
+	// Error The error of checking the kubernetes cluster
+	Error *string `json:"error,omitempty"`
+
+	// Errors The remote write requests of the VMAgent since it started which failed without a response
+	Errors *int64 `json:"errors,omitempty"`
+
+	// InUse Whether the monitoring config is used by the cluster monitoring or a database cluster
+	InUse        bool   `json:"inUse"`
+	KubernetesId string `json:"kubernetesId"`
+
+	// Reachability Unknown unless the cluster monitoring VMAgent writes to the monitoring instance
+	Reachability MonitoringInstanceClusterStatusReachability `json:"reachability"`
+
+	// Requests The remote write requests of the VMAgent since it started by the HTTP status code
+	Requests *map[string]int64 `json:"requests,omitempty"`
+}
+
+// MonitoringInstanceClusterStatusReachability Unknown unless the cluster monitoring VMAgent writes to the monitoring instance
+type MonitoringInstanceClusterStatusReachability string
+
 // MonitoringInstanceCreateParams defines model for MonitoringInstanceCreateParams.
 type MonitoringInstanceCreateParams struct {
+	// CreatedAt When the monitoring instance was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// CreatedBy The Everest user who created the monitoring instance. It is not set if unknown, e.g. for the monitoring instances created before it was recorded
+	CreatedBy *string `json:"createdBy,omitempty"`
+
+	// DeletedAt When the monitoring instance was moved to the trash. It is only set for the monitoring instances in the trash
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
 	// Name A user defined string name of the storage in the DNS name format https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-label-names
-	Name string                             `json:"name,omitempty"`
-	Pmm  *PMMMonitoringInstanceSpec         `json:"pmm,omitempty"`
-	Type MonitoringInstanceCreateParamsType `json:"type,omitempty"`
-	Url  string                             `json:"url,omitempty"`
+	Name string                     `json:"name,omitempty"`
+	Pmm  *PMMMonitoringInstanceSpec `json:"pmm,omitempty"`
+
+	// Project The project the monitoring instance belongs to
+	Project *string `json:"project,omitempty"`
+
+	// Prometheus Credentials of a Prometheus compatible remote write endpoint such as VictoriaMetrics. Either username and password or bearerToken are required.
+	Prometheus *PrometheusMonitoringInstanceSpec  `json:"prometheus,omitempty"`
+	Type       MonitoringInstanceCreateParamsType `json:"type,omitempty"`
+
+	// UpdatedAt When the monitoring instance was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// Url The PMM address for the pmm type or the full remote write endpoint for the prometheus type, e.g. http://vmsingle:8428/api/v1/write
+	Url string `json:"url,omitempty"`
+
+	// Version The version of the monitoring instance. It is incremented on every update and ignored on creation. The updates shall be based on the current version passed either in this field or in the If-Match header and are rejected with 409 if the monitoring instance has been updated since
+	Version *int64 `json:"version,omitempty"`
 }
 
 // PMMMonitoringInstanceSpec defines model for .
@@ -627,6 +1897,13 @@ type PMMMonitoringInstanceSpec struct {
 	User     string `json:"user,omitempty"`
 }
 
+// PrometheusMonitoringInstanceSpec Credentials of a Prometheus compatible remote write endpoint such as VictoriaMetrics. Either username and password or bearerToken are required.
+type PrometheusMonitoringInstanceSpec struct {
+	BearerToken string `json:"bearerToken,omitempty"`
+	Password    string `json:"password,omitempty"`
+	Username    string `json:"username,omitempty"`
+}
+
 // MonitoringInstanceCreateParamsType defines model for MonitoringInstanceCreateParams.Type.
 type MonitoringInstanceCreateParamsType string
 
@@ -635,11 +1912,54 @@ type MonitoringInstancePMM struct {
 	Pmm *PMMMonitoringInstanceSpec `json:"pmm,omitempty"`
 }
 
+// MonitoringInstancePrometheus defines model for MonitoringInstancePrometheus.
+type MonitoringInstancePrometheus struct {
+	// Prometheus Credentials of a Prometheus compatible remote write endpoint such as VictoriaMetrics. Either username and password or bearerToken are required.
+	Prometheus *PrometheusMonitoringInstanceSpec `json:"prometheus,omitempty"`
+}
+
+// MonitoringInstanceStatus Health of a monitoring instance
+type MonitoringInstanceStatus struct {
+	// Authenticated Whether the monitoring instance accepted the stored credentials
+	Authenticated bool      `json:"authenticated"`
+	CheckedAt     time.Time `json:"checkedAt"`
+
+	// Error The error of the probe from the backend
+	Error              *string                           `json:"error,omitempty"`
+	KubernetesClusters []MonitoringInstanceClusterStatus `json:"kubernetesClusters"`
+	Name               string                            `json:"name"`
+
+	// Reachable Whether the monitoring instance responded to the backend
+	Reachable bool `json:"reachable"`
+
+	// Version The PMM server version. Empty for the prometheus type
+	Version *string `json:"version,omitempty"`
+}
+
 // MonitoringInstanceUpdateParams defines model for MonitoringInstanceUpdateParams.
 type MonitoringInstanceUpdateParams struct {
-	Pmm  *PMMMonitoringInstanceSpec         `json:"pmm,omitempty"`
-	Type MonitoringInstanceUpdateParamsType `json:"type,omitempty"`
-	Url  string                             `json:"url,omitempty"`
+	// CreatedAt When the monitoring instance was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// CreatedBy The Everest user who created the monitoring instance. It is not set if unknown, e.g. for the monitoring instances created before it was recorded
+	CreatedBy *string `json:"createdBy,omitempty"`
+
+	// DeletedAt When the monitoring instance was moved to the trash. It is only set for the monitoring instances in the trash
+	DeletedAt *time.Time                 `json:"deletedAt,omitempty"`
+	Pmm       *PMMMonitoringInstanceSpec `json:"pmm,omitempty"`
+
+	// Prometheus Credentials of a Prometheus compatible remote write endpoint such as VictoriaMetrics. Either username and password or bearerToken are required.
+	Prometheus *PrometheusMonitoringInstanceSpec  `json:"prometheus,omitempty"`
+	Type       MonitoringInstanceUpdateParamsType `json:"type,omitempty"`
+
+	// UpdatedAt When the monitoring instance was last updated
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// Url The PMM address for the pmm type or the full remote write endpoint for the prometheus type, e.g. http://vmsingle:8428/api/v1/write
+	Url string `json:"url,omitempty"`
+
+	// Version The version of the monitoring instance. It is incremented on every update and ignored on creation. The updates shall be based on the current version passed either in this field or in the If-Match header and are rejected with 409 if the monitoring instance has been updated since
+	Version *int64 `json:"version,omitempty"`
 }
 
 // MonitoringInstanceUpdateParamsType defines model for MonitoringInstanceUpdateParams.Type.
@@ -648,401 +1968,1687 @@ type MonitoringInstanceUpdateParamsType string
 // MonitoringInstancesList defines model for MonitoringInstancesList.
 type MonitoringInstancesList = []MonitoringInstance
 
-// UnregisterKubernetesClusterParams Options for removing a kubernetes cluster
-type UnregisterKubernetesClusterParams struct {
-	// Force Remove the kubernetes cluster even if there are database clusters running.
-	Force bool `json:"force,omitempty"`
+// Namespace Namespace of a kubernetes cluster
+type Namespace struct {
+	CreatedAt *time.Time         `json:"createdAt,omitempty"`
+	Labels    *map[string]string `json:"labels,omitempty"`
 
-	// IgnoreKubernetesUnavailable Ignore if the kubernetes cluster is not available and proceed with removal.
-	IgnoreKubernetesUnavailable bool `json:"ignoreKubernetesUnavailable,omitempty"`
+	// Managed Whether the namespace is prepared for Everest
+	Managed bool    `json:"managed"`
+	Name    string  `json:"name"`
+	Phase   *string `json:"phase,omitempty"`
 }
 
-// UpdateBackupStorageParams Backup storage parameters
-type UpdateBackupStorageParams struct {
-	AccessKey *string `json:"accessKey,omitempty"`
+// NamespaceList defines model for NamespaceList.
+type NamespaceList = []Namespace
 
-	// BucketName The cloud storage bucket/container name
-	BucketName  *string `json:"bucketName,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Region      *string `json:"region,omitempty"`
-	SecretKey   *string `json:"secretKey,omitempty"`
-	Url         *string `json:"url,omitempty"`
+// NamespaceParams Namespace to prepare for Everest
+type NamespaceParams struct {
+	// CopySecrets Names of the secrets copied from the namespace of Everest into the namespace
+	CopySecrets *[]string `json:"copySecrets,omitempty"`
+
+	// Labels Labels set on the namespace
+	Labels *map[string]string `json:"labels,omitempty"`
+	Name   string             `json:"name"`
+
+	// Operators Operators which watch the namespace. Defaults to the installed database operators
+	Operators *[]string `json:"operators,omitempty"`
 }
 
-// IoK8sApimachineryPkgApisMetaV1ListMeta ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
-type IoK8sApimachineryPkgApisMetaV1ListMeta struct {
-	// Continue continue may be set if the user set a limit on the number of items returned, and indicates that the server has more data available. The value is opaque and may be used to issue another request to the endpoint that served this list to retrieve the next set of available objects. Continuing a consistent list may not be possible if the server configuration has changed or more than a few minutes have passed. The resourceVersion field returned when using this continue value will be identical to the value in the first response, unless you have received this token from an error message.
-	Continue *string `json:"continue,omitempty"`
+// NamespacePreparation Result of the preparation of a namespace
+type NamespacePreparation struct {
+	CopiedSecrets *[]string `json:"copiedSecrets,omitempty"`
 
-	// RemainingItemCount remainingItemCount is the number of subsequent items in the list which are not included in this list response. If the list request contained label or field selectors, then the number of remaining items is unknown and the field will be left unset and omitted during serialization. If the list is complete (either because it is not chunking or because this is the last chunk), then there are no more remaining items and this field will be left unset and omitted during serialization. Servers older than v1.15 do not set this field. The intended use of the remainingItemCount is *estimating* the size of a collection. Clients should not rely on the remainingItemCount to be set or to be exact.
-	RemainingItemCount *int64 `json:"remainingItemCount,omitempty"`
+	// Created Whether the namespace was created
+	Created bool `json:"created"`
 
-	// ResourceVersion String that identifies the server's internal version of this object that can be used by clients to determine when objects have changed. Value must be treated as opaque by clients and passed unmodified back to the server. Populated by the system. Read-only. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency
-	ResourceVersion *string `json:"resourceVersion,omitempty"`
+	// Namespace Namespace of a kubernetes cluster
+	Namespace Namespace `json:"namespace"`
 
-	// SelfLink selfLink is a URL representing this object. Populated by the system. Read-only.
-	//
-	// DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.
-	SelfLink *string `json:"selfLink,omitempty"`
+	// WatchedBy Operators reconfigured to watch the namespace
+	WatchedBy *[]string `json:"watchedBy,omitempty"`
 }
 
-// IoK8sApimachineryPkgApisMetaV1StatusCause StatusCause provides more information about an api.Status failure, including cases when multiple errors are encountered.
-type IoK8sApimachineryPkgApisMetaV1StatusCause struct {
-	// Field The field of the resource that has caused this error, as named by its JSON serialization. May include dot and postfix notation for nested attributes. Arrays are zero-indexed.  Fields may appear more than once in an array of causes due to fields having multiple errors. Optional.
-	//
-	// Examples:
-	//   "name" - the field "name" on the current resource
-	//   "items[0].name" - the field "name" on the first array entry in "items"
-	Field *string `json:"field,omitempty"`
+// NamespaceTemplate Template of the namespaces the database clusters of a project are created in
+type NamespaceTemplate struct {
+	// Labels Labels set on the created namespaces
+	Labels *map[string]string `json:"labels,omitempty"`
 
-	// Message A human-readable description of the cause of the error.  This field may be presented as-is to a reader.
-	Message *string `json:"message,omitempty"`
+	// ResourceQuota Limits of the resource quota created in the namespaces
+	ResourceQuota *struct {
+		Cpu     *string `json:"cpu,omitempty"`
+		Memory  *string `json:"memory,omitempty"`
+		Storage *string `json:"storage,omitempty"`
+	} `json:"resourceQuota,omitempty"`
 
-	// Reason A machine-readable description of the cause of the error. If this value is empty there is no information available.
-	Reason *string `json:"reason,omitempty"`
+	// Template Name of the namespace. The {project} and {env} placeholders are replaced with the everest.percona.com/project and everest.percona.com/env labels of the database cluster
+	Template string `json:"template"`
 }
 
-// IoK8sApimachineryPkgApisMetaV1StatusDetailsV2 StatusDetails is a set of additional properties that MAY be set by the server to provide additional information about a response. The Reason field of a Status object defines what attributes will be set. Clients must ignore fields that do not match the defined type of each attribute, and should assume that any attribute may be empty, invalid, or under defined.
-type IoK8sApimachineryPkgApisMetaV1StatusDetailsV2 struct {
-	// Causes The Causes array includes more details associated with the StatusReason failure. Not all StatusReasons may provide detailed causes.
-	Causes *[]IoK8sApimachineryPkgApisMetaV1StatusCause `json:"causes,omitempty"`
-
-	// Group The group attribute of the resource associated with the status StatusReason.
-	Group *string `json:"group,omitempty"`
+// NodeResourceAmounts defines model for NodeResourceAmounts.
+type NodeResourceAmounts struct {
+	CpuMillis             uint64 `json:"cpuMillis"`
+	EphemeralStorageBytes uint64 `json:"ephemeralStorageBytes"`
+	MemoryBytes           uint64 `json:"memoryBytes"`
+}
 
-	// Kind The kind attribute of the resource associated with the status StatusReason. On some operations may differ from the requested resource Kind. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-	Kind *string `json:"kind,omitempty"`
+// NodeResources Allocatable and requested resources of a kubernetes node
+type NodeResources struct {
+	Allocatable NodeResourceAmounts `json:"allocatable"`
+	Name        string              `json:"name"`
+	Requested   NodeResourceAmounts `json:"requested"`
 
-	// Name The name attribute of the resource associated with the status StatusReason (when there is a single name which can be described).
-	Name *string `json:"name,omitempty"`
+	// Unschedulable New pods are not scheduled on the node
+	Unschedulable bool `json:"unschedulable"`
+}
 
-	// RetryAfterSeconds If specified, the time in seconds before the operation should be retried. Some errors may indicate the client must take an alternate action - for those errors this field may indicate how long to wait before taking the alternate action.
-	RetryAfterSeconds *int32 `json:"retryAfterSeconds,omitempty"`
+// NotificationChannel defines model for NotificationChannel.
+type NotificationChannel struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Name      string    `json:"name"`
 
-	// Uid UID of the resource. (when there is a single resource which can be described). More info: http://kubernetes.io/docs/user-guide/identifiers#uids
-	Uid *string `json:"uid,omitempty"`
+	// Target The comma separated email recipients for the email type and the URL for the webhook type
+	Target *string                 `json:"target,omitempty"`
+	Type   NotificationChannelType `json:"type"`
 }
 
-// IoK8sApimachineryPkgApisMetaV1StatusV2 Status is a return value for calls that don't return other objects.
-type IoK8sApimachineryPkgApisMetaV1StatusV2 struct {
-	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-	ApiVersion *string `json:"apiVersion,omitempty"`
+// NotificationChannelType defines model for NotificationChannel.Type.
+type NotificationChannelType string
 
-	// Code Suggested HTTP return code for this status, 0 if not set.
-	Code *int32 `json:"code,omitempty"`
+// NotificationChannelList defines model for NotificationChannelList.
+type NotificationChannelList = []NotificationChannel
 
-	// Details StatusDetails is a set of additional properties that MAY be set by the server to provide additional information about a response. The Reason field of a Status object defines what attributes will be set. Clients must ignore fields that do not match the defined type of each attribute, and should assume that any attribute may be empty, invalid, or under defined.
-	Details *IoK8sApimachineryPkgApisMetaV1StatusDetailsV2 `json:"details,omitempty"`
+// NotificationRule defines model for NotificationRule.
+type NotificationRule struct {
+	ChannelName string    `json:"channelName"`
+	CreatedAt   time.Time `json:"createdAt"`
 
-	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-	Kind *string `json:"kind,omitempty"`
+	// EventTypes The matching event types, e.g. database-cluster-error, backup-failed, restore-completed, restore-failed, backup-verification-failed or volume-capacity. All events match if it is empty
+	EventTypes []string `json:"eventTypes"`
 
-	// Message A human-readable description of the status of this operation.
-	Message *string `json:"message,omitempty"`
+	// KubernetesId Limits the matching events to a kubernetes cluster
+	KubernetesId *string                     `json:"kubernetesId,omitempty"`
+	MinSeverity  NotificationRuleMinSeverity `json:"minSeverity"`
 
-	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
-	Metadata *IoK8sApimachineryPkgApisMetaV1ListMeta `json:"metadata,omitempty"`
+	// Name A name in the DNS label format
+	Name string `json:"name"`
+}
 
-	// Reason A machine-readable description of why this operation is in the "Failure" status. If this value is empty there is no information available. A Reason clarifies an HTTP status code but does not override it.
-	Reason *string `json:"reason,omitempty"`
+// NotificationRuleMinSeverity defines model for NotificationRule.MinSeverity.
+type NotificationRuleMinSeverity string
 
-	// Status Status of the operation. One of: "Success" or "Failure". More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
-	Status *string `json:"status,omitempty"`
+// NotificationRuleList defines model for NotificationRuleList.
+type NotificationRuleList = []NotificationRule
+
+// NotificationRuleParams defines model for NotificationRuleParams.
+type NotificationRuleParams struct {
+	ChannelName string `json:"channelName"`
+
+	// EventTypes The matching event types, e.g. database-cluster-error, backup-failed, restore-completed, restore-failed, backup-verification-failed or volume-capacity. All events match if it is empty
+	EventTypes *[]string `json:"eventTypes,omitempty"`
+
+	// KubernetesId Limits the matching events to a kubernetes cluster
+	KubernetesId *string                            `json:"kubernetesId,omitempty"`
+	MinSeverity  *NotificationRuleParamsMinSeverity `json:"minSeverity,omitempty"`
+
+	// Name A name in the DNS label format
+	Name string `json:"name"`
 }
 
-// CreateBackupStorageJSONRequestBody defines body for CreateBackupStorage for application/json ContentType.
-type CreateBackupStorageJSONRequestBody = CreateBackupStorageParams
+// NotificationRuleParamsMinSeverity defines model for NotificationRuleParams.MinSeverity.
+type NotificationRuleParamsMinSeverity string
 
-// UpdateBackupStorageJSONRequestBody defines body for UpdateBackupStorage for application/json ContentType.
-type UpdateBackupStorageJSONRequestBody = UpdateBackupStorageParams
+// Operator Operator installed on a kubernetes cluster
+type Operator struct {
+	Deployment string `json:"deployment"`
+	Image      string `json:"image"`
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+}
 
-// RegisterKubernetesClusterJSONRequestBody defines body for RegisterKubernetesCluster for application/json ContentType.
-type RegisterKubernetesClusterJSONRequestBody = CreateKubernetesClusterParams
+// OperatorList defines model for OperatorList.
+type OperatorList = []Operator
 
-// UnregisterKubernetesClusterJSONRequestBody defines body for UnregisterKubernetesCluster for application/json ContentType.
-type UnregisterKubernetesClusterJSONRequestBody = UnregisterKubernetesClusterParams
+// OperatorUpgrade defines model for OperatorUpgrade.
+type OperatorUpgrade struct {
+	TargetVersion string `json:"targetVersion"`
+}
 
-// SetKubernetesClusterMonitoringJSONRequestBody defines body for SetKubernetesClusterMonitoring for application/json ContentType.
-type SetKubernetesClusterMonitoringJSONRequestBody = KubernetesClusterMonitoring
+// OrphanedResource defines model for OrphanedResource.
+type OrphanedResource struct {
+	Deleted bool `json:"deleted"`
 
-// CreateDatabaseClusterBackupJSONRequestBody defines body for CreateDatabaseClusterBackup for application/json ContentType.
-type CreateDatabaseClusterBackupJSONRequestBody = DatabaseClusterBackup
+	// Error Why the resource could not be deleted
+	Error *string `json:"error,omitempty"`
 
-// CreateDatabaseClusterRestoreJSONRequestBody defines body for CreateDatabaseClusterRestore for application/json ContentType.
-type CreateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+	// Kind BackupStorage, MonitoringConfig or Secret
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
 
-// UpdateDatabaseClusterRestoreJSONRequestBody defines body for UpdateDatabaseClusterRestore for application/json ContentType.
-type UpdateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+// OrphanedResourceList defines model for OrphanedResourceList.
+type OrphanedResourceList = []OrphanedResource
 
-// CreateDatabaseClusterJSONRequestBody defines body for CreateDatabaseCluster for application/json ContentType.
-type CreateDatabaseClusterJSONRequestBody = DatabaseCluster
+// PendingOperation defines model for PendingOperation.
+type PendingOperation struct {
+	CreatedAt time.Time `json:"createdAt"`
+	CreatedBy *string   `json:"createdBy,omitempty"`
+	Id        string    `json:"id"`
 
-// UpdateDatabaseClusterJSONRequestBody defines body for UpdateDatabaseCluster for application/json ContentType.
-type UpdateDatabaseClusterJSONRequestBody = DatabaseCluster
+	// Message Describes the failure if applying the operation failed
+	Message *string `json:"message,omitempty"`
 
-// UpdateDatabaseEngineJSONRequestBody defines body for UpdateDatabaseEngine for application/json ContentType.
-type UpdateDatabaseEngineJSONRequestBody = DatabaseEngine
+	// State One of pending or failed
+	State string `json:"state"`
 
-// CreateMonitoringInstanceJSONRequestBody defines body for CreateMonitoringInstance for application/json ContentType.
-type CreateMonitoringInstanceJSONRequestBody = MonitoringInstanceCreateParams
+	// Type One of update-database-cluster or upgrade-engine
+	Type string `json:"type"`
+}
 
-// UpdateMonitoringInstanceJSONRequestBody defines body for UpdateMonitoringInstance for application/json ContentType.
-type UpdateMonitoringInstanceJSONRequestBody = MonitoringInstanceUpdateParams
+// PendingOperationList defines model for PendingOperationList.
+type PendingOperationList = []PendingOperation
 
-// AsDatabaseClusterSpecEngineResourcesCpu0 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as a DatabaseClusterSpecEngineResourcesCpu0
-func (t DatabaseCluster_Spec_Engine_Resources_Cpu) AsDatabaseClusterSpecEngineResourcesCpu0() (DatabaseClusterSpecEngineResourcesCpu0, error) {
-	var body DatabaseClusterSpecEngineResourcesCpu0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// PreflightResult defines model for PreflightResult.
+type PreflightResult struct {
+	// Passed Whether the kubernetes cluster has enough capacity for the database cluster
+	Passed bool `json:"passed"`
+
+	// Problems Reasons the database cluster does not fit into the kubernetes cluster
+	Problems []string `json:"problems"`
 }
 
-// FromDatabaseClusterSpecEngineResourcesCpu0 overwrites any union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as the provided DatabaseClusterSpecEngineResourcesCpu0
-func (t *DatabaseCluster_Spec_Engine_Resources_Cpu) FromDatabaseClusterSpecEngineResourcesCpu0(v DatabaseClusterSpecEngineResourcesCpu0) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// PriceRates defines model for PriceRates.
+type PriceRates struct {
+	// Cpu Monthly price of a CPU core
+	Cpu float64 `json:"cpu"`
+
+	// Memory Monthly price of a GiB of memory
+	Memory float64 `json:"memory"`
+
+	// Storage Monthly price of a GiB of storage
+	Storage float64 `json:"storage"`
 }
 
-// MergeDatabaseClusterSpecEngineResourcesCpu0 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu, using the provided DatabaseClusterSpecEngineResourcesCpu0
-func (t *DatabaseCluster_Spec_Engine_Resources_Cpu) MergeDatabaseClusterSpecEngineResourcesCpu0(v DatabaseClusterSpecEngineResourcesCpu0) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// Pricing Monthly prices of the resources requested by the database clusters
+type Pricing struct {
+	// Currency Currency of the prices, USD if unset
+	Currency *string    `json:"currency,omitempty"`
+	Default  PriceRates `json:"default"`
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
+	// Regions Prices overriding the default ones for the kubernetes clusters labeled with the region
+	Regions *map[string]PriceRates `json:"regions,omitempty"`
 }
 
-// AsDatabaseClusterSpecEngineResourcesCpu1 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as a DatabaseClusterSpecEngineResourcesCpu1
-func (t DatabaseCluster_Spec_Engine_Resources_Cpu) AsDatabaseClusterSpecEngineResourcesCpu1() (DatabaseClusterSpecEngineResourcesCpu1, error) {
-	var body DatabaseClusterSpecEngineResourcesCpu1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// Project A project the database clusters, backup storages and monitoring instances belong to
+type Project struct {
+	Description *string `json:"description,omitempty"`
+
+	// Name Name of the project. The database clusters belong to the project they are labeled with in everest.percona.com/project
+	Name string `json:"name"`
 }
 
-// FromDatabaseClusterSpecEngineResourcesCpu1 overwrites any union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as the provided DatabaseClusterSpecEngineResourcesCpu1
-func (t *DatabaseCluster_Spec_Engine_Resources_Cpu) FromDatabaseClusterSpecEngineResourcesCpu1(v DatabaseClusterSpecEngineResourcesCpu1) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// ProjectList defines model for ProjectList.
+type ProjectList = []Project
+
+// ProjectMember defines model for ProjectMember.
+type ProjectMember struct {
+	Role    string `json:"role"`
+	Subject string `json:"subject"`
+
+	// SubjectKind Either user or team
+	SubjectKind string `json:"subjectKind"`
 }
 
-// MergeDatabaseClusterSpecEngineResourcesCpu1 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu, using the provided DatabaseClusterSpecEngineResourcesCpu1
-func (t *DatabaseCluster_Spec_Engine_Resources_Cpu) MergeDatabaseClusterSpecEngineResourcesCpu1(v DatabaseClusterSpecEngineResourcesCpu1) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// ProjectMemberList defines model for ProjectMemberList.
+type ProjectMemberList = []ProjectMember
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
+// ProjectMemberRole defines model for ProjectMemberRole.
+type ProjectMemberRole struct {
+	// Role One of viewer, editor or admin. Viewers may read the resources of the project, editors may also change them and admins may also manage the members
+	Role string `json:"role"`
 }
 
-func (t DatabaseCluster_Spec_Engine_Resources_Cpu) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// PublicStatus Aggregate health of Everest
+type PublicStatus struct {
+	// BackupSuccessRate The part of the backups finished within the backup window which succeeded. Absent if no backup finished within the window.
+	BackupSuccessRate *float64 `json:"backupSuccessRate,omitempty"`
+	BackupWindowHours int      `json:"backupWindowHours"`
+
+	// DatabaseClusters Number of the healthy and degraded items
+	DatabaseClusters HealthCount `json:"databaseClusters"`
+
+	// KubernetesClusters Number of the healthy and degraded items
+	KubernetesClusters HealthCount `json:"kubernetesClusters"`
+
+	// Status operational if all the kubernetes and database clusters are healthy, degraded otherwise
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-func (t *DatabaseCluster_Spec_Engine_Resources_Cpu) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
+// Quota defines model for Quota.
+type Quota struct {
+	// Limits Limits of the resources requested by the database clusters. A zero limit is not enforced
+	Limits  QuotaLimits `json:"limits"`
+	Subject string      `json:"subject"`
+
+	// SubjectKind Either user or team
+	SubjectKind string `json:"subjectKind"`
 }
 
-// AsDatabaseClusterSpecEngineResourcesMemory0 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Memory as a DatabaseClusterSpecEngineResourcesMemory0
-func (t DatabaseCluster_Spec_Engine_Resources_Memory) AsDatabaseClusterSpecEngineResourcesMemory0() (DatabaseClusterSpecEngineResourcesMemory0, error) {
-	var body DatabaseClusterSpecEngineResourcesMemory0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// QuotaConsumption Resources requested by the database clusters
+type QuotaConsumption struct {
+	CpuMillis        int64 `json:"cpuMillis"`
+	DatabaseClusters int   `json:"databaseClusters"`
+	MemoryBytes      int64 `json:"memoryBytes"`
+	StorageBytes     int64 `json:"storageBytes"`
 }
 
-// FromDatabaseClusterSpecEngineResourcesMemory0 overwrites any union data inside the DatabaseCluster_Spec_Engine_Resources_Memory as the provided DatabaseClusterSpecEngineResourcesMemory0
-func (t *DatabaseCluster_Spec_Engine_Resources_Memory) FromDatabaseClusterSpecEngineResourcesMemory0(v DatabaseClusterSpecEngineResourcesMemory0) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// QuotaLimits Limits of the resources requested by the database clusters. A zero limit is not enforced
+type QuotaLimits struct {
+	MaxCpuMillis        *int64 `json:"maxCpuMillis,omitempty"`
+	MaxDatabaseClusters *int   `json:"maxDatabaseClusters,omitempty"`
+	MaxMemoryBytes      *int64 `json:"maxMemoryBytes,omitempty"`
+	MaxStorageBytes     *int64 `json:"maxStorageBytes,omitempty"`
 }
 
-// MergeDatabaseClusterSpecEngineResourcesMemory0 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Resources_Memory, using the provided DatabaseClusterSpecEngineResourcesMemory0
-func (t *DatabaseCluster_Spec_Engine_Resources_Memory) MergeDatabaseClusterSpecEngineResourcesMemory0(v DatabaseClusterSpecEngineResourcesMemory0) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// QuotaList defines model for QuotaList.
+type QuotaList = []Quota
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
-}
+// QuotaUsage defines model for QuotaUsage.
+type QuotaUsage struct {
+	// Limits Limits of the resources requested by the database clusters. A zero limit is not enforced
+	Limits      *QuotaLimits `json:"limits,omitempty"`
+	Subject     string       `json:"subject"`
+	SubjectKind string       `json:"subjectKind"`
 
-// AsDatabaseClusterSpecEngineResourcesMemory1 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Memory as a DatabaseClusterSpecEngineResourcesMemory1
-func (t DatabaseCluster_Spec_Engine_Resources_Memory) AsDatabaseClusterSpecEngineResourcesMemory1() (DatabaseClusterSpecEngineResourcesMemory1, error) {
-	var body DatabaseClusterSpecEngineResourcesMemory1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+	// UnreachableClusters The kubernetes clusters the database clusters of which are accounted for with their last known state or not at all
+	UnreachableClusters []UnreachableCluster `json:"unreachableClusters"`
 
-// FromDatabaseClusterSpecEngineResourcesMemory1 overwrites any union data inside the DatabaseCluster_Spec_Engine_Resources_Memory as the provided DatabaseClusterSpecEngineResourcesMemory1
-func (t *DatabaseCluster_Spec_Engine_Resources_Memory) FromDatabaseClusterSpecEngineResourcesMemory1(v DatabaseClusterSpecEngineResourcesMemory1) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+	// Used Resources requested by the database clusters
+	Used QuotaConsumption `json:"used"`
 }
 
-// MergeDatabaseClusterSpecEngineResourcesMemory1 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Resources_Memory, using the provided DatabaseClusterSpecEngineResourcesMemory1
-func (t *DatabaseCluster_Spec_Engine_Resources_Memory) MergeDatabaseClusterSpecEngineResourcesMemory1(v DatabaseClusterSpecEngineResourcesMemory1) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// RecommendedVersion defines model for RecommendedVersion.
+type RecommendedVersion struct {
+	Critical *bool `json:"critical,omitempty"`
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
+	// ImageHash Digest of the image
+	ImageHash *string `json:"imageHash,omitempty"`
+	ImagePath string  `json:"imagePath"`
+
+	// Status One of recommended, available or disabled
+	Status  string `json:"status"`
+	Version string `json:"version"`
 }
 
-func (t DatabaseCluster_Spec_Engine_Resources_Memory) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// RecommendedVersions defines model for RecommendedVersions.
+type RecommendedVersions = []EngineRecommendedVersions
+
+// ReplicationSnapshot State of the primary Everest instance replicated to its standby instances
+type ReplicationSnapshot map[string]interface{}
+
+// ReplicationStatus defines model for ReplicationStatus.
+type ReplicationStatus struct {
+	// LastError Error of the last replication attempt
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastSyncedAt Time the state was last replicated from the primary instance
+	LastSyncedAt *time.Time `json:"lastSyncedAt,omitempty"`
+
+	// MissingSecrets IDs of the referenced secrets which are not present in the secrets storage of the standby instance
+	MissingSecrets *[]string `json:"missingSecrets,omitempty"`
+
+	// PrimaryUrl URL of the primary instance the state is replicated from
+	PrimaryUrl *string               `json:"primaryUrl,omitempty"`
+	Role       ReplicationStatusRole `json:"role"`
 }
 
-func (t *DatabaseCluster_Spec_Engine_Resources_Memory) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
+// ReplicationStatusRole defines model for ReplicationStatus.Role.
+type ReplicationStatusRole string
+
+// ResourceAmounts defines model for ResourceAmounts.
+type ResourceAmounts struct {
+	CpuMillis   *uint64 `json:"cpuMillis,omitempty"`
+	DiskBytes   *uint64 `json:"diskBytes,omitempty"`
+	MemoryBytes *uint64 `json:"memoryBytes,omitempty"`
 }
 
-// AsDatabaseClusterSpecEngineStorageSize0 returns the union data inside the DatabaseCluster_Spec_Engine_Storage_Size as a DatabaseClusterSpecEngineStorageSize0
-func (t DatabaseCluster_Spec_Engine_Storage_Size) AsDatabaseClusterSpecEngineStorageSize0() (DatabaseClusterSpecEngineStorageSize0, error) {
-	var body DatabaseClusterSpecEngineStorageSize0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// RestoreHistory defines model for RestoreHistory.
+type RestoreHistory = []RestoreHistoryEntry
+
+// RestoreHistoryEntry defines model for RestoreHistoryEntry.
+type RestoreHistoryEntry struct {
+	// BackupName Name of the database cluster backup restored if any
+	BackupName  *string    `json:"backupName,omitempty"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// CreatedBy User who created the restore if known
+	CreatedBy     *string `json:"createdBy,omitempty"`
+	DbClusterName string  `json:"dbClusterName"`
+
+	// DurationSeconds Duration of the completed restore
+	DurationSeconds *int64    `json:"durationSeconds,omitempty"`
+	KubernetesId    string    `json:"kubernetesId"`
+	Message         *string   `json:"message,omitempty"`
+	Name            string    `json:"name"`
+	StartedAt       time.Time `json:"startedAt"`
+	State           *string   `json:"state,omitempty"`
 }
 
-// FromDatabaseClusterSpecEngineStorageSize0 overwrites any union data inside the DatabaseCluster_Spec_Engine_Storage_Size as the provided DatabaseClusterSpecEngineStorageSize0
-func (t *DatabaseCluster_Spec_Engine_Storage_Size) FromDatabaseClusterSpecEngineStorageSize0(v DatabaseClusterSpecEngineStorageSize0) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// RetentionPolicy Backup retention policy. A database cluster policy overrides the policy of the backup storage. The most recent successful backup, the backups under legal hold and the backups younger than the compliance age are never pruned
+type RetentionPolicy struct {
+	// MaxAgeDays Prune the backups older than this number of days. Zero disables the limit
+	MaxAgeDays *int `json:"maxAgeDays,omitempty"`
+
+	// MaxCopies Keep this number of the most recent successful backups only. Zero disables the limit
+	MaxCopies *int `json:"maxCopies,omitempty"`
 }
 
-// MergeDatabaseClusterSpecEngineStorageSize0 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Storage_Size, using the provided DatabaseClusterSpecEngineStorageSize0
-func (t *DatabaseCluster_Spec_Engine_Storage_Size) MergeDatabaseClusterSpecEngineStorageSize0(v DatabaseClusterSpecEngineStorageSize0) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	Description *string          `json:"description,omitempty"`
+	Kind        SearchResultKind `json:"kind"`
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
+	// KubernetesId The kubernetes cluster of the database cluster, or the id of the kubernetes cluster
+	KubernetesId *string `json:"kubernetesId,omitempty"`
+
+	// KubernetesName The name of the kubernetes cluster of the database cluster
+	KubernetesName *string `json:"kubernetesName,omitempty"`
+
+	// Matches The fields matching the search, any of name, description, bucketName, url, labels, backupStorage or monitoringInstance
+	Matches []string `json:"matches"`
+	Name    string   `json:"name"`
 }
 
-// AsDatabaseClusterSpecEngineStorageSize1 returns the union data inside the DatabaseCluster_Spec_Engine_Storage_Size as a DatabaseClusterSpecEngineStorageSize1
-func (t DatabaseCluster_Spec_Engine_Storage_Size) AsDatabaseClusterSpecEngineStorageSize1() (DatabaseClusterSpecEngineStorageSize1, error) {
-	var body DatabaseClusterSpecEngineStorageSize1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// SearchResultKind defines model for SearchResult.Kind.
+type SearchResultKind string
+
+// SearchResults Resources matching a search
+type SearchResults struct {
+	// Partial Whether the database clusters of some kubernetes clusters are stale or missing
+	Partial bool `json:"partial"`
+
+	// Results The resources matching the name first
+	Results []SearchResult `json:"results"`
+
+	// UnreachableClusters Kubernetes clusters which could not be reached. Their last known database clusters are searched if any
+	UnreachableClusters []UnreachableCluster `json:"unreachableClusters"`
 }
 
-// FromDatabaseClusterSpecEngineStorageSize1 overwrites any union data inside the DatabaseCluster_Spec_Engine_Storage_Size as the provided DatabaseClusterSpecEngineStorageSize1
-func (t *DatabaseCluster_Spec_Engine_Storage_Size) FromDatabaseClusterSpecEngineStorageSize1(v DatabaseClusterSpecEngineStorageSize1) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// Session Tokens of a session which are returned once
+type Session struct {
+	AccessToken           string    `json:"accessToken"`
+	AccessTokenExpiresAt  time.Time `json:"accessTokenExpiresAt"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
+	Username              string    `json:"username"`
 }
 
-// MergeDatabaseClusterSpecEngineStorageSize1 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Storage_Size, using the provided DatabaseClusterSpecEngineStorageSize1
-func (t *DatabaseCluster_Spec_Engine_Storage_Size) MergeDatabaseClusterSpecEngineStorageSize1(v DatabaseClusterSpecEngineStorageSize1) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// SessionLogin Either the username and the password of the admin user or the authorization code returned by the OIDC identity provider to the redirect URI
+type SessionLogin struct {
+	Code *string `json:"code,omitempty"`
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
+	// CodeVerifier The PKCE code verifier of the authorization request
+	CodeVerifier *string `json:"codeVerifier,omitempty"`
+	Password     *string `json:"password,omitempty"`
+	RedirectUri  *string `json:"redirectUri,omitempty"`
+	Username     *string `json:"username,omitempty"`
 }
 
-func (t DatabaseCluster_Spec_Engine_Storage_Size) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// SessionRefresh defines model for SessionRefresh.
+type SessionRefresh struct {
+	RefreshToken string `json:"refreshToken"`
 }
 
-func (t *DatabaseCluster_Spec_Engine_Storage_Size) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
+// SetupAdmin defines model for SetupAdmin.
+type SetupAdmin struct {
+	Password string `json:"password"`
+	Username string `json:"username"`
 }
 
-// AsDatabaseClusterSpecMonitoringResourcesLimits0 returns the union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties as a DatabaseClusterSpecMonitoringResourcesLimits0
-func (t DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) AsDatabaseClusterSpecMonitoringResourcesLimits0() (DatabaseClusterSpecMonitoringResourcesLimits0, error) {
-	var body DatabaseClusterSpecMonitoringResourcesLimits0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// SetupDefaultBackupStorage defines model for SetupDefaultBackupStorage.
+type SetupDefaultBackupStorage struct {
+	// Name Name of the backup storage
+	Name string `json:"name"`
 }
 
-// FromDatabaseClusterSpecMonitoringResourcesLimits0 overwrites any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties as the provided DatabaseClusterSpecMonitoringResourcesLimits0
-func (t *DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) FromDatabaseClusterSpecMonitoringResourcesLimits0(v DatabaseClusterSpecMonitoringResourcesLimits0) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
+// SetupState defines model for SetupState.
+type SetupState struct {
+	// Completed Whether all the setup steps are completed
+	Completed bool        `json:"completed"`
+	Steps     []SetupStep `json:"steps"`
 }
 
-// MergeDatabaseClusterSpecMonitoringResourcesLimits0 performs a merge with any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties, using the provided DatabaseClusterSpecMonitoringResourcesLimits0
-func (t *DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) MergeDatabaseClusterSpecMonitoringResourcesLimits0(v DatabaseClusterSpecMonitoringResourcesLimits0) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// SetupStep defines model for SetupStep.
+type SetupStep struct {
+	Completed bool `json:"completed"`
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
+	// Name One of admin, secrets-backend, kubernetes-cluster or default-backup-storage in the order the steps are passed
+	Name string `json:"name"`
 }
 
-// AsDatabaseClusterSpecMonitoringResourcesLimits1 returns the union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties as a DatabaseClusterSpecMonitoringResourcesLimits1
-func (t DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) AsDatabaseClusterSpecMonitoringResourcesLimits1() (DatabaseClusterSpecMonitoringResourcesLimits1, error) {
-	var body DatabaseClusterSpecMonitoringResourcesLimits1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// SizingPreset Resource preset of a database cluster
+type SizingPreset struct {
+	Cpu        string           `json:"cpu"`
+	EngineType string           `json:"engineType"`
+	Memory     string           `json:"memory"`
+	Name       SizingPresetName `json:"name"`
+	Replicas   int32            `json:"replicas"`
+	Storage    string           `json:"storage"`
 }
 
-// FromDatabaseClusterSpecMonitoringResourcesLimits1 overwrites any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties as the provided DatabaseClusterSpecMonitoringResourcesLimits1
-func (t *DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) FromDatabaseClusterSpecMonitoringResourcesLimits1(v DatabaseClusterSpecMonitoringResourcesLimits1) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
-}
+// SizingPresetName defines model for SizingPreset.Name.
+type SizingPresetName string
 
-// MergeDatabaseClusterSpecMonitoringResourcesLimits1 performs a merge with any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties, using the provided DatabaseClusterSpecMonitoringResourcesLimits1
-func (t *DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) MergeDatabaseClusterSpecMonitoringResourcesLimits1(v DatabaseClusterSpecMonitoringResourcesLimits1) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// SizingPresetList defines model for SizingPresetList.
+type SizingPresetList = []SizingPreset
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
-	return err
-}
+// StorageClass Storage class of a kubernetes cluster
+type StorageClass struct {
+	// AllowVolumeExpansion Whether the persistent volumes of the storage class can be expanded
+	AllowVolumeExpansion bool `json:"allowVolumeExpansion"`
 
-func (t DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+	// Default Whether the storage class is used for the persistent volume claims which do not request one
+	Default     bool   `json:"default"`
+	Name        string `json:"name"`
+	Provisioner string `json:"provisioner"`
 }
 
-func (t *DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
+// StorageClassList defines model for StorageClassList.
+type StorageClassList = []StorageClass
 
-// AsDatabaseClusterSpecMonitoringResourcesRequests0 returns the union data inside the DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties as a DatabaseClusterSpecMonitoringResourcesRequests0
-func (t DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) AsDatabaseClusterSpecMonitoringResourcesRequests0() (DatabaseClusterSpecMonitoringResourcesRequests0, error) {
-	var body DatabaseClusterSpecMonitoringResourcesRequests0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+// StoredBackup Backup artifact found in a backup storage
+type StoredBackup struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DbClusterName The name of the database cluster the backup was taken of
+	DbClusterName string `json:"dbClusterName"`
+
+	// EngineType One of pxc, psmdb or postgresql
+	EngineType string `json:"engineType"`
+
+	// Path The key prefix of the backup artifact in the bucket
+	Path string `json:"path"`
+
+	// Size The size of the backup artifact in bytes
+	Size int64 `json:"size"`
+
+	// Type Either full or incremental
+	Type string `json:"type"`
+}
+
+// StoredBackupList defines model for StoredBackupList.
+type StoredBackupList = []StoredBackup
+
+// Summary Counts and health breakdowns aggregated across all the kubernetes clusters
+type Summary struct {
+	// Backups Backups started within the window
+	Backups            SummaryBackups            `json:"backups"`
+	DatabaseClusters   SummaryDatabaseClusters   `json:"databaseClusters"`
+	KubernetesClusters SummaryKubernetesClusters `json:"kubernetesClusters"`
+
+	// Restores Restores started within the window
+	Restores SummaryRestores `json:"restores"`
+
+	// UnreachableClusters The kubernetes clusters the last known state of which is summarized, if any, as they could not be reached
+	UnreachableClusters []UnreachableCluster `json:"unreachableClusters"`
+	UpdatedAt           time.Time            `json:"updatedAt"`
+
+	// WindowHours The period the backups and the restores are counted over
+	WindowHours int `json:"windowHours"`
+}
+
+// SummaryBackups Backups started within the window
+type SummaryBackups struct {
+	Failed    int `json:"failed"`
+	Running   int `json:"running"`
+	Succeeded int `json:"succeeded"`
+}
+
+// SummaryDatabaseClusters defines model for .
+type SummaryDatabaseClusters struct {
+	// ByEngine Number of the database clusters by the engine type
+	ByEngine map[string]int `json:"byEngine"`
+
+	// ByState Number of the database clusters by their state, e.g. ready or error
+	ByState map[string]int `json:"byState"`
+	Total   int            `json:"total"`
+}
+
+// SummaryKubernetesClusters defines model for .
+type SummaryKubernetesClusters struct {
+	Incompatible int `json:"incompatible"`
+	Total        int `json:"total"`
+	Unreachable  int `json:"unreachable"`
+}
+
+// SummaryRestores Restores started within the window
+type SummaryRestores struct {
+	Failed    int `json:"failed"`
+	Running   int `json:"running"`
+	Succeeded int `json:"succeeded"`
+}
+
+// TemporaryAccess defines model for TemporaryAccess.
+type TemporaryAccess struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+	Password  string    `json:"password"`
+	ReadOnly  bool      `json:"readOnly"`
+	Username  string    `json:"username"`
+}
+
+// TemporaryAccessRequest defines model for TemporaryAccessRequest.
+type TemporaryAccessRequest struct {
+	// ReadOnly Grant reading data and monitoring only
+	ReadOnly *bool `json:"readOnly,omitempty"`
+
+	// TtlMinutes Minutes the database user is valid for
+	TtlMinutes *int `json:"ttlMinutes,omitempty"`
+}
+
+// UnmanagedBackupStorage Backup storage which exists in a kubernetes cluster but is not managed by Everest
+type UnmanagedBackupStorage struct {
+	BucketName string `json:"bucketName"`
+
+	// CredentialsFound Whether the credentials can be captured from the referenced secret. Otherwise they shall be provided on import.
+	CredentialsFound      bool    `json:"credentialsFound"`
+	CredentialsSecretName string  `json:"credentialsSecretName"`
+	Name                  string  `json:"name"`
+	Region                string  `json:"region"`
+	Type                  string  `json:"type"`
+	Url                   *string `json:"url,omitempty"`
+}
+
+// UnmanagedConfigs Configs which exist in a kubernetes cluster but are not managed by Everest
+type UnmanagedConfigs struct {
+	BackupStorages      []UnmanagedBackupStorage      `json:"backupStorages"`
+	MonitoringInstances []UnmanagedMonitoringInstance `json:"monitoringInstances"`
+}
+
+// UnmanagedMonitoringInstance Monitoring config which exists in a kubernetes cluster but is not managed by Everest
+type UnmanagedMonitoringInstance struct {
+	// CredentialsFound Whether the credentials can be captured from the referenced secret. Otherwise they shall be provided on import.
+	CredentialsFound      bool   `json:"credentialsFound"`
+	CredentialsSecretName string `json:"credentialsSecretName"`
+	Name                  string `json:"name"`
+	Type                  string `json:"type"`
+	Url                   string `json:"url"`
+}
+
+// UnreachableCluster Kubernetes cluster which could not be reached while aggregating the state of all of them
+type UnreachableCluster struct {
+	Error          string `json:"error"`
+	KubernetesId   string `json:"kubernetesId"`
+	KubernetesName string `json:"kubernetesName"`
+
+	// LastSeenAt When the returned state of the kubernetes cluster was fetched. Absent if no state is known
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty"`
+}
+
+// UnregisterKubernetesClusterParams Options for removing a kubernetes cluster
+type UnregisterKubernetesClusterParams struct {
+	// Cascade Clean up the kubernetes cluster before removing it. The database clusters are deleted and the backup storages and monitoring configs pushed by Everest are removed. The cleanup runs in the background and its progress is returned by the deregistration endpoint
+	Cascade bool `json:"cascade,omitempty"`
+
+	// Force Remove the kubernetes cluster even if there are database clusters running.
+	Force bool `json:"force,omitempty"`
+
+	// IgnoreKubernetesUnavailable Ignore if the kubernetes cluster is not available and proceed with removal.
+	IgnoreKubernetesUnavailable bool `json:"ignoreKubernetesUnavailable,omitempty"`
+
+	// OrphanDatabaseClusters Keep the database clusters running on a cascade removal. The configs they use are kept as well
+	OrphanDatabaseClusters bool `json:"orphanDatabaseClusters,omitempty"`
+}
+
+// UpdateAlertRuleTemplateParams defines model for UpdateAlertRuleTemplateParams.
+type UpdateAlertRuleTemplateParams = AlertRuleTemplateSpec
+
+// UpdateBackupStorageParams Backup storage parameters
+type UpdateBackupStorageParams struct {
+	AccessKey *string `json:"accessKey,omitempty"`
+
+	// BucketName The cloud storage bucket/container name
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// CaCert The PEM encoded CA bundle trusted by the S3-compatible storage. An empty string removes the CA bundle.
+	CaCert      *string `json:"caCert,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// ForcePathStyle Whether the bucket is addressed in the path instead of the host name. Required by the S3-compatible storages serving the path-style requests only, like MinIO or Ceph RGW. Defaults to false.
+	ForcePathStyle *bool   `json:"forcePathStyle,omitempty"`
+	Region         *string `json:"region,omitempty"`
+	SecretKey      *string `json:"secretKey,omitempty"`
+
+	// SessionToken The session token of temporary static credentials.
+	SessionToken *string `json:"sessionToken,omitempty"`
+
+	// Url The endpoint of an S3-compatible storage. It shall be an absolute http or https URL.
+	Url *string `json:"url,omitempty"`
+
+	// VerifyTLS Whether the certificate of the storage is verified. Defaults to true.
+	VerifyTLS *bool `json:"verifyTLS,omitempty"`
+
+	// Version The version of the backup storage the update is based on. Either it or the If-Match header is required. The update is rejected with 409 if the backup storage has been updated since
+	Version *int64 `json:"version,omitempty"`
+}
+
+// UpdateKubernetesClusterParams Changes of the kubernetes cluster settings
+type UpdateKubernetesClusterParams struct {
+	// DefaultMonitoringInstanceName The monitoring instance the new database clusters are attached to unless they opt out. An empty value unsets it
+	DefaultMonitoringInstanceName *string `json:"defaultMonitoringInstanceName,omitempty"`
+
+	// Labels Replaces the labels of the kubernetes cluster. An empty object removes all of them
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Version The version of the kubernetes cluster the update is based on. Either it or the If-Match header is required. The update is rejected with 409 if the kubernetes cluster has been updated since
+	Version *int64 `json:"version,omitempty"`
+}
+
+// UpdateNotificationChannelParams defines model for UpdateNotificationChannelParams.
+type UpdateNotificationChannelParams struct {
+	Secret *string `json:"secret,omitempty"`
+	Target *string `json:"target,omitempty"`
+}
+
+// UpdateWebhookParams defines model for UpdateWebhookParams.
+type UpdateWebhookParams struct {
+	EventTypes *[]UpdateWebhookParamsEventTypes `json:"eventTypes,omitempty"`
+
+	// Secret The key the payloads are signed with. An empty string disables signing
+	Secret *string `json:"secret,omitempty"`
+	Url    *string `json:"url,omitempty"`
+}
+
+// UpdateWebhookParamsEventTypes defines model for UpdateWebhookParams.EventTypes.
+type UpdateWebhookParamsEventTypes string
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// EventTypes The lifecycle events posted to the webhook. All events are posted if it is empty
+	EventTypes []WebhookEventTypes `json:"eventTypes"`
+	Name       string              `json:"name"`
+	Url        string              `json:"url"`
+}
+
+// WebhookEventTypes defines model for Webhook.EventTypes.
+type WebhookEventTypes string
+
+// WebhookList defines model for WebhookList.
+type WebhookList = []Webhook
+
+// WeeklyMaintenanceWindow defines model for WeeklyMaintenanceWindow.
+type WeeklyMaintenanceWindow struct {
+	Days []WeeklyMaintenanceWindowDays `json:"days"`
+
+	// StartTime The start time in the HH:MM format
+	StartTime string `json:"startTime"`
+}
+
+// WeeklyMaintenanceWindowDays defines model for WeeklyMaintenanceWindow.Days.
+type WeeklyMaintenanceWindowDays string
+
+// IoK8sApimachineryPkgApisMetaV1ListMeta ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+type IoK8sApimachineryPkgApisMetaV1ListMeta struct {
+	// Continue continue may be set if the user set a limit on the number of items returned, and indicates that the server has more data available. The value is opaque and may be used to issue another request to the endpoint that served this list to retrieve the next set of available objects. Continuing a consistent list may not be possible if the server configuration has changed or more than a few minutes have passed. The resourceVersion field returned when using this continue value will be identical to the value in the first response, unless you have received this token from an error message.
+	Continue *string `json:"continue,omitempty"`
+
+	// RemainingItemCount remainingItemCount is the number of subsequent items in the list which are not included in this list response. If the list request contained label or field selectors, then the number of remaining items is unknown and the field will be left unset and omitted during serialization. If the list is complete (either because it is not chunking or because this is the last chunk), then there are no more remaining items and this field will be left unset and omitted during serialization. Servers older than v1.15 do not set this field. The intended use of the remainingItemCount is *estimating* the size of a collection. Clients should not rely on the remainingItemCount to be set or to be exact.
+	RemainingItemCount *int64 `json:"remainingItemCount,omitempty"`
+
+	// ResourceVersion String that identifies the server's internal version of this object that can be used by clients to determine when objects have changed. Value must be treated as opaque by clients and passed unmodified back to the server. Populated by the system. Read-only. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency
+	ResourceVersion *string `json:"resourceVersion,omitempty"`
+
+	// SelfLink selfLink is a URL representing this object. Populated by the system. Read-only.
+	//
+	// DEPRECATED Kubernetes will stop propagating this field in 1.20 release and the field is planned to be removed in 1.21 release.
+	SelfLink *string `json:"selfLink,omitempty"`
+}
+
+// IoK8sApimachineryPkgApisMetaV1StatusCause StatusCause provides more information about an api.Status failure, including cases when multiple errors are encountered.
+type IoK8sApimachineryPkgApisMetaV1StatusCause struct {
+	// Field The field of the resource that has caused this error, as named by its JSON serialization. May include dot and postfix notation for nested attributes. Arrays are zero-indexed.  Fields may appear more than once in an array of causes due to fields having multiple errors. Optional.
+	//
+	// Examples:
+	//   "name" - the field "name" on the current resource
+	//   "items[0].name" - the field "name" on the first array entry in "items"
+	Field *string `json:"field,omitempty"`
+
+	// Message A human-readable description of the cause of the error.  This field may be presented as-is to a reader.
+	Message *string `json:"message,omitempty"`
+
+	// Reason A machine-readable description of the cause of the error. If this value is empty there is no information available.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// IoK8sApimachineryPkgApisMetaV1StatusDetailsV2 StatusDetails is a set of additional properties that MAY be set by the server to provide additional information about a response. The Reason field of a Status object defines what attributes will be set. Clients must ignore fields that do not match the defined type of each attribute, and should assume that any attribute may be empty, invalid, or under defined.
+type IoK8sApimachineryPkgApisMetaV1StatusDetailsV2 struct {
+	// Causes The Causes array includes more details associated with the StatusReason failure. Not all StatusReasons may provide detailed causes.
+	Causes *[]IoK8sApimachineryPkgApisMetaV1StatusCause `json:"causes,omitempty"`
+
+	// Group The group attribute of the resource associated with the status StatusReason.
+	Group *string `json:"group,omitempty"`
+
+	// Kind The kind attribute of the resource associated with the status StatusReason. On some operations may differ from the requested resource Kind. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind *string `json:"kind,omitempty"`
+
+	// Name The name attribute of the resource associated with the status StatusReason (when there is a single name which can be described).
+	Name *string `json:"name,omitempty"`
+
+	// RetryAfterSeconds If specified, the time in seconds before the operation should be retried. Some errors may indicate the client must take an alternate action - for those errors this field may indicate how long to wait before taking the alternate action.
+	RetryAfterSeconds *int32 `json:"retryAfterSeconds,omitempty"`
+
+	// Uid UID of the resource. (when there is a single resource which can be described). More info: http://kubernetes.io/docs/user-guide/identifiers#uids
+	Uid *string `json:"uid,omitempty"`
+}
+
+// IoK8sApimachineryPkgApisMetaV1StatusV2 Status is a return value for calls that don't return other objects.
+type IoK8sApimachineryPkgApisMetaV1StatusV2 struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+	ApiVersion *string `json:"apiVersion,omitempty"`
+
+	// Code Suggested HTTP return code for this status, 0 if not set.
+	Code *int32 `json:"code,omitempty"`
+
+	// Details StatusDetails is a set of additional properties that MAY be set by the server to provide additional information about a response. The Reason field of a Status object defines what attributes will be set. Clients must ignore fields that do not match the defined type of each attribute, and should assume that any attribute may be empty, invalid, or under defined.
+	Details *IoK8sApimachineryPkgApisMetaV1StatusDetailsV2 `json:"details,omitempty"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+	Kind *string `json:"kind,omitempty"`
+
+	// Message A human-readable description of the status of this operation.
+	Message *string `json:"message,omitempty"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata *IoK8sApimachineryPkgApisMetaV1ListMeta `json:"metadata,omitempty"`
+
+	// Reason A machine-readable description of why this operation is in the "Failure" status. If this value is empty there is no information available. A Reason clarifies an HTTP status code but does not override it.
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Status of the operation. One of: "Success" or "Failure". More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	Status *string `json:"status,omitempty"`
+}
+
+// ListAuditEntriesParams defines parameters for ListAuditEntries.
+type ListAuditEntriesParams struct {
+	// Action Return the entries of the action only
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// Limit Maximum number of the audit entries to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of the audit entries to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListBackupStoragesParams defines parameters for ListBackupStorages.
+type ListBackupStoragesParams struct {
+	// SortBy Field to sort the backup storages by
+	SortBy *ListBackupStoragesParamsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+
+	// Order Sort order of the returned items
+	Order *ListBackupStoragesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Type Return only the backup storages of the given type
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// Region Return only the backup storages in the given region
+	Region *string `form:"region,omitempty" json:"region,omitempty"`
+
+	// NamePrefix Return only the backup storages which names start with the given prefix
+	NamePrefix *string `form:"name_prefix,omitempty" json:"name_prefix,omitempty"`
+
+	// Deleted Return the backup storages in the trash instead of the active ones
+	Deleted *bool `form:"deleted,omitempty" json:"deleted,omitempty"`
+
+	// Limit Maximum number of the backup storages to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of the backup storages to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListBackupStoragesParamsSortBy defines parameters for ListBackupStorages.
+type ListBackupStoragesParamsSortBy string
+
+// ListBackupStoragesParamsOrder defines parameters for ListBackupStorages.
+type ListBackupStoragesParamsOrder string
+
+// ListStoredBackupsParams defines parameters for ListStoredBackups.
+type ListStoredBackupsParams struct {
+	// Prefix Only the objects with the key prefix are looked at, like the name of a database cluster
+	Prefix *string `form:"prefix,omitempty" json:"prefix,omitempty"`
+}
+
+// ListBackupVerificationsParams defines parameters for ListBackupVerifications.
+type ListBackupVerificationsParams struct {
+	// KubernetesId Return the verifications of the kubernetes cluster only
+	KubernetesId *string `form:"kubernetesId,omitempty" json:"kubernetesId,omitempty"`
+
+	// DbClusterName Return the verifications of the database cluster only
+	DbClusterName *string `form:"dbClusterName,omitempty" json:"dbClusterName,omitempty"`
+
+	// State Return the verifications in the state only
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Limit Maximum number of the backup verifications to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of the backup verifications to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListRestoreHistoryParams defines parameters for ListRestoreHistory.
+type ListRestoreHistoryParams struct {
+	// KubernetesId Return the restores of the kubernetes cluster only
+	KubernetesId *string `form:"kubernetesId,omitempty" json:"kubernetesId,omitempty"`
+
+	// Cluster Return the restores of the database cluster only
+	Cluster *string `form:"cluster,omitempty" json:"cluster,omitempty"`
+
+	// Status Return the restores in the state only
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetInventoryParams defines parameters for GetInventory.
+type GetInventoryParams struct {
+	// Format Either json (the default) or csv. The csv has a row per component image.
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+
+	// LabelSelector Only include the kubernetes clusters matching the label selector, e.g. env=prod,region=eu
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
+// ListKubernetesClustersParams defines parameters for ListKubernetesClusters.
+type ListKubernetesClustersParams struct {
+	// LabelSelector Only include the kubernetes clusters matching the label selector, e.g. env=prod,region=eu
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
+// CreateDatabaseClusterBackupParams defines parameters for CreateDatabaseClusterBackup.
+type CreateDatabaseClusterBackupParams struct {
+	// Namespace Namespace of the database cluster the resource belongs to if it was created in a namespace from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// DeleteDatabaseClusterBackupParams defines parameters for DeleteDatabaseClusterBackup.
+type DeleteDatabaseClusterBackupParams struct {
+	// Namespace Namespace of the database cluster the resource belongs to if it was created in a namespace from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Force Delete the backup even if it is under legal hold or younger than the compliance age. The override is recorded in the audit entries
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+
+	// Reason Reason of the override, required if force is set
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty"`
+}
+
+// GetDatabaseClusterBackupParams defines parameters for GetDatabaseClusterBackup.
+type GetDatabaseClusterBackupParams struct {
+	// Namespace Namespace of the database cluster the resource belongs to if it was created in a namespace from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// SetDatabaseClusterBackupLegalHoldParams defines parameters for SetDatabaseClusterBackupLegalHold.
+type SetDatabaseClusterBackupLegalHoldParams struct {
+	// Namespace Namespace of the database cluster the resource belongs to if it was created in a namespace from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// CreateDatabaseClusterRestoreParams defines parameters for CreateDatabaseClusterRestore.
+type CreateDatabaseClusterRestoreParams struct {
+	// Namespace Namespace of the database cluster the resource belongs to if it was created in a namespace from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// DeleteDatabaseClusterRestoreParams defines parameters for DeleteDatabaseClusterRestore.
+type DeleteDatabaseClusterRestoreParams struct {
+	// Namespace Namespace of the database cluster the resource belongs to if it was created in a namespace from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetDatabaseClusterRestoreParams defines parameters for GetDatabaseClusterRestore.
+type GetDatabaseClusterRestoreParams struct {
+	// Namespace Namespace of the database cluster the resource belongs to if it was created in a namespace from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// UpdateDatabaseClusterRestoreParams defines parameters for UpdateDatabaseClusterRestore.
+type UpdateDatabaseClusterRestoreParams struct {
+	// Namespace Namespace of the database cluster the resource belongs to if it was created in a namespace from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// ListDatabaseClustersParams defines parameters for ListDatabaseClusters.
+type ListDatabaseClustersParams struct {
+	// LabelSelector Kubernetes label selector to filter the database clusters by
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// EngineType Return only the database clusters of the given engine type, e.g. pxc, psmdb or postgresql
+	EngineType *string `form:"engineType,omitempty" json:"engineType,omitempty"`
+
+	// State Return only the database clusters in the given state
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Namespace Namespace the database clusters were created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// BulkDeleteDatabaseClustersParams defines parameters for BulkDeleteDatabaseClusters.
+type BulkDeleteDatabaseClustersParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// DeleteDatabaseClusterParams defines parameters for DeleteDatabaseCluster.
+type DeleteDatabaseClusterParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetDatabaseClusterParams defines parameters for GetDatabaseCluster.
+type GetDatabaseClusterParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// IfNoneMatch The ETag of the database cluster the client has. 304 is returned if it is still current
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// UpdateDatabaseClusterParams defines parameters for UpdateDatabaseCluster.
+type UpdateDatabaseClusterParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// OverrideMaintenanceWindow Apply the disruptive changes right away even if the maintenance window of the database cluster is closed
+	OverrideMaintenanceWindow *bool `form:"overrideMaintenanceWindow,omitempty" json:"overrideMaintenanceWindow,omitempty"`
+
+	// IfMatch The ETag of the database cluster the update is based on. The update is rejected with 409 if the database cluster has changed since
+	IfMatch string `json:"If-Match"`
+}
+
+// AdoptDatabaseClusterParams defines parameters for AdoptDatabaseCluster.
+type AdoptDatabaseClusterParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// DeleteDatabaseClusterBackupSLOParams defines parameters for DeleteDatabaseClusterBackupSLO.
+type DeleteDatabaseClusterBackupSLOParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetDatabaseClusterBackupSLOParams defines parameters for GetDatabaseClusterBackupSLO.
+type GetDatabaseClusterBackupSLOParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// SetDatabaseClusterBackupSLOParams defines parameters for SetDatabaseClusterBackupSLO.
+type SetDatabaseClusterBackupSLOParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// DeleteDatabaseClusterBackupVerificationPolicyParams defines parameters for DeleteDatabaseClusterBackupVerificationPolicy.
+type DeleteDatabaseClusterBackupVerificationPolicyParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetDatabaseClusterBackupVerificationPolicyParams defines parameters for GetDatabaseClusterBackupVerificationPolicy.
+type GetDatabaseClusterBackupVerificationPolicyParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// SetDatabaseClusterBackupVerificationPolicyParams defines parameters for SetDatabaseClusterBackupVerificationPolicy.
+type SetDatabaseClusterBackupVerificationPolicyParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// ListDatabaseClusterBackupsParams defines parameters for ListDatabaseClusterBackups.
+type ListDatabaseClusterBackupsParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetDatabaseClusterCostEstimateParams defines parameters for GetDatabaseClusterCostEstimate.
+type GetDatabaseClusterCostEstimateParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetDatabaseClusterCredentialsParams defines parameters for GetDatabaseClusterCredentials.
+type GetDatabaseClusterCredentialsParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// RevertDatabaseClusterDiagnosticsParams defines parameters for RevertDatabaseClusterDiagnostics.
+type RevertDatabaseClusterDiagnosticsParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetDatabaseClusterDiagnosticsParams defines parameters for GetDatabaseClusterDiagnostics.
+type GetDatabaseClusterDiagnosticsParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// SetDatabaseClusterDiagnosticsParams defines parameters for SetDatabaseClusterDiagnostics.
+type SetDatabaseClusterDiagnosticsParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// DiffDatabaseClusterParams defines parameters for DiffDatabaseCluster.
+type DiffDatabaseClusterParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// ExportDatabaseClusterParams defines parameters for ExportDatabaseCluster.
+type ExportDatabaseClusterParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Format Either yaml (the default) or json
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportDatabaseClusterBundleParams defines parameters for ExportDatabaseClusterBundle.
+type ExportDatabaseClusterBundleParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Format Either yaml (the default) or json
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// DeleteDatabaseClusterMaintenanceWindowParams defines parameters for DeleteDatabaseClusterMaintenanceWindow.
+type DeleteDatabaseClusterMaintenanceWindowParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetDatabaseClusterMaintenanceWindowParams defines parameters for GetDatabaseClusterMaintenanceWindow.
+type GetDatabaseClusterMaintenanceWindowParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// SetDatabaseClusterMaintenanceWindowParams defines parameters for SetDatabaseClusterMaintenanceWindow.
+type SetDatabaseClusterMaintenanceWindowParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// ListDatabaseClusterPendingOperationsParams defines parameters for ListDatabaseClusterPendingOperations.
+type ListDatabaseClusterPendingOperationsParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// CancelDatabaseClusterPendingOperationParams defines parameters for CancelDatabaseClusterPendingOperation.
+type CancelDatabaseClusterPendingOperationParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetDatabaseClusterResourceUsageParams defines parameters for GetDatabaseClusterResourceUsage.
+type GetDatabaseClusterResourceUsageParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// ListDatabaseClusterRestoresParams defines parameters for ListDatabaseClusterRestores.
+type ListDatabaseClusterRestoresParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// DeleteDatabaseClusterRetentionPolicyParams defines parameters for DeleteDatabaseClusterRetentionPolicy.
+type DeleteDatabaseClusterRetentionPolicyParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetDatabaseClusterRetentionPolicyParams defines parameters for GetDatabaseClusterRetentionPolicy.
+type GetDatabaseClusterRetentionPolicyParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// SetDatabaseClusterRetentionPolicyParams defines parameters for SetDatabaseClusterRetentionPolicy.
+type SetDatabaseClusterRetentionPolicyParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// ScaleDatabaseClusterParams defines parameters for ScaleDatabaseCluster.
+type ScaleDatabaseClusterParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// OverrideMaintenanceWindow Apply the disruptive changes right away even if the maintenance window of the database cluster is closed
+	OverrideMaintenanceWindow *bool `form:"overrideMaintenanceWindow,omitempty" json:"overrideMaintenanceWindow,omitempty"`
+}
+
+// CreateDatabaseClusterTemporaryAccessParams defines parameters for CreateDatabaseClusterTemporaryAccess.
+type CreateDatabaseClusterTemporaryAccessParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// GetDatabaseClusterEngineUpgradeParams defines parameters for GetDatabaseClusterEngineUpgrade.
+type GetDatabaseClusterEngineUpgradeParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+}
+
+// UpgradeDatabaseClusterEngineParams defines parameters for UpgradeDatabaseClusterEngine.
+type UpgradeDatabaseClusterEngineParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// OverrideMaintenanceWindow Apply the disruptive changes right away even if the maintenance window of the database cluster is closed
+	OverrideMaintenanceWindow *bool `form:"overrideMaintenanceWindow,omitempty" json:"overrideMaintenanceWindow,omitempty"`
+}
+
+// GetDatabaseClusterEngineUpgradePlanParams defines parameters for GetDatabaseClusterEngineUpgradePlan.
+type GetDatabaseClusterEngineUpgradePlanParams struct {
+	// Namespace Namespace the database cluster was created in from the namespace template. Defaults to the namespace of the kubernetes cluster
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// TargetVersion Engine version to upgrade to
+	TargetVersion string `form:"targetVersion" json:"targetVersion"`
+}
+
+// ListMonitoringInstancesParams defines parameters for ListMonitoringInstances.
+type ListMonitoringInstancesParams struct {
+	// SortBy Field to sort the monitoring instances by
+	SortBy *ListMonitoringInstancesParamsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+
+	// Order Sort order of the returned items
+	Order *ListMonitoringInstancesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Type Return only the monitoring instances of the given type
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// NamePrefix Return only the monitoring instances which names start with the given prefix
+	NamePrefix *string `form:"name_prefix,omitempty" json:"name_prefix,omitempty"`
+
+	// Deleted Return the monitoring instances in the trash instead of the active ones
+	Deleted *bool `form:"deleted,omitempty" json:"deleted,omitempty"`
+
+	// Limit Maximum number of the monitoring instances to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of the monitoring instances to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListMonitoringInstancesParamsSortBy defines parameters for ListMonitoringInstances.
+type ListMonitoringInstancesParamsSortBy string
+
+// ListMonitoringInstancesParamsOrder defines parameters for ListMonitoringInstances.
+type ListMonitoringInstancesParamsOrder string
+
+// GetReplicationSnapshotParams defines parameters for GetReplicationSnapshot.
+type GetReplicationSnapshotParams struct {
+	// XEverestReplicationToken Token shared between the primary and standby instances
+	XEverestReplicationToken string `json:"X-Everest-Replication-Token"`
+}
+
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	// Q The text to search for
+	Q string `form:"q" json:"q"`
+
+	// Kind Only return the resources of the kind, one of databaseCluster, backupStorage, monitoringInstance or kubernetesCluster
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty"`
+}
+
+// ListSizingPresetsParams defines parameters for ListSizingPresets.
+type ListSizingPresetsParams struct {
+	// EngineType Return only the presets of the given engine type
+	EngineType *ListSizingPresetsParamsEngineType `form:"engineType,omitempty" json:"engineType,omitempty"`
+}
+
+// ListSizingPresetsParamsEngineType defines parameters for ListSizingPresets.
+type ListSizingPresetsParamsEngineType string
+
+// CreateAlertRuleTemplateJSONRequestBody defines body for CreateAlertRuleTemplate for application/json ContentType.
+type CreateAlertRuleTemplateJSONRequestBody = CreateAlertRuleTemplateParams
+
+// UpdateAlertRuleTemplateJSONRequestBody defines body for UpdateAlertRuleTemplate for application/json ContentType.
+type UpdateAlertRuleTemplateJSONRequestBody = UpdateAlertRuleTemplateParams
+
+// CreateAPITokenJSONRequestBody defines body for CreateAPIToken for application/json ContentType.
+type CreateAPITokenJSONRequestBody = CreateAPITokenParams
+
+// CreateBackupStorageJSONRequestBody defines body for CreateBackupStorage for application/json ContentType.
+type CreateBackupStorageJSONRequestBody = CreateBackupStorageParams
+
+// UpdateBackupStorageJSONRequestBody defines body for UpdateBackupStorage for application/json ContentType.
+type UpdateBackupStorageJSONRequestBody = UpdateBackupStorageParams
+
+// CreateStoredBackupDownloadURLJSONRequestBody defines body for CreateStoredBackupDownloadURL for application/json ContentType.
+type CreateStoredBackupDownloadURLJSONRequestBody = BackupDownloadURLParams
+
+// SetBackupStorageRetentionPolicyJSONRequestBody defines body for SetBackupStorageRetentionPolicy for application/json ContentType.
+type SetBackupStorageRetentionPolicyJSONRequestBody = RetentionPolicy
+
+// RotateBackupStorageCredentialsJSONRequestBody defines body for RotateBackupStorageCredentials for application/json ContentType.
+type RotateBackupStorageCredentialsJSONRequestBody = BackupStorageCredentials
+
+// RegisterKubernetesClusterJSONRequestBody defines body for RegisterKubernetesCluster for application/json ContentType.
+type RegisterKubernetesClusterJSONRequestBody = CreateKubernetesClusterParams
+
+// UnregisterKubernetesClusterJSONRequestBody defines body for UnregisterKubernetesCluster for application/json ContentType.
+type UnregisterKubernetesClusterJSONRequestBody = UnregisterKubernetesClusterParams
+
+// UpdateKubernetesClusterJSONRequestBody defines body for UpdateKubernetesCluster for application/json ContentType.
+type UpdateKubernetesClusterJSONRequestBody = UpdateKubernetesClusterParams
+
+// BootstrapKubernetesClusterJSONRequestBody defines body for BootstrapKubernetesCluster for application/json ContentType.
+type BootstrapKubernetesClusterJSONRequestBody = BootstrapParams
+
+// SetKubernetesClusterMonitoringJSONRequestBody defines body for SetKubernetesClusterMonitoring for application/json ContentType.
+type SetKubernetesClusterMonitoringJSONRequestBody = KubernetesClusterMonitoring
+
+// CreateDatabaseClusterBackupJSONRequestBody defines body for CreateDatabaseClusterBackup for application/json ContentType.
+type CreateDatabaseClusterBackupJSONRequestBody = DatabaseClusterBackup
+
+// SetDatabaseClusterBackupLegalHoldJSONRequestBody defines body for SetDatabaseClusterBackupLegalHold for application/json ContentType.
+type SetDatabaseClusterBackupLegalHoldJSONRequestBody = LegalHold
+
+// CreateDatabaseClusterRestoreJSONRequestBody defines body for CreateDatabaseClusterRestore for application/json ContentType.
+type CreateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+
+// UpdateDatabaseClusterRestoreJSONRequestBody defines body for UpdateDatabaseClusterRestore for application/json ContentType.
+type UpdateDatabaseClusterRestoreJSONRequestBody = DatabaseClusterRestore
+
+// CreateDatabaseClusterJSONRequestBody defines body for CreateDatabaseCluster for application/json ContentType.
+type CreateDatabaseClusterJSONRequestBody = DatabaseCluster
+
+// BulkDeleteDatabaseClustersJSONRequestBody defines body for BulkDeleteDatabaseClusters for application/json ContentType.
+type BulkDeleteDatabaseClustersJSONRequestBody = DatabaseClusterBulkDelete
+
+// EstimateDatabaseClusterCostJSONRequestBody defines body for EstimateDatabaseClusterCost for application/json ContentType.
+type EstimateDatabaseClusterCostJSONRequestBody = DatabaseCluster
+
+// PreviewDatabaseClusterJSONRequestBody defines body for PreviewDatabaseCluster for application/json ContentType.
+type PreviewDatabaseClusterJSONRequestBody = DatabaseCluster
+
+// UpdateDatabaseClusterJSONRequestBody defines body for UpdateDatabaseCluster for application/json ContentType.
+type UpdateDatabaseClusterJSONRequestBody = DatabaseCluster
+
+// AdoptDatabaseClusterJSONRequestBody defines body for AdoptDatabaseCluster for application/json ContentType.
+type AdoptDatabaseClusterJSONRequestBody = DatabaseClusterAdoption
+
+// SetDatabaseClusterBackupSLOJSONRequestBody defines body for SetDatabaseClusterBackupSLO for application/json ContentType.
+type SetDatabaseClusterBackupSLOJSONRequestBody = BackupSLOParams
+
+// SetDatabaseClusterBackupVerificationPolicyJSONRequestBody defines body for SetDatabaseClusterBackupVerificationPolicy for application/json ContentType.
+type SetDatabaseClusterBackupVerificationPolicyJSONRequestBody = BackupVerificationPolicy
+
+// SetDatabaseClusterDiagnosticsJSONRequestBody defines body for SetDatabaseClusterDiagnostics for application/json ContentType.
+type SetDatabaseClusterDiagnosticsJSONRequestBody = DiagnosticSettings
+
+// DiffDatabaseClusterJSONRequestBody defines body for DiffDatabaseCluster for application/json ContentType.
+type DiffDatabaseClusterJSONRequestBody = DatabaseCluster
+
+// SetDatabaseClusterMaintenanceWindowJSONRequestBody defines body for SetDatabaseClusterMaintenanceWindow for application/json ContentType.
+type SetDatabaseClusterMaintenanceWindowJSONRequestBody = MaintenanceWindowParams
+
+// SetDatabaseClusterRetentionPolicyJSONRequestBody defines body for SetDatabaseClusterRetentionPolicy for application/json ContentType.
+type SetDatabaseClusterRetentionPolicyJSONRequestBody = RetentionPolicy
+
+// ScaleDatabaseClusterJSONRequestBody defines body for ScaleDatabaseCluster for application/json ContentType.
+type ScaleDatabaseClusterJSONRequestBody = DatabaseClusterScale
+
+// CreateDatabaseClusterTemporaryAccessJSONRequestBody defines body for CreateDatabaseClusterTemporaryAccess for application/json ContentType.
+type CreateDatabaseClusterTemporaryAccessJSONRequestBody = TemporaryAccessRequest
+
+// UpgradeDatabaseClusterEngineJSONRequestBody defines body for UpgradeDatabaseClusterEngine for application/json ContentType.
+type UpgradeDatabaseClusterEngineJSONRequestBody = DatabaseClusterUpgradeRequest
+
+// UpdateDatabaseEngineJSONRequestBody defines body for UpdateDatabaseEngine for application/json ContentType.
+type UpdateDatabaseEngineJSONRequestBody = DatabaseEngine
+
+// SetKubernetesClusterGuardrailJSONRequestBody defines body for SetKubernetesClusterGuardrail for application/json ContentType.
+type SetKubernetesClusterGuardrailJSONRequestBody = Guardrail
+
+// UpdateKubernetesClusterKubeconfigJSONRequestBody defines body for UpdateKubernetesClusterKubeconfig for application/json ContentType.
+type UpdateKubernetesClusterKubeconfigJSONRequestBody = KubeconfigParams
+
+// SetKubernetesClusterNamespaceTemplateJSONRequestBody defines body for SetKubernetesClusterNamespaceTemplate for application/json ContentType.
+type SetKubernetesClusterNamespaceTemplateJSONRequestBody = NamespaceTemplate
+
+// PrepareKubernetesClusterNamespaceJSONRequestBody defines body for PrepareKubernetesClusterNamespace for application/json ContentType.
+type PrepareKubernetesClusterNamespaceJSONRequestBody = NamespaceParams
+
+// UpgradeKubernetesClusterOperatorJSONRequestBody defines body for UpgradeKubernetesClusterOperator for application/json ContentType.
+type UpgradeKubernetesClusterOperatorJSONRequestBody = OperatorUpgrade
+
+// PreflightDatabaseClusterJSONRequestBody defines body for PreflightDatabaseCluster for application/json ContentType.
+type PreflightDatabaseClusterJSONRequestBody = DatabaseCluster
+
+// ImportUnmanagedConfigsJSONRequestBody defines body for ImportUnmanagedConfigs for application/json ContentType.
+type ImportUnmanagedConfigsJSONRequestBody = ImportUnmanagedConfigsParams
+
+// LintDatabaseClusterJSONRequestBody defines body for LintDatabaseCluster for application/json ContentType.
+type LintDatabaseClusterJSONRequestBody = DatabaseCluster
+
+// CreateMonitoringInstanceJSONRequestBody defines body for CreateMonitoringInstance for application/json ContentType.
+type CreateMonitoringInstanceJSONRequestBody = MonitoringInstanceCreateParams
+
+// UpdateMonitoringInstanceJSONRequestBody defines body for UpdateMonitoringInstance for application/json ContentType.
+type UpdateMonitoringInstanceJSONRequestBody = MonitoringInstanceUpdateParams
+
+// CreateNotificationChannelJSONRequestBody defines body for CreateNotificationChannel for application/json ContentType.
+type CreateNotificationChannelJSONRequestBody = CreateNotificationChannelParams
+
+// UpdateNotificationChannelJSONRequestBody defines body for UpdateNotificationChannel for application/json ContentType.
+type UpdateNotificationChannelJSONRequestBody = UpdateNotificationChannelParams
+
+// CreateNotificationRuleJSONRequestBody defines body for CreateNotificationRule for application/json ContentType.
+type CreateNotificationRuleJSONRequestBody = NotificationRuleParams
+
+// SetPricingJSONRequestBody defines body for SetPricing for application/json ContentType.
+type SetPricingJSONRequestBody = Pricing
+
+// CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
+type CreateProjectJSONRequestBody = Project
+
+// SetProjectMemberJSONRequestBody defines body for SetProjectMember for application/json ContentType.
+type SetProjectMemberJSONRequestBody = ProjectMemberRole
+
+// SetQuotaJSONRequestBody defines body for SetQuota for application/json ContentType.
+type SetQuotaJSONRequestBody = QuotaLimits
+
+// CreateSessionJSONRequestBody defines body for CreateSession for application/json ContentType.
+type CreateSessionJSONRequestBody = SessionLogin
+
+// RefreshSessionJSONRequestBody defines body for RefreshSession for application/json ContentType.
+type RefreshSessionJSONRequestBody = SessionRefresh
+
+// SetLogLevelJSONRequestBody defines body for SetLogLevel for application/json ContentType.
+type SetLogLevelJSONRequestBody = LogLevel
+
+// SetSetupAdminJSONRequestBody defines body for SetSetupAdmin for application/json ContentType.
+type SetSetupAdminJSONRequestBody = SetupAdmin
+
+// SetSetupDefaultBackupStorageJSONRequestBody defines body for SetSetupDefaultBackupStorage for application/json ContentType.
+type SetSetupDefaultBackupStorageJSONRequestBody = SetupDefaultBackupStorage
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = CreateWebhookParams
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = UpdateWebhookParams
+
+// AsDatabaseClusterSpecEngineResourcesCpu0 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as a DatabaseClusterSpecEngineResourcesCpu0
+func (t DatabaseCluster_Spec_Engine_Resources_Cpu) AsDatabaseClusterSpecEngineResourcesCpu0() (DatabaseClusterSpecEngineResourcesCpu0, error) {
+	var body DatabaseClusterSpecEngineResourcesCpu0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecEngineResourcesCpu0 overwrites any union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as the provided DatabaseClusterSpecEngineResourcesCpu0
+func (t *DatabaseCluster_Spec_Engine_Resources_Cpu) FromDatabaseClusterSpecEngineResourcesCpu0(v DatabaseClusterSpecEngineResourcesCpu0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecEngineResourcesCpu0 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu, using the provided DatabaseClusterSpecEngineResourcesCpu0
+func (t *DatabaseCluster_Spec_Engine_Resources_Cpu) MergeDatabaseClusterSpecEngineResourcesCpu0(v DatabaseClusterSpecEngineResourcesCpu0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDatabaseClusterSpecEngineResourcesCpu1 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as a DatabaseClusterSpecEngineResourcesCpu1
+func (t DatabaseCluster_Spec_Engine_Resources_Cpu) AsDatabaseClusterSpecEngineResourcesCpu1() (DatabaseClusterSpecEngineResourcesCpu1, error) {
+	var body DatabaseClusterSpecEngineResourcesCpu1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecEngineResourcesCpu1 overwrites any union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu as the provided DatabaseClusterSpecEngineResourcesCpu1
+func (t *DatabaseCluster_Spec_Engine_Resources_Cpu) FromDatabaseClusterSpecEngineResourcesCpu1(v DatabaseClusterSpecEngineResourcesCpu1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecEngineResourcesCpu1 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Resources_Cpu, using the provided DatabaseClusterSpecEngineResourcesCpu1
+func (t *DatabaseCluster_Spec_Engine_Resources_Cpu) MergeDatabaseClusterSpecEngineResourcesCpu1(v DatabaseClusterSpecEngineResourcesCpu1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t DatabaseCluster_Spec_Engine_Resources_Cpu) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *DatabaseCluster_Spec_Engine_Resources_Cpu) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsDatabaseClusterSpecEngineResourcesMemory0 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Memory as a DatabaseClusterSpecEngineResourcesMemory0
+func (t DatabaseCluster_Spec_Engine_Resources_Memory) AsDatabaseClusterSpecEngineResourcesMemory0() (DatabaseClusterSpecEngineResourcesMemory0, error) {
+	var body DatabaseClusterSpecEngineResourcesMemory0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecEngineResourcesMemory0 overwrites any union data inside the DatabaseCluster_Spec_Engine_Resources_Memory as the provided DatabaseClusterSpecEngineResourcesMemory0
+func (t *DatabaseCluster_Spec_Engine_Resources_Memory) FromDatabaseClusterSpecEngineResourcesMemory0(v DatabaseClusterSpecEngineResourcesMemory0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecEngineResourcesMemory0 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Resources_Memory, using the provided DatabaseClusterSpecEngineResourcesMemory0
+func (t *DatabaseCluster_Spec_Engine_Resources_Memory) MergeDatabaseClusterSpecEngineResourcesMemory0(v DatabaseClusterSpecEngineResourcesMemory0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDatabaseClusterSpecEngineResourcesMemory1 returns the union data inside the DatabaseCluster_Spec_Engine_Resources_Memory as a DatabaseClusterSpecEngineResourcesMemory1
+func (t DatabaseCluster_Spec_Engine_Resources_Memory) AsDatabaseClusterSpecEngineResourcesMemory1() (DatabaseClusterSpecEngineResourcesMemory1, error) {
+	var body DatabaseClusterSpecEngineResourcesMemory1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecEngineResourcesMemory1 overwrites any union data inside the DatabaseCluster_Spec_Engine_Resources_Memory as the provided DatabaseClusterSpecEngineResourcesMemory1
+func (t *DatabaseCluster_Spec_Engine_Resources_Memory) FromDatabaseClusterSpecEngineResourcesMemory1(v DatabaseClusterSpecEngineResourcesMemory1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecEngineResourcesMemory1 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Resources_Memory, using the provided DatabaseClusterSpecEngineResourcesMemory1
+func (t *DatabaseCluster_Spec_Engine_Resources_Memory) MergeDatabaseClusterSpecEngineResourcesMemory1(v DatabaseClusterSpecEngineResourcesMemory1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t DatabaseCluster_Spec_Engine_Resources_Memory) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *DatabaseCluster_Spec_Engine_Resources_Memory) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsDatabaseClusterSpecEngineStorageSize0 returns the union data inside the DatabaseCluster_Spec_Engine_Storage_Size as a DatabaseClusterSpecEngineStorageSize0
+func (t DatabaseCluster_Spec_Engine_Storage_Size) AsDatabaseClusterSpecEngineStorageSize0() (DatabaseClusterSpecEngineStorageSize0, error) {
+	var body DatabaseClusterSpecEngineStorageSize0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecEngineStorageSize0 overwrites any union data inside the DatabaseCluster_Spec_Engine_Storage_Size as the provided DatabaseClusterSpecEngineStorageSize0
+func (t *DatabaseCluster_Spec_Engine_Storage_Size) FromDatabaseClusterSpecEngineStorageSize0(v DatabaseClusterSpecEngineStorageSize0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecEngineStorageSize0 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Storage_Size, using the provided DatabaseClusterSpecEngineStorageSize0
+func (t *DatabaseCluster_Spec_Engine_Storage_Size) MergeDatabaseClusterSpecEngineStorageSize0(v DatabaseClusterSpecEngineStorageSize0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDatabaseClusterSpecEngineStorageSize1 returns the union data inside the DatabaseCluster_Spec_Engine_Storage_Size as a DatabaseClusterSpecEngineStorageSize1
+func (t DatabaseCluster_Spec_Engine_Storage_Size) AsDatabaseClusterSpecEngineStorageSize1() (DatabaseClusterSpecEngineStorageSize1, error) {
+	var body DatabaseClusterSpecEngineStorageSize1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecEngineStorageSize1 overwrites any union data inside the DatabaseCluster_Spec_Engine_Storage_Size as the provided DatabaseClusterSpecEngineStorageSize1
+func (t *DatabaseCluster_Spec_Engine_Storage_Size) FromDatabaseClusterSpecEngineStorageSize1(v DatabaseClusterSpecEngineStorageSize1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecEngineStorageSize1 performs a merge with any union data inside the DatabaseCluster_Spec_Engine_Storage_Size, using the provided DatabaseClusterSpecEngineStorageSize1
+func (t *DatabaseCluster_Spec_Engine_Storage_Size) MergeDatabaseClusterSpecEngineStorageSize1(v DatabaseClusterSpecEngineStorageSize1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t DatabaseCluster_Spec_Engine_Storage_Size) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *DatabaseCluster_Spec_Engine_Storage_Size) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsDatabaseClusterSpecMonitoringResourcesLimits0 returns the union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties as a DatabaseClusterSpecMonitoringResourcesLimits0
+func (t DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) AsDatabaseClusterSpecMonitoringResourcesLimits0() (DatabaseClusterSpecMonitoringResourcesLimits0, error) {
+	var body DatabaseClusterSpecMonitoringResourcesLimits0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecMonitoringResourcesLimits0 overwrites any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties as the provided DatabaseClusterSpecMonitoringResourcesLimits0
+func (t *DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) FromDatabaseClusterSpecMonitoringResourcesLimits0(v DatabaseClusterSpecMonitoringResourcesLimits0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecMonitoringResourcesLimits0 performs a merge with any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties, using the provided DatabaseClusterSpecMonitoringResourcesLimits0
+func (t *DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) MergeDatabaseClusterSpecMonitoringResourcesLimits0(v DatabaseClusterSpecMonitoringResourcesLimits0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDatabaseClusterSpecMonitoringResourcesLimits1 returns the union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties as a DatabaseClusterSpecMonitoringResourcesLimits1
+func (t DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) AsDatabaseClusterSpecMonitoringResourcesLimits1() (DatabaseClusterSpecMonitoringResourcesLimits1, error) {
+	var body DatabaseClusterSpecMonitoringResourcesLimits1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecMonitoringResourcesLimits1 overwrites any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties as the provided DatabaseClusterSpecMonitoringResourcesLimits1
+func (t *DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) FromDatabaseClusterSpecMonitoringResourcesLimits1(v DatabaseClusterSpecMonitoringResourcesLimits1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecMonitoringResourcesLimits1 performs a merge with any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties, using the provided DatabaseClusterSpecMonitoringResourcesLimits1
+func (t *DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) MergeDatabaseClusterSpecMonitoringResourcesLimits1(v DatabaseClusterSpecMonitoringResourcesLimits1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *DatabaseCluster_Spec_Monitoring_Resources_Limits_AdditionalProperties) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsDatabaseClusterSpecMonitoringResourcesRequests0 returns the union data inside the DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties as a DatabaseClusterSpecMonitoringResourcesRequests0
+func (t DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) AsDatabaseClusterSpecMonitoringResourcesRequests0() (DatabaseClusterSpecMonitoringResourcesRequests0, error) {
+	var body DatabaseClusterSpecMonitoringResourcesRequests0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
 // FromDatabaseClusterSpecMonitoringResourcesRequests0 overwrites any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties as the provided DatabaseClusterSpecMonitoringResourcesRequests0
 func (t *DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) FromDatabaseClusterSpecMonitoringResourcesRequests0(v DatabaseClusterSpecMonitoringResourcesRequests0) error {
@@ -1051,312 +3657,3630 @@ func (t *DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties
 	return err
 }
 
-// MergeDatabaseClusterSpecMonitoringResourcesRequests0 performs a merge with any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties, using the provided DatabaseClusterSpecMonitoringResourcesRequests0
-func (t *DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) MergeDatabaseClusterSpecMonitoringResourcesRequests0(v DatabaseClusterSpecMonitoringResourcesRequests0) error {
-	b, err := json.Marshal(v)
+// MergeDatabaseClusterSpecMonitoringResourcesRequests0 performs a merge with any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties, using the provided DatabaseClusterSpecMonitoringResourcesRequests0
+func (t *DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) MergeDatabaseClusterSpecMonitoringResourcesRequests0(v DatabaseClusterSpecMonitoringResourcesRequests0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDatabaseClusterSpecMonitoringResourcesRequests1 returns the union data inside the DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties as a DatabaseClusterSpecMonitoringResourcesRequests1
+func (t DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) AsDatabaseClusterSpecMonitoringResourcesRequests1() (DatabaseClusterSpecMonitoringResourcesRequests1, error) {
+	var body DatabaseClusterSpecMonitoringResourcesRequests1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecMonitoringResourcesRequests1 overwrites any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties as the provided DatabaseClusterSpecMonitoringResourcesRequests1
+func (t *DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) FromDatabaseClusterSpecMonitoringResourcesRequests1(v DatabaseClusterSpecMonitoringResourcesRequests1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecMonitoringResourcesRequests1 performs a merge with any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties, using the provided DatabaseClusterSpecMonitoringResourcesRequests1
+func (t *DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) MergeDatabaseClusterSpecMonitoringResourcesRequests1(v DatabaseClusterSpecMonitoringResourcesRequests1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsDatabaseClusterSpecProxyResourcesCpu0 returns the union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu as a DatabaseClusterSpecProxyResourcesCpu0
+func (t DatabaseCluster_Spec_Proxy_Resources_Cpu) AsDatabaseClusterSpecProxyResourcesCpu0() (DatabaseClusterSpecProxyResourcesCpu0, error) {
+	var body DatabaseClusterSpecProxyResourcesCpu0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecProxyResourcesCpu0 overwrites any union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu as the provided DatabaseClusterSpecProxyResourcesCpu0
+func (t *DatabaseCluster_Spec_Proxy_Resources_Cpu) FromDatabaseClusterSpecProxyResourcesCpu0(v DatabaseClusterSpecProxyResourcesCpu0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecProxyResourcesCpu0 performs a merge with any union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu, using the provided DatabaseClusterSpecProxyResourcesCpu0
+func (t *DatabaseCluster_Spec_Proxy_Resources_Cpu) MergeDatabaseClusterSpecProxyResourcesCpu0(v DatabaseClusterSpecProxyResourcesCpu0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDatabaseClusterSpecProxyResourcesCpu1 returns the union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu as a DatabaseClusterSpecProxyResourcesCpu1
+func (t DatabaseCluster_Spec_Proxy_Resources_Cpu) AsDatabaseClusterSpecProxyResourcesCpu1() (DatabaseClusterSpecProxyResourcesCpu1, error) {
+	var body DatabaseClusterSpecProxyResourcesCpu1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecProxyResourcesCpu1 overwrites any union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu as the provided DatabaseClusterSpecProxyResourcesCpu1
+func (t *DatabaseCluster_Spec_Proxy_Resources_Cpu) FromDatabaseClusterSpecProxyResourcesCpu1(v DatabaseClusterSpecProxyResourcesCpu1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecProxyResourcesCpu1 performs a merge with any union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu, using the provided DatabaseClusterSpecProxyResourcesCpu1
+func (t *DatabaseCluster_Spec_Proxy_Resources_Cpu) MergeDatabaseClusterSpecProxyResourcesCpu1(v DatabaseClusterSpecProxyResourcesCpu1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t DatabaseCluster_Spec_Proxy_Resources_Cpu) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *DatabaseCluster_Spec_Proxy_Resources_Cpu) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsDatabaseClusterSpecProxyResourcesMemory0 returns the union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory as a DatabaseClusterSpecProxyResourcesMemory0
+func (t DatabaseCluster_Spec_Proxy_Resources_Memory) AsDatabaseClusterSpecProxyResourcesMemory0() (DatabaseClusterSpecProxyResourcesMemory0, error) {
+	var body DatabaseClusterSpecProxyResourcesMemory0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecProxyResourcesMemory0 overwrites any union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory as the provided DatabaseClusterSpecProxyResourcesMemory0
+func (t *DatabaseCluster_Spec_Proxy_Resources_Memory) FromDatabaseClusterSpecProxyResourcesMemory0(v DatabaseClusterSpecProxyResourcesMemory0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecProxyResourcesMemory0 performs a merge with any union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory, using the provided DatabaseClusterSpecProxyResourcesMemory0
+func (t *DatabaseCluster_Spec_Proxy_Resources_Memory) MergeDatabaseClusterSpecProxyResourcesMemory0(v DatabaseClusterSpecProxyResourcesMemory0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDatabaseClusterSpecProxyResourcesMemory1 returns the union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory as a DatabaseClusterSpecProxyResourcesMemory1
+func (t DatabaseCluster_Spec_Proxy_Resources_Memory) AsDatabaseClusterSpecProxyResourcesMemory1() (DatabaseClusterSpecProxyResourcesMemory1, error) {
+	var body DatabaseClusterSpecProxyResourcesMemory1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDatabaseClusterSpecProxyResourcesMemory1 overwrites any union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory as the provided DatabaseClusterSpecProxyResourcesMemory1
+func (t *DatabaseCluster_Spec_Proxy_Resources_Memory) FromDatabaseClusterSpecProxyResourcesMemory1(v DatabaseClusterSpecProxyResourcesMemory1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDatabaseClusterSpecProxyResourcesMemory1 performs a merge with any union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory, using the provided DatabaseClusterSpecProxyResourcesMemory1
+func (t *DatabaseCluster_Spec_Proxy_Resources_Memory) MergeDatabaseClusterSpecProxyResourcesMemory1(v DatabaseClusterSpecProxyResourcesMemory1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t DatabaseCluster_Spec_Proxy_Resources_Memory) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *DatabaseCluster_Spec_Proxy_Resources_Memory) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List the alert rule templates
+	// (GET /alert-rule-templates)
+	ListAlertRuleTemplates(ctx echo.Context) error
+	// Create an alert rule template
+	// (POST /alert-rule-templates)
+	CreateAlertRuleTemplate(ctx echo.Context) error
+	// Delete an alert rule template
+	// (DELETE /alert-rule-templates/{name})
+	DeleteAlertRuleTemplate(ctx echo.Context, name string) error
+	// Get an alert rule template
+	// (GET /alert-rule-templates/{name})
+	GetAlertRuleTemplate(ctx echo.Context, name string) error
+	// Update an alert rule template
+	// (PATCH /alert-rule-templates/{name})
+	UpdateAlertRuleTemplate(ctx echo.Context, name string) error
+	// List the alert rules pushed for the database clusters
+	// (GET /alert-rules)
+	ListAlertRules(ctx echo.Context) error
+	// List the API tokens
+	// (GET /api-tokens)
+	ListAPITokens(ctx echo.Context) error
+	// Create an API token
+	// (POST /api-tokens)
+	CreateAPIToken(ctx echo.Context) error
+	// Revoke an API token
+	// (DELETE /api-tokens/{name})
+	DeleteAPIToken(ctx echo.Context, name string) error
+	// List the audit entries
+	// (GET /audit-entries)
+	ListAuditEntries(ctx echo.Context, params ListAuditEntriesParams) error
+	// List of the created backup storages
+	// (GET /backup-storages)
+	ListBackupStorages(ctx echo.Context, params ListBackupStoragesParams) error
+	// Create a new backup storage object
+	// (POST /backup-storages)
+	CreateBackupStorage(ctx echo.Context) error
+	// Delete the specified backup storage
+	// (DELETE /backup-storages/{name})
+	DeleteBackupStorage(ctx echo.Context, name string) error
+	// Get the specified backup storage
+	// (GET /backup-storages/{name})
+	GetBackupStorage(ctx echo.Context, name string) error
+	// Partial update of the specified backup storage
+	// (PATCH /backup-storages/{name})
+	UpdateBackupStorage(ctx echo.Context, name string) error
+	// List the backups stored in the specified backup storage
+	// (GET /backup-storages/{name}/backups)
+	ListStoredBackups(ctx echo.Context, name string, params ListStoredBackupsParams) error
+	// Generate the download URLs of a stored backup
+	// (POST /backup-storages/{name}/backups/{key}/download-url)
+	CreateStoredBackupDownloadURL(ctx echo.Context, name string, key string) error
+	// Restore the specified backup storage from the trash
+	// (POST /backup-storages/{name}/restore)
+	RestoreBackupStorage(ctx echo.Context, name string) error
+	// Push the specified backup storage and its credentials to all the registered kubernetes clusters
+	// (POST /backup-storages/{name}/resync)
+	ResyncBackupStorage(ctx echo.Context, name string) error
+	// Delete the backup retention policy of the specified backup storage
+	// (DELETE /backup-storages/{name}/retention-policy)
+	DeleteBackupStorageRetentionPolicy(ctx echo.Context, name string) error
+	// Get the backup retention policy of the specified backup storage
+	// (GET /backup-storages/{name}/retention-policy)
+	GetBackupStorageRetentionPolicy(ctx echo.Context, name string) error
+	// Set the backup retention policy of the specified backup storage
+	// (PUT /backup-storages/{name}/retention-policy)
+	SetBackupStorageRetentionPolicy(ctx echo.Context, name string) error
+	// Rotate the credentials of the specified backup storage
+	// (POST /backup-storages/{name}/rotate-credentials)
+	RotateBackupStorageCredentials(ctx echo.Context, name string) error
+	// Get the sync status of the specified backup storage on the registered kubernetes clusters
+	// (GET /backup-storages/{name}/sync-status)
+	GetBackupStorageSyncStatus(ctx echo.Context, name string) error
+	// List the backup verifications
+	// (GET /backup-verifications)
+	ListBackupVerifications(ctx echo.Context, params ListBackupVerificationsParams) error
+	// Get the catalog of the options and the example payloads
+	// (GET /catalog)
+	GetCatalog(ctx echo.Context) error
+	// List the canary rollouts of the backup storages and monitoring instances
+	// (GET /config-rollouts)
+	ListConfigRollouts(ctx echo.Context) error
+	// List the restore history
+	// (GET /database-cluster-restores)
+	ListRestoreHistory(ctx echo.Context, params ListRestoreHistoryParams) error
+	// List the component images and versions of all database clusters
+	// (GET /inventory)
+	GetInventory(ctx echo.Context, params GetInventoryParams) error
+	// List of the registered kubernetes clusters
+	// (GET /kubernetes)
+	ListKubernetesClusters(ctx echo.Context, params ListKubernetesClustersParams) error
+	// Register kubernetes cluster in Everest
+	// (POST /kubernetes)
+	RegisterKubernetesCluster(ctx echo.Context) error
+	// Remove the specified kubernetes cluster from Everest
+	// (DELETE /kubernetes/{kubernetes-id})
+	UnregisterKubernetesCluster(ctx echo.Context, kubernetesId string) error
+	// Get the specified kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id})
+	GetKubernetesCluster(ctx echo.Context, kubernetesId string) error
+	// Update the settings of the specified kubernetes cluster
+	// (PATCH /kubernetes/{kubernetes-id})
+	UpdateKubernetesCluster(ctx echo.Context, kubernetesId string) error
+	// List the backup SLOs of the database clusters on the specified kubernetes cluster and their compliance
+	// (GET /kubernetes/{kubernetes-id}/backup-slos)
+	ListBackupSLOs(ctx echo.Context, kubernetesId string) error
+	// Get the bootstrap progress of a kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/bootstrap)
+	GetKubernetesClusterBootstrap(ctx echo.Context, kubernetesId string) error
+	// Install Everest into a kubernetes cluster
+	// (POST /kubernetes/{kubernetes-id}/bootstrap)
+	BootstrapKubernetesCluster(ctx echo.Context, kubernetesId string) error
+	// Get the cluster type and storage classes of a kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/cluster-info)
+	GetKubernetesClusterInfo(ctx echo.Context, kubernetesId string) error
+	// Manage Kubernetes cluster monitoring configuration
+	// (POST /kubernetes/{kubernetes-id}/cluster-monitoring)
+	SetKubernetesClusterMonitoring(ctx echo.Context, kubernetesId string) error
+	// Create a database cluster backup on the specified kubernetes cluster
+	// (POST /kubernetes/{kubernetes-id}/database-cluster-backups)
+	CreateDatabaseClusterBackup(ctx echo.Context, kubernetesId string, params CreateDatabaseClusterBackupParams) error
+	// Delete the specified cluster backup on the specified kubernetes cluster
+	// (DELETE /kubernetes/{kubernetes-id}/database-cluster-backups/{name})
+	DeleteDatabaseClusterBackup(ctx echo.Context, kubernetesId string, name string, params DeleteDatabaseClusterBackupParams) error
+	// Returns the specified cluster backup on the specified kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/database-cluster-backups/{name})
+	GetDatabaseClusterBackup(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterBackupParams) error
+	// Set the legal hold of a backup
+	// (PUT /kubernetes/{kubernetes-id}/database-cluster-backups/{name}/legal-hold)
+	SetDatabaseClusterBackupLegalHold(ctx echo.Context, kubernetesId string, name string, params SetDatabaseClusterBackupLegalHoldParams) error
+	// Create a database cluster restore on the specified kubernetes cluster
+	// (POST /kubernetes/{kubernetes-id}/database-cluster-restores)
+	CreateDatabaseClusterRestore(ctx echo.Context, kubernetesId string, params CreateDatabaseClusterRestoreParams) error
+	// Delete the specified cluster restore on the specified kubernetes cluster
+	// (DELETE /kubernetes/{kubernetes-id}/database-cluster-restores/{name})
+	DeleteDatabaseClusterRestore(ctx echo.Context, kubernetesId string, name string, params DeleteDatabaseClusterRestoreParams) error
+	// Returns the specified cluster restore on the specified kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/database-cluster-restores/{name})
+	GetDatabaseClusterRestore(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterRestoreParams) error
+	// Replace the specified cluster restore on the specified kubernetes cluster
+	// (PUT /kubernetes/{kubernetes-id}/database-cluster-restores/{name})
+	UpdateDatabaseClusterRestore(ctx echo.Context, kubernetesId string, name string, params UpdateDatabaseClusterRestoreParams) error
+	// List of the created database clusters on the specified kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/database-clusters)
+	ListDatabaseClusters(ctx echo.Context, kubernetesId string, params ListDatabaseClustersParams) error
+	// Create a database cluster on the specified kubernetes cluster
+	// (POST /kubernetes/{kubernetes-id}/database-clusters)
+	CreateDatabaseCluster(ctx echo.Context, kubernetesId string) error
+	// Delete the database clusters matching a selector
+	// (POST /kubernetes/{kubernetes-id}/database-clusters/bulk-delete)
+	BulkDeleteDatabaseClusters(ctx echo.Context, kubernetesId string, params BulkDeleteDatabaseClustersParams) error
+	// Estimate the monthly cost of a database cluster
+	// (POST /kubernetes/{kubernetes-id}/database-clusters/cost-estimate)
+	EstimateDatabaseClusterCost(ctx echo.Context, kubernetesId string) error
+	// Preview the manifests of a database cluster
+	// (POST /kubernetes/{kubernetes-id}/database-clusters/preview)
+	PreviewDatabaseCluster(ctx echo.Context, kubernetesId string) error
+	// Delete the specified database cluster on the specified kubernetes cluster
+	// (DELETE /kubernetes/{kubernetes-id}/database-clusters/{name})
+	DeleteDatabaseCluster(ctx echo.Context, kubernetesId string, name string, params DeleteDatabaseClusterParams) error
+	// Get the specified database cluster on the specified kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name})
+	GetDatabaseCluster(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterParams) error
+	// Replace the specified database cluster on the specified kubernetes cluster
+	// (PUT /kubernetes/{kubernetes-id}/database-clusters/{name})
+	UpdateDatabaseCluster(ctx echo.Context, kubernetesId string, name string, params UpdateDatabaseClusterParams) error
+	// Adopt a database cluster created outside of Everest
+	// (POST /kubernetes/{kubernetes-id}/database-clusters/{name}/adopt)
+	AdoptDatabaseCluster(ctx echo.Context, kubernetesId string, name string, params AdoptDatabaseClusterParams) error
+	// Delete the backup SLO of the specified database cluster
+	// (DELETE /kubernetes/{kubernetes-id}/database-clusters/{name}/backup-slo)
+	DeleteDatabaseClusterBackupSLO(ctx echo.Context, kubernetesId string, name string, params DeleteDatabaseClusterBackupSLOParams) error
+	// Get the backup SLO of the specified database cluster and its compliance
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/backup-slo)
+	GetDatabaseClusterBackupSLO(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterBackupSLOParams) error
+	// Set the backup SLO of the specified database cluster
+	// (PUT /kubernetes/{kubernetes-id}/database-clusters/{name}/backup-slo)
+	SetDatabaseClusterBackupSLO(ctx echo.Context, kubernetesId string, name string, params SetDatabaseClusterBackupSLOParams) error
+	// Delete the backup verification policy of the specified database cluster
+	// (DELETE /kubernetes/{kubernetes-id}/database-clusters/{name}/backup-verification-policy)
+	DeleteDatabaseClusterBackupVerificationPolicy(ctx echo.Context, kubernetesId string, name string, params DeleteDatabaseClusterBackupVerificationPolicyParams) error
+	// Get the backup verification policy of the specified database cluster
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/backup-verification-policy)
+	GetDatabaseClusterBackupVerificationPolicy(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterBackupVerificationPolicyParams) error
+	// Set the backup verification policy of the specified database cluster
+	// (PUT /kubernetes/{kubernetes-id}/database-clusters/{name}/backup-verification-policy)
+	SetDatabaseClusterBackupVerificationPolicy(ctx echo.Context, kubernetesId string, name string, params SetDatabaseClusterBackupVerificationPolicyParams) error
+	// List of the created database cluster backups on the specified kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/backups)
+	ListDatabaseClusterBackups(ctx echo.Context, kubernetesId string, name string, params ListDatabaseClusterBackupsParams) error
+	// Estimate the monthly cost of the specified database cluster
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/cost-estimate)
+	GetDatabaseClusterCostEstimate(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterCostEstimateParams) error
+	// Get the specified database cluster credentials on the specified kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/credentials)
+	GetDatabaseClusterCredentials(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterCredentialsParams) error
+	// Revert the diagnostic settings of the specified database cluster immediately
+	// (DELETE /kubernetes/{kubernetes-id}/database-clusters/{name}/diagnostics)
+	RevertDatabaseClusterDiagnostics(ctx echo.Context, kubernetesId string, name string, params RevertDatabaseClusterDiagnosticsParams) error
+	// Get the active diagnostic settings of the specified database cluster
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/diagnostics)
+	GetDatabaseClusterDiagnostics(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterDiagnosticsParams) error
+	// Temporarily enable diagnostic settings on the specified database cluster. The settings are reverted automatically once the TTL expires
+	// (PUT /kubernetes/{kubernetes-id}/database-clusters/{name}/diagnostics)
+	SetDatabaseClusterDiagnostics(ctx echo.Context, kubernetesId string, name string, params SetDatabaseClusterDiagnosticsParams) error
+	// Preview the changes of updating the specified database cluster
+	// (POST /kubernetes/{kubernetes-id}/database-clusters/{name}/diff)
+	DiffDatabaseCluster(ctx echo.Context, kubernetesId string, name string, params DiffDatabaseClusterParams) error
+	// Export the specified database cluster as a manifest
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/export)
+	ExportDatabaseCluster(ctx echo.Context, kubernetesId string, name string, params ExportDatabaseClusterParams) error
+	// Export the specified database cluster with the resources it depends on
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/export-bundle)
+	ExportDatabaseClusterBundle(ctx echo.Context, kubernetesId string, name string, params ExportDatabaseClusterBundleParams) error
+	// Delete the maintenance window
+	// (DELETE /kubernetes/{kubernetes-id}/database-clusters/{name}/maintenance-window)
+	DeleteDatabaseClusterMaintenanceWindow(ctx echo.Context, kubernetesId string, name string, params DeleteDatabaseClusterMaintenanceWindowParams) error
+	// Get the maintenance window
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/maintenance-window)
+	GetDatabaseClusterMaintenanceWindow(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterMaintenanceWindowParams) error
+	// Set the maintenance window
+	// (PUT /kubernetes/{kubernetes-id}/database-clusters/{name}/maintenance-window)
+	SetDatabaseClusterMaintenanceWindow(ctx echo.Context, kubernetesId string, name string, params SetDatabaseClusterMaintenanceWindowParams) error
+	// List the pending operations
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/pending-operations)
+	ListDatabaseClusterPendingOperations(ctx echo.Context, kubernetesId string, name string, params ListDatabaseClusterPendingOperationsParams) error
+	// Cancel a pending operation
+	// (DELETE /kubernetes/{kubernetes-id}/database-clusters/{name}/pending-operations/{id})
+	CancelDatabaseClusterPendingOperation(ctx echo.Context, kubernetesId string, name string, id string, params CancelDatabaseClusterPendingOperationParams) error
+	// Get the resource usage of the specified database cluster
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/resource-usage)
+	GetDatabaseClusterResourceUsage(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterResourceUsageParams) error
+	// List of the created database cluster restores on the specified kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/restores)
+	ListDatabaseClusterRestores(ctx echo.Context, kubernetesId string, name string, params ListDatabaseClusterRestoresParams) error
+	// Delete the backup retention policy of the specified database cluster
+	// (DELETE /kubernetes/{kubernetes-id}/database-clusters/{name}/retention-policy)
+	DeleteDatabaseClusterRetentionPolicy(ctx echo.Context, kubernetesId string, name string, params DeleteDatabaseClusterRetentionPolicyParams) error
+	// Get the backup retention policy of the specified database cluster
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/retention-policy)
+	GetDatabaseClusterRetentionPolicy(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterRetentionPolicyParams) error
+	// Set the backup retention policy of the specified database cluster
+	// (PUT /kubernetes/{kubernetes-id}/database-clusters/{name}/retention-policy)
+	SetDatabaseClusterRetentionPolicy(ctx echo.Context, kubernetesId string, name string, params SetDatabaseClusterRetentionPolicyParams) error
+	// Scale the database cluster
+	// (POST /kubernetes/{kubernetes-id}/database-clusters/{name}/scale)
+	ScaleDatabaseCluster(ctx echo.Context, kubernetesId string, name string, params ScaleDatabaseClusterParams) error
+	// Create a temporary database user
+	// (POST /kubernetes/{kubernetes-id}/database-clusters/{name}/temporary-access)
+	CreateDatabaseClusterTemporaryAccess(ctx echo.Context, kubernetesId string, name string, params CreateDatabaseClusterTemporaryAccessParams) error
+	// Get the database engine upgrade
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/upgrade)
+	GetDatabaseClusterEngineUpgrade(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterEngineUpgradeParams) error
+	// Upgrade the database engine
+	// (POST /kubernetes/{kubernetes-id}/database-clusters/{name}/upgrade)
+	UpgradeDatabaseClusterEngine(ctx echo.Context, kubernetesId string, name string, params UpgradeDatabaseClusterEngineParams) error
+	// Plan the database engine upgrade
+	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/upgrade-plan)
+	GetDatabaseClusterEngineUpgradePlan(ctx echo.Context, kubernetesId string, name string, params GetDatabaseClusterEngineUpgradePlanParams) error
+	// List of the available database engines on the specified kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/database-engines)
+	ListDatabaseEngines(ctx echo.Context, kubernetesId string) error
+	// List the availability of the database engines
+	// (GET /kubernetes/{kubernetes-id}/database-engines-availability)
+	ListDatabaseEnginesAvailability(ctx echo.Context, kubernetesId string) error
+	// Get the availability of a database engine
+	// (GET /kubernetes/{kubernetes-id}/database-engines-availability/{name})
+	GetDatabaseEngineAvailability(ctx echo.Context, kubernetesId string, name string) error
+	// List the versions of a database engine
+	// (GET /kubernetes/{kubernetes-id}/database-engines/{engine-type}/versions)
+	ListDatabaseEngineVersions(ctx echo.Context, kubernetesId string, engineType string) error
+	// Get the specified database engine on the specified kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/database-engines/{name})
+	GetDatabaseEngine(ctx echo.Context, kubernetesId string, name string) error
+	// Update the specified database engine on the specified kubernetes cluster
+	// (PUT /kubernetes/{kubernetes-id}/database-engines/{name})
+	UpdateDatabaseEngine(ctx echo.Context, kubernetesId string, name string) error
+	// Get the progress of the cascade removal of a kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/deregistration)
+	GetKubernetesClusterDeregistration(ctx echo.Context, kubernetesId string) error
+	// List the guardrails of a kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/guardrails)
+	ListKubernetesClusterGuardrails(ctx echo.Context, kubernetesId string) error
+	// Delete the guardrail of an engine type
+	// (DELETE /kubernetes/{kubernetes-id}/guardrails/{engine-type})
+	DeleteKubernetesClusterGuardrail(ctx echo.Context, kubernetesId string, engineType string) error
+	// Set the guardrail of an engine type
+	// (PUT /kubernetes/{kubernetes-id}/guardrails/{engine-type})
+	SetKubernetesClusterGuardrail(ctx echo.Context, kubernetesId string, engineType string) error
+	// Replace the kubeconfig of a kubernetes cluster
+	// (PUT /kubernetes/{kubernetes-id}/kubeconfig)
+	UpdateKubernetesClusterKubeconfig(ctx echo.Context, kubernetesId string) error
+	// Delete the namespace template of a kubernetes cluster
+	// (DELETE /kubernetes/{kubernetes-id}/namespace-template)
+	DeleteKubernetesClusterNamespaceTemplate(ctx echo.Context, kubernetesId string) error
+	// Get the namespace template of a kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/namespace-template)
+	GetKubernetesClusterNamespaceTemplate(ctx echo.Context, kubernetesId string) error
+	// Set the namespace template of a kubernetes cluster
+	// (PUT /kubernetes/{kubernetes-id}/namespace-template)
+	SetKubernetesClusterNamespaceTemplate(ctx echo.Context, kubernetesId string) error
+	// List the namespaces of a kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/namespaces)
+	ListKubernetesClusterNamespaces(ctx echo.Context, kubernetesId string) error
+	// Prepare a namespace of a kubernetes cluster for Everest
+	// (POST /kubernetes/{kubernetes-id}/namespaces)
+	PrepareKubernetesClusterNamespace(ctx echo.Context, kubernetesId string) error
+	// List the operators installed on a kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/operators)
+	ListKubernetesClusterOperators(ctx echo.Context, kubernetesId string) error
+	// Upgrade an operator
+	// (POST /kubernetes/{kubernetes-id}/operators/{operator-name}/upgrade)
+	UpgradeKubernetesClusterOperator(ctx echo.Context, kubernetesId string, operatorName string) error
+	// Delete the orphaned resources created by Everest
+	// (DELETE /kubernetes/{kubernetes-id}/orphaned-resources)
+	DeleteOrphanedResources(ctx echo.Context, kubernetesId string) error
+	// List the orphaned resources created by Everest
+	// (GET /kubernetes/{kubernetes-id}/orphaned-resources)
+	ListOrphanedResources(ctx echo.Context, kubernetesId string) error
+	// Check the capacity of the kubernetes cluster for a database cluster
+	// (POST /kubernetes/{kubernetes-id}/preflight)
+	PreflightDatabaseCluster(ctx echo.Context, kubernetesId string) error
+	// Get the recommended engine versions
+	// (GET /kubernetes/{kubernetes-id}/recommended-versions)
+	GetRecommendedVersions(ctx echo.Context, kubernetesId string) error
+	// Get the capacity and available resources of a kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/resources)
+	GetKubernetesClusterResources(ctx echo.Context, kubernetesId string) error
+	// Re-apply the backup storages and monitoring configs to a kubernetes cluster
+	// (POST /kubernetes/{kubernetes-id}/resync)
+	ResyncKubernetesCluster(ctx echo.Context, kubernetesId string) error
+	// Get the health status of a kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/status)
+	GetKubernetesClusterStatus(ctx echo.Context, kubernetesId string) error
+	// List the storage classes of a kubernetes cluster
+	// (GET /kubernetes/{kubernetes-id}/storage-classes)
+	ListKubernetesClusterStorageClasses(ctx echo.Context, kubernetesId string) error
+	// List backup storages and monitoring configs of a kubernetes cluster which are not managed by Everest
+	// (GET /kubernetes/{kubernetes-id}/unmanaged-configs)
+	ListUnmanagedConfigs(ctx echo.Context, kubernetesId string) error
+	// Import backup storages and monitoring configs of a kubernetes cluster into Everest
+	// (POST /kubernetes/{kubernetes-id}/unmanaged-configs/import)
+	ImportUnmanagedConfigs(ctx echo.Context, kubernetesId string) error
+	// Lint a database cluster spec against the best practices
+	// (POST /lint)
+	LintDatabaseCluster(ctx echo.Context) error
+	// List of the created monitoring instances
+	// (GET /monitoring-instances)
+	ListMonitoringInstances(ctx echo.Context, params ListMonitoringInstancesParams) error
+	// Create a new monitoring instance object
+	// (POST /monitoring-instances)
+	CreateMonitoringInstance(ctx echo.Context) error
+	// Delete the specified Monitoring instance
+	// (DELETE /monitoring-instances/{name})
+	DeleteMonitoringInstance(ctx echo.Context, name string) error
+	// Get the specified monitoring instance
+	// (GET /monitoring-instances/{name})
+	GetMonitoringInstance(ctx echo.Context, name string) error
+	// Update the specified Monitoring instance
+	// (PATCH /monitoring-instances/{name})
+	UpdateMonitoringInstance(ctx echo.Context, name string) error
+	// Restore the specified monitoring instance from the trash
+	// (POST /monitoring-instances/{name}/restore)
+	RestoreMonitoringInstance(ctx echo.Context, name string) error
+	// Push the specified monitoring instance and its credentials to all the registered kubernetes clusters
+	// (POST /monitoring-instances/{name}/resync)
+	ResyncMonitoringInstance(ctx echo.Context, name string) error
+	// Check the health of the specified monitoring instance
+	// (GET /monitoring-instances/{name}/status)
+	GetMonitoringInstanceStatus(ctx echo.Context, name string) error
+	// Get the sync status of the specified monitoring instance on the registered kubernetes clusters
+	// (GET /monitoring-instances/{name}/sync-status)
+	GetMonitoringInstanceSyncStatus(ctx echo.Context, name string) error
+	// List the notification channels
+	// (GET /notification-channels)
+	ListNotificationChannels(ctx echo.Context) error
+	// Create a notification channel
+	// (POST /notification-channels)
+	CreateNotificationChannel(ctx echo.Context) error
+	// Delete a notification channel
+	// (DELETE /notification-channels/{name})
+	DeleteNotificationChannel(ctx echo.Context, name string) error
+	// Get a notification channel
+	// (GET /notification-channels/{name})
+	GetNotificationChannel(ctx echo.Context, name string) error
+	// Update a notification channel
+	// (PATCH /notification-channels/{name})
+	UpdateNotificationChannel(ctx echo.Context, name string) error
+	// Send a test event to a notification channel
+	// (POST /notification-channels/{name}/test)
+	TestNotificationChannel(ctx echo.Context, name string) error
+	// List the notification rules
+	// (GET /notification-rules)
+	ListNotificationRules(ctx echo.Context) error
+	// Create a notification rule
+	// (POST /notification-rules)
+	CreateNotificationRule(ctx echo.Context) error
+	// Delete a notification rule
+	// (DELETE /notification-rules/{name})
+	DeleteNotificationRule(ctx echo.Context, name string) error
+	// Get a notification rule
+	// (GET /notification-rules/{name})
+	GetNotificationRule(ctx echo.Context, name string) error
+	// Get the prices the costs of the database clusters are estimated with
+	// (GET /pricing)
+	GetPricing(ctx echo.Context) error
+	// Set the prices the costs of the database clusters are estimated with
+	// (PUT /pricing)
+	SetPricing(ctx echo.Context) error
+	// List the projects
+	// (GET /projects)
+	ListProjects(ctx echo.Context) error
+	// Create a project
+	// (POST /projects)
+	CreateProject(ctx echo.Context) error
+	// Delete the specified project
+	// (DELETE /projects/{name})
+	DeleteProject(ctx echo.Context, name string) error
+	// Get the specified project
+	// (GET /projects/{name})
+	GetProject(ctx echo.Context, name string) error
+	// List the members of the specified project
+	// (GET /projects/{name}/members)
+	ListProjectMembers(ctx echo.Context, name string) error
+	// Remove a member from the specified project
+	// (DELETE /projects/{name}/members/{subject-kind}/{subject})
+	DeleteProjectMember(ctx echo.Context, name string, subjectKind string, subject string) error
+	// Set the role of a member of the specified project
+	// (PUT /projects/{name}/members/{subject-kind}/{subject})
+	SetProjectMember(ctx echo.Context, name string, subjectKind string, subject string) error
+	// List the quotas of the users and the teams
+	// (GET /quotas)
+	ListQuotas(ctx echo.Context) error
+	// Delete the quota of a user or a team
+	// (DELETE /quotas/{subject-kind}/{subject})
+	DeleteQuota(ctx echo.Context, subjectKind string, subject string) error
+	// Set the quota of a user or a team
+	// (PUT /quotas/{subject-kind}/{subject})
+	SetQuota(ctx echo.Context, subjectKind string, subject string) error
+	// Get the usage of the quota of a user or a team
+	// (GET /quotas/{subject-kind}/{subject}/usage)
+	GetQuotaUsage(ctx echo.Context, subjectKind string, subject string) error
+	// Promote the standby instance to primary
+	// (POST /replication/promote)
+	PromoteReplicationStandby(ctx echo.Context) error
+	// Get the replicated state of Everest
+	// (GET /replication/snapshot)
+	GetReplicationSnapshot(ctx echo.Context, params GetReplicationSnapshotParams) error
+	// Get the replication status of Everest
+	// (GET /replication/status)
+	GetReplicationStatus(ctx echo.Context) error
+	// Search the Everest resources
+	// (GET /search)
+	Search(ctx echo.Context, params SearchParams) error
+	// Log in
+	// (POST /session/login)
+	CreateSession(ctx echo.Context) error
+	// Log out
+	// (POST /session/logout)
+	DeleteSession(ctx echo.Context) error
+	// Refresh a session
+	// (POST /session/refresh)
+	RefreshSession(ctx echo.Context) error
+	// Get the log level
+	// (GET /settings/log-level)
+	GetLogLevel(ctx echo.Context) error
+	// Change the log level
+	// (PUT /settings/log-level)
+	SetLogLevel(ctx echo.Context) error
+	// Get the setup state
+	// (GET /setup)
+	GetSetupState(ctx echo.Context) error
+	// Set the admin credentials
+	// (POST /setup/admin)
+	SetSetupAdmin(ctx echo.Context) error
+	// Pick the default backup storage
+	// (PUT /setup/default-backup-storage)
+	SetSetupDefaultBackupStorage(ctx echo.Context) error
+	// Configure the secrets backend
+	// (POST /setup/secrets-backend)
+	SetSetupSecretsBackend(ctx echo.Context) error
+	// List the resource presets for database clusters
+	// (GET /sizing-presets)
+	ListSizingPresets(ctx echo.Context, params ListSizingPresetsParams) error
+	// Get the aggregate health of Everest
+	// (GET /status)
+	GetPublicStatus(ctx echo.Context) error
+	// Get the summary of all kubernetes clusters for the dashboard
+	// (GET /summary)
+	GetSummary(ctx echo.Context) error
+	// List the webhooks
+	// (GET /webhooks)
+	ListWebhooks(ctx echo.Context) error
+	// Create a webhook
+	// (POST /webhooks)
+	CreateWebhook(ctx echo.Context) error
+	// Delete a webhook
+	// (DELETE /webhooks/{name})
+	DeleteWebhook(ctx echo.Context, name string) error
+	// Get a webhook
+	// (GET /webhooks/{name})
+	GetWebhook(ctx echo.Context, name string) error
+	// Update a webhook
+	// (PATCH /webhooks/{name})
+	UpdateWebhook(ctx echo.Context, name string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// ListAlertRuleTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAlertRuleTemplates(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListAlertRuleTemplates(ctx)
+	return err
+}
+
+// CreateAlertRuleTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateAlertRuleTemplate(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateAlertRuleTemplate(ctx)
+	return err
+}
+
+// DeleteAlertRuleTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAlertRuleTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAlertRuleTemplate(ctx, name)
+	return err
+}
+
+// GetAlertRuleTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAlertRuleTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAlertRuleTemplate(ctx, name)
+	return err
+}
+
+// UpdateAlertRuleTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateAlertRuleTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateAlertRuleTemplate(ctx, name)
+	return err
+}
+
+// ListAlertRules converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAlertRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListAlertRules(ctx)
+	return err
+}
+
+// ListAPITokens converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAPITokens(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListAPITokens(ctx)
+	return err
+}
+
+// CreateAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateAPIToken(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateAPIToken(ctx)
+	return err
+}
+
+// DeleteAPIToken converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAPIToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAPIToken(ctx, name)
+	return err
+}
+
+// ListAuditEntries converts echo context to params.
+func (w *ServerInterfaceWrapper) ListAuditEntries(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEntriesParams
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", ctx.QueryParams(), &params.Action)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListAuditEntries(ctx, params)
+	return err
+}
+
+// ListBackupStorages converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBackupStorages(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBackupStoragesParams
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", ctx.QueryParams(), &params.SortBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort_by: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "region" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "region", ctx.QueryParams(), &params.Region)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter region: %s", err))
+	}
+
+	// ------------- Optional query parameter "name_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name_prefix", ctx.QueryParams(), &params.NamePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name_prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deleted", ctx.QueryParams(), &params.Deleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deleted: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBackupStorages(ctx, params)
+	return err
+}
+
+// CreateBackupStorage converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateBackupStorage(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateBackupStorage(ctx)
+	return err
+}
+
+// DeleteBackupStorage converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBackupStorage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBackupStorage(ctx, name)
+	return err
+}
+
+// GetBackupStorage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBackupStorage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBackupStorage(ctx, name)
+	return err
+}
+
+// UpdateBackupStorage converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateBackupStorage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateBackupStorage(ctx, name)
+	return err
+}
+
+// ListStoredBackups converts echo context to params.
+func (w *ServerInterfaceWrapper) ListStoredBackups(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListStoredBackupsParams
+	// ------------- Optional query parameter "prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prefix", ctx.QueryParams(), &params.Prefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter prefix: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListStoredBackups(ctx, name, params)
+	return err
+}
+
+// CreateStoredBackupDownloadURL converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateStoredBackupDownloadURL(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "key", runtime.ParamLocationPath, ctx.Param("key"), &key)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateStoredBackupDownloadURL(ctx, name, key)
+	return err
+}
+
+// RestoreBackupStorage converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreBackupStorage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreBackupStorage(ctx, name)
+	return err
+}
+
+// ResyncBackupStorage converts echo context to params.
+func (w *ServerInterfaceWrapper) ResyncBackupStorage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResyncBackupStorage(ctx, name)
+	return err
+}
+
+// DeleteBackupStorageRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBackupStorageRetentionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBackupStorageRetentionPolicy(ctx, name)
+	return err
+}
+
+// GetBackupStorageRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBackupStorageRetentionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBackupStorageRetentionPolicy(ctx, name)
+	return err
+}
+
+// SetBackupStorageRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) SetBackupStorageRetentionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetBackupStorageRetentionPolicy(ctx, name)
+	return err
+}
+
+// RotateBackupStorageCredentials converts echo context to params.
+func (w *ServerInterfaceWrapper) RotateBackupStorageCredentials(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RotateBackupStorageCredentials(ctx, name)
+	return err
+}
+
+// GetBackupStorageSyncStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBackupStorageSyncStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBackupStorageSyncStatus(ctx, name)
+	return err
+}
+
+// ListBackupVerifications converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBackupVerifications(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBackupVerificationsParams
+	// ------------- Optional query parameter "kubernetesId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kubernetesId", ctx.QueryParams(), &params.KubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetesId: %s", err))
+	}
+
+	// ------------- Optional query parameter "dbClusterName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dbClusterName", ctx.QueryParams(), &params.DbClusterName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dbClusterName: %s", err))
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBackupVerifications(ctx, params)
+	return err
+}
+
+// GetCatalog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCatalog(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCatalog(ctx)
+	return err
+}
+
+// ListConfigRollouts converts echo context to params.
+func (w *ServerInterfaceWrapper) ListConfigRollouts(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListConfigRollouts(ctx)
+	return err
+}
+
+// ListRestoreHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) ListRestoreHistory(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRestoreHistoryParams
+	// ------------- Optional query parameter "kubernetesId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kubernetesId", ctx.QueryParams(), &params.KubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetesId: %s", err))
+	}
+
+	// ------------- Optional query parameter "cluster" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cluster", ctx.QueryParams(), &params.Cluster)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cluster: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListRestoreHistory(ctx, params)
+	return err
+}
+
+// GetInventory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInventory(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInventoryParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInventory(ctx, params)
+	return err
+}
+
+// ListKubernetesClusters converts echo context to params.
+func (w *ServerInterfaceWrapper) ListKubernetesClusters(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListKubernetesClustersParams
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListKubernetesClusters(ctx, params)
+	return err
+}
+
+// RegisterKubernetesCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) RegisterKubernetesCluster(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RegisterKubernetesCluster(ctx)
+	return err
+}
+
+// UnregisterKubernetesCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) UnregisterKubernetesCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnregisterKubernetesCluster(ctx, kubernetesId)
+	return err
+}
+
+// GetKubernetesCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) GetKubernetesCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetKubernetesCluster(ctx, kubernetesId)
+	return err
+}
+
+// UpdateKubernetesCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateKubernetesCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateKubernetesCluster(ctx, kubernetesId)
+	return err
+}
+
+// ListBackupSLOs converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBackupSLOs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBackupSLOs(ctx, kubernetesId)
+	return err
+}
+
+// GetKubernetesClusterBootstrap converts echo context to params.
+func (w *ServerInterfaceWrapper) GetKubernetesClusterBootstrap(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetKubernetesClusterBootstrap(ctx, kubernetesId)
+	return err
+}
+
+// BootstrapKubernetesCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) BootstrapKubernetesCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BootstrapKubernetesCluster(ctx, kubernetesId)
+	return err
+}
+
+// GetKubernetesClusterInfo converts echo context to params.
+func (w *ServerInterfaceWrapper) GetKubernetesClusterInfo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetKubernetesClusterInfo(ctx, kubernetesId)
+	return err
+}
+
+// SetKubernetesClusterMonitoring converts echo context to params.
+func (w *ServerInterfaceWrapper) SetKubernetesClusterMonitoring(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetKubernetesClusterMonitoring(ctx, kubernetesId)
+	return err
+}
+
+// CreateDatabaseClusterBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseClusterBackup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateDatabaseClusterBackupParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateDatabaseClusterBackup(ctx, kubernetesId, params)
+	return err
+}
+
+// DeleteDatabaseClusterBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDatabaseClusterBackupParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	// ------------- Optional query parameter "reason" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reason", ctx.QueryParams(), &params.Reason)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reason: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseClusterBackup(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseClusterBackup converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterBackup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterBackupParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterBackup(ctx, kubernetesId, name, params)
+	return err
+}
+
+// SetDatabaseClusterBackupLegalHold converts echo context to params.
+func (w *ServerInterfaceWrapper) SetDatabaseClusterBackupLegalHold(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetDatabaseClusterBackupLegalHoldParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetDatabaseClusterBackupLegalHold(ctx, kubernetesId, name, params)
+	return err
+}
+
+// CreateDatabaseClusterRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseClusterRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateDatabaseClusterRestoreParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateDatabaseClusterRestore(ctx, kubernetesId, params)
+	return err
+}
+
+// DeleteDatabaseClusterRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDatabaseClusterRestoreParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseClusterRestore(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseClusterRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterRestoreParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterRestore(ctx, kubernetesId, name, params)
+	return err
+}
+
+// UpdateDatabaseClusterRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseClusterRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateDatabaseClusterRestoreParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseClusterRestore(ctx, kubernetesId, name, params)
+	return err
+}
+
+// ListDatabaseClusters converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseClusters(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDatabaseClustersParams
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter labelSelector: %s", err))
+	}
+
+	// ------------- Optional query parameter "engineType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "engineType", ctx.QueryParams(), &params.EngineType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter engineType: %s", err))
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDatabaseClusters(ctx, kubernetesId, params)
+	return err
+}
+
+// CreateDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateDatabaseCluster(ctx, kubernetesId)
+	return err
+}
+
+// BulkDeleteDatabaseClusters converts echo context to params.
+func (w *ServerInterfaceWrapper) BulkDeleteDatabaseClusters(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BulkDeleteDatabaseClustersParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BulkDeleteDatabaseClusters(ctx, kubernetesId, params)
+	return err
+}
+
+// EstimateDatabaseClusterCost converts echo context to params.
+func (w *ServerInterfaceWrapper) EstimateDatabaseClusterCost(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EstimateDatabaseClusterCost(ctx, kubernetesId)
+	return err
+}
+
+// PreviewDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreviewDatabaseCluster(ctx, kubernetesId)
+	return err
+}
+
+// DeleteDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDatabaseClusterParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseCluster(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseCluster(ctx, kubernetesId, name, params)
+	return err
+}
+
+// UpdateDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateDatabaseClusterParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Optional query parameter "overrideMaintenanceWindow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overrideMaintenanceWindow", ctx.QueryParams(), &params.OverrideMaintenanceWindow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overrideMaintenanceWindow: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = IfMatch
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter If-Match is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseCluster(ctx, kubernetesId, name, params)
+	return err
+}
+
+// AdoptDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) AdoptDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AdoptDatabaseClusterParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdoptDatabaseCluster(ctx, kubernetesId, name, params)
+	return err
+}
+
+// DeleteDatabaseClusterBackupSLO converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackupSLO(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDatabaseClusterBackupSLOParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseClusterBackupSLO(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseClusterBackupSLO converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterBackupSLO(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterBackupSLOParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterBackupSLO(ctx, kubernetesId, name, params)
+	return err
+}
+
+// SetDatabaseClusterBackupSLO converts echo context to params.
+func (w *ServerInterfaceWrapper) SetDatabaseClusterBackupSLO(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetDatabaseClusterBackupSLOParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetDatabaseClusterBackupSLO(ctx, kubernetesId, name, params)
+	return err
+}
+
+// DeleteDatabaseClusterBackupVerificationPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackupVerificationPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDatabaseClusterBackupVerificationPolicyParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseClusterBackupVerificationPolicy(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseClusterBackupVerificationPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterBackupVerificationPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterBackupVerificationPolicyParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterBackupVerificationPolicy(ctx, kubernetesId, name, params)
+	return err
+}
+
+// SetDatabaseClusterBackupVerificationPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) SetDatabaseClusterBackupVerificationPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetDatabaseClusterBackupVerificationPolicyParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetDatabaseClusterBackupVerificationPolicy(ctx, kubernetesId, name, params)
+	return err
+}
+
+// ListDatabaseClusterBackups converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseClusterBackups(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDatabaseClusterBackupsParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDatabaseClusterBackups(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseClusterCostEstimate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterCostEstimate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterCostEstimateParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterCostEstimate(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseClusterCredentials converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterCredentials(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterCredentialsParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterCredentials(ctx, kubernetesId, name, params)
+	return err
+}
+
+// RevertDatabaseClusterDiagnostics converts echo context to params.
+func (w *ServerInterfaceWrapper) RevertDatabaseClusterDiagnostics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevertDatabaseClusterDiagnosticsParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevertDatabaseClusterDiagnostics(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseClusterDiagnostics converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterDiagnostics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterDiagnosticsParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterDiagnostics(ctx, kubernetesId, name, params)
+	return err
+}
+
+// SetDatabaseClusterDiagnostics converts echo context to params.
+func (w *ServerInterfaceWrapper) SetDatabaseClusterDiagnostics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetDatabaseClusterDiagnosticsParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetDatabaseClusterDiagnostics(ctx, kubernetesId, name, params)
+	return err
+}
+
+// DiffDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) DiffDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffDatabaseClusterParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DiffDatabaseCluster(ctx, kubernetesId, name, params)
+	return err
+}
+
+// ExportDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportDatabaseClusterParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportDatabaseCluster(ctx, kubernetesId, name, params)
+	return err
+}
+
+// ExportDatabaseClusterBundle converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportDatabaseClusterBundle(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportDatabaseClusterBundleParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportDatabaseClusterBundle(ctx, kubernetesId, name, params)
+	return err
+}
+
+// DeleteDatabaseClusterMaintenanceWindow converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterMaintenanceWindow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDatabaseClusterMaintenanceWindowParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseClusterMaintenanceWindow(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseClusterMaintenanceWindow converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterMaintenanceWindow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterMaintenanceWindowParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterMaintenanceWindow(ctx, kubernetesId, name, params)
+	return err
+}
+
+// SetDatabaseClusterMaintenanceWindow converts echo context to params.
+func (w *ServerInterfaceWrapper) SetDatabaseClusterMaintenanceWindow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetDatabaseClusterMaintenanceWindowParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetDatabaseClusterMaintenanceWindow(ctx, kubernetesId, name, params)
+	return err
+}
+
+// ListDatabaseClusterPendingOperations converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseClusterPendingOperations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDatabaseClusterPendingOperationsParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDatabaseClusterPendingOperations(ctx, kubernetesId, name, params)
+	return err
+}
+
+// CancelDatabaseClusterPendingOperation converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelDatabaseClusterPendingOperation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelDatabaseClusterPendingOperationParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelDatabaseClusterPendingOperation(ctx, kubernetesId, name, id, params)
+	return err
+}
+
+// GetDatabaseClusterResourceUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterResourceUsage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterResourceUsageParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterResourceUsage(ctx, kubernetesId, name, params)
+	return err
+}
+
+// ListDatabaseClusterRestores converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseClusterRestores(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDatabaseClusterRestoresParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDatabaseClusterRestores(ctx, kubernetesId, name, params)
+	return err
+}
+
+// DeleteDatabaseClusterRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDatabaseClusterRetentionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDatabaseClusterRetentionPolicyParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDatabaseClusterRetentionPolicy(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseClusterRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterRetentionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterRetentionPolicyParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterRetentionPolicy(ctx, kubernetesId, name, params)
+	return err
+}
+
+// SetDatabaseClusterRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) SetDatabaseClusterRetentionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetDatabaseClusterRetentionPolicyParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetDatabaseClusterRetentionPolicy(ctx, kubernetesId, name, params)
+	return err
+}
+
+// ScaleDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) ScaleDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScaleDatabaseClusterParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Optional query parameter "overrideMaintenanceWindow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overrideMaintenanceWindow", ctx.QueryParams(), &params.OverrideMaintenanceWindow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overrideMaintenanceWindow: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScaleDatabaseCluster(ctx, kubernetesId, name, params)
+	return err
+}
+
+// CreateDatabaseClusterTemporaryAccess converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDatabaseClusterTemporaryAccess(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateDatabaseClusterTemporaryAccessParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateDatabaseClusterTemporaryAccess(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseClusterEngineUpgrade converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterEngineUpgrade(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterEngineUpgradeParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterEngineUpgrade(ctx, kubernetesId, name, params)
+	return err
+}
+
+// UpgradeDatabaseClusterEngine converts echo context to params.
+func (w *ServerInterfaceWrapper) UpgradeDatabaseClusterEngine(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpgradeDatabaseClusterEngineParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Optional query parameter "overrideMaintenanceWindow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overrideMaintenanceWindow", ctx.QueryParams(), &params.OverrideMaintenanceWindow)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overrideMaintenanceWindow: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpgradeDatabaseClusterEngine(ctx, kubernetesId, name, params)
+	return err
+}
+
+// GetDatabaseClusterEngineUpgradePlan converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseClusterEngineUpgradePlan(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseClusterEngineUpgradePlanParams
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Required query parameter "targetVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "targetVersion", ctx.QueryParams(), &params.TargetVersion)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter targetVersion: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseClusterEngineUpgradePlan(ctx, kubernetesId, name, params)
+	return err
+}
+
+// ListDatabaseEngines converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseEngines(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDatabaseEngines(ctx, kubernetesId)
+	return err
+}
+
+// ListDatabaseEnginesAvailability converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseEnginesAvailability(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDatabaseEnginesAvailability(ctx, kubernetesId)
+	return err
+}
+
+// GetDatabaseEngineAvailability converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseEngineAvailability(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseEngineAvailability(ctx, kubernetesId, name)
+	return err
+}
+
+// ListDatabaseEngineVersions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDatabaseEngineVersions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "engine-type" -------------
+	var engineType string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "engine-type", runtime.ParamLocationPath, ctx.Param("engine-type"), &engineType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter engine-type: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDatabaseEngineVersions(ctx, kubernetesId, engineType)
+	return err
+}
+
+// GetDatabaseEngine converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDatabaseEngine(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDatabaseEngine(ctx, kubernetesId, name)
+	return err
+}
+
+// UpdateDatabaseEngine converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateDatabaseEngine(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateDatabaseEngine(ctx, kubernetesId, name)
+	return err
+}
+
+// GetKubernetesClusterDeregistration converts echo context to params.
+func (w *ServerInterfaceWrapper) GetKubernetesClusterDeregistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetKubernetesClusterDeregistration(ctx, kubernetesId)
+	return err
+}
+
+// ListKubernetesClusterGuardrails converts echo context to params.
+func (w *ServerInterfaceWrapper) ListKubernetesClusterGuardrails(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListKubernetesClusterGuardrails(ctx, kubernetesId)
+	return err
+}
+
+// DeleteKubernetesClusterGuardrail converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteKubernetesClusterGuardrail(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "engine-type" -------------
+	var engineType string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "engine-type", runtime.ParamLocationPath, ctx.Param("engine-type"), &engineType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter engine-type: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteKubernetesClusterGuardrail(ctx, kubernetesId, engineType)
+	return err
+}
+
+// SetKubernetesClusterGuardrail converts echo context to params.
+func (w *ServerInterfaceWrapper) SetKubernetesClusterGuardrail(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "engine-type" -------------
+	var engineType string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "engine-type", runtime.ParamLocationPath, ctx.Param("engine-type"), &engineType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter engine-type: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetKubernetesClusterGuardrail(ctx, kubernetesId, engineType)
+	return err
+}
+
+// UpdateKubernetesClusterKubeconfig converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateKubernetesClusterKubeconfig(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateKubernetesClusterKubeconfig(ctx, kubernetesId)
+	return err
+}
+
+// DeleteKubernetesClusterNamespaceTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteKubernetesClusterNamespaceTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteKubernetesClusterNamespaceTemplate(ctx, kubernetesId)
+	return err
+}
+
+// GetKubernetesClusterNamespaceTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) GetKubernetesClusterNamespaceTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetKubernetesClusterNamespaceTemplate(ctx, kubernetesId)
+	return err
+}
+
+// SetKubernetesClusterNamespaceTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) SetKubernetesClusterNamespaceTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetKubernetesClusterNamespaceTemplate(ctx, kubernetesId)
+	return err
+}
+
+// ListKubernetesClusterNamespaces converts echo context to params.
+func (w *ServerInterfaceWrapper) ListKubernetesClusterNamespaces(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListKubernetesClusterNamespaces(ctx, kubernetesId)
+	return err
+}
+
+// PrepareKubernetesClusterNamespace converts echo context to params.
+func (w *ServerInterfaceWrapper) PrepareKubernetesClusterNamespace(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PrepareKubernetesClusterNamespace(ctx, kubernetesId)
+	return err
+}
+
+// ListKubernetesClusterOperators converts echo context to params.
+func (w *ServerInterfaceWrapper) ListKubernetesClusterOperators(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
 	if err != nil {
-		return err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListKubernetesClusterOperators(ctx, kubernetesId)
 	return err
 }
 
-// AsDatabaseClusterSpecMonitoringResourcesRequests1 returns the union data inside the DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties as a DatabaseClusterSpecMonitoringResourcesRequests1
-func (t DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) AsDatabaseClusterSpecMonitoringResourcesRequests1() (DatabaseClusterSpecMonitoringResourcesRequests1, error) {
-	var body DatabaseClusterSpecMonitoringResourcesRequests1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+// UpgradeKubernetesClusterOperator converts echo context to params.
+func (w *ServerInterfaceWrapper) UpgradeKubernetesClusterOperator(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
 
-// FromDatabaseClusterSpecMonitoringResourcesRequests1 overwrites any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties as the provided DatabaseClusterSpecMonitoringResourcesRequests1
-func (t *DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) FromDatabaseClusterSpecMonitoringResourcesRequests1(v DatabaseClusterSpecMonitoringResourcesRequests1) error {
-	b, err := json.Marshal(v)
-	t.union = b
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// ------------- Path parameter "operator-name" -------------
+	var operatorName string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "operator-name", runtime.ParamLocationPath, ctx.Param("operator-name"), &operatorName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter operator-name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpgradeKubernetesClusterOperator(ctx, kubernetesId, operatorName)
 	return err
 }
 
-// MergeDatabaseClusterSpecMonitoringResourcesRequests1 performs a merge with any union data inside the DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties, using the provided DatabaseClusterSpecMonitoringResourcesRequests1
-func (t *DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) MergeDatabaseClusterSpecMonitoringResourcesRequests1(v DatabaseClusterSpecMonitoringResourcesRequests1) error {
-	b, err := json.Marshal(v)
+// DeleteOrphanedResources converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteOrphanedResources(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
 	if err != nil {
-		return err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteOrphanedResources(ctx, kubernetesId)
 	return err
 }
 
-func (t DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
+// ListOrphanedResources converts echo context to params.
+func (w *ServerInterfaceWrapper) ListOrphanedResources(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
 
-func (t *DatabaseCluster_Spec_Monitoring_Resources_Requests_AdditionalProperties) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListOrphanedResources(ctx, kubernetesId)
 	return err
 }
 
-// AsDatabaseClusterSpecProxyResourcesCpu0 returns the union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu as a DatabaseClusterSpecProxyResourcesCpu0
-func (t DatabaseCluster_Spec_Proxy_Resources_Cpu) AsDatabaseClusterSpecProxyResourcesCpu0() (DatabaseClusterSpecProxyResourcesCpu0, error) {
-	var body DatabaseClusterSpecProxyResourcesCpu0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+// PreflightDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) PreflightDatabaseCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
 
-// FromDatabaseClusterSpecProxyResourcesCpu0 overwrites any union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu as the provided DatabaseClusterSpecProxyResourcesCpu0
-func (t *DatabaseCluster_Spec_Proxy_Resources_Cpu) FromDatabaseClusterSpecProxyResourcesCpu0(v DatabaseClusterSpecProxyResourcesCpu0) error {
-	b, err := json.Marshal(v)
-	t.union = b
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreflightDatabaseCluster(ctx, kubernetesId)
 	return err
 }
 
-// MergeDatabaseClusterSpecProxyResourcesCpu0 performs a merge with any union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu, using the provided DatabaseClusterSpecProxyResourcesCpu0
-func (t *DatabaseCluster_Spec_Proxy_Resources_Cpu) MergeDatabaseClusterSpecProxyResourcesCpu0(v DatabaseClusterSpecProxyResourcesCpu0) error {
-	b, err := json.Marshal(v)
+// GetRecommendedVersions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecommendedVersions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
 	if err != nil {
-		return err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRecommendedVersions(ctx, kubernetesId)
 	return err
 }
 
-// AsDatabaseClusterSpecProxyResourcesCpu1 returns the union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu as a DatabaseClusterSpecProxyResourcesCpu1
-func (t DatabaseCluster_Spec_Proxy_Resources_Cpu) AsDatabaseClusterSpecProxyResourcesCpu1() (DatabaseClusterSpecProxyResourcesCpu1, error) {
-	var body DatabaseClusterSpecProxyResourcesCpu1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// GetKubernetesClusterResources converts echo context to params.
+func (w *ServerInterfaceWrapper) GetKubernetesClusterResources(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetKubernetesClusterResources(ctx, kubernetesId)
+	return err
 }
 
-// FromDatabaseClusterSpecProxyResourcesCpu1 overwrites any union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu as the provided DatabaseClusterSpecProxyResourcesCpu1
-func (t *DatabaseCluster_Spec_Proxy_Resources_Cpu) FromDatabaseClusterSpecProxyResourcesCpu1(v DatabaseClusterSpecProxyResourcesCpu1) error {
-	b, err := json.Marshal(v)
-	t.union = b
+// ResyncKubernetesCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) ResyncKubernetesCluster(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResyncKubernetesCluster(ctx, kubernetesId)
 	return err
 }
 
-// MergeDatabaseClusterSpecProxyResourcesCpu1 performs a merge with any union data inside the DatabaseCluster_Spec_Proxy_Resources_Cpu, using the provided DatabaseClusterSpecProxyResourcesCpu1
-func (t *DatabaseCluster_Spec_Proxy_Resources_Cpu) MergeDatabaseClusterSpecProxyResourcesCpu1(v DatabaseClusterSpecProxyResourcesCpu1) error {
-	b, err := json.Marshal(v)
+// GetKubernetesClusterStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetKubernetesClusterStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
 	if err != nil {
-		return err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetKubernetesClusterStatus(ctx, kubernetesId)
 	return err
 }
 
-func (t DatabaseCluster_Spec_Proxy_Resources_Cpu) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
+// ListKubernetesClusterStorageClasses converts echo context to params.
+func (w *ServerInterfaceWrapper) ListKubernetesClusterStorageClasses(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListKubernetesClusterStorageClasses(ctx, kubernetesId)
+	return err
 }
 
-func (t *DatabaseCluster_Spec_Proxy_Resources_Cpu) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
+// ListUnmanagedConfigs converts echo context to params.
+func (w *ServerInterfaceWrapper) ListUnmanagedConfigs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListUnmanagedConfigs(ctx, kubernetesId)
 	return err
 }
 
-// AsDatabaseClusterSpecProxyResourcesMemory0 returns the union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory as a DatabaseClusterSpecProxyResourcesMemory0
-func (t DatabaseCluster_Spec_Proxy_Resources_Memory) AsDatabaseClusterSpecProxyResourcesMemory0() (DatabaseClusterSpecProxyResourcesMemory0, error) {
-	var body DatabaseClusterSpecProxyResourcesMemory0
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+// ImportUnmanagedConfigs converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportUnmanagedConfigs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "kubernetes-id" -------------
+	var kubernetesId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ImportUnmanagedConfigs(ctx, kubernetesId)
+	return err
 }
 
-// FromDatabaseClusterSpecProxyResourcesMemory0 overwrites any union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory as the provided DatabaseClusterSpecProxyResourcesMemory0
-func (t *DatabaseCluster_Spec_Proxy_Resources_Memory) FromDatabaseClusterSpecProxyResourcesMemory0(v DatabaseClusterSpecProxyResourcesMemory0) error {
-	b, err := json.Marshal(v)
-	t.union = b
+// LintDatabaseCluster converts echo context to params.
+func (w *ServerInterfaceWrapper) LintDatabaseCluster(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LintDatabaseCluster(ctx)
 	return err
 }
 
-// MergeDatabaseClusterSpecProxyResourcesMemory0 performs a merge with any union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory, using the provided DatabaseClusterSpecProxyResourcesMemory0
-func (t *DatabaseCluster_Spec_Proxy_Resources_Memory) MergeDatabaseClusterSpecProxyResourcesMemory0(v DatabaseClusterSpecProxyResourcesMemory0) error {
-	b, err := json.Marshal(v)
+// ListMonitoringInstances converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMonitoringInstances(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMonitoringInstancesParams
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", ctx.QueryParams(), &params.SortBy)
 	if err != nil {
-		return err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort_by: %s", err))
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "name_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name_prefix", ctx.QueryParams(), &params.NamePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name_prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "deleted", ctx.QueryParams(), &params.Deleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deleted: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMonitoringInstances(ctx, params)
 	return err
 }
 
-// AsDatabaseClusterSpecProxyResourcesMemory1 returns the union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory as a DatabaseClusterSpecProxyResourcesMemory1
-func (t DatabaseCluster_Spec_Proxy_Resources_Memory) AsDatabaseClusterSpecProxyResourcesMemory1() (DatabaseClusterSpecProxyResourcesMemory1, error) {
-	var body DatabaseClusterSpecProxyResourcesMemory1
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+// CreateMonitoringInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateMonitoringInstance(ctx echo.Context) error {
+	var err error
 
-// FromDatabaseClusterSpecProxyResourcesMemory1 overwrites any union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory as the provided DatabaseClusterSpecProxyResourcesMemory1
-func (t *DatabaseCluster_Spec_Proxy_Resources_Memory) FromDatabaseClusterSpecProxyResourcesMemory1(v DatabaseClusterSpecProxyResourcesMemory1) error {
-	b, err := json.Marshal(v)
-	t.union = b
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateMonitoringInstance(ctx)
 	return err
 }
 
-// MergeDatabaseClusterSpecProxyResourcesMemory1 performs a merge with any union data inside the DatabaseCluster_Spec_Proxy_Resources_Memory, using the provided DatabaseClusterSpecProxyResourcesMemory1
-func (t *DatabaseCluster_Spec_Proxy_Resources_Memory) MergeDatabaseClusterSpecProxyResourcesMemory1(v DatabaseClusterSpecProxyResourcesMemory1) error {
-	b, err := json.Marshal(v)
+// DeleteMonitoringInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMonitoringInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
 	if err != nil {
-		return err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	merged, err := runtime.JsonMerge(t.union, b)
-	t.union = merged
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteMonitoringInstance(ctx, name)
 	return err
 }
 
-func (t DatabaseCluster_Spec_Proxy_Resources_Memory) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
-
-func (t *DatabaseCluster_Spec_Proxy_Resources_Memory) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
+// GetMonitoringInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMonitoringInstance(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// List of the created backup storages
-	// (GET /backup-storages)
-	ListBackupStorages(ctx echo.Context) error
-	// Create a new backup storage object
-	// (POST /backup-storages)
-	CreateBackupStorage(ctx echo.Context) error
-	// Delete the specified backup storage
-	// (DELETE /backup-storages/{name})
-	DeleteBackupStorage(ctx echo.Context, name string) error
-	// Get the specified backup storage
-	// (GET /backup-storages/{name})
-	GetBackupStorage(ctx echo.Context, name string) error
-	// Partial update of the specified backup storage
-	// (PATCH /backup-storages/{name})
-	UpdateBackupStorage(ctx echo.Context, name string) error
-	// List of the registered kubernetes clusters
-	// (GET /kubernetes)
-	ListKubernetesClusters(ctx echo.Context) error
-	// Register kubernetes cluster in Everest
-	// (POST /kubernetes)
-	RegisterKubernetesCluster(ctx echo.Context) error
-	// Remove the specified kubernetes cluster from Everest
-	// (DELETE /kubernetes/{kubernetes-id})
-	UnregisterKubernetesCluster(ctx echo.Context, kubernetesId string) error
-	// Get the specified kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id})
-	GetKubernetesCluster(ctx echo.Context, kubernetesId string) error
-	// Get the cluster type and storage classes of a kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id}/cluster-info)
-	GetKubernetesClusterInfo(ctx echo.Context, kubernetesId string) error
-	// Manage Kubernetes cluster monitoring configuration
-	// (POST /kubernetes/{kubernetes-id}/cluster-monitoring)
-	SetKubernetesClusterMonitoring(ctx echo.Context, kubernetesId string) error
-	// Create a database cluster backup on the specified kubernetes cluster
-	// (POST /kubernetes/{kubernetes-id}/database-cluster-backups)
-	CreateDatabaseClusterBackup(ctx echo.Context, kubernetesId string) error
-	// Delete the specified cluster backup on the specified kubernetes cluster
-	// (DELETE /kubernetes/{kubernetes-id}/database-cluster-backups/{name})
-	DeleteDatabaseClusterBackup(ctx echo.Context, kubernetesId string, name string) error
-	// Returns the specified cluster backup on the specified kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id}/database-cluster-backups/{name})
-	GetDatabaseClusterBackup(ctx echo.Context, kubernetesId string, name string) error
-	// Create a database cluster restore on the specified kubernetes cluster
-	// (POST /kubernetes/{kubernetes-id}/database-cluster-restores)
-	CreateDatabaseClusterRestore(ctx echo.Context, kubernetesId string) error
-	// Delete the specified cluster restore on the specified kubernetes cluster
-	// (DELETE /kubernetes/{kubernetes-id}/database-cluster-restores/{name})
-	DeleteDatabaseClusterRestore(ctx echo.Context, kubernetesId string, name string) error
-	// Returns the specified cluster restore on the specified kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id}/database-cluster-restores/{name})
-	GetDatabaseClusterRestore(ctx echo.Context, kubernetesId string, name string) error
-	// Replace the specified cluster restore on the specified kubernetes cluster
-	// (PUT /kubernetes/{kubernetes-id}/database-cluster-restores/{name})
-	UpdateDatabaseClusterRestore(ctx echo.Context, kubernetesId string, name string) error
-	// List of the created database clusters on the specified kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id}/database-clusters)
-	ListDatabaseClusters(ctx echo.Context, kubernetesId string) error
-	// Create a database cluster on the specified kubernetes cluster
-	// (POST /kubernetes/{kubernetes-id}/database-clusters)
-	CreateDatabaseCluster(ctx echo.Context, kubernetesId string) error
-	// Delete the specified database cluster on the specified kubernetes cluster
-	// (DELETE /kubernetes/{kubernetes-id}/database-clusters/{name})
-	DeleteDatabaseCluster(ctx echo.Context, kubernetesId string, name string) error
-	// Get the specified database cluster on the specified kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name})
-	GetDatabaseCluster(ctx echo.Context, kubernetesId string, name string) error
-	// Replace the specified database cluster on the specified kubernetes cluster
-	// (PUT /kubernetes/{kubernetes-id}/database-clusters/{name})
-	UpdateDatabaseCluster(ctx echo.Context, kubernetesId string, name string) error
-	// List of the created database cluster backups on the specified kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/backups)
-	ListDatabaseClusterBackups(ctx echo.Context, kubernetesId string, name string) error
-	// Get the specified database cluster credentials on the specified kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/credentials)
-	GetDatabaseClusterCredentials(ctx echo.Context, kubernetesId string, name string) error
-	// List of the created database cluster restores on the specified kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id}/database-clusters/{name}/restores)
-	ListDatabaseClusterRestores(ctx echo.Context, kubernetesId string, name string) error
-	// List of the available database engines on the specified kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id}/database-engines)
-	ListDatabaseEngines(ctx echo.Context, kubernetesId string) error
-	// Get the specified database engine on the specified kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id}/database-engines/{name})
-	GetDatabaseEngine(ctx echo.Context, kubernetesId string, name string) error
-	// Update the specified database engine on the specified kubernetes cluster
-	// (PUT /kubernetes/{kubernetes-id}/database-engines/{name})
-	UpdateDatabaseEngine(ctx echo.Context, kubernetesId string, name string) error
-	// Get the capacity and available resources of a kubernetes cluster
-	// (GET /kubernetes/{kubernetes-id}/resources)
-	GetKubernetesClusterResources(ctx echo.Context, kubernetesId string) error
-	// List of the created monitoring instances
-	// (GET /monitoring-instances)
-	ListMonitoringInstances(ctx echo.Context) error
-	// Create a new monitoring instance object
-	// (POST /monitoring-instances)
-	CreateMonitoringInstance(ctx echo.Context) error
-	// Delete the specified Monitoring instance
-	// (DELETE /monitoring-instances/{name})
-	DeleteMonitoringInstance(ctx echo.Context, name string) error
-	// Get the specified monitoring instance
-	// (GET /monitoring-instances/{name})
-	GetMonitoringInstance(ctx echo.Context, name string) error
-	// Update the specified Monitoring instance
-	// (PATCH /monitoring-instances/{name})
-	UpdateMonitoringInstance(ctx echo.Context, name string) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMonitoringInstance(ctx, name)
+	return err
 }
 
-// ListBackupStorages converts echo context to params.
-func (w *ServerInterfaceWrapper) ListBackupStorages(ctx echo.Context) error {
+// UpdateMonitoringInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMonitoringInstance(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListBackupStorages(ctx)
+	err = w.Handler.UpdateMonitoringInstance(ctx, name)
 	return err
 }
 
-// CreateBackupStorage converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateBackupStorage(ctx echo.Context) error {
+// RestoreMonitoringInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreMonitoringInstance(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateBackupStorage(ctx)
+	err = w.Handler.RestoreMonitoringInstance(ctx, name)
 	return err
 }
 
-// DeleteBackupStorage converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteBackupStorage(ctx echo.Context) error {
+// ResyncMonitoringInstance converts echo context to params.
+func (w *ServerInterfaceWrapper) ResyncMonitoringInstance(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "name" -------------
 	var name string
@@ -1367,12 +7291,12 @@ func (w *ServerInterfaceWrapper) DeleteBackupStorage(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteBackupStorage(ctx, name)
+	err = w.Handler.ResyncMonitoringInstance(ctx, name)
 	return err
 }
 
-// GetBackupStorage converts echo context to params.
-func (w *ServerInterfaceWrapper) GetBackupStorage(ctx echo.Context) error {
+// GetMonitoringInstanceStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMonitoringInstanceStatus(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "name" -------------
 	var name string
@@ -1383,12 +7307,12 @@ func (w *ServerInterfaceWrapper) GetBackupStorage(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetBackupStorage(ctx, name)
+	err = w.Handler.GetMonitoringInstanceStatus(ctx, name)
 	return err
 }
 
-// UpdateBackupStorage converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateBackupStorage(ctx echo.Context) error {
+// GetMonitoringInstanceSyncStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMonitoringInstanceSyncStatus(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "name" -------------
 	var name string
@@ -1399,119 +7323,129 @@ func (w *ServerInterfaceWrapper) UpdateBackupStorage(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateBackupStorage(ctx, name)
+	err = w.Handler.GetMonitoringInstanceSyncStatus(ctx, name)
 	return err
 }
 
-// ListKubernetesClusters converts echo context to params.
-func (w *ServerInterfaceWrapper) ListKubernetesClusters(ctx echo.Context) error {
+// ListNotificationChannels converts echo context to params.
+func (w *ServerInterfaceWrapper) ListNotificationChannels(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListKubernetesClusters(ctx)
+	err = w.Handler.ListNotificationChannels(ctx)
 	return err
 }
 
-// RegisterKubernetesCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) RegisterKubernetesCluster(ctx echo.Context) error {
+// CreateNotificationChannel converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateNotificationChannel(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RegisterKubernetesCluster(ctx)
+	err = w.Handler.CreateNotificationChannel(ctx)
 	return err
 }
 
-// UnregisterKubernetesCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) UnregisterKubernetesCluster(ctx echo.Context) error {
+// DeleteNotificationChannel converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNotificationChannel(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UnregisterKubernetesCluster(ctx, kubernetesId)
+	err = w.Handler.DeleteNotificationChannel(ctx, name)
 	return err
 }
 
-// GetKubernetesCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) GetKubernetesCluster(ctx echo.Context) error {
+// GetNotificationChannel converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNotificationChannel(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetKubernetesCluster(ctx, kubernetesId)
+	err = w.Handler.GetNotificationChannel(ctx, name)
 	return err
 }
 
-// GetKubernetesClusterInfo converts echo context to params.
-func (w *ServerInterfaceWrapper) GetKubernetesClusterInfo(ctx echo.Context) error {
+// UpdateNotificationChannel converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateNotificationChannel(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetKubernetesClusterInfo(ctx, kubernetesId)
+	err = w.Handler.UpdateNotificationChannel(ctx, name)
 	return err
 }
 
-// SetKubernetesClusterMonitoring converts echo context to params.
-func (w *ServerInterfaceWrapper) SetKubernetesClusterMonitoring(ctx echo.Context) error {
+// TestNotificationChannel converts echo context to params.
+func (w *ServerInterfaceWrapper) TestNotificationChannel(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SetKubernetesClusterMonitoring(ctx, kubernetesId)
+	err = w.Handler.TestNotificationChannel(ctx, name)
 	return err
 }
 
-// CreateDatabaseClusterBackup converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateDatabaseClusterBackup(ctx echo.Context) error {
+// ListNotificationRules converts echo context to params.
+func (w *ServerInterfaceWrapper) ListNotificationRules(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListNotificationRules(ctx)
+	return err
+}
+
+// CreateNotificationRule converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateNotificationRule(ctx echo.Context) error {
+	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateDatabaseClusterBackup(ctx, kubernetesId)
+	err = w.Handler.CreateNotificationRule(ctx)
 	return err
 }
 
-// DeleteDatabaseClusterBackup converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackup(ctx echo.Context) error {
+// DeleteNotificationRule converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNotificationRule(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteNotificationRule(ctx, name)
+	return err
+}
+
+// GetNotificationRule converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNotificationRule(ctx echo.Context) error {
+	var err error
 	// ------------- Path parameter "name" -------------
 	var name string
 
@@ -1521,21 +7455,49 @@ func (w *ServerInterfaceWrapper) DeleteDatabaseClusterBackup(ctx echo.Context) e
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteDatabaseClusterBackup(ctx, kubernetesId, name)
+	err = w.Handler.GetNotificationRule(ctx, name)
 	return err
 }
 
-// GetDatabaseClusterBackup converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterBackup(ctx echo.Context) error {
+// GetPricing converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPricing(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPricing(ctx)
+	return err
+}
+
+// SetPricing converts echo context to params.
+func (w *ServerInterfaceWrapper) SetPricing(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetPricing(ctx)
+	return err
+}
+
+// ListProjects converts echo context to params.
+func (w *ServerInterfaceWrapper) ListProjects(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListProjects(ctx)
+	return err
+}
+
+// CreateProject converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateProject(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateProject(ctx)
+	return err
+}
 
+// DeleteProject converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProject(ctx echo.Context) error {
+	var err error
 	// ------------- Path parameter "name" -------------
 	var name string
 
@@ -1545,37 +7507,29 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterBackup(ctx echo.Context) erro
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterBackup(ctx, kubernetesId, name)
+	err = w.Handler.DeleteProject(ctx, name)
 	return err
 }
 
-// CreateDatabaseClusterRestore converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateDatabaseClusterRestore(ctx echo.Context) error {
+// GetProject converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProject(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateDatabaseClusterRestore(ctx, kubernetesId)
+	err = w.Handler.GetProject(ctx, name)
 	return err
 }
 
-// DeleteDatabaseClusterRestore converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteDatabaseClusterRestore(ctx echo.Context) error {
+// ListProjectMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) ListProjectMembers(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
-	}
-
 	// ------------- Path parameter "name" -------------
 	var name string
 
@@ -1585,21 +7539,13 @@ func (w *ServerInterfaceWrapper) DeleteDatabaseClusterRestore(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteDatabaseClusterRestore(ctx, kubernetesId, name)
+	err = w.Handler.ListProjectMembers(ctx, name)
 	return err
 }
 
-// GetDatabaseClusterRestore converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterRestore(ctx echo.Context) error {
+// DeleteProjectMember converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectMember(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
-	}
-
 	// ------------- Path parameter "name" -------------
 	var name string
 
@@ -1608,22 +7554,30 @@ func (w *ServerInterfaceWrapper) GetDatabaseClusterRestore(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterRestore(ctx, kubernetesId, name)
-	return err
-}
+	// ------------- Path parameter "subject-kind" -------------
+	var subjectKind string
 
-// UpdateDatabaseClusterRestore converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateDatabaseClusterRestore(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subject-kind", runtime.ParamLocationPath, ctx.Param("subject-kind"), &subjectKind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject-kind: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	// ------------- Path parameter "subject" -------------
+	var subject string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subject", runtime.ParamLocationPath, ctx.Param("subject"), &subject)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject: %s", err))
 	}
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectMember(ctx, name, subjectKind, subject)
+	return err
+}
+
+// SetProjectMember converts echo context to params.
+func (w *ServerInterfaceWrapper) SetProjectMember(ctx echo.Context) error {
+	var err error
 	// ------------- Path parameter "name" -------------
 	var name string
 
@@ -1632,287 +7586,319 @@ func (w *ServerInterfaceWrapper) UpdateDatabaseClusterRestore(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateDatabaseClusterRestore(ctx, kubernetesId, name)
-	return err
-}
+	// ------------- Path parameter "subject-kind" -------------
+	var subjectKind string
 
-// ListDatabaseClusters converts echo context to params.
-func (w *ServerInterfaceWrapper) ListDatabaseClusters(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subject-kind", runtime.ParamLocationPath, ctx.Param("subject-kind"), &subjectKind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject-kind: %s", err))
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	// ------------- Path parameter "subject" -------------
+	var subject string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subject", runtime.ParamLocationPath, ctx.Param("subject"), &subject)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListDatabaseClusters(ctx, kubernetesId)
+	err = w.Handler.SetProjectMember(ctx, name, subjectKind, subject)
 	return err
 }
 
-// CreateDatabaseCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateDatabaseCluster(ctx echo.Context) error {
+// ListQuotas converts echo context to params.
+func (w *ServerInterfaceWrapper) ListQuotas(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
-	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateDatabaseCluster(ctx, kubernetesId)
+	err = w.Handler.ListQuotas(ctx)
 	return err
 }
 
-// DeleteDatabaseCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteDatabaseCluster(ctx echo.Context) error {
+// DeleteQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteQuota(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
+	// ------------- Path parameter "subject-kind" -------------
+	var subjectKind string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subject-kind", runtime.ParamLocationPath, ctx.Param("subject-kind"), &subjectKind)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject-kind: %s", err))
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "subject" -------------
+	var subject string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subject", runtime.ParamLocationPath, ctx.Param("subject"), &subject)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteDatabaseCluster(ctx, kubernetesId, name)
+	err = w.Handler.DeleteQuota(ctx, subjectKind, subject)
 	return err
 }
 
-// GetDatabaseCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseCluster(ctx echo.Context) error {
+// SetQuota converts echo context to params.
+func (w *ServerInterfaceWrapper) SetQuota(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
+	// ------------- Path parameter "subject-kind" -------------
+	var subjectKind string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subject-kind", runtime.ParamLocationPath, ctx.Param("subject-kind"), &subjectKind)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject-kind: %s", err))
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "subject" -------------
+	var subject string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subject", runtime.ParamLocationPath, ctx.Param("subject"), &subject)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseCluster(ctx, kubernetesId, name)
+	err = w.Handler.SetQuota(ctx, subjectKind, subject)
 	return err
 }
 
-// UpdateDatabaseCluster converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateDatabaseCluster(ctx echo.Context) error {
+// GetQuotaUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQuotaUsage(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
+	// ------------- Path parameter "subject-kind" -------------
+	var subjectKind string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subject-kind", runtime.ParamLocationPath, ctx.Param("subject-kind"), &subjectKind)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject-kind: %s", err))
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "subject" -------------
+	var subject string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "subject", runtime.ParamLocationPath, ctx.Param("subject"), &subject)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateDatabaseCluster(ctx, kubernetesId, name)
+	err = w.Handler.GetQuotaUsage(ctx, subjectKind, subject)
 	return err
 }
 
-// ListDatabaseClusterBackups converts echo context to params.
-func (w *ServerInterfaceWrapper) ListDatabaseClusterBackups(ctx echo.Context) error {
+// PromoteReplicationStandby converts echo context to params.
+func (w *ServerInterfaceWrapper) PromoteReplicationStandby(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PromoteReplicationStandby(ctx)
+	return err
+}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+// GetReplicationSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReplicationSnapshot(ctx echo.Context) error {
+	var err error
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReplicationSnapshotParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Everest-Replication-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Everest-Replication-Token")]; found {
+		var XEverestReplicationToken string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Everest-Replication-Token, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Everest-Replication-Token", runtime.ParamLocationHeader, valueList[0], &XEverestReplicationToken)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Everest-Replication-Token: %s", err))
+		}
+
+		params.XEverestReplicationToken = XEverestReplicationToken
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Everest-Replication-Token is required, but not found"))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListDatabaseClusterBackups(ctx, kubernetesId, name)
+	err = w.Handler.GetReplicationSnapshot(ctx, params)
 	return err
 }
 
-// GetDatabaseClusterCredentials converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseClusterCredentials(ctx echo.Context) error {
+// GetReplicationStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReplicationStatus(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReplicationStatus(ctx)
+	return err
+}
+
+// Search converts echo context to params.
+func (w *ServerInterfaceWrapper) Search(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Optional query parameter "kind" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	err = runtime.BindQueryParameter("form", true, false, "kind", ctx.QueryParams(), &params.Kind)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseClusterCredentials(ctx, kubernetesId, name)
+	err = w.Handler.Search(ctx, params)
 	return err
 }
 
-// ListDatabaseClusterRestores converts echo context to params.
-func (w *ServerInterfaceWrapper) ListDatabaseClusterRestores(ctx echo.Context) error {
+// CreateSession converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSession(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateSession(ctx)
+	return err
+}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+// DeleteSession converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSession(ctx echo.Context) error {
+	var err error
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSession(ctx)
+	return err
+}
+
+// RefreshSession converts echo context to params.
+func (w *ServerInterfaceWrapper) RefreshSession(ctx echo.Context) error {
+	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListDatabaseClusterRestores(ctx, kubernetesId, name)
+	err = w.Handler.RefreshSession(ctx)
 	return err
 }
 
-// ListDatabaseEngines converts echo context to params.
-func (w *ServerInterfaceWrapper) ListDatabaseEngines(ctx echo.Context) error {
+// GetLogLevel converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLogLevel(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLogLevel(ctx)
+	return err
+}
+
+// SetLogLevel converts echo context to params.
+func (w *ServerInterfaceWrapper) SetLogLevel(ctx echo.Context) error {
+	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListDatabaseEngines(ctx, kubernetesId)
+	err = w.Handler.SetLogLevel(ctx)
 	return err
 }
 
-// GetDatabaseEngine converts echo context to params.
-func (w *ServerInterfaceWrapper) GetDatabaseEngine(ctx echo.Context) error {
+// GetSetupState converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSetupState(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSetupState(ctx)
+	return err
+}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+// SetSetupAdmin converts echo context to params.
+func (w *ServerInterfaceWrapper) SetSetupAdmin(ctx echo.Context) error {
+	var err error
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetSetupAdmin(ctx)
+	return err
+}
+
+// SetSetupDefaultBackupStorage converts echo context to params.
+func (w *ServerInterfaceWrapper) SetSetupDefaultBackupStorage(ctx echo.Context) error {
+	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetDatabaseEngine(ctx, kubernetesId, name)
+	err = w.Handler.SetSetupDefaultBackupStorage(ctx)
 	return err
 }
 
-// UpdateDatabaseEngine converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateDatabaseEngine(ctx echo.Context) error {
+// SetSetupSecretsBackend converts echo context to params.
+func (w *ServerInterfaceWrapper) SetSetupSecretsBackend(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetSetupSecretsBackend(ctx)
+	return err
+}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+// ListSizingPresets converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSizingPresets(ctx echo.Context) error {
+	var err error
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSizingPresetsParams
+	// ------------- Optional query parameter "engineType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "engineType", ctx.QueryParams(), &params.EngineType)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter engineType: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateDatabaseEngine(ctx, kubernetesId, name)
+	err = w.Handler.ListSizingPresets(ctx, params)
 	return err
 }
 
-// GetKubernetesClusterResources converts echo context to params.
-func (w *ServerInterfaceWrapper) GetKubernetesClusterResources(ctx echo.Context) error {
+// GetPublicStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPublicStatus(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "kubernetes-id" -------------
-	var kubernetesId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "kubernetes-id", runtime.ParamLocationPath, ctx.Param("kubernetes-id"), &kubernetesId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kubernetes-id: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPublicStatus(ctx)
+	return err
+}
+
+// GetSummary converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSummary(ctx echo.Context) error {
+	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetKubernetesClusterResources(ctx, kubernetesId)
+	err = w.Handler.GetSummary(ctx)
 	return err
 }
 
-// ListMonitoringInstances converts echo context to params.
-func (w *ServerInterfaceWrapper) ListMonitoringInstances(ctx echo.Context) error {
+// ListWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) ListWebhooks(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListMonitoringInstances(ctx)
+	err = w.Handler.ListWebhooks(ctx)
 	return err
 }
 
-// CreateMonitoringInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) CreateMonitoringInstance(ctx echo.Context) error {
+// CreateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateWebhook(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CreateMonitoringInstance(ctx)
+	err = w.Handler.CreateWebhook(ctx)
 	return err
 }
 
-// DeleteMonitoringInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteMonitoringInstance(ctx echo.Context) error {
+// DeleteWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteWebhook(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "name" -------------
 	var name string
@@ -1923,12 +7909,12 @@ func (w *ServerInterfaceWrapper) DeleteMonitoringInstance(ctx echo.Context) erro
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteMonitoringInstance(ctx, name)
+	err = w.Handler.DeleteWebhook(ctx, name)
 	return err
 }
 
-// GetMonitoringInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) GetMonitoringInstance(ctx echo.Context) error {
+// GetWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhook(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "name" -------------
 	var name string
@@ -1939,12 +7925,12 @@ func (w *ServerInterfaceWrapper) GetMonitoringInstance(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetMonitoringInstance(ctx, name)
+	err = w.Handler.GetWebhook(ctx, name)
 	return err
 }
 
-// UpdateMonitoringInstance converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateMonitoringInstance(ctx echo.Context) error {
+// UpdateWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateWebhook(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "name" -------------
 	var name string
@@ -1955,7 +7941,7 @@ func (w *ServerInterfaceWrapper) UpdateMonitoringInstance(ctx echo.Context) erro
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateMonitoringInstance(ctx, name)
+	err = w.Handler.UpdateWebhook(ctx, name)
 	return err
 }
 
@@ -1982,175 +7968,741 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 // Registers handlers, and prepends BaseURL to the paths, so that the paths
 // can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/alert-rule-templates", wrapper.ListAlertRuleTemplates)
+	router.POST(baseURL+"/alert-rule-templates", wrapper.CreateAlertRuleTemplate)
+	router.DELETE(baseURL+"/alert-rule-templates/:name", wrapper.DeleteAlertRuleTemplate)
+	router.GET(baseURL+"/alert-rule-templates/:name", wrapper.GetAlertRuleTemplate)
+	router.PATCH(baseURL+"/alert-rule-templates/:name", wrapper.UpdateAlertRuleTemplate)
+	router.GET(baseURL+"/alert-rules", wrapper.ListAlertRules)
+	router.GET(baseURL+"/api-tokens", wrapper.ListAPITokens)
+	router.POST(baseURL+"/api-tokens", wrapper.CreateAPIToken)
+	router.DELETE(baseURL+"/api-tokens/:name", wrapper.DeleteAPIToken)
+	router.GET(baseURL+"/audit-entries", wrapper.ListAuditEntries)
 	router.GET(baseURL+"/backup-storages", wrapper.ListBackupStorages)
 	router.POST(baseURL+"/backup-storages", wrapper.CreateBackupStorage)
 	router.DELETE(baseURL+"/backup-storages/:name", wrapper.DeleteBackupStorage)
 	router.GET(baseURL+"/backup-storages/:name", wrapper.GetBackupStorage)
 	router.PATCH(baseURL+"/backup-storages/:name", wrapper.UpdateBackupStorage)
+	router.GET(baseURL+"/backup-storages/:name/backups", wrapper.ListStoredBackups)
+	router.POST(baseURL+"/backup-storages/:name/backups/:key/download-url", wrapper.CreateStoredBackupDownloadURL)
+	router.POST(baseURL+"/backup-storages/:name/restore", wrapper.RestoreBackupStorage)
+	router.POST(baseURL+"/backup-storages/:name/resync", wrapper.ResyncBackupStorage)
+	router.DELETE(baseURL+"/backup-storages/:name/retention-policy", wrapper.DeleteBackupStorageRetentionPolicy)
+	router.GET(baseURL+"/backup-storages/:name/retention-policy", wrapper.GetBackupStorageRetentionPolicy)
+	router.PUT(baseURL+"/backup-storages/:name/retention-policy", wrapper.SetBackupStorageRetentionPolicy)
+	router.POST(baseURL+"/backup-storages/:name/rotate-credentials", wrapper.RotateBackupStorageCredentials)
+	router.GET(baseURL+"/backup-storages/:name/sync-status", wrapper.GetBackupStorageSyncStatus)
+	router.GET(baseURL+"/backup-verifications", wrapper.ListBackupVerifications)
+	router.GET(baseURL+"/catalog", wrapper.GetCatalog)
+	router.GET(baseURL+"/config-rollouts", wrapper.ListConfigRollouts)
+	router.GET(baseURL+"/database-cluster-restores", wrapper.ListRestoreHistory)
+	router.GET(baseURL+"/inventory", wrapper.GetInventory)
 	router.GET(baseURL+"/kubernetes", wrapper.ListKubernetesClusters)
 	router.POST(baseURL+"/kubernetes", wrapper.RegisterKubernetesCluster)
 	router.DELETE(baseURL+"/kubernetes/:kubernetes-id", wrapper.UnregisterKubernetesCluster)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id", wrapper.GetKubernetesCluster)
+	router.PATCH(baseURL+"/kubernetes/:kubernetes-id", wrapper.UpdateKubernetesCluster)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/backup-slos", wrapper.ListBackupSLOs)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/bootstrap", wrapper.GetKubernetesClusterBootstrap)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/bootstrap", wrapper.BootstrapKubernetesCluster)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id/cluster-info", wrapper.GetKubernetesClusterInfo)
 	router.POST(baseURL+"/kubernetes/:kubernetes-id/cluster-monitoring", wrapper.SetKubernetesClusterMonitoring)
 	router.POST(baseURL+"/kubernetes/:kubernetes-id/database-cluster-backups", wrapper.CreateDatabaseClusterBackup)
 	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/database-cluster-backups/:name", wrapper.DeleteDatabaseClusterBackup)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-cluster-backups/:name", wrapper.GetDatabaseClusterBackup)
+	router.PUT(baseURL+"/kubernetes/:kubernetes-id/database-cluster-backups/:name/legal-hold", wrapper.SetDatabaseClusterBackupLegalHold)
 	router.POST(baseURL+"/kubernetes/:kubernetes-id/database-cluster-restores", wrapper.CreateDatabaseClusterRestore)
 	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/database-cluster-restores/:name", wrapper.DeleteDatabaseClusterRestore)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-cluster-restores/:name", wrapper.GetDatabaseClusterRestore)
 	router.PUT(baseURL+"/kubernetes/:kubernetes-id/database-cluster-restores/:name", wrapper.UpdateDatabaseClusterRestore)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters", wrapper.ListDatabaseClusters)
 	router.POST(baseURL+"/kubernetes/:kubernetes-id/database-clusters", wrapper.CreateDatabaseCluster)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/database-clusters/bulk-delete", wrapper.BulkDeleteDatabaseClusters)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/database-clusters/cost-estimate", wrapper.EstimateDatabaseClusterCost)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/database-clusters/preview", wrapper.PreviewDatabaseCluster)
 	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name", wrapper.DeleteDatabaseCluster)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name", wrapper.GetDatabaseCluster)
 	router.PUT(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name", wrapper.UpdateDatabaseCluster)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/adopt", wrapper.AdoptDatabaseCluster)
+	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/backup-slo", wrapper.DeleteDatabaseClusterBackupSLO)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/backup-slo", wrapper.GetDatabaseClusterBackupSLO)
+	router.PUT(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/backup-slo", wrapper.SetDatabaseClusterBackupSLO)
+	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/backup-verification-policy", wrapper.DeleteDatabaseClusterBackupVerificationPolicy)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/backup-verification-policy", wrapper.GetDatabaseClusterBackupVerificationPolicy)
+	router.PUT(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/backup-verification-policy", wrapper.SetDatabaseClusterBackupVerificationPolicy)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/backups", wrapper.ListDatabaseClusterBackups)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/cost-estimate", wrapper.GetDatabaseClusterCostEstimate)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/credentials", wrapper.GetDatabaseClusterCredentials)
+	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/diagnostics", wrapper.RevertDatabaseClusterDiagnostics)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/diagnostics", wrapper.GetDatabaseClusterDiagnostics)
+	router.PUT(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/diagnostics", wrapper.SetDatabaseClusterDiagnostics)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/diff", wrapper.DiffDatabaseCluster)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/export", wrapper.ExportDatabaseCluster)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/export-bundle", wrapper.ExportDatabaseClusterBundle)
+	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/maintenance-window", wrapper.DeleteDatabaseClusterMaintenanceWindow)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/maintenance-window", wrapper.GetDatabaseClusterMaintenanceWindow)
+	router.PUT(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/maintenance-window", wrapper.SetDatabaseClusterMaintenanceWindow)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/pending-operations", wrapper.ListDatabaseClusterPendingOperations)
+	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/pending-operations/:id", wrapper.CancelDatabaseClusterPendingOperation)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/resource-usage", wrapper.GetDatabaseClusterResourceUsage)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/restores", wrapper.ListDatabaseClusterRestores)
+	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/retention-policy", wrapper.DeleteDatabaseClusterRetentionPolicy)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/retention-policy", wrapper.GetDatabaseClusterRetentionPolicy)
+	router.PUT(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/retention-policy", wrapper.SetDatabaseClusterRetentionPolicy)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/scale", wrapper.ScaleDatabaseCluster)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/temporary-access", wrapper.CreateDatabaseClusterTemporaryAccess)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/upgrade", wrapper.GetDatabaseClusterEngineUpgrade)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/upgrade", wrapper.UpgradeDatabaseClusterEngine)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-clusters/:name/upgrade-plan", wrapper.GetDatabaseClusterEngineUpgradePlan)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-engines", wrapper.ListDatabaseEngines)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-engines-availability", wrapper.ListDatabaseEnginesAvailability)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-engines-availability/:name", wrapper.GetDatabaseEngineAvailability)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-engines/:engine-type/versions", wrapper.ListDatabaseEngineVersions)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id/database-engines/:name", wrapper.GetDatabaseEngine)
 	router.PUT(baseURL+"/kubernetes/:kubernetes-id/database-engines/:name", wrapper.UpdateDatabaseEngine)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/deregistration", wrapper.GetKubernetesClusterDeregistration)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/guardrails", wrapper.ListKubernetesClusterGuardrails)
+	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/guardrails/:engine-type", wrapper.DeleteKubernetesClusterGuardrail)
+	router.PUT(baseURL+"/kubernetes/:kubernetes-id/guardrails/:engine-type", wrapper.SetKubernetesClusterGuardrail)
+	router.PUT(baseURL+"/kubernetes/:kubernetes-id/kubeconfig", wrapper.UpdateKubernetesClusterKubeconfig)
+	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/namespace-template", wrapper.DeleteKubernetesClusterNamespaceTemplate)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/namespace-template", wrapper.GetKubernetesClusterNamespaceTemplate)
+	router.PUT(baseURL+"/kubernetes/:kubernetes-id/namespace-template", wrapper.SetKubernetesClusterNamespaceTemplate)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/namespaces", wrapper.ListKubernetesClusterNamespaces)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/namespaces", wrapper.PrepareKubernetesClusterNamespace)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/operators", wrapper.ListKubernetesClusterOperators)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/operators/:operator-name/upgrade", wrapper.UpgradeKubernetesClusterOperator)
+	router.DELETE(baseURL+"/kubernetes/:kubernetes-id/orphaned-resources", wrapper.DeleteOrphanedResources)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/orphaned-resources", wrapper.ListOrphanedResources)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/preflight", wrapper.PreflightDatabaseCluster)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/recommended-versions", wrapper.GetRecommendedVersions)
 	router.GET(baseURL+"/kubernetes/:kubernetes-id/resources", wrapper.GetKubernetesClusterResources)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/resync", wrapper.ResyncKubernetesCluster)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/status", wrapper.GetKubernetesClusterStatus)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/storage-classes", wrapper.ListKubernetesClusterStorageClasses)
+	router.GET(baseURL+"/kubernetes/:kubernetes-id/unmanaged-configs", wrapper.ListUnmanagedConfigs)
+	router.POST(baseURL+"/kubernetes/:kubernetes-id/unmanaged-configs/import", wrapper.ImportUnmanagedConfigs)
+	router.POST(baseURL+"/lint", wrapper.LintDatabaseCluster)
 	router.GET(baseURL+"/monitoring-instances", wrapper.ListMonitoringInstances)
 	router.POST(baseURL+"/monitoring-instances", wrapper.CreateMonitoringInstance)
 	router.DELETE(baseURL+"/monitoring-instances/:name", wrapper.DeleteMonitoringInstance)
 	router.GET(baseURL+"/monitoring-instances/:name", wrapper.GetMonitoringInstance)
 	router.PATCH(baseURL+"/monitoring-instances/:name", wrapper.UpdateMonitoringInstance)
+	router.POST(baseURL+"/monitoring-instances/:name/restore", wrapper.RestoreMonitoringInstance)
+	router.POST(baseURL+"/monitoring-instances/:name/resync", wrapper.ResyncMonitoringInstance)
+	router.GET(baseURL+"/monitoring-instances/:name/status", wrapper.GetMonitoringInstanceStatus)
+	router.GET(baseURL+"/monitoring-instances/:name/sync-status", wrapper.GetMonitoringInstanceSyncStatus)
+	router.GET(baseURL+"/notification-channels", wrapper.ListNotificationChannels)
+	router.POST(baseURL+"/notification-channels", wrapper.CreateNotificationChannel)
+	router.DELETE(baseURL+"/notification-channels/:name", wrapper.DeleteNotificationChannel)
+	router.GET(baseURL+"/notification-channels/:name", wrapper.GetNotificationChannel)
+	router.PATCH(baseURL+"/notification-channels/:name", wrapper.UpdateNotificationChannel)
+	router.POST(baseURL+"/notification-channels/:name/test", wrapper.TestNotificationChannel)
+	router.GET(baseURL+"/notification-rules", wrapper.ListNotificationRules)
+	router.POST(baseURL+"/notification-rules", wrapper.CreateNotificationRule)
+	router.DELETE(baseURL+"/notification-rules/:name", wrapper.DeleteNotificationRule)
+	router.GET(baseURL+"/notification-rules/:name", wrapper.GetNotificationRule)
+	router.GET(baseURL+"/pricing", wrapper.GetPricing)
+	router.PUT(baseURL+"/pricing", wrapper.SetPricing)
+	router.GET(baseURL+"/projects", wrapper.ListProjects)
+	router.POST(baseURL+"/projects", wrapper.CreateProject)
+	router.DELETE(baseURL+"/projects/:name", wrapper.DeleteProject)
+	router.GET(baseURL+"/projects/:name", wrapper.GetProject)
+	router.GET(baseURL+"/projects/:name/members", wrapper.ListProjectMembers)
+	router.DELETE(baseURL+"/projects/:name/members/:subject-kind/:subject", wrapper.DeleteProjectMember)
+	router.PUT(baseURL+"/projects/:name/members/:subject-kind/:subject", wrapper.SetProjectMember)
+	router.GET(baseURL+"/quotas", wrapper.ListQuotas)
+	router.DELETE(baseURL+"/quotas/:subject-kind/:subject", wrapper.DeleteQuota)
+	router.PUT(baseURL+"/quotas/:subject-kind/:subject", wrapper.SetQuota)
+	router.GET(baseURL+"/quotas/:subject-kind/:subject/usage", wrapper.GetQuotaUsage)
+	router.POST(baseURL+"/replication/promote", wrapper.PromoteReplicationStandby)
+	router.GET(baseURL+"/replication/snapshot", wrapper.GetReplicationSnapshot)
+	router.GET(baseURL+"/replication/status", wrapper.GetReplicationStatus)
+	router.GET(baseURL+"/search", wrapper.Search)
+	router.POST(baseURL+"/session/login", wrapper.CreateSession)
+	router.POST(baseURL+"/session/logout", wrapper.DeleteSession)
+	router.POST(baseURL+"/session/refresh", wrapper.RefreshSession)
+	router.GET(baseURL+"/settings/log-level", wrapper.GetLogLevel)
+	router.PUT(baseURL+"/settings/log-level", wrapper.SetLogLevel)
+	router.GET(baseURL+"/setup", wrapper.GetSetupState)
+	router.POST(baseURL+"/setup/admin", wrapper.SetSetupAdmin)
+	router.PUT(baseURL+"/setup/default-backup-storage", wrapper.SetSetupDefaultBackupStorage)
+	router.POST(baseURL+"/setup/secrets-backend", wrapper.SetSetupSecretsBackend)
+	router.GET(baseURL+"/sizing-presets", wrapper.ListSizingPresets)
+	router.GET(baseURL+"/status", wrapper.GetPublicStatus)
+	router.GET(baseURL+"/summary", wrapper.GetSummary)
+	router.GET(baseURL+"/webhooks", wrapper.ListWebhooks)
+	router.POST(baseURL+"/webhooks", wrapper.CreateWebhook)
+	router.DELETE(baseURL+"/webhooks/:name", wrapper.DeleteWebhook)
+	router.GET(baseURL+"/webhooks/:name", wrapper.GetWebhook)
+	router.PATCH(baseURL+"/webhooks/:name", wrapper.UpdateWebhook)
+
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+x9bXPbNrbwX8GwO9NkV5KTtrvTx192Esfb+mndeOxk79yJc28hEpKwJgEWAGWraf77",
-	"HRwAJEiCMvVix675zRZAvJz3c3Bw8CmKeZZzRpiS0eGnSMYLkmH48zWOr4r8QnGB50T/gJOEKsoZTs8E",
-	"z4lQlMjocIZTSUZRQmQsaK7bo0P7LZLmY0TZjIsMQ+Moyr2vP0XTIr4i6hecwRxqlZPoMJJKUDaPPjfG",
-	"DbSzrg8FmXd9Y374FBFWZNHhh0h+G40i/HshSDSK5rGMPo7aHxUiDQwGE/1WUEESPRKsZuTvqVyIHbIa",
-	"mk//Q2Klh65BWv5MpdIzUUUygNBfBJlFh9FXBxWqDiyeDupIKvcWYSHwSv9/JAhWpNbtDAtsRt4eo7ke",
-	"gygiZAuhOI6JlD+RVRD2dXTX53i3IChOeZGU05jeBzFnClNGBLIA3ppM6hO+QoUkAiVkRhnRs+ruMAfi",
-	"M6QWxKNg+PfNLxem2dAzWiiVy8ODg6tiSgQjisgJ5QcJj6Vec0xyJQ/4koglJdcH11xcUTYfX1O1GBvs",
-	"ywM9mjz4KmFynOIpScfwQzSKyA3O8hRweS3HCVmGtr2GyCWJBVFdaLhfFqhIwl9XH9Yw5PtTCd6jtJCK",
-	"iIqE6wit8IDsGE3q1D1izmZ0vpZOKuhnlFH9UQj8gKscx5a0ZrhIVXQY5UTEnOExWRJBpGp/GQaZt7QQ",
-	"KN5ghadYEguC9uYbHRCVQLMXICo0xcK/ie0Vm14SvTo7mbSZOKf/JkJa4mpwzdmJbbOcY+ZZmt80H5kZ",
-	"gYWoRILkgkjCFCgA/TNmFj0TdEGE/hDJBS/SBMWcLYlQSJCYzxn9vRxNIsVhmhQrIhWiTBHBcIqWOC3I",
-	"CGGWoAyvkCB6XFQwbwToIifolAujiw5Lxp1TNbn6Hrg25llWMKpWIG4EnRaKC3mQkCVJDySdj7GIF1SR",
-	"WBWCHOCcjmGxTG9KTrLkK0EkL0QM3NsilSvKkjYof6Is0XjCTvbAUiuI6Z/0ps+PL94hN76BqgFg1VVW",
-	"sNRwoGxGhOk5EzyDUQhLck6Zgn/ilBKmkCymGVUaSb8VRCoN5gk6woxxhaYEFXmCFUkm6IShI5yR9AhL",
-	"cueQ1NCTYw2yICwzorAmY4+DKzaROYlv5Y2LnMQ14k2I1NyIpMIKhH/jgwCHpCm/fs8knpEjYNpCYBXm",
-	"l46eaEZJmmgVlGjiJkwWQiMXGwSBaooxQzHIQBT730pUsBlVwNW54EkRw4iFJJMKYlPOU4IZqF1Q3+21",
-	"WbVuRcXUKvmcxHRG47DBRhiepiRAzMemwdDzLMVzsyv9ox1ZBtemGTwpUhKQ5xeuyQyaUqk0ctw6yw9H",
-	"lbUU2p8bprlP93MNtG1UT33rKWy6vG52cVP5xkStEzo6N7j2ydCZGykvgd+i/q3gD4Pb7QaREDaQunbS",
-	"Hsq3SZRh5SOe0xBSz+sdyvGLbEqEh97YNCuOBNHmXzSKjNkVHUaUqW+/qWbX2mBOhE9N3cTkJowFZ2t2",
-	"0lDSbSKoUDFyKrwcLaTA66Z5Y3g3VOhDLesuQPSHBZtpKwkJg45HVlloCTHlXEklcK71CUaMXCOr/rto",
-	"vWO2115rk5msbtLY0mRMQO/cEy+BDIWdGjkzCRFmjtWiPdsZVgs3ge7h7Ay7rRlNyUFCBYkVF6vJVmQC",
-	"EwcRO7XqxewmDI43r1udQgB589rh1C29jYr20ltLImxOGQkJF/27m9gZkch0v0VjVPZ2fUyjDd2Ydqia",
-	"LA7LlzylMQ4KFtPSlih27PLTXpKksucCM9kmhIURrqVxllKwpzQxEhwvGlNP0MkMadtKEjVqfaQH0400",
-	"y7kEudIAZF6A6cFWb2fR4YdP7UW3XJqPTUf+6Oy9g4/+s1yCJeIMIkFAs9rAjg6j/3l2efm3P8bP//ns",
-	"2YcX4//38W/PLi8n8Ndfn//z+R/lf397/vzZsw8/nf7w7uz4I33+xwdWZFfmvz+efSDHH/uP8/z5P/8S",
-	"jaKbceXPjSlTYy7Gdl+HShQETMGMi9XOQDmFYRxczKCPGzQh3pZVLK+hGV2Uo8aJzhhpcmSDJlMsAxxy",
-	"pH92A5YjwY+Ka3ldOqS59hulNgnQkqdFBt1oFmJ9SX8nO+P6gv5e7lQP6ARo9zoeC8J9PQSg6rZCWqG3",
-	"Vd5EP3QMRYEkERcQxJFhhfW+3iFoP0IzsnE95+WCx2Oagn7fsisi4cIR9Q247repbMcWa8JQGWdUcQPt",
-	"5uSnZVspP6pf1vNO1dGowjA8TwO9mkDFqDkWOjqfhNVnD63mTMm6grKep2PcasZJSCrQLCwWaCbBkas2",
-	"IPUOynWNyngsZWBYTFyT+Xhk3CatK8Hsm65MmKMMEk/QJUPv9E/aE2IIp/kCW2cbs8SJc2l8I0d8b1YM",
-	"ZzR2MNBOe2zddIKV9sznWJFqbDOeniTLCqWN9wk6UeCwc5au0FRTuXHQy5WBXdrhqZ77m0SCzIggTOOC",
-	"M03RSqsnhs54cqFBUust2/Bf485lhVQowype1CioNk3Ok0kA9I59z3iCrhdE2FBUCQqND4BChq/Ao8Wq",
-	"IiG8xDQFZ5QySROCsIeyfjHSW72qhpzUZDbOcD6+Iivpj9LuZYfJcK4HNfZY9xHJxirokZhTdXL52Vil",
-	"5sepDVFk+IZmRYZwxgsG0ZiYZ3mhKhNYIoiNaVIIxAnXHZXUpOVBhhmek3E57Ljio4MoQAkuhPnU0Xbu",
-	"QrkNxFF2K+Icx4GbUo5DJeIZVcr62B7fjhCFABYuUggdI0sydGaYn0pEbrTjQ1W6cl4iSUaIqwUR11RC",
-	"wAAz7fGkYGAD6sdOA0A4fFKtJDaBaXITE5LYye6Vyj73+EWTjZaEoVgDaKxagE4qntuAvIvItKNzueA3",
-	"q8B4+ucyeAH/1DzxurepVWGu1YSgWAX7o2uaplpz4TxPqUW3HntOl4RZu2qCXmnKyUy4GcXY2vKSKHte",
-	"4asExYFaBE+NaXZjj23MkaALtpTxhLgr3N4vhmD2dGsIgdxo/zoQ5IDf64OZvrcYctTGxM4xm4csq5Mz",
-	"v91N4MLZJ2cueiZM+7OjkzfnGnEw23PgES1SHdRmgmd13CrQxlQixn1bzTc3Os6Aq1SByjNwB5nukC0a",
-	"rXMXDID01yMwf6akOp3jokQ5xEvNabM3btn6sVd4apvgj8Hjl4j91GYeQj9D6OeLhX5u9/oNrVqn3zFq",
-	"xtmc640vsFFBVhXJ3zTv5vMpL1hMRC/mbR14QKD5YzBOhVUhbz/EhW618zM+lUQsNzrHXXCpwt7Sj7bF",
-	"Qcj1LF2fUl05sSc01wPzBs6spQzG3k5NgzGVlMB+shzCU16osHXgnWtwoQK2AReqxK3+u8eqewlGnKxC",
-	"QhEnq7bohd7am+wpdl2Arztip7jCqS/c+4/dQVWWjMpQJfxnHd1OqPezAxvE97rjED7YrV/6jj3vGpJ4",
-	"hiSeJ5fEY4+AN03lMZ9NHtLJdHkOfMsJsD8lF3RONe80fSdYzO0Btfqco8D2d1DNDgabK+gu7MQ8y1Oi",
-	"Ql71kWsqdQQ1Strk7P6HT9E1lqgcYeLrC80ZY/1BCC8m8yo0pWnwJ5QKZ7mjgSKXShCcWax/LU0Sl80u",
-	"6jd5QqSirCOn7E3V6BYxK9I0kMEQJDiAflgVlgTmEFNmfmPVQVbbakKX6d6DlHRXG863MhPiSzZWU3en",
-	"jVNKJQjeFnd4fDhoyzvVlmXkoddNhrCtFAhTDEr4XpRwDy4+EiTRc+F0m0z8HEt5zUVST7cXnKuuU+d2",
-	"cn64d4+l9xI9exM6g7R54NJmkDMPWc6cmyzGW/nV9uvnOdvUyMF1Hlznp+c6W07Z2He237X5ZecUdcOO",
-	"6y9gDEnpTzQpfaP4iE/PfkjEm7pHdKSi5+b0O4RFHNttERfp5LxaYKRfZME7i+gbGfBW7olnWS23wb/7",
-	"CBLYOXuZ6l7f/YQJnHkwmAYP23J3tuFgwD9EA/644zZRvf0Wg92cFA+G+mCoPyFD3XAGGOgG7Povk33Z",
-	"uHzXcTWdJJb266J1gyyw9vU/yBeRCrOkugUgizznQpGkuS45Qed0vlCI8WtE1dfS5MXnNzHwQC6zZDpB",
-	"P/JrsrSJpDYfIZcjlM+hE2YrkypqLfnbDbfOKxy3mWgW4JuYZsdd8HeZ7j4GgjdWtAElihp3eHnyS9eJ",
-	"z1o3LivN2OUurUuDbh+gwViVoeQnoVhbqXMFkxIg6LjR5FDa+HZU/WDSjjQtcZ5KRDNTXUgtApauoIrG",
-	"2K9B46XIwpc/YrkIUjm0nllfK2j0FjJc26brwkHoyuwA7nsAd5kL3ZnjP2Dh7rHQ/kFvZUDLw0JLqIve",
-	"BlZceGbzmkWEzIDuKIBFB2UIo6vvpZ/Ov1NEwMy7PhJQ9dktAuCsl8HVeJiOv/UpB4f/ITn8x0LwQEk6",
-	"+FkDNedMkvb9585AZGiOVhXAbbIOaLLvun/tVIXgHA0/hXpVg6rhzMcf+2z+hM34WgC4SKYmocD9bGh8",
-	"Z529gAKAswKo4vALVKT0gfMhmuffRKNonn+rF9vXuWyAwF9DaMZeYNioWmqbggJSpNXpdM3l/5/a8O59",
-	"+9+UfApbENUgJ0w73HHHsdQv3mGLNzG1H/m1Nrxm3bu98halj6Kb8ZzD1eixvKL5mOfGgBuDiNMMGCo9",
-	"YffVC33n3fesAqTsa5MOlxsyi5o3p05pmlKfQs39AX+D0WFUUKb+8R2cN1F5dWGvIvT7wtwber1SpPc0",
-	"LRHng9vIo+qu2atyf59HUYxzHFO1+pPu9chtryUwXMPIw3eIzE5b3GPDYvaa2DoZ0f72NZbkv6haAAcG",
-	"LpAF2K5e8LoVnzKldK38/xhcsJ50fa2R8Fx1emiW+c2zrH1Xqy+blwWAM8p+JmyuHZiXO8iMHmirgX5H",
-	"FMJtwD5VMh5yVei7Af0WNN0DeSZJ3qs2vhf+G236+dnpac8d2kKruzOvnrIlmzXvtX7EObUluveB2VEt",
-	"qXZrLpfGtN4TdQVE/dnpaRtoFzmJo55y4T24TPshrTslKePb1UgquKHNqv4H9FvAkH3PBJlTbTj1rp/+",
-	"Nq9KPAmS8aUpGHoVshXrhDzjwdyscz2IuXUasOfIkjBTK4QIAlfgW5nTSBSM2RJTDTO5P0XTOePCqyL/",
-	"ntXsxUatBuhslxVaNVRZUN45DRxnCQ41SbQYN6DD6Q5rDrGBIfon/5TD1m8edD5f0II05RBmwTnNcLzQ",
-	"q11N8qu5/kFOMqLwZPlyojn2lJgISbNukmnxCvC4cIqJRsoVUwuiaOyV3oGyXAu8JCNEWZwWiWY9UydN",
-	"09cSC8oLWd5PNobCBL2qQlYZXsEA5pyVMzBXPr2Fnno5I+QW9jlYX0VRVgRQ6VpgfFvVzDKHLdinoDR3",
-	"RhXirHEBHMQZEkQVgpHEhCQpS2iMlSsQZuoBiiURaIElyrgVAxWDTZAmJxO2oxLxHP9WkDK6OSVlCXUq",
-	"JTSYI2MbbnNBUi8yp1FgznQheAfxYFNmWlBixRUjN1CqA2KzJauXcD8yUDHyMebMlY6EsfSybHAv51JS",
-	"KHo283daiwzAvuMFZnOSIPDUTR14hjCakWuUUVZocAFytYYniQGJQ70LPZuqOw7a5gpkIcuiPCUmDShd",
-	"sR8Kl3hinDpIWUgbXM6okKoM4Y1QwVIiJVrxwqxHkJjQEpSKXxFmoqGYIQLhPxvl66hGmJkCkCeKZEe8",
-	"YIEAf7tPu9CALKZSo1u3AcnZ1QM6rhc0XlQVVoC7THnBCv1ug1ClpfzSkZCTWgkCc1wjycBakhSScKEq",
-	"IWGt8gd25W5REhXsivFrBtRrwKuHcahIyUyhggFLsaSsupUUYFVIIihO6e9VbadyobS634qeEQr0PyUx",
-	"LiRBVDmVFS8Kpp0NvX7XqmyhRHOOIG2n59V+rGZm3NBlc09mI2XJp6124oLqPE0goI4ZWr6cvPw7Srir",
-	"mOPNYWhfa0um0ag3Yf2yMKX8lUhFtfHF5n+tVX3VjJtq/MEijiBYX5666HkFAUHaNbbiTh7CmaH+h9zg",
-	"WE0aBSn+8d3aGkOdh0oXykbrsLJMOqPuoQCA2NfSO/OxMsCdMNVOv8zJpyuXGdudKo4Srfgzyux9aSve",
-	"DGdbiTRB/wZ5AApqSpCyd59xKYm9IcEUAgmFCpbxBMq9Qe63Ey5m5RN0xvMihYGggCdBciUVySbonOBk",
-	"rFXYnR+BaGe8EIKweDW2RcrGmCXjUpzHq+DlaZLOfqbsqo0w12KOm96f/9w8ZSrx0mv/l+ySvTk+Oz8+",
-	"evXu+I0fsQUug8pxWovjOW5VXmPo5eSbF5qCiTan6+KGSpSnmDGjNaEEjDbT3Wcv3WeTfun5vcwlk1l1",
-	"pGVOVw0WaNQ7WtKEWEugXQ0HythROx6aYZoWomY0xVhqEGl6zopU0TwlRhOZKluExZp7iTCVABpujIZP",
-	"2Jw1oCslTXlOiJXR36a2H+AAZhtpDtFGLmCYKon+/8XbX5qi7xTOEUEjoYQbYZlzqWb0piwAB+4YIxK4",
-	"ThlKJ9r2056e2dTvRPAxZQm50QyL/qXXag4pcZ4T7NsU3ARzAI56AKgEqRcvUVLAicHMfL3A4P41YDhB",
-	"b63LAvR5bM6E5OElQ+gSgiKXERp7xFb+aAWpYbmqMKz5EJTJhxcfJz1GMCaJWXxZstYOcRltVH3pFVoU",
-	"GWZjQXACBp7XXBYBwp6KASBMkF8D2BqhltFBMo5N5UMMBZCC+Q9QSUkGUwmQ5aKNF3ViRX9pKZMsV6ta",
-	"bcAaO5X29d7Z/A1RmKbyf5ffdPG67WEP5q2ZXfqwqOJKw2Gnr/7b6VonLo0hrbgTGP7nAanhWXiam88B",
-	"+hVTY3The1ZlFsc11Jouma60byRRlckAqtEEGRzzmKd9jPlSFVt2MWUNW8iXwdowdaMb98jaH1jKIrPy",
-	"BbNV1cvRGyBXy70lTmky0jZIwZIqcB3w8YDLw9LtyEgAw1RWIDlnzKIKS8ljCioLohyQsg9Ac8A0sniC",
-	"ftGCLE1rrUYaOVyZMUliJU+tLva6yNfGqiYQF5sLHqrCpaEATR6om9I+BALrkft7nfRPrNez6pY9TIre",
-	"MiR5RpDJ8KIO5gmdzYioUlSsU0OSaoqfKEu+dMYJ6wwkwdnKzvBBz64rj8aIHcrmqR3e+IguRdDGbZLn",
-	"HZJbidWrmYJnDrjeTjuIOPOrHZdFiShD0nyCpmTGbT2+El+O98EiU4JqXX6hMWrNF5N0ZKInfoIRyB+F",
-	"r4gpdw8egSIIm0fhxjZXn8tyIFXXXuWYC36NUs6gMPE1pqpcJb5yeQPN4Sf9qu/ZpJjGSxEnb5rYnHSi",
-	"qcR3F6qa9Bs+gSskEeN5QRNyUPpUQn5V0BBV7qgG1+g/szUTqrEKG54KwGlaKg/2tXI9TETLRZ+G1MS7",
-	"Tk2MeRJyU4r53EjOH9+9O3O40X0ti1EXoB2hF4iW5X578ohVtHvUgZ4dNuRH7jk/cgePwi8yCgFt0nlz",
-	"up6JuTNZlIcWOzkg14tVY+XwEIrxzi6jfxk78DKyG93BM0GvnKUep1iY+Bdmhv0sFIH9poUWmMSEOfmS",
-	"CKGtTKo6q9+tqQRrkVRhBb2Fs5RDdBldFHAkpn1R4e/0zslRWxMQnLKL75NQr5WVzRJVVEFW65l5mhod",
-	"m6eprbSOvLeVopeTF5MX9qIAwzmNDqNvJy8m39iaEQC3A3PFbGwP9+C3OVHho7DSZbWBw2nt/FFvpQT1",
-	"SWK/qT+JD2kYxnuDqb558cKdWRFzYgAPFphHDA7+Y6na7m2TF/TNMTxArin5Ae+zIq3oQsPouz2uxORQ",
-	"ByZ/z2TH9H+/j+lPnO62LjexHUeRLLIMi1VvPCs8l616JJBDk/PQ1Q6TQWRfLK0PV+V314nHfFJDalQ+",
-	"DfOam9rVe4FXYCZ7HB+A4TuvJk1tAzYA6yqN+vlGNnnhfih/IPrNib4XeXbR/OdRS4oefNKu6GfDBykJ",
-	"1WF5A78bI8L5l42pWyxhvmmyhJf2cfhhXVp5a3Sqe0D5IOe0u4sMddodeThoKquPLbr+LmRuD/S3jv76",
-	"EUO30A1q7B+I2oy8fiDqodPWIDMfDM32IK81VgJWcbBamlAUpy7Z0jlZHTNMkEmks/Uo6l1N9H7SIvJA",
-	"7t3DoPP92zXdaYb97BoAitROUwd0yzMU59gPVs9j4uDNuO0WC6gK0PZyIV1CM0kCiblhT7KV+3yn3mT4",
-	"fuJAZTs5lLdi3VHY1fdyjTd5bocJ5nQzFxJpEdF5VxL9nfqVXSn7HTI4sKUt/cuXd8cLAx9szge9ibbO",
-	"A3XZevDJfzIuWethejc2KpkemBwi+l08s+bqyW1m00mZYxW8dRIwnGp7exAW1K0XbwLE4F+9qe47wz2S",
-	"6PPgLe+Dk7Yi7KZu6ek0B4m35Tg/fO64Lztp0A378KWDRLGJZjiwn43dwdFacncsA+lskLtmfcA4xVIS",
-	"aRLrtmSFE1u35EmyA2x+YImtWWIHytyKXbJajZiw/3EKL82jzUrG1PnkIsAnXnmaP79ptW73Ha5Rq7T7",
-	"LgdvAzduwo1bUfxG/OeQO3aMaN/a6ObC8tCu42VAd8lgI1PODBp+wu7Pz5QdT/f1ZEcH9i99HN57F11c",
-	"v8/YSe/FuJdIrSww6/jm/tfxKo5JrlE2iL92fsBuosYJxCSIi61F5LbZBnsQl2bcBy8uR+uO9DpwComr",
-	"WoTNeMESeyPn1KZwfnA32T6WDyQFn3632daP4Dx8w2T4waPZT5LHnciRjtjWOWS5y/1LgR+IGkTA4xcB",
-	"O9tNA6e7APXeGG3fJoN7DW0bt8o997c3v8o9+fXkHKvyrbOenlUJ+QfmWq3Zxxfwrdas5n6dqzULGbyr",
-	"TbyrzSROh6x02NheWO7qYO0iOIMe1gMUnJvZV+751p0MrPOaVBycrEGW7JUPbxUnW7lZu8iCtp81CILH",
-	"KQh2t6MGhu/ja+2d4/MiyPF5iuO70P4mhX9g+vtl+sfh/9lLF4P/t7n/NyvSQYb6MnR/8mvfTthmFQna",
-	"Vee3kbp65AZtyaeSwNbY93DrZX9lFLYlzg6W6lNuoZ0yta/Y7dML2t5LWtp9LfwLqOd+ejld3XFwdojK",
-	"7hqV3VVqbWoBbBt+3YvwC8ZfH63rtZvLNURaB/mwPtK6d1nR+5rWXpi9HWAdOP2RhVIHVt7H9bM74OMN",
-	"Iqd74eVg6HRg58cTJN3O33oAUdFBBO0rBPlQXI8D7zLS1rFIm/i3t5Dka7umQaQ9xrzaIbB6d4HVDTlt",
-	"zzm2pdCIBYF3CXAqb72Av0bmecPsyak58hY2SI/HJT0q3A3S4048nc3Zbf/mhp+lv7294UbZl8Fx7lY1",
-	"yIxHmV42mBx3aHJsyGx7S5MgbE5ZD0lRvXxdLt1+urN4OLZLeGIZEmbbA1PtzlQ702aTmwxqNuci76Rx",
-	"U2vdjLCrgW4X/ugULHHrfiya0QJ6YNx9mtAb8UAnz3YcFZh4/h2wX/2gYODAuw/wdzPfw47vD0JjW6Gx",
-	"R+bdVtdXr0TeWgwT5zimagXlBivbpBxgp2KY595jlU+zImYFgYGRti+LuT2NtsvyVTX8xpRJhVm8YejJ",
-	"KwJYDRByGasijydevzujvcB0g7+2vyBIB9odgWUBZHfnrL8KDVc9TAuiTKJftej61doCkqjJJXuNJUmc",
-	"8nDt5iHqnMSKLgm6IivzonitRCVihCSyNtZFES8QliNEZ2aoQ5Rn2a/w8DdDv+q/YTD/S/sSvn2zHNfn",
-	"mFyyjnz6Nm3e0TMU7YnMAta/Q3HajYwvl9gegNnAyru9dtjNdLdycpfq2DZfO0ByHenYQd7p/YZXFpzn",
-	"qT+G+J1Z691OH5IqjCtzIPPw05vDFHqbvusZSsx6kP8PRO1G+6f3SPuD3B8Yq0/8MNuKqzqekAxGGvpo",
-	"FvPhg9Ys92EbGjCstw2z22zDL/Ie5CAk/jxCYgMuvsVG1cPCPIZ3C5FGh9HB8mWkWcp+22Tp4yURK7XQ",
-	"EwmSgqurOCzGewDBKwzg4m/fy6gdre8ezIWVA0M1U4m2GrY6l2+M6uLYO6wVeclA4TWX1Th2maXKcQ5P",
-	"4mrUbjDH6+arpnbk+qOmnz9+/r8AAAD//5++0lhSAwEA",
+
+	"H4sIAAAAAAAC/+y9e3PcNrYg/lVQulu1k93ulvOY2bmu2tpSZE+ijRVrJTu5u4l/c9EkuhtXbIADgJJ7",
+	"cvPdfwUcAARJgE22HpZi/mW5SeJxcM7BeZ/fjjK+LTkjTMmjl78dyWxDttj8eXJx9o5fE6b/zonMBC0V",
+	"5ezopX6ClH6Ebqna8EohqiS6wUVFjmZHpeAlEYoSM0omCFYkP1H6PysutlgdvTzKsSJzRbf6fbUrydHL",
+	"I6kEZeuj32dHDG+JfrvzQGa8jD9RBG8jD36fHQnyj4oKkh+9/AUGdsPMgqV98Kvgy/8gmdJDuu2/odKs",
+	"nSqyNTv6L4Ksjl4e/ctxDbljC7Zj99HR735ELATemQFzXiqSn3K2oms9UBNQ15TlXVC/pmpDBFri7Loq",
+	"rxQXeE0QF2jLGVVc7/KMSYVZNg6SgmDJIyf782aH1IagzCwSZbwqcsS4QkuC6LbkQpE8NpFUWFWyO95b",
+	"RhBfoS1meE1yRFeIKnSLJcKFIDjf+SfLHXp9QwSRauYn0vus2DXjt6w7Z+toDfRm/oRhOdFjLYhQl1VB",
+	"rnYs6y743YYgrF9BoiqIRGUlNyRHihuw1FBH1IJdbw+jHCu8xJKgrKikIqJDBvnyFJ78mDqS62pJBCOK",
+	"yLM8+kKBpXotBBfxVRP9SK9GL1S/a9YeO6wu7iQXZYAQn49V2yXxE1o4BaCrZ6ZMkTURBk92LBvDDNqn",
+	"HMJo1gJqcmNuG3vRYRyph19G6d298I5sywIr0qX5A5gjYXhZkBBDlpwXBBuWs+LinLJKERk8D8C/JUrQ",
+	"LHrSaaZLboigahd9qDaCyA0v8uYGeLUsgtUDquj3qzLH6g4IYOnb7iOcv7H5YNU1xEKGH66kFy3c2R2G",
+	"Gu7rQehxVZKsiyIjzrtJo9/zW1Rwtjbk6eGENlhqbrYkiHzMCMk17yUrLoh5D+h3RYUB4pYyuq22Ry+/",
+	"jNJygBiE6dd+ObrFgulz07Cmima4OPrQOdMW2rRkC1QSkRGm9EW34gKuo7JCmOUop/L6vdRPAAOk+VWS",
+	"jLNc+rcFKQuaYT3gG7xGHln24ufvMUyocqpeMyV23bPBGSy6swfzO7rd0GxjbruSCD05yWeILNYLe53P",
+	"c1IQ/eac3xAhaB4leJypGMt/L4lAtxtejw0HCFPTFUrcm7NDmM7eu6mWJyKPJK9ERrpbuLRPwoU3oIX4",
+	"/osfvjsK5tkr2PkTHUfU/rMYNX9rTvQVv2UFxxG0vhBkLumakRy9v3xjSDC3LyOMpOJCE6IZpCM7kI8l",
+	"FUSOOTDYrRy8ueby33pYNbfZAn29rnrCGMCjg++BUBNADMFoIGz1Q+uaxK8qSf9J4pKMfuLkGDsPZWi5",
+	"g5vEA5wy9ZdvolJNJYr9yodel10FfLEfVO8v31xggeH4cJ5TvWhcXAT7XeFCkllrUzBKDT9uHsgUYp2x",
+	"xiWywlWhjl5++ef2sH/jAm3CW8VgMhZEq340X6B37jd7jlo7RIpocR6LHcoEyQlTFBcSwdRI8TUxCg68",
+	"uiHhS/oGwh/tDfTixV9f9N9IvyfhefXmbffk4RG6evM2LsKbq4UqiTS5FNSqWGOl+rwiJyqOdpp2td4D",
+	"14TeOyMfFZJVlhEpV1VhMRxRAy6Sge41jAFoqIgbXHzPK5EQBrWOcOUnA3CM4TEpne/Uw8sR1dWbt4Ac",
+	"GthUIqyQoPIacf3OlkvlXnSrNlJKiaUkuTcx4C5kjHAHgoc7JHU0O8Lqksrro9nRUhCcbUgekUFaxNnW",
+	"JJrg83t15/mhD9VG3Sr+q/SlcvXm7V24gIZ5qb8nioguD+ggSlsc68VHfZQFwVLBWZZES2BUBsrhxkKQ",
+	"fMTbsiBHL7/6Zi8ZhyfTXF8P4ME2chiMJHyMKAPUB4miCahllV0TlST0hlTVNqoQZtB72ZxNyzr2s8F0",
+	"bd//dhfnKdaGgionHdr3I9Mv0JnSR8m4QpIoLTJaY4sVUp043fzML9mpDdagI0jGRR63DtU8/SohClo7",
+	"kSYzms0QxVvEBZJK9g8nX5trpBfoWSUEYUoPFrmBBgPeyOrjz3fLb2rzkRJYbhzcOSt2BvApOFNWfzRi",
+	"mcHKImi64iIjF1htrtSuIHGtcoPlKT4lIr5Vc11jlFVS8S06PUHLiuUF0VtSQvPQAAWCQZP2hVJwJxB2",
+	"ngmyTm1E8IKcCBYnA/0QYSkrrUQ4+LYQIHql7Vh25a+1Pr4NVtwr/75h+p6F1wqx/FpfSP+sDKatMxlV",
+	"hxtWkeG4ZYx89tvBCBKXVWdHWpdf7d69uYrjxA0RMqrrnrFMkC1hxl7LELkhYmcXBXc+/C2R3OCiQEu9",
+	"EwnvhtTpxh8gb8dNQgF79nhjv917aZwGWHGn+6OJXm1jQUak/CGlnZBMEBV/2tF43UDhZ2M2eckVjlsu",
+	"LomsCqtmLZN7Q8IN0N6klZ0PJh9BFGwzxXiMrVmQG8qrJjvHgiD79QKdrfS1NtNv78InWtwO3Bua3IkA",
+	"0UUZw9EWU22/QrXBw6kDMIP5Il9EOFzrkNxGZjVI9p6QPERyhE/T0uNPmq6tNawL1vBp49QFsVo2ZYoj",
+	"HGhxe10dMIITlJrz6V+dsG84Dg0V+fswVXVUsvQC2jsJ+eySaC1XIsVjk6woo3IzbmF7bWhbIiVeR9Zs",
+	"bjRjYAvgZs9shWmRdsmlJS1RMY3oMxDvjRmYi3o0L60f+eexOcKlvBoO+DQyhUdAZRML7+odAoDssw52",
+	"qeYAqgw/H0aaF7yg2e6w26eBEKUZaKBXco/yZxa4sw5FRWTMOAEX/j6l78u//HWfO0FLM5cV6xWC7Coa",
+	"G9aSkFRY9AhBguD8LSt2Ry+VqMg+NBqgcXKupBK4jFkx+VoQKWuLhlS4KDyDdQqaZavde6ZzRofwmiQr",
+	"uQQ2Yhenyb0S0RH0CrDi4qda7otxGDGSO3um5OTjkrDcOYwIVpSt51qgkyXOwA5jwKd/zkQum7+4NR7N",
+	"jm4xNd+uuAh/NlYhYjEDeNteU5BjE20IhPvtRYraWNM8yAhIO+QmaX06xKKK+w4p7tBpgV6BmVY61dLK",
+	"z+ZvScQNEYhKK+dUwprRohy0s5FTrHDBIxEqjSCUd7uSNP0LncNucz3C1pRFPuwVFGExr/2n8YGrPuvY",
+	"mDW2rF9FwW9JDqFN0kmPsDZkgbOboYJeE9SQxxZ63Jm+Uu03cIiGQTtbnP2uoFI1vpULyYX6+3J3FDkc",
+	"y1H7dtvZxWv4BpV4p90B7X1oekNYSrLVjma0EnxrHrupHD42t02JjK2vG4JxAKKA+paIOzn5+QrZF9DV",
+	"18acfINpob3kiGoyHTpPi+5D7JzFcD29uXrFDheDg/qQJrEAqzvEZimd5G9jSnfuTyWmqejfYTs186AS",
+	"+SERHwOn2qjRzzjN01lj4dG9G570yrq+rxJOBPccgeUd5BmrtnGGMPph/81pTXb9yqQdk0pkX68JoDsF",
+	"WDSc2x4kVCWoEVC96LoWvGI54nqKWypJ1BxGXCDXWD1hj8xrtzwU8KNk2+jJRdAF3rvkRcGriDR3ipkW",
+	"/QU8b5zsmjDHJu29FkHvrtHBDPhDAImR/KaeNuEgNlaWcHWW+Oyyl0TbDAQH2qrUMK/xQwR8DtUhHfC1",
+	"8LzBRSKqMx0T1qtbwnnMkJe+9PrTs4DlsddLamANaJOyzHhrAlYDbaG9EaQBSgSaYwTRgvWnic7SwgHU",
+	"Zr9Mk9lVw2TdhJ9+lmSgA1SPfXThlMJ+8hhGDZS5gNwus7z/0Fhtx0NYKbItVcoRMFKzMV98N5qT+Ejd",
+	"2k0UPZmxZvHWxdDE5/ZaPfjTKNyy1Y7D4vrjOCJL9Voquo0yFfck1yxQbYodyoKIARf1JZHePJEKjLxd",
+	"28cCXfpXXUiB/QQYCOMK4SzjlXFlrHiXHLKy6i7vPLoot5TTi/dDAg9nR+AHyXYJy+CWi93Yue1Xg6av",
+	"HW2xa2PtNMtS0AwUAhvbSARBldQm95OlJMx4kZURkYx66j7w78VtAt5zP2Z77rNB+1Nc4SKyPf1zA68G",
+	"homGlOaPbmYwxB9XvTM3f5S6jDXS5Y0cFOhRp+P0xHnsT6qJ3uU431I2QzmWmyXHwlzl1mMLwrD9DyxA",
+	"oi3eIXBQgYO7SaP2EO03oKjAyrkwsVaK4K1R6TT2gjGxYY3264ghkksCiggRetiYyb+OZvABaLAeLEjA",
+	"DYzlxTz9R8UVlkPj1AG2PcfejgEPzr8o3q6OXv4yMtLcBJH/Pmtrk3Xgf4y+I+HSKNMxyXBCWN8XG8GZ",
+	"9rkFb+vjPN9d/Z835qjDYCxDBs1xtXLiorejTnAWdRucgHnCQv/Vj1eowEtSIEukAwxaH4ZmEHzwx9Iw",
+	"x9wl9sr5TnvosuEWbhtrYdlBBANWNAvdnosYHTQjlboHnhW88vwTwdvHGWcKU0YEshBKDGuNlPq3pF59",
+	"49/RtGwzGJzHH4YJoogyXEnQGwD45vnZ6pxKSdm6aeo0wF5ENeosEbKid3zx+hwRlnHt5qojVmy4ijOH",
+	"XX091xSGFdWmJBcllXZLthbab2awu6Y1w7EobW9XyIzLOYGALPKRSjV86+Piq9Cfgiv6izDaClh6F83A",
+	"902UEa0cws6Qjz4wsbIQZYwLE9YkNQKYK22BftasVU/COLomOzsaOPb0h3HGDPNYvLdBL45HlzxH1CxO",
+	"7dCfzi6vTjR2vf7haoZuubg2Qc/+OWfoux9ef2HXIZX0ThiIEJLIxhJpKK+JSkQs65UKstLcgphlbYPE",
+	"mZ0NJ1s0biuKt/cTozUEr3CeCyJljVkl1mBnUhGcu5t3w6UyBL5Anrv0ob80zgTK1n7EudSLqkVnzfqt",
+	"JfucsrO3GpNOSblBl9/9PBiBU7zfhDHmZEWZEfg0gOA+sNup4zb99WAew+2ANkqV8uXxca0LLSg/znkm",
+	"NbvLSKnksb7mbii5PdaIo11IGsnmNp3hWI8mj/8lZ3Ju7h1wTjUOGd/KeU5uYgcdhLZ1eZIXnLrBXf3B",
+	"Bw8ZFBegReqN2JIa0Uv3c4mFLCSlS0tweYEAuQroduAchwXriSK+HsLyklMGFk2WuE508KePhMMM4aXk",
+	"RaWIwVVjJ9M4q7MoFkezPVF6PUZtIhR4yLukIr2prOVFFBUZENh0WLgdyFW15cxGZtSyVXMvNcHahJyI",
+	"bd+s/DyZytw9n1jyNmRd3MauH0EQVsrkD2jwVKyw19FO33TWzBvJu7Bba+TSRGXES7KmPuala/Pxt5So",
+	"mESUubhhuBdDjcWw6MzrK2GYgeT60u3c5ObujXJivY7MVyhoC7WS/OUbL0jVr7qlOTxxwPLA0A8l6QJs",
+	"dvRxvuZz/eNcXtNy7mSIuaEkDUWNlsbCtyRFr4+3/5o9OhFLqgxzuCa7Y+PQBVVCIi7WmNF/uluuexTS",
+	"RsQTdvM/S8HzmOPTXWH1xbCljOqxUpZ1iHEI0eSoJCLjDM+t6z/2pQbTW+vUO92Q7PruiObMYdGgg9pp",
+	"KLV1EitElbbFm0heF/JQaklupYi4xRClMYSJpPnEj1z5+J7TDWaMFKmgivvRGt0NFmcclGV8q7Hjliw3",
+	"nF+b/ER/nRU4u0Z6PC/LCl4p/fo12fnXSrwmIq/Uzrzqcy80uJEgqhIsbhxTWKxT68r4douRJFq7VCRH",
+	"ZItpgQTJaEkJU3VCNDxorDHcgtuWdeDuvyb1lo9mR2ZYzZnd3nQgDoy1P8wm1DLTmPAzDJc6fXJDmPIB",
+	"BhEHBV2RbJcVBq81REouVW1ot4tdoJOicG9gQdxboJNRici2NJvzFm8HCXdtzJ2RuU7n6TyqA/U7j5zX",
+	"1oUdzJ20UA/XelAP1nqQHMqFUjbCGObuIgwft5c3t896NudfSW/Sv9J1Uacds49B3ZpK1SaIjjE3ZJ3A",
+	"Cjrxhnz0F9/35yen86vvT77681/Mi1hVgsAVx5Rb1r/N7R08v/KvbAjOiRhO/IPyii0hpTKKT22068Bi",
+	"TnUlJ2vip9Iv0QTKP60CT7Mj5XY1qvQTfLUvFviVxeGGSNeIUmm+oIFlVGmIlHL81ZGCly1PLs4WXUNg",
+	"SZORgScXZ/aZ1YZlGPSn72aY0cj65sRKQTQ21oH9LoV+ga5MeKBEcmOqO2Wc3RChTALfmtF/+tF8bKF1",
+	"8xqBjOEC0GNmrhJt7hdEj4sqFoxgXpELdG4yBNmKv/TK+JqqxfVfjSauL7CKUbUz1kdBl5XiQh7n5IYU",
+	"x5Ku51hkG6pIpqnnGJd0bhbL9KbkYpv/i/csRAPuo/EVP1CWg4cB3rTI7iHmpMDL11fvvOcCoAoArF+V",
+	"NSw1HChbufTXOobO6YTK2F2pSUSslltNZd6EovgCnWJmy2xZFrpAZwyd4i0pTrEkDw5JDT051yCT8bgS",
+	"hTUaB4RWk4m0hWt6aUM7JhrImxNpdAUTXKFRtPVBhEJ0NOZ7JvGKnNrA1oSr/STxJlpRUuTGE6mRmzBZ",
+	"GfsdhgMy5iYt2wJbQFn4rUQVW1FI19RKQAUFS6qUTQvu32TdAcsqnOWnJFmdMTBL1wBq+cbhAeDzqsBr",
+	"2JX+0Y4so2vTBJ7HS3tduUcwaEHB9+rW6T8MpKHY/tww7X26nxugXSRyiKwHJq7Sf9t+xU0VGggbL6HT",
+	"SzjrEA2dXaTgHvh9NbeGw9+FzOrtjjB6pnbSHSq0CCog5VNe0tihXjZf8OP7hA17PBk8VhwJojBtp2N+",
+	"/VW8qJtbWhKZ3ISZ4Kx3J4puyf/jLGbBsU/cUGcnP55AcNg/9a8hiCDWdeGNIPaGk82XFEfv353O0DUh",
+	"JTzigq6pvuCsCGd14YXVyhcZ3x47qdqOYkQcvQCJDAMHLqNvRj8pVQivMWV1muH7d6eIr1aSKJRtMNMR",
+	"3w1L3Pt3p4u9HuYuhYSVzry4Y0Edk272REPDULEP9UWQcjS98s88lUEsDrI3qWaf3m6gL1tsDHD9yYSp",
+	"2b4NnrY5DfxoUNkoHuZSfiRGYy4Ys1Pzc9z6rL0pkQQi47WRtQfHCmF2WytakOOcCpIpLnaHoYmZOHqw",
+	"LmPu254Uzlffdl6KAeTVt+5M3dK7RzEgGQXC2GOcV//uJvbmW3h9z3Wasm+e+lDwIIC+cVHFma8Jc4hy",
+	"XXjSZbd2bP/pIDZbC7vJSmqgvIYxN6igRtjUyEhwtmlN7VKlkSRq1vnIhcXRbckheCsaEIfZzoaqdBbd",
+	"Ucs+tI2TpxfvHXz0n34JFom3hCkJOKuI0B/8f3/69df//p/zL/7Xn/70y4v5v37473/69deF+eu/ffG/",
+	"vvhP/7///sUXf/rTLz+cf/fu4vUH+sV//sKq7TX87z//9At5/WH4OF988b/+i7FV18bTOWVqzsXc7suZ",
+	"qetIvTsB5dwM4+ACgz5v0MRoOxn4d1X7qgJKtK93KLJdgQDLWFEq/bMb0I9kftTOHVnXmiyJkFQqU5SC",
+	"F9XWvEajjnxXUu5OZ32lq8+5hQWV6NLreC4H3siq1KBKSyEdaW9Xto8/ZZ2uJBFXxr4n4xfW++YLUeHa",
+	"PEY2BsqZAPTI9pFMOGP7EzmbG7jxiaT7ElB91GjKOF5bcKNRs/aZ5x/1L/20U78IV2EcnueRt9pAxag9",
+	"Fjq9XMSvzwG3mhMlmxeUVcsd4dYzLmJcgW7jbIFupdFy6w2YOFW/rpkPQKHMCBYL9wg+noFOiW2EM4TT",
+	"UOmQSdt7f2Xonf6JSuPyL8oNtpYICCoyZ28D5RzyvdoxvKWZg4G2aLiSDwSsyWtTYseNDePpSbbbSmnh",
+	"3diZtTXDBOIuiS825VcmF2k1/jLcJBJkRQRh+iw4I4gwpa8nhi54rg07i8bbcpGMPo7outtKKrTFytVA",
+	"tBjUmKbk+SICeke+FzxHtxsirJ3OgwIi08/08NdG3ceqRqEwa1TSnCBcA2YxLMB3r1bV4pMazeZbXM51",
+	"FFw4SvctO8wWl3pQkMf6vN8jr6BnIk410eUNSKXw49Lab2yFUIS3LvZBR91UKgw7x5DGHTWi9sWGNbjl",
+	"MbQqmPth5zUdHcciApx993M/tksLh/bBUbb34BzFGTXFj0Ml4luqbJpOSLczE0QbmFIsytCVDV0wZf8K",
+	"mlFV7JyWSPJZnayrP8JMazyFEbDN0c/dDWB8BYt6JRlY7aGSup3sUbHs9wG/aLTRnDBma6hk23opFS+t",
+	"t8JZZLqmy1Lwj7to8ZOPXmsx7zQ18aa2qa/CUl8TgmIVfR/dUhsoV5YFDWII1/SGMCtXLdCJiYQAWzzK",
+	"sJXlJVHWmRNeCYobbBG8sDUOrE/LRRvzaDTy4kAbAuxprwmBfCy5jBk5zO/NweDdPYIctTaxS2Nd7A58",
+	"dhE+dxM4W//ZhbOeCXj+p9OzV5fImTe/MDSiWaqDmjbnNM9WmdvYVBcNZbWDqg7U4VHOA3k061MXAEBQ",
+	"gMMGKrkPERf+yIN8lWBc//TDIPPUIcYfOMdPYftpzDyZfibTzycz/ezX+gFXrdLvCHXL2ZrrjW+weX5k",
+	"ryL5DxOHtl7yimVEDCLeaPmXqEifKnTe9nCb1xrORb40tZjGOLk3XKq4tvS9feIg5N70qo+/rhzbc+XP",
+	"xxSKOIcHICopgcOa2AgvXZxoRzqohy55LA3rggvlz1b/PWDVgxgjzqNZB7q5WIf1mre1NjmQ7cZ7RoQW",
+	"O5PYGzL34WOnijaY32tTpave0Av1YXJgC/lMQzprwBqR2xgUkq2zsq15ILeCibTRY5kPa8lwqUwhMh8b",
+	"M6AARcN7Nbxo2JlpIBfL4owp6MMC0Lv1V8aup5urcMdFjThiqOsb6WCUm56E/dkknSKdVEb69rmwRoMz",
+	"WuPCbNdFDPNGgdf6Wyy77f3CrEbzwXAoN/sr7nNwu53X8wyIAPw2EdMTfW1YNKD1EE8xgVNM4GcXE2g5",
+	"9NjIQPhs8ZRiOfZUnX71bfAY0Va4UYe/mgTdo7E9a7rbv4Mw62AwXqRNnU5dizXeMYgoMEUpV/Tr1lX9",
+	"/Q++hF4p7rXF2HYpkSnhQTihVHhbOhyoSqkEwVt76v/V5u3bYMXBvTgUZYkQ1Vf1Q7eIVVUUkZifxYjq",
+	"3vrAPIK5g/HFKLTD6J5kRxjTlYIagEr6VesAg0HBImutm00DFJhxqDSMt0MdAR1Ot+WD3pZe7Bokf0WP",
+	"PWbYmy7hR7mEh1BxVVybep5j+2a9i5bsMF5cfU5LblOTITFLksLEK/rsQ3PbloKs6EdjabQ5YQt0UrcT",
+	"c9fxNswsjtvhXcuuRJZk/UadymSLXuRih0TFkgnMCjDSvhZl8mJ3WbFYxZViBwwtXt7EFlAzDGTpIRBV",
+	"hAwQrywMmznGOiM5o7OSlqSgjPzPL7/6+ptUxtWFgXfz+4zO9Sfz/cIGbPPDGJw6U2TbVTnTlW97y432",
+	"dpdX2cYKacGhzhxQE50tOiDfkzqW7imfhEBK7R6JtSosOuzKWFKTo9pFLF9dwmQjQgZj0HEsQQH9qN3F",
+	"ybtdDU0U2aeq23W4SQecQG2iOqS2RImlvOUib5KK4Fyl4s+6yf/xtwew5Fd0tYqIVHRlDSloSdQtcf0w",
+	"6E2d0q03wWUEJ4xTtcs5N945eMgZ/k17VE/NGHewqtljlpfEmVq7qBa8o7BQsZdaCOO21v22M+MAZAp3",
+	"GslFhsly62JOtRaKHoH5pIk3+r2FdWwHLsJujSjBI7UO//fV2x99mrJBDhux8GPd5NFoGs4djvO8xRW/",
+	"js1GtyWO1TESAFa0JZi1IvG1Idy23jLvkNw4GbXpHN42L3Bhg1vhXbMc/R60TeTCfpIHPiDGGdScqU+0",
+	"dZJhcvAeGHma2QMnu6IGpP689+Ywnx958A3AtUEK1b2pUpMO9cR1qEl7esra04UgunJcTLxrFrTuL5Ad",
+	"vKuXhBldubDB1hnXkotHOZeryEVuTsn2O7QBU2G8Td8izu2kbkf7JLJ6kQN4mgtQeZ9qSeW2YiytLiKa",
+	"hKU54a4Y1NIs0zWRRrYMpPL6FJc4o2r37U7Fgmzc42Ryhkzd/J3y0YFwdPTyqIJy7nW4KMn3HZYPCTeB",
+	"k8Z9KBP5kT97H7sOsDGsEgJKKgmZNFsCVD1DBPpOQF1cObc9pLhA5XYbVvdm9kWnnJeC39CcSERT0vEh",
+	"G6oULeg/e/QjgyslFvGa6+FW9Z/ubKi8Rpk9Sh03CEwyKzgEfv6TCI5ktV5DUXiG+A0Rc7NDe8N15Do9",
+	"Orbj4CW/IcZygRmqWN76FuSWaBjVgArmeu0DX60jkYaUMm+Sbyi6g1bjCfR9cCbddqcOee2Rx6iqeayz",
+	"gFSHcRHFBdkrHNn3hjlfbT7q5H2dvK+fn/fVUspo96v9rksvd64LAOTYXxJkqgTwmVYCGOViD/E59KoH",
+	"Uw9wsNf43J7+Dp51R3YHuNaTlNfwrY9uETvUuRysPGDPsl5ui37vw89s5xxkFwnevR9PsxMPJtHgaZtJ",
+	"7MFP1pKnbC25ynCsRNSPQXC2zZhpa9I2fz5Q5EzQPxTm9F+BQ9XlrUgCKdkFWWlst0b6SGU1PfRlkMXT",
+	"36TcTDz09QFAeV+uBc5Jqtfy/lb67kbF14QF/Sg6wKMSVTBXNB3gkCbjGr/72oMnUwxeNfJAbRNyZ3K3",
+	"q6wdtvtak8tk/QUZdF6GDrgOBJpZ9gGLgT1tVLpaf0/MnKyIENq5YTuez+xiwkbmMxT2MYejDd+D5bUa",
+	"a6YBBbWj00fU9lYE59n+2G3vw2CUvigw66K1VKQ8mM3bka8UKfdaKGGi4cu1Kb17mp73qQW+qoy+iPE1",
+	"QTgQdy2y+aMcdFzRile+0Tv3pBJvE2Kfulrx+8vEv3fDhUSzokJ6dxis0C/BF64wJdyIQEHn/T0e2uZe",
+	"hx+TOfvOGeEsbii0vXQtJDyZIV7/BiR1D9Rj1zAbsbXXidpmzed7LFmwgcmCNVmwPiMLFlCGsVwB2PVf",
+	"UAuidZcnqgiTPJQeDslJ77Jmk70qFWZ5XZNIVmXJRSNQyxLsAl3S9UYhxm8RVf/VRmeVHzNDA6Xc5ssF",
+	"+p7fkhtb1sJmR5Zyhsq1eUknWJnCFdbEtd+ikSwotc92YQE+xmbxOgV/V3dngPwmlaga1BFU7blxL2np",
+	"qiXA1bJEyo7YV5Slm5xgxqotCGFKbEJZ8StYeICg161H7khb387qHyAJWuMS54VEdAvNJNVmESnDTxXN",
+	"cJEI19Nffo/lJorl5ukFVvGnNW4MsNL1FPCcwP0I4PaVWVLQnk7hEU6h+4PeynQsT+tYYq+0jAujwtHr",
+	"SzJuHq+tCxhd/1WGxYXuZCqHeU/gZqQFVbuIiuTuzbfdDbaqrIFk7pUlQQqCZV0q09Tsw//BfZtfq/yp",
+	"DYZQpLpjGNcIZHVH97JrVlc3Bh7VIWbI+VgLy96d2gdNhTcnZcF3W00SVKIl0bJ7aDa63VBb3N+XpaVF",
+	"oc+buxLzpSA3lFfSRnyMy91L0DCMX0dEtfu02Q51HvZ9fbxUN0hHH0R/EYDajlifrxFCtTgtEQ4TdwIb",
+	"he2kEcl/oWoTzXa5iQnmQ6xEQAbutPcZiML+Y+5IPCSCVXwYRXjOT3XAwsNhYqpH8+1+h1j9zt0cYU5X",
+	"mQwLT9P/Bec8+b2epN+ryY+6NzLYIfq57k1dSdq+7/O2mjSa6Au/Vw5LSlrm6Tu8HieGNYpi99sibrxr",
+	"oV5IMO3MA+jDUBjHGkR6y8zB0sQj3UfBSvfdPkSYhq6p5lcXgq8FkXXRKCwznBPIYcEFMnHUkTawhm5f",
+	"+86z3diuwBwv+xy58YTXFa+0A5elaz913a02RfO0rgTUN2ezizp07O/UPpc+K7S/EFV3MYf4SK9Jqe53",
+	"9XpEtCQZriSp4/2pKX4aXXbSDXtJsKyVRuuGjW2Ci3KD2asIBsSS9bZQQf/VnREGBGuQwDu5us1CamJk",
+	"A0ovgbukMqsyHFmU087WdgNTGT60p3FkNsxvzE8edeporNmR9c5+2F/0HwTQBKxnXQLsg3WHcpqYGMIs",
+	"ymEoXjMuFc2uoMd+LCHVveIK7UqEM0VNAPyQPI1OOF+sKi4VRJ4k+rbqswWN1M8vCBJaMdLUbRrBDsOG",
+	"NWFE4OINX8dxuhR8RXVh/jdaogjeCZGw4Lf/pyJi924jiNzwIj+XsTf31PCp97zvXGDPI8s2WKtP3j28",
+	"BTIFCxrwrJ0XTumEzxMU60xI0Oi9edpNELcMDnythRtf7hCqmy7QVTi9d4xwqfTtZgp+DjmquIKE4EUi",
+	"UKFfnKEXJkF+tZqhL90zW4BR1zkGOcF4G/QivqpfcQuv32gvXHtyjmZHtk790cuvZnXQ0IvZCFTqQk1P",
+	"/I+KCEokEhXTrAAVnK1rawyVQW3KLS0KKknGWd5epduGVfjCPNc/v3ixb8VKFeeUVSrVhjtBoZXi2nCZ",
+	"4aLYQfv47oph1GA5f3kRwPLLb755MdsXg9VwgNUrjRHYaxsOpjUKwvKml/DTS5bdhY0TK9uL2iNovnaV",
+	"OlpMRP+MBJElZ7Kb0pQOLI4pS99VWOQC0wit2tr9RBu4M+JFx66gABaDoE3QAr1nkqh2LWs3UsolbIN8",
+	"TKuoaGvUsG0UkYnVaG0YZLHhbuUmMgmCc82NoUpCTCHFH085Y8SEnEQWeg70ERBSVr+ebG5nVm5AcTTb",
+	"Ewa5xR8vk5XPu7N3292NDZv0aDLKwOa/isH8e4ILtTnVKYf7ZNONeRVSCXNibcGwgo5YYx/HpQQ70ADB",
+	"wL05q0eMkWi60u04waAbwkbNyBBjmwUVf7EYVsvX5Ipy5fJDI0Rnegf8QHZRCmksb1StIJIJouLDDu3h",
+	"s6de7zjQ1sOA5ZxlHfjqFvzXxNSrvh/QljQF1wTcxkHmPbPlf61CcRBc7Lc1LBBliicNEFMp6LGloGGq",
+	"HuuJfWDBT/IHOYAG6GN8+C7Q7MJxr0DU2kZ8/ijqG5OxTaxOueWN+RKUhDA+KSopDLKxDUMqt7QB5UNK",
+	"LOJ1sUKzM3UD6sVLvo1xIYmo8W0XBHGBtlTKRlxzoJRVzHuf09ags9xDKjaXLSdvnEDWV+AW2apzsVfY",
+	"qpjpMNC/nB+GrcH2KgA2XmCpkKln3gRgXcTQll6D4uhDi3O876x3f8W0rkEodghxWNQ40ksGHumj5e2g",
+	"ZVXasxB9Enda2QwKF5Bi4lBmzlzKBYRA7unP2X/dmXlnwbLdIusxekHRJrsIQNypjqfpGs57FYcOBEjb",
+	"BdV9w5aeyc85U5tip8vRRFQ+9xbawmso47XfuNMtKywXwlEpqOtOJEnTKpcsYVGzgLM8jir+haT98A4x",
+	"JG38CFfTmdt322/o2k3Qx3TvACli2KU5EOhntXjV5VHwRsqn07lirv0nsTQWSf7yjS+NFrwas6Bf09IF",
+	"2JzqOh7780tOsoyUynN4u3JyQ5iLEWoHtUADMRWLakkllgSrTgEVQBTQ6tj6kPrgsKJ1qFUfHXdmPG18",
+	"Xdcoj9m5f3Yl0LtHayqigyOQCJIPNnbb2b7dxU11VvqD3se3Gx5MkViIqy7K6tJ5tquHjUlysfCxaz0Y",
+	"3aZIUWV3lnGRSFa0aNYV/uLpWe+aLZBrJWxDdOhaxIRjKmgpZa52k2gF1YCNt42XCvEqWuuIxlkVZUlc",
+	"czIX9a2RIgftjkRUJtwzXSK4N5C0X8M+OhFLqgQWO62IHkNYCAyKuFhjRv/pYkMix2gPWhcjLgXPY81Q",
+	"uxVStQlIj5UK/5MlzhLhaglA2+CRQwjJiG72+8GklOz/fcYy6NEGVkvN1nZ2dJAU4e+gVq/GP2/hzCoh",
+	"TGUxf1WE7aT+8s3RXjM3zetbqYYlQG4QWzxts7i0rhCBqEkJkXGWfnJxJu+jaNvA7GKrl8TXUQviPREu",
+	"zkXsuL4RWChr/NcyvGFu3koOO4MztuK915MDtw6M6oIUHiZFQxkYuzXflA3q/OVoXeq2Zevya73YocpV",
+	"a7fhGmIzDgLDKItv5+uY0Nx56bynnX5XERzeTx8CnONO5e3A2ytM9t/GTYmV7XgbPNZv/9AT1mIPcIR5",
+	"qelUMvsadHyX6c6lEVQOYyQTAewR7aqszo1rM4D0nmKLujjdVbPi9J4voKieLw855KMxxfXkid+fFtJs",
+	"3bw/6F5dWUBz1fM8hhsnRcEzLZgVpFUS1KOIo4pbLq6JQDDQQJvKj1yn/NuB9vMxt95ZgIaDsP8qkSoC",
+	"zqegu+Mg7Q2XFGJux3RV8AaeOB8KhJhaNrv5cvHV/1h8vTeftB77w4Dzr6FzcnEGG7Hw+X12iAhQ63on",
+	"a3JFTFxD4+uUtBR+qi3BA2TGWs/ukSKlGWuw/LjXyOFpo3nWvudpd1+mHekA9yK859qnjjs8TTuyPjgn",
+	"UTVNW80V1wp8FAeTlpr2TuN4O6h9R2hDOGTXzthRbzxSAGZfEo2ld40rFt9dvA5e8zqDyjwDNZR8LEmm",
+	"QA1ttOkIQJHKRwsMx87Coj2NtrRv3ZrVGrFngW8bcjCCehnY8Fen5wMA65r8EW91w7a8XzBumdjslhxQ",
+	"Q/YwC9hgPw++JHLHsrFdaOJGaFtKpFnckQtUi46nSVtZD3q79jBRi7c11cycYtpX7Sdu0ba4b+cZAq3L",
+	"xJKuqu0We3eGj0YWZG5LjWsEHXSJBS35IjHWsL3os3EpMlE0iHmDALYDeKZbeP2NX29fa5o3ZI2L7zk0",
+	"+ojpB3kqkhpLzvaFbRd6dKSDBPfihJstukjK1N8oxEB3ZTG0JFKhUuBMUWs4K6ixbpjCGzknwBZW3EYP",
+	"JdqcROqb2m2Yccx75r8rWAoSxKRzQYWj8U1S+mpciqpoGaQYn2Om6ByvdKS/ipsFyA0RVjB3zM+q37dY",
+	"MNCpfNrNXq5nFhGMOvMtQ9zSU4eVolP4XYNVn5CGIR7cjMbAfDiFhThzeDCFzKJVvb988cL2iWHcoYOc",
+	"WVOa/T/SUXvChunqYRDOtNVYP1IcUSVRANk6aHRfQGvrkGCFsxpA0TPhdchxbxBME+hFPEzZF41bVuuZ",
+	"se9o3q8xrNXBbFmt99I9zBFb9DnWe2aYZeRnynIeaWWRW9tGEN/b5cyMfFRXrjdTJPxXBXX69bvo1szm",
+	"wjStbKLxKq8KUvMT+FBnCpuc+R3BYrBwzUvC+oUxWISJ+y4J04V44tKVXVZ8b5ngTAtpAhIl9C7/DIxM",
+	"hpOYnUhISugsVW/h/3E2IC7LryX4aNY5I7v5QSee8i2+i6y97pSL1lS77WwrCJsdYEDhD5H7328JuS52",
+	"KMc7CIyBU7XnthfbkrHef47Gzj/qYXVnODv58cRsDf2TM9JCMwAaZQv0CjxYJvjt/bvT2DwAtX08+Gfz",
+	"VpeOOwEhLcDGcaPZBKYrruj88ASu+GRhXBjlAJLJfXuaiMKMIRXZFGjFElEZpT7XaSY+a6QjzlGsdEas",
+	"d43hpDTVwyYSWQaB4m9XRy9/GRuVpl3rP1O1MSbe3z8MCREN6rocRYp4zY4qUTgJ/0N0wXrSSHT23rmi",
+	"4nqvgSRmlzY6rpeYH8Y3bd9PrWGwZzryrV/8KL90QQ4FFTS8szUMlMCy2bVTL793tZTVXw6Gt7LOIZ8F",
+	"ubVtEbZEbUjDTzXSYTDMGZsCxkHeWE0PUbS5OD/XjQUFkXVdvnK7NZkiiIu69bogW64IuhVUBcUL/Cce",
+	"LOZLi0IbpcqXx8c3W+1lLMjLv37z1V91iYHjmy+PzUCQ7PCGsLXahOkOowGa9Da/C+oEpB1FDp1o2jcN",
+	"LHnNuIBnvuP9eJ81KrHUT62EQG0umu2RKRy6nq3m51hlG7QhOHfdlASxVTpIbmQ/9M2Lf0U0uTG0wRIt",
+	"CWHeTCIpy0iINz1e8wGMv8G873gJHP0+a9+qLOr2OwEuBwVUcleuI2yr4XI1LChf/XgFj2HXvk5GffPq",
+	"Uhk5z6SukpGRUsljfkOEvuqPtQtF5zBrcM8BFvJYjyaP/yVncm4iQYxNVN4bPpeCG5hH8dk+TJ75kmi7",
+	"qYyWPu6e6gEX7gC82OPiuQTjp4mgAA9PbCMmzSNajmGDb8ybSnYdzkezlDmzC0rzSC/AmFDTUTwxnmq+",
+	"TUp8Abf05Vwscv50frImTAEpImpVOZLbGGowmxnq5pVCOMwmHOC9oey93GNp74DMlH+XdS5zxJnfrB/b",
+	"AUsgle713Ijg8GOGeYgSr+PKYstxMDQQ9vWNIkgUGOJri3vT/q7/hyu14cJ2001HrPhOhL3hZANO6b5Q",
+	"xh7Y9+/eXTgHSsbz/YJ+y6UASNM6mmGi/6kRBYMsp3tRA2ZjP784Pz/kq1qcG8YIbcm2uysger0dJVLL",
+	"mC9/Syas3dPdEnRwP1iAlUQc/v2QeIiL8/Mu0HRR66GSSXC0XTg3nrXylYJ8TnMz1QOhOq4tIQ/LKtsg",
+	"LNFPNNOrwefQMXSBXLV92w8fyjXYgzDmIIIFEe/4NWFWyAOUipRort+8ywneFxbE/Xf3igke/ndDiD3h",
+	"JikppHMA+qrQCJI519igi9YNp83wpHSKOQRFhDnE8Vpl4+M/hgg9VnNbkjqhVmcOEZb3R2SMzsHbJx9G",
+	"XI99YQ91yM440IMglde2hM5u4zEUcbXZhgrY9xbo9bZUu5RGvNcR4b3RtVTSRLSmmz9yGMOu6/dlfm/X",
+	"9dO9pq3OHl7TUWjIUQG0QzJqZyYo1cfnd+NVzaPBUW0NC+Mwyj8g3aGDNzaFvZ/EfPA8ohKVgpRY2H6e",
+	"dZr0iHimcmNtsrUP78QUzRpKO27RMULwkB914P6r3nNOuYnq01bcwacFntZh83J3RTJBVGo0b9+At1DG",
+	"SxrWQ2AhgtlpIHO98XRUSvCd02femAGMmZazzkIGZMMogrdz3NfuLAIvF5PmUpNvjS2tMXvT19Qsz+1V",
+	"3XqKg0P9kxUjahQy2JGoWVmHLcDF4l8FLhICs4NPlOQBRg0/9CAQaQgDaDo04kTveeJgijNHFnd51Kcr",
+	"iMszsDXIu+d8t5NzQ7j99R7kO7Iti2gFc/fE+/rdJ7KncpO39ZnKMrAASHNrhUrcP4262ep1xojVeRb/",
+	"T8WhBnC0TJXdsnsZ/UO/HeynBZAuIpdVkyN8+Zd4SJPr7V+/+Zdvvou9ag3ErVHfDeu2rJKHHCakBGxG",
+	"i4y/2aP83eh+vxF28zsqC5wRHZ/mEisFMT9Z236QI7Yoicg4w4uMb489UrA8+pywG5+fGM9Cb0bKLOd+",
+	"cXOzsL03rodAlBiC/IGTLa9sZv+dczVIuSFbInBhQ0xH5WAcmrgR7rpec3O01NL2Aefw1I6G7MjA4Nct",
+	"22YHGpPv4c6rXwOzazpw4IrZSJS4FvcjuUUlz+vSdPbt2p/GGhbOVLa7lQqbs80agAn3Ej8sRVda/6Kc",
+	"nW4wYxCPdmcRPQla6I+YCNDh2y1GEm5/kiOyxbRAgmS0pBrsXvWEB3psg0L6p/eXb/zjW7LccH6dUEu7",
+	"jm9Z4Oz6aHZkhtV4htdE5JWJG7Rj7Q/mbHaUqEE2EOrjpPbu91H5PXjt0oZFDdOG21/6+lR3Ro0W1MgN",
+	"YUpniFrP4lUdsdkHwg+R3R0MQf3xEPDVWlA7fdmcQLqScb3HeHUCLc/ZLGWmDNa6nPp2Feq5sWy58jdz",
+	"cKTNXKf6uS89Xf/kXrFfaOi6PdlnSPfy4UW1JXOX6bZAJ0UBy5GwPO2BhzIFRBuBRqlXbXdZVIBSHUDI",
+	"npyCrmAUoE5YiiSIyz4kYHuW9M/r30Pnu5FGrPd9qDofIk6MTTSb98aUg0a3pWH2lrrD0cjyTEmWPjYX",
+	"K1jBsEpLbrejKNx9FMNI9yzZf31k99/9TX/fmsLuJHfCQndKV+M+mg2SsHX/vNk11Y5GdbJO1fx9WU6N",
+	"9KZZJ7lJMwpQtUemOblMlnFJS+YrH1Y3CKrjEKT1cQxRLsJeYtE+LgfIRo04x6FlZMa309eZWTsX8OEL",
+	"lPc0jO9vYW97JOzpOb8r0yOAybrTWWFIP+5YgZNW765+iat9kKMwpf1xFFMEWRW6pW+dmtPyyJqQuNHF",
+	"VDZYIsJ4td4gdzt3moD3Bqto91dBtjKVShY3zgRZXTSwr0bvlwMtTxYgwQqjBydoRi6xInENOxrhbErU",
+	"mbpzoEmeXrxHLounU3wukgpUF6Kr7S17J/mOfqv/sF+Mnikw1wydyn0ycq6uyu+V/bpMS/IsoimCjTV2",
+	"jGEy0PHb7auS1VAhojSLlVu1T2pzsZ50ht5fvYIYbxm/oLxMuIfYa4QzkFq7Iuspu+PwwdqNmgBYN0QI",
+	"mjtGbVeJOCOyt3aaEThDOxosdW9clAND/IATQZknjZDMzunN9rUvqiPVIXQTIjfLdK/b34ZK4qE90q4R",
+	"rJGdRdZThy/XTZQaAKWszy45TMDvgfC468dOGr11zKNzYki7wyAFLxLln6qlO+jUsx/6EuFNdLJGToK3",
+	"e4ERDlhPPYPV9QAJdnUIqODLvQC75LF6Qg5oURlGx0sTMUMkp640Qr7VOV0/mQfSdpnEeYsDNjHUfS9t",
+	"0wXJkdYF1wSKBZs4eD1s8Bxcv6Amm8XLvXBPw7daFjRLRQudrNeCrLFyjQ8CR2uqKnhlivlfxr1CettB",
+	"Bih8IpHrp+YSPOtnLmcOvJpSD05ykrfqytp3Y8PY/NJhtWZhHEic+55XIpHkGqvO3YeJYX+JZGjRiAFS",
+	"VT68YI8LI/TbTj71fAab4kUu4Xx3QeUPU0z5lsp4ik2Y03OArc9X9YgAI9rhrHs04SJimO2ddC3nobEx",
+	"7YO4+RjMUU+GR9qVJ/d6ypmstmXSrX4HASx0Xw2J9043CAzearmoBowrW66wscUwI3iV9nLJfc6tEEcG",
+	"+oKHQH+BTtA/ieDQs8hlLSY7FukOQL3H09+wa4s/xvoz7v3ofM/h7R3gat9Z7qnLkDqOERKC+SImGZgH",
+	"752N5XH5R5fVDmlS8C6hGSSDLeBCxbZSRmWSALWK4VQIKsImBhIa0guDiljpq+U+exaY4Op8EExDJjeU",
+	"cVayXYWqN4w00lstYurb20/5eyw3sW6Za1LXy3cW77jpPdmROSUAWMFU1BuYIV+mUB9fTk1X+/xOPRLi",
+	"7Zp76tkmuugNQp90H74IFtlOZBoaVwyXcsNVWluHjmrtvm5BzFIpqCl0VUcW+shqmAZCsDSb1w/y5c6/",
+	"Eo0eClfnD7Ad2SRVb689uzT9nl+GtuRipci2VPEIWamudiyLZ2C/871TzdZ9znWwRx9v6QASJAsMrNAM",
+	"pZeT0Z5nr4KbckUE0av1YZ81q4JWVwQkf9aIDXU5sD4ltnkgo5yUdp/vY2nkOraghR+NMvIARirbAIyB",
+	"xWmXPukeBgRi0ssfUDYqpdc9REiSLh/7KGFIsd0oLsj3VLq2SwO7ZIafvWZK7OJso/taB16ggOyvzNyx",
+	"nsOHzgmf91Qa9y77gz1ILVyNVcew69DLMHd7bMz9HZldFZmg1mrrnqvqqF0oE2j35hYwLL93b3ptX2G3",
+	"dGfAO/QJH1W80nm5W72d+3tuXxJFmIbdBS9otkvfYH39G4UbBJVmFK1VdFATHjmzs3Ubuh9jvejBnLrl",
+	"5oLICOQAZkTKVVXYV2cNy07FciKC0oQ+Rsu9sONV2KXYIgqF5LE1AbZPbvRiRcXi+s/JmrzCuwgSXuhP",
+	"GtOZ6NNoS+Qc7+QC/T8iuJOSpC1vuKUqjCD9+sUA7eaUlzFT9tEPhJTtmdU+kELll0GL+x/j9aYrgkW2",
+	"Sbkq91niXfSAu8NaKra33Fx5B1W33H3MDBQNwOmPHorrQCm+PHMFX2ieruXcnxmYbjITVucYvqSo6GSy",
+	"AhIany1Y5mOlQCDSxznTd4yeR69khoJPZ2hZZddE/WgeVKKY2ejpGWqcFGq0ETg7RI4a1mG0Gevh9vth",
+	"D6bKPhOXBwi24OhwjUG9D6OKc7IHIhZkWBNEkdrAu4alqHGqUM+FCqnCE+iTfRpk/Yi9D5uGg7ixGQ7l",
+	"MdofOlgf0t/wCmoDRs5Jp6PbyHQJLwVKiu/tyKP51Ia3JxLaf5+Fz19/LKkgcoyUIshKELlJDx++cMD4",
+	"6Rz4NuD9m40tHSU22Fp5ap09p/SGrykbKSpZ47ymr0apAmXcVK5cAfBq44Or7fj6F1tEBcTcjOfB0Vvb",
+	"7tuzV6eImmR3tXNNmoXzOguSU0Eyhd5fnkWy2fK47Kof/GQid0ki4/3ih9PXsJ4b+57fRGPJ1hQdO+d0",
+	"vQRzzLDu94JGn/cjSeoAL+HER1bN3YPwHW4QvB1HJlWVJ/qo40FbDiZb/NHVJvkfXzXKYP11D9X0VTXp",
+	"oSE/eXLVNrmz2WT55bASY6H+2hT4D49uMIu6clpTt22ij3CN37/OeSn1MEgqUtqG8/7TeP8DUg63Ldol",
+	"knJ/25dgVpijZ8uk3LPjdJp41JxrWM/MWbrmtozDLBBCwvBJG9Mzt0H+rdpwXOS+AYUDqQ++Gxao7ncS",
+	"BYHpDnghiCQqLaGBEU/BDRqRgvflQ8ZYVrP/bf1y+TEbmj351Xd9wcw+Q2gL3o8tyWm1PdIGVrEmifJZ",
+	"tiJ9y7/19Vd97s3Wov783dCjaXSd9XPPxoT1hec3ypcWfhgTN6+Clm+RnhLwFGX68fAmSLrHwE8mXeX1",
+	"xxKzuLQWSvQlEZJKZcpTmu9ku4YirCDDDC1NyxXM8gSvCWII0xM2h3WF51Y8sRz9Ht06GTvntsWKuacR",
+	"Z6S3xkSNM9Cxr3urawFEA4mI5vvNypD4Vs7JUg7FunDUGiqz+OlEcS5AjXE4F3yYwjmSw42YynBAWgFY",
+	"4UyhFa+YSc/G3TvwznH+HYtqv82gY6kLI6KwRApfE21a3c8I4/H7H7MZKuU2X+obo+RSrQWR/yjioqDa",
+	"JGwtRMu0ZEU/tmQHD1IXymUMDrHBpW1G1x1cP+kZdmmDNAaYkON5CFb2N9V+uahr4eJiL96X4PBsG3Ub",
+	"3LeT+mn3msJ/h6aj8d99GMV/6NQTCZs2PiGj69i4vqUg+Drnt0wi7GL+coQzwaWMBZIlQ4XgrGSK3GRd",
+	"/rMdo9cZqq8DkKgYs+Hn3Yc+THBAK5/63aCFjxt9SF8wC2S7vVT0UwtIO3BrDyhhkayi+WPDfhyJcAYV",
+	"FLCylf5c495y50X0h10IFeAatcms0BmACyjPFlvZ2P51Hqb1pkYcXycGKhmn2W5nF3T/HdeHr1mWdfhG",
+	"w69a7YdHbPiH7ubMfMY3Fzeumid/VPp1+7uXiKtu5JQLuKISSTOhLr47s/bPGcK2pXzMqnrPgVZjA3dn",
+	"R7fNeOguGEoiKG+69ZwZzSGU1d0hzkz7G/cHa46LDJZHAfY215yy/faHD+sKRlxgsTsxBstYD5TR5tM9",
+	"ZjWcv2VFosnlQZZXP18w+ixY+IB9X1ojYbT1qFuuV4ViMVXfCcygT6R2ZugDbGcEcVhXd9NKFUH/Hz/L",
+	"X150qiLCW80bSANCE9wNLqjRuY5m6R5Cw3yl75ktu9cxs0V1C6f9Ae3XfXCiVd6XlQ/2tZOgpQ8+68pZ",
+	"3okXtxLXNVb/pvWafi01eNupvhkuVSVs8FI8MmuB3roUAeBevh+EtXSbQgRUo5NaRM83mBdiw5L76cko",
+	"X6dc0yrV8N72BxlTwyUAt58ztf4I9D/04RJk1MeKM8ODEH16sceFyA1BnxB/h1tME/gfuWe6ruMDZhlS",
+	"grR1bK2NxRfSexzxfk/JKqy2p8ADkPhnQ8P3SaiVKUV/R8LsCFIDHOM9fnH9qCBesfaRGk401BAH9Wmb",
+	"bucxvmd4N1AlHhtMCOvtzeS9mjKMjk40Pl8RBaEAjQQ9HxXrQg4PSBlrhda1dufqoqQOdE31EjtaT6qa",
+	"7VvzB2RdC7LlNxBNMqSGMZaZrSPT4uaaYlBVpqBnu4v52WgyeRkLX9ClFU+XTLp2XZrLSm4aXAdhN6eN",
+	"30CZXmdVIlEx30xMj74WxkCqB6ZKav6wFgSM2m2/d04A4DYE1DUM6PKP4dX3TfZTTC3VK0+BlJguiwZf",
+	"BYQSdoFpdcXFXRYHnbJq5HrPfNZFJNjdvGyXFVu1vSH8EAbkpeAZcRnp5rxwcac1c1PyJpb7FY1Y7AEd",
+	"lJuyeO/XBrhk0c5cLZWEM7gmpenzeEuK4vAdRMVzo9GdFEQoXaTNFaEdW/+9MwA0XvjgZ2hIP8Hoo6N0",
+	"nYJQ6jFI1KAK8TK2J0qHgTfVgEgZxYJXuZ8G3tZdvxSmjAgU3p3hsBk+JakevhevzxFhGc9Jjk5P0LJi",
+	"eUGQElWY1Xj19TxoH+Kjh08Y1IxzXcyA8YDe5sdaxCt29MehGv6gU5Gu1G5ftwQAg6Yz2wywLsurbfsm",
+	"oYNgH/qz4VIZSC3Qpb2PercpTbMEd8vrEedSLyroc8SK3QwV9Jqgc8rO3iIu0CkpN+jyu5+bZboN8iwS",
+	"YYRJzQdkuxTO2JA1HzPTPWL7BlIc3ExIOaOAuclpFkqbi1EtGN1doEfFLIUnZ6oWRDFDeCl5USliWtlp",
+	"YOl/pa7zuUhkstHV7t2bqz0SsyYyUwCx20lPutipvHkemvUsxnfQaDVlbF7W5ifXw0H6Zoq+jQ5VLuSs",
+	"3SSRyrp1TtCZEX5P9E1szX2/LROBPfZIWSNY5KmpgiF75E1JlDJt2LvlY8yJdTW5NKOMdVBRpkn5bUIC",
+	"w0qBdK940L5th3ipEK9UwOxucFERKEYkEVX31MaiLQiZWtrO/hyWw+5CLlgbnJ1nxE1VpHO+Y5A8cmCP",
+	"iuiJAmr3jeyR+rup4rDAlveUQh4QMQkT/wzViFOTNSvNertLO0/DhY4t6oYGnUd1/93Oo7quZDPeLBiu",
+	"9aAerPUgOZTLnGsYc+a+m23wuFMi1z7r2Zx/Jb1J/0q3/GQ696E+63jgBIgGu4JjW/tb0jWzWNyVk3zS",
+	"j36rkVcwwFjSwR+LOfdSwHJfQeOCrki2ywriCvmWXKq6J5Utqd0oMqyhYd9KVxqe8Phx8DhptBtjmwOj",
+	"XKO+d3+JTouho6Jh7DexTfxMyHWxO8eanTMNbKgj1CWA3KYRdtBMVizHOzg5+ENVRMJftyRn7m+1qYT9",
+	"cyUo/CGxqoT+80O8WPUZTPZld93G164z9BNiun6MNGU69eX771+en9eVp0usFBH69f/vT7+8+PLDLy/m",
+	"//rhP7/65cX86w9fvPzlxfzP8NN/2Wt8M4AJFxQ7NcoX13+VC1zSLdapS0TsFuX1Wv8gF1ui8OLmy4U+",
+	"03MSb58CT1Duy9jqj4zHUG2wQnLH1IZo9SPIkqqk0g2SyQxRlhWVcTIWxgqvzSY3WFBeSdctFtZqSuy4",
+	"IUxZNT0AtNfnECH329sl1IZTeIbcwn5fRNI0mKKsihyQe2LGXxIkiXKSiXFM6v9jW+PHdXrwkTQG/7xZ",
+	"bWa2QlludBUJwFAb4pryabFmy611q7YbgaQEwieViJf4HxUYk+ySKmkrWEhpHpiKXz7c1HJor7DBEegZ",
+	"c8hgLSi8JYgSlNw4efmjQi62uy494uB+ClABa2rGmQt/NWPpZVnLecmlNCqhBZndqWt+BHZFvW+olZdD",
+	"tqIgkNqL0Yrcoq11CpvDhSB3AIk7eltKxPaid9BGtxstIUpQ4KlE/iQBlLcU9FLI68lw4SBlIQ1naXL1",
+	"fDPrmdMQdryC9QiSEepBCYo2dABntmWlzWxfxPO8tphqQUDzDqgL10HA7jsaC5p4Jqul1MfNlEU5u3pz",
+	"HM26G0BdzlLijt9tcIHOVvWXDoWcoSm3JfG5sLCWpCCZ4kKabPE29vuVu0VJZJtUe3M3DOOOoiArBfqV",
+	"eYFvqVIkR3llhCdJBMWFzXpqLpRKn1CC/kRAC1mSDFeSoLr2Vrap2LWtd+2eGhDQINzHvPRFvR9rcGYc",
+	"8LK9J9gIlXfZyZUhikZS+82Xiy//7ALH9Sj1HID75grUx6g34WuuxDDlvxGp6Na4q/6bec2F5GrCLfT5",
+	"mUWcFtCPRW6850sQw0hTYyvu+CEX9j/kI87UYlg8b4t6Y8kEAmgXK0ukK0pkwEb+qzRgEAwXTaWVuhsC",
+	"PrZuVNcrPrM7VRzlRBGxpYwAs4CPLKexHGmBfjL8wFxQS4KUrcGBPScOhnQNkvW5sC3P9YpzY6pxzAVW",
+	"vkAXvKwKHFha5U4qstWmSZzPoU7AufEvsBV/CYayl8fHa6rM3Uy5Fp22FaNqZ+zAgi4rTYjHObkhxbGk",
+	"67nOzaWKZKoS5BiXdJ5xdgPFJORim/9LxpmryDw3Q/Bijlk+9+w8i1Y3kaRYvaHsuntg7omxyJruPYLY",
+	"Mj+eCQOIB+3/V/Yre/X64vL16cm7169Q4Ko1VCYVL5G+xbH3xXoypAx9ufjqhcZggiVpsRsqUVloDT+3",
+	"aGv9ZvazL91ni2GN1QaJS1Ap6lTznBim+4fOX28lgaAXLMJLXiljRi2pHc/1BgiFpgxLIgGft1WhaFnY",
+	"5smgkREG4XvRNt0GPj01Cjo98Qx9mfsbgxSiz8A2tMHSWNvNCVMl0f++evtjm/Wd451dOkE5B2apdUad",
+	"jMC4go1r5y2DYotYAaYTLftp8Ro2pesszinLyUdNsOhvrp7CDuGyJDiUKTjLzOWu4agH0Fsyi5cor4ix",
+	"1cPXG2xs/y0YLtBb68cy+Pkacm/ky18ZQr8aPenXIzQPkM3/6DoUGpJTHoTwoblMfnnxYTFgBBBJYPGE",
+	"KVO5yg3x61E8SS7RaOIEbaotZnNBcG4EvOCxO2u4J+1/DBAWCL2rac0KoZbQDWecU9voRxizX0L0cS1E",
+	"2kuyVDR6UWeW9XtJGUwvcIcbEaBJTl6+vncyf0UUpoX8+81XKVq3bwCndGK2txijmiqBws5P/q+7a5e7",
+	"4B6BHr2GYYSfR7hGIOFpaoY+ETVRY3QValY6+5Qyw0awCojOyzeSqFpkMFcj+M4d8ZhVW/Fl63ubwqg5",
+	"dHnjK0RwtqlHB/XIyh9Yympr+Qtmu/oth2/mcDXfM2GhpgoMFCmyk0R0PEPlce5meK+0RGUZklPG7FFh",
+	"KXlGsQrr8wPQHDCBFy/Qj9xU1mw8BW7kzgrGJLnlPIuhseGjr5qIEUXHf5RxKJhHAajb3D4GAquRh3td",
+	"DG9PZMyolOX3MCl6y6Coiy+fDTDP6WpFRBg7125OiXSl0QcXtzRE5FxvVh4NbkrmEwrvDB/0p9taowG2",
+	"Q9m6sMPboDcQlJ3dJv8iwbmV2J2sFBHJqnFnKyRLkhnxFwqJOeuWhE9clFSzj5Gl/SWxtoh8ga741jJ4",
+	"OE1nPTFfgtgN/Efha/AxF0YjUARho9mguU3T5dIPpJq3lx9zw2+R6ydxi6nyq8TXLgygPXxb2UmkhFc0",
+	"gvzvz161T3ORPCZ/3qmjauPvy+PjZj5wzjN5XEki5uuK5uTY61RC/ktFc3nv12DP/QdbA1ONvbD1KWW4",
+	"KPzlwf6rcm+ARctZn7qxNSVNapEnF2f2mb/UVO3kJDkC3uoVR6+y1M3KmddanKZuEdVQuFCmUO+a0X/6",
+	"0Xxrdq3iQDN7q6bqrc688Q6cnqhiwQjmFfng7MibXuMVLGORj1fVeg2c8/t37y7c2eh3LYlRZ6CdoRcQ",
+	"MWqMFwNpxF6093gHBnJY8gbSvN8Smtm+xcaW5krQ5eurd6HeU9sY/KuyRhBgKytioeIvn8AK69mXrJam",
+	"xrwPK1J8gU4xsyZU6whaoDOGTvGWFKdaNf3Et9WdNApnxHemGsf/F/GZwHVwL2jhnRZ3UkBuN7vWyjUC",
+	"WZPrr0d/Aznw1yO70TtoJujESepZgQXYvzAD8rNQNOSnExJ8dzdXBlRHHqdKoFYyyZntIdWngqDawEv0",
+	"65HtCqN1URHu9MHRUUsTxjjlG47svar0T3pBeqOKKlMg4wL6PvmgaUCeoFXpy6MvFy8WLzSYeEkYLunR",
+	"y6OvFy8WX4EbbmPgdowLItRcVAWZu6by5kG0DfYb418xsoO5LKqCIP+Vi+TGMnjsr4+L8/NoRJPWnW6I",
+	"2LmHJI+V3/FHeJbbZXQiYm26pdEMzQ6+evHC+cNsO1lc+vLkx/9hKcbC7eXI+Fu9BDiY9sXiK6XysCHj",
+	"n+9xMVCOPTL5mbubrUpN7IuzI+nqLvQfoUZGvJbavWoem4xlnSXKZQQbTo39GCTVzlignIeIYIIoAEUs",
+	"TsRbsO3ay5M7lkWwAKbvnEzdVP5bnu/uDeiJ2Vzr8e5hvIvD+Cj0YtvA98dD2zEo+81joOx7JpPT/+vD",
+	"T6/zGQuaqSdFor10FSfR32dxTn78m9aJf687OMc69BYkOZuOe5YdKnZOBi8L3o2QYQUxQg6SEF7+0l54",
+	"WCIwDiiqX7O1cWxtBd+/OSTBWXCq7cv4Q4c8v4mpEykc/ubhUUrb6CB18CkhcS9ape6ZqNDxHVHpYZqY",
+	"9B1RzwaNngyX/2xRtBex4nKQtv9HrF8Q+m1baEKOsvUegNFlCO4mMsWeEPrev1DVnx2XEKpqyCb2bNIf",
+	"zMiTsDVY2PpsuYAl3sOlrQHqciNNPZSm9upDd9ePH0cv1u28/kg6sT8aVxg11pI0hRolnZvwyQGYcXJx",
+	"BqGW0ri8eKVsaTqwnceP9uIM6v0/6MnaSZ7/odYgDo+sUptBpg3/NZKEmSRxjJYECyLsz9ZYetIoZA9J",
+	"YtYGYur0y4yX2i2NTXCdgZ3PONnwwizTVVeQmyXHIo9+Y0LC7Ye+LuYMMc7mkOAD/b2ddV5CTm8i+6yg",
+	"Us0CQzaR3eoNWEkkeR3h7R1Afp0SMUJyxHgjB9fsxYJINltQmEmghRIkmCxSxh2LhA9r07GThFLH40kN",
+	"pzbrxO10MtA8JwON5w5d1tK8CQYYYi7JDb/ujBo1ldRkMVg3CMec7CKfDnfipxzDnSqnak6YEnSQR0a/",
+	"juzrkIel5UgfRxO2c+MsJVnoQV7bKfcg1yX4zMEVDLM6AReiVWwNRYNs/6iIKfRvsQ3eOOrDr1mnwBnU",
+	"SWx1qWtuG1J/KsES87rmdPW0dfXFFy/2Vl/8rbfOcGcpulZMYiF8tZKkuRJfS3JPM7+HNSU5BNiNkvtm",
+	"RyDwmPX82/wdV7iYJ5KAzMPeU2y0GVvRwkrbHVypQfL7p78Nn6AyEwK1wWNyqiyTaeYD72Ez9rBc69ZW",
+	"fa8oQ/m2Xfuwl6WYYHdDOVyoaA2xZYqj6C/+bp5GKKruRgKps836fGHtzE4CcJofXek1QvMaH/lmZVwI",
+	"gE1Qvv4isUwss2CV8D896aD1WH4M+kEEdHaRa3pDmCu+HlugfTSCM++bmbJgZg/t2Nz+4T3ODkGGegJ7",
+	"LdZ3IqwIGkYkVqT/+bt/Y/yyeuChBJadyk76ZjSJvySFQHUyf2c1vvbP0NuzvbJPen9GFvMMb9Amx3vU",
+	"W7QNwOkevfM9uvfKc5dqs6XwfsOSqeLUHA75jgAxU8i3rZ7FD2cPiZUSTLhiohuwmYh1YZDHM6Y0gfR8",
+	"TClPzrLRi54pnI8IlMPjT4wR0iVadNtdxcwgbZIYbAvpjP4wBpGv7o8wTZEJs2vKGUS3pq6WusgpotIX",
+	"5TWhOr7Ari2Ym9sB60CeoC0FinVaptLls3Tr8GpEHmkFmohuN5gC0jdNMmpmFE19R9RTJ6hPfVE0BLTX",
+	"7/D6gNKavVrEJH81o3MOJolEoM4F9FZ3tRr5qneGBYLYAFkrl/WrEIWySITxPEFKeqjoncPFRQMUXYYg",
+	"BV2fo+0Sh56BMPk58IjP1/M3joEcJCsfB/0q+10+rSaksu4XG5QUjyJYWGRFbcDclG6XaC1o3CQUEwEt",
+	"ZWZhUMDOJfG6ipGmhoGt5ZY5naI9MoQIXPzb6QxdXJ2/+hZKpqw13ened6jAO14pF3LuskoXUUNz2HhU",
+	"fnKGO+t2ubUsztVl8jbIoGWt3mfB+bUpDjOrAzdcG95oY/KYbWyAvfIhhatO99gpDvIZOKZbbEXa0BzH",
+	"Th6Exx3/dk12vx/rLr8Fx/ncVnCNm86+I0yfFPFFGObGHE1yTT9zW6z4/eUbKIdmh0TY7cP1qq6j7BoN",
+	"qqLsADiUJlEqkS3G54g2TKd3RcI12zAPmpNqduuLHUhiAzrdp42J10TZimML9B3nulzCqWmYcVX3AZBV",
+	"WXLT8VRtBK/WG6PMX32Ngr4FQYObmDUxJNFXFlTvL988PcapS6+51h4W6jUb1WB3IHe9EjzQ4yu6Jrun",
+	"IDp3IN8vOHtshs4zrjHuQ8q9bm0T834eqSwBb/TYYphhlx0dxrKtaJfmz7Zfce9t4e2RQa9m8IMKoiBJ",
+	"3vbubXZrMr16rRPG1o6LmSfxGlNbF89IpfozLYZ2uKBd62TwmkL1+kL1BiC0N50bND6YtHYsS1PWRSU3",
+	"/atwFv1QolHclG5TJjIFmg3qS7RLNjHq2LHs8yEOcK/oFJZ+18pEI12DyIOj5kH0ZO+SeckLmu0Guh/t",
+	"woObyHw9wMqz1zt56ca8gAU9PWqaordHuuoOx5YDPXn3hZ5tR9/Tx837O/z2XicmP8YV9xAoX1YRlL+6",
+	"24SgO5CPJa2VHlt/SFSM5FbFoLpG465DH1fPgT7u3yQxgDSgU0nzLB7VJXcn8p1sE5+Ge1w9GPfoEwG5",
+	"0j3iAqEzrV79pMtuO+OJDnwLvgKTglSBS22GvFq4BZeVlYG3w+Va4FClIDemF1RjQuPtUoZ1GUsGWIu7",
+	"g6A1V37JnBFpXXJkZzvVmqgF45S7MU4la2+BBsx4pYi4xSIWw3BpgNdggqcBIP+gDDC53wQnbGHKp4tN",
+	"CNZ6aRtNTJyxhzN+vuELQNgp39f9cmBtQprXBVr7gxR3LGvU0k0vpu7iNMqk1VZ6amPPZNmalJ7e+MMH",
+	"wM0B5ATN3mHbA2KBGq830VXWUTmU2cohdff8brjPgbnjQF4/NZY9PIU8uv4u8PqSyuu3z/KDc/Wi62jD",
+	"qG8V+dJ2mP8R+MB9LMOFYBjm3TO3eeE+suotWjdX8RSSAzsrerYZgiGhfIoswSYkp1TBewydasI2YPeO",
+	"j1gOAYhg2X6GFS74eq+ohIuC3/reGu5Qdc64hkwdOg39Gx3z9WWdCHR5qxu950TQRi1fXZbEXnCwgxlS",
+	"fE1M7JO/EQhbU0ZMGnk9NmRvS2T7oiokKqboljRCRX2DSRMxWtEitwXPVlxsJcp3DG8ThrnviDq1UHpI",
+	"kclO8RxrnjkkschUN0AAKk8hQYCikqgaJY3wOBe8KHilBgghtkVMhpmWLOx3dQXDiGMwUvFQd4rQqvUa",
+	"Qlpc55ogp61ZNNHOFhG0XHtB5t812W8AlC2YR2gq6JmzcHQTIC2V7stNcKE2O73KDS40wbl9Bn2ZTaNI",
+	"8Oo7pgrLjwcvg5R+6eD84PqAnen5l/ZrYppMJcInMC3E++u/Sov1DhXmFhXmVnoegP8dOdF9ajC4KKJI",
+	"6ngqFSh3bcT1gnmlMr4lh4rjNnjle6r/2Y2QxMM1fyIhvL2EMfJ3HRl/x7nHCN2VPPp0Hs3GOR8oRdrM",
+	"4LnNb5lfEmnk5KhjjiMlKtMH3zQpjCE1Fs1cYnvz0IAk9CtS4YKYRvlUSg2r/qImwULf14PPrTgV6QN0",
+	"yrdbjCTRuK9ZNa3rRoeriyvpU5rmKF5sDxZtPMdJiL0WYy27paY5kv5gL3sVFTPt6m3uXCD8amFUziyE",
+	"TA//UvCP1LJ+ex0ozgtZSyMdpoIzwaU0fHqf8+YKIvAlOv3ptW9Ha+ZaFYQoVJVrgXMCvbkpi1z73xF1",
+	"5ne+hzm/hsSD/zCtL23zWa3GfqEpJ5M3NlRW3pj21RgJfotKIpA/akS32iueYGC2n934fCbX7Tp+S7SU",
+	"ygIviUakgmSKixkii/UCEXbzP0vB8xmoDv+TVCnbgv76yn78yXhtfWIadRX5qI4zedP8vsMrphIkhwp3",
+	"TfQFWg5pX1NqX1nuWqarsXNQhbuRvgX9aR2Nflq/1kvVnycJdeD0zBIEn2R5qsH+BqCIWTKBA4aJDKKl",
+	"YSt6RaLF4bPO0T5olarObP0ZVDEt5rBqVV8+HC1MdHBIksZApO27FY5/q/+e03xPmW7d/KzlB4xMHlZc",
+	"6lYJYaKHanrvjbM8rZknch7DvT2JwiHp3aep+K35Q0JzbW9f2fIbXBz9/oC1t14RWKxIBtYY6RvLTEv8",
+	"dkVGEjeWG/Ls6mJ9xuExh5F2+3YdWI8rSr4dLfHp84fHkhQfuARPFFqTDeiwUl1RYHak0L3d9CRR2vQd",
+	"CbzpTgBWEL6lSpG8/hILgq5JqRKFuj7L6ze+834BOttgtg4A+6jhrhMvmKJon1zHwLEMaqQO4uNqCz68",
+	"FtjVm7c9hbw42y/b1J4aDbaCYpaRvtYOb97Kz0Ui8TuebFb3Eyf1YNg6JOCqj/I4V1IJXO6NxioFXwsi",
+	"/S5sBIwfAJnQlQMl/W/9Mj4XAvMbnkLUR+XlenQL8REPlML7+hS48oOyxBnpiQjBpu6kVC77jdhK484j",
+	"C8ErVHtML1/Z7Df7voEaElUd51yXFPfFI/y+wlaSSyip+N3rd2hL1IZ3y/x4hPocxXy/+bRg/22NODUw",
+	"HtKY1kvh7xqo3LKgTUaxT8RkzixZu+YBJocE34N86+LrKFvxvRetfdlEHBuu4KJIswJLSeSdLtozvYLP",
+	"1axmNj8Js4fHWh+OmQeRSx3Ims5oP8dMr6BbIi8Mg4WI5MobSjpVMDqocl5P/ce/Pvt2nyrT2YlTvUND",
+	"pIkax1DjQRg/iv46ceFBnfY9vb46eAGfDtFwE/V7X0UV2ydElLNYGnVDi+gAxQaR8kpkBC2JLjZvUvzo",
+	"ClGFbrF0FKT1BByoJT51qf5JkW1ZYEUW6BXESvpm+wO0mZ5WkObLo0/AjeIHPpQPOXz71P3ZBu8ixe7u",
+	"M/xm8GJsi35kmSCs46vHX8dJlpHyaahDT69h3d147B0Nhqm74dD2d/dwT8C4z/OeSF4RAI8FOoVuI9Dv",
+	"pGI5EeicKKzf/+VXs6hfjz64UaIwsLxw8VB16z+X6262v54q0U2WYVdU2tMqyFoHSfHCdIrZ8co0llEb",
+	"zHzoNxjzkS/nx2+IEDQnYALMuMjrklbtVueJNIfWXnw1gBUuJJkN6KJ8SbCsvcRuRTPkEEVv08yjFwnF",
+	"B2JLEWaYTxaDTfni+q9ygUu6xTq6nIjdorxe6x/kYksUXtx8uYB6MX+/+epZlZJ6BCNd0MiMGsO0Ipnv",
+	"sOk6aj79/pIPck0mYt8gvVLeeQULdMbm3hUA30m0JsrW51kQqehW88xTzUDMSSD/W804XZ5t2223ooya",
+	"1HLOiIzmbE336XSfPrz6+FS1r0npcHHC98PPHlzxODZy1lzLWcZMFau1fFFobMZu2TH5TJCCaFKjSpe9",
+	"SL2YYca40nzENnmJ2ZSjOPhGD/K9XuQz56QT93uSxrMavxLyXIjuYQmRRzWO9a5yKt76VMtaN3EHd3ts",
+	"3RdrD+vQjHU42G/vz+PgijhMLofPxeXgTnyoz8Gj3BNzOvTs4xN4HXpW87huh56FTH6HMX6Hcax2UI2c",
+	"Q26Ju7oe7nJjRH0Pz+XGSF4WFiJ3s5ZcNrjiZC55wuaSP6yZ/HkYpu+Zjx5kmh6xhqZt2n74SY3TE8Od",
+	"GO5ztk8fIKhPjHWIgfreOWvUrnxJSmNZvn/xEvJvJ243cbvJsuItK5UhismycoBlZVUV0+URXh73x7jv",
+	"27wxrH6nYy0H5ZRHix20cEs+6WsmSIJolgzVrAKauyRS7pe7OxcPTdVWN90W4rNaSK2pDhQMOovYCqfl",
+	"x2yGSrnNl9oXXXKptI71jyKxVBjgnV7WPa+TsmCdrtfSPfVhqm/U+Ny3RJDwyvxclYKp9Mbdy8XelT0m",
+	"mPr+agI41slhgGXlpPudrifAK2X7YfgML0kyPSWiEmGlcBb0ibHRvrFGIGmysP1hhAno5YzMEGaIbEu1",
+	"i83KSyURr9QwF+pnkEPZ3vFj5E0+1sI/gUg7TJYtdg/sKnziPsJvXnz9OFHgHbQlHzNCcokw+kfFFXbk",
+	"W0mN0iBzKYK3z8SRedfLYKxof7ysiut57ayMXyXWZxCt/V+Xy8dtyRez3JoZUCnIin60wqXeISk3ZEsE",
+	"LqDNl4niOT3TlfWp4GxLmAl7zHWjqYrZ3upLgrY4J9BibIHOFCqoVGBx86voLlAvQ1jjnG1pJrbmzNHt",
+	"hmYbe1NZ74CfShKmzJUHqTD+BZMK8x+Qf6Afo29e/Ktradazig2+CQo6UuakTthh17fwbVVcR3268pnE",
+	"/0RNVC0j1OeYRezPFbjHp0sE9guxjaemnKOnXxgo8N72cmJZmw3u8bLIuFRz5z5NXxev7RtOUVCbYof0",
+	"t3XvDDBSS2QJDgqL4bjKYT4pBc3q5nTQdyXNByzLbo9GJWJcBcJtk+W6dbcI5VRvctIbUgKY4t6jbvNI",
+	"zUE/quqgj8id3hTJ/RwiuXt5RJcRBHxMcwJNCAfwr1KQG0pu05wr6EkZGHRrdgXy4i2vijzQkk17jO6a",
+	"F+hHrgw/prXQ49oBN1tJS5IJoqBwuiA5zmLs6QJWP1k0RnAmd+KfUM6yxzYZUMczCQs6q1oxuiJSyb0M",
+	"4h4EnQPjeA9U5wcE8j7bEIu7hVY8XkzF89RWpyjcP1IU7r1bAwe3RboXxtWNhp241sS19uxFC266RUxv",
+	"UFtWUMIU2mC5QF+/+KZRkNwXOZKKFgXKKiEI80WAoBFNvcqz1fxHzsj83LRBeiL+9fturOP0lZ+aDXYi",
+	"IlN/e52vX3wTn6BzSBtsLSsd+7Y72ybgp5ugp4/XA1wDI4KF7+UqiEYLT7fBdBvs2ctJWbpIMCpFVSrq",
+	"nWYSCbreKIRv8c6XtwPFkDJFmIkpuaUs57fJu0TbYQouSZ5YtSsud14P+bMZMbaLnpJ1gy41CB7Wa9KP",
+	"dIoRWK3r35Nuxij/bfDenvvPXX1/jECVJxCC/VSv7/uMRrkgLKds/ba+Qvs6FkIuHhYKChlJ+s8EczIR",
+	"AsLEiREhIG6MKokY+agihD0FugwLdHm0moz7GVFbCPTy3xMPvf/0kTm2mhjOeanSHotvBTh820KHzwNy",
+	"d/9yZ1acqUJjy3dUvS1dYVjXZWZr6vlD7E1QcdP21pDed9Gp7q81MKFJmLCMgBeDbksuQOZQ3M9QsYJI",
+	"E7CzM2/hQhCc7+zMOUzLmfe01OXN/Hj6s1WB1+vAmRK76E1MuQGeuVszCF8CotlaR4vkxY2bNRMkJ0xR",
+	"XHQnz3CpKhEmDF9Heh7gnX63FPyGBmVy7c2JljzfLdCJXhCcWGfRJnpKalhi6SCij80BD+KYMi5yA0GU",
+	"4aIgQr+sWSa/ZUQ4AFPlQaspkjPSDTAyS/mjiOiTcP2JpDZAaBAIHlHmctM+w9Clz9blb84sxvgcBfFK",
+	"SZobeug2+r+/G7Xu8TvQwVd3Tu22HI4wosE9Aa7evJ0Y7h/ZI/fNs+kp9RmzpcMJ/cDK7L6D7IjZfFPW",
+	"ngbhqVLpE5uZHP9ju61PEtWz6kV9Z06yn5VFXUhXByxgcIXyiW9N+ugIlpXuuP2uiaEBRj2m1+A58tYn",
+	"V/j7niW0O6qQN0TQlYXGvOQFzXZ9KuXbUsXJlleqWQMfhSODfbLEUjV+BjvrNSnVGJ3zp2CEC1jxxGMn",
+	"FXTSAVs6YEhpCEj7EXXCQ2cfphBOPGDSD+8iw0TwZ5RIM+lrD81jospaUvygLLUqXWRBumLIgZAY9GIk",
+	"gvKcal/kztWps05fbIiACyx2EQoyLlaqowVIdm19ubaHFcIrRcQtFrkcrCxOPG3SHR+Unb3rpdtPoEne",
+	"lQtPRrsnoco+1CVwN9X2bjU/fZvYp99fNlJo9FsLgSlcfbqFPm2f2Knw5sMV3hzDox6Q3XZK6kSZ7qEV",
+	"deIkdlhNnQHmhUYZlkn8nhjfVLrncyvdM1huvUMZH8c664jtJOMckF0ZDHNPae+nwcImIXLipZ9KiKzx",
+	"cBIiHyQxezzruP9o5pziNeNS0Uz2+Z4vyQ0R1v7rv0CSKJ2NIgeEDdHtluQUK1LsOiwQBm9h36tgYZMs",
+	"OLmYJ6Ht02Y53iv9H1xzCGcmp/+gNQwQvSamMwlNY4UmjzJXRMpEbvvE0J6qL/2ODGV01Zx31qdNix0i",
+	"DC+LxNxsz9wQ1effh4RkzaNJjnCl+BYr61XnLo3+3bs3iHwsqSBD/OITK5xc4YdxQUDJZM2HCLYrbmnh",
+	"ceuwTJz7OXLuJ8NBH0IZX63StTq0AxsLWEkpeMllTNDWG659NIW+3DgjtvpDyYVK1MdqlBuvyyK1IsPp",
+	"ajXVfJguhwep1JXE6U9ZnUtj/HQvPId7Iaz27uqI8RWwMs3W7iDLH8rPyUfNcJPepaBdRLL+kqbNsiSG",
+	"iVIlbVTTzPztivysKCnyoL6SdbOgkpdVgQNfPkBvhmRFlbk4deeJjG+3VAGIOMKutpO+LCRVXOy6UU+v",
+	"zb6mi+Dzqaz5mqoNEWiHtwX6U9Ca9QvEBTJEHp9uxcUWqydUKXnWGE3vpzlae3UT43/6AQUfvVjbl7cu",
+	"EfZdQB6Q28+XFcsLso/pmx5rq7mGHqaM5H5pCL43rDnGN2aILgg0wbyCtj8z8x+bH9wutnfui+2dxmrt",
+	"rXhR8Nv6hmgRjOu4ydGW3xBz6eREh8TqzeifT8Sao9NXmgv8rag+Op1Kr8v1KALFyhRJtKVozd8bXuRE",
+	"SFTQa4L+y29Xr08vX7/7+48n56///sPr//u7zfCo22lWS6moqsxtRlZcEKTRbedudoAazP9/T87fODBS",
+	"c+xVoeg851m1JUzpO5XgrQfR/756+2PjdRP/525gGNKDLLc1CyXaVlJBT9F2qb2BF+a3Zsrp2pyuzU9x",
+	"bdrxINJmuhjv8WL8fNuLDruJvXGqjjqmCuWkJCyXiLMHuZyDatBzWw16WPW+4fXhbaEFKHVdIyHcgOZE",
+	"TBqJ+dZUqDa5i8OqL8RKyk/XxhQTM5VdSFHpXaJMhtP8gJiSiXSnyJKDaKOLOFOZhDGhHaN5Qm+NurFy",
+	"QFWuBc6JnLlmFtL64HQ7C5n6ttPOQm38dLY4u+0ykxO2QD9TteGVQti9U5fGt/JG3fVmQMzHxKom596d",
+	"uVR/Ib0oUT6ed++OPHUy8X7aogcjWfqhyqLV4ea1Dtdfz0AvrX43yduHtikaUGSg3VBpitGbhMqDOnGN",
+	"rRHwtJL0VdTg8kg84fg3mvc2eT/VRF0gzOq13TtvgDn2cIeJObQ3/MrN2MGe+JT3scnJOvVZySyO+qMo",
+	"dv/8yRnT55XEa7I3o/304v0MbcmWix3UzqPyGlWy9gSXPO/RUgvO1qbZTtCjjOS1RR904NOL92ZwO49Z",
+	"mXaxKnxNLJZviRI0k3MLSK79264lN+MKUSYVLgqSz2qquDg/h99Zip6odF3mzIYGWOku7crfG+hN/HIS",
+	"psaHFzVxaFIsn5Gt0MdbAo+6W+rXHVi44oLcsXieG2V89Tz/5acsn3fpgDCVPpk4+Sfk5BoJpwJ6D1hA",
+	"bwyfSrNbe1J34roaWsNacHQL/fuvD6+zHw34uHTjTtWoJ4V6ktV290d899Ni4x7oPqaETkQ/CS+jqaqN",
+	"NlOYyAHdNB6Ilwzpezh+ajCvQSp67ksRY0FQKSpG8kZfjQGBHxPjmcI+7p3nQN5ME7UfNdjjTnxxssg9",
+	"if4WD8KWD1UVZYYLki7Q4ZbOqu2SCL1SQQwMu44UwtbUVuWwj0rBP+4WOl9vzV99675EkhhnsOlwhHie",
+	"B4Nvif4Lwgov/u3U7d+9rlBBsPGRC0IQ4zmRLoTQRAmKymQhUrYuCOKMLNBVhgtbAKQdgjg8+rF232QF",
+	"l5EaInoWMuWOfz5JcCc6TzNRdSaIT0XkhjCNPWPxLcC02KptvCyJBbd2drHkvCCYPVotFEMMqctTYaEt",
+	"8V128ikLovSuOHY4hmmaO+arF189WBxT9HJP87NEeE2SzJ+CSPD1w0//Ny6WJrR8ypx8ZKlHE0nqhrl/",
+	"T6PvrDjHBtt6io6ZW0YLChtd3EDXEwtErkq6fM6Cbqm+jdYCMyVN9QKczzc8QzCDTYqQwBNywaGojSY9",
+	"qlxRNSPKUCVRiaW85SLX7wpTMMG8bGuxdYPgzCJbjMrVidudwBYnEWPSafspuIUxlzBF8nZ2b1sMH3Ap",
+	"f/lQS917HzvCsyc6qbifNDLQ8dRIg9tKPhDLt/loewMDfTRIM5Cjrbna4e6QF/vaDPTeLmvizpOrY7wy",
+	"4rBnsuw9I4dLgpXsS86NiqcWAaLjJg0GDCpQLdArfsvM9yB5ymtallph3OL/4EK31pW+focgOiyL5At0",
+	"tkLYWScllNvSN+ua3hAGpbgcb6QyKPtR7KAvOcJoJYjc+CE0opBcmoFVrfXb2ZHlIRJhxMgtERaduJgF",
+	"aWdcQNVeM68uCCmkQrcbwurY7A5HtqCLcuWJHU/2uM/SHmeJYo/o32Fcn8wa13MBvotyovu2w911PXVx",
+	"9SgnczX6WsxyhriwXoY/gmVvsrM9lgzSIy88pNY1LwvM9ueQS0VKW7Raf+ZqW7YFG8VjggJlWVH5bzw1",
+	"2RXIPtlirLZ2oXcziQh/4LqVgGgOTxT3nFvxxEyAWj/BF6Mg+fj6osHfSWecLohIF4ECs4O11KG3BAy5",
+	"P88L32BaQIeb5moOazUdZlu9tkt4Qlz8MfgAbHvK67l7Xs+dcbNNRnA046lobldCC6p2+2Ws7mpT+A2O",
+	"zdsNMTWcTcxUBpl9Jk9ecXufd+56qMhOQwsSy7sS2RD6PAn39lnSagiBZ0a3n7Gt15NbSJyJKEj5UMzA",
+	"KmG9Lq+AvDtX/mBqp0qOpfVAw+ri+fPTrbwKfR+q1adhLhNjeVZ9e1t8BQ8w5xzKVI5/gz/mGlF/P3aU",
+	"vl/WcG86cnH2m10Zyk9WXIoIIDjLuMhtZy4wEGFVSdcLrLvjffLET27pT5nBvNPgiTOYmellyVeo/JjN",
+	"UCm3+RJxgUou1VoQ+Y8ivrjg+J4oI/IHMzGh5yTdhAT+sBxovySTyIpxzvAD7AUdGWWSSx6JHUzGicMl",
+	"g7vSQJJmE8m8702v5AcgPxh4osDHa1CcJr5ocguUatKymdYSfcvsxw8GmJjG4f7geyPeg+96IsiaSmWh",
+	"MzY+N8MywzlBgmz5DS5AEolWejPhEtekVHXMRfc9ZDIudLfHPCYP/OA/cP2xm6v/XEyUzV1PBTfGXNAH",
+	"YnBAYtd/lfvpal1hkQtMiwGKuslekoiwFRdZ3aWtY+rTayM42zQ0eefVT+rxUcW8Q0nf1ev9TKjI73jy",
+	"x91RD61x/f6pp2n96quOd6V4aWlI26wsUfXRUssoliiNlyaVyY51IBGPqjg3EVur/JwnDkNtrIXCTTrb",
+	"UwKqffOYoP2h9GIyE/z1I5wOMuImuiJqoq77oK77V0rrY0joo+vgnB5P5+xd1sRDhhU3GsNA9lzU+r/Q",
+	"kl6vPMprLqH7vqdUeD0lKdie/5CspHlTRoSiKw0t182fK2xSod6ZePvbcFAqdd9/CnxIu8I32ISxlpwy",
+	"5d1YeEuGW8A6DOqHestPTVK+fzZQb7a/sV7zHB6VJXQOaPJiPQd9fBxbGMuXfOT53MW1Dyys3Q2IR1Kz",
+	"DawMjnflolAIomxowPwCvf5IpdKKin8bxmJcIVhnPlQh8bH/79xen7QKP0n/d5H+Iwg6lGb21JYOx2vM",
+	"JNMqAUal4MYP0aSDQdbbZ4a394cL3Y1PV9YzMiHfiQR79fH7JEErIId3Uf1qXYwH4syh1sqSFNInvfqm",
+	"RP+ouMJuRX6F3lQA6f7tpcFobnhyQwSRalESkXGGFxnfHneXMsg+8PSZxv1L4YP4xbsoZj6qKP6c+dqT",
+	"09LvwGWGCscDfFP1u6nZ0RaLa5/4y4hEpSAlFroSCBfoNZD+MC/Uj/XKPjdRYPJC3dELtR9TY7dxX9nJ",
+	"JhVCZemcE2gNSrT+NoNrTj/AEm0xw2voYWqxfoYyXu58m1KNbkiSTBAlYznYfOU+NLcwznNEvdkq2N8t",
+	"Vtmmbpbq8j26eR4XQIlpOvucLs89Fqya3XLHwT7N5QmHdkBsx8QQdjXOI9yWfSNXV/OCGnWJ1kQ3PhHD",
+	"0rgbwovcLuLLDV33H0acpVjagGv1bcAgPotb1W14ulTveKmOQ8XDCOj4N/fnvFMstL/uHmY1De1dX7xw",
+	"ja0MXVeGEmRlUi7hut/iHVoKgq/Np6JiTEu6HT08Vd4uSYnPJpK6rvdnvdqWec3rB4GfWzOyfY7uxmE/",
+	"BQHBncme6mFNvGnD51FFBY9Fk9lwqiKTLjMWsMfRzFmUG8xIPndWQDnQf+Y+9ObD2tBYq0WjHGXvAluk",
+	"RLcbmm1QxqsiN2rYkjhvmS2UWnLRsGoCgOKetLd2sZd+k5+LfNTa+CQn3dkvNwjxh7rkvPwFTcOubKFf",
+	"fb2ec0YV1zhyCh7zej6rRlDhbQx3Ij1La8YpTaipU2Eko+UuzDZ1LzMu0DXjt6ZeW23F2G25iOeGT8Q3",
+	"Ed89KSkHkd6eG7AUZFXocsQ93Wn41lgaVOOG8jWvE4SC15gyu3JcFDzTLxQEZbjEma4n4awBrrx3VmAp",
+	"+0pFwR0ZK4Wsb8iUc+3CbfAJd8N7nLLLg1MuFUfZhmTXjyrs+3O6JLIqJk5xSMsTfWg228sSWfrWM82j",
+	"7rURliAZ324Jy0k+31u+xQUZkEYRVIlkVVrR1lr9A4OHN9J0SrZcgMPdDWOARDPixWMqEN3itRUe/ELN",
+	"Cdl6L7FQnst6R0+xqMvDtjvvbn0iySEkqWd/hC6CVxbFK+aLHCXieAK6bJPbHTKqGxpzL4k3bny/2ECU",
+	"SLktcMHZulZxQykCyNhJII2htOVuh265uDbiek4GBel9duJ5DwQmOj84Zu5QXB8rtgsidyxLy+yXZI5N",
+	"BxKghhH6NdAbVdJq114ZjkbmzWzTCHexSsVFb7lXLiCkQF/elCGqFuicYKaMPBL/RrpxcwgmICrL/bTc",
+	"VZClJcmD4IFFh+ovDcg6aP/50TsAYhKzD0/psLQV9kwB0gIy2HraQpDuYZKz7oPsrai678YVBGebVj3Y",
+	"k4szuynoabUhuFCbtn9HztwAOWVB+QjobO9Uds1EAqLoz2lBWKICSwU6ZZ0+oiG3FtqNAYp92NrIvsl9",
+	"ay3rp9xgUPaXhDD/1o6oQVf8lZPzP8/73W5/8qU9oxB8S6R1RdL7YSKGV82twW1Ix5yOhe7wIB0rhJza",
+	"yT8Tagx3PRnC72gIH46Po+iiYjaydW5v7X7KGOWzAh+TkXzd/Re5KZeV8smRVuKlrDe0/L1b86ld8mdC",
+	"T519T/R0GD0NlF9Tsl3gO+UqEhl+Zxo8ptuSix7v1Jl5/hDUSFnt4jWNYzNBcsIUxUWdw1wKfkNzks+g",
+	"d4n+OcOlqry2qgd3fmpBVkQQltUKtQjMTk3qhn09efq+f69VfOP9Ue2BmmXx5TFdV7Di58iLpnC1x2O3",
+	"llHdkeGGTCnKXAvKerjlG8pUzFsvS5I1XPZLIjVzw5mi2ppmNHTzUtPdbqKQ2W6YNsAiPvgn5vc20HtM",
+	"3qGhMpniDhdhDkLnvV7umiDnegjMsgGNBPU8jiwCiq4HiAnwtZRyFrzXe8f/jZLC9EqSmp/oWWOzoeUu",
+	"0URUf/Z387Q+oRyaodblwgmrtho+9r+2aJbd3ok6+jDbH2B/pdfHRU6EA48gqhJMqzWKbGVifeaLxOqw",
+	"zILFwf/0pIPWc2lmR5wVuzTY7ErXVDdmt9uOrdI+GpFvMGh6EE31HBJJhYWq/Z+wpFKQFf3Y04n27/6N",
+	"8WtLLstKyUpguTE/E+wlTU1UN5ATnVhWXeinp/d8Z03n+CPdVlvEqu2yRqHo8hS3qJVYgCkA2Zh+C4Mf",
+	"vfzyxYsXs6MtZfa/Ho8oU2RNRGxlPw5akbymZQrFVytJVBzHw9W8iKzmIdXqCDcaZa2aHW0IzglkC/7b",
+	"/B1XuJif8opF2KZ5OORwtzoN2GXer2hhM5E6qFSD6Pfpioy2E91zO7k7cRu5k9JZ5Cex4VzbBtf8HP27",
+	"PqR/t20cJFGLX9m3WNZlVN1z0IlLAjzlmuyA/4FYXAF8ESMkl42xripthpAz7ScyQ71E5Xb770YrZ+jf",
+	"9d9msPBLp7rDDLg5x+LXbnEnyJfv0sgDibHdiWAB/arwefowYNt1oOzjSbkRmE3S7vj4TnNyCJsSfWmi",
+	"20vJKQk3aIA1IAWq7tQRQblEJlKUdnqF3TBJcxud52GaTn11b0cOViGzf8oZeGFTl2pty9J6tsv4MnbE",
+	"sGIGVfahZobeylgx6/cvCPohEkVjsn6VoDEXvMbz51Sy8FEMVzFWyrhCqyfnLx5Blvsu+YGt77YDaP47",
+	"ou5G8OePSPBP4rJryM+v3+F1RGyuK23088X05n+f6PeJxnvso6+9ErpWlQZ2zxtya8OHT/rWfgy5G8DQ",
+	"L3dv98ndtm/E4rkI3hMvelRe9DnXcRjMne6k1xzbMPK+qHnzwn5W7EVzbTpo2EYF0dDUBFESQbmLbbVe",
+	"vUYrGfgsJqkbz4VvIAhRBToMPxbTrhc8SVnjTQqfcUeCoUjuNUuD2vdBfr05KxeV3AxYlVOAw2gcxXV2",
+	"mLUerqmmomgHUZnICvkcCQjsElc7lvXbJCYS6tZefBxMvRu57ckVuRB8SVIiW0362kREWA7JFuYVJb3M",
+	"pzd4uyGmZooLzCV5J04OZxkpTS+jv3FhM9J6N1/7PDuRMd38FmMoE/QmDLgTZMt18XZBlb58Kxb2dnOT",
+	"BGP/dH6yJsxlmZjPpMstj4BnMczUMSzh5I94FR+Sa/LZcpO6bEMzJ+tuxoC97GHHsvnAfDL9bpCEsp/z",
+	"Wdl25F0cJyJ/QU1X8kRE+y1oD4Wq+6mNcdvBj3I2zzaYMTKkLXb4GfKfxWLFfgzePK1ffLhS3d35xmLk",
+	"E6yfnwC3O9/w+YDi+Tg6oKt/zVQQvkKVbL4sqsJ2Q8tJQW8M8imeCDuIHMYDxR0k59tTWT4Ch8etLB+B",
+	"0HMKxf9c7X+9lNRDmUmeOzyOIUG9QeGZONEmwhviNDpYaEns/2GkllG+/s9WrOjFk95bIylQJ8fqCMPP",
+	"CZ2eEBv/rGXgAzB1v9PY1oTnIshmhAylQagMIz1xbL5/OSq57X45aqXTO2TftpHi1ps8yVdTNZHBDtZ7",
+	"F7COFZE9uYZX2m6MkX4JdCGogpTCaGNhBnt5GIjd4SbviFR/WEFrIpFP1Y1yMK6OIRhQFsaZgOIKRtv+",
+	"c2nfehRuryf7g1l+HJQPNvvoAZzdxiUnNWbomn96cWqfzUefwQMZfNrTjLDziKro8sffHxEtJwvPs7Xw",
+	"WNwZx0wPtu3Y2faZbSyZHSZK2Dkmg81TM9jsQbXh1pooFrVMNU8XhZ4KG54sNKO4YClopo90n5tev0ek",
+	"+TPjUnkbQqdqs/E5EanoFrsg1hhSX9h5H7TrB0wxoc8YJ/cdD9rhmsMrI+1WUQ3+7vNBALQdwdgMtaud",
+	"Mx/U3Fv52/QdD/vcwccdbL1qYuv9y8g9iFrv75Eb5hxAOlMi9e6e8DpKR8Ctuc76GaD2uzdtjoDucqNR",
+	"nm6pMpEAtnONew0ZG7yrHuN/9RkCW6JLaehNRK0HF25dD4qTZo7nbysoa2DVp2x/GmAcsO8m1PoL//Rh",
+	"OBWMnuRU4eSPxaqSS5p09aeqq9eIEqGAkNEdWjbCfo8UX0MIuQ+4sJys3RTXcmf3neZ516RUCa2+prLB",
+	"mli95UmFf2LFDHqxcXDVghRfNsrOk0OXT8yAp1jiYdgX4YXHloPtlwFroW0gqgai3Lmd5I+MsrDHKRB+",
+	"vAg7ALPGIfPxb7IyBQ3m15Tlv/v/9t78l2TLb7Q4UUno/oWRIngb1Ebfi/GN6xzw4dOhfKcW5A+U+UqY",
+	"AKgZqtuImy3rDcenDwF6t2WEO/bzbsj+uSeR5lFyri0VAIb0Y39c4YzZ507yvEtZisdH1q9od/OaGBlb",
+	"8ILEzWgTnT0NOnsw0wCc7SWPu22MzsUL0gT2p7AXWBycYgyfRQCVbT1oMcezuvHixz8qrvAA0RneC4mx",
+	"7lCoyTEeRPV/YPQHxF4zw/M3gQ4BrztBeLdxfgdJi4Hqb0YBTGpecAn50EB9330VXiJ2Pe6/Zr5JdJsY",
+	"W9oa1YeSHUrY41I1Xh5Z90VwNs64+8nVjFruOnOjLd75Lqk4E1xKX2Ek4lFdoP9HBHfTuy5WhK24yCIF",
+	"pq6Imgjrk8hq9hbRx5SS0uAQH1UyA2SYXM4Hy0ejeMiA2/S4knhNBnSEdgzG4mpPT/fY6gZwlpYfx282",
+	"Zmw3aPTerHxiLJ/CuhocwETMBzsIDO010HEMZQtSL74UfMtVT2nKK8VL5L+w+QZSYRXU6ioF1QtsFiCD",
+	"JkJ6M/orqIhleUBXQbqAZVzWK7tSmOWmWdSD4WJzttF1oz5XR709K4cI+pTqk1fcYUOAfQHCRVBQMlzK",
+	"DVd77xLAOm/1A5xz7Qn8CtzQEMmkhc32IuUC/YSLChz7rkWqk0gpy4rK9FU1EU++c6ory7aNXSshJrnd",
+	"7Llf3vFrwpDcYKFvRKJuCWGNjVkaaq7cMXmokFyz+X+bWzjMg6XMzRxPhvXHgDSK4L58jDsAV2rDBf0n",
+	"+cyLI9cCnCcnT3/dNqB7KHxYtbfQ+Nsha2cBapbYCmZJX0f7KNYVeXuaF82TxQgN8/o0huCEJFhkmyQe",
+	"XJnHcd1g5ouCBj1sZ+nebQ5dYvqCMWZkWBJdnJAwSU3LL1ktgQ1a3KLCdkPUQwULtdFhkVDdQnLIuoyt",
+	"1a8oslwIpa0kMUNDUC3Aqm6Z07j8YrvKcLaxQe0YyY1p0Um3MVebOYR915PRKj6a1FFYih450VXwH3fT",
+	"at7CResbQXYC77TipO9js/9W49QZWoYd2GeoWydPS8Q1xE6bTclbW7E62qe5IeFooCuvnPSjQ4wdnoO4",
+	"CyRsdO84k+VDjilJqZdZ8DVlfXqQVmcwsq/Xtoaw6LDF12VFCzWnDOF8S5nTykyPQMzQ27NXp4iab9TO",
+	"NQMUiNb1J0juWp3O6thUJ5jY/G2eA7cwRY6lRMrIk1QiSZhCWCKMlgQLItwToK2TxiggRqKKKVogqhD5",
+	"WFIR8CpBVoLIjR2CfAQ3vtSvAp/RDeFKTMHbpl+Si0Ts+RXA7YFiz+3ob8wZGuR5PNOk29lzchd/AlH6",
+	"6Tgaub55A3ag19llBrxSfQ07bvi1VYHhE0sv4A6hQK6axDOfuIOkViCxQsxaDg1Vh9TLOPzYJLuwkrnu",
+	"eb/lIlIIHNxFIZU922IwnztyaszrxU6LH2n0fG05dYSJGzuhw9maiTfwELPc/tz41qVFhMNl2DYWX9qk",
+	"Sh4tU38JHz3KJWDnehbXwOeM6vacanRMIb3SdmepefK8IDek2GtIyCohCFPIvN22KBR8Ha0A/4av35jR",
+	"HxBH/BzPWf8v+Bog25Co4ZDS8QenNUNKHgvCCgktjG6JuTF5pUzatnElAPeBb01HWUmUizkNBGejJcJt",
+	"zLT+Ct/G4gsaB37/3Kh51o/Hhw7BsUl/dB0xaiTtx3LLmapygNeClF4zXFEh1VxUDJmP241swPSjd2W6",
+	"vMXY1JX+TlsRydGDXmZ+lufMqgDI0kIrOMaqDM/w2OjpPbo/UaNUfVafNVpyrpzg5JUDs/Q84HG13NWe",
+	"RwtYS7MTkLOMfAVmwgwzxpV7Slc1Bpn/swZrNGcQ5YPmrE8MBB5KLvMTJAKKAHjBto8eV3I7BNmnRPFP",
+	"HNEUQ5o0iedkhatCzcFaPLdmeUPzMXHlgtrWSE0zPug4yx2yw/kaMvCaTNLXK3j/29BW/ZDkFp0vQX1u",
+	"L82tTiT4bMQWj6zJk0zThY1/mNt+e+lL0HULwyooxi59nz49l7UcgytHNl6D3zMucm08xqGtO0kzV/Dt",
+	"t3Zlk7gTnL+e/euHn/1Kh29lBFUM32Ba4GVBWrh36s4xhhUpzKP/1K3hSqPDDUi4cT4cZL8wXLfjgF2g",
+	"k86PPoDdu2sI6JuLkoiMM7zI+La5Hij9heRGh5zW7lx4GM3suTKfX9jd7HGsXoKfMyinBFuy8uSa3hCG",
+	"CFtTRpDxPcb9lPDGO3ihPmTCqq2Gdvkx0wuR23x5BEWD1oLIfxRHH2aP69EMQDM+l37i7rvxZBDQXMtV",
+	"7qhvWDQOXq8FWWPV7g4ZiT2YpYqXWX3GCkde3wmjK/QWiMK0kAt0ZpSjLcEMBKtbXBRLjkUOQ1WlsQxZ",
+	"Bz/8RiWQktWoQAlCZbUsqG/HRyUiTLOuPNo/9cK8/PBRQI15ppoSY/T4GC52A44sYlssd4PssxXziqka",
+	"Ve34S0Hwdc5vWbo036yB2rXH3AlCbsl5O4Whv+Mj2Ars6hEdHtejbUN2zw/J0O0Uz9oqZIGrXWFFETsE",
+	"r9blWG4MB0qh2S1Zbji/HiDE+DdjIsTP9cMHOzo7x/NPEA4g6c7E/zSgRqJ91wzlmyQUdEWyXVb47plB",
+	"pFlA8rGgvprkBUF67r5umvYQHrSDpp2jv5vCbWMhj6Plu81PVrZnVI6xRpQIsYUscEyHhHrQWBRLTSSD",
+	"i8DUA04VFJ9AE4RepOntepDCjO+IeoJo8Yl542fez2APlu3vL/n+8s2s0VpS1A200YoWCurIpLESxnoa",
+	"iPlQjSQHiRPN5pFexPok/SKfo5gx9YjslzP0N2YQIKxKFEcvj45vvjz6/YP/oBMFeUPEThnxXpAC17Xt",
+	"0Q+1yndam81cCshf5dHvs+GDvXJqQneotgHuoGFfG1NvZFR4cKe1okurvCTXbF+42yzfeu9ofBJ4PmqO",
+	"b9suLjvysunxHDHiLRZbn3EbJrk1jE12muD5qElwlVOFCFOChkA3P48aqB1JFFukeTJq1KbhNDqmtV+O",
+	"GPTk4swmh9RZnBDx2YCA2oyDZEGEgnaKqKzkpn4SSwgMJtLfmWtzxGS23+Iu2joLLAb1DOHDcZDilVpq",
+	"Du1NHO06TR07RT2r+2TUhBmXyvUXsageNXbW07ieI2Nm8asfUtrN5RSaV8chb9CZJEgiXJKCszWYZPwm",
+	"4M1xu7CRqS4KMEVy5uGokW2CpbUTp7LX/Az65aPfP/z+/w8A5DMGa45HBAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
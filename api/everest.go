@@ -19,12 +19,15 @@ package api
 //go:generate ../bin/oapi-codegen --config=server.cfg.yml  ../docs/spec/openapi.yml
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/deepmap/oapi-codegen/pkg/middleware"
@@ -35,51 +38,179 @@ import (
 	"github.com/percona/percona-everest-backend/cmd/config"
 	"github.com/percona/percona-everest-backend/model"
 	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+	"github.com/percona/percona-everest-backend/pkg/versionservice"
 	"github.com/percona/percona-everest-backend/public"
 )
 
 const (
 	pgStorageName   = "postgres"
 	pgMigrationsDir = "migrations"
+
+	// gzipMinLength is the size a response shall reach to be compressed.
+	gzipMinLength = 1024
+
+	// totalCountHeader is the response header with the total number of items of a paginated list.
+	totalCountHeader = "X-Total-Count"
 )
 
 // EverestServer represents the server struct.
 type EverestServer struct {
 	config         *config.EverestConfig
 	l              *zap.SugaredLogger
+	logLevel       zap.AtomicLevel
 	storage        storage
 	secretsStorage secretsStorage
 	waitGroup      *sync.WaitGroup
 	echo           *echo.Echo
+	// redirectServer redirects the plain HTTP requests to HTTPS if TLS is enabled.
+	redirectServer *http.Server
+	replication    *replicationState
+	versionService *versionservice.Client
+	capacityAlerts *capacityAlertState
+	resourceStatus *resourceStatusState
+	publicStatus   *publicStatusCache
+	summary        *summaryCache
+	clusterStates  *clusterStateCache
+	clusterHealth  *clusterHealthCache
+	// alertRuleSyncRequests makes the alert rule syncer run before its interval elapses.
+	alertRuleSyncRequests chan struct{}
+	// stopBackgroundJobs cancels the context all background jobs are running with.
+	stopBackgroundJobs context.CancelFunc
 }
 
 // NewEverestServer creates and configures everest API.
-func NewEverestServer(c *config.EverestConfig, l *zap.SugaredLogger) (*EverestServer, error) {
+// The log level is changed with the settings API.
+func NewEverestServer(c *config.EverestConfig, l *zap.SugaredLogger, logLevel zap.AtomicLevel) (*EverestServer, error) {
 	e := &EverestServer{
 		config:    c,
 		l:         l,
+		logLevel:  logLevel,
 		echo:      echo.New(),
 		waitGroup: &sync.WaitGroup{},
+
+		versionService: versionservice.New(c.VersionServiceURL),
+		capacityAlerts: &capacityAlertState{severity: make(map[string]string)},
+		resourceStatus: newResourceStatusState(),
+		publicStatus:   &publicStatusCache{},
+		summary:        &summaryCache{},
+		clusterStates:  newClusterStateCache(),
+		clusterHealth:  newClusterHealthCache(),
+
+		alertRuleSyncRequests: make(chan struct{}, 1),
+	}
+	kubernetes.AllowExecCommands(c.KubeconfigExecCommands)
+	kubernetes.SetTransportOptions(kubernetes.TransportOptions{
+		DialTimeout:            c.KubernetesDialTimeout,
+		TLSHandshakeTimeout:    c.KubernetesTLSHandshakeTimeout,
+		ResponseHeaderTimeout:  c.KubernetesResponseTimeout,
+		RequestTimeout:         c.KubernetesRequestTimeout,
+		MaxIdleConnsPerCluster: c.KubernetesMaxIdleConns,
+	})
+	if err := validateAdminConfig(c); err != nil {
+		return e, err
+	}
+	if err := validateTLSConfig(c); err != nil {
+		return e, err
+	}
+	if err := e.initReplication(); err != nil {
+		return e, err
 	}
 	if err := e.initHTTPServer(); err != nil {
 		return e, err
 	}
-	err := e.initEverest()
+	if err := e.initEverest(); err != nil {
+		return e, err
+	}
+	e.startBackgroundJobs()
 
-	return e, err
+	return e, nil
 }
 
 func (e *EverestServer) initEverest() error {
-	db, err := model.NewDatabase(pgStorageName, e.config.DSN, pgMigrationsDir)
+	db, err := newDatabase(e.config)
 	if err != nil {
 		return err
 	}
 	e.storage = db
-	e.secretsStorage = db // so far the db implements both interfaces - the regular storage and the secrets storage
+	s, err := newSecretsStorage(context.Background(), e.config, db)
+	if err != nil {
+		return err
+	}
+	e.secretsStorage = &cachingSecretsStorage{secretsStorage: s}
+	if !e.config.MigrateOnStartup {
+		return checkMigrations(db)
+	}
 	_, err = db.Migrate()
 	return err
 }
 
+// newDatabase opens the Everest database with the connection pool tuned by the config.
+func newDatabase(c *config.EverestConfig) (*model.Database, error) {
+	db, err := model.NewDatabase(pgStorageName, c.DSN, pgMigrationsDir)
+	if err != nil {
+		return nil, err
+	}
+	db.SetPoolOptions(model.PoolOptions{
+		MaxOpenConns:     c.DBMaxOpenConns,
+		MaxIdleConns:     c.DBMaxIdleConns,
+		ConnMaxLifetime:  c.DBConnMaxLifetime,
+		StatementTimeout: c.DBStatementTimeout,
+		Retries:          c.DBRetries,
+		RetryBackoff:     c.DBRetryBackoff,
+	})
+	return db, nil
+}
+
+// startBackgroundJobs starts all jobs which run periodically for the lifetime of the server.
+func (e *EverestServer) startBackgroundJobs() {
+	ctx, cancel := context.WithCancel(context.Background())
+	e.stopBackgroundJobs = cancel
+
+	e.waitGroup.Add(1)
+	go e.runDatabaseEngineCacheRefresher(ctx)
+
+	e.waitGroup.Add(1)
+	go e.runBackupSLOChecker(ctx)
+
+	e.waitGroup.Add(1)
+	go e.runDiagnosticsReverter(ctx)
+
+	e.waitGroup.Add(1)
+	go e.runConfigSyncer(ctx)
+
+	e.waitGroup.Add(1)
+	go e.runReplicator(ctx)
+
+	e.waitGroup.Add(1)
+	go e.runCompatibilityChecker(ctx)
+
+	e.waitGroup.Add(1)
+	go e.runTemporaryAccessCleaner(ctx)
+
+	e.waitGroup.Add(1)
+	go e.runCapacityChecker(ctx)
+
+	e.waitGroup.Add(1)
+	go e.runSTSCredentialsRefresher(ctx)
+
+	e.waitGroup.Add(1)
+	go e.runScheduleTimeZoneSyncer(ctx)
+	e.waitGroup.Add(1)
+	go e.runRetentionEnforcer(ctx)
+	e.waitGroup.Add(1)
+	go e.runBackupVerifier(ctx)
+	e.waitGroup.Add(1)
+	go e.runAlertRuleSyncer(ctx)
+	e.waitGroup.Add(1)
+	go e.runResourceStatusWatcher(ctx)
+	e.waitGroup.Add(1)
+	go e.runMaintenanceExecutor(ctx)
+	e.waitGroup.Add(1)
+	go e.runClusterHealthChecker(ctx)
+	e.waitGroup.Add(1)
+	go e.runTrashPurger(ctx)
+}
+
 func (e *EverestServer) initKubeClient(ctx context.Context, kubernetesID string) (*model.KubernetesCluster, *kubernetes.Kubernetes, int, error) {
 	k, err := e.storage.GetKubernetesCluster(ctx, kubernetesID)
 	if err != nil {
@@ -105,6 +236,40 @@ func (e *EverestServer) initHTTPServer() error {
 	if err != nil {
 		return err
 	}
+	if !e.config.Headless {
+		if err := e.registerUIRoutes(); err != nil {
+			return err
+		}
+	}
+	// Log all requests
+	e.echo.Use(echomiddleware.Logger())
+	e.echo.Pre(echomiddleware.RemoveTrailingSlash())
+
+	basePath, err := swagger.Servers.BasePath()
+	if err != nil {
+		return errors.Join(err, errors.New("could not get base path"))
+	}
+
+	// Use our validation middleware to check all requests against the OpenAPI schema.
+	apiGroup := e.echo.Group(basePath)
+	apiGroup.Use(keepUncompressedWriter)
+	apiGroup.Use(echomiddleware.GzipWithConfig(echomiddleware.GzipConfig{MinLength: gzipMinLength}))
+	apiGroup.Use(e.authenticateAPIToken)
+	apiGroup.Use(middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{
+		SilenceServersWarning: true,
+	}))
+	apiGroup.Use(e.scopeDatabaseClusterNamespace)
+	apiGroup.Use(e.authorizeProjectAccess)
+	apiGroup.Use(e.rejectWritesOnStandby)
+	apiGroup.Use(e.requestDeadline)
+	apiGroup.Use(e.requestSecretCache)
+	RegisterHandlers(apiGroup, e)
+
+	return nil
+}
+
+// registerUIRoutes serves the embedded FE app.
+func (e *EverestServer) registerUIRoutes() error {
 	fsys, err := fs.Sub(public.Static, "dist")
 	if err != nil {
 		return errors.Join(err, errors.New("error reading filesystem"))
@@ -122,27 +287,15 @@ func (e *EverestServer) initHTTPServer() error {
 	e.echo.GET("/favicon.ico", echo.WrapHandler(staticFilesHandler))
 	e.echo.GET("/assets-manifest.json", echo.WrapHandler(staticFilesHandler))
 	e.echo.GET("/static/*", echo.WrapHandler(staticFilesHandler))
-	// Log all requests
-	e.echo.Use(echomiddleware.Logger())
-	e.echo.Pre(echomiddleware.RemoveTrailingSlash())
-
-	basePath, err := swagger.Servers.BasePath()
-	if err != nil {
-		return errors.Join(err, errors.New("could not get base path"))
-	}
-
-	// Use our validation middleware to check all requests against the OpenAPI schema.
-	apiGroup := e.echo.Group(basePath)
-	apiGroup.Use(middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{
-		SilenceServersWarning: true,
-	}))
-	RegisterHandlers(apiGroup, e)
 
 	return nil
 }
 
 // Start starts everest server.
 func (e *EverestServer) Start() error {
+	if tlsEnabled(e.config) {
+		return e.startTLS()
+	}
 	return e.echo.Start(fmt.Sprintf("0.0.0.0:%d", e.config.HTTPPort))
 }
 
@@ -154,8 +307,16 @@ func (e *EverestServer) Shutdown(ctx context.Context) error {
 	} else {
 		e.l.Info("http server shut down")
 	}
+	if e.redirectServer != nil {
+		if err := e.redirectServer.Shutdown(ctx); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not shut down HTTPS redirect server")))
+		}
+	}
 
 	e.l.Info("Shutting down Everest")
+	if e.stopBackgroundJobs != nil {
+		e.stopBackgroundJobs()
+	}
 	e.waitGroup.Wait()
 
 	e.waitGroup.Add(1)
@@ -194,6 +355,36 @@ func (e *EverestServer) Shutdown(ctx context.Context) error {
 	}
 }
 
+// requestDeadline is a middleware which sets the configured deadline on the context of the request
+// so that the storage and secrets calls do not outlive the request.
+func (e *EverestServer) requestDeadline(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		if e.config.RequestTimeout <= 0 {
+			return next(ctx)
+		}
+
+		c, cancel := context.WithTimeout(ctx.Request().Context(), e.config.RequestTimeout)
+		defer cancel()
+		ctx.SetRequest(ctx.Request().WithContext(c))
+		return next(ctx)
+	}
+}
+
+// replaceRequestBody replaces the body of the request to be proxied with the JSON encoded object.
+func replaceRequestBody(req *http.Request, obj interface{}) error {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(b)), nil
+	}
+	req.ContentLength = int64(len(b))
+	req.Header.Set(echo.HeaderContentLength, strconv.Itoa(len(b)))
+	return nil
+}
+
 func (e *EverestServer) getBodyFromContext(ctx echo.Context, into any) error {
 	// GetBody creates a copy of the body to avoid "spoiling" the request before proxing
 	reader, err := ctx.Request().GetBody()
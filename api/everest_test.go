@@ -15,9 +15,23 @@
 package api
 
 import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+	"github.com/percona/percona-everest-backend/model"
 )
 
 func TestBuildProxiedUrl(t *testing.T) {
@@ -64,3 +78,207 @@ func TestBuildProxiedUrl(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveImpersonationHeaders(t *testing.T) {
+	t.Parallel()
+
+	h := http.Header{}
+	h.Set("Impersonate-User", "admin")
+	h.Add("Impersonate-Group", "system:masters")
+	h.Set("Impersonate-Extra-Scopes", "all")
+	h.Set("Content-Type", "application/json")
+
+	removeImpersonationHeaders(h)
+	require.Equal(t, http.Header{"Content-Type": []string{"application/json"}}, h)
+}
+
+// kubeconfigStorage returns the Kubernetes cluster the kubeconfig is stored for.
+type kubeconfigStorage struct {
+	storage
+}
+
+func (kubeconfigStorage) GetKubernetesCluster(_ context.Context, id string) (*model.KubernetesCluster, error) {
+	return &model.KubernetesCluster{ID: id, Name: "test", Namespace: "percona-everest"}, nil
+}
+
+func TestProxyKubernetesCredentials(t *testing.T) {
+	t.Parallel()
+
+	header := make(chan http.Header, 1)
+	kube := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header <- r.Header.Clone()
+		w.Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		_, _ = w.Write([]byte(`{"items":[]}`))
+	}))
+	defer kube.Close()
+
+	kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+    insecure-skip-tls-verify: true
+users:
+- name: test
+  user:
+    token: kube-token
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+`, kube.URL)
+	e := &EverestServer{
+		config:  &config.EverestConfig{},
+		l:       zap.NewNop().Sugar(),
+		storage: kubeconfigStorage{},
+		secretsStorage: &countingSecretsStorage{values: map[string]string{
+			"123": base64.StdEncoding.EncodeToString([]byte(kubeconfig)),
+		}},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/kubernetes/123/database-clusters", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer evt_everest-token")
+	req.Header.Set("Cookie", "session=evs_everest-session")
+	rec := httptest.NewRecorder()
+	require.NoError(t, e.proxyKubernetes(echo.New().NewContext(req, rec), "123", ""))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	h := <-header
+	assert.Equal(t, "Bearer kube-token", h.Get(echo.HeaderAuthorization))
+	assert.Empty(t, h.Get("Cookie"))
+}
+
+// slowStorage simulates a hung database which only returns once the context is done.
+type slowStorage struct {
+	storage
+}
+
+func (slowStorage) ListBackupStorages(ctx context.Context, _ model.ListBackupStoragesParams) ([]model.BackupStorage, int, error) {
+	<-ctx.Done()
+	return nil, 0, ctx.Err()
+}
+
+func (slowStorage) GetKubernetesCluster(_ context.Context, id string) (*model.KubernetesCluster, error) {
+	return &model.KubernetesCluster{ID: id, Namespace: "percona-everest"}, nil
+}
+
+// slowSecretsStorage simulates a hung secrets storage which only returns once the context is done.
+type slowSecretsStorage struct {
+	secretsStorage
+}
+
+func (slowSecretsStorage) GetSecret(ctx context.Context, _ string) (string, error) {
+	<-ctx.Done()
+	return "", ctx.Err()
+}
+
+func TestRequestDeadline(t *testing.T) {
+	t.Parallel()
+
+	e := &EverestServer{
+		config:         &config.EverestConfig{RequestTimeout: 50 * time.Millisecond},
+		l:              zap.NewNop().Sugar(),
+		storage:        slowStorage{},
+		secretsStorage: slowSecretsStorage{},
+	}
+
+	cases := []struct {
+		name    string
+		handler echo.HandlerFunc
+		code    int
+	}{
+		{
+			name: "storage",
+			handler: func(ctx echo.Context) error {
+				return e.ListBackupStorages(ctx, ListBackupStoragesParams{})
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "secrets storage",
+			handler: func(ctx echo.Context) error {
+				return e.GetKubernetesClusterResources(ctx, "123")
+			},
+			code: http.StatusInternalServerError,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+			start := time.Now()
+			err := e.requestDeadline(tc.handler)(ctx)
+			require.NoError(t, err)
+			assert.Less(t, time.Since(start), 5*time.Second)
+			assert.Equal(t, tc.code, rec.Code)
+		})
+	}
+}
+
+func TestETagMatches(t *testing.T) {
+	t.Parallel()
+
+	tag := resourceVersionETag("1234")
+	assert.Equal(t, `"1234"`, tag)
+	assert.True(t, etagMatches(`"1234"`, tag))
+	assert.True(t, etagMatches(`"1200", W/"1234"`, tag))
+	assert.True(t, etagMatches("*", tag))
+	assert.False(t, etagMatches(`"1200"`, tag))
+	assert.False(t, etagMatches("", tag))
+}
+
+func TestTaggedResponse(t *testing.T) {
+	t.Parallel()
+
+	body := `{"apiVersion":"everest.percona.com/v1alpha1","kind":"DatabaseCluster","metadata":{"name":"db","resourceVersion":"1234"}}`
+	respond := func(method, ifNoneMatch string) *http.Response {
+		req := httptest.NewRequest(method, "/apis/everest.percona.com/v1alpha1/namespaces/ns/databaseclusters/db", nil)
+		req.Header.Set("If-None-Match", ifNoneMatch)
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}
+		require.NoError(t, everestResponseModifier(zap.NewNop().Sugar(), nil, true, func() {})(resp))
+		return resp
+	}
+
+	resp := respond(http.MethodGet, `"1200"`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `"1234"`, resp.Header.Get("ETag"))
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(b))
+
+	resp = respond(http.MethodGet, `"1234"`)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+	assert.Equal(t, `"1234"`, resp.Header.Get("ETag"))
+
+	resp = respond(http.MethodPut, `"1234"`)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestEncodedResponse(t *testing.T) {
+	t.Parallel()
+
+	body := "compressed"
+	resp := &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header:     http.Header{echo.HeaderContentEncoding: []string{"gzip"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    httptest.NewRequest(http.MethodGet, "/apis/everest.percona.com/v1alpha1/namespaces/ns/databaseclusters", nil),
+	}
+	var passed bool
+	require.NoError(t, everestResponseModifier(zap.NewNop().Sugar(), nil, false, func() { passed = true })(resp))
+	assert.True(t, passed)
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(b))
+}
@@ -0,0 +1,195 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+//nolint:gochecknoglobals
+var maxConnectionsSettings = map[everestv1alpha1.EngineType]*regexp.Regexp{
+	everestv1alpha1.DatabaseEnginePXC:        regexp.MustCompile(`(?m)^\s*max[_-]connections\s*=\s*(\d+)\s*$`),
+	everestv1alpha1.DatabaseEnginePostgresql: regexp.MustCompile(`(?m)^\s*max_connections\s*[=:]?\s*'?(\d+)'?\s*$`),
+	everestv1alpha1.DatabaseEnginePSMDB:      regexp.MustCompile(`(?m)^\s*maxIncomingConnections\s*:\s*(\d+)\s*$`),
+}
+
+// ListKubernetesClusterGuardrails lists the guardrails of a kubernetes cluster.
+func (e *EverestServer) ListKubernetesClusterGuardrails(ctx echo.Context, kubernetesID string) error {
+	guardrails, err := e.storage.ListGuardrails(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list guardrails")})
+	}
+
+	result := make(GuardrailList, 0, len(guardrails))
+	for _, g := range guardrails {
+		g := g
+		res, err := guardrailToAPIJson(&g)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list guardrails")})
+		}
+		result = append(result, *res)
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// SetKubernetesClusterGuardrail sets the guardrail of an engine type on a kubernetes cluster.
+func (e *EverestServer) SetKubernetesClusterGuardrail(ctx echo.Context, kubernetesID string, engineType string) error {
+	var params Guardrail
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := validateGuardrail(engineType, params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	if _, err := e.storage.GetKubernetesCluster(ctx.Request().Context(), kubernetesID); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not find Kubernetes cluster")})
+	}
+
+	storageClasses, err := json.Marshal(pointer.Get(params.AllowedStorageClasses))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not encode allowed storage classes")})
+	}
+	g := &model.Guardrail{
+		KubernetesID:          kubernetesID,
+		EngineType:            engineType,
+		MaxReplicas:           pointer.GetInt(params.MaxReplicas),
+		MaxConnections:        pointer.GetInt(params.MaxConnections),
+		AllowedStorageClasses: string(storageClasses),
+	}
+	if err := e.storage.SaveGuardrail(ctx.Request().Context(), g); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save guardrail")})
+	}
+
+	params.EngineType = &engineType
+	return ctx.JSON(http.StatusOK, params)
+}
+
+// DeleteKubernetesClusterGuardrail deletes the guardrail of an engine type on a kubernetes cluster.
+func (e *EverestServer) DeleteKubernetesClusterGuardrail(ctx echo.Context, kubernetesID string, engineType string) error {
+	if err := e.storage.DeleteGuardrail(ctx.Request().Context(), kubernetesID, engineType); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete guardrail")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func validateGuardrail(engineType string, g Guardrail) error {
+	if _, ok := operatorEngine[everestv1alpha1.EngineType(engineType)]; !ok {
+		return errors.New("unsupported database engine")
+	}
+	if g.MaxReplicas != nil && *g.MaxReplicas < 1 {
+		return errors.New("maxReplicas shall be positive")
+	}
+	if g.MaxConnections != nil && *g.MaxConnections < 1 {
+		return errors.New("maxConnections shall be positive")
+	}
+	return nil
+}
+
+// validateGuardrails checks the database cluster against the guardrail of its engine type if any.
+func (e *EverestServer) validateGuardrails(ctx context.Context, kubernetesID string, dbc *DatabaseCluster) error {
+	g, err := e.storage.GetGuardrail(ctx, kubernetesID, dbc.Spec.Engine.Type)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		e.l.Error(err)
+		return errors.New("could not get guardrail")
+	}
+	return checkGuardrail(g, dbc)
+}
+
+func checkGuardrail(g *model.Guardrail, dbc *DatabaseCluster) error {
+	engine := dbc.Spec.Engine
+	if g.MaxReplicas != 0 && engine.Replicas != nil && int(*engine.Replicas) > g.MaxReplicas {
+		return fmt.Errorf("%s database clusters may have at most %d replicas", engine.Type, g.MaxReplicas)
+	}
+
+	var storageClasses []string
+	if err := json.Unmarshal([]byte(g.AllowedStorageClasses), &storageClasses); err != nil {
+		return errors.Join(err, errors.New("could not decode allowed storage classes"))
+	}
+	if len(storageClasses) != 0 && !slices.Contains(storageClasses, pointer.GetString(engine.Storage.Class)) {
+		return fmt.Errorf("%s database clusters may use only the storage classes %v", engine.Type, storageClasses)
+	}
+
+	if g.MaxConnections != 0 && engine.Config != nil {
+		conns, ok, err := configuredMaxConnections(everestv1alpha1.EngineType(engine.Type), *engine.Config)
+		if err != nil {
+			return err
+		}
+		if ok && conns > g.MaxConnections {
+			return fmt.Errorf("%s database clusters may allow at most %d connections", engine.Type, g.MaxConnections)
+		}
+	}
+	return nil
+}
+
+// configuredMaxConnections returns the maximum number of connections set in the engine configuration.
+func configuredMaxConnections(engineType everestv1alpha1.EngineType, config string) (int, bool, error) {
+	re, ok := maxConnectionsSettings[engineType]
+	if !ok {
+		return 0, false, nil
+	}
+	m := re.FindStringSubmatch(config)
+	if m == nil {
+		return 0, false, nil
+	}
+	conns, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false, errors.Join(err, errors.New("could not parse the maximum number of connections"))
+	}
+	return conns, true, nil
+}
+
+func guardrailToAPIJson(g *model.Guardrail) (*Guardrail, error) {
+	var storageClasses []string
+	if err := json.Unmarshal([]byte(g.AllowedStorageClasses), &storageClasses); err != nil {
+		return nil, err
+	}
+	res := &Guardrail{
+		EngineType:            &g.EngineType,
+		AllowedStorageClasses: &storageClasses,
+	}
+	if g.MaxReplicas != 0 {
+		res.MaxReplicas = &g.MaxReplicas
+	}
+	if g.MaxConnections != 0 {
+		res.MaxConnections = &g.MaxConnections
+	}
+	return res, nil
+}
@@ -0,0 +1,113 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestCheckGuardrail(t *testing.T) {
+	t.Parallel()
+
+	guardrail := &model.Guardrail{
+		EngineType:            "pxc",
+		MaxReplicas:           3,
+		MaxConnections:        500,
+		AllowedStorageClasses: `["standard"]`,
+	}
+
+	type tCase struct {
+		name    string
+		cluster []byte
+		err     string
+	}
+
+	cases := []tCase{
+		{
+			name: "within the limits",
+			cluster: []byte(`{"spec": {"engine": {"type": "pxc", "replicas": 3,
+				"storage": {"size": "10G", "class": "standard"}, "config": "[mysqld]\nmax_connections = 200\n"}}}`),
+		},
+		{
+			name:    "too many replicas",
+			cluster: []byte(`{"spec": {"engine": {"type": "pxc", "replicas": 5, "storage": {"size": "10G", "class": "standard"}}}}`),
+			err:     "pxc database clusters may have at most 3 replicas",
+		},
+		{
+			name:    "default storage class",
+			cluster: []byte(`{"spec": {"engine": {"type": "pxc", "replicas": 1, "storage": {"size": "10G"}}}}`),
+			err:     "pxc database clusters may use only the storage classes [standard]",
+		},
+		{
+			name: "too many connections",
+			cluster: []byte(`{"spec": {"engine": {"type": "pxc", "replicas": 1,
+				"storage": {"size": "10G", "class": "standard"}, "config": "[mysqld]\nmax-connections=5000"}}}`),
+			err: "pxc database clusters may allow at most 500 connections",
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dbc := &DatabaseCluster{}
+			require.NoError(t, json.Unmarshal(tc.cluster, dbc))
+
+			err := checkGuardrail(guardrail, dbc)
+			if tc.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestConfiguredMaxConnections(t *testing.T) {
+	t.Parallel()
+
+	type tCase struct {
+		engine everestv1alpha1.EngineType
+		config string
+		conns  int
+		ok     bool
+	}
+
+	cases := []tCase{
+		{engine: everestv1alpha1.DatabaseEnginePXC, config: "[mysqld]\nmax_connections=250\n", conns: 250, ok: true},
+		{engine: everestv1alpha1.DatabaseEnginePXC, config: "[mysqld]\nmax_allowed_packet=64M\n"},
+		{engine: everestv1alpha1.DatabaseEnginePostgresql, config: "shared_buffers = 128MB\nmax_connections = '300'\n", conns: 300, ok: true},
+		{engine: everestv1alpha1.DatabaseEnginePSMDB, config: "net:\n  maxIncomingConnections: 1000\n", conns: 1000, ok: true},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(string(tc.engine), func(t *testing.T) {
+			t.Parallel()
+			conns, ok, err := configuredMaxConnections(tc.engine, tc.config)
+			require.NoError(t, err)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.conns, conns)
+		})
+	}
+}
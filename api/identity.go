@@ -0,0 +1,67 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// identityContextKey is the key the identity of the authenticated user is stored under in the echo context.
+const identityContextKey = "everest.identity"
+
+// userIdentity is the identity of the Everest user who sent the request.
+type userIdentity struct {
+	Username string
+	Groups   []string
+	// ProjectScoped limits the user to the resources of the projects the user or the groups are members of.
+	ProjectScoped bool
+}
+
+// setUserIdentity stores the identity of the authenticated user in the echo context.
+// It shall be called by the authentication middleware.
+func setUserIdentity(ctx echo.Context, id userIdentity) {
+	ctx.Set(identityContextKey, id)
+}
+
+// userIdentityFrom returns the identity of the authenticated user if there is one.
+func userIdentityFrom(ctx echo.Context) (userIdentity, bool) {
+	id, ok := ctx.Get(identityContextKey).(userIdentity)
+	if !ok || id.Username == "" {
+		return userIdentity{}, false
+	}
+	return id, true
+}
+
+// creatorFrom returns the username of the user who makes the request to record as the creator
+// of a resource. It is empty if the user is not known.
+func creatorFrom(ctx echo.Context) string {
+	id, _ := userIdentityFrom(ctx)
+	return id.Username
+}
+
+// removeImpersonationHeaders removes the Kubernetes impersonation headers sent by the client
+// so that the client cannot act as another user with the credentials of the kubeconfig.
+func removeImpersonationHeaders(h http.Header) {
+	for name := range h {
+		if strings.HasPrefix(http.CanonicalHeaderKey(name), "Impersonate-") {
+			h.Del(name)
+		}
+	}
+}
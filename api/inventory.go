@@ -0,0 +1,210 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const (
+	inventoryFormatJSON = "json"
+	inventoryFormatCSV  = "csv"
+)
+
+//nolint:gochecknoglobals
+var inventoryCSVHeader = []string{
+	"kubernetes_id", "kubernetes_name", "database_cluster", "engine_type", "engine_version",
+	"operator_version", "component", "container", "image", "version", "estimated_monthly_cost",
+}
+
+// GetInventory lists the component images and versions of the database clusters on all the kubernetes clusters.
+func (e *EverestServer) GetInventory(ctx echo.Context, params GetInventoryParams) error {
+	format := pointer.GetString(params.Format)
+	if format == "" {
+		format = inventoryFormatJSON
+	}
+	if format != inventoryFormatJSON && format != inventoryFormatCSV {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("format shall be either %s or %s", inventoryFormatJSON, inventoryFormatCSV)),
+		})
+	}
+
+	selector, err := parseLabelSelector(params.LabelSelector)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	clusters, err := e.storage.ListKubernetesClusters(c)
+	if err == nil {
+		clusters, err = filterKubernetesClusters(clusters, selector)
+	}
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list Kubernetes clusters")})
+	}
+	pricing, err := e.storage.GetPricing(c)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the prices")})
+	}
+
+	inventory := Inventory{
+		DatabaseClusters:    []InventoryDatabaseCluster{},
+		UnavailableClusters: []string{},
+		UnreachableClusters: []UnreachableCluster{},
+	}
+	for _, k := range clusters {
+		k := k
+		var prices *clusterPrices
+		if pricing != nil {
+			if prices, err = pricesFor(pricing, &k); err != nil {
+				e.l.Error(err)
+				return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the prices")})
+			}
+		}
+		// The inventory of the other clusters is still useful if a cluster is unreachable.
+		dbs, unreachable := e.clusterState(c, k, "inventory", func(ctx context.Context) (interface{}, error) {
+			return e.clusterInventory(ctx, k, prices)
+		})
+		if unreachable != nil {
+			inventory.UnavailableClusters = append(inventory.UnavailableClusters, k.ID)
+			inventory.UnreachableClusters = append(inventory.UnreachableClusters, *unreachable)
+			inventory.Partial = true
+		}
+		if dbs != nil {
+			inventory.DatabaseClusters = append(inventory.DatabaseClusters, dbs.([]InventoryDatabaseCluster)...)
+		}
+	}
+
+	if format == inventoryFormatJSON {
+		return ctx.JSON(http.StatusOK, inventory)
+	}
+	setPartialResultHeaders(ctx, inventory.UnreachableClusters)
+	b, err := inventoryCSV(inventory)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not export the inventory")})
+	}
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="inventory.csv"`)
+	return ctx.Blob(http.StatusOK, "text/csv", b)
+}
+
+func (e *EverestServer) clusterInventory(
+	ctx context.Context, k model.KubernetesCluster, prices *clusterPrices,
+) ([]InventoryDatabaseCluster, error) {
+	kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+	if err != nil {
+		return nil, err
+	}
+	dbs, err := kubeClient.ListDatabaseClusters(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list database clusters"))
+	}
+
+	operatorVersions := make(map[string]string)
+	res := make([]InventoryDatabaseCluster, 0, len(dbs.Items))
+	for _, db := range dbs.Items {
+		db := db
+		engineType := string(db.Spec.Engine.Type)
+		operatorVersion, ok := operatorVersions[engineType]
+		if !ok {
+			op, err := kubeClient.GetOperator(ctx, engineType)
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return nil, errors.Join(err, fmt.Errorf("could not get %s operator", engineType))
+			}
+			if op != nil {
+				operatorVersion = op.Version
+			}
+			operatorVersions[engineType] = operatorVersion
+		}
+
+		images, err := kubeClient.DatabaseClusterImages(ctx, &db)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("could not get the images of database cluster %s", db.Name))
+		}
+		components := make([]InventoryComponent, 0, len(images))
+		for _, img := range images {
+			components = append(components, InventoryComponent{
+				Kind:      img.Kind,
+				Container: img.Container,
+				Image:     img.Image,
+				Version:   img.Version,
+			})
+		}
+
+		item := InventoryDatabaseCluster{
+			KubernetesId:    k.ID,
+			KubernetesName:  k.Name,
+			Name:            db.Name,
+			EngineType:      engineType,
+			EngineVersion:   db.Spec.Engine.Version,
+			OperatorVersion: operatorVersion,
+			Components:      components,
+		}
+		if prices != nil {
+			item.EstimatedMonthlyCost = pointer.ToFloat64(estimateCost(db.Spec, *prices).Total)
+		}
+		res = append(res, item)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res, nil
+}
+
+// inventoryCSV exports the inventory with a row per component image.
+func inventoryCSV(inventory Inventory) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(inventoryCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, db := range inventory.DatabaseClusters {
+		var cost string
+		if db.EstimatedMonthlyCost != nil {
+			cost = strconv.FormatFloat(*db.EstimatedMonthlyCost, 'f', 2, 64)
+		}
+		for _, c := range db.Components {
+			row := []string{
+				db.KubernetesId, db.KubernetesName, db.Name, db.EngineType, db.EngineVersion,
+				db.OperatorVersion, c.Kind, c.Container, c.Image, c.Version, cost,
+			}
+			if err := w.Write(row); err != nil {
+				return nil, err
+			}
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -21,6 +21,8 @@ import (
 
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
 )
 
 // configGetter stores kubeconfig string to convert it to the final object.
@@ -43,6 +45,9 @@ func (g *configGetter) loadFromString() (*clientcmdapi.Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := kubernetes.ValidateAuth(config); err != nil {
+		return nil, err
+	}
 
 	if config.AuthInfos == nil {
 		config.AuthInfos = make(map[string]*clientcmdapi.AuthInfo)
@@ -22,11 +22,11 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/AlekSi/pointer"
 	"github.com/jinzhu/gorm"
 	"github.com/labstack/echo/v4"
-	"github.com/lib/pq"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/clientcmd"
@@ -36,21 +36,25 @@ import (
 )
 
 // ListKubernetesClusters returns list of k8s clusters.
-func (e *EverestServer) ListKubernetesClusters(ctx echo.Context) error {
+func (e *EverestServer) ListKubernetesClusters(ctx echo.Context, params ListKubernetesClustersParams) error {
+	selector, err := parseLabelSelector(params.LabelSelector)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
 	list, err := e.storage.ListKubernetesClusters(ctx.Request().Context())
 	if err != nil {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not list Kubernetes clusters")})
 	}
+	list, err = filterKubernetesClusters(list, selector)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not filter Kubernetes clusters")})
+	}
 
 	result := make([]KubernetesCluster, 0, len(list))
 	for _, k := range list {
-		result = append(result, KubernetesCluster{
-			Id:        k.ID,
-			Name:      k.Name,
-			Namespace: k.Namespace,
-			Uid:       k.UID,
-		})
+		result = append(result, kubernetesClusterToAPIJson(&k))
 	}
 
 	return ctx.JSON(http.StatusOK, result)
@@ -58,49 +62,51 @@ func (e *EverestServer) ListKubernetesClusters(ctx echo.Context) error {
 
 // RegisterKubernetesCluster registers a k8s cluster in Everest server.
 func (e *EverestServer) RegisterKubernetesCluster(ctx echo.Context) error {
-	list, err := e.storage.ListKubernetesClusters(ctx.Request().Context())
-	if err != nil {
-		e.l.Error(err)
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not list Kubernetes clusters")})
-	}
-	if len(list) != 0 {
-		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Everest does not support multiple kubernetes clusters right now. Please delete the existing cluster before registering a new one")})
-	}
 	var params CreateKubernetesClusterParams
 	if err := ctx.Bind(&params); err != nil {
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
 	}
-	c := ctx.Request().Context()
-
-	_, err = clientcmd.BuildConfigFromKubeconfigGetter("", newConfigGetter(params.Kubeconfig).loadFromString)
+	if pointer.GetString(params.Namespace) == "" {
+		params.Namespace = pointer.ToString(e.config.DefaultNamespace)
+	}
+	if err := setInClusterKubeconfig(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	labels, err := encodeKubernetesClusterLabels(pointer.Get(params.Labels))
 	if err != nil {
-		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString("Could not build kubeconfig"),
-		})
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	c := ctx.Request().Context()
+	if pointer.GetString(params.DefaultMonitoringInstanceName) == "" {
+		params.DefaultMonitoringInstanceName = nil
+	} else if code, err := e.checkMonitoringInstanceExists(c, *params.DefaultMonitoringInstanceName); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 	}
 
-	ns, err := e.getNamespace(ctx.Request().Context(), params)
+	ns, err := e.validateKubeconfig(c, params.Kubeconfig, *params.Namespace, pointer.GetBool(params.SkipOperatorCheck))
 	if err != nil {
 		e.l.Error(err)
-		return ctx.JSON(http.StatusInternalServerError, Error{
-			Message: pointer.ToString(err.Error()),
-		})
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if code, err := e.checkNamespaceNotRegistered(c, string(ns.UID)); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 	}
 
 	k, err := e.storage.CreateKubernetesCluster(c, model.CreateKubernetesClusterParams{
 		Name:      params.Name,
 		Namespace: params.Namespace,
 		UID:       string(ns.UID),
+
+		DefaultMonitoringInstanceName: params.DefaultMonitoringInstanceName,
+		InCluster:                     pointer.GetBool(params.InCluster),
+		Labels:                        labels,
+		CreatedBy:                     creatorFrom(ctx),
 	})
 	if err != nil {
-		var pgErr *pq.Error
-		if errors.As(err, &pgErr) {
-			if pgErr.Code.Name() == pgErrUniqueViolation {
-				return ctx.JSON(http.StatusBadRequest, Error{
-					Message: pointer.ToString("Kubernetes cluster with the same name already exists. " + pgErr.Detail),
-				})
-			}
+		if detail, ok := model.UniqueViolation(err); ok {
+			return ctx.JSON(http.StatusBadRequest, Error{
+				Message: pointer.ToString("Kubernetes cluster with the same name already exists. " + detail),
+			})
 		}
 		e.l.Error(err)
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not create Kubernetes cluster")})
@@ -112,13 +118,46 @@ func (e *EverestServer) RegisterKubernetesCluster(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not store kubeconfig in secrets storage")})
 	}
 
+	// The operator may be installed later by bootstrapping the cluster, so incompatibility is not an error here.
+	_, kubeClient, _, err := e.initKubeClient(c, k.ID)
+	if err == nil {
+		err = e.checkCompatibility(c, k, kubeClient)
+	}
+	if err != nil {
+		e.l.Error(err)
+	}
+
 	result := KubernetesCluster{
-		Id:   k.ID,
-		Name: k.Name,
+		Id:                            k.ID,
+		Name:                          k.Name,
+		Compatibility:                 compatibilityToAPIJson(k),
+		DefaultMonitoringInstanceName: k.DefaultMonitoringInstanceName,
+		InCluster:                     pointer.ToBool(k.InCluster),
+		Labels:                        params.Labels,
+		Version:                       pointer.ToInt64(k.Version),
+		CreatedAt:                     &k.CreatedAt,
+		UpdatedAt:                     &k.UpdatedAt,
+		CreatedBy:                     pointer.ToStringOrNil(k.CreatedBy),
 	}
 	return ctx.JSON(http.StatusOK, result)
 }
 
+// checkNamespaceNotRegistered returns an error if the namespace with the given UID is registered
+// as a Kubernetes cluster already. Both would manage the same resources otherwise.
+func (e *EverestServer) checkNamespaceNotRegistered(ctx context.Context, uid string) (int, error) {
+	list, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not list Kubernetes clusters")
+	}
+	for _, k := range list {
+		if uid != "" && k.UID == uid {
+			return http.StatusBadRequest, fmt.Errorf("the namespace is already registered as Kubernetes cluster %s", k.Name)
+		}
+	}
+	return 0, nil
+}
+
 // GetKubernetesCluster Get the specified Kubernetes cluster.
 func (e *EverestServer) GetKubernetesCluster(ctx echo.Context, kubernetesID string) error {
 	k, err := e.storage.GetKubernetesCluster(ctx.Request().Context(), kubernetesID)
@@ -126,13 +165,154 @@ func (e *EverestServer) GetKubernetesCluster(ctx echo.Context, kubernetesID stri
 		e.l.Error(err)
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not find Kubernetes cluster")})
 	}
-	result := KubernetesCluster{
-		Id:        k.ID,
-		Name:      k.Name,
-		Namespace: k.Namespace,
-		Uid:       k.UID,
+	ctx.Response().Header().Set("ETag", versionETag(k.Version))
+	return ctx.JSON(http.StatusOK, kubernetesClusterToAPIJson(k))
+}
+
+// UpdateKubernetesCluster updates the settings of the specified Kubernetes cluster.
+func (e *EverestServer) UpdateKubernetesCluster(ctx echo.Context, kubernetesID string) error {
+	var params UpdateKubernetesClusterParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
 	}
-	return ctx.JSON(http.StatusOK, result)
+
+	c := ctx.Request().Context()
+	k, err := e.storage.GetKubernetesCluster(c, kubernetesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find Kubernetes cluster")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get Kubernetes cluster")})
+	}
+	version, code, err := checkVersion(ctx, "kubernetes cluster", params.Version, k.Version)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	update := model.UpdateKubernetesClusterParams{
+		DefaultMonitoringInstanceName: params.DefaultMonitoringInstanceName,
+		Version:                       version,
+	}
+	if name := pointer.GetString(params.DefaultMonitoringInstanceName); name != "" {
+		if code, err := e.checkMonitoringInstanceExists(c, name); err != nil {
+			return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+		}
+	}
+	if params.Labels != nil {
+		labels, err := encodeKubernetesClusterLabels(*params.Labels)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		}
+		update.Labels = &labels
+	}
+	if err := e.storage.UpdateKubernetesCluster(c, kubernetesID, update); err != nil {
+		if errors.Is(err, model.ErrVersionMismatch) {
+			return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(versionConflictError("kubernetes cluster").Error())})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not update Kubernetes cluster")})
+	}
+
+	k, err = e.storage.GetKubernetesCluster(c, kubernetesID)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get Kubernetes cluster")})
+	}
+	ctx.Response().Header().Set("ETag", versionETag(k.Version))
+	return ctx.JSON(http.StatusOK, kubernetesClusterToAPIJson(k))
+}
+
+// checkMonitoringInstanceExists returns the error to respond with if there is no such monitoring instance.
+func (e *EverestServer) checkMonitoringInstanceExists(ctx context.Context, name string) (int, error) {
+	if _, err := e.storage.GetMonitoringInstance(ctx, name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusBadRequest, fmt.Errorf("MonitoringInstance '%s' is not found", name)
+		}
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not get monitoring instance")
+	}
+	return 0, nil
+}
+
+func kubernetesClusterToAPIJson(k *model.KubernetesCluster) KubernetesCluster {
+	var labels *map[string]string
+	if l, err := kubernetesClusterLabels(k); err == nil && len(l) != 0 {
+		labels = &l
+	}
+	return KubernetesCluster{
+		Id:                            k.ID,
+		Name:                          k.Name,
+		Namespace:                     k.Namespace,
+		Uid:                           k.UID,
+		Compatibility:                 compatibilityToAPIJson(k),
+		DefaultMonitoringInstanceName: k.DefaultMonitoringInstanceName,
+		InCluster:                     pointer.ToBool(k.InCluster),
+		Labels:                        labels,
+		Version:                       pointer.ToInt64(k.Version),
+		CreatedAt:                     &k.CreatedAt,
+		UpdatedAt:                     &k.UpdatedAt,
+		CreatedBy:                     pointer.ToStringOrNil(k.CreatedBy),
+	}
+}
+
+// UpdateKubernetesClusterKubeconfig replaces the kubeconfig of the specified Kubernetes cluster.
+func (e *EverestServer) UpdateKubernetesClusterKubeconfig(ctx echo.Context, kubernetesID string) error {
+	var params KubeconfigParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	k, err := e.storage.GetKubernetesCluster(c, kubernetesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find Kubernetes cluster")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get Kubernetes cluster")})
+	}
+	if k.InCluster {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("The Kubernetes cluster Everest runs in is accessed with the service account of Everest"),
+		})
+	}
+
+	ns, err := e.validateKubeconfig(c, params.Kubeconfig, k.Namespace, pointer.GetBool(params.SkipOperatorCheck))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if string(ns.UID) != k.UID {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("The kubeconfig does not belong to the %s Kubernetes cluster", k.Name)),
+		})
+	}
+
+	// The secret is replaced at once so the requests in flight use either the old or the new kubeconfig.
+	if err := e.secretsStorage.UpdateSecret(c, k.ID, params.Kubeconfig); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not store kubeconfig in secrets storage")})
+	}
+	if err := e.storage.SetKubernetesClusterKubeconfigUpdated(c, k.ID); err != nil {
+		e.l.Error(err)
+	}
+	e.clusterHealth.delete(k.ID)
+
+	_, kubeClient, _, err := e.initKubeClient(c, k.ID)
+	if err == nil {
+		err = e.checkCompatibility(c, k, kubeClient)
+	}
+	if err != nil {
+		e.l.Error(err)
+	}
+
+	k, err = e.storage.GetKubernetesCluster(c, kubernetesID)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get Kubernetes cluster")})
+	}
+	return ctx.JSON(http.StatusOK, kubernetesClusterToAPIJson(k))
 }
 
 // UnregisterKubernetesCluster removes a Kubernetes cluster from Everest.
@@ -142,6 +322,18 @@ func (e *EverestServer) UnregisterKubernetesCluster(ctx echo.Context, kubernetes
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
 	}
 
+	if params.Cascade {
+		_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+		if err == nil {
+			return e.startDeregistration(ctx, kubeClient, kubernetesID, params.OrphanDatabaseClusters)
+		}
+		// Nothing can be cleaned up on an unavailable Kubernetes cluster.
+		if !params.IgnoreKubernetesUnavailable {
+			return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+		}
+		params.Force = true
+	}
+
 	var kubeClient *kubernetes.Kubernetes
 	var code int
 	var err error
@@ -221,6 +413,15 @@ func (e *EverestServer) GetKubernetesClusterResources(ctx echo.Context, kubernet
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
 	}
 
+	nodes, err := kubeClient.GetNodesResources(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{
+			Message: pointer.ToString("Could not get resources of the nodes"),
+		})
+	}
+	res.Nodes = nodeResourcesToAPIJson(nodes)
+
 	return ctx.JSON(http.StatusOK, res)
 }
 
@@ -288,7 +489,7 @@ func (e *EverestServer) disableK8sClusterMonitoring(ctx echo.Context, kubeClient
 }
 
 func (e *EverestServer) enableK8sClusterMonitoring(ctx echo.Context, params KubernetesClusterMonitoring, kubeClient *kubernetes.Kubernetes) error {
-	mi, err := e.storage.GetMonitoringInstance(params.MonitoringInstanceName)
+	mi, err := e.storage.GetMonitoringInstance(ctx.Request().Context(), params.MonitoringInstanceName)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, Error{
@@ -309,7 +510,13 @@ func (e *EverestServer) enableK8sClusterMonitoring(ctx echo.Context, params Kube
 		})
 	}
 
-	if err := kubeClient.DeployVMAgent(ctx.Request().Context(), mi.SecretName(), mi.URL); err != nil {
+	remoteWrite := kubernetes.VMAgentRemoteWrite{
+		URL:         mi.URL,
+		SecretName:  mi.SecretName(),
+		Prometheus:  mi.Type == model.PrometheusMonitoringInstanceType,
+		BearerToken: mi.Username == "",
+	}
+	if err := kubeClient.DeployVMAgent(ctx.Request().Context(), remoteWrite); err != nil {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, Error{
 			Message: pointer.ToString("Could not create VMAgent in Kubernetes"),
@@ -375,24 +582,103 @@ func (e *EverestServer) calculateClusterResources(
 	return res, nil
 }
 
-func (e *EverestServer) getNamespace(ctx context.Context, params CreateKubernetesClusterParams) (*corev1.Namespace, error) {
-	kubeconfig, err := base64.StdEncoding.DecodeString(params.Kubeconfig)
+func nodeResourcesToAPIJson(nodes []kubernetes.NodeResources) *[]NodeResources {
+	res := make([]NodeResources, 0, len(nodes))
+	for _, n := range nodes {
+		res = append(res, NodeResources{
+			Name:          n.Name,
+			Unschedulable: n.Unschedulable,
+			Allocatable:   resourceAmountsToAPIJson(n.Allocatable),
+			Requested:     resourceAmountsToAPIJson(n.Requested),
+		})
+	}
+	return &res
+}
+
+func resourceAmountsToAPIJson(r kubernetes.ResourceAmounts) NodeResourceAmounts {
+	return NodeResourceAmounts{
+		CpuMillis:             r.CPUMillis,
+		MemoryBytes:           r.MemoryBytes,
+		EphemeralStorageBytes: r.EphemeralStorageBytes,
+	}
+}
+
+// setInClusterKubeconfig sets the kubeconfig of the Kubernetes cluster Everest runs in
+// if it is registered. Otherwise, it checks the kubeconfig is provided.
+func setInClusterKubeconfig(params *CreateKubernetesClusterParams) error {
+	if !pointer.GetBool(params.InCluster) {
+		if params.Kubeconfig == "" {
+			return errors.New("kubeconfig is required")
+		}
+		return nil
+	}
+
+	if params.Kubeconfig != "" {
+		return errors.New("kubeconfig shall not be provided for the Kubernetes cluster Everest runs in")
+	}
+	kubeconfig, err := kubernetes.InClusterKubeconfig()
 	if err != nil {
-		e.l.Error(err)
-		return nil, errors.New("could not decode kubeconfig")
+		return err
 	}
+	params.Kubeconfig = base64.StdEncoding.EncodeToString(kubeconfig)
+	return nil
+}
 
-	kubeClient, err := kubernetes.New(kubeconfig, *params.Namespace, e.l)
+// validateKubeconfig connects to the kubernetes cluster with the kubeconfig and checks that
+// the everest operator is installed and the namespace exists. It returns the namespace.
+func (e *EverestServer) validateKubeconfig(
+	ctx context.Context, kubeconfigBase64, namespace string, skipOperatorCheck bool,
+) (*corev1.Namespace, error) {
+	kubeconfig, err := base64.StdEncoding.DecodeString(kubeconfigBase64)
 	if err != nil {
-		e.l.Error(err)
-		return nil, errors.New("could not create kube client")
+		return nil, errors.New("kubeconfig shall be base64 encoded")
+	}
+	if _, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig); err != nil {
+		return nil, fmt.Errorf("could not parse kubeconfig: %w", err)
 	}
 
-	ns, err := kubeClient.GetNamespace(ctx, *params.Namespace)
+	kubeClient, err := kubernetes.New(kubeconfig, namespace, e.l)
 	if err != nil {
-		e.l.Error(err)
-		return nil, errors.New("could not get namespace from Kubernetes")
+		return nil, kubeAPIError("could not connect to the Kubernetes API server", err)
+	}
+	if _, err := kubeClient.GetServerVersion(); err != nil {
+		return nil, kubeAPIError("could not get the version of the Kubernetes API server", err)
+	}
+
+	if !skipOperatorCheck {
+		missing, err := kubeClient.MissingEverestKinds()
+		if err != nil {
+			return nil, kubeAPIError("could not discover the everest operator APIs", err)
+		}
+		if len(missing) != 0 {
+			return nil, fmt.Errorf(
+				"the everest operator CRDs for %s are not installed. Install the everest operator or register the cluster with skipOperatorCheck to bootstrap it afterwards",
+				strings.Join(missing, ", "),
+			)
+		}
+	}
+
+	ns, err := kubeClient.GetNamespace(ctx, namespace)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("namespace %s does not exist in the Kubernetes cluster", namespace)
+		}
+		return nil, kubeAPIError(fmt.Sprintf("could not get namespace %s", namespace), err)
 	}
 
 	return ns, nil
 }
+
+// kubeAPIError describes the failed request to the Kubernetes API server made with the kubeconfig.
+func kubeAPIError(msg string, err error) error {
+	switch {
+	case k8serrors.IsUnauthorized(err):
+		return fmt.Errorf("%s: the credentials of the kubeconfig are rejected", msg)
+	case k8serrors.IsForbidden(err):
+		return fmt.Errorf("%s: the credentials of the kubeconfig are not allowed to access it", msg)
+	case errors.Is(err, context.DeadlineExceeded), k8serrors.IsTimeout(err):
+		return fmt.Errorf("%s: the Kubernetes API server did not respond in time", msg)
+	default:
+		return fmt.Errorf("%s: %w", msg, err)
+	}
+}
@@ -0,0 +1,87 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/AlekSi/pointer"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// encodeKubernetesClusterLabels validates the labels of a Kubernetes cluster and encodes them for the storage.
+func encodeKubernetesClusterLabels(l map[string]string) (string, error) {
+	if err := validateLabels(l); err != nil {
+		return "", err
+	}
+	if len(l) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(l)
+	if err != nil {
+		return "", errors.Join(err, errors.New("could not encode labels"))
+	}
+	return string(b), nil
+}
+
+// kubernetesClusterLabels returns the decoded labels of a Kubernetes cluster.
+func kubernetesClusterLabels(k *model.KubernetesCluster) (map[string]string, error) {
+	l := map[string]string{}
+	if k.Labels == "" {
+		return l, nil
+	}
+	if err := json.Unmarshal([]byte(k.Labels), &l); err != nil {
+		return nil, errors.Join(err, errors.New("could not decode labels"))
+	}
+	return l, nil
+}
+
+// parseLabelSelector parses the label selector the Kubernetes clusters are filtered with, e.g. env=prod,region=eu.
+// An unset selector matches all the clusters.
+func parseLabelSelector(selector *string) (labels.Selector, error) {
+	if pointer.GetString(selector) == "" {
+		return labels.Everything(), nil
+	}
+	s, err := labels.Parse(*selector)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("invalid label selector"))
+	}
+	return s, nil
+}
+
+// filterKubernetesClusters returns the Kubernetes clusters the labels of which match the selector.
+func filterKubernetesClusters(clusters []model.KubernetesCluster, s labels.Selector) ([]model.KubernetesCluster, error) {
+	if s.Empty() {
+		return clusters, nil
+	}
+
+	res := make([]model.KubernetesCluster, 0, len(clusters))
+	for _, k := range clusters {
+		k := k
+		l, err := kubernetesClusterLabels(&k)
+		if err != nil {
+			return nil, err
+		}
+		if s.Matches(labels.Set(l)) {
+			res = append(res, k)
+		}
+	}
+	return res, nil
+}
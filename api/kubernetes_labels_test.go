@@ -0,0 +1,64 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestFilterKubernetesClusters(t *testing.T) {
+	t.Parallel()
+
+	prod, err := encodeKubernetesClusterLabels(map[string]string{"env": "prod", "region": "eu"})
+	require.NoError(t, err)
+	dev, err := encodeKubernetesClusterLabels(map[string]string{"env": "dev", "region": "eu"})
+	require.NoError(t, err)
+	clusters := []model.KubernetesCluster{
+		{ID: "prod", Labels: prod},
+		{ID: "dev", Labels: dev},
+		{ID: "unlabeled"},
+	}
+
+	ids := func(selector *string) []string {
+		t.Helper()
+		s, err := parseLabelSelector(selector)
+		require.NoError(t, err)
+		res, err := filterKubernetesClusters(clusters, s)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(res))
+		for _, k := range res {
+			ids = append(ids, k.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"prod", "dev", "unlabeled"}, ids(nil))
+	assert.Equal(t, []string{"prod"}, ids(pointer.ToString("env=prod,region=eu")))
+	assert.Equal(t, []string{"prod", "dev"}, ids(pointer.ToString("region=eu")))
+	assert.Equal(t, []string{"dev", "unlabeled"}, ids(pointer.ToString("env!=prod")))
+	assert.Equal(t, []string{"unlabeled"}, ids(pointer.ToString("!env")))
+
+	_, err = parseLabelSelector(pointer.ToString("env in prod"))
+	assert.Error(t, err)
+	_, err = encodeKubernetesClusterLabels(map[string]string{"env": "not valid"})
+	assert.Error(t, err)
+}
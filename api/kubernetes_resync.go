@@ -0,0 +1,207 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const (
+	resyncResultCreated = "created"
+	resyncResultUpdated = "updated"
+	resyncResultFailed  = "failed"
+)
+
+// resyncKinds maps the config kinds to the kinds of their Kubernetes resources.
+//
+//nolint:gochecknoglobals
+var resyncKinds = map[string]string{
+	model.ConfigKindBackupStorage:      "BackupStorage",
+	model.ConfigKindMonitoringInstance: "MonitoringConfig",
+}
+
+// ResyncKubernetesCluster re-applies the backup storages and monitoring configs with their secrets
+// to the specified kubernetes cluster.
+func (e *EverestServer) ResyncKubernetesCluster(ctx echo.Context, kubernetesID string) error {
+	c := ctx.Request().Context()
+	k, kubeClient, code, err := e.initKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	storageNames, monitoringNames, err := configNamesOnCluster(c, kubeClient, k.Namespace)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list the configs used in the Kubernetes cluster")})
+	}
+	configs, err := e.resyncedConfigs(c, storageNames, monitoringNames)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the configs")})
+	}
+
+	result := KubernetesResyncResult{Items: make([]KubernetesResyncItem, 0, len(configs))}
+	for _, cfg := range configs {
+		item := e.resyncConfigTo(c, kubeClient, kubernetesID, cfg)
+		switch item.Result {
+		case resyncResultCreated:
+			result.Created++
+		case resyncResultUpdated:
+			result.Updated++
+		default:
+			result.Failed++
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// resyncConfigTo applies the config to the Kubernetes cluster and records it as synced.
+func (e *EverestServer) resyncConfigTo(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, kubernetesID string, cfg syncedConfig,
+) KubernetesResyncItem {
+	item := KubernetesResyncItem{Kind: resyncKinds[cfg.kind], Name: cfg.name}
+	created, err := kubeClient.ApplyConfig(ctx, cfg.resource, e.secretsStorage.GetSecret)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not apply config to Kubernetes cluster")))
+		item.Result = resyncResultFailed
+		item.Error = pointer.ToString(err.Error())
+		return item
+	}
+
+	item.Result = resyncResultUpdated
+	if created {
+		item.Result = resyncResultCreated
+	}
+	err = e.storage.SaveConfigSync(ctx, &model.ConfigSync{
+		KubernetesID:     kubernetesID,
+		ConfigKind:       cfg.kind,
+		ConfigName:       cfg.name,
+		SyncedGeneration: cfg.generation,
+		SyncedAt:         pointer.ToTime(time.Now().UTC()),
+	})
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not save config sync")))
+	}
+	return item
+}
+
+// resyncedConfigs returns the configs stored in Everest among the provided ones.
+// The others are not managed by Everest and are left as is.
+func (e *EverestServer) resyncedConfigs(ctx context.Context, storageNames, monitoringNames []string) ([]syncedConfig, error) {
+	configs := make([]syncedConfig, 0, len(storageNames)+len(monitoringNames))
+	for _, name := range storageNames {
+		bs, err := e.storage.GetBackupStorage(ctx, nil, name)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return nil, errors.Join(err, errors.New("could not get backup storage"))
+		}
+		configs = append(configs, backupStorageSyncedConfig(bs))
+	}
+	for _, name := range monitoringNames {
+		i, err := e.storage.GetMonitoringInstance(ctx, name)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return nil, errors.Join(err, errors.New("could not get monitoring instance"))
+		}
+		configs = append(configs, monitoringInstanceSyncedConfig(i))
+	}
+	return configs, nil
+}
+
+// configNamesOnCluster returns the sorted names of the backup storages and monitoring configs
+// used by the database clusters, backups and restores of the Kubernetes cluster or existing in it.
+func configNamesOnCluster(ctx context.Context, kubeClient *kubernetes.Kubernetes, namespace string) ([]string, []string, error) {
+	storages := make(map[string]struct{})
+	monitorings := make(map[string]struct{})
+
+	dbs, err := kubeClient.ListDatabaseClusters(ctx)
+	if err != nil {
+		return nil, nil, errors.Join(err, errors.New("could not list database clusters"))
+	}
+	for _, db := range dbs.Items {
+		db := db
+		for name := range kubernetes.BackupStorageNamesFromDBCluster(&db) {
+			storages[name] = struct{}{}
+		}
+		if db.Spec.Monitoring != nil && db.Spec.Monitoring.MonitoringConfigName != "" {
+			monitorings[db.Spec.Monitoring.MonitoringConfigName] = struct{}{}
+		}
+	}
+
+	backups, err := kubeClient.ListDatabaseClusterBackups(ctx)
+	if err != nil {
+		return nil, nil, errors.Join(err, errors.New("could not list database cluster backups"))
+	}
+	for _, b := range backups.Items {
+		storages[b.Spec.BackupStorageName] = struct{}{}
+	}
+
+	restores, err := kubeClient.ListDatabaseClusterRestores(ctx)
+	if err != nil {
+		return nil, nil, errors.Join(err, errors.New("could not list database cluster restores"))
+	}
+	for _, r := range restores.Items {
+		if r.Spec.DataSource.BackupSource != nil {
+			storages[r.Spec.DataSource.BackupSource.BackupStorageName] = struct{}{}
+		}
+	}
+
+	existingStorages, err := kubeClient.ListBackupStorages(ctx, namespace)
+	if err != nil {
+		return nil, nil, errors.Join(err, errors.New("could not list backup storages"))
+	}
+	for _, bs := range existingStorages.Items {
+		storages[bs.Name] = struct{}{}
+	}
+
+	existingMonitorings, err := kubeClient.ListMonitoringConfigs(ctx)
+	if err != nil {
+		return nil, nil, errors.Join(err, errors.New("could not list monitoring configs"))
+	}
+	for _, mc := range existingMonitorings.Items {
+		monitorings[mc.Name] = struct{}{}
+	}
+
+	delete(storages, "")
+	return sortedKeys(storages), sortedKeys(monitorings), nil
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
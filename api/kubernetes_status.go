@@ -0,0 +1,239 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// clusterHealthCache keeps the health status of every kubernetes cluster last checked in the background.
+type clusterHealthCache struct {
+	mu       sync.Mutex
+	statuses map[string]KubernetesClusterStatus
+}
+
+func newClusterHealthCache() *clusterHealthCache {
+	return &clusterHealthCache{statuses: make(map[string]KubernetesClusterStatus)}
+}
+
+func (c *clusterHealthCache) store(status KubernetesClusterStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.statuses[status.KubernetesId] = status
+}
+
+func (c *clusterHealthCache) load(kubernetesID string) (KubernetesClusterStatus, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.statuses[kubernetesID]
+	return s, ok
+}
+
+// delete drops the status of the kubernetes cluster so that it is checked again when requested.
+func (c *clusterHealthCache) delete(kubernetesID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.statuses, kubernetesID)
+}
+
+// replace drops the statuses of the kubernetes clusters which are not registered anymore.
+func (c *clusterHealthCache) replace(statuses map[string]KubernetesClusterStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.statuses = statuses
+}
+
+// GetKubernetesClusterStatus returns the health status of a kubernetes cluster.
+func (e *EverestServer) GetKubernetesClusterStatus(ctx echo.Context, kubernetesID string) error {
+	k, err := e.storage.GetKubernetesCluster(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find Kubernetes cluster")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get Kubernetes cluster")})
+	}
+
+	status, ok := e.clusterHealth.load(k.ID)
+	if !ok {
+		status = e.checkClusterHealth(ctx.Request().Context(), k)
+		e.clusterHealth.store(status)
+	}
+	status.Stale = time.Since(status.CheckedAt) > 2*e.config.ClusterHealthCheckInterval
+
+	return ctx.JSON(http.StatusOK, status)
+}
+
+// checkClusterHealth checks the API server, the operators and the nodes of the kubernetes cluster.
+func (e *EverestServer) checkClusterHealth(ctx context.Context, k *model.KubernetesCluster) KubernetesClusterStatus {
+	now := time.Now().UTC()
+	storedAt := k.KubeconfigStoredAt().UTC()
+	status := KubernetesClusterStatus{
+		KubernetesId:         k.ID,
+		CheckedAt:            now,
+		KubeconfigStoredAt:   pointer.ToTime(storedAt),
+		KubeconfigAgeSeconds: pointer.ToInt64(int64(now.Sub(storedAt).Seconds())),
+		Status:               Unreachable,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.config.ClusterRequestTimeout)
+	defer cancel()
+
+	kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+	if err != nil {
+		e.l.Warn(errors.Join(err, fmt.Errorf("could not create Kubernetes client for %s", k.ID)))
+		status.ApiServer.Error = pointer.ToString(err.Error())
+		return status
+	}
+	v, err := kubeClient.GetServerVersion()
+	if err != nil {
+		e.l.Warn(errors.Join(err, fmt.Errorf("could not get version of Kubernetes cluster %s", k.ID)))
+		status.ApiServer.Error = pointer.ToString(err.Error())
+		return status
+	}
+	status.ApiServer.Reachable = true
+	status.ApiServer.Version = pointer.ToString(v.GitVersion)
+
+	operators := e.operatorsHealth(ctx, k.ID, kubeClient)
+	status.Operators = &operators
+
+	nodes, err := kubeClient.GetNodes(ctx)
+	if err != nil {
+		e.l.Warn(errors.Join(err, fmt.Errorf("could not get nodes of Kubernetes cluster %s", k.ID)))
+	} else {
+		status.Nodes = nodesHealth(nodes)
+	}
+
+	status.Status = clusterHealthOf(operators, status.Nodes)
+	return status
+}
+
+// operatorsHealth returns whether the operators managed by Everest are installed and ready.
+func (e *EverestServer) operatorsHealth(
+	ctx context.Context, kubernetesID string, kubeClient *kubernetes.Kubernetes,
+) []KubernetesOperatorStatus {
+	names := make([]string, 0, len(kubernetes.OperatorDeployments))
+	for name := range kubernetes.OperatorDeployments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := make([]KubernetesOperatorStatus, 0, len(names))
+	for _, name := range names {
+		s := KubernetesOperatorStatus{Name: name}
+		op, err := kubeClient.GetOperator(ctx, name)
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				// The operator is reported as installed but not ready if its state is unknown.
+				e.l.Warn(errors.Join(err, fmt.Errorf("could not get %s operator of Kubernetes cluster %s", name, kubernetesID)))
+				s.Installed = true
+			}
+			res = append(res, s)
+			continue
+		}
+		s.Installed = true
+		s.Version = pointer.ToString(op.Version)
+		s.Ready, err = kubeClient.IsDeploymentReady(ctx, op.Deployment)
+		if err != nil {
+			e.l.Warn(errors.Join(err, fmt.Errorf("could not check %s operator of Kubernetes cluster %s", name, kubernetesID)))
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+func nodesHealth(nodes []corev1.Node) *KubernetesNodesStatus {
+	res := &KubernetesNodesStatus{Total: len(nodes)}
+	for _, node := range nodes {
+		if kubernetes.IsNodeInCondition(node, corev1.NodeReady) {
+			res.Ready++
+		}
+	}
+	return res
+}
+
+// clusterHealthOf returns the health of a reachable kubernetes cluster. It is degraded
+// if the everest operator is missing, an installed operator or a node is not ready
+// or the nodes could not be checked.
+func clusterHealthOf(operators []KubernetesOperatorStatus, nodes *KubernetesNodesStatus) KubernetesClusterStatusStatus {
+	for _, op := range operators {
+		if op.Name == kubernetes.EverestOperatorName && !op.Installed {
+			return Degraded
+		}
+		if op.Installed && !op.Ready {
+			return Degraded
+		}
+	}
+	if nodes == nil || nodes.Ready < nodes.Total {
+		return Degraded
+	}
+	return Healthy
+}
+
+// runClusterHealthChecker periodically checks the health of all Kubernetes clusters
+// until the context is canceled.
+func (e *EverestServer) runClusterHealthChecker(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.ClusterHealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.checkAllClusterHealth(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) checkAllClusterHealth(ctx context.Context) {
+	clusters, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list Kubernetes clusters")))
+		return
+	}
+
+	statuses := make(map[string]KubernetesClusterStatus, len(clusters))
+	for i := range clusters {
+		if ctx.Err() != nil {
+			return
+		}
+		statuses[clusters[i].ID] = e.checkClusterHealth(ctx, &clusters[i])
+	}
+	e.clusterHealth.replace(statuses)
+}
@@ -0,0 +1,78 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+func TestClusterHealthOf(t *testing.T) {
+	t.Parallel()
+
+	everest := KubernetesOperatorStatus{Name: kubernetes.EverestOperatorName, Installed: true, Ready: true}
+	pxc := KubernetesOperatorStatus{Name: "pxc", Installed: true, Ready: true}
+	psmdb := KubernetesOperatorStatus{Name: "psmdb"}
+	nodes := &KubernetesNodesStatus{Total: 3, Ready: 3}
+
+	testCases := []struct {
+		name      string
+		operators []KubernetesOperatorStatus
+		nodes     *KubernetesNodesStatus
+		health    KubernetesClusterStatusStatus
+	}{
+		{
+			name:      "healthy without some engine operators",
+			operators: []KubernetesOperatorStatus{everest, pxc, psmdb},
+			nodes:     nodes,
+			health:    Healthy,
+		},
+		{
+			name:      "everest operator missing",
+			operators: []KubernetesOperatorStatus{{Name: kubernetes.EverestOperatorName}, pxc},
+			nodes:     nodes,
+			health:    Degraded,
+		},
+		{
+			name:      "operator not ready",
+			operators: []KubernetesOperatorStatus{everest, {Name: "pxc", Installed: true}},
+			nodes:     nodes,
+			health:    Degraded,
+		},
+		{
+			name:      "node not ready",
+			operators: []KubernetesOperatorStatus{everest},
+			nodes:     &KubernetesNodesStatus{Total: 3, Ready: 2},
+			health:    Degraded,
+		},
+		{
+			name:      "nodes unknown",
+			operators: []KubernetesOperatorStatus{everest},
+			health:    Degraded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.health, clusterHealthOf(tc.operators, tc.nodes))
+		})
+	}
+}
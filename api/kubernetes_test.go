@@ -0,0 +1,100 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestKubeAPIError(t *testing.T) {
+	t.Parallel()
+
+	ns := schema.GroupResource{Resource: "namespaces"}
+	testCases := []struct {
+		name string
+		err  error
+		msg  string
+	}{
+		{
+			name: "unauthorized",
+			err:  k8serrors.NewUnauthorized("token expired"),
+			msg:  "could not connect: the credentials of the kubeconfig are rejected",
+		},
+		{
+			name: "forbidden",
+			err:  k8serrors.NewForbidden(ns, "everest", errors.New("denied")),
+			msg:  "could not connect: the credentials of the kubeconfig are not allowed to access it",
+		},
+		{
+			name: "timeout",
+			err:  context.DeadlineExceeded,
+			msg:  "could not connect: the Kubernetes API server did not respond in time",
+		},
+		{
+			name: "other",
+			err:  errors.New("connection refused"),
+			msg:  "could not connect: connection refused",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.EqualError(t, kubeAPIError("could not connect", tc.err), tc.msg)
+		})
+	}
+}
+
+// kubernetesClustersStub knows the registered Kubernetes clusters.
+type kubernetesClustersStub struct {
+	storage
+	clusters []model.KubernetesCluster
+}
+
+func (s kubernetesClustersStub) ListKubernetesClusters(_ context.Context) ([]model.KubernetesCluster, error) {
+	return s.clusters, nil
+}
+
+func TestCheckNamespaceNotRegistered(t *testing.T) {
+	t.Parallel()
+
+	e := &EverestServer{
+		l: zap.NewNop().Sugar(),
+		storage: kubernetesClustersStub{clusters: []model.KubernetesCluster{
+			{ID: "1", Name: "eks", UID: "uid-1"},
+			{ID: "2", Name: "gke", UID: "uid-2"},
+		}},
+	}
+
+	code, err := e.checkNamespaceNotRegistered(context.Background(), "uid-3")
+	assert.NoError(t, err)
+	assert.Zero(t, code)
+
+	code, err = e.checkNamespaceNotRegistered(context.Background(), "uid-2")
+	assert.EqualError(t, err, "the namespace is already registered as Kubernetes cluster gke")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
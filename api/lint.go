@@ -0,0 +1,174 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// minHAReplicas is the number of replicas required to spread a cluster across nodes
+	// and to survive a node failure without losing the quorum.
+	minHAReplicas = 3
+	// minProxyReplicas is the number of proxy replicas required to survive a proxy failure.
+	minProxyReplicas = 2
+)
+
+//nolint:gochecknoglobals
+var (
+	minProxyCPU    = resource.MustParse("500m")
+	minProxyMemory = resource.MustParse("512M")
+
+	// lintSeverityPenalty defines how much a finding of each severity lowers the lint score.
+	lintSeverityPenalty = map[LintFindingSeverity]int{
+		LintFindingSeverityInfo:     5,
+		LintFindingSeverityWarning:  15,
+		LintFindingSeverityCritical: 30,
+	}
+)
+
+// LintDatabaseCluster lints a database cluster spec against the best practices.
+func (e *EverestServer) LintDatabaseCluster(ctx echo.Context) error {
+	db := &everestv1alpha1.DatabaseCluster{}
+	if err := ctx.Bind(db); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	findings := lintDatabaseCluster(db)
+	return ctx.JSON(http.StatusOK, LintResult{
+		Score:    lintScore(findings),
+		Findings: findings,
+	})
+}
+
+func lintDatabaseCluster(db *everestv1alpha1.DatabaseCluster) []LintFinding {
+	findings := []LintFinding{}
+	findings = append(findings, lintEngine(db)...)
+	findings = append(findings, lintProxy(db)...)
+	findings = append(findings, lintBackup(db)...)
+
+	if db.Spec.Monitoring == nil || db.Spec.Monitoring.MonitoringConfigName == "" {
+		findings = append(findings, LintFinding{
+			Rule:     "no-monitoring",
+			Severity: LintFindingSeverityInfo,
+			Field:    "spec.monitoring",
+			Message:  "The database cluster is not monitored",
+		})
+	}
+
+	return findings
+}
+
+func lintEngine(db *everestv1alpha1.DatabaseCluster) []LintFinding {
+	var findings []LintFinding
+
+	if db.Spec.Engine.Replicas < minHAReplicas {
+		findings = append(findings, LintFinding{
+			Rule:     "no-anti-affinity",
+			Severity: LintFindingSeverityWarning,
+			Field:    "spec.engine.replicas",
+			Message: fmt.Sprintf(
+				"The database cluster has %d replicas and cannot be spread across %d nodes, a node failure may cause downtime",
+				db.Spec.Engine.Replicas, minHAReplicas,
+			),
+		})
+	}
+	if db.Spec.AllowUnsafeConfiguration {
+		findings = append(findings, LintFinding{
+			Rule:     "no-anti-affinity",
+			Severity: LintFindingSeverityWarning,
+			Field:    "spec.allowUnsafeConfiguration",
+			Message:  "Unsafe configurations allow the operators to schedule the replicas without anti-affinity",
+		})
+	}
+	if db.Spec.Engine.Resources.CPU.IsZero() || db.Spec.Engine.Resources.Memory.IsZero() {
+		findings = append(findings, LintFinding{
+			Rule:     "missing-resources",
+			Severity: LintFindingSeverityCritical,
+			Field:    "spec.engine.resources",
+			Message:  "The engine has no CPU or memory limits, the replicas may be evicted or starve other workloads",
+		})
+	}
+
+	return findings
+}
+
+func lintProxy(db *everestv1alpha1.DatabaseCluster) []LintFinding {
+	var findings []LintFinding
+
+	proxy := db.Spec.Proxy
+	if proxy.Replicas != nil && *proxy.Replicas < minProxyReplicas && db.Spec.Engine.Replicas > 1 {
+		findings = append(findings, LintFinding{
+			Rule:     "undersized-proxy",
+			Severity: LintFindingSeverityWarning,
+			Field:    "spec.proxy.replicas",
+			Message:  fmt.Sprintf("The proxy has %d replicas, a proxy failure makes the database cluster unavailable", *proxy.Replicas),
+		})
+	}
+	if !proxy.Resources.CPU.IsZero() && proxy.Resources.CPU.Cmp(minProxyCPU) < 0 {
+		findings = append(findings, LintFinding{
+			Rule:     "undersized-proxy",
+			Severity: LintFindingSeverityWarning,
+			Field:    "spec.proxy.resources.cpu",
+			Message:  fmt.Sprintf("The proxy CPU is lower than %s", minProxyCPU.String()),
+		})
+	}
+	if !proxy.Resources.Memory.IsZero() && proxy.Resources.Memory.Cmp(minProxyMemory) < 0 {
+		findings = append(findings, LintFinding{
+			Rule:     "undersized-proxy",
+			Severity: LintFindingSeverityWarning,
+			Field:    "spec.proxy.resources.memory",
+			Message:  fmt.Sprintf("The proxy memory is lower than %s", minProxyMemory.String()),
+		})
+	}
+
+	return findings
+}
+
+func lintBackup(db *everestv1alpha1.DatabaseCluster) []LintFinding {
+	if db.Spec.Backup.Enabled {
+		for _, schedule := range db.Spec.Backup.Schedules {
+			if schedule.Enabled {
+				return nil
+			}
+		}
+	}
+
+	return []LintFinding{{
+		Rule:     "missing-pitr",
+		Severity: LintFindingSeverityWarning,
+		Field:    "spec.backup",
+		Message:  "There is no enabled backup schedule, so the database cluster cannot be restored to a point in time",
+	}}
+}
+
+func lintScore(findings []LintFinding) int {
+	score := 100
+	for _, f := range findings {
+		score -= lintSeverityPenalty[f.Severity]
+	}
+	if score < 0 {
+		return 0
+	}
+	return score
+}
@@ -0,0 +1,87 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintDatabaseCluster(t *testing.T) {
+	t.Parallel()
+
+	type tCase struct {
+		name    string
+		cluster []byte
+		rules   []string
+		score   int
+	}
+
+	cases := []tCase{
+		{
+			name: "best practices followed",
+			cluster: []byte(`{"spec": {
+				"engine": {"type": "pxc", "replicas": 3, "resources": {"cpu": "1", "memory": "2G"}, "storage": {"size": "10G"}},
+				"proxy": {"type": "haproxy", "replicas": 2, "resources": {"cpu": "1", "memory": "1G"}},
+				"backup": {"enabled": true, "schedules": [{"enabled": true, "name": "daily", "schedule": "0 0 * * *", "backupStorageName": "s3"}]},
+				"monitoring": {"monitoringConfigName": "pmm"}
+			}}`),
+			rules: []string{},
+			score: 100,
+		},
+		{
+			name: "single node without backups",
+			cluster: []byte(`{"spec": {
+				"engine": {"type": "pxc", "replicas": 1, "resources": {"cpu": "1", "memory": "2G"}, "storage": {"size": "10G"}},
+				"proxy": {"type": "haproxy", "replicas": 1},
+				"monitoring": {"monitoringConfigName": "pmm"}
+			}}`),
+			rules: []string{"no-anti-affinity", "missing-pitr"},
+			score: 70,
+		},
+		{
+			name: "undersized proxy",
+			cluster: []byte(`{"spec": {
+				"engine": {"type": "psmdb", "replicas": 3, "storage": {"size": "10G"}},
+				"proxy": {"type": "mongos", "replicas": 1, "resources": {"cpu": "100m", "memory": "128M"}},
+				"backup": {"enabled": true, "schedules": [{"enabled": false, "name": "daily", "schedule": "0 0 * * *", "backupStorageName": "s3"}]}
+			}}`),
+			rules: []string{"missing-resources", "undersized-proxy", "undersized-proxy", "undersized-proxy", "missing-pitr", "no-monitoring"},
+			score: 5,
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			db := &everestv1alpha1.DatabaseCluster{}
+			require.NoError(t, json.Unmarshal(tc.cluster, db))
+
+			findings := lintDatabaseCluster(db)
+			rules := make([]string, 0, len(findings))
+			for _, f := range findings {
+				rules = append(rules, f.Rule)
+			}
+			assert.Equal(t, tc.rules, rules)
+			assert.Equal(t, tc.score, lintScore(findings))
+		})
+	}
+}
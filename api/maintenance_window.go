@@ -0,0 +1,562 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// Types of the notification events of the operations deferred to the maintenance windows.
+const (
+	maintenanceEventOperationApplied = "maintenance-operation-applied"
+	maintenanceEventOperationFailed  = "maintenance-operation-failed"
+)
+
+// maintenanceWindowSearchLimit limits the search of the next start of a maintenance window.
+const maintenanceWindowSearchLimit = 366 * 24 * time.Hour
+
+var (
+	errMaintenanceWindowSchedule = errors.New("either schedule or weekly shall be set")
+	errInvalidStartTime          = errors.New("startTime shall be in the HH:MM format")
+)
+
+//nolint:gochecknoglobals
+var weekdayNumbers = map[WeeklyMaintenanceWindowDays]int{
+	Sunday:    0,
+	Monday:    1,
+	Tuesday:   2,
+	Wednesday: 3,
+	Thursday:  4,
+	Friday:    5,
+	Saturday:  6,
+}
+
+// GetDatabaseClusterMaintenanceWindow returns the maintenance window of the database cluster.
+func (e *EverestServer) GetDatabaseClusterMaintenanceWindow(ctx echo.Context, kubernetesID string, name string, _ GetDatabaseClusterMaintenanceWindowParams) error {
+	w, err := e.storage.GetMaintenanceWindow(ctx.Request().Context(), kubernetesID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("The database cluster has no maintenance window")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get maintenance window")})
+	}
+
+	res, err := maintenanceWindowToAPI(w, time.Now())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not evaluate maintenance window")})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// SetDatabaseClusterMaintenanceWindow creates or replaces the maintenance window of the database cluster.
+func (e *EverestServer) SetDatabaseClusterMaintenanceWindow(ctx echo.Context, kubernetesID string, name string, query SetDatabaseClusterMaintenanceWindowParams) error {
+	var params MaintenanceWindowParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	w, err := maintenanceWindowFrom(params)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if _, err := kubeClient.GetDatabaseCluster(c, name); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString(fmt.Sprintf("DatabaseCluster '%s' is not found", name))})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	w.KubernetesID = kubernetesID
+	w.DBClusterName = name
+	if existing, err := e.storage.GetMaintenanceWindow(c, kubernetesID, name); err == nil {
+		w.CreatedAt = existing.CreatedAt
+	}
+	if err := e.storage.SaveMaintenanceWindow(c, w); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save maintenance window")})
+	}
+
+	return e.GetDatabaseClusterMaintenanceWindow(ctx, kubernetesID, name, GetDatabaseClusterMaintenanceWindowParams(query))
+}
+
+// DeleteDatabaseClusterMaintenanceWindow deletes the maintenance window of the database cluster.
+func (e *EverestServer) DeleteDatabaseClusterMaintenanceWindow(ctx echo.Context, kubernetesID string, name string, _ DeleteDatabaseClusterMaintenanceWindowParams) error {
+	if err := e.storage.DeleteMaintenanceWindow(ctx.Request().Context(), kubernetesID, name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("The database cluster has no maintenance window")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete maintenance window")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListDatabaseClusterPendingOperations lists the operations of the database cluster deferred to its maintenance window.
+func (e *EverestServer) ListDatabaseClusterPendingOperations(ctx echo.Context, kubernetesID string, name string, _ ListDatabaseClusterPendingOperationsParams) error {
+	ops, err := e.storage.ListPendingOperations(ctx.Request().Context(), kubernetesID, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list pending operations")})
+	}
+
+	res := make(PendingOperationList, 0, len(ops))
+	for _, op := range ops {
+		op := op
+		res = append(res, pendingOperationToAPI(&op))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// CancelDatabaseClusterPendingOperation cancels an operation of the database cluster deferred to its maintenance window.
+func (e *EverestServer) CancelDatabaseClusterPendingOperation(ctx echo.Context, kubernetesID string, name string, id string, _ CancelDatabaseClusterPendingOperationParams) error {
+	c := ctx.Request().Context()
+	if err := e.storage.DeletePendingOperation(c, kubernetesID, name, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Pending operation not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not cancel pending operation")})
+	}
+
+	// The deferred engine upgrade is finished so that another one can be requested.
+	u, err := e.storage.GetEngineUpgrade(c, kubernetesID, name)
+	if err == nil && u.State == model.EngineUpgradeStateDeferred {
+		e.failEngineUpgrade(c, u, "The deferred upgrade was canceled")
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func maintenanceWindowFrom(params MaintenanceWindowParams) (*model.MaintenanceWindow, error) {
+	if (params.Schedule == nil) == (params.Weekly == nil) {
+		return nil, errMaintenanceWindowSchedule
+	}
+	schedule := pointer.GetString(params.Schedule)
+	if params.Weekly != nil {
+		var err error
+		schedule, err = weeklyWindowToCron(*params.Weekly)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := parseCron(schedule); err != nil {
+		return nil, err
+	}
+	tz := pointer.GetString(params.TimeZone)
+	if tz == "" {
+		tz = time.UTC.String()
+	}
+	if err := validateTimeZone(tz); err != nil {
+		return nil, err
+	}
+
+	return &model.MaintenanceWindow{
+		Schedule:        schedule,
+		TimeZone:        tz,
+		DurationMinutes: params.DurationMinutes,
+	}, nil
+}
+
+// weeklyWindowToCron returns the cron schedule starting the window at the start time on the days.
+func weeklyWindowToCron(w WeeklyMaintenanceWindow) (string, error) {
+	hour, minute, ok := strings.Cut(w.StartTime, ":")
+	if !ok {
+		return "", errInvalidStartTime
+	}
+	h, err := strconv.Atoi(hour)
+	if err != nil || h < 0 || h > 23 {
+		return "", errInvalidStartTime
+	}
+	m, err := strconv.Atoi(minute)
+	if err != nil || m < 0 || m > 59 {
+		return "", errInvalidStartTime
+	}
+
+	days := make([]string, 0, len(w.Days))
+	for _, d := range w.Days {
+		n, ok := weekdayNumbers[d]
+		if !ok {
+			return "", fmt.Errorf("unknown day '%s'", d)
+		}
+		days = append(days, strconv.Itoa(n))
+	}
+	return fmt.Sprintf("%d %d * * %s", m, h, strings.Join(days, ",")), nil
+}
+
+func maintenanceWindowToAPI(w *model.MaintenanceWindow, now time.Time) (MaintenanceWindow, error) {
+	schedule, err := parseCron(w.Schedule)
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+
+	res := MaintenanceWindow{
+		Schedule:        w.Schedule,
+		TimeZone:        w.TimeZone,
+		DurationMinutes: w.DurationMinutes,
+		Open:            maintenanceWindowOpen(schedule, loc, w.DurationMinutes, now),
+	}
+	if next, ok := nextMaintenanceWindowStart(schedule, loc, now); ok {
+		res.NextStart = &next
+	}
+	return res, nil
+}
+
+func pendingOperationToAPI(op *model.PendingOperation) PendingOperation {
+	return PendingOperation{
+		Id:        op.ID,
+		Type:      op.Type,
+		State:     op.State,
+		Message:   pointer.ToStringOrNil(op.Message),
+		CreatedBy: pointer.ToStringOrNil(op.CreatedBy),
+		CreatedAt: op.CreatedAt,
+	}
+}
+
+// maintenanceWindowOpen returns true if a window started less than its duration ago.
+func maintenanceWindowOpen(schedule *cronSchedule, loc *time.Location, durationMinutes int, now time.Time) bool {
+	t := now.In(loc).Truncate(time.Minute)
+	for i := 0; i < durationMinutes; i++ {
+		if schedule.matches(t.Add(-time.Duration(i) * time.Minute)) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextMaintenanceWindowStart returns the next start of the window after now.
+// It returns false if the window does not start within maintenanceWindowSearchLimit.
+func nextMaintenanceWindowStart(schedule *cronSchedule, loc *time.Location, now time.Time) (time.Time, bool) {
+	t := now.In(loc).Truncate(time.Minute).Add(time.Minute)
+	limit := now.Add(maintenanceWindowSearchLimit)
+	for t.Before(limit) {
+		switch {
+		case !schedule.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case !schedule.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case !schedule.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// deferToMaintenanceWindow returns true if a disruptive operation of the database cluster shall be deferred
+// because its maintenance window is closed. The operations of the database clusters without a window are not deferred.
+func (e *EverestServer) deferToMaintenanceWindow(ctx echo.Context, kubernetesID, name string, override bool) (bool, int, error) {
+	if override {
+		return false, 0, nil
+	}
+	w, err := e.storage.GetMaintenanceWindow(ctx.Request().Context(), kubernetesID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, 0, nil
+		}
+		e.l.Error(err)
+		return false, http.StatusInternalServerError, errors.New("could not get maintenance window")
+	}
+	open, err := e.maintenanceWindowOpenNow(w)
+	if err != nil {
+		e.l.Error(err)
+		return false, http.StatusInternalServerError, errors.New("could not evaluate maintenance window")
+	}
+	return !open, 0, nil
+}
+
+func (e *EverestServer) maintenanceWindowOpenNow(w *model.MaintenanceWindow) (bool, error) {
+	schedule, err := parseCron(w.Schedule)
+	if err != nil {
+		return false, err
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return false, err
+	}
+	return maintenanceWindowOpen(schedule, loc, w.DurationMinutes, time.Now()), nil
+}
+
+// deferDatabaseClusterUpdate queues the update of the database cluster in the request body if it restarts
+// or resizes the database cluster and its maintenance window is closed. It returns nil if the update is not deferred.
+func (e *EverestServer) deferDatabaseClusterUpdate(
+	ctx echo.Context, kubernetesID string, oldDB *everestv1alpha1.DatabaseCluster, override bool,
+) (*model.PendingOperation, int, error) {
+	proposed := &everestv1alpha1.DatabaseCluster{}
+	if err := e.getBodyFromContext(ctx, proposed); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	diff, err := diffDatabaseClusterSpecs(oldDB, proposed)
+	if err != nil {
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not compare the database clusters")
+	}
+	if !diff.RequiresRestart && !diff.RequiresResize {
+		return nil, 0, nil
+	}
+	deferred, code, err := e.deferToMaintenanceWindow(ctx, kubernetesID, oldDB.Name, override)
+	if err != nil || !deferred {
+		return nil, code, err
+	}
+
+	body, err := ctx.Request().GetBody()
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	payload, err := io.ReadAll(body)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	op, err := e.queuePendingOperation(ctx, kubernetesID, oldDB.Name, model.PendingOperationUpdateDatabaseCluster, string(payload))
+	if err != nil {
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not defer the database cluster update")
+	}
+	return op, 0, nil
+}
+
+// queuePendingOperation queues an operation of the database cluster requested by the user of the request.
+func (e *EverestServer) queuePendingOperation(ctx echo.Context, kubernetesID, name, opType, payload string) (*model.PendingOperation, error) {
+	op := &model.PendingOperation{
+		ID:            uuid.NewString(),
+		KubernetesID:  kubernetesID,
+		DBClusterName: name,
+		Namespace:     namespaceFrom(ctx.Request().Context()),
+		Type:          opType,
+		Payload:       payload,
+		State:         model.PendingOperationStatePending,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if id, ok := userIdentityFrom(ctx); ok {
+		op.CreatedBy = id.Username
+	}
+	if err := e.storage.CreatePendingOperation(ctx.Request().Context(), op); err != nil {
+		return nil, errors.Join(err, errors.New("could not queue pending operation"))
+	}
+	return op, nil
+}
+
+// deleteMaintenanceState deletes the maintenance window and the pending operations of a deleted database cluster.
+func (e *EverestServer) deleteMaintenanceState(ctx context.Context, kubernetesID, name string) {
+	if err := e.storage.DeleteMaintenanceWindow(ctx, kubernetesID, name); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(errors.Join(err, errors.New("could not delete maintenance window")))
+	}
+	if err := e.storage.DeletePendingOperations(ctx, kubernetesID, name); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not delete pending operations")))
+	}
+}
+
+// runMaintenanceExecutor periodically applies the pending operations whose maintenance windows are open
+// until the context is canceled.
+func (e *EverestServer) runMaintenanceExecutor(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.MaintenanceCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.applyPendingOperations(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) applyPendingOperations(ctx context.Context) {
+	ops, err := e.storage.ListPendingOperations(ctx, "", "")
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list pending operations")))
+		return
+	}
+
+	open := make(map[string]bool)
+	for i := range ops {
+		if ctx.Err() != nil {
+			return
+		}
+		op := &ops[i]
+		if op.State != model.PendingOperationStatePending {
+			continue
+		}
+		key := op.KubernetesID + "/" + op.DBClusterName
+		isOpen, ok := open[key]
+		if !ok {
+			isOpen, err = e.pendingOperationWindowOpen(ctx, op)
+			if err != nil {
+				e.l.Error(errors.Join(err, fmt.Errorf("could not evaluate maintenance window of %s", key)))
+				continue
+			}
+			open[key] = isOpen
+		}
+		if !isOpen {
+			continue
+		}
+		e.applyPendingOperation(ctx, op)
+	}
+}
+
+// pendingOperationWindowOpen returns true if the maintenance window of the database cluster of the operation is open.
+// The operations of a database cluster whose window was deleted are applied right away.
+func (e *EverestServer) pendingOperationWindowOpen(ctx context.Context, op *model.PendingOperation) (bool, error) {
+	w, err := e.storage.GetMaintenanceWindow(ctx, op.KubernetesID, op.DBClusterName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return true, nil
+		}
+		return false, err
+	}
+	return e.maintenanceWindowOpenNow(w)
+}
+
+func (e *EverestServer) applyPendingOperation(ctx context.Context, op *model.PendingOperation) {
+	resource := "database-clusters/" + op.DBClusterName
+	err := e.executePendingOperation(ctx, op)
+	if err != nil {
+		e.l.Error(errors.Join(err, fmt.Errorf("could not apply pending operation %s", op.ID)))
+		if err := e.storage.UpdatePendingOperationState(ctx, op.ID, model.PendingOperationStateFailed, err.Error()); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not save pending operation")))
+		}
+		e.notify(ctx, notificationEvent{
+			Type:         maintenanceEventOperationFailed,
+			Severity:     notificationSeverityWarning,
+			KubernetesID: op.KubernetesID,
+			Resource:     resource,
+			Message:      fmt.Sprintf("Could not apply %s of %s in the maintenance window: %s", op.Type, resource, err),
+		})
+		return
+	}
+
+	if err := e.storage.DeletePendingOperation(ctx, op.KubernetesID, op.DBClusterName, op.ID); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not delete pending operation")))
+	}
+	e.notify(ctx, notificationEvent{
+		Type:         maintenanceEventOperationApplied,
+		Severity:     notificationSeverityInfo,
+		KubernetesID: op.KubernetesID,
+		Resource:     resource,
+		Message:      fmt.Sprintf("Applied %s of %s in the maintenance window", op.Type, resource),
+	})
+}
+
+func (e *EverestServer) executePendingOperation(ctx context.Context, op *model.PendingOperation) error {
+	_, kubeClient, _, err := e.initDatabaseClusterKubeClient(withNamespace(ctx, op.Namespace), op.KubernetesID)
+	if err != nil {
+		return err
+	}
+
+	switch op.Type {
+	case model.PendingOperationUpgradeEngine:
+		u, err := e.storage.GetEngineUpgrade(ctx, op.KubernetesID, op.DBClusterName)
+		if err != nil {
+			return errors.Join(err, errors.New("could not get database engine upgrade"))
+		}
+		if u.State != model.EngineUpgradeStateDeferred {
+			return nil
+		}
+		if _, err := e.startEngineUpgrade(ctx, kubeClient, u); err != nil {
+			e.failEngineUpgrade(ctx, u, err.Error())
+			return err
+		}
+		return nil
+	case model.PendingOperationUpdateDatabaseCluster:
+		return e.applyDatabaseClusterUpdate(ctx, kubeClient, op.DBClusterName, []byte(op.Payload))
+	default:
+		return fmt.Errorf("unknown pending operation type %s", op.Type)
+	}
+}
+
+// applyDatabaseClusterUpdate replaces the database cluster like the update request deferred with the payload would do.
+func (e *EverestServer) applyDatabaseClusterUpdate(ctx context.Context, kubeClient *kubernetes.Kubernetes, name string, payload []byte) error {
+	dbc := &DatabaseCluster{}
+	if err := json.Unmarshal(payload, dbc); err != nil {
+		return errors.Join(err, errors.New("could not decode database cluster"))
+	}
+	proposed := &everestv1alpha1.DatabaseCluster{}
+	if err := json.Unmarshal(payload, proposed); err != nil {
+		return errors.Join(err, errors.New("could not decode database cluster"))
+	}
+	oldDB, err := kubeClient.GetDatabaseCluster(ctx, name)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get database cluster"))
+	}
+
+	newMonitoringName := monitoringNameFrom(dbc)
+	newBackupNames := backupStorageNamesFrom(dbc)
+	if err := e.createResources(ctx, oldDB, kubeClient, newMonitoringName, newBackupNames); err != nil {
+		return err
+	}
+	db := oldDB.DeepCopy()
+	db.Spec = proposed.Spec
+	db.Labels = proposed.Labels
+	db.Annotations = proposed.Annotations
+	if _, err := kubeClient.UpdateDatabaseCluster(ctx, db); err != nil {
+		return errors.Join(err, errors.New("could not update database cluster"))
+	}
+
+	e.waitGroup.Add(1)
+	go e.deleteBackupStoragesOnUpdate(context.Background(), kubeClient, oldDB, newBackupNames)
+	e.waitGroup.Add(1)
+	go e.deleteMonitoringInstanceOnUpdate(context.Background(), kubeClient, oldDB, newMonitoringName)
+	return nil
+}
+
+func (e *EverestServer) failEngineUpgrade(ctx context.Context, u *model.EngineUpgrade, message string) {
+	now := time.Now().UTC()
+	u.State = model.EngineUpgradeStateFailed
+	u.Message = message
+	u.FinishedAt = &now
+	if err := e.storage.SaveEngineUpgrade(ctx, u); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not save database engine upgrade")))
+	}
+}
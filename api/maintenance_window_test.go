@@ -0,0 +1,97 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		expr     string
+		at       time.Time
+		expected bool
+		err      bool
+	}
+	cases := []testCase{
+		{name: "exact", expr: "30 2 * * *", at: time.Date(2024, 3, 5, 2, 30, 0, 0, time.UTC), expected: true},
+		{name: "other minute", expr: "30 2 * * *", at: time.Date(2024, 3, 5, 2, 31, 0, 0, time.UTC), expected: false},
+		{name: "step", expr: "*/15 * * * *", at: time.Date(2024, 3, 5, 7, 45, 0, 0, time.UTC), expected: true},
+		{name: "range", expr: "0 1-3 * * *", at: time.Date(2024, 3, 5, 3, 0, 0, 0, time.UTC), expected: true},
+		{name: "sunday as 7", expr: "0 0 * * 7", at: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC), expected: true},
+		{name: "weekday", expr: "0 0 * * 1,2", at: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC), expected: false},
+		{name: "day or weekday", expr: "0 0 3 * 1", at: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC), expected: true},
+		{name: "month", expr: "0 0 * 4 *", at: time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC), expected: false},
+		{name: "fields", expr: "0 0 * *", err: true},
+		{name: "out of range", expr: "0 24 * * *", err: true},
+		{name: "invalid", expr: "a 0 * * *", err: true},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			s, err := parseCron(tc.expr)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, s.matches(tc.at))
+		})
+	}
+}
+
+func TestMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	schedule, err := weeklyWindowToCron(WeeklyMaintenanceWindow{Days: []WeeklyMaintenanceWindowDays{Saturday, Sunday}, StartTime: "23:30"})
+	require.NoError(t, err)
+	assert.Equal(t, "30 23 * * 6,0", schedule)
+	_, err = weeklyWindowToCron(WeeklyMaintenanceWindow{Days: []WeeklyMaintenanceWindowDays{Sunday}, StartTime: "24:00"})
+	assert.ErrorIs(t, err, errInvalidStartTime)
+
+	s, err := parseCron(schedule)
+	require.NoError(t, err)
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	// Saturday 2024-03-09 23:30 in Berlin is 22:30 UTC.
+	start := time.Date(2024, 3, 9, 22, 30, 0, 0, time.UTC)
+	assert.True(t, maintenanceWindowOpen(s, loc, 60, start))
+	assert.True(t, maintenanceWindowOpen(s, loc, 60, start.Add(59*time.Minute)))
+	assert.False(t, maintenanceWindowOpen(s, loc, 60, start.Add(60*time.Minute)))
+	assert.False(t, maintenanceWindowOpen(s, loc, 60, start.Add(-time.Minute)))
+
+	next, ok := nextMaintenanceWindowStart(s, loc, time.Date(2024, 3, 6, 12, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, start, next)
+	next, ok = nextMaintenanceWindowStart(s, loc, start)
+	require.True(t, ok)
+	assert.Equal(t, start.Add(24*time.Hour), next)
+
+	never, err := parseCron("0 0 31 2 *")
+	require.NoError(t, err)
+	_, ok = nextMaintenanceWindowStart(never, time.UTC, start)
+	assert.False(t, ok)
+}
@@ -0,0 +1,98 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// MigrateDatabase applies the pending schema migrations of the Everest database.
+func MigrateDatabase(c *config.EverestConfig, l *zap.SugaredLogger) error {
+	db, err := newDatabase(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close() //nolint:errcheck
+
+	v, err := db.Migrate()
+	if err != nil {
+		return err
+	}
+	l.Infof("The database schema is at version %d", v)
+	return nil
+}
+
+// RevertMigrations reverts the given number of the last schema migrations applied to the Everest database.
+func RevertMigrations(c *config.EverestConfig, l *zap.SugaredLogger, steps int) error {
+	db, err := newDatabase(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close() //nolint:errcheck
+
+	v, err := db.MigrateDown(steps)
+	if err != nil {
+		return err
+	}
+	l.Infof("Reverted %d migrations, the database schema is at version %d", steps, v)
+	return nil
+}
+
+// PrintMigrationStatus logs the state of the schema migrations of the Everest database.
+// An error is returned if the last migration failed halfway.
+func PrintMigrationStatus(c *config.EverestConfig, l *zap.SugaredLogger) error {
+	db, err := newDatabase(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close() //nolint:errcheck
+
+	status, err := db.MigrationStatus()
+	if err != nil {
+		return err
+	}
+	l.Infof("The database schema is at version %d, the latest migration is %d", status.Version, status.Latest)
+	if len(status.Pending) != 0 {
+		l.Infof("Pending migrations: %v", status.Pending)
+	}
+	if status.Dirty {
+		return fmt.Errorf("migration %d failed halfway and shall be fixed by hand", status.Version)
+	}
+	return nil
+}
+
+// checkMigrations returns an error unless the schema of the database is migrated up to the latest
+// migration. The newer schemas are accepted so that the server may be rolled back before the migrations.
+func checkMigrations(db *model.Database) error {
+	status, err := db.MigrationStatus()
+	if err != nil {
+		return err
+	}
+	if status.Dirty {
+		return fmt.Errorf("migration %d failed halfway and shall be fixed by hand", status.Version)
+	}
+	if status.Version < status.Latest {
+		return errors.New("the database schema is not migrated to the latest version; run the migrate up command first")
+	}
+	return nil
+}
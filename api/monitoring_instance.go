@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/AlekSi/pointer"
 	"github.com/google/uuid"
@@ -39,7 +40,11 @@ func (e *EverestServer) CreateMonitoringInstance(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
 	}
 
-	i, err := e.storage.GetMonitoringInstance(params.Name)
+	if code, err := e.checkProjectExists(ctx.Request().Context(), pointer.GetString(params.Project)); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	i, err := e.storage.GetMonitoringInstance(ctx.Request().Context(), params.Name)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, Error{
@@ -51,22 +56,34 @@ func (e *EverestServer) CreateMonitoringInstance(ctx echo.Context) error {
 			Message: pointer.ToString("Monitoring instance with the same name already exists"),
 		})
 	}
+	if code, err := e.checkMonitoringInstanceNotInTrash(ctx.Request().Context(), params.Name); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
 
-	apiKeyID, err := e.createAndStorePMMApiKey(
-		ctx.Request().Context(), params.Name,
-		params.Url, params.Pmm.ApiKey, params.Pmm.User, params.Pmm.Password,
-	)
+	var apiKeyID, username string
+	if params.Type == MonitoringInstanceCreateParamsTypePrometheus {
+		apiKeyID, err = e.storePrometheusCredentials(ctx.Request().Context(), params.Prometheus)
+		username = params.Prometheus.Username
+	} else {
+		apiKeyID, err = e.createAndStorePMMApiKey(
+			ctx.Request().Context(), params.Name,
+			params.Url, params.Pmm.ApiKey, params.Pmm.User, params.Pmm.Password,
+		)
+	}
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, Error{
 			Message: pointer.ToString(err.Error()),
 		})
 	}
 
-	i, err = e.storage.CreateMonitoringInstance(&model.MonitoringInstance{
+	i, err = e.storage.CreateMonitoringInstance(ctx.Request().Context(), &model.MonitoringInstance{
 		Type:           model.MonitoringInstanceType(params.Type),
 		Name:           params.Name,
 		URL:            params.Url,
 		APIKeySecretID: apiKeyID,
+		Username:       username,
+		Project:        pointer.GetString(params.Project),
+		CreatedBy:      creatorFrom(ctx),
 	})
 	if err != nil {
 		e.l.Error(err)
@@ -83,8 +100,19 @@ func (e *EverestServer) CreateMonitoringInstance(ctx echo.Context) error {
 }
 
 // ListMonitoringInstances lists all monitoring instances.
-func (e *EverestServer) ListMonitoringInstances(ctx echo.Context) error {
-	list, err := e.storage.ListMonitoringInstances()
+func (e *EverestServer) ListMonitoringInstances(ctx echo.Context, params ListMonitoringInstancesParams) error {
+	list, total, err := e.storage.ListMonitoringInstances(ctx.Request().Context(), model.ListMonitoringInstancesParams{
+		SortBy:     string(pointer.Get(params.SortBy)),
+		Order:      string(pointer.Get(params.Order)),
+		Type:       pointer.GetString(params.Type),
+		NamePrefix: pointer.GetString(params.NamePrefix),
+		Projects:   callerProjects(ctx),
+		Deleted:    pointer.GetBool(params.Deleted),
+		Pagination: model.Pagination{
+			Limit:  pointer.GetInt(params.Limit),
+			Offset: pointer.GetInt(params.Offset),
+		},
+	})
 	if err != nil {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get a list of monitoring instances")})
@@ -96,12 +124,13 @@ func (e *EverestServer) ListMonitoringInstances(ctx echo.Context) error {
 		result = append(result, e.monitoringInstanceToAPIJson(&i))
 	}
 
+	ctx.Response().Header().Set(totalCountHeader, strconv.Itoa(total))
 	return ctx.JSON(http.StatusOK, result)
 }
 
 // GetMonitoringInstance retrieves a monitoring instance.
 func (e *EverestServer) GetMonitoringInstance(ctx echo.Context, name string) error {
-	i, err := e.storage.GetMonitoringInstance(name)
+	i, err := e.storage.GetMonitoringInstance(ctx.Request().Context(), name)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Monitoring instance not found")})
@@ -110,6 +139,7 @@ func (e *EverestServer) GetMonitoringInstance(ctx echo.Context, name string) err
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not find monitoring instance")})
 	}
 
+	ctx.Response().Header().Set("ETag", versionETag(i.Version))
 	return ctx.JSON(http.StatusOK, e.monitoringInstanceToAPIJson(i))
 }
 
@@ -120,16 +150,27 @@ func (e *EverestServer) UpdateMonitoringInstance(ctx echo.Context, name string)
 		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
 	}
 
-	i, err := e.storage.GetMonitoringInstance(name)
+	i, err := e.storage.GetMonitoringInstance(ctx.Request().Context(), name)
 	if err != nil {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusNotFound, Error{
 			Message: pointer.ToString("Could not find monitoring instance"),
 		})
 	}
+	version, code, err := checkVersion(ctx, "monitoring instance", params.Version, i.Version)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	params.Version = version
 
-	var apiKeyID *string
-	if params.Pmm != nil {
+	monitoringType := i.Type
+	if params.Type != "" {
+		monitoringType = model.MonitoringInstanceType(params.Type)
+	}
+
+	var apiKeyID, username *string
+	switch {
+	case params.Pmm != nil && monitoringType == model.PMMMonitoringInstanceType:
 		keyID, err := e.createAndStorePMMApiKey(
 			ctx.Request().Context(), i.Name,
 			params.Url, params.Pmm.ApiKey, params.Pmm.User, params.Pmm.Password,
@@ -141,14 +182,29 @@ func (e *EverestServer) UpdateMonitoringInstance(ctx echo.Context, name string)
 		}
 
 		apiKeyID = &keyID
+		username = pointer.ToString("")
+	case params.Prometheus != nil && monitoringType == model.PrometheusMonitoringInstanceType:
+		keyID, err := e.storePrometheusCredentials(ctx.Request().Context(), params.Prometheus)
+		if err != nil {
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString(err.Error()),
+			})
+		}
+
+		apiKeyID = &keyID
+		username = &params.Prometheus.Username
+	case params.Pmm != nil || params.Prometheus != nil:
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("Credentials do not match the monitoring type %s", monitoringType)),
+		})
 	}
 
-	return e.performMonitoringInstanceUpdate(ctx, name, apiKeyID, i.APIKeySecretID, params)
+	return e.performMonitoringInstanceUpdate(ctx, name, apiKeyID, username, i.APIKeySecretID, params)
 }
 
 // DeleteMonitoringInstance deletes a monitoring instance.
 func (e *EverestServer) DeleteMonitoringInstance(ctx echo.Context, name string) error {
-	i, err := e.storage.GetMonitoringInstance(name)
+	i, err := e.storage.GetMonitoringInstance(ctx.Request().Context(), name)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusInternalServerError, Error{
@@ -161,64 +217,82 @@ func (e *EverestServer) DeleteMonitoringInstance(ctx echo.Context, name string)
 		})
 	}
 
-	ks, err := e.storage.ListKubernetesClusters(ctx.Request().Context())
-	if err != nil {
-		return errors.Join(err, errors.New("could not list Kubernetes clusters"))
-	}
-	if len(ks) == 0 {
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("No registered kubernetes clusters available")})
-	}
-	// FIXME: Revisit it once multi k8s support will be enabled
-	_, kubeClient, _, err := e.initKubeClient(ctx.Request().Context(), ks[0].ID)
+	pending, statuses, err := e.deleteConfigFromClusters(ctx.Request().Context(), model.ConfigKindMonitoringInstance, i.Name, i)
 	if err != nil {
-		e.l.Error(errors.Join(err, errors.New("could not init kube client")))
-		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not make connection to the kubernetes cluster")})
-	}
-
-	err = kubeClient.DeleteConfig(ctx.Request().Context(), i, func(ctx context.Context, name string) (bool, error) {
-		return kubernetes.IsMonitoringConfigInUse(ctx, name, kubeClient)
-	})
-	if err != nil && !errors.Is(err, kubernetes.ErrConfigInUse) {
-		e.l.Error(errors.Join(err, errors.New("could not delete monitoring config from kubernetes cluster")))
+		e.l.Error(errors.Join(err, errors.New("could not delete monitoring config from kubernetes clusters")))
 		if errors.Is(err, kubernetes.ErrConfigInUse) {
-			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not delete monitoring config from the Kubernetes cluster")})
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Cannot delete the monitoring instance because it's used on the Kubernetes cluster")})
 		}
 		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString(err.Error())})
 	}
-	err = e.deleteMonitoringConfig(ctx.Request().Context(), i)
-
-	if err != nil {
+	if err := e.deleteMonitoringConfig(ctx.Request().Context(), i, pending); err != nil {
 		return ctx.JSON(http.StatusInternalServerError, Error{
 			Message: pointer.ToString(err.Error()),
 		})
 	}
 
+	if len(pending) != 0 {
+		return ctx.JSON(http.StatusAccepted, statuses)
+	}
 	return ctx.NoContent(http.StatusNoContent)
 }
 
-func (e *EverestServer) deleteMonitoringConfig(c context.Context, i *model.MonitoringInstance) error {
+func (e *EverestServer) deleteMonitoringConfig(c context.Context, i *model.MonitoringInstance, pending []model.ConfigDeletion) error {
 	return e.storage.Transaction(func(tx *gorm.DB) error {
-		if err := e.storage.DeleteMonitoringInstance(i.Name, tx); err != nil {
+		if err := e.storage.DeleteMonitoringInstance(c, i.Name, tx); err != nil {
 			e.l.Error(err)
 			return errors.New("could not delete monitoring instance")
 		}
-
-		_, err := e.secretsStorage.DeleteSecret(c, i.APIKeySecretID)
-		if err != nil {
-			return errors.Join(err, fmt.Errorf("could not delete monitoring instance API key secret %s", i.APIKeySecretID))
+		if err := e.storage.DeleteConfigSyncs(c, model.ConfigKindMonitoringInstance, i.Name, tx); err != nil {
+			e.l.Error(err)
+			return errors.New("could not delete monitoring instance sync status")
 		}
-
-		return nil
+		if err := e.savePendingConfigDeletions(c, pending, tx); err != nil {
+			return err
+		}
+		if err := e.storage.DeleteConfigRollout(c, model.ConfigKindMonitoringInstance, i.Name, tx); err != nil {
+			e.l.Error(err)
+			return errors.New("could not delete monitoring instance rollout")
+		}
+		// The API key is kept for the monitoring instance to be restored from the trash.
+		if e.config.TrashRetention != 0 {
+			return nil
+		}
+		return e.purgeMonitoringConfig(c, i, tx)
 	})
 }
 
+// purgeMonitoringConfig deletes the monitoring instance and its API key permanently.
+func (e *EverestServer) purgeMonitoringConfig(c context.Context, i *model.MonitoringInstance, tx *gorm.DB) error {
+	if err := e.storage.PurgeMonitoringInstance(c, i.Name, tx); err != nil {
+		e.l.Error(err)
+		return errors.New("could not purge monitoring instance")
+	}
+
+	_, err := e.secretsStorage.DeleteSecret(c, i.APIKeySecretID)
+	if err != nil {
+		return errors.Join(err, fmt.Errorf("could not delete monitoring instance API key secret %s", i.APIKeySecretID))
+	}
+
+	return nil
+}
+
 // monitoringInstanceToAPIJson converts monitoring instance model to API JSON response.
 func (e *EverestServer) monitoringInstanceToAPIJson(i *model.MonitoringInstance) *MonitoringInstance {
-	return &MonitoringInstance{
-		Type: MonitoringInstanceBaseWithNameType(i.Type),
-		Name: i.Name,
-		Url:  i.URL,
+	res := &MonitoringInstance{
+		Type:      MonitoringInstanceBaseWithNameType(i.Type),
+		Name:      i.Name,
+		Url:       i.URL,
+		Version:   pointer.ToInt64(i.Version),
+		DeletedAt: i.DeletedAt,
+		CreatedAt: &i.CreatedAt,
+		UpdatedAt: &i.UpdatedAt,
+		CreatedBy: pointer.ToStringOrNil(i.CreatedBy),
+	}
+	if i.Project != "" {
+		res.Project = &i.Project
 	}
+	return res
 }
 
 func (e *EverestServer) createAndStorePMMApiKey(ctx context.Context, name, url, apiKey, user, password string) (string, error) {
@@ -244,50 +318,55 @@ func (e *EverestServer) createAndStorePMMApiKey(ctx context.Context, name, url,
 	return apiKeyID, nil
 }
 
+// storePrometheusCredentials stores the basic auth password or the bearer token
+// of a prometheus monitoring instance in secrets storage and returns its ID.
+func (e *EverestServer) storePrometheusCredentials(ctx context.Context, spec *PrometheusMonitoringInstanceSpec) (string, error) {
+	secret := spec.BearerToken
+	if spec.Username != "" {
+		secret = spec.Password
+	}
+
+	secretID := uuid.NewString()
+	if err := e.secretsStorage.CreateSecret(ctx, secretID, secret); err != nil {
+		e.l.Error(err)
+		return "", errors.New("could not save prometheus credentials to secrets storage")
+	}
+
+	return secretID, nil
+}
+
 func (e *EverestServer) performMonitoringInstanceUpdate(
-	ctx echo.Context, name string, apiKeyID *string, previousAPIKeyID string,
+	ctx echo.Context, name string, apiKeyID, username *string, previousAPIKeyID string,
 	params *UpdateMonitoringInstanceJSONRequestBody,
 ) error {
 	var monitoringInstance *model.MonitoringInstance
 	err := e.storage.Transaction(func(tx *gorm.DB) error {
-		ks, err := e.storage.ListKubernetesClusters(ctx.Request().Context())
-		if err != nil {
-			return errors.Join(err, errors.New("could not list Kubernetes clusters"))
-		}
-		if len(ks) == 0 {
-			return errors.New("no registered Kubernetes clusters available")
-		}
-		err = e.storage.UpdateMonitoringInstance(name, model.UpdateMonitoringInstanceParams{
+		err := e.storage.UpdateMonitoringInstance(ctx.Request().Context(), name, model.UpdateMonitoringInstanceParams{
 			Type:           (*model.MonitoringInstanceType)(&params.Type),
 			URL:            &params.Url,
 			APIKeySecretID: apiKeyID,
+			Username:       username,
+			Version:        params.Version,
 		})
 		if err != nil {
-			if _, err := e.secretsStorage.DeleteSecret(ctx.Request().Context(), *apiKeyID); err != nil {
-				return errors.Join(err, fmt.Errorf("could not delete secret %s from secret storage", *apiKeyID))
+			if apiKeyID != nil {
+				if _, err := e.secretsStorage.DeleteSecret(ctx.Request().Context(), *apiKeyID); err != nil {
+					return errors.Join(err, fmt.Errorf("could not delete secret %s from secret storage", *apiKeyID))
+				}
+			}
+			if errors.Is(err, model.ErrVersionMismatch) {
+				return err
 			}
 
 			e.l.Error(err)
 			return errors.New("could not update monitoring instance")
 		}
 
-		monitoringInstance, err = e.storage.GetMonitoringInstance(name)
+		monitoringInstance, err = e.storage.GetMonitoringInstance(ctx.Request().Context(), name)
 		if err != nil {
 			e.l.Error(err)
 			return errors.New("could not find updated monitoring instance")
 		}
-		// FIXME: Revisit it once multi k8s support will be enabled
-		// FIXME: This is not recommended to do network calls in a database transaction
-		// This will be removed during the implementation of multi k8s support
-		// However, right now it guarantees data consistency
-		_, kubeClient, _, err := e.initKubeClient(ctx.Request().Context(), ks[0].ID)
-		if err != nil {
-			return errors.Join(err, errors.New("could not init kube client to update config"))
-		}
-
-		if err := kubeClient.UpdateConfig(ctx.Request().Context(), monitoringInstance, e.secretsStorage.GetSecret); err != nil {
-			return errors.Join(err, errors.New("could not update config"))
-		}
 
 		if apiKeyID != nil {
 			if _, err := e.secretsStorage.DeleteSecret(context.Background(), previousAPIKeyID); err != nil {
@@ -298,10 +377,20 @@ func (e *EverestServer) performMonitoringInstanceUpdate(
 		return nil
 	})
 	if err != nil {
+		if errors.Is(err, model.ErrVersionMismatch) {
+			return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(versionConflictError("monitoring instance").Error())})
+		}
 		return ctx.JSON(http.StatusInternalServerError, Error{
 			Message: pointer.ToString(err.Error()),
 		})
 	}
 
+	// The Kubernetes clusters which could not be synced now are retried by the config syncer.
+	if _, err := e.syncConfig(ctx.Request().Context(), monitoringInstanceSyncedConfig(monitoringInstance), false); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not sync config")))
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not sync config to the kubernetes clusters")})
+	}
+
+	ctx.Response().Header().Set("ETag", versionETag(monitoringInstance.Version))
 	return ctx.JSON(http.StatusOK, e.monitoringInstanceToAPIJson(monitoringInstance))
 }
@@ -0,0 +1,238 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+	"github.com/percona/percona-everest-backend/pkg/pmm"
+)
+
+const monitoringProbeTimeout = 10 * time.Second
+
+var errRemoteWriteUnauthorized = errors.New("remote write endpoint rejected the credentials")
+
+// GetMonitoringInstanceStatus checks the health of a monitoring instance.
+func (e *EverestServer) GetMonitoringInstanceStatus(ctx echo.Context, name string) error {
+	c := ctx.Request().Context()
+	i, err := e.storage.GetMonitoringInstance(c, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Monitoring instance not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not find monitoring instance")})
+	}
+
+	status := e.probeMonitoringInstance(c, i)
+
+	ks, err := e.storage.ListKubernetesClusters(c)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list Kubernetes clusters")})
+	}
+	status.KubernetesClusters = make([]MonitoringInstanceClusterStatus, 0, len(ks))
+	for _, k := range ks {
+		s, ok := e.monitoringInstanceClusterStatus(c, k.ID, i.Name)
+		if ok {
+			status.KubernetesClusters = append(status.KubernetesClusters, s)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, status)
+}
+
+// probeMonitoringInstance checks whether the monitoring instance responds to
+// the backend and accepts the stored credentials.
+func (e *EverestServer) probeMonitoringInstance(ctx context.Context, i *model.MonitoringInstance) *MonitoringInstanceStatus {
+	status := &MonitoringInstanceStatus{
+		Name:      i.Name,
+		CheckedAt: time.Now().UTC(),
+	}
+
+	secret, err := e.secretsStorage.GetSecret(ctx, i.APIKeySecretID)
+	if err != nil {
+		e.l.Error(err)
+		status.Error = pointer.ToString("Could not get monitoring instance credentials from secrets storage")
+		return status
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, monitoringProbeTimeout)
+	defer cancel()
+
+	if i.Type == model.PrometheusMonitoringInstanceType {
+		err = probeRemoteWrite(ctx, i.URL, i.Username, secret)
+	} else {
+		var version string
+		version, err = pmm.GetVersion(ctx, i.URL, secret)
+		status.Version = pointer.ToString(version)
+	}
+
+	switch {
+	case err == nil:
+		status.Reachable = true
+		status.Authenticated = true
+	case errors.Is(err, pmm.ErrUnauthorized), errors.Is(err, errRemoteWriteUnauthorized):
+		status.Reachable = true
+		status.Error = pointer.ToString(err.Error())
+	default:
+		var statusErr *unexpectedStatusError
+		status.Reachable = errors.As(err, &statusErr)
+		status.Error = pointer.ToString(err.Error())
+	}
+
+	return status
+}
+
+// unexpectedStatusError is returned when the monitoring instance
+// responds with an HTTP status code other than an authentication failure.
+type unexpectedStatusError struct {
+	code int
+}
+
+func (e *unexpectedStatusError) Error() string {
+	return fmt.Sprintf("monitoring instance returned an unexpected HTTP status code %d", e.code)
+}
+
+// probeRemoteWrite sends a GET request with the credentials to the remote write endpoint.
+// Remote write endpoints accept POST requests only so any response other than
+// an authentication failure means the endpoint is reachable with the credentials.
+func probeRemoteWrite(ctx context.Context, url, username, secret string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Close = true
+	if username != "" {
+		req.SetBasicAuth(username, secret)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+secret)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return errRemoteWriteUnauthorized
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return &unexpectedStatusError{code: resp.StatusCode}
+	}
+
+	return nil
+}
+
+// monitoringInstanceClusterStatus returns the reachability of the monitoring instance
+// from the Kubernetes cluster. It returns false if the Kubernetes cluster has no monitoring config
+// of the monitoring instance.
+func (e *EverestServer) monitoringInstanceClusterStatus(
+	ctx context.Context, kubernetesID, name string,
+) (MonitoringInstanceClusterStatus, bool) {
+	status := MonitoringInstanceClusterStatus{
+		KubernetesId: kubernetesID,
+		Reachability: MonitoringInstanceClusterStatusReachabilityUnknown,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.config.ClusterRequestTimeout)
+	defer cancel()
+
+	_, kubeClient, _, err := e.initKubeClient(ctx, kubernetesID)
+	if err != nil {
+		status.Error = pointer.ToString(err.Error())
+		return status, true
+	}
+
+	if _, err := kubeClient.GetMonitoringConfig(ctx, name); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return status, false
+		}
+		e.l.Error(err)
+		status.Error = pointer.ToString("Could not get the monitoring config")
+		return status, true
+	}
+
+	inUse, err := kubernetes.IsMonitoringConfigInUse(ctx, name, kubeClient)
+	if err != nil {
+		e.l.Error(err)
+		status.Error = pointer.ToString("Could not check whether the monitoring config is in use")
+		return status, true
+	}
+	status.InUse = inUse
+
+	usedByVMAgent, err := kubeClient.IsMonitoringConfigUsedByVMAgent(ctx, name)
+	if err != nil || !usedByVMAgent {
+		if err != nil {
+			e.l.Error(err)
+			status.Error = pointer.ToString("Could not check whether the VMAgent writes to the monitoring instance")
+		}
+		return status, true
+	}
+
+	stats, err := kubeClient.GetVMAgentRemoteWriteStats(ctx)
+	if err != nil {
+		if !errors.Is(err, kubernetes.ErrNoVMAgentPods) {
+			e.l.Error(err)
+		}
+		status.Error = pointer.ToString(err.Error())
+		return status, true
+	}
+	status.Requests = &stats.Requests
+	status.Errors = pointer.ToInt64(stats.Errors)
+	status.Reachability = remoteWriteReachability(stats)
+
+	return status, true
+}
+
+// remoteWriteReachability derives the reachability from the remote write counters.
+// The counters are cumulative so a failure reported next to successful requests is not
+// considered permanent.
+func remoteWriteReachability(stats *kubernetes.VMAgentRemoteWriteStats) MonitoringInstanceClusterStatusReachability {
+	var succeeded, unauthorized int64
+	for code, n := range stats.Requests {
+		switch {
+		case len(code) == 3 && code[0] == '2':
+			succeeded += n
+		case code == "401" || code == "403":
+			unauthorized += n
+		}
+	}
+
+	switch {
+	case succeeded > 0:
+		return MonitoringInstanceClusterStatusReachabilityReachable
+	case unauthorized > 0:
+		return MonitoringInstanceClusterStatusReachabilityUnauthorized
+	case stats.Errors > 0:
+		return MonitoringInstanceClusterStatusReachabilityUnreachable
+	}
+
+	return MonitoringInstanceClusterStatusReachabilityUnknown
+}
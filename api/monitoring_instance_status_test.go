@@ -0,0 +1,86 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+func TestRemoteWriteReachability(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name  string
+		stats kubernetes.VMAgentRemoteWriteStats
+		want  MonitoringInstanceClusterStatusReachability
+	}{
+		{
+			name:  "no requests",
+			stats: kubernetes.VMAgentRemoteWriteStats{Requests: map[string]int64{}},
+			want:  MonitoringInstanceClusterStatusReachabilityUnknown,
+		},
+		{
+			name:  "successful requests",
+			stats: kubernetes.VMAgentRemoteWriteStats{Requests: map[string]int64{"204": 3, "401": 1}, Errors: 1},
+			want:  MonitoringInstanceClusterStatusReachabilityReachable,
+		},
+		{
+			name:  "rejected credentials",
+			stats: kubernetes.VMAgentRemoteWriteStats{Requests: map[string]int64{"401": 2}, Errors: 1},
+			want:  MonitoringInstanceClusterStatusReachabilityUnauthorized,
+		},
+		{
+			name:  "connection errors",
+			stats: kubernetes.VMAgentRemoteWriteStats{Requests: map[string]int64{"502": 2}, Errors: 4},
+			want:  MonitoringInstanceClusterStatusReachabilityUnreachable,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, remoteWriteReachability(&tc.stats))
+		})
+	}
+}
+
+func TestProbeRemoteWrite(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); ok && user == "u" && password == "p" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if r.Header.Get("Authorization") == "Bearer t" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	require.NoError(t, probeRemoteWrite(context.Background(), srv.URL, "u", "p"))
+	require.NoError(t, probeRemoteWrite(context.Background(), srv.URL, "", "t"))
+	require.ErrorIs(t, probeRemoteWrite(context.Background(), srv.URL, "u", "wrong"), errRemoteWriteUnauthorized)
+}
@@ -0,0 +1,178 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// ListKubernetesClusterNamespaces lists the namespaces of a kubernetes cluster.
+func (e *EverestServer) ListKubernetesClusterNamespaces(ctx echo.Context, kubernetesID string) error {
+	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	namespaces, err := kubeClient.ListNamespaces(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list namespaces")})
+	}
+
+	res := make(NamespaceList, 0, len(namespaces))
+	for i := range namespaces {
+		res = append(res, namespaceToAPIJson(&namespaces[i]))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// PrepareKubernetesClusterNamespace creates and labels a namespace of a kubernetes cluster,
+// copies the required secrets into it and makes the operators watch it.
+func (e *EverestServer) PrepareKubernetesClusterNamespace(ctx echo.Context, kubernetesID string) error {
+	var params NamespaceParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := validateNamespaceParams(params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	k, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if params.Name == k.Namespace {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("The namespace of Everest is prepared already")})
+	}
+
+	ns, created, err := kubeClient.EnsureManagedNamespace(ctx.Request().Context(), params.Name, pointer.Get(params.Labels))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not prepare the namespace")})
+	}
+
+	copied := make([]string, 0, len(pointer.Get(params.CopySecrets)))
+	for _, name := range pointer.Get(params.CopySecrets) {
+		if err := kubeClient.CopySecret(ctx.Request().Context(), name, params.Name); err != nil {
+			e.l.Error(err)
+			if k8serrors.IsNotFound(err) {
+				return ctx.JSON(http.StatusBadRequest, Error{
+					Message: pointer.ToString(fmt.Sprintf("Secret %s does not exist in the namespace of Everest", name)),
+				})
+			}
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString(fmt.Sprintf("Could not copy secret %s", name)),
+			})
+		}
+		copied = append(copied, name)
+	}
+
+	operators, err := namespaceOperators(ctx, kubeClient, params.Operators)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list the installed operators")})
+	}
+	watchedBy := make([]string, 0, len(operators))
+	for _, name := range operators {
+		updated, err := kubeClient.WatchNamespace(ctx.Request().Context(), name, params.Name)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString(fmt.Sprintf("Could not configure %s operator to watch the namespace", name)),
+			})
+		}
+		if updated {
+			watchedBy = append(watchedBy, name)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, NamespacePreparation{
+		Namespace:     namespaceToAPIJson(ns),
+		Created:       created,
+		CopiedSecrets: &copied,
+		WatchedBy:     &watchedBy,
+	})
+}
+
+// namespaceOperators returns the operators which shall watch a prepared namespace.
+// It defaults to the operators installed on the kubernetes cluster.
+func namespaceOperators(ctx echo.Context, kubeClient *kubernetes.Kubernetes, requested *[]string) ([]string, error) {
+	if requested != nil {
+		return *requested, nil
+	}
+
+	names := make([]string, 0, len(kubernetes.OperatorDeployments))
+	for name := range kubernetes.OperatorDeployments {
+		if _, err := kubeClient.GetOperator(ctx.Request().Context(), name); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func validateNamespaceParams(params NamespaceParams) error {
+	if errs := validation.IsDNS1123Label(params.Name); len(errs) != 0 {
+		return fmt.Errorf("invalid namespace name '%s': %s", params.Name, strings.Join(errs, "; "))
+	}
+	if err := validateLabels(pointer.Get(params.Labels)); err != nil {
+		return err
+	}
+	for _, name := range pointer.Get(params.CopySecrets) {
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) != 0 {
+			return fmt.Errorf("invalid secret name '%s': %s", name, strings.Join(errs, "; "))
+		}
+	}
+	for _, name := range pointer.Get(params.Operators) {
+		if _, ok := kubernetes.OperatorDeployments[name]; !ok {
+			return fmt.Errorf("unknown operator '%s'", name)
+		}
+	}
+	return nil
+}
+
+func namespaceToAPIJson(ns *corev1.Namespace) Namespace {
+	res := Namespace{
+		Name:    ns.Name,
+		Managed: kubernetes.IsManagedNamespace(ns),
+	}
+	if ns.Status.Phase != "" {
+		res.Phase = pointer.ToString(string(ns.Status.Phase))
+	}
+	if len(ns.Labels) != 0 {
+		res.Labels = &ns.Labels
+	}
+	if !ns.CreationTimestamp.IsZero() {
+		res.CreatedAt = pointer.ToTime(ns.CreationTimestamp.Time.UTC())
+	}
+	return res
+}
@@ -0,0 +1,322 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const (
+	projectLabel = "everest.percona.com/project"
+	envLabel     = "everest.percona.com/env"
+
+	namespaceQuotaName = "everest-quota"
+)
+
+// GetKubernetesClusterNamespaceTemplate returns the namespace template of a kubernetes cluster.
+func (e *EverestServer) GetKubernetesClusterNamespaceTemplate(ctx echo.Context, kubernetesID string) error {
+	t, err := e.storage.GetNamespaceTemplate(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Namespace template is not set")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get namespace template")})
+	}
+
+	res, err := namespaceTemplateToAPIJson(t)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get namespace template")})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// SetKubernetesClusterNamespaceTemplate sets the namespace template of a kubernetes cluster.
+func (e *EverestServer) SetKubernetesClusterNamespaceTemplate(ctx echo.Context, kubernetesID string) error {
+	var params NamespaceTemplate
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := validateNamespaceTemplate(params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	if _, err := e.storage.GetKubernetesCluster(ctx.Request().Context(), kubernetesID); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not find Kubernetes cluster")})
+	}
+
+	labels, err := json.Marshal(pointer.Get(params.Labels))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Could not encode labels")})
+	}
+	t := &model.NamespaceTemplate{
+		KubernetesID: kubernetesID,
+		Template:     params.Template,
+		Labels:       string(labels),
+	}
+	if params.ResourceQuota != nil {
+		t.QuotaCPU = pointer.GetString(params.ResourceQuota.Cpu)
+		t.QuotaMemory = pointer.GetString(params.ResourceQuota.Memory)
+		t.QuotaStorage = pointer.GetString(params.ResourceQuota.Storage)
+	}
+	if err := e.storage.SaveNamespaceTemplate(ctx.Request().Context(), t); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save namespace template")})
+	}
+
+	return ctx.JSON(http.StatusOK, params)
+}
+
+// DeleteKubernetesClusterNamespaceTemplate deletes the namespace template of a kubernetes cluster.
+func (e *EverestServer) DeleteKubernetesClusterNamespaceTemplate(ctx echo.Context, kubernetesID string) error {
+	if err := e.storage.DeleteNamespaceTemplate(ctx.Request().Context(), kubernetesID); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete namespace template")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func validateNamespaceTemplate(t NamespaceTemplate) error {
+	if !strings.Contains(t.Template, "{project}") {
+		return errors.New("namespace template shall contain the {project} placeholder")
+	}
+	if _, err := renderNamespace(t.Template, "project", "env"); err != nil {
+		return err
+	}
+
+	if err := validateLabels(pointer.Get(t.Labels)); err != nil {
+		return err
+	}
+
+	if t.ResourceQuota == nil {
+		return nil
+	}
+	for name, q := range map[string]*string{
+		"cpu":     t.ResourceQuota.Cpu,
+		"memory":  t.ResourceQuota.Memory,
+		"storage": t.ResourceQuota.Storage,
+	} {
+		if q == nil || *q == "" {
+			continue
+		}
+		if _, err := resource.ParseQuantity(*q); err != nil {
+			return fmt.Errorf("invalid %s quota '%s'", name, *q)
+		}
+	}
+	return nil
+}
+
+// validateLabels returns an error if a key or a value of the labels is invalid.
+func validateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+			return fmt.Errorf("invalid label key '%s': %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) != 0 {
+			return fmt.Errorf("invalid value of label '%s': %s", key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// renderNamespace returns the name of the namespace for the given project and environment.
+func renderNamespace(template, project, env string) (string, error) {
+	if env == "" && strings.Contains(template, "{env}") {
+		return "", fmt.Errorf("database cluster shall be labeled with %s to render the namespace template", envLabel)
+	}
+
+	ns := strings.NewReplacer("{project}", project, "{env}", env).Replace(template)
+	if errs := validation.IsDNS1123Label(ns); len(errs) != 0 {
+		return "", fmt.Errorf("'%s' rendered from the namespace template is not a valid namespace name: %s", ns, strings.Join(errs, "; "))
+	}
+	return ns, nil
+}
+
+// projectNamespacePlan describes the namespace of the project a database cluster belongs to.
+type projectNamespacePlan struct {
+	name      string
+	namespace *corev1.Namespace
+	quota     *corev1.ResourceQuota
+	exists    bool
+}
+
+// planProjectNamespace returns the namespace of the project the database cluster belongs to
+// if the kubernetes cluster has a namespace template or nil otherwise.
+func (e *EverestServer) planProjectNamespace(
+	ctx context.Context, kubernetesID string, kubeClient *kubernetes.Kubernetes, dbc *DatabaseCluster,
+) (*projectNamespacePlan, int, error) {
+	project := databaseClusterLabel(dbc, projectLabel)
+	if project == "" {
+		return nil, 0, nil
+	}
+
+	t, err := e.storage.GetNamespaceTemplate(ctx, kubernetesID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0, nil
+		}
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not get namespace template")
+	}
+
+	env := databaseClusterLabel(dbc, envLabel)
+	name, err := renderNamespace(t.Template, project, env)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	plan := &projectNamespacePlan{name: name}
+	_, err = kubeClient.GetNamespace(ctx, name)
+	if err == nil {
+		plan.exists = true
+		return plan, 0, nil
+	}
+	if !k8serrors.IsNotFound(err) {
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not get project namespace")
+	}
+
+	plan.namespace, plan.quota, err = projectNamespace(t, name, project, env)
+	if err != nil {
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not build project namespace")
+	}
+	return plan, 0, nil
+}
+
+// ensureProjectNamespace creates the namespace of the project the database cluster belongs to
+// if the kubernetes cluster has a namespace template. It returns the namespace the database cluster
+// shall be created in or an empty string for the namespace of the kubernetes cluster.
+func (e *EverestServer) ensureProjectNamespace(
+	ctx context.Context, kubernetesID string, kubeClient *kubernetes.Kubernetes, dbc *DatabaseCluster,
+) (string, int, error) {
+	plan, code, err := e.planProjectNamespace(ctx, kubernetesID, kubeClient, dbc)
+	if err != nil || plan == nil {
+		return "", code, err
+	}
+	if plan.exists {
+		return plan.name, 0, nil
+	}
+
+	if _, err := kubeClient.CreateNamespace(ctx, plan.namespace); err != nil {
+		e.l.Error(err)
+		return "", http.StatusInternalServerError, errors.New("could not create project namespace")
+	}
+	if plan.quota != nil {
+		if _, err := kubeClient.CreateResourceQuota(ctx, plan.quota); err != nil {
+			e.l.Error(err)
+			return "", http.StatusInternalServerError, errors.New("could not create resource quota of project namespace")
+		}
+	}
+
+	return plan.name, 0, nil
+}
+
+// projectNamespace returns the namespace of a project and its resource quota built from the template.
+// The returned quota is nil if the template does not limit any resources.
+func projectNamespace(t *model.NamespaceTemplate, name, project, env string) (*corev1.Namespace, *corev1.ResourceQuota, error) {
+	labels := map[string]string{}
+	if t.Labels != "" {
+		if err := json.Unmarshal([]byte(t.Labels), &labels); err != nil {
+			return nil, nil, errors.Join(err, errors.New("could not decode labels"))
+		}
+	}
+	labels[projectLabel] = project
+	if env != "" {
+		labels[envLabel] = env
+	}
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+
+	hard := corev1.ResourceList{}
+	for name, q := range map[corev1.ResourceName]string{
+		corev1.ResourceLimitsCPU:       t.QuotaCPU,
+		corev1.ResourceLimitsMemory:    t.QuotaMemory,
+		corev1.ResourceRequestsStorage: t.QuotaStorage,
+	} {
+		if q == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(q)
+		if err != nil {
+			return nil, nil, errors.Join(err, fmt.Errorf("invalid %s quota", name))
+		}
+		hard[name] = quantity
+	}
+	if len(hard) == 0 {
+		return ns, nil, nil
+	}
+
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      namespaceQuotaName,
+			Namespace: name,
+		},
+		Spec: corev1.ResourceQuotaSpec{Hard: hard},
+	}
+	return ns, quota, nil
+}
+
+func namespaceTemplateToAPIJson(t *model.NamespaceTemplate) (*NamespaceTemplate, error) {
+	res := &NamespaceTemplate{Template: t.Template}
+	if t.Labels != "" {
+		labels := map[string]string{}
+		if err := json.Unmarshal([]byte(t.Labels), &labels); err != nil {
+			return nil, errors.Join(err, errors.New("could not decode labels"))
+		}
+		if len(labels) != 0 {
+			res.Labels = &labels
+		}
+	}
+	if t.QuotaCPU != "" || t.QuotaMemory != "" || t.QuotaStorage != "" {
+		res.ResourceQuota = &struct {
+			Cpu     *string `json:"cpu,omitempty"`
+			Memory  *string `json:"memory,omitempty"`
+			Storage *string `json:"storage,omitempty"`
+		}{
+			Cpu:     pointer.ToStringOrNil(t.QuotaCPU),
+			Memory:  pointer.ToStringOrNil(t.QuotaMemory),
+			Storage: pointer.ToStringOrNil(t.QuotaStorage),
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,90 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestRenderNamespace(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		template string
+		project  string
+		env      string
+		want     string
+		wantErr  bool
+	}{
+		{template: "db-{project}-{env}", project: "shop", env: "prod", want: "db-shop-prod"},
+		{template: "db-{project}", project: "shop", want: "db-shop"},
+		{template: "db-{project}-{env}", project: "shop", wantErr: true},
+		{template: "db-{project}", project: "Shop_1", wantErr: true},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.template+"/"+tc.project+"/"+tc.env, func(t *testing.T) {
+			t.Parallel()
+			ns, err := renderNamespace(tc.template, tc.project, tc.env)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ns)
+		})
+	}
+}
+
+func TestProjectNamespace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("labels and quota", func(t *testing.T) {
+		t.Parallel()
+		ns, quota, err := projectNamespace(&model.NamespaceTemplate{
+			Labels:      `{"team":"payments"}`,
+			QuotaCPU:    "4",
+			QuotaMemory: "8G",
+		}, "db-shop-prod", "shop", "prod")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"team":       "payments",
+			projectLabel: "shop",
+			envLabel:     "prod",
+		}, ns.Labels)
+		require.NotNil(t, quota)
+		assert.Equal(t, "db-shop-prod", quota.Namespace)
+		assert.Equal(t, corev1.ResourceList{
+			corev1.ResourceLimitsCPU:    resource.MustParse("4"),
+			corev1.ResourceLimitsMemory: resource.MustParse("8G"),
+		}, quota.Spec.Hard)
+	})
+
+	t.Run("no quota", func(t *testing.T) {
+		t.Parallel()
+		ns, quota, err := projectNamespace(&model.NamespaceTemplate{}, "db-shop", "shop", "")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{projectLabel: "shop"}, ns.Labels)
+		assert.Nil(t, quota)
+	})
+}
@@ -0,0 +1,489 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// notificationEventTest is the type of the event sent by testing a notification channel.
+const notificationEventTest = "notification-channel-test"
+
+//nolint:gochecknoglobals
+var notificationSeverityLevels = map[string]int{
+	notificationSeverityInfo:     0,
+	notificationSeverityWarning:  1,
+	notificationSeverityCritical: 2,
+}
+
+// ListNotificationChannels lists the notification channels.
+func (e *EverestServer) ListNotificationChannels(ctx echo.Context) error {
+	channels, err := e.storage.ListNotificationChannels(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list notification channels")})
+	}
+
+	res := make([]NotificationChannel, 0, len(channels))
+	for _, c := range channels {
+		c := c
+		res = append(res, notificationChannelToAPI(&c))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// CreateNotificationChannel creates a notification channel.
+func (e *EverestServer) CreateNotificationChannel(ctx echo.Context) error {
+	var params CreateNotificationChannelParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := validateRFC1035(params.Name, "name"); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	target, secret := pointer.GetString(params.Target), pointer.GetString(params.Secret)
+	if err := e.validateNotificationChannel(string(params.Type), target, &secret); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	if _, err := e.storage.GetNotificationChannel(c, params.Name); err == nil {
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("Notification channel with the same name already exists")})
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get notification channel")})
+	}
+
+	channel := &model.NotificationChannel{
+		Name:   params.Name,
+		Type:   string(params.Type),
+		Target: target,
+	}
+	if secret != "" {
+		channel.SecretID = uuid.NewString()
+		if err := e.secretsStorage.CreateSecret(c, channel.SecretID, secret); err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the secret to secrets storage")})
+		}
+	}
+	if err := e.storage.CreateNotificationChannel(c, channel); err != nil {
+		e.l.Error(err)
+		e.deleteNotificationChannelSecret(c, channel.SecretID)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not create notification channel")})
+	}
+
+	return ctx.JSON(http.StatusOK, notificationChannelToAPI(channel))
+}
+
+// GetNotificationChannel returns a notification channel.
+func (e *EverestServer) GetNotificationChannel(ctx echo.Context, name string) error {
+	channel, err := e.storage.GetNotificationChannel(ctx.Request().Context(), name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Notification channel not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get notification channel")})
+	}
+
+	return ctx.JSON(http.StatusOK, notificationChannelToAPI(channel))
+}
+
+// UpdateNotificationChannel updates the target or the secret of a notification channel.
+func (e *EverestServer) UpdateNotificationChannel(ctx echo.Context, name string) error {
+	var params UpdateNotificationChannelParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	channel, err := e.storage.GetNotificationChannel(c, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Notification channel not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get notification channel")})
+	}
+
+	target := channel.Target
+	if params.Target != nil {
+		target = *params.Target
+	}
+	if err := e.validateNotificationChannel(channel.Type, target, params.Secret); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	update := model.UpdateNotificationChannelParams{Target: params.Target}
+	if params.Secret != nil {
+		update.SecretID = pointer.ToString(uuid.NewString())
+		if err := e.secretsStorage.CreateSecret(c, *update.SecretID, *params.Secret); err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the secret to secrets storage")})
+		}
+	}
+	if err := e.storage.UpdateNotificationChannel(c, name, update); err != nil {
+		e.l.Error(err)
+		if update.SecretID != nil {
+			e.deleteNotificationChannelSecret(c, *update.SecretID)
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not update notification channel")})
+	}
+	if update.SecretID != nil {
+		e.deleteNotificationChannelSecret(c, channel.SecretID)
+	}
+
+	return e.GetNotificationChannel(ctx, name)
+}
+
+// DeleteNotificationChannel deletes a notification channel along with its rules.
+func (e *EverestServer) DeleteNotificationChannel(ctx echo.Context, name string) error {
+	c := ctx.Request().Context()
+	channel, err := e.storage.GetNotificationChannel(c, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Notification channel not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get notification channel")})
+	}
+	if err := e.storage.DeleteNotificationChannel(c, name); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete notification channel")})
+	}
+	e.deleteNotificationChannelSecret(c, channel.SecretID)
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// TestNotificationChannel sends a test event to a notification channel.
+func (e *EverestServer) TestNotificationChannel(ctx echo.Context, name string) error {
+	c := ctx.Request().Context()
+	channel, err := e.storage.GetNotificationChannel(c, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Notification channel not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get notification channel")})
+	}
+
+	event := notificationEvent{
+		Type:     notificationEventTest,
+		Severity: notificationSeverityInfo,
+		Resource: "notificationchannels/" + name,
+		Message:  fmt.Sprintf("Test notification of channel %s", name),
+	}
+	if err := e.sendNotification(c, channel, event); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListNotificationRules lists the notification rules.
+func (e *EverestServer) ListNotificationRules(ctx echo.Context) error {
+	rules, err := e.storage.ListNotificationRules(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list notification rules")})
+	}
+
+	res := make([]NotificationRule, 0, len(rules))
+	for _, r := range rules {
+		r := r
+		res = append(res, notificationRuleToAPI(&r))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// CreateNotificationRule creates a notification rule.
+func (e *EverestServer) CreateNotificationRule(ctx echo.Context) error {
+	var params NotificationRuleParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := validateRFC1035(params.Name, "name"); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	if _, err := e.storage.GetNotificationChannel(c, params.ChannelName); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Notification channel not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get notification channel")})
+	}
+	if params.KubernetesId != nil {
+		if _, err := e.storage.GetKubernetesCluster(c, *params.KubernetesId); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("Kubernetes cluster not found")})
+			}
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get Kubernetes cluster")})
+		}
+	}
+	if _, err := e.storage.GetNotificationRule(c, params.Name); err == nil {
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("Notification rule with the same name already exists")})
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get notification rule")})
+	}
+
+	rule := &model.NotificationRule{
+		Name:         params.Name,
+		ChannelName:  params.ChannelName,
+		MinSeverity:  notificationSeverityWarning,
+		KubernetesID: params.KubernetesId,
+	}
+	if params.EventTypes != nil {
+		rule.EventTypes = strings.Join(*params.EventTypes, ",")
+	}
+	if params.MinSeverity != nil {
+		rule.MinSeverity = string(*params.MinSeverity)
+	}
+	if err := e.storage.CreateNotificationRule(c, rule); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not create notification rule")})
+	}
+
+	return ctx.JSON(http.StatusOK, notificationRuleToAPI(rule))
+}
+
+// GetNotificationRule returns a notification rule.
+func (e *EverestServer) GetNotificationRule(ctx echo.Context, name string) error {
+	rule, err := e.storage.GetNotificationRule(ctx.Request().Context(), name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Notification rule not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get notification rule")})
+	}
+
+	return ctx.JSON(http.StatusOK, notificationRuleToAPI(rule))
+}
+
+// DeleteNotificationRule deletes a notification rule.
+func (e *EverestServer) DeleteNotificationRule(ctx echo.Context, name string) error {
+	if err := e.storage.DeleteNotificationRule(ctx.Request().Context(), name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Notification rule not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete notification rule")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// validateNotificationChannel validates the target and the secret of a notification channel.
+// A nil secret means the stored secret of the channel is kept.
+func (e *EverestServer) validateNotificationChannel(channelType, target string, secret *string) error {
+	switch channelType {
+	case model.NotificationChannelSlack, model.NotificationChannelPagerDuty:
+		if secret == nil {
+			return nil
+		}
+		if *secret == "" {
+			return fmt.Errorf("secret is required for the %s notification channels", channelType)
+		}
+		if channelType == model.NotificationChannelSlack && !validateURL(*secret) {
+			return ErrInvalidURL("secret")
+		}
+	case model.NotificationChannelEmail:
+		if e.config.SMTPAddress == "" {
+			return errors.New("SMTP server is not configured")
+		}
+		if _, err := mail.ParseAddressList(target); err != nil {
+			return errors.Join(err, errors.New("target shall be a comma separated list of email addresses"))
+		}
+	case model.NotificationChannelWebhook:
+		if !validateURL(target) {
+			return ErrInvalidURL("target")
+		}
+	default:
+		return fmt.Errorf("notification channel type %s is not supported", channelType)
+	}
+	return nil
+}
+
+func (e *EverestServer) deleteNotificationChannelSecret(ctx context.Context, id string) {
+	if id == "" {
+		return
+	}
+	if _, err := e.secretsStorage.DeleteSecret(ctx, id); err != nil {
+		e.l.Warnf("Could not delete secret %s from secret storage due to error: %s", id, err)
+	}
+}
+
+func notificationChannelToAPI(c *model.NotificationChannel) NotificationChannel {
+	res := NotificationChannel{
+		Name:      c.Name,
+		Type:      NotificationChannelType(c.Type),
+		CreatedAt: c.CreatedAt,
+	}
+	if c.Target != "" {
+		res.Target = pointer.ToString(c.Target)
+	}
+	return res
+}
+
+func notificationRuleToAPI(r *model.NotificationRule) NotificationRule {
+	res := NotificationRule{
+		Name:         r.Name,
+		ChannelName:  r.ChannelName,
+		EventTypes:   []string{},
+		MinSeverity:  NotificationRuleMinSeverity(r.MinSeverity),
+		KubernetesId: r.KubernetesID,
+		CreatedAt:    r.CreatedAt,
+	}
+	if r.EventTypes != "" {
+		res.EventTypes = strings.Split(r.EventTypes, ",")
+	}
+	return res
+}
+
+// notificationRuleMatches returns true if the event shall be delivered by the notification rule.
+func notificationRuleMatches(r model.NotificationRule, event notificationEvent) bool {
+	if notificationSeverityLevels[event.Severity] < notificationSeverityLevels[r.MinSeverity] {
+		return false
+	}
+	if r.KubernetesID != nil && *r.KubernetesID != event.KubernetesID {
+		return false
+	}
+	if r.EventTypes == "" {
+		return true
+	}
+	for _, t := range strings.Split(r.EventTypes, ",") {
+		if t == event.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// dispatchNotification delivers the event to the channels of the matching notification rules.
+// An event is delivered to a channel once even if several of its rules match.
+func (e *EverestServer) dispatchNotification(ctx context.Context, event notificationEvent) {
+	rules, err := e.storage.ListNotificationRules(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list notification rules")))
+		return
+	}
+
+	delivered := make(map[string]struct{}, len(rules))
+	for _, r := range rules {
+		if _, ok := delivered[r.ChannelName]; ok || !notificationRuleMatches(r, event) {
+			continue
+		}
+		delivered[r.ChannelName] = struct{}{}
+
+		channel, err := e.storage.GetNotificationChannel(ctx, r.ChannelName)
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not get notification channel %s", r.ChannelName)))
+			continue
+		}
+		if err := e.sendNotification(ctx, channel, event); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not deliver %s notification to channel %s", event.Type, r.ChannelName)))
+		}
+	}
+}
+
+// sendNotification delivers the event to the notification channel.
+func (e *EverestServer) sendNotification(ctx context.Context, channel *model.NotificationChannel, event notificationEvent) error {
+	var secret string
+	if channel.SecretID != "" {
+		var err error
+		secret, err = e.secretsStorage.GetSecret(ctx, channel.SecretID)
+		if err != nil {
+			return errors.Join(err, errors.New("could not get the secret of the notification channel"))
+		}
+	}
+
+	switch channel.Type {
+	case model.NotificationChannelSlack:
+		return postJSON(ctx, secret, map[string]string{"text": notificationText(event)})
+	case model.NotificationChannelPagerDuty:
+		return postJSON(ctx, e.config.PagerDutyEventsURL, pagerDutyEvent(secret, event))
+	case model.NotificationChannelWebhook:
+		return postJSON(ctx, channel.Target, event)
+	case model.NotificationChannelEmail:
+		return e.sendNotificationEmail(channel.Target, event)
+	default:
+		return fmt.Errorf("notification channel type %s is not supported", channel.Type)
+	}
+}
+
+func notificationText(event notificationEvent) string {
+	return fmt.Sprintf("[%s] %s: %s", strings.ToUpper(event.Severity), event.Type, event.Message)
+}
+
+// pagerDutyEvent returns the PagerDuty Events API v2 trigger event of the notification event.
+func pagerDutyEvent(routingKey string, event notificationEvent) map[string]interface{} {
+	source := "everest"
+	if event.KubernetesID != "" {
+		source = "everest/" + event.KubernetesID
+	}
+	return map[string]interface{}{
+		"routing_key":  routingKey,
+		"event_action": "trigger",
+		"dedup_key":    strings.Join([]string{event.Type, event.KubernetesID, event.Resource}, "/"),
+		"payload": map[string]interface{}{
+			"summary":        event.Message,
+			"source":         source,
+			"severity":       event.Severity,
+			"timestamp":      event.Time,
+			"custom_details": event,
+		},
+	}
+}
+
+func (e *EverestServer) sendNotificationEmail(recipients string, event notificationEvent) error {
+	addresses, err := mail.ParseAddressList(recipients)
+	if err != nil {
+		return err
+	}
+	to := make([]string, 0, len(addresses))
+	for _, a := range addresses {
+		to = append(to, a.Address)
+	}
+
+	var auth smtp.Auth
+	if e.config.SMTPUsername != "" {
+		host, _, _ := strings.Cut(e.config.SMTPAddress, ":")
+		auth = smtp.PlainAuth("", e.config.SMTPUsername, e.config.SMTPPassword, host)
+	}
+	msg := fmt.Sprintf(
+		"From: %s\r\nTo: %s\r\nSubject: [Everest] %s\r\n\r\n%s\r\n",
+		e.config.SMTPFrom, strings.Join(to, ", "), notificationText(event), event.Message,
+	)
+	return smtp.SendMail(e.config.SMTPAddress, auth, e.config.SMTPFrom, to, []byte(msg))
+}
@@ -0,0 +1,104 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestNotificationRuleMatches(t *testing.T) {
+	t.Parallel()
+
+	event := notificationEvent{
+		Type:         resourceEventBackupFailed,
+		Severity:     notificationSeverityCritical,
+		KubernetesID: "k8s",
+	}
+	type testCase struct {
+		name     string
+		rule     model.NotificationRule
+		expected bool
+	}
+	cases := []testCase{
+		{name: "any event", rule: model.NotificationRule{MinSeverity: notificationSeverityInfo}, expected: true},
+		{
+			name:     "event type",
+			rule:     model.NotificationRule{EventTypes: "restore-failed,backup-failed", MinSeverity: notificationSeverityWarning},
+			expected: true,
+		},
+		{
+			name:     "other event type",
+			rule:     model.NotificationRule{EventTypes: "restore-failed", MinSeverity: notificationSeverityWarning},
+			expected: false,
+		},
+		{
+			name:     "kubernetes cluster",
+			rule:     model.NotificationRule{MinSeverity: notificationSeverityCritical, KubernetesID: pointer.ToString("k8s")},
+			expected: true,
+		},
+		{
+			name:     "other kubernetes cluster",
+			rule:     model.NotificationRule{MinSeverity: notificationSeverityCritical, KubernetesID: pointer.ToString("other")},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, notificationRuleMatches(tc.rule, event))
+		})
+	}
+
+	info := notificationEvent{Type: resourceEventRestoreCompleted, Severity: notificationSeverityInfo}
+	assert.False(t, notificationRuleMatches(model.NotificationRule{MinSeverity: notificationSeverityWarning}, info))
+}
+
+func TestResourceStatusStateObserve(t *testing.T) {
+	t.Parallel()
+
+	s := newResourceStatusState()
+	assert.Empty(t, s.observe("k8s", []watchedResource{
+		{kind: watchedDatabaseCluster, name: "db", state: "error"},
+		{kind: watchedRestore, name: "restore", state: "Starting"},
+	}))
+
+	events := s.observe("k8s", []watchedResource{
+		{kind: watchedDatabaseCluster, name: "db", state: "ready"},
+		{kind: watchedRestore, name: "restore", state: "Succeeded"},
+		{kind: watchedBackup, name: "backup", state: "Failed"},
+	})
+	types := make([]string, 0, len(events))
+	for _, event := range events {
+		assert.Equal(t, "k8s", event.KubernetesID)
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []string{
+		resourceEventDatabaseClusterRecovered,
+		resourceEventRestoreCompleted,
+		resourceEventBackupFailed,
+	}, types)
+
+	assert.Empty(t, s.observe("k8s", []watchedResource{
+		{kind: watchedDatabaseCluster, name: "db", state: "ready"},
+	}))
+}
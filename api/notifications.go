@@ -0,0 +1,102 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Severities of the notification events.
+const (
+	notificationSeverityInfo     = "info"
+	notificationSeverityWarning  = "warning"
+	notificationSeverityCritical = "critical"
+)
+
+// notificationTimeout is the deadline of delivering a notification event.
+const notificationTimeout = 10 * time.Second
+
+// notificationEvent is an event Everest notifies about.
+type notificationEvent struct {
+	Type         string    `json:"type"`
+	Severity     string    `json:"severity"`
+	KubernetesID string    `json:"kubernetesId"`
+	Resource     string    `json:"resource"`
+	Message      string    `json:"message"`
+	Time         time.Time `json:"time"`
+}
+
+// notify logs the event and delivers it to the notification webhook if one is configured
+// and to the channels of the matching notification rules.
+func (e *EverestServer) notify(ctx context.Context, event notificationEvent) {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	if event.Severity == notificationSeverityInfo {
+		e.l.Info(event.Message)
+	} else {
+		e.l.Warn(event.Message)
+	}
+
+	if e.config.NotificationWebhookURL != "" {
+		if err := postJSON(ctx, e.config.NotificationWebhookURL, event); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not deliver %s notification", event.Type)))
+		}
+	}
+	e.dispatchNotification(ctx, event)
+}
+
+// postJSON posts the JSON encoded payload to the URL.
+func postJSON(ctx context.Context, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return postBody(ctx, url, body, nil)
+}
+
+// postBody posts the JSON body to the URL with the additional headers.
+func postBody(ctx context.Context, url string, body []byte, headers map[string]string) error {
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("webhook returned HTTP status code %d: %s", resp.StatusCode, string(data))
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// ListKubernetesClusterOperators lists the operators installed on a kubernetes cluster.
+func (e *EverestServer) ListKubernetesClusterOperators(ctx echo.Context, kubernetesID string) error {
+	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	names := make([]string, 0, len(kubernetes.OperatorDeployments))
+	for name := range kubernetes.OperatorDeployments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := make(OperatorList, 0, len(names))
+	for _, name := range names {
+		op, err := kubeClient.GetOperator(ctx.Request().Context(), name)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				// The engine operator is not installed.
+				continue
+			}
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{
+				Message: pointer.ToString(fmt.Sprintf("Could not get %s operator", name)),
+			})
+		}
+		res = append(res, operatorToAPIJson(op))
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// UpgradeKubernetesClusterOperator upgrades an operator installed on a kubernetes cluster.
+func (e *EverestServer) UpgradeKubernetesClusterOperator(ctx echo.Context, kubernetesID string, operatorName string) error {
+	var params OperatorUpgrade
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if _, ok := kubernetes.OperatorDeployments[operatorName]; !ok {
+		return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Unknown operator")})
+	}
+
+	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	op, err := kubeClient.GetOperator(ctx.Request().Context(), operatorName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("The operator is not installed")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the operator")})
+	}
+
+	dbs, err := kubeClient.ListDatabaseClusters(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list database clusters")})
+	}
+
+	problems, err := operatorUpgradeProblems(operatorName, op.Version, params.TargetVersion, dbs.Items)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if len(problems) != 0 {
+		return ctx.JSON(http.StatusConflict, Error{
+			Message: pointer.ToString("The operator cannot be upgraded: " + strings.Join(problems, "; ")),
+		})
+	}
+
+	if err := kubeClient.UpgradeOperator(ctx.Request().Context(), operatorName, params.TargetVersion); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not upgrade the operator")})
+	}
+
+	if operatorName != kubernetes.EverestOperatorName {
+		// The engine reports the new operator version once the operator is rolled out.
+		e.waitGroup.Add(1)
+		go func() {
+			defer e.waitGroup.Done()
+			if _, err := e.refreshDatabaseEngines(context.Background(), kubernetesID); err != nil {
+				e.l.Error(errors.Join(err, errors.New("could not refresh database engines")))
+			}
+		}()
+	}
+
+	op, err = kubeClient.GetOperator(ctx.Request().Context(), operatorName)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the operator")})
+	}
+	return ctx.JSON(http.StatusOK, operatorToAPIJson(op))
+}
+
+// operatorUpgradeProblems returns the reasons the operator upgrade may break the running database clusters.
+// Percona operators support upgrades to the next minor version only, and the custom resources of the
+// database clusters are reconciled against the new operator right away, so the database clusters
+// managed by the operator shall be ready.
+func operatorUpgradeProblems(
+	operatorName, currentVersion, targetVersion string, dbs []everestv1alpha1.DatabaseCluster,
+) ([]string, error) {
+	target, err := goversion.NewVersion(targetVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target version '%s'", targetVersion)
+	}
+	current, err := goversion.NewVersion(currentVersion)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the installed version '%s'", currentVersion)
+	}
+
+	var problems []string
+	cur, tgt := current.Segments(), target.Segments()
+	switch {
+	case !target.GreaterThan(current):
+		problems = append(problems, fmt.Sprintf("the target version %s is not newer than the installed version %s", target, current))
+	case tgt[0] != cur[0]:
+		problems = append(problems, "upgrades across major versions are not supported")
+	case tgt[1] > cur[1]+1:
+		problems = append(problems, fmt.Sprintf("the operator shall be upgraded to %d.%d first", cur[0], cur[1]+1))
+	}
+
+	for _, db := range dbs {
+		if operatorName != kubernetes.EverestOperatorName && string(db.Spec.Engine.Type) != operatorName {
+			continue
+		}
+		state := db.Status.Status
+		if state == "" {
+			state = everestv1alpha1.AppStateUnknown
+		}
+		if state != everestv1alpha1.AppStateReady && state != everestv1alpha1.AppStatePaused {
+			problems = append(problems, fmt.Sprintf("database cluster %s is %s", db.Name, state))
+		}
+	}
+
+	return problems, nil
+}
+
+func operatorToAPIJson(op *kubernetes.Operator) Operator {
+	return Operator{
+		Name:       op.Name,
+		Deployment: op.Deployment,
+		Image:      op.Image,
+		Version:    op.Version,
+	}
+}
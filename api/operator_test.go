@@ -0,0 +1,72 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOperatorUpgradeProblems(t *testing.T) {
+	t.Parallel()
+
+	db := func(name string, engine everestv1alpha1.EngineType, state everestv1alpha1.AppState) everestv1alpha1.DatabaseCluster {
+		return everestv1alpha1.DatabaseCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       everestv1alpha1.DatabaseClusterSpec{Engine: everestv1alpha1.Engine{Type: engine}},
+			Status:     everestv1alpha1.DatabaseClusterStatus{Status: state},
+		}
+	}
+	dbs := []everestv1alpha1.DatabaseCluster{
+		db("mysql", everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateReady),
+		db("mongo", everestv1alpha1.DatabaseEnginePSMDB, everestv1alpha1.AppStateRestoring),
+	}
+
+	cases := []struct {
+		name     string
+		operator string
+		current  string
+		target   string
+		problems int
+		wantErr  bool
+	}{
+		{name: "next minor", operator: "pxc", current: "1.12.0", target: "1.13.0"},
+		{name: "patch", operator: "pxc", current: "1.13.0", target: "1.13.1"},
+		{name: "downgrade", operator: "pxc", current: "1.13.0", target: "1.12.0", problems: 1},
+		{name: "minor skipped", operator: "pxc", current: "1.11.0", target: "1.13.0", problems: 1},
+		{name: "major", operator: "pxc", current: "1.13.0", target: "2.0.0", problems: 1},
+		{name: "restoring cluster", operator: "psmdb", current: "1.14.0", target: "1.15.0", problems: 1},
+		{name: "everest operator", operator: "everest-operator", current: "0.3.0", target: "0.4.0", problems: 1},
+		{name: "invalid target", operator: "pxc", current: "1.13.0", target: "latest", wantErr: true},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			problems, err := operatorUpgradeProblems(tc.operator, tc.current, tc.target, dbs)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, problems, tc.problems)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// Kinds of the orphaned resources.
+const (
+	orphanKindBackupStorage    = "BackupStorage"
+	orphanKindMonitoringConfig = "MonitoringConfig"
+	orphanKindSecret           = "Secret"
+)
+
+// orphanScan holds the resources created by Everest in a Kubernetes cluster and what they are referenced by.
+type orphanScan struct {
+	backupStorages    []everestv1alpha1.BackupStorage
+	monitoringConfigs []everestv1alpha1.MonitoringConfig
+	secrets           []corev1.Secret
+	// known contains the names of the backup storages and the monitoring instances stored by Everest.
+	known map[string]struct{}
+	// used contains the names of the configs used by the database clusters, their backups and restores.
+	used map[string]struct{}
+}
+
+// ListOrphanedResources lists the resources created by Everest in the kubernetes cluster which are not referenced anymore.
+func (e *EverestServer) ListOrphanedResources(ctx echo.Context, kubernetesID string) error {
+	c := ctx.Request().Context()
+	k, kubeClient, code, err := e.initKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	orphans, err := e.orphanedResources(c, k, kubeClient)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not scan for orphaned resources")})
+	}
+	return ctx.JSON(http.StatusOK, orphans)
+}
+
+// DeleteOrphanedResources deletes the resources created by Everest in the kubernetes cluster which are not referenced anymore.
+func (e *EverestServer) DeleteOrphanedResources(ctx echo.Context, kubernetesID string) error {
+	c := ctx.Request().Context()
+	k, kubeClient, code, err := e.initKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	orphans, err := e.orphanedResources(c, k, kubeClient)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not scan for orphaned resources")})
+	}
+
+	for i := range orphans {
+		o := &orphans[i]
+		if err := deleteOrphanedResource(c, kubeClient, k.Namespace, *o); err != nil && !k8serrors.IsNotFound(err) {
+			e.l.Error(errors.Join(err, errors.New("could not delete orphaned resource")))
+			o.Error = pointer.ToString(err.Error())
+			continue
+		}
+		o.Deleted = true
+		if err := e.recordAudit(ctx, auditActionOrphanDeleted, kubernetesID, o.Kind+"/"+o.Name, o.Reason); err != nil {
+			e.l.Error(err)
+		}
+	}
+	return ctx.JSON(http.StatusOK, orphans)
+}
+
+func (e *EverestServer) orphanedResources(
+	ctx context.Context, k *model.KubernetesCluster, kubeClient *kubernetes.Kubernetes,
+) (OrphanedResourceList, error) {
+	scan := orphanScan{known: make(map[string]struct{}), used: make(map[string]struct{})}
+
+	storages, _, err := e.storage.ListBackupStorages(ctx, model.ListBackupStoragesParams{})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list backup storages"))
+	}
+	for _, bs := range storages {
+		scan.known[bs.Name] = struct{}{}
+	}
+	instances, _, err := e.storage.ListMonitoringInstances(ctx, model.ListMonitoringInstancesParams{})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list monitoring instances"))
+	}
+	for _, mi := range instances {
+		scan.known[mi.Name] = struct{}{}
+	}
+
+	bsList, err := kubeClient.ListBackupStorages(ctx, k.Namespace)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list backup storages in Kubernetes"))
+	}
+	scan.backupStorages = bsList.Items
+	mcList, err := kubeClient.ListMonitoringConfigs(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list monitoring configs in Kubernetes"))
+	}
+	scan.monitoringConfigs = mcList.Items
+	secrets, err := kubeClient.ListConfigSecrets(ctx, k.Namespace)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list secrets in Kubernetes"))
+	}
+	scan.secrets = secrets.Items
+
+	// Only the configs unknown to Everest are checked for use since the known ones are never orphaned.
+	for _, bs := range scan.backupStorages {
+		if !scan.candidate(bs.ObjectMeta.Labels, bs.Name) {
+			continue
+		}
+		used, err := kubernetes.IsBackupStorageConfigInUse(ctx, bs.Name, kubeClient)
+		if err != nil {
+			return nil, err
+		}
+		if used {
+			scan.used[bs.Name] = struct{}{}
+		}
+	}
+	for _, mc := range scan.monitoringConfigs {
+		if !scan.candidate(mc.ObjectMeta.Labels, mc.Name) {
+			continue
+		}
+		used, err := kubernetes.IsMonitoringConfigInUse(ctx, mc.Name, kubeClient)
+		if err != nil {
+			return nil, err
+		}
+		if used {
+			scan.used[mc.Name] = struct{}{}
+		}
+	}
+
+	return scan.orphans(), nil
+}
+
+// candidate tells if the config resource was created by Everest and is unknown to it.
+func (s orphanScan) candidate(labels map[string]string, name string) bool {
+	if _, ok := labels[kubernetes.ConfigLabel]; !ok {
+		return false
+	}
+	_, known := s.known[name]
+	return !known
+}
+
+// orphans returns the config resources created by Everest which are neither known to Everest nor used,
+// and the secrets of the configs which are either orphaned or gone.
+func (s orphanScan) orphans() OrphanedResourceList {
+	orphans := OrphanedResourceList{}
+	// alive contains the names of the config resources which stay.
+	alive := make(map[string]struct{})
+	for _, bs := range s.backupStorages {
+		_, used := s.used[bs.Name]
+		if !s.candidate(bs.ObjectMeta.Labels, bs.Name) || used {
+			alive[bs.Name] = struct{}{}
+			continue
+		}
+		orphans = append(orphans, OrphanedResource{
+			Kind:   orphanKindBackupStorage,
+			Name:   bs.Name,
+			Reason: "The backup storage is unknown to Everest and used by no database cluster, backup or restore",
+		})
+	}
+	for _, mc := range s.monitoringConfigs {
+		_, used := s.used[mc.Name]
+		if !s.candidate(mc.ObjectMeta.Labels, mc.Name) || used {
+			alive[mc.Name] = struct{}{}
+			continue
+		}
+		orphans = append(orphans, OrphanedResource{
+			Kind:   orphanKindMonitoringConfig,
+			Name:   mc.Name,
+			Reason: "The monitoring config is unknown to Everest and used by no database cluster",
+		})
+	}
+	for _, secret := range s.secrets {
+		config := secret.Labels[kubernetes.ConfigLabel]
+		_, known := s.known[config]
+		_, isAlive := alive[config]
+		if known || isAlive {
+			continue
+		}
+		orphans = append(orphans, OrphanedResource{
+			Kind:   orphanKindSecret,
+			Name:   secret.Name,
+			Reason: "The secret belongs to config " + config + " which is orphaned or gone",
+		})
+	}
+
+	// The secrets go last so that they are deleted after the config resources referencing them.
+	sort.SliceStable(orphans, func(i, j int) bool {
+		return orphans[i].Kind != orphanKindSecret && orphans[j].Kind == orphanKindSecret
+	})
+	return orphans
+}
+
+func deleteOrphanedResource(ctx context.Context, kubeClient *kubernetes.Kubernetes, namespace string, o OrphanedResource) error {
+	switch o.Kind {
+	case orphanKindBackupStorage:
+		return kubeClient.DeleteBackupStorage(ctx, o.Name, namespace)
+	case orphanKindMonitoringConfig:
+		// The secret is deleted as an orphan on its own.
+		return kubeClient.DeleteMonitoringConfig(ctx, o.Name, "")
+	case orphanKindSecret:
+		return kubeClient.DeleteSecret(ctx, o.Name, namespace)
+	default:
+		return errors.New("unknown kind of orphaned resource")
+	}
+}
@@ -0,0 +1,65 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+func TestOrphanScan(t *testing.T) {
+	t.Parallel()
+
+	labeled := func(name, config string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Labels: map[string]string{kubernetes.ConfigLabel: config}}
+	}
+	scan := orphanScan{
+		backupStorages: []everestv1alpha1.BackupStorage{
+			{ObjectMeta: labeled("known", "known")},
+			{ObjectMeta: labeled("used", "used")},
+			{ObjectMeta: labeled("orphan-bs", "orphan-bs")},
+			{ObjectMeta: metav1.ObjectMeta{Name: "foreign"}},
+		},
+		monitoringConfigs: []everestv1alpha1.MonitoringConfig{
+			{ObjectMeta: labeled("orphan-mc", "orphan-mc")},
+		},
+		secrets: []corev1.Secret{
+			{ObjectMeta: labeled("known-secret", "known")},
+			{ObjectMeta: labeled("used-secret", "used")},
+			{ObjectMeta: labeled("orphan-bs-secret", "orphan-bs")},
+			{ObjectMeta: labeled("gone-secret", "gone")},
+		},
+		known: map[string]struct{}{"known": {}},
+		used:  map[string]struct{}{"used": {}},
+	}
+
+	var got []string
+	for _, o := range scan.orphans() {
+		got = append(got, o.Kind+"/"+o.Name)
+	}
+	assert.Equal(t, []string{
+		"BackupStorage/orphan-bs",
+		"MonitoringConfig/orphan-mc",
+		"Secret/orphan-bs-secret",
+		"Secret/gone-secret",
+	}, got)
+}
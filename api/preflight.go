@@ -0,0 +1,171 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// PreflightDatabaseCluster checks whether the kubernetes cluster has enough capacity for the database cluster.
+func (e *EverestServer) PreflightDatabaseCluster(ctx echo.Context, kubernetesID string) error {
+	db := &everestv1alpha1.DatabaseCluster{}
+	if err := ctx.Bind(db); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	problems, err := e.preflightCapacity(ctx.Request().Context(), kubeClient, db)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not check the capacity of the Kubernetes cluster")})
+	}
+
+	return ctx.JSON(http.StatusOK, PreflightResult{
+		Passed:   len(problems) == 0,
+		Problems: problems,
+	})
+}
+
+// preflightDatabaseClusterCreation returns an error listing the reasons the database cluster
+// from the request body does not fit into the kubernetes cluster.
+func (e *EverestServer) preflightDatabaseClusterCreation(ctx echo.Context, kubeClient *kubernetes.Kubernetes) (int, error) {
+	db := &everestv1alpha1.DatabaseCluster{}
+	if err := e.getBodyFromContext(ctx, db); err != nil {
+		e.l.Error(err)
+		return http.StatusBadRequest, errors.New("could not get DatabaseCluster from the request body")
+	}
+
+	problems, err := e.preflightCapacity(ctx.Request().Context(), kubeClient, db)
+	if err != nil {
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not check the capacity of the Kubernetes cluster")
+	}
+	if len(problems) != 0 {
+		return http.StatusBadRequest, fmt.Errorf("the Kubernetes cluster does not have enough capacity: %s", strings.Join(problems, "; "))
+	}
+	return 0, nil
+}
+
+func (e *EverestServer) preflightCapacity(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, db *everestv1alpha1.DatabaseCluster,
+) ([]string, error) {
+	nodes, err := kubeClient.GetWorkerNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	storageClasses, err := kubeClient.GetStorageClasses(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not get storage classes"))
+	}
+
+	return checkCapacity(db, nodes, storageClasses.Items), nil
+}
+
+// checkCapacity returns the reasons the database cluster does not fit into the given nodes and storage classes.
+func checkCapacity(db *everestv1alpha1.DatabaseCluster, nodes []corev1.Node, storageClasses []storagev1.StorageClass) []string {
+	problems := []string{}
+	if len(nodes) == 0 {
+		return append(problems, "the Kubernetes cluster has no worker nodes")
+	}
+
+	replicas := int64(db.Spec.Engine.Replicas)
+	if replicas < 1 {
+		replicas = 1
+	}
+	for _, r := range []struct {
+		name      string
+		resource  corev1.ResourceName
+		requested resource.Quantity
+	}{
+		{name: "CPU", resource: corev1.ResourceCPU, requested: db.Spec.Engine.Resources.CPU},
+		{name: "memory", resource: corev1.ResourceMemory, requested: db.Spec.Engine.Resources.Memory},
+	} {
+		if r.requested.IsZero() {
+			continue
+		}
+
+		largest := resource.Quantity{}
+		total := resource.Quantity{}
+		for _, node := range nodes {
+			allocatable := node.Status.Allocatable[r.resource]
+			if allocatable.Cmp(largest) > 0 {
+				largest = allocatable
+			}
+			total.Add(allocatable)
+		}
+
+		if r.requested.Cmp(largest) > 0 {
+			problems = append(problems, fmt.Sprintf(
+				"requested %s of %s per replica but the largest node has %s allocatable",
+				r.requested.String(), r.name, largest.String(),
+			))
+			continue
+		}
+		requestedTotal := resource.NewMilliQuantity(r.requested.MilliValue()*replicas, r.requested.Format)
+		if requestedTotal.Cmp(total) > 0 {
+			problems = append(problems, fmt.Sprintf(
+				"requested %s of %s for %d replicas but all nodes have %s allocatable",
+				requestedTotal.String(), r.name, replicas, total.String(),
+			))
+		}
+	}
+
+	return append(problems, checkStorageClass(db.Spec.Engine.Storage.Class, storageClasses)...)
+}
+
+func checkStorageClass(class *string, storageClasses []storagev1.StorageClass) []string {
+	names := make([]string, 0, len(storageClasses))
+	for _, sc := range storageClasses {
+		if class == nil && isDefaultStorageClass(sc) {
+			return nil
+		}
+		if class != nil && sc.Name == *class {
+			return nil
+		}
+		names = append(names, sc.Name)
+	}
+	sort.Strings(names)
+
+	if class == nil {
+		return []string{fmt.Sprintf(
+			"no storage class requested and the Kubernetes cluster has no default one. Available storage classes: %s",
+			strings.Join(names, ", "),
+		)}
+	}
+	return []string{fmt.Sprintf(
+		"requested storage class '%s' does not exist. Available storage classes: %s",
+		*class, strings.Join(names, ", "),
+	)}
+}
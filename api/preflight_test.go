@@ -0,0 +1,112 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckCapacity(t *testing.T) {
+	t.Parallel()
+
+	node := func(cpu, memory string) corev1.Node {
+		return corev1.Node{Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		}}}
+	}
+	db := func(replicas int32, cpu, memory string, class *string) *everestv1alpha1.DatabaseCluster {
+		return &everestv1alpha1.DatabaseCluster{Spec: everestv1alpha1.DatabaseClusterSpec{
+			Engine: everestv1alpha1.Engine{
+				Replicas: replicas,
+				Resources: everestv1alpha1.Resources{
+					CPU:    resource.MustParse(cpu),
+					Memory: resource.MustParse(memory),
+				},
+				Storage: everestv1alpha1.Storage{Size: resource.MustParse("10Gi"), Class: class},
+			},
+		}}
+	}
+	storageClasses := []storagev1.StorageClass{
+		{ObjectMeta: metav1.ObjectMeta{Name: "standard", Annotations: map[string]string{annotationStorageClassDefault: "true"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "fast"}},
+	}
+	fast := "fast"
+	missing := "missing"
+
+	cases := []struct {
+		name           string
+		db             *everestv1alpha1.DatabaseCluster
+		nodes          []corev1.Node
+		storageClasses []storagev1.StorageClass
+		problems       []string
+	}{
+		{
+			name:           "fits",
+			db:             db(3, "1", "4Gi", nil),
+			nodes:          []corev1.Node{node("2", "8Gi"), node("2", "8Gi")},
+			storageClasses: storageClasses,
+			problems:       []string{},
+		},
+		{
+			name:           "replica larger than the largest node",
+			db:             db(1, "1", "32Gi", &fast),
+			nodes:          []corev1.Node{node("4", "16Gi"), node("4", "8Gi")},
+			storageClasses: storageClasses,
+			problems:       []string{"requested 32Gi of memory per replica but the largest node has 16Gi allocatable"},
+		},
+		{
+			name:           "replicas larger than all nodes",
+			db:             db(3, "2", "1Gi", nil),
+			nodes:          []corev1.Node{node("2", "8Gi"), node("2", "8Gi")},
+			storageClasses: storageClasses,
+			problems:       []string{"requested 6 of CPU for 3 replicas but all nodes have 4 allocatable"},
+		},
+		{
+			name:           "unknown storage class",
+			db:             db(1, "1", "1Gi", &missing),
+			nodes:          []corev1.Node{node("2", "8Gi")},
+			storageClasses: storageClasses,
+			problems:       []string{"requested storage class 'missing' does not exist. Available storage classes: fast, standard"},
+		},
+		{
+			name:           "no default storage class",
+			db:             db(1, "1", "1Gi", nil),
+			nodes:          []corev1.Node{node("2", "8Gi")},
+			storageClasses: storageClasses[1:],
+			problems:       []string{"no storage class requested and the Kubernetes cluster has no default one. Available storage classes: fast"},
+		},
+		{
+			name:     "no nodes",
+			db:       db(1, "1", "1Gi", nil),
+			problems: []string{"the Kubernetes cluster has no worker nodes"},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.problems, checkCapacity(tc.db, tc.nodes, tc.storageClasses))
+		})
+	}
+}
@@ -0,0 +1,454 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// projectRolesContextKey is the key the roles of a project scoped user are stored under in the echo context.
+const projectRolesContextKey = "everest.projectRoles"
+
+//nolint:gochecknoglobals
+var (
+	// projectOperations are the operations the project API tokens may access besides the ones on
+	// the resources under projectRoutePrefixes. The access to the resources of a project is checked
+	// by authorizeProjectAccess and the lists are limited to the projects of the caller.
+	projectOperations = map[string]struct{}{
+		"GET /kubernetes":                                                       {},
+		"GET /kubernetes/:kubernetes-id":                                        {},
+		"GET /kubernetes/:kubernetes-id/database-engines":                       {},
+		"GET /kubernetes/:kubernetes-id/database-engines-availability":          {},
+		"GET /kubernetes/:kubernetes-id/database-engines-availability/:name":    {},
+		"GET /kubernetes/:kubernetes-id/database-engines/:engine-type/versions": {},
+		"GET /kubernetes/:kubernetes-id/recommended-versions":                   {},
+		"GET /kubernetes/:kubernetes-id/storage-classes":                        {},
+		"GET /kubernetes/:kubernetes-id/database-clusters":                      {},
+		"POST /kubernetes/:kubernetes-id/database-clusters":                     {},
+		"POST /kubernetes/:kubernetes-id/database-clusters/preview":             {},
+		"POST /kubernetes/:kubernetes-id/database-clusters/cost-estimate":       {},
+		"POST /kubernetes/:kubernetes-id/preflight":                             {},
+		"GET /sizing-presets":                                                   {},
+		"GET /backup-storages":                                                  {},
+		"POST /backup-storages":                                                 {},
+		"GET /monitoring-instances":                                             {},
+		"POST /monitoring-instances":                                            {},
+		"GET /projects":                                                         {},
+		"GET /projects/:name":                                                   {},
+		"GET /projects/:name/members":                                           {},
+		"PUT /projects/:name/members/:subject-kind/:subject":                    {},
+		"DELETE /projects/:name/members/:subject-kind/:subject":                 {},
+	}
+
+	// projectRoutePrefixes are the routes of the resources belonging to a project.
+	// The projects themselves are managed by the Everest admins.
+	projectRoutePrefixes = []string{
+		"/kubernetes/:kubernetes-id/database-clusters/:name",
+		"/backup-storages/:name",
+		"/monitoring-instances/:name",
+	}
+
+	// projectReadOperations do not change the resources of the project in spite of their method.
+	projectReadOperations = map[string]struct{}{
+		"POST /kubernetes/:kubernetes-id/database-clusters/preview":       {},
+		"POST /kubernetes/:kubernetes-id/database-clusters/cost-estimate": {},
+		"POST /kubernetes/:kubernetes-id/database-clusters/:name/diff":    {},
+		"POST /kubernetes/:kubernetes-id/preflight":                       {},
+	}
+
+	projectRoleRanks = map[string]int{
+		model.ProjectRoleViewer: 1,
+		model.ProjectRoleEditor: 2,
+		model.ProjectRoleAdmin:  3,
+	}
+)
+
+// ListProjects lists the projects.
+func (e *EverestServer) ListProjects(ctx echo.Context) error {
+	projects, err := e.storage.ListProjects(ctx.Request().Context(), callerProjects(ctx))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list projects")})
+	}
+
+	res := make(ProjectList, 0, len(projects))
+	for _, p := range projects {
+		p := p
+		res = append(res, projectToAPIJson(&p))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// CreateProject creates a project.
+func (e *EverestServer) CreateProject(ctx echo.Context) error {
+	var params Project
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	// The database clusters refer to the project with a label.
+	if err := validateLabels(map[string]string{projectLabel: params.Name}); err != nil || params.Name == "" {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("'%s' is not a valid project name", params.Name)),
+		})
+	}
+
+	p := &model.Project{Name: params.Name, Description: pointer.GetString(params.Description)}
+	if err := e.storage.CreateProject(ctx.Request().Context(), p); err != nil {
+		if _, ok := model.UniqueViolation(err); ok {
+			return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("Project with the same name already exists")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not create project")})
+	}
+	return ctx.JSON(http.StatusOK, projectToAPIJson(p))
+}
+
+// GetProject returns the specified project.
+func (e *EverestServer) GetProject(ctx echo.Context, name string) error {
+	p, err := e.storage.GetProject(ctx.Request().Context(), name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Project is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get project")})
+	}
+	return ctx.JSON(http.StatusOK, projectToAPIJson(p))
+}
+
+// DeleteProject deletes the specified project and its members.
+func (e *EverestServer) DeleteProject(ctx echo.Context, name string) error {
+	if err := e.storage.DeleteProject(ctx.Request().Context(), name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Project is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete project")})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ListProjectMembers lists the members of the specified project.
+func (e *EverestServer) ListProjectMembers(ctx echo.Context, name string) error {
+	c := ctx.Request().Context()
+	if code, err := e.checkProjectExists(c, name); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	members, err := e.storage.ListProjectMembers(c, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list project members")})
+	}
+
+	res := make(ProjectMemberList, 0, len(members))
+	for _, m := range members {
+		res = append(res, ProjectMember{SubjectKind: m.SubjectKind, Subject: m.Subject, Role: m.Role})
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// SetProjectMember adds a user or a team to the specified project or changes its role.
+func (e *EverestServer) SetProjectMember(ctx echo.Context, name string, subjectKind string, subject string) error {
+	var params ProjectMemberRole
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := validateQuotaSubject(subjectKind, subject); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if _, ok := projectRoleRanks[params.Role]; !ok {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("role shall be one of %s, %s or %s",
+				model.ProjectRoleViewer, model.ProjectRoleEditor, model.ProjectRoleAdmin)),
+		})
+	}
+
+	c := ctx.Request().Context()
+	if code, err := e.checkProjectExists(c, name); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	m := &model.ProjectMember{Project: name, SubjectKind: subjectKind, Subject: subject, Role: params.Role}
+	if err := e.storage.SaveProjectMember(c, m); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not set project member")})
+	}
+	return ctx.JSON(http.StatusOK, ProjectMember{SubjectKind: m.SubjectKind, Subject: m.Subject, Role: m.Role})
+}
+
+// DeleteProjectMember removes a user or a team from the specified project.
+func (e *EverestServer) DeleteProjectMember(ctx echo.Context, name string, subjectKind string, subject string) error {
+	if err := e.storage.DeleteProjectMember(ctx.Request().Context(), name, subjectKind, subject); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Project member is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete project member")})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// checkProjectExists returns an error if a resource cannot be assigned to the project. Empty project is valid.
+func (e *EverestServer) checkProjectExists(ctx context.Context, name string) (int, error) {
+	if name == "" {
+		return 0, nil
+	}
+	if _, err := e.storage.GetProject(ctx, name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return http.StatusNotFound, fmt.Errorf("project %s is not found", name)
+		}
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not get project")
+	}
+	return 0, nil
+}
+
+// checkProjectChange returns an error if the caller may not move a resource from a project to the other one.
+// The users scoped to their projects need the editor role in both projects, hence they cannot remove
+// the resource from their projects either.
+func (e *EverestServer) checkProjectChange(ctx echo.Context, from, to string) (int, error) {
+	if from == to {
+		return 0, nil
+	}
+	if roles, ok := ctx.Get(projectRolesContextKey).(map[string]string); ok {
+		if err := checkProjectRole(roles, from, model.ProjectRoleEditor); err != nil {
+			return http.StatusForbidden, err
+		}
+		if err := checkProjectRole(roles, to, model.ProjectRoleEditor); err != nil {
+			return http.StatusForbidden, err
+		}
+	}
+	return e.checkProjectExists(ctx.Request().Context(), to)
+}
+
+// authorizeProjectAccess limits the users scoped to their projects to the resources of the projects
+// they have a sufficient role in. The roles are stored in the echo context for the lists to be limited too.
+func (e *EverestServer) authorizeProjectAccess(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		id, ok := userIdentityFrom(ctx)
+		if !ok || !id.ProjectScoped {
+			return next(ctx)
+		}
+
+		memberships, err := e.storage.ListProjectMemberships(ctx.Request().Context(), id.Username, id.Groups)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get project memberships")})
+		}
+		roles := projectRoles(memberships)
+		ctx.Set(projectRolesContextKey, roles)
+
+		project, single, err := e.projectOfRequest(ctx)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the project of the resource")})
+		}
+		if !single {
+			// The lists are limited to the projects by the handlers.
+			return next(ctx)
+		}
+		// The resources whose project cannot be determined, e.g. the missing ones, are denied
+		// just like the ones outside of the projects of the caller.
+		if err := checkProjectRole(roles, project, requiredProjectRole(apiOperation(ctx))); err != nil {
+			return ctx.JSON(http.StatusForbidden, Error{Message: pointer.ToString(err.Error())})
+		}
+		return next(ctx)
+	}
+}
+
+// projectOfRequest returns the project of the resource the request accesses or creates.
+// single is false for the requests not accessing a single resource. The project is empty
+// if it cannot be determined, e.g. for the missing resources and the invalid bodies.
+func (e *EverestServer) projectOfRequest(ctx echo.Context) (string, bool, error) {
+	c := ctx.Request().Context()
+	operation := apiOperation(ctx)
+	route := strings.TrimPrefix(ctx.Path(), "/v1")
+	switch {
+	case strings.HasPrefix(route, "/projects/:name"):
+		return ctx.Param("name"), true, nil
+
+	case strings.HasPrefix(route, "/kubernetes/:kubernetes-id/database-clusters/:name"):
+		// The namespace of the database cluster is resolved by scopeDatabaseClusterNamespace.
+		_, kubeClient, _, err := e.initDatabaseClusterKubeClient(c, ctx.Param("kubernetes-id"))
+		if err != nil {
+			return "", true, nil //nolint:nilerr // the project of a database cluster that cannot be read is unknown.
+		}
+		db, err := kubeClient.GetDatabaseCluster(c, ctx.Param("name"))
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				return "", true, nil
+			}
+			return "", false, err
+		}
+		return db.Labels[projectLabel], true, nil
+
+	case operation == "POST /kubernetes/:kubernetes-id/database-clusters" ||
+		operation == "POST /kubernetes/:kubernetes-id/database-clusters/preview" ||
+		operation == "POST /kubernetes/:kubernetes-id/database-clusters/cost-estimate" ||
+		operation == "POST /kubernetes/:kubernetes-id/preflight":
+		db := &everestv1alpha1.DatabaseCluster{}
+		if err := e.getBodyFromContext(ctx, db); err != nil {
+			return "", true, nil //nolint:nilerr // the project of an invalid body is unknown.
+		}
+		return db.Labels[projectLabel], true, nil
+
+	case operation == "POST /backup-storages/:name/restore":
+		bs, err := e.storage.GetDeletedBackupStorage(c, ctx.Param("name"))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", true, nil
+			}
+			return "", false, err
+		}
+		return bs.Project, true, nil
+
+	case operation == "POST /monitoring-instances/:name/restore":
+		i, err := e.storage.GetDeletedMonitoringInstance(c, ctx.Param("name"))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", true, nil
+			}
+			return "", false, err
+		}
+		return i.Project, true, nil
+
+	case strings.HasPrefix(route, "/backup-storages/:name"):
+		bs, err := e.storage.GetBackupStorage(c, nil, ctx.Param("name"))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", true, nil
+			}
+			return "", false, err
+		}
+		return bs.Project, true, nil
+
+	case strings.HasPrefix(route, "/monitoring-instances/:name"):
+		i, err := e.storage.GetMonitoringInstance(c, ctx.Param("name"))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", true, nil
+			}
+			return "", false, err
+		}
+		return i.Project, true, nil
+
+	case operation == "POST /backup-storages" || operation == "POST /monitoring-instances":
+		var params struct {
+			Project string `json:"project"`
+		}
+		if err := e.getBodyFromContext(ctx, &params); err != nil {
+			return "", true, nil //nolint:nilerr // the project of an invalid body is unknown.
+		}
+		return params.Project, true, nil
+	}
+	return "", false, nil
+}
+
+// requiredProjectRole returns the role in the project of the resource the operation requires.
+func requiredProjectRole(operation string) string {
+	method, route, _ := strings.Cut(operation, " ")
+	if strings.HasPrefix(route, "/projects/:name/members/") && method != http.MethodGet {
+		return model.ProjectRoleAdmin
+	}
+	// The credentials of the database clusters give the access to the data.
+	if strings.HasSuffix(route, "/credentials") {
+		return model.ProjectRoleEditor
+	}
+	if _, ok := projectReadOperations[operation]; ok || method == http.MethodGet {
+		return model.ProjectRoleViewer
+	}
+	return model.ProjectRoleEditor
+}
+
+// checkProjectRole returns an error unless the roles include at least the required one in the project.
+func checkProjectRole(roles map[string]string, project, required string) error {
+	if project == "" {
+		return errors.New("the resource does not belong to any of your projects")
+	}
+	role, ok := roles[project]
+	if !ok {
+		return fmt.Errorf("you are not a member of project %s", project)
+	}
+	if projectRoleRanks[role] < projectRoleRanks[required] {
+		return fmt.Errorf("the %s role in project %s is required", required, project)
+	}
+	return nil
+}
+
+// projectRoles returns the highest role in every project of the memberships.
+func projectRoles(memberships []model.ProjectMember) map[string]string {
+	roles := make(map[string]string, len(memberships))
+	for _, m := range memberships {
+		if projectRoleRanks[m.Role] > projectRoleRanks[roles[m.Project]] {
+			roles[m.Project] = m.Role
+		}
+	}
+	return roles
+}
+
+// projectScopeAllows returns true if the project API tokens may access the operation.
+func projectScopeAllows(operation string) bool {
+	if _, ok := projectOperations[operation]; ok {
+		return true
+	}
+	_, route, _ := strings.Cut(operation, " ")
+	for _, prefix := range projectRoutePrefixes {
+		if route == prefix || strings.HasPrefix(route, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// callerProjects returns the projects the lists shall be limited to, or nil if the caller is not limited.
+func callerProjects(ctx echo.Context) []string {
+	roles, ok := ctx.Get(projectRolesContextKey).(map[string]string)
+	if !ok {
+		return nil
+	}
+	projects := make([]string, 0, len(roles))
+	for p := range roles {
+		projects = append(projects, p)
+	}
+	sort.Strings(projects)
+	return projects
+}
+
+// projectsLabelSelector returns the label selector limiting the database clusters to the projects.
+func projectsLabelSelector(projects []string) string {
+	return fmt.Sprintf("%s in (%s)", projectLabel, strings.Join(projects, ","))
+}
+
+func projectToAPIJson(p *model.Project) Project {
+	res := Project{Name: p.Name}
+	if p.Description != "" {
+		res.Description = pointer.ToString(p.Description)
+	}
+	return res
+}
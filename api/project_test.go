@@ -0,0 +1,232 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestProjectRoles(t *testing.T) {
+	t.Parallel()
+
+	roles := projectRoles([]model.ProjectMember{
+		{Project: "payments", SubjectKind: model.SubjectKindUser, Role: model.ProjectRoleViewer},
+		{Project: "payments", SubjectKind: model.SubjectKindTeam, Role: model.ProjectRoleEditor},
+		{Project: "search", SubjectKind: model.SubjectKindTeam, Role: model.ProjectRoleAdmin},
+		{Project: "search", SubjectKind: model.SubjectKindUser, Role: model.ProjectRoleViewer},
+	})
+	assert.Equal(t, map[string]string{"payments": model.ProjectRoleEditor, "search": model.ProjectRoleAdmin}, roles)
+
+	assert.NoError(t, checkProjectRole(roles, "payments", model.ProjectRoleEditor))
+	assert.EqualError(t, checkProjectRole(roles, "payments", model.ProjectRoleAdmin),
+		"the admin role in project payments is required")
+	assert.EqualError(t, checkProjectRole(roles, "billing", model.ProjectRoleViewer),
+		"you are not a member of project billing")
+	assert.EqualError(t, checkProjectRole(roles, "", model.ProjectRoleViewer),
+		"the resource does not belong to any of your projects")
+}
+
+func TestRequiredProjectRole(t *testing.T) {
+	t.Parallel()
+
+	for operation, role := range map[string]string{
+		"GET /kubernetes/:kubernetes-id/database-clusters/:name":               model.ProjectRoleViewer,
+		"GET /kubernetes/:kubernetes-id/database-clusters/:name/credentials":   model.ProjectRoleEditor,
+		"PUT /kubernetes/:kubernetes-id/database-clusters/:name":               model.ProjectRoleEditor,
+		"POST /kubernetes/:kubernetes-id/database-clusters/:name/diff":         model.ProjectRoleViewer,
+		"POST /kubernetes/:kubernetes-id/database-clusters":                    model.ProjectRoleEditor,
+		"DELETE /backup-storages/:name":                                        model.ProjectRoleEditor,
+		"GET /projects/:name/members":                                          model.ProjectRoleViewer,
+		"PUT /projects/:name/members/:subject-kind/:subject":                   model.ProjectRoleAdmin,
+		"POST /kubernetes/:kubernetes-id/database-clusters/preview":            model.ProjectRoleViewer,
+		"POST /kubernetes/:kubernetes-id/database-clusters/:name/upgrade":      model.ProjectRoleEditor,
+		"DELETE /kubernetes/:kubernetes-id/database-clusters/:name/backup-slo": model.ProjectRoleEditor,
+	} {
+		assert.Equal(t, role, requiredProjectRole(operation), operation)
+	}
+}
+
+func TestProjectScopeAllows(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, projectScopeAllows("GET /kubernetes/:kubernetes-id/database-clusters"))
+	assert.True(t, projectScopeAllows("DELETE /kubernetes/:kubernetes-id/database-clusters/:name"))
+	assert.True(t, projectScopeAllows("GET /kubernetes/:kubernetes-id/database-clusters/:name/backups"))
+	assert.True(t, projectScopeAllows("PATCH /backup-storages/:name"))
+	assert.True(t, projectScopeAllows("PUT /projects/:name/members/:subject-kind/:subject"))
+	assert.False(t, projectScopeAllows("POST /projects"))
+	assert.False(t, projectScopeAllows("DELETE /projects/:name"))
+	assert.False(t, projectScopeAllows("DELETE /kubernetes/:kubernetes-id"))
+	assert.False(t, projectScopeAllows("GET /inventory"))
+	assert.False(t, projectScopeAllows("POST /api-tokens"))
+}
+
+func TestProjectsLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	s, err := labels.Parse(projectsLabelSelector([]string{"payments", "search"}))
+	require.NoError(t, err)
+	assert.True(t, s.Matches(labels.Set{projectLabel: "search"}))
+	assert.False(t, s.Matches(labels.Set{projectLabel: "billing"}))
+	assert.False(t, s.Matches(labels.Set{}))
+}
+
+// projectStorageStub knows the backup storages, the projects and the memberships of a single user.
+type projectStorageStub struct {
+	storage
+	storages    map[string]model.BackupStorage
+	projects    map[string]struct{}
+	memberships []model.ProjectMember
+}
+
+func (s projectStorageStub) ListProjectMemberships(_ context.Context, _ string, _ []string) ([]model.ProjectMember, error) {
+	return s.memberships, nil
+}
+
+func (s projectStorageStub) GetBackupStorage(_ context.Context, _ *gorm.DB, name string) (*model.BackupStorage, error) {
+	bs, ok := s.storages[name]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &bs, nil
+}
+
+func (s projectStorageStub) GetProject(_ context.Context, name string) (*model.Project, error) {
+	if _, ok := s.projects[name]; !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &model.Project{Name: name}, nil
+}
+
+func (s projectStorageStub) GetKubernetesCluster(_ context.Context, _ string) (*model.KubernetesCluster, error) {
+	return nil, errors.New("unreachable")
+}
+
+func TestAuthorizeProjectAccess(t *testing.T) {
+	t.Parallel()
+
+	stub := projectStorageStub{
+		storages: map[string]model.BackupStorage{
+			"payments-s3": {Name: "payments-s3", Project: "payments"},
+			"billing-s3":  {Name: "billing-s3", Project: "billing"},
+		},
+		memberships: []model.ProjectMember{{Project: "payments", Role: model.ProjectRoleEditor}},
+	}
+
+	cases := []struct {
+		name   string
+		scoped bool
+		method string
+		path   string
+		code   int
+	}{
+		{name: "not scoped", method: http.MethodGet, path: "/v1/backup-storages/missing", code: http.StatusNoContent},
+		{name: "list", scoped: true, method: http.MethodGet, path: "/v1/backup-storages", code: http.StatusNoContent},
+		{name: "own project", scoped: true, method: http.MethodGet, path: "/v1/backup-storages/payments-s3", code: http.StatusNoContent},
+		{name: "other project", scoped: true, method: http.MethodGet, path: "/v1/backup-storages/billing-s3", code: http.StatusForbidden},
+		{name: "missing resource", scoped: true, method: http.MethodGet, path: "/v1/backup-storages/missing", code: http.StatusForbidden},
+		{
+			name: "unknown database cluster", scoped: true, method: http.MethodDelete,
+			path: "/v1/kubernetes/123/database-clusters/db", code: http.StatusForbidden,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			e := &EverestServer{
+				l:       zap.NewNop().Sugar(),
+				storage: stub,
+			}
+			router := echo.New()
+			g := router.Group("/v1")
+			g.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(ctx echo.Context) error {
+					setUserIdentity(ctx, userIdentity{Username: "alice", ProjectScoped: tc.scoped})
+					return next(ctx)
+				}
+			})
+			g.Use(e.authorizeProjectAccess)
+			handler := func(ctx echo.Context) error { return ctx.NoContent(http.StatusNoContent) }
+			g.GET("/backup-storages", handler)
+			g.GET("/backup-storages/:name", handler)
+			g.DELETE("/kubernetes/:kubernetes-id/database-clusters/:name", handler)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(tc.method, tc.path, nil))
+			assert.Equal(t, tc.code, rec.Code)
+		})
+	}
+}
+
+func TestCheckProjectChange(t *testing.T) {
+	t.Parallel()
+
+	e := &EverestServer{
+		l: zap.NewNop().Sugar(),
+		storage: projectStorageStub{
+			projects: map[string]struct{}{"payments": {}, "search": {}, "billing": {}},
+		},
+	}
+	roles := map[string]string{
+		"payments": model.ProjectRoleEditor,
+		"search":   model.ProjectRoleEditor,
+		"billing":  model.ProjectRoleViewer,
+	}
+
+	cases := []struct {
+		name     string
+		scoped   bool
+		from, to string
+		code     int
+	}{
+		{name: "unchanged", scoped: true, from: "payments", to: "payments"},
+		{name: "editor in both projects", scoped: true, from: "payments", to: "search"},
+		{name: "other project", scoped: true, from: "payments", to: "marketing", code: http.StatusForbidden},
+		{name: "viewer in target project", scoped: true, from: "payments", to: "billing", code: http.StatusForbidden},
+		{name: "label removed", scoped: true, from: "payments", to: "", code: http.StatusForbidden},
+		{name: "viewer in source project", scoped: true, from: "billing", to: "payments", code: http.StatusForbidden},
+		{name: "not scoped", from: "payments", to: "billing"},
+		{name: "not scoped label removed", from: "payments", to: ""},
+		{name: "missing project", from: "payments", to: "marketing", code: http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodPut, "/", nil), httptest.NewRecorder())
+			if tc.scoped {
+				ctx.Set(projectRolesContextKey, roles)
+			}
+			code, err := e.checkProjectChange(ctx, tc.from, tc.to)
+			assert.Equal(t, tc.code, code)
+			assert.Equal(t, tc.code != 0, err != nil)
+		})
+	}
+}
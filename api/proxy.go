@@ -18,6 +18,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -27,6 +28,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/AlekSi/pointer"
 	"github.com/labstack/echo/v4"
@@ -34,6 +36,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
 )
 
 var (
@@ -54,7 +58,53 @@ var (
 	}
 )
 
+// proxyFlushInterval defines how often the body of a proxied response is flushed to the client.
+const proxyFlushInterval = 100 * time.Millisecond
+
+// uncompressedWriterContextKey is the key of the response writer the gzip middleware wraps.
+const uncompressedWriterContextKey = "everest.uncompressedWriter"
+
+// keepUncompressedWriter stores the response writer of the request before the gzip middleware wraps it
+// for the proxy to pass the bodies compressed by Kubernetes through.
+func keepUncompressedWriter(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		ctx.Set(uncompressedWriterContextKey, ctx.Response().Writer)
+		return next(ctx)
+	}
+}
+
+// responseBodyModifier modifies the body of a successful proxied response.
+type responseBodyModifier func(ctx context.Context, body []byte) ([]byte, error)
+
 func (e *EverestServer) proxyKubernetes(ctx echo.Context, kubernetesID, resourceName string) error {
+	return e.proxyKubernetesNamespace(ctx, kubernetesID, "", resourceName)
+}
+
+// proxyKubernetesNamespace proxies the request to the given namespace of the kubernetes cluster.
+// An empty namespace stands for the namespace of the database cluster of the request if any
+// or else for the namespace the kubernetes cluster was registered with.
+func (e *EverestServer) proxyKubernetesNamespace(ctx echo.Context, kubernetesID, namespace, resourceName string) error {
+	return e.proxyKubernetesModified(ctx, kubernetesID, namespace, resourceName, nil)
+}
+
+// proxyKubernetesModified proxies the request like proxyKubernetesNamespace does
+// and lets the modifier change the body of the successful response.
+func (e *EverestServer) proxyKubernetesModified(
+	ctx echo.Context, kubernetesID, namespace, resourceName string, modify responseBodyModifier,
+) error {
+	return e.proxyKubernetesResponse(ctx, kubernetesID, namespace, resourceName, modify, false)
+}
+
+// proxyKubernetesTagged proxies the request of a single resource like proxyKubernetesNamespace does
+// and sets the resourceVersion of the resource as the ETag of the successful response. The response
+// is replaced with 304 Not Modified if the ETag matches If-None-Match.
+func (e *EverestServer) proxyKubernetesTagged(ctx echo.Context, kubernetesID, namespace, resourceName string) error {
+	return e.proxyKubernetesResponse(ctx, kubernetesID, namespace, resourceName, nil, true)
+}
+
+func (e *EverestServer) proxyKubernetesResponse(
+	ctx echo.Context, kubernetesID, namespace, resourceName string, modify responseBodyModifier, tagged bool,
+) error {
 	cluster, err := e.storage.GetKubernetesCluster(ctx.Request().Context(), kubernetesID)
 	if err != nil {
 		e.l.Error(err)
@@ -62,6 +112,9 @@ func (e *EverestServer) proxyKubernetes(ctx echo.Context, kubernetesID, resource
 			Message: pointer.ToString("Could not get a Kubernetes cluster"),
 		})
 	}
+	if code, err := e.ensureCompatible(ctx.Request().Context(), cluster); err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
 	encodedSecret, err := e.secretsStorage.GetSecret(ctx.Request().Context(), kubernetesID)
 	if err != nil {
 		e.l.Error(err)
@@ -77,12 +130,25 @@ func (e *EverestServer) proxyKubernetes(ctx echo.Context, kubernetesID, resource
 			Message: pointer.ToString("Could not build kubeconfig"),
 		})
 	}
+	if e.config.ImpersonateUsers {
+		id, ok := userIdentityFrom(ctx)
+		if !ok {
+			// The request would be sent with the credentials of the kubeconfig otherwise.
+			return ctx.JSON(http.StatusUnauthorized, Error{
+				Message: pointer.ToString("Authentication is required to impersonate the user in Kubernetes"),
+			})
+		}
+		config.Impersonate = rest.ImpersonationConfig{
+			UserName: id.Username,
+			Groups:   id.Groups,
+		}
+	}
 	reverseProxy := httputil.NewSingleHostReverseProxy(
 		&url.URL{
 			Host:   strings.TrimPrefix(config.Host, "https://"),
 			Scheme: "https",
 		})
-	transport, err := rest.TransportFor(config)
+	transport, err := kubernetes.TransportFor(config)
 	if err != nil {
 		e.l.Error(err)
 		return ctx.JSON(http.StatusBadRequest, Error{
@@ -91,9 +157,39 @@ func (e *EverestServer) proxyKubernetes(ctx echo.Context, kubernetesID, resource
 	}
 	reverseProxy.Transport = transport
 	reverseProxy.ErrorHandler = everestErrorHandler(cluster.Name, e.l)
-	reverseProxy.ModifyResponse = everestResponseModifier(e.l) //nolint:bodyclose
+	reverseProxy.ModifyResponse = everestResponseModifier(e.l, modify, tagged, func() { //nolint:bodyclose
+		// The gzip middleware would compress the body compressed by Kubernetes once more.
+		if w, ok := ctx.Get(uncompressedWriterContextKey).(http.ResponseWriter); ok {
+			ctx.Response().Writer = w
+		}
+	})
+	// The body is streamed to the client as it is received rather than once the response is complete.
+	reverseProxy.FlushInterval = proxyFlushInterval
 	req := ctx.Request()
-	req.URL.Path = buildProxiedURL(ctx.Request().URL.Path, kubernetesID, resourceName, cluster.Namespace)
+	removeImpersonationHeaders(req.Header)
+	// The client credentials are meant for Everest. They would take precedence over the credentials
+	// of the kubeconfig and end up in the audit logs of Kubernetes otherwise.
+	req.Header.Del(echo.HeaderAuthorization)
+	req.Header.Del("Cookie")
+	if modify != nil || tagged {
+		// The transport negotiates the compression with Kubernetes and decompresses the body itself
+		// so that it can be modified. The other bodies are passed through as Kubernetes compressed them.
+		req.Header.Del(echo.HeaderAcceptEncoding)
+	}
+	if namespace == "" {
+		namespace = namespaceFrom(req.Context())
+	}
+	if namespace == "" {
+		namespace = cluster.Namespace
+	}
+	// The query parameters handled by Everest are not passed to Kubernetes.
+	query := req.URL.Query()
+	if query.Has("namespace") || query.Has("overrideMaintenanceWindow") {
+		query.Del("namespace")
+		query.Del("overrideMaintenanceWindow")
+		req.URL.RawQuery = query.Encode()
+	}
+	req.URL.Path = buildProxiedURL(ctx.Request().URL.Path, kubernetesID, resourceName, namespace)
 	reverseProxy.ServeHTTP(ctx.Response(), req)
 	return nil
 }
@@ -118,34 +214,94 @@ func buildProxiedURL(uri, kubernetesID, resourceName, namespace string) string {
 	return proxiedURL
 }
 
-func everestResponseModifier(logger *zap.SugaredLogger) func(resp *http.Response) error {
+// everestResponseModifier returns the modifier of the proxied responses reading the body only if
+// it is modified or tagged. passEncoded is called for the bodies compressed by Kubernetes which
+// are passed through as they are.
+func everestResponseModifier(
+	logger *zap.SugaredLogger, modify responseBodyModifier, tagged bool, passEncoded func(),
+) func(resp *http.Response) error {
 	return func(resp *http.Response) error {
-		if _, ok := rewriteCodes[resp.StatusCode]; ok {
-			b, err := io.ReadAll(resp.Body)
-			if err != nil {
-				logger.Error(errors.Join(err, errors.New("failed reading body")))
-				return err
-			}
-			err = resp.Body.Close()
-			if err != nil {
-				logger.Error(errors.Join(err, errors.New("failed closing body")))
-				return err
-			}
+		if resp.Header.Get(echo.HeaderContentEncoding) != "" {
+			passEncoded()
+			return nil
+		}
+		_, rewrite := rewriteCodes[resp.StatusCode]
+		modified := modify != nil && resp.StatusCode == http.StatusOK
+		etag := tagged && resp.StatusCode == http.StatusOK
+		if !rewrite && !modified && !etag {
+			return nil
+		}
+
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			logger.Error(errors.Join(err, errors.New("failed reading body")))
+			return err
+		}
+		err = resp.Body.Close()
+		if err != nil {
+			logger.Error(errors.Join(err, errors.New("failed closing body")))
+			return err
+		}
+		if rewrite {
 			b, err = tryOverrideResponseBody(b)
 			if err != nil {
 				logger.Error(errors.Join(err, errors.New("failed overriding response body")))
 				return err
 			}
-
-			body := io.NopCloser(bytes.NewReader(b))
-			resp.Body = body
-			resp.ContentLength = int64(len(b))
-			resp.Header.Set("Content-Length", strconv.Itoa(len(b)))
+		} else if modified {
+			if m, err := modify(resp.Request.Context(), b); err != nil {
+				// The response is passed unmodified rather than failed.
+				logger.Error(errors.Join(err, errors.New("failed modifying response body")))
+			} else {
+				b = m
+			}
+		}
+		if etag && tagResponse(resp, b) {
+			resp.StatusCode = http.StatusNotModified
+			resp.Status = http.StatusText(http.StatusNotModified)
+			resp.Body = http.NoBody
+			resp.ContentLength = 0
+			resp.Header.Del("Content-Length")
+			return nil
 		}
+
+		body := io.NopCloser(bytes.NewReader(b))
+		resp.Body = body
+		resp.ContentLength = int64(len(b))
+		resp.Header.Set("Content-Length", strconv.Itoa(len(b)))
 		return nil
 	}
 }
 
+// tagResponse sets the resourceVersion of the resource in the body as the ETag of the response.
+// It reports whether the ETag matches If-None-Match of a GET request.
+func tagResponse(resp *http.Response, body []byte) bool {
+	var obj metav1.PartialObjectMetadata
+	if err := json.Unmarshal(body, &obj); err != nil || obj.ResourceVersion == "" {
+		return false
+	}
+	tag := resourceVersionETag(obj.ResourceVersion)
+	resp.Header.Set("ETag", tag)
+	return resp.Request.Method == http.MethodGet && etagMatches(resp.Request.Header.Get("If-None-Match"), tag)
+}
+
+// resourceVersionETag returns the ETag of a resource with the resourceVersion.
+func resourceVersionETag(resourceVersion string) string {
+	return strconv.Quote(resourceVersion)
+}
+
+// etagMatches reports whether the ETag is in the list of If-Match or If-None-Match. The weak
+// ETags of the list are compared by their values since the ETags are never weak themselves.
+func etagMatches(header, tag string) bool {
+	for _, t := range strings.Split(header, ",") {
+		t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+		if t == "*" || t == tag {
+			return true
+		}
+	}
+	return false
+}
+
 func tryOverrideResponseBody(b []byte) ([]byte, error) {
 	status := metav1.Status{}
 	err := json.Unmarshal(b, &status)
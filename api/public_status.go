@@ -0,0 +1,172 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const (
+	publicStatusOperational = "operational"
+	publicStatusDegraded    = "degraded"
+
+	// publicStatusBackupWindow is the period the backup success rate is computed over.
+	publicStatusBackupWindow = 24 * time.Hour
+)
+
+// publicStatusCache keeps the last computed public status so that the unauthenticated
+// requests do not hit the Kubernetes clusters more often than the cache TTL allows.
+type publicStatusCache struct {
+	mu     sync.Mutex
+	status *PublicStatus
+}
+
+// GetPublicStatus returns the aggregate health of Everest.
+func (e *EverestServer) GetPublicStatus(ctx echo.Context) error {
+	if !e.config.PublicStatus {
+		return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("The public status is disabled")})
+	}
+
+	e.publicStatus.mu.Lock()
+	defer e.publicStatus.mu.Unlock()
+
+	if s := e.publicStatus.status; s != nil && time.Since(s.UpdatedAt) < e.config.PublicStatusCacheTTL {
+		return ctx.JSON(http.StatusOK, s)
+	}
+
+	status, err := e.computePublicStatus(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the status")})
+	}
+	e.publicStatus.status = status
+	return ctx.JSON(http.StatusOK, status)
+}
+
+func (e *EverestServer) computePublicStatus(ctx context.Context) (*PublicStatus, error) {
+	clusters, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list Kubernetes clusters"))
+	}
+
+	now := time.Now().UTC()
+	status := &PublicStatus{
+		BackupWindowHours: int(publicStatusBackupWindow.Hours()),
+		UpdatedAt:         now,
+	}
+	var succeeded, finished int
+	for i := range clusters {
+		k := &clusters[i]
+		state, unreachable := e.clusterState(ctx, *k, "status", func(ctx context.Context) (interface{}, error) {
+			return e.publicStatusResources(ctx, k)
+		})
+		if unreachable != nil || k.CompatibilityStatus == model.CompatibilityStatusIncompatible {
+			status.KubernetesClusters.Degraded++
+		} else {
+			status.KubernetesClusters.Healthy++
+		}
+		if state == nil {
+			continue
+		}
+		// The last known resources of an unreachable cluster are still counted.
+		resources := state.(*publicStatusClusterResources)
+
+		healthy, degraded := databaseClustersHealth(resources.dbs)
+		status.DatabaseClusters.Healthy += healthy
+		status.DatabaseClusters.Degraded += degraded
+
+		s, f := backupsSucceeded(resources.backups, now.Add(-publicStatusBackupWindow))
+		succeeded += s
+		finished += f
+	}
+
+	if finished != 0 {
+		status.BackupSuccessRate = pointer.ToFloat64(float64(succeeded) / float64(finished))
+	}
+	status.Status = publicStatusOperational
+	if status.KubernetesClusters.Degraded != 0 || status.DatabaseClusters.Degraded != 0 {
+		status.Status = publicStatusDegraded
+	}
+	return status, nil
+}
+
+type publicStatusClusterResources struct {
+	dbs     []everestv1alpha1.DatabaseCluster
+	backups []everestv1alpha1.DatabaseClusterBackup
+}
+
+func (e *EverestServer) publicStatusResources(
+	ctx context.Context, k *model.KubernetesCluster,
+) (*publicStatusClusterResources, error) {
+	kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+	if err != nil {
+		return nil, err
+	}
+	dbs, err := kubeClient.ListDatabaseClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	backups, err := kubeClient.ListDatabaseClusterBackups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &publicStatusClusterResources{dbs: dbs.Items, backups: backups.Items}, nil
+}
+
+// databaseClustersHealth counts the ready database clusters as healthy and all the others as degraded.
+func databaseClustersHealth(dbs []everestv1alpha1.DatabaseCluster) (int, int) {
+	var healthy, degraded int
+	for _, db := range dbs {
+		if db.Status.Status == everestv1alpha1.AppStateReady {
+			healthy++
+		} else {
+			degraded++
+		}
+	}
+	return healthy, degraded
+}
+
+// backupsSucceeded returns the number of the backups finished since the given time which succeeded
+// and the number of all of them.
+func backupsSucceeded(backups []everestv1alpha1.DatabaseClusterBackup, since time.Time) (int, int) {
+	var succeeded, finished int
+	for _, b := range backups {
+		if b.Status.CompletedAt == nil || b.Status.CompletedAt.Time.Before(since) {
+			continue
+		}
+		state := strings.ToLower(string(b.Status.State))
+		if _, ok := successfulBackupStates[state]; ok {
+			succeeded++
+			finished++
+		} else if _, ok := failedBackupStates[state]; ok {
+			finished++
+		}
+	}
+	return succeeded, finished
+}
@@ -0,0 +1,67 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDatabaseClustersHealth(t *testing.T) {
+	t.Parallel()
+
+	db := func(state everestv1alpha1.AppState) everestv1alpha1.DatabaseCluster {
+		return everestv1alpha1.DatabaseCluster{Status: everestv1alpha1.DatabaseClusterStatus{Status: state}}
+	}
+	healthy, degraded := databaseClustersHealth([]everestv1alpha1.DatabaseCluster{
+		db(everestv1alpha1.AppStateReady),
+		db(everestv1alpha1.AppStateReady),
+		db(everestv1alpha1.AppStateError),
+		db(everestv1alpha1.AppStateInit),
+	})
+	assert.Equal(t, 2, healthy)
+	assert.Equal(t, 2, degraded)
+}
+
+func TestBackupsSucceeded(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	backup := func(state string, completedAt *time.Time) everestv1alpha1.DatabaseClusterBackup {
+		b := everestv1alpha1.DatabaseClusterBackup{}
+		b.Status.State = everestv1alpha1.BackupState(state)
+		if completedAt != nil {
+			b.Status.CompletedAt = &metav1.Time{Time: *completedAt}
+		}
+		return b
+	}
+	recent := now.Add(-time.Hour)
+	old := now.Add(-48 * time.Hour)
+
+	succeeded, finished := backupsSucceeded([]everestv1alpha1.DatabaseClusterBackup{
+		backup("Succeeded", &recent),
+		backup("Failed", &recent),
+		backup("Error", &recent),
+		backup("Succeeded", &old),
+		backup("Running", nil),
+	}, now.Add(-publicStatusBackupWindow))
+	assert.Equal(t, 1, succeeded)
+	assert.Equal(t, 3, finished)
+}
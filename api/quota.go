@@ -0,0 +1,398 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// Annotations of the database clusters recording the user and the team the database cluster is accounted to in the quotas.
+const (
+	ownerAnnotation = "everest.percona.com/owner"
+	teamAnnotation  = "everest.percona.com/team"
+)
+
+// resourceRequests are the resources requested by a database cluster.
+type resourceRequests struct {
+	cpuMillis    int64
+	memoryBytes  int64
+	storageBytes int64
+}
+
+// ListQuotas lists the quotas of the users and the teams.
+func (e *EverestServer) ListQuotas(ctx echo.Context) error {
+	quotas, err := e.storage.ListQuotas(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list quotas")})
+	}
+
+	res := make(QuotaList, 0, len(quotas))
+	for _, q := range quotas {
+		q := q
+		res = append(res, quotaToAPIJson(&q))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// SetQuota sets the quota of a user or a team.
+func (e *EverestServer) SetQuota(ctx echo.Context, subjectKind string, subject string) error {
+	var params QuotaLimits
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := validateQuota(subjectKind, subject, params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	q := &model.Quota{
+		SubjectKind:         subjectKind,
+		Subject:             subject,
+		MaxDatabaseClusters: pointer.GetInt(params.MaxDatabaseClusters),
+		MaxCPUMillis:        pointer.GetInt64(params.MaxCpuMillis),
+		MaxMemoryBytes:      pointer.GetInt64(params.MaxMemoryBytes),
+		MaxStorageBytes:     pointer.GetInt64(params.MaxStorageBytes),
+	}
+	if err := e.storage.SaveQuota(ctx.Request().Context(), q); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not set quota")})
+	}
+	return ctx.JSON(http.StatusOK, quotaToAPIJson(q))
+}
+
+// DeleteQuota deletes the quota of a user or a team.
+func (e *EverestServer) DeleteQuota(ctx echo.Context, subjectKind string, subject string) error {
+	if err := e.storage.DeleteQuota(ctx.Request().Context(), subjectKind, subject); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Quota is not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete quota")})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetQuotaUsage returns the resources requested by the database clusters of a user or a team together with the quota.
+func (e *EverestServer) GetQuotaUsage(ctx echo.Context, subjectKind string, subject string) error {
+	if err := validateQuotaSubject(subjectKind, subject); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	res := QuotaUsage{SubjectKind: subjectKind, Subject: subject}
+	q, err := e.storage.GetQuota(c, subjectKind, subject)
+	switch {
+	case err == nil:
+		res.Limits = pointer.To(quotaToAPIJson(q).Limits)
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get quota")})
+	}
+
+	dbs, unreachable, err := e.accountedDatabaseClusters(c)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list Kubernetes clusters")})
+	}
+	res.Used = quotaConsumption(dbs, subjectKind, subject, nil)
+	res.UnreachableClusters = unreachable
+
+	setPartialResultHeaders(ctx, unreachable)
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// enforceQuotas checks the database cluster from the request body does not exceed the quotas of its user and team.
+// The database cluster is accounted to the user sending the request on creation and keeps being accounted
+// to the same user and team on update, so the annotations recording them are set in the request body.
+// The old database cluster is nil on creation.
+func (e *EverestServer) enforceQuotas(ctx echo.Context, kubernetesID string, old *everestv1alpha1.DatabaseCluster) (int, error) {
+	var owner, team string
+	if old != nil {
+		owner, team = old.Annotations[ownerAnnotation], old.Annotations[teamAnnotation]
+	} else if id, ok := userIdentityFrom(ctx); ok {
+		owner = id.Username
+		if len(id.Groups) != 0 {
+			team = id.Groups[0]
+		}
+	}
+	if owner == "" && team == "" {
+		return 0, nil
+	}
+	if err := setOwnerAnnotations(ctx.Request(), owner, team); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	c := ctx.Request().Context()
+	quotas, err := e.quotasOf(c, owner, team)
+	if err != nil {
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not get quota")
+	}
+	if len(quotas) == 0 {
+		return 0, nil
+	}
+
+	proposed := &everestv1alpha1.DatabaseCluster{}
+	if err := e.getBodyFromContext(ctx, proposed); err != nil {
+		e.l.Error(err)
+		return http.StatusBadRequest, errors.New("could not get DatabaseCluster from the request body")
+	}
+	return e.checkQuotas(c, kubernetesID, quotas, old, proposed)
+}
+
+// quotasOf returns the quotas set for the user and the team.
+func (e *EverestServer) quotasOf(ctx context.Context, owner, team string) ([]*model.Quota, error) {
+	quotas := make([]*model.Quota, 0, 2)
+	for _, s := range []struct{ kind, name string }{
+		{kind: model.SubjectKindUser, name: owner},
+		{kind: model.SubjectKindTeam, name: team},
+	} {
+		if s.name == "" {
+			continue
+		}
+		q, err := e.storage.GetQuota(ctx, s.kind, s.name)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		quotas = append(quotas, q)
+	}
+	return quotas, nil
+}
+
+// checkQuotas checks the proposed database cluster does not exceed the quotas. The database cluster
+// being updated is replaced with the proposed one, the old database cluster is nil on creation.
+func (e *EverestServer) checkQuotas(
+	ctx context.Context, kubernetesID string, quotas []*model.Quota, old, proposed *everestv1alpha1.DatabaseCluster,
+) (int, error) {
+	if len(quotas) == 0 {
+		return 0, nil
+	}
+	var replaced *accountedDatabaseCluster
+	if old != nil {
+		replaced = &accountedDatabaseCluster{kubernetesID: kubernetesID, db: *old}
+	}
+	dbs, _, err := e.accountedDatabaseClusters(ctx)
+	if err != nil {
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not list Kubernetes clusters")
+	}
+	for _, q := range quotas {
+		used := quotaConsumption(dbs, q.SubjectKind, q.Subject, replaced)
+		if err := checkQuota(q, used, databaseClusterRequests(proposed.Spec)); err != nil {
+			return http.StatusForbidden, err
+		}
+	}
+	return 0, nil
+}
+
+// accountedDatabaseCluster is a database cluster on a kubernetes cluster.
+type accountedDatabaseCluster struct {
+	kubernetesID string
+	db           everestv1alpha1.DatabaseCluster
+}
+
+// accountedDatabaseClusters lists the database clusters on all the kubernetes clusters. The last known database
+// clusters of the unreachable kubernetes clusters are returned together with the unreachable clusters.
+func (e *EverestServer) accountedDatabaseClusters(ctx context.Context) ([]accountedDatabaseCluster, []UnreachableCluster, error) {
+	clusters, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := []accountedDatabaseCluster{}
+	unreachable := []UnreachableCluster{}
+	for _, k := range clusters {
+		k := k
+		dbs, u := e.clusterState(ctx, k, "quota", func(ctx context.Context) (interface{}, error) {
+			kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+			if err != nil {
+				return nil, err
+			}
+			list, err := kubeClient.ListDatabaseClusters(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return list.Items, nil
+		})
+		if u != nil {
+			unreachable = append(unreachable, *u)
+		}
+		if dbs != nil {
+			for _, db := range dbs.([]everestv1alpha1.DatabaseCluster) {
+				res = append(res, accountedDatabaseCluster{kubernetesID: k.ID, db: db})
+			}
+		}
+	}
+	return res, unreachable, nil
+}
+
+// quotaConsumption sums the resources requested by the database clusters of a user or a team.
+// The replaced database cluster is left out.
+func quotaConsumption(dbs []accountedDatabaseCluster, subjectKind, subject string, replaced *accountedDatabaseCluster) QuotaConsumption {
+	annotation := ownerAnnotation
+	if subjectKind == model.SubjectKindTeam {
+		annotation = teamAnnotation
+	}
+
+	res := QuotaConsumption{}
+	for _, d := range dbs {
+		if d.db.Annotations[annotation] != subject {
+			continue
+		}
+		if replaced != nil && d.kubernetesID == replaced.kubernetesID &&
+			d.db.Namespace == replaced.db.Namespace && d.db.Name == replaced.db.Name {
+			continue
+		}
+		r := databaseClusterRequests(d.db.Spec)
+		res.DatabaseClusters++
+		res.CpuMillis += r.cpuMillis
+		res.MemoryBytes += r.memoryBytes
+		res.StorageBytes += r.storageBytes
+	}
+	return res
+}
+
+// checkQuota returns an error listing the limits of the quota one more database cluster requesting
+// the resources exceeds.
+func checkQuota(q *model.Quota, used QuotaConsumption, requested resourceRequests) error {
+	var exceeded []string
+	if q.MaxDatabaseClusters != 0 && used.DatabaseClusters+1 > q.MaxDatabaseClusters {
+		exceeded = append(exceeded, fmt.Sprintf("at most %d database clusters", q.MaxDatabaseClusters))
+	}
+	for _, l := range []struct {
+		name  string
+		limit int64
+		total int64
+	}{
+		{name: "CPU millis", limit: q.MaxCPUMillis, total: used.CpuMillis + requested.cpuMillis},
+		{name: "bytes of memory", limit: q.MaxMemoryBytes, total: used.MemoryBytes + requested.memoryBytes},
+		{name: "bytes of storage", limit: q.MaxStorageBytes, total: used.StorageBytes + requested.storageBytes},
+	} {
+		if l.limit != 0 && l.total > l.limit {
+			exceeded = append(exceeded, fmt.Sprintf("at most %d %s while %d are requested", l.limit, l.name, l.total))
+		}
+	}
+	if len(exceeded) == 0 {
+		return nil
+	}
+	return fmt.Errorf("the quota of %s %s allows %s", q.SubjectKind, q.Subject, strings.Join(exceeded, "; "))
+}
+
+// databaseClusterRequests returns the resources requested by the engine and the proxy replicas.
+// The proxy has as many replicas as the engine if its replicas are not set.
+func databaseClusterRequests(spec everestv1alpha1.DatabaseClusterSpec) resourceRequests {
+	replicas := int64(spec.Engine.Replicas)
+	if replicas < 1 {
+		replicas = 1
+	}
+	proxyReplicas := replicas
+	if spec.Proxy.Replicas != nil {
+		proxyReplicas = int64(*spec.Proxy.Replicas)
+	}
+
+	return resourceRequests{
+		cpuMillis:    replicas*spec.Engine.Resources.CPU.MilliValue() + proxyReplicas*spec.Proxy.Resources.CPU.MilliValue(),
+		memoryBytes:  replicas*spec.Engine.Resources.Memory.Value() + proxyReplicas*spec.Proxy.Resources.Memory.Value(),
+		storageBytes: replicas * spec.Engine.Storage.Size.Value(),
+	}
+}
+
+// setOwnerAnnotations sets the annotations recording the user and the team of the database cluster in the request body.
+func setOwnerAnnotations(req *http.Request, owner, team string) error {
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return errors.Join(err, errors.New("could not decode body"))
+	}
+
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+		obj["metadata"] = metadata
+	}
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+	if annotations == nil {
+		annotations = map[string]interface{}{}
+		metadata["annotations"] = annotations
+	}
+	for key, value := range map[string]string{ownerAnnotation: owner, teamAnnotation: team} {
+		if value == "" {
+			delete(annotations, key)
+			continue
+		}
+		annotations[key] = value
+	}
+	return replaceRequestBody(req, obj)
+}
+
+func validateQuotaSubject(subjectKind, subject string) error {
+	if subjectKind != model.SubjectKindUser && subjectKind != model.SubjectKindTeam {
+		return fmt.Errorf("subject kind shall be either %s or %s", model.SubjectKindUser, model.SubjectKindTeam)
+	}
+	if subject == "" {
+		return errors.New("subject cannot be empty")
+	}
+	return nil
+}
+
+func validateQuota(subjectKind, subject string, l QuotaLimits) error {
+	if err := validateQuotaSubject(subjectKind, subject); err != nil {
+		return err
+	}
+	if pointer.GetInt(l.MaxDatabaseClusters) < 0 || pointer.GetInt64(l.MaxCpuMillis) < 0 ||
+		pointer.GetInt64(l.MaxMemoryBytes) < 0 || pointer.GetInt64(l.MaxStorageBytes) < 0 {
+		return errors.New("quota limits shall not be negative")
+	}
+	return nil
+}
+
+func quotaToAPIJson(q *model.Quota) Quota {
+	return Quota{
+		SubjectKind: q.SubjectKind,
+		Subject:     q.Subject,
+		Limits: QuotaLimits{
+			MaxDatabaseClusters: pointer.ToInt(q.MaxDatabaseClusters),
+			MaxCpuMillis:        pointer.ToInt64(q.MaxCPUMillis),
+			MaxMemoryBytes:      pointer.ToInt64(q.MaxMemoryBytes),
+			MaxStorageBytes:     pointer.ToInt64(q.MaxStorageBytes),
+		},
+	}
+}
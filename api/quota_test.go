@@ -0,0 +1,99 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestQuotaConsumption(t *testing.T) {
+	t.Parallel()
+
+	db := func(name, owner, team string) accountedDatabaseCluster {
+		d := everestv1alpha1.DatabaseCluster{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "everest",
+			Annotations: map[string]string{ownerAnnotation: owner, teamAnnotation: team},
+		}}
+		d.Spec.Engine.Replicas = 3
+		d.Spec.Engine.Resources.CPU = resource.MustParse("1")
+		d.Spec.Engine.Resources.Memory = resource.MustParse("1G")
+		d.Spec.Engine.Storage.Size = resource.MustParse("10G")
+		d.Spec.Proxy.Replicas = pointer.ToInt32(1)
+		d.Spec.Proxy.Resources.CPU = resource.MustParse("500m")
+		return accountedDatabaseCluster{kubernetesID: "k1", db: d}
+	}
+	dbs := []accountedDatabaseCluster{
+		db("db1", "alice", "payments"),
+		db("db2", "bob", "payments"),
+		db("db3", "alice", ""),
+	}
+
+	assert.Equal(t, QuotaConsumption{
+		DatabaseClusters: 2, CpuMillis: 7000, MemoryBytes: 6e9, StorageBytes: 60e9,
+	}, quotaConsumption(dbs, model.SubjectKindUser, "alice", nil))
+	assert.Equal(t, QuotaConsumption{
+		DatabaseClusters: 1, CpuMillis: 3500, MemoryBytes: 3e9, StorageBytes: 30e9,
+	}, quotaConsumption(dbs, model.SubjectKindTeam, "payments", &dbs[1]))
+	assert.Equal(t, QuotaConsumption{}, quotaConsumption(dbs, model.SubjectKindTeam, "search", nil))
+}
+
+func TestCheckQuota(t *testing.T) {
+	t.Parallel()
+
+	q := &model.Quota{SubjectKind: model.SubjectKindTeam, Subject: "payments", MaxDatabaseClusters: 2, MaxCPUMillis: 4000}
+	used := QuotaConsumption{DatabaseClusters: 1, CpuMillis: 3000, MemoryBytes: 1 << 40}
+
+	assert.NoError(t, checkQuota(q, used, resourceRequests{cpuMillis: 1000}))
+	assert.EqualError(t, checkQuota(q, used, resourceRequests{cpuMillis: 1500}),
+		"the quota of team payments allows at most 4000 CPU millis while 4500 are requested")
+
+	used.DatabaseClusters = 2
+	assert.EqualError(t, checkQuota(q, used, resourceRequests{}),
+		"the quota of team payments allows at most 2 database clusters")
+}
+
+func TestSetOwnerAnnotations(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"metadata":{"name":"db1","annotations":{"everest.percona.com/team":"search","a":"b"}}}`)
+	req, err := http.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	require.NoError(t, err)
+	req.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+
+	require.NoError(t, setOwnerAnnotations(req, "alice", ""))
+	b, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	var obj map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &obj))
+	assert.Equal(t, map[string]interface{}{
+		"name":        "db1",
+		"annotations": map[string]interface{}{ownerAnnotation: "alice", "a": "b"},
+	}, obj["metadata"])
+}
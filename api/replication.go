@@ -0,0 +1,239 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+const replicationTokenHeader = "X-Everest-Replication-Token"
+
+const standbyInstanceMessage = "Everest is running as a standby instance"
+
+// replicationState holds the role of the instance in the warm standby replication
+// and the outcome of the last replication from the primary instance.
+type replicationState struct {
+	mu             sync.RWMutex
+	role           ReplicationStatusRole
+	lastSyncedAt   *time.Time
+	lastError      string
+	missingSecrets []string
+}
+
+func (e *EverestServer) initReplication() error {
+	role := ReplicationStatusRole(e.config.ReplicationRole)
+	switch role {
+	case Primary:
+	case Standby:
+		if e.config.ReplicationPrimaryURL == "" || e.config.ReplicationToken == "" {
+			return errors.New("primary URL and replication token are required for a standby instance")
+		}
+	default:
+		return fmt.Errorf("unknown replication role '%s'", role)
+	}
+
+	e.replication = &replicationState{role: role}
+	return nil
+}
+
+func (e *EverestServer) isStandby() bool {
+	e.replication.mu.RLock()
+	defer e.replication.mu.RUnlock()
+	return e.replication.role == Standby
+}
+
+// rejectWritesOnStandby is a middleware which rejects all requests changing the state
+// while the instance is a standby, except for its promotion.
+func (e *EverestServer) rejectWritesOnStandby(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		switch ctx.Request().Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return next(ctx)
+		}
+		if !e.isStandby() || strings.HasSuffix(ctx.Request().URL.Path, "/replication/promote") {
+			return next(ctx)
+		}
+		return ctx.JSON(http.StatusServiceUnavailable, Error{Message: pointer.ToString(standbyInstanceMessage)})
+	}
+}
+
+// GetReplicationSnapshot returns the state replicated to the standby instances.
+func (e *EverestServer) GetReplicationSnapshot(ctx echo.Context, params GetReplicationSnapshotParams) error {
+	if e.config.ReplicationToken == "" ||
+		subtle.ConstantTimeCompare([]byte(params.XEverestReplicationToken), []byte(e.config.ReplicationToken)) != 1 {
+		return ctx.JSON(http.StatusUnauthorized, Error{Message: pointer.ToString("Invalid replication token")})
+	}
+	if e.isStandby() {
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString(standbyInstanceMessage)})
+	}
+
+	s, err := e.storage.GetReplicationSnapshot(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the replication snapshot")})
+	}
+
+	return ctx.JSON(http.StatusOK, s)
+}
+
+// GetReplicationStatus returns the replication status of the instance.
+func (e *EverestServer) GetReplicationStatus(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, e.replicationStatus())
+}
+
+// PromoteReplicationStandby promotes the standby instance to primary.
+func (e *EverestServer) PromoteReplicationStandby(ctx echo.Context) error {
+	e.replication.mu.Lock()
+	if e.replication.role != Standby {
+		e.replication.mu.Unlock()
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("Everest is not running as a standby instance")})
+	}
+	e.replication.role = Primary
+	e.replication.mu.Unlock()
+
+	e.l.Infof("Promoted to primary. The state was last replicated at %v", e.replicationStatus().LastSyncedAt)
+	return ctx.JSON(http.StatusOK, e.replicationStatus())
+}
+
+func (e *EverestServer) replicationStatus() ReplicationStatus {
+	e.replication.mu.RLock()
+	defer e.replication.mu.RUnlock()
+
+	res := ReplicationStatus{
+		Role:         e.replication.role,
+		LastSyncedAt: e.replication.lastSyncedAt,
+	}
+	if e.config.ReplicationPrimaryURL != "" {
+		res.PrimaryUrl = pointer.ToString(e.config.ReplicationPrimaryURL)
+	}
+	if e.replication.lastError != "" {
+		res.LastError = pointer.ToString(e.replication.lastError)
+	}
+	if len(e.replication.missingSecrets) != 0 {
+		res.MissingSecrets = &e.replication.missingSecrets
+	}
+	return res
+}
+
+// runReplicator periodically replicates the state from the primary instance
+// while the instance is a standby until the context is canceled.
+func (e *EverestServer) runReplicator(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.ReplicationInterval)
+	defer ticker.Stop()
+
+	for {
+		if e.isStandby() {
+			e.replicate(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// replicate replaces the local state with the one of the primary instance.
+func (e *EverestServer) replicate(ctx context.Context) {
+	missing, err := e.applyPrimarySnapshot(ctx)
+
+	e.replication.mu.Lock()
+	defer e.replication.mu.Unlock()
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not replicate the state from the primary instance")))
+		e.replication.lastError = err.Error()
+		return
+	}
+	e.replication.lastSyncedAt = pointer.ToTime(time.Now().UTC())
+	e.replication.lastError = ""
+	e.replication.missingSecrets = missing
+}
+
+func (e *EverestServer) applyPrimarySnapshot(ctx context.Context) ([]string, error) {
+	s, err := e.fetchPrimarySnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The instance could have been promoted while the snapshot was being fetched.
+	if !e.isStandby() {
+		return nil, nil
+	}
+	if err := e.storage.ApplyReplicationSnapshot(ctx, s); err != nil {
+		return nil, errors.Join(err, errors.New("could not apply the replication snapshot"))
+	}
+
+	missing := make([]string, 0)
+	for _, id := range s.SecretIDs {
+		if _, err := e.secretsStorage.GetSecret(ctx, id); err != nil {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) != 0 {
+		e.l.Warnf("%d secrets referenced by the replicated state are missing in the secrets storage", len(missing))
+	}
+	return missing, nil
+}
+
+func (e *EverestServer) fetchPrimarySnapshot(ctx context.Context) (*model.ReplicationSnapshot, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/v1/replication/snapshot", strings.TrimSuffix(e.config.ReplicationPrimaryURL, "/")),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(replicationTokenHeader, e.config.ReplicationToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("primary instance returned HTTP status code %d: %s", resp.StatusCode, string(data))
+	}
+
+	s := &model.ReplicationSnapshot{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, errors.Join(err, errors.New("could not decode the replication snapshot"))
+	}
+	return s, nil
+}
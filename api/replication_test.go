@@ -0,0 +1,57 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRejectWritesOnStandby(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		role   ReplicationStatusRole
+		method string
+		path   string
+		code   int
+	}{
+		{name: "primary write", role: Primary, method: http.MethodPost, path: "/v1/backup-storages", code: http.StatusOK},
+		{name: "standby read", role: Standby, method: http.MethodGet, path: "/v1/backup-storages", code: http.StatusOK},
+		{name: "standby write", role: Standby, method: http.MethodPost, path: "/v1/backup-storages", code: http.StatusServiceUnavailable},
+		{name: "standby delete", role: Standby, method: http.MethodDelete, path: "/v1/kubernetes/1", code: http.StatusServiceUnavailable},
+		{name: "standby promotion", role: Standby, method: http.MethodPost, path: "/v1/replication/promote", code: http.StatusOK},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			e := &EverestServer{replication: &replicationState{role: tc.role}}
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(tc.method, tc.path, nil), rec)
+
+			err := e.rejectWritesOnStandby(func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.code, rec.Code)
+		})
+	}
+}
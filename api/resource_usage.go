@@ -0,0 +1,176 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+	"github.com/percona/percona-everest-backend/pkg/pmm"
+)
+
+// Sources of the CPU and memory usage of a database cluster.
+const (
+	resourceUsageSourceMetricsServer = "metrics-server"
+	resourceUsageSourcePMM           = "pmm"
+)
+
+// GetDatabaseClusterResourceUsage returns the resources used and requested by the pods of the database cluster.
+func (e *EverestServer) GetDatabaseClusterResourceUsage(ctx echo.Context, kubernetesID string, name string, _ GetDatabaseClusterResourceUsageParams) error {
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	db, err := kubeClient.GetDatabaseCluster(c, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Database cluster not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+	pods, err := kubeClient.DatabaseClusterPods(c, db)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the pods of database cluster")})
+	}
+	requested, err := kubernetes.PodsRequests(pods)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the requested resources")})
+	}
+	volumes, err := kubeClient.GetVolumeUsage(c, db.Namespace)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the usage of the persistent volumes")})
+	}
+
+	usage := DatabaseClusterResourceUsage{
+		Requested: ResourceAmounts{
+			CpuMillis:   pointer.ToUint64(requested.CPUMillis),
+			MemoryBytes: pointer.ToUint64(requested.MemoryBytes),
+			DiskBytes:   pointer.ToUint64(uint64(db.Spec.Engine.Storage.Size.Value())),
+		},
+		CheckedAt: time.Now().UTC(),
+	}
+	claims := kubernetes.PodsVolumeClaims(pods)
+	var diskUsed uint64
+	for _, v := range volumes {
+		if _, ok := claims[v.PVCName]; ok {
+			diskUsed += v.UsedBytes
+			usage.DiskCapacityBytes += v.CapacityBytes
+		}
+	}
+	usage.Used.DiskBytes = pointer.ToUint64(diskUsed)
+
+	used, source, err := e.databaseClusterUsage(c, kubeClient, db, pods)
+	if err != nil {
+		// The disk usage and the requested resources are still useful without the CPU and memory usage.
+		e.l.Warn(errors.Join(err, fmt.Errorf("could not get the usage of database cluster %s", name)))
+	}
+	if used != nil {
+		usage.Source = &source
+		usage.Used.CpuMillis = pointer.ToUint64(used.CPUMillis)
+		usage.Used.MemoryBytes = pointer.ToUint64(used.MemoryBytes)
+	}
+	usage.Utilization = resourceUtilization(usage)
+
+	return ctx.JSON(http.StatusOK, usage)
+}
+
+// databaseClusterUsage returns the CPU and memory used by the pods of the database cluster. The usage is
+// taken from metrics-server or, if it is not installed, from the PMM instance the database cluster is
+// monitored by. Nil is returned if none of them provides the usage.
+func (e *EverestServer) databaseClusterUsage(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, db *everestv1alpha1.DatabaseCluster, pods []corev1.Pod,
+) (*kubernetes.ResourceAmounts, string, error) {
+	used, err := kubeClient.GetDatabaseClusterUsage(ctx, db)
+	if err == nil {
+		return &used, resourceUsageSourceMetricsServer, nil
+	}
+	if !errors.Is(err, kubernetes.ErrMetricsUnavailable) {
+		return nil, "", err
+	}
+
+	if db.Spec.Monitoring == nil || db.Spec.Monitoring.MonitoringConfigName == "" || len(pods) == 0 {
+		return nil, "", nil
+	}
+	mi, err := e.storage.GetMonitoringInstance(ctx, db.Spec.Monitoring.MonitoringConfigName)
+	if err != nil {
+		return nil, "", errors.Join(err, errors.New("could not get monitoring instance"))
+	}
+	if mi.Type != model.PMMMonitoringInstanceType {
+		return nil, "", nil
+	}
+	apiKey, err := e.secretsStorage.GetSecret(ctx, mi.APIKeySecretID)
+	if err != nil {
+		return nil, "", errors.Join(err, errors.New("could not get PMM API key"))
+	}
+
+	// The container metrics are shipped to PMM by the VMAgent deployed by Everest.
+	selector := podsMetricsSelector(db.Namespace, pods)
+	cpu, found, err := pmm.QuerySum(ctx, mi.URL, apiKey, fmt.Sprintf("sum(rate(container_cpu_usage_seconds_total%s[5m]))", selector))
+	if err != nil || !found {
+		return nil, "", err
+	}
+	memory, found, err := pmm.QuerySum(ctx, mi.URL, apiKey, fmt.Sprintf("sum(container_memory_working_set_bytes%s)", selector))
+	if err != nil || !found {
+		return nil, "", err
+	}
+	return &kubernetes.ResourceAmounts{
+		CPUMillis:   uint64(cpu * 1000), //nolint:gomnd
+		MemoryBytes: uint64(memory),
+	}, resourceUsageSourcePMM, nil
+}
+
+// podsMetricsSelector returns the PromQL selector of the container metrics of the pods.
+func podsMetricsSelector(namespace string, pods []corev1.Pod) string {
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, regexp.QuoteMeta(pod.Name))
+	}
+	return fmt.Sprintf(`{namespace=%q,pod=~%q,container!=""}`, namespace, strings.Join(names, "|"))
+}
+
+func resourceUtilization(usage DatabaseClusterResourceUsage) ResourceUtilization {
+	ratio := func(used *uint64, total uint64) *float64 {
+		if used == nil || total == 0 {
+			return nil
+		}
+		return pointer.ToFloat64(float64(*used) / float64(total))
+	}
+	return ResourceUtilization{
+		Cpu:    ratio(usage.Used.CpuMillis, pointer.GetUint64(usage.Requested.CpuMillis)),
+		Memory: ratio(usage.Used.MemoryBytes, pointer.GetUint64(usage.Requested.MemoryBytes)),
+		Disk:   ratio(usage.Used.DiskBytes, usage.DiskCapacityBytes),
+	}
+}
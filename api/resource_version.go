@@ -0,0 +1,49 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// versionETag returns the ETag of a version of a resource stored by Everest.
+func versionETag(version int64) string {
+	return resourceVersionETag(strconv.FormatInt(version, 10))
+}
+
+// checkVersion checks the update of a resource stored by Everest is based on its current version.
+// The version is passed either in the If-Match header or in the version field of the request body.
+// The returned version is the one the update shall be conditioned on in the database so that the updates
+// racing with this one are detected as well.
+func checkVersion(ctx echo.Context, resource string, field *int64, current int64) (*int64, int, error) {
+	header := ctx.Request().Header.Get("If-Match")
+	if header == "" && field == nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("the version of the %s the update is based on is required in the If-Match header or the version field", resource)
+	}
+	if (header != "" && !etagMatches(header, versionETag(current))) || (field != nil && *field != current) {
+		return nil, http.StatusConflict, versionConflictError(resource)
+	}
+	return &current, http.StatusOK, nil
+}
+
+func versionConflictError(resource string) error {
+	return fmt.Errorf("the %s has been updated in the meantime, please reload it and try again", resource)
+}
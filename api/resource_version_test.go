@@ -0,0 +1,66 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckVersion(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		ifMatch string
+		field   *int64
+		code    int
+	}{
+		{name: "none", code: http.StatusBadRequest},
+		{name: "current field", field: pointer.ToInt64(3), code: http.StatusOK},
+		{name: "outdated field", field: pointer.ToInt64(2), code: http.StatusConflict},
+		{name: "current header", ifMatch: `"3"`, code: http.StatusOK},
+		{name: "weak header", ifMatch: `W/"3"`, code: http.StatusOK},
+		{name: "any header", ifMatch: "*", code: http.StatusOK},
+		{name: "outdated header", ifMatch: `"2"`, code: http.StatusConflict},
+		{name: "header and outdated field", ifMatch: `"3"`, field: pointer.ToInt64(2), code: http.StatusConflict},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPatch, "/v1/backup-storages/s3", nil)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+			version, code, err := checkVersion(ctx, "backup storage", tc.field, 3)
+			assert.Equal(t, tc.code, code)
+			if tc.code != http.StatusOK {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(3), pointer.GetInt64(version))
+		})
+	}
+}
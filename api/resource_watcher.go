@@ -0,0 +1,222 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// Types of the notification events emitted by the resource status watcher.
+const (
+	resourceEventDatabaseClusterError     = "database-cluster-error"
+	resourceEventDatabaseClusterRecovered = "database-cluster-recovered"
+	resourceEventBackupFailed             = "backup-failed"
+	resourceEventRestoreCompleted         = "restore-completed"
+	resourceEventRestoreFailed            = "restore-failed"
+)
+
+// Kinds of the resources watched by the resource status watcher.
+const (
+	watchedDatabaseCluster = "databaseclusters"
+	watchedBackup          = "databaseclusterbackups"
+	watchedRestore         = "databaseclusterrestores"
+)
+
+// resourceStatusState keeps the last observed state of every watched resource
+// so that an event is emitted only when it changes.
+type resourceStatusState struct {
+	mu sync.Mutex
+	// clusters contains the Kubernetes clusters observed at least once.
+	clusters map[string]struct{}
+	// states maps the Kubernetes cluster ID to the states of its resources keyed by kind and name.
+	states map[string]map[string]string
+}
+
+func newResourceStatusState() *resourceStatusState {
+	return &resourceStatusState{
+		clusters: make(map[string]struct{}),
+		states:   make(map[string]map[string]string),
+	}
+}
+
+// watchedResource is the observed state of a watched resource.
+type watchedResource struct {
+	kind  string
+	name  string
+	state string
+}
+
+// runResourceStatusWatcher periodically checks the status of the database clusters, backups
+// and restores of all Kubernetes clusters until the context is canceled.
+func (e *EverestServer) runResourceStatusWatcher(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.ResourceWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.watchAllResources(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) watchAllResources(ctx context.Context) {
+	clusters, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list Kubernetes clusters")))
+		return
+	}
+
+	for i := range clusters {
+		if ctx.Err() != nil {
+			return
+		}
+		k := &clusters[i]
+		kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not create Kubernetes client for %s", k.ID)))
+			continue
+		}
+		resources, err := watchedResourcesOf(ctx, kubeClient)
+		if err != nil {
+			e.l.Warn(errors.Join(err, fmt.Errorf("could not watch resources of Kubernetes cluster %s", k.ID)))
+			continue
+		}
+		for _, event := range e.resourceStatus.observe(k.ID, resources) {
+			e.notify(ctx, event)
+		}
+	}
+}
+
+func watchedResourcesOf(ctx context.Context, kubeClient *kubernetes.Kubernetes) ([]watchedResource, error) {
+	dbs, err := kubeClient.ListDatabaseClusters(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list database clusters"))
+	}
+	backups, err := kubeClient.ListDatabaseClusterBackups(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list database cluster backups"))
+	}
+	restores, err := kubeClient.ListDatabaseClusterRestores(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list database cluster restores"))
+	}
+	return watchedResourcesFrom(dbs, backups, restores), nil
+}
+
+func watchedResourcesFrom(
+	dbs *everestv1alpha1.DatabaseClusterList,
+	backups *everestv1alpha1.DatabaseClusterBackupList,
+	restores *everestv1alpha1.DatabaseClusterRestoreList,
+) []watchedResource {
+	res := make([]watchedResource, 0, len(dbs.Items)+len(backups.Items)+len(restores.Items))
+	for _, db := range dbs.Items {
+		res = append(res, watchedResource{kind: watchedDatabaseCluster, name: db.Name, state: string(db.Status.Status)})
+	}
+	for _, b := range backups.Items {
+		res = append(res, watchedResource{kind: watchedBackup, name: b.Name, state: string(b.Status.State)})
+	}
+	for _, r := range restores.Items {
+		res = append(res, watchedResource{kind: watchedRestore, name: r.Name, state: string(r.Status.State)})
+	}
+	return res
+}
+
+// observe records the states of the resources of a Kubernetes cluster and returns the events
+// of their transitions. The first observation of a Kubernetes cluster is only recorded.
+func (s *resourceStatusState) observe(kubernetesID string, resources []watchedResource) []notificationEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, seen := s.clusters[kubernetesID]
+	s.clusters[kubernetesID] = struct{}{}
+
+	last := s.states[kubernetesID]
+	current := make(map[string]string, len(resources))
+	var events []notificationEvent
+	for _, r := range resources {
+		key := r.kind + "/" + r.name
+		state := strings.ToLower(r.state)
+		current[key] = state
+		if !seen {
+			continue
+		}
+		eventType, severity, ok := resourceStatusTransition(r.kind, last[key], state)
+		if !ok {
+			continue
+		}
+		events = append(events, notificationEvent{
+			Type:         eventType,
+			Severity:     severity,
+			KubernetesID: kubernetesID,
+			Resource:     key,
+			Message:      fmt.Sprintf("%s on Kubernetes cluster %s is %s", key, kubernetesID, state),
+		})
+	}
+	// Deleted resources are forgotten by replacing the states.
+	s.states[kubernetesID] = current
+	return events
+}
+
+// resourceStatusTransition returns the type and the severity of the event emitted when a resource
+// of the kind moves from the previous to the current lowercased state, if any.
+func resourceStatusTransition(kind, previous, current string) (string, string, bool) {
+	if previous == current {
+		return "", "", false
+	}
+	_, failed := failedBackupStates[current]
+	_, succeeded := successfulBackupStates[current]
+
+	switch kind {
+	case watchedDatabaseCluster:
+		switch {
+		case current == string(everestv1alpha1.AppStateError):
+			return resourceEventDatabaseClusterError, notificationSeverityCritical, true
+		case previous == string(everestv1alpha1.AppStateError) && current == string(everestv1alpha1.AppStateReady):
+			return resourceEventDatabaseClusterRecovered, notificationSeverityInfo, true
+		}
+	case watchedBackup:
+		if failed {
+			return resourceEventBackupFailed, notificationSeverityCritical, true
+		}
+	case watchedRestore:
+		switch {
+		case failed:
+			return resourceEventRestoreFailed, notificationSeverityCritical, true
+		case succeeded:
+			return resourceEventRestoreCompleted, notificationSeverityInfo, true
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,138 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// ListRestoreHistory lists the database cluster restores of all kubernetes clusters.
+func (e *EverestServer) ListRestoreHistory(ctx echo.Context, params ListRestoreHistoryParams) error {
+	c := ctx.Request().Context()
+	clusters, err := e.storage.ListKubernetesClusters(c)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list Kubernetes clusters")})
+	}
+
+	creators, err := e.restoreCreators(ctx)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list audit entries")})
+	}
+
+	history := make(RestoreHistory, 0)
+	var unreachable []UnreachableCluster
+	for _, k := range clusters {
+		if params.KubernetesId != nil && *params.KubernetesId != k.ID {
+			continue
+		}
+
+		k := k
+		// An unreachable Kubernetes cluster shall not hide the history of the other ones.
+		restores, u := e.clusterState(c, k, "restores", func(ctx context.Context) (interface{}, error) {
+			_, kubeClient, _, err := e.initKubeClient(ctx, k.ID)
+			if err != nil {
+				return nil, err
+			}
+			restores, err := kubeClient.ListDatabaseClusterRestores(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return restores.Items, nil
+		})
+		if u != nil {
+			unreachable = append(unreachable, *u)
+		}
+		if restores != nil {
+			history = append(history, restoreHistory(k.ID, restores.([]everestv1alpha1.DatabaseClusterRestore), creators, params)...)
+		}
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].StartedAt.After(history[j].StartedAt)
+	})
+	setPartialResultHeaders(ctx, unreachable)
+	return ctx.JSON(http.StatusOK, history)
+}
+
+// restoreCreators returns the users who created the restores by the kubernetes cluster ID and the restore name.
+func (e *EverestServer) restoreCreators(ctx echo.Context) (map[string]string, error) {
+	entries, _, err := e.storage.ListAuditEntries(ctx.Request().Context(), model.ListAuditEntriesParams{
+		Action: auditActionRestoreCreated,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	creators := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		key := entry.KubernetesID + "/" + entry.Resource
+		// The entries are sorted starting with the most recent ones,
+		// so a reused restore name refers to the latest creator.
+		if _, ok := creators[key]; !ok && entry.Actor != "" {
+			creators[key] = entry.Actor
+		}
+	}
+	return creators, nil
+}
+
+func restoreHistory(
+	kubernetesID string, restores []everestv1alpha1.DatabaseClusterRestore,
+	creators map[string]string, params ListRestoreHistoryParams,
+) []RestoreHistoryEntry {
+	var history []RestoreHistoryEntry
+	for _, r := range restores {
+		if params.Cluster != nil && *params.Cluster != r.Spec.DBClusterName {
+			continue
+		}
+		if params.Status != nil && *params.Status != string(r.Status.State) {
+			continue
+		}
+
+		entry := RestoreHistoryEntry{
+			KubernetesId:  kubernetesID,
+			Name:          r.Name,
+			DbClusterName: r.Spec.DBClusterName,
+			BackupName:    pointer.ToStringOrNil(r.Spec.DataSource.DBClusterBackupName),
+			State:         pointer.ToStringOrNil(string(r.Status.State)),
+			Message:       pointer.ToStringOrNil(r.Status.Message),
+			StartedAt:     r.CreationTimestamp.UTC(),
+		}
+		if creator, ok := creators[kubernetesID+"/"+restoreAuditResource(r.Name)]; ok {
+			entry.CreatedBy = &creator
+		}
+		if r.Status.CompletedAt != nil {
+			entry.CompletedAt = pointer.ToTime(r.Status.CompletedAt.UTC())
+			entry.DurationSeconds = pointer.ToInt64(int64(r.Status.CompletedAt.Sub(r.CreationTimestamp.Time).Seconds()))
+		}
+		history = append(history, entry)
+	}
+	return history
+}
+
+func restoreAuditResource(name string) string {
+	return "database-cluster-restores/" + name
+}
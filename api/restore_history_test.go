@@ -0,0 +1,68 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRestoreHistory(t *testing.T) {
+	t.Parallel()
+
+	started := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	restore := func(name, db string, state everestv1alpha1.RestoreState, completedAfter time.Duration) everestv1alpha1.DatabaseClusterRestore {
+		r := everestv1alpha1.DatabaseClusterRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(started)},
+			Spec: everestv1alpha1.DatabaseClusterRestoreSpec{
+				DBClusterName: db,
+				DataSource:    everestv1alpha1.DataSource{DBClusterBackupName: db + "-backup"},
+			},
+			Status: everestv1alpha1.DatabaseClusterRestoreStatus{State: state},
+		}
+		if completedAfter != 0 {
+			r.Status.CompletedAt = pointer.To(metav1.NewTime(started.Add(completedAfter)))
+		}
+		return r
+	}
+	restores := []everestv1alpha1.DatabaseClusterRestore{
+		restore("restore-1", "db-1", "Succeeded", 90*time.Second),
+		restore("restore-2", "db-1", "Restoring", 0),
+		restore("restore-3", "db-2", "Failed", 10*time.Second),
+	}
+	creators := map[string]string{"k8s/database-cluster-restores/restore-1": "alice"}
+
+	history := restoreHistory("k8s", restores, creators, ListRestoreHistoryParams{})
+	require.Len(t, history, 3)
+	assert.Equal(t, "alice", pointer.GetString(history[0].CreatedBy))
+	assert.Equal(t, int64(90), pointer.GetInt64(history[0].DurationSeconds))
+	assert.Equal(t, "db-1-backup", pointer.GetString(history[0].BackupName))
+	assert.Nil(t, history[1].CreatedBy)
+	assert.Nil(t, history[1].DurationSeconds)
+
+	history = restoreHistory("k8s", restores, creators, ListRestoreHistoryParams{Cluster: pointer.ToString("db-1")})
+	assert.Len(t, history, 2)
+
+	history = restoreHistory("k8s", restores, creators, ListRestoreHistoryParams{Status: pointer.ToString("Failed")})
+	require.Len(t, history, 1)
+	assert.Equal(t, "restore-3", history[0].Name)
+}
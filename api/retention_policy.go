@@ -0,0 +1,432 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// s3DeleteObjectsLimit is the maximum number of objects a single DeleteObjects request deletes.
+const s3DeleteObjectsLimit = 1000
+
+// expiredBackup is a backup to be pruned under a retention policy.
+type expiredBackup struct {
+	backup *everestv1alpha1.DatabaseClusterBackup
+	reason string
+}
+
+// GetBackupStorageRetentionPolicy returns the retention policy of the specified backup storage.
+func (e *EverestServer) GetBackupStorageRetentionPolicy(ctx echo.Context, name string) error {
+	policy, err := e.storage.GetBackupStorageRetentionPolicy(ctx.Request().Context(), name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find retention policy")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get retention policy")})
+	}
+	return ctx.JSON(http.StatusOK, retentionPolicyFrom(policy.RetentionLimits))
+}
+
+// SetBackupStorageRetentionPolicy creates or updates the retention policy of the specified backup storage.
+func (e *EverestServer) SetBackupStorageRetentionPolicy(ctx echo.Context, name string) error {
+	limits, err := bindRetentionLimits(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	if _, err := e.storage.GetBackupStorage(c, nil, name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup storage")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup storage")})
+	}
+
+	policy, err := e.storage.SaveBackupStorageRetentionPolicy(c, name, limits)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save retention policy")})
+	}
+	return ctx.JSON(http.StatusOK, retentionPolicyFrom(policy.RetentionLimits))
+}
+
+// DeleteBackupStorageRetentionPolicy deletes the retention policy of the specified backup storage.
+func (e *EverestServer) DeleteBackupStorageRetentionPolicy(ctx echo.Context, name string) error {
+	if err := e.storage.DeleteBackupStorageRetentionPolicy(ctx.Request().Context(), name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find retention policy")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete retention policy")})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetDatabaseClusterRetentionPolicy returns the retention policy of the specified database cluster.
+func (e *EverestServer) GetDatabaseClusterRetentionPolicy(ctx echo.Context, kubernetesID string, name string, _ GetDatabaseClusterRetentionPolicyParams) error {
+	policy, err := e.storage.GetDBClusterRetentionPolicy(ctx.Request().Context(), kubernetesID, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find retention policy")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get retention policy")})
+	}
+	return ctx.JSON(http.StatusOK, retentionPolicyFrom(policy.RetentionLimits))
+}
+
+// SetDatabaseClusterRetentionPolicy creates or updates the retention policy of the specified database cluster.
+func (e *EverestServer) SetDatabaseClusterRetentionPolicy(ctx echo.Context, kubernetesID string, name string, _ SetDatabaseClusterRetentionPolicyParams) error {
+	limits, err := bindRetentionLimits(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	if _, err := kubeClient.GetDatabaseCluster(c, name); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString(fmt.Sprintf("DatabaseCluster '%s' is not found", name))})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+
+	policy, err := e.storage.SaveDBClusterRetentionPolicy(c, kubernetesID, name, limits)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save retention policy")})
+	}
+	return ctx.JSON(http.StatusOK, retentionPolicyFrom(policy.RetentionLimits))
+}
+
+// DeleteDatabaseClusterRetentionPolicy deletes the retention policy of the specified database cluster.
+func (e *EverestServer) DeleteDatabaseClusterRetentionPolicy(ctx echo.Context, kubernetesID string, name string, _ DeleteDatabaseClusterRetentionPolicyParams) error {
+	if err := e.storage.DeleteDBClusterRetentionPolicy(ctx.Request().Context(), kubernetesID, name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find retention policy")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete retention policy")})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func bindRetentionLimits(ctx echo.Context) (model.RetentionLimits, error) {
+	var params RetentionPolicy
+	if err := ctx.Bind(&params); err != nil {
+		return model.RetentionLimits{}, err
+	}
+	limits := model.RetentionLimits{
+		MaxAgeDays: pointer.GetInt(params.MaxAgeDays),
+		MaxCopies:  pointer.GetInt(params.MaxCopies),
+	}
+	if limits.MaxAgeDays < 0 || limits.MaxCopies < 0 {
+		return model.RetentionLimits{}, errors.New("maxAgeDays and maxCopies shall not be negative")
+	}
+	if limits.MaxAgeDays == 0 && limits.MaxCopies == 0 {
+		return model.RetentionLimits{}, errors.New("either maxAgeDays or maxCopies shall be set")
+	}
+	return limits, nil
+}
+
+func retentionPolicyFrom(limits model.RetentionLimits) RetentionPolicy {
+	return RetentionPolicy{
+		MaxAgeDays: pointer.ToInt(limits.MaxAgeDays),
+		MaxCopies:  pointer.ToInt(limits.MaxCopies),
+	}
+}
+
+// runRetentionEnforcer periodically prunes the backups expired under the retention policies
+// until the context is canceled.
+func (e *EverestServer) runRetentionEnforcer(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.RetentionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.enforceRetentionPolicies(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) enforceRetentionPolicies(ctx context.Context) {
+	storagePolicies, err := e.storage.ListBackupStorageRetentionPolicies(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list backup storage retention policies")))
+		return
+	}
+	storageLimits := make(map[string]model.RetentionLimits, len(storagePolicies))
+	for _, p := range storagePolicies {
+		storageLimits[p.BackupStorageName] = p.RetentionLimits
+	}
+
+	clusters, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list Kubernetes clusters")))
+		return
+	}
+	for _, k := range clusters {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := e.enforceClusterRetention(ctx, k, storageLimits); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not enforce the retention policies on Kubernetes cluster %s", k.ID)))
+		}
+	}
+}
+
+func (e *EverestServer) enforceClusterRetention(
+	ctx context.Context, k model.KubernetesCluster, storageLimits map[string]model.RetentionLimits,
+) error {
+	dbPolicies, err := e.storage.ListDBClusterRetentionPolicies(ctx, k.ID)
+	if err != nil {
+		return errors.Join(err, errors.New("could not list database cluster retention policies"))
+	}
+	if len(dbPolicies) == 0 && len(storageLimits) == 0 {
+		return nil
+	}
+	dbLimits := make(map[string]model.RetentionLimits, len(dbPolicies))
+	for _, p := range dbPolicies {
+		dbLimits[p.DBClusterName] = p.RetentionLimits
+	}
+
+	kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+	if err != nil {
+		return err
+	}
+	backups, err := kubeClient.ListDatabaseClusterBackups(ctx)
+	if err != nil {
+		return errors.Join(err, errors.New("could not list database cluster backups"))
+	}
+	dbs, err := kubeClient.ListDatabaseClusters(ctx)
+	if err != nil {
+		return errors.Join(err, errors.New("could not list database clusters"))
+	}
+	engines := make(map[string]everestv1alpha1.EngineType, len(dbs.Items))
+	for _, db := range dbs.Items {
+		engines[db.Name] = db.Spec.Engine.Type
+	}
+
+	expired := expiredBackups(backups.Items, dbLimits, storageLimits, e.config.BackupComplianceAge, time.Now())
+	pruned := make(map[string]struct{})
+	for _, exp := range expired {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := e.pruneBackup(ctx, k, kubeClient, exp, engines[exp.backup.Spec.DBClusterName]); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not prune backup %s", exp.backup.Name)))
+			continue
+		}
+		pruned[exp.backup.Spec.BackupStorageName] = struct{}{}
+	}
+
+	// The backup storages are removed from the Kubernetes cluster once no backup uses them.
+	for name := range pruned {
+		if err := e.deleteK8SBackupStorage(ctx, kubeClient, name); err != nil && !errors.Is(err, kubernetes.ErrConfigInUse) {
+			e.l.Error(err)
+		}
+	}
+	return nil
+}
+
+// pruneBackup deletes the backup and its objects in the bucket and records it in the audit trail.
+// The objects of the backups which database cluster is unknown or uses PostgreSQL are left to the operators,
+// since pgBackRest expires its repository on its own.
+func (e *EverestServer) pruneBackup(
+	ctx context.Context, k model.KubernetesCluster, kubeClient *kubernetes.Kubernetes,
+	exp expiredBackup, engine everestv1alpha1.EngineType,
+) error {
+	b := exp.backup
+	if err := kubeClient.DeleteDatabaseClusterBackup(ctx, b.Name); err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	e.l.Infof("pruned backup %s of database cluster %s on Kubernetes cluster %s: %s", b.Name, b.Spec.DBClusterName, k.ID, exp.reason)
+
+	if engine != "" && engine != everestv1alpha1.DatabaseEnginePostgresql && b.Status.Destination != nil {
+		if err := e.deleteBackupObjects(ctx, b.Spec.BackupStorageName, *b.Status.Destination); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not delete the objects of backup %s", b.Name)))
+		}
+	}
+
+	return e.storage.CreateAuditEntry(ctx, &model.AuditEntry{
+		Action:       auditActionBackupRetentionPruned,
+		Resource:     backupAuditResource(b.Name),
+		KubernetesID: k.ID,
+		Reason:       exp.reason,
+	})
+}
+
+// deleteBackupObjects deletes the objects under the destination of a backup if it is in the bucket of the backup storage.
+func (e *EverestServer) deleteBackupObjects(ctx context.Context, backupStorageName, destination string) error {
+	bs, err := e.storage.GetBackupStorage(ctx, nil, backupStorageName)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get backup storage"))
+	}
+	if bs.Type != string(BackupStorageTypeS3) {
+		return nil
+	}
+	prefix, ok := backupDestinationPrefix(destination, bs.BucketName)
+	if !ok {
+		return fmt.Errorf("the destination %s is not in bucket %s", destination, bs.BucketName)
+	}
+
+	svc, err := e.backupStorageS3Client(ctx, bs)
+	if err != nil {
+		return err
+	}
+	objects, err := listS3Objects(ctx, svc, bs.BucketName, prefix)
+	if err != nil {
+		return errors.Join(err, errors.New("could not list backup objects"))
+	}
+	for start := 0; start < len(objects); start += s3DeleteObjectsLimit {
+		end := start + s3DeleteObjectsLimit
+		if end > len(objects) {
+			end = len(objects)
+		}
+		ids := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, o := range objects[start:end] {
+			ids = append(ids, &s3.ObjectIdentifier{Key: aws.String(o.key)})
+		}
+		_, err := svc.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bs.BucketName),
+			Delete: &s3.Delete{Objects: ids, Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return errors.Join(err, errors.New("could not delete backup objects"))
+		}
+	}
+	return nil
+}
+
+// backupDestinationPrefix returns the key prefix of the objects of a backup with the destination
+// like s3://bucket/path/name. An empty prefix is never returned so that the whole bucket is never pruned.
+func backupDestinationPrefix(destination, bucketName string) (string, bool) {
+	u, err := url.Parse(destination)
+	if err != nil || u.Scheme != "s3" || u.Host != bucketName {
+		return "", false
+	}
+	prefix := strings.Trim(u.Path, "/")
+	return prefix, prefix != ""
+}
+
+// expiredBackups returns the backups expired under the retention policies. The policy of the database cluster
+// overrides the policy of the backup storage and the copies are counted per database cluster and backup storage.
+// The most recent successful backup, the in-progress backups and the protected backups are never expired.
+func expiredBackups(
+	backups []everestv1alpha1.DatabaseClusterBackup,
+	dbLimits, storageLimits map[string]model.RetentionLimits,
+	complianceAge time.Duration, now time.Time,
+) []expiredBackup {
+	groups := make(map[string][]*everestv1alpha1.DatabaseClusterBackup)
+	for i := range backups {
+		b := &backups[i]
+		key := b.Spec.DBClusterName + "/" + b.Spec.BackupStorageName
+		groups[key] = append(groups[key], b)
+	}
+
+	var expired []expiredBackup
+	for _, group := range groups {
+		dbName, storageName := group[0].Spec.DBClusterName, group[0].Spec.BackupStorageName
+		limits, ok := dbLimits[dbName]
+		source := "database cluster " + dbName
+		if !ok {
+			if limits, ok = storageLimits[storageName]; !ok {
+				continue
+			}
+			source = "backup storage " + storageName
+		}
+
+		sort.SliceStable(group, func(i, j int) bool {
+			return backupCreatedAt(group[i]).After(backupCreatedAt(group[j]))
+		})
+		var copies int
+		for _, b := range group {
+			state := strings.ToLower(string(b.Status.State))
+			_, succeeded := successfulBackupStates[state]
+			_, failed := failedBackupStates[state]
+			if !succeeded && !failed {
+				continue
+			}
+			if succeeded {
+				copies++
+				if copies == 1 {
+					continue
+				}
+			}
+
+			var reason string
+			switch age := now.Sub(backupCreatedAt(b)); {
+			case limits.MaxAgeDays > 0 && age > limits.MaxAge():
+				reason = fmt.Sprintf("older than %d days", limits.MaxAgeDays)
+			case succeeded && limits.MaxCopies > 0 && copies > limits.MaxCopies:
+				reason = fmt.Sprintf("beyond the %d most recent copies", limits.MaxCopies)
+			default:
+				continue
+			}
+			if backupProtection(b, complianceAge, now) != "" {
+				continue
+			}
+			expired = append(expired, expiredBackup{
+				backup: b,
+				reason: fmt.Sprintf("%s under the retention policy of %s", reason, source),
+			})
+		}
+	}
+
+	sort.Slice(expired, func(i, j int) bool { return expired[i].backup.Name < expired[j].backup.Name })
+	return expired
+}
+
+func backupCreatedAt(b *everestv1alpha1.DatabaseClusterBackup) time.Time {
+	if b.Status.CreatedAt != nil {
+		return b.Status.CreatedAt.Time
+	}
+	return b.CreationTimestamp.Time
+}
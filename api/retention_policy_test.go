@@ -0,0 +1,145 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestExpiredBackups(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	backup := func(name, db, storage string, age time.Duration, state everestv1alpha1.BackupState) everestv1alpha1.DatabaseClusterBackup {
+		return everestv1alpha1.DatabaseClusterBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(now.Add(-age))},
+			Spec:       everestv1alpha1.DatabaseClusterBackupSpec{DBClusterName: db, BackupStorageName: storage},
+			Status:     everestv1alpha1.DatabaseClusterBackupStatus{State: state},
+		}
+	}
+	names := func(expired []expiredBackup) []string {
+		res := []string{}
+		for _, e := range expired {
+			res = append(res, e.backup.Name)
+		}
+		return res
+	}
+
+	cases := []struct {
+		name          string
+		backups       []everestv1alpha1.DatabaseClusterBackup
+		dbLimits      map[string]model.RetentionLimits
+		storageLimits map[string]model.RetentionLimits
+		complianceAge time.Duration
+		expired       []string
+	}{
+		{
+			name: "no policy",
+			backups: []everestv1alpha1.DatabaseClusterBackup{
+				backup("b1", "db1", "s3", 100*day, "Succeeded"),
+				backup("b2", "db1", "s3", 50*day, "Succeeded"),
+			},
+			expired: []string{},
+		},
+		{
+			name: "max age keeps the most recent successful backup",
+			backups: []everestv1alpha1.DatabaseClusterBackup{
+				backup("b1", "db1", "s3", 100*day, "Succeeded"),
+				backup("b2", "db1", "s3", 50*day, "Succeeded"),
+				backup("b3", "db1", "s3", 40*day, "Failed"),
+				backup("b4", "db1", "s3", 40*day, "Running"),
+			},
+			storageLimits: map[string]model.RetentionLimits{"s3": {MaxAgeDays: 30}},
+			expired:       []string{"b1", "b3"},
+		},
+		{
+			name: "max copies counts successful backups only",
+			backups: []everestv1alpha1.DatabaseClusterBackup{
+				backup("b1", "db1", "s3", 4*day, "Succeeded"),
+				backup("b2", "db1", "s3", 3*day, "Failed"),
+				backup("b3", "db1", "s3", 2*day, "Succeeded"),
+				backup("b4", "db1", "s3", 1*day, "Succeeded"),
+			},
+			storageLimits: map[string]model.RetentionLimits{"s3": {MaxCopies: 2}},
+			expired:       []string{"b1"},
+		},
+		{
+			name: "database cluster policy overrides the storage one",
+			backups: []everestv1alpha1.DatabaseClusterBackup{
+				backup("b1", "db1", "s3", 3*day, "Succeeded"),
+				backup("b2", "db1", "s3", 2*day, "Succeeded"),
+				backup("b3", "db2", "s3", 3*day, "Succeeded"),
+				backup("b4", "db2", "s3", 2*day, "Succeeded"),
+			},
+			dbLimits:      map[string]model.RetentionLimits{"db1": {MaxCopies: 5}},
+			storageLimits: map[string]model.RetentionLimits{"s3": {MaxCopies: 1}},
+			expired:       []string{"b3"},
+		},
+		{
+			name: "protected backups are kept",
+			backups: []everestv1alpha1.DatabaseClusterBackup{
+				backup("b1", "db1", "s3", 3*day, "Succeeded"),
+				backup("b2", "db1", "s3", 2*day, "Succeeded"),
+				backup("b3", "db1", "s3", 1*day, "Succeeded"),
+			},
+			storageLimits: map[string]model.RetentionLimits{"s3": {MaxCopies: 1}},
+			complianceAge: 60 * time.Hour,
+			expired:       []string{"b1"},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			expired := expiredBackups(tc.backups, tc.dbLimits, tc.storageLimits, tc.complianceAge, now)
+			assert.Equal(t, tc.expired, names(expired))
+		})
+	}
+}
+
+func TestBackupDestinationPrefix(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		destination string
+		prefix      string
+		ok          bool
+	}{
+		{destination: "s3://bucket/db1/db1-2023-09-25-10:00:00-full", prefix: "db1/db1-2023-09-25-10:00:00-full", ok: true},
+		{destination: "s3://bucket/2023-09-25T10:00:00Z", prefix: "2023-09-25T10:00:00Z", ok: true},
+		{destination: "s3://other/db1/backup"},
+		{destination: "s3://bucket/"},
+		{destination: "s3://bucket"},
+		{destination: "gs://bucket/db1/backup"},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.destination, func(t *testing.T) {
+			t.Parallel()
+			prefix, ok := backupDestinationPrefix(tc.destination, "bucket")
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.prefix, prefix)
+		})
+	}
+}
@@ -0,0 +1,341 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// backupScheduleTimeZonesAnnotation keeps the schedules of a database cluster given in a time zone
+// by the schedule name so that they are converted to UTC again once the UTC offset changes.
+const backupScheduleTimeZonesAnnotation = "everest.percona.com/backup-schedule-timezones"
+
+var (
+	errInvalidCronSchedule    = errors.New("the schedule shall be a cron expression of 5 fields")
+	errScheduleNotConvertible = errors.New("the schedule cannot be converted to UTC, use UTC instead of the time zone")
+)
+
+// zonedSchedule is a backup schedule in a time zone.
+type zonedSchedule struct {
+	TimeZone string `json:"timeZone"`
+	Schedule string `json:"schedule"`
+}
+
+// validateTimeZone checks the time zone is a known IANA time zone.
+func validateTimeZone(tz string) error {
+	if _, err := time.LoadLocation(tz); err != nil {
+		return fmt.Errorf("unknown time zone '%s'", tz)
+	}
+	return nil
+}
+
+// convertScheduleTimeZones converts the backup schedules of the database cluster in the request body
+// given in a time zone to UTC and keeps the original ones in the backupScheduleTimeZonesAnnotation.
+func convertScheduleTimeZones(ctx echo.Context) error {
+	req := ctx.Request()
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return errors.Join(err, errors.New("could not decode body"))
+	}
+	changed, err := applyScheduleTimeZones(obj, time.Now())
+	if err != nil || !changed {
+		return err
+	}
+
+	return replaceRequestBody(req, obj)
+}
+
+// applyScheduleTimeZones converts the schedules of the unstructured database cluster with a time zone to UTC.
+// A schedule without a time zone stays in the annotation only if it was not changed since it was converted.
+// It returns true if the database cluster was changed.
+func applyScheduleTimeZones(obj map[string]interface{}, now time.Time) (bool, error) {
+	spec, _ := obj["spec"].(map[string]interface{})
+	backup, _ := spec["backup"].(map[string]interface{})
+	schedules, _ := backup["schedules"].([]interface{})
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+	existing := parseZonedSchedules(annotations)
+
+	zoned := make(map[string]zonedSchedule)
+	changed := false
+	for _, s := range schedules {
+		schedule, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := schedule["name"].(string)
+		cron, _ := schedule["schedule"].(string)
+		tz, _ := schedule["timeZone"].(string)
+		if _, ok := schedule["timeZone"]; ok {
+			delete(schedule, "timeZone")
+			changed = true
+		}
+
+		if tz == "" {
+			prev, ok := existing[name]
+			if !ok {
+				continue
+			}
+			if utc, err := zonedScheduleToUTC(prev, now); err == nil && utc == cron {
+				zoned[name] = prev
+			}
+			continue
+		}
+
+		z := zonedSchedule{TimeZone: tz, Schedule: cron}
+		utc, err := zonedScheduleToUTC(z, now)
+		if err != nil {
+			return false, fmt.Errorf("backup schedule '%s': %w", name, err)
+		}
+		schedule["schedule"] = utc
+		zoned[name] = z
+		changed = true
+	}
+
+	if len(zoned) == len(existing) && !changed {
+		return false, nil
+	}
+	if len(zoned) == 0 {
+		delete(annotations, backupScheduleTimeZonesAnnotation)
+		return true, nil
+	}
+	v, err := json.Marshal(zoned)
+	if err != nil {
+		return false, err
+	}
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+		obj["metadata"] = metadata
+	}
+	if annotations == nil {
+		annotations = make(map[string]interface{})
+		metadata["annotations"] = annotations
+	}
+	annotations[backupScheduleTimeZonesAnnotation] = string(v)
+	return true, nil
+}
+
+func parseZonedSchedules(annotations map[string]interface{}) map[string]zonedSchedule {
+	res := make(map[string]zonedSchedule)
+	v, _ := annotations[backupScheduleTimeZonesAnnotation].(string)
+	if v == "" {
+		return res
+	}
+	if err := json.Unmarshal([]byte(v), &res); err != nil {
+		return make(map[string]zonedSchedule)
+	}
+	return res
+}
+
+func zonedScheduleToUTC(z zonedSchedule, now time.Time) (string, error) {
+	loc, err := time.LoadLocation(z.TimeZone)
+	if err != nil {
+		return "", fmt.Errorf("unknown time zone '%s'", z.TimeZone)
+	}
+	return cronToUTC(z.Schedule, loc, now)
+}
+
+// cronToUTC converts a cron schedule in the location to UTC with the UTC offset the location has at the time.
+// Only the schedules with fixed minutes and hours can be shifted by an offset which is not whole hours,
+// and the schedules shifted to another day may only be restricted by the day of the week.
+func cronToUTC(schedule string, loc *time.Location, at time.Time) (string, error) {
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return "", errInvalidCronSchedule
+	}
+	_, offset := at.In(loc).Zone()
+	offset /= 60
+	if offset == 0 {
+		return strings.Join(fields, " "), nil
+	}
+
+	minuteShift := -(offset % 60)
+	minutes, hourCarry, err := shiftCronField(fields[0], minuteShift, 60)
+	if err != nil {
+		return "", err
+	}
+	if minutes == "*" && minuteShift != 0 && fields[1] != "*" {
+		return "", errScheduleNotConvertible
+	}
+	hours, dayCarry, err := shiftCronField(fields[1], -(offset/60)+hourCarry, 24)
+	if err != nil {
+		return "", err
+	}
+	fields[0], fields[1] = minutes, hours
+
+	if dayCarry != 0 && (fields[2] != "*" || fields[4] != "*") {
+		if fields[2] != "*" {
+			return "", errScheduleNotConvertible
+		}
+		fields[4], _, err = shiftCronField(fields[4], dayCarry, 7)
+		if err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// shiftCronField shifts the values of a cron field by the shift wrapping them around the modulo.
+// It returns the shifted field and the carry to the next field, which shall be the same for all values.
+func shiftCronField(field string, shift, modulo int) (string, int, error) {
+	if field == "*" || shift == 0 {
+		return field, 0, nil
+	}
+
+	items := strings.Split(field, ",")
+	carry := 0
+	for i, item := range items {
+		bounds := strings.Split(item, "-")
+		if len(bounds) > 2 {
+			return "", 0, errScheduleNotConvertible
+		}
+		for j, bound := range bounds {
+			v, err := strconv.Atoi(bound)
+			if err != nil {
+				return "", 0, errScheduleNotConvertible
+			}
+			v += shift
+			c := 0
+			for v < 0 {
+				v += modulo
+				c--
+			}
+			for v >= modulo {
+				v -= modulo
+				c++
+			}
+			if i == 0 && j == 0 {
+				carry = c
+			} else if c != carry {
+				return "", 0, errScheduleNotConvertible
+			}
+			bounds[j] = strconv.Itoa(v)
+		}
+		items[i] = strings.Join(bounds, "-")
+	}
+	return strings.Join(items, ","), carry, nil
+}
+
+// runScheduleTimeZoneSyncer periodically converts the backup schedules given in a time zone to UTC again
+// so that they follow the daylight saving time changes until the context is canceled.
+func (e *EverestServer) runScheduleTimeZoneSyncer(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.ScheduleTimeZoneSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// The standby instance leaves it to the primary one.
+			if !e.isStandby() {
+				e.syncScheduleTimeZones(ctx)
+			}
+		}
+	}
+}
+
+func (e *EverestServer) syncScheduleTimeZones(ctx context.Context) {
+	ks, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list Kubernetes clusters")))
+		return
+	}
+
+	for _, k := range ks {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := e.syncClusterScheduleTimeZones(ctx, k); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not sync backup schedule time zones on Kubernetes cluster %s", k.ID)))
+		}
+	}
+}
+
+func (e *EverestServer) syncClusterScheduleTimeZones(ctx context.Context, k model.KubernetesCluster) error {
+	kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+	if err != nil {
+		return err
+	}
+	dbs, err := kubeClient.ListDatabaseClusters(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i := range dbs.Items {
+		db := &dbs.Items[i]
+		if !rezoneSchedules(db, now) {
+			continue
+		}
+		if _, err := kubeClient.UpdateDatabaseCluster(ctx, db); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not update backup schedules of database cluster %s", db.Name)))
+		}
+	}
+	return nil
+}
+
+// rezoneSchedules converts the backup schedules of the database cluster kept in the
+// backupScheduleTimeZonesAnnotation to UTC. It returns true if a schedule was changed.
+func rezoneSchedules(db *everestv1alpha1.DatabaseCluster, now time.Time) bool {
+	v := db.Annotations[backupScheduleTimeZonesAnnotation]
+	if v == "" {
+		return false
+	}
+	zoned := make(map[string]zonedSchedule)
+	if err := json.Unmarshal([]byte(v), &zoned); err != nil {
+		return false
+	}
+
+	changed := false
+	for i, s := range db.Spec.Backup.Schedules {
+		z, ok := zoned[s.Name]
+		if !ok {
+			continue
+		}
+		utc, err := zonedScheduleToUTC(z, now)
+		if err != nil || utc == s.Schedule {
+			continue
+		}
+		db.Spec.Backup.Schedules[i].Schedule = utc
+		changed = true
+	}
+	return changed
+}
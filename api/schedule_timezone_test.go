@@ -0,0 +1,119 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronToUTC(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	winter := time.Date(2023, time.January, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2023, time.July, 15, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		schedule string
+		loc      *time.Location
+		at       time.Time
+		expected string
+		err      error
+	}{
+		{name: "winter", schedule: "0 2 * * *", loc: berlin, at: winter, expected: "0 1 * * *"},
+		{name: "summer", schedule: "0 2 * * *", loc: berlin, at: summer, expected: "0 0 * * *"},
+		{name: "utc", schedule: "0 2 * * *", loc: time.UTC, at: winter, expected: "0 2 * * *"},
+		{name: "half hour offset", schedule: "15 2 * * *", loc: kolkata, at: winter, expected: "45 20 * * *"},
+		{name: "list and range", schedule: "0 9-17,20 * * *", loc: berlin, at: winter, expected: "0 8-16,19 * * *"},
+		{name: "previous day of week", schedule: "30 0 * * 1,7", loc: berlin, at: winter, expected: "30 23 * * 0,6"},
+		{name: "next day of week", schedule: "0 22 * * 5", loc: newYork, at: winter, expected: "0 3 * * 6"},
+		{name: "every minute", schedule: "* * * * *", loc: kolkata, at: winter, expected: "* * * * *"},
+		{name: "day of month shifted", schedule: "0 0 1 * *", loc: berlin, at: winter, err: errScheduleNotConvertible},
+		{name: "wrapping range", schedule: "0 0-2 * * *", loc: berlin, at: winter, err: errScheduleNotConvertible},
+		{name: "steps", schedule: "0 */6 * * *", loc: berlin, at: winter, err: errScheduleNotConvertible},
+		{name: "invalid", schedule: "0 2 * *", loc: berlin, at: winter, err: errInvalidCronSchedule},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := cronToUTC(tc.schedule, tc.loc, tc.at)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestApplyScheduleTimeZones(t *testing.T) {
+	t.Parallel()
+
+	winter := time.Date(2023, time.January, 15, 12, 0, 0, 0, time.UTC)
+	obj := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"backup": map[string]interface{}{
+				"schedules": []interface{}{
+					map[string]interface{}{"name": "daily", "schedule": "0 2 * * *", "timeZone": "Europe/Berlin"},
+					map[string]interface{}{"name": "hourly", "schedule": "0 * * * *"},
+				},
+			},
+		},
+	}
+
+	changed, err := applyScheduleTimeZones(obj, winter)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	schedules := obj["spec"].(map[string]interface{})["backup"].(map[string]interface{})["schedules"].([]interface{}) //nolint:forcetypeassert
+	assert.Equal(t, map[string]interface{}{"name": "daily", "schedule": "0 1 * * *"}, schedules[0])
+	annotations := obj["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})                                                //nolint:forcetypeassert
+	assert.JSONEq(t, `{"daily": {"timeZone": "Europe/Berlin", "schedule": "0 2 * * *"}}`, annotations[backupScheduleTimeZonesAnnotation].(string)) //nolint:forcetypeassert
+
+	// The converted schedule sent back unchanged keeps its time zone.
+	changed, err = applyScheduleTimeZones(obj, winter)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	// The schedule changed without a time zone is in UTC.
+	schedules[0].(map[string]interface{})["schedule"] = "0 3 * * *" //nolint:forcetypeassert
+	changed, err = applyScheduleTimeZones(obj, winter)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.NotContains(t, annotations, backupScheduleTimeZonesAnnotation)
+
+	_, err = applyScheduleTimeZones(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"backup": map[string]interface{}{
+				"schedules": []interface{}{
+					map[string]interface{}{"name": "daily", "schedule": "0 2 * * *", "timeZone": "Mars/Olympus"},
+				},
+			},
+		},
+	}, winter)
+	assert.Error(t, err)
+}
@@ -0,0 +1,280 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// searchResource is the resource the database clusters are cached under for the search.
+const searchResource = "search"
+
+// Fields of the resources the search matches.
+const (
+	searchFieldName               = "name"
+	searchFieldDescription        = "description"
+	searchFieldBucketName         = "bucketName"
+	searchFieldURL                = "url"
+	searchFieldLabels             = "labels"
+	searchFieldBackupStorage      = "backupStorage"
+	searchFieldMonitoringInstance = "monitoringInstance"
+)
+
+// searchedDatabaseCluster is the part of a database cluster the search matches.
+type searchedDatabaseCluster struct {
+	name               string
+	backupStorages     []string
+	monitoringInstance string
+}
+
+// searchQuery matches the fields of the resources against a case-insensitive substring.
+type searchQuery struct {
+	text string
+	kind string
+}
+
+func (q searchQuery) includes(kind SearchResultKind) bool {
+	return q.kind == "" || q.kind == string(kind)
+}
+
+// match returns the names of the fields containing the text. The values of a field are given in a slice.
+func (q searchQuery) match(fields map[string][]string) []string {
+	var matches []string
+	for field, values := range fields {
+		for _, v := range values {
+			if strings.Contains(strings.ToLower(v), q.text) {
+				matches = append(matches, field)
+				break
+			}
+		}
+	}
+	sort.Strings(matches)
+	return matches
+}
+
+// Search searches the Everest resources by their names and descriptions.
+func (e *EverestServer) Search(ctx echo.Context, params SearchParams) error {
+	q := searchQuery{text: strings.ToLower(strings.TrimSpace(params.Q)), kind: pointer.GetString(params.Kind)}
+	if q.text == "" {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("q cannot be empty")})
+	}
+	switch SearchResultKind(q.kind) {
+	case "", SearchResultKindDatabaseCluster, SearchResultKindBackupStorage,
+		SearchResultKindMonitoringInstance, SearchResultKindKubernetesCluster:
+	default:
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("kind shall be one of %s, %s, %s or %s", SearchResultKindDatabaseCluster,
+				SearchResultKindBackupStorage, SearchResultKindMonitoringInstance, SearchResultKindKubernetesCluster)),
+		})
+	}
+
+	res, err := e.search(ctx.Request().Context(), q)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not search")})
+	}
+	setPartialResultHeaders(ctx, res.UnreachableClusters)
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func (e *EverestServer) search(ctx context.Context, q searchQuery) (*SearchResults, error) {
+	res := &SearchResults{
+		Results:             []SearchResult{},
+		UnreachableClusters: []UnreachableCluster{},
+	}
+
+	if q.includes(SearchResultKindBackupStorage) {
+		storages, _, err := e.storage.ListBackupStorages(ctx, model.ListBackupStoragesParams{})
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not list backup storages"))
+		}
+		for _, bs := range storages {
+			matches := q.match(map[string][]string{
+				searchFieldName:        {bs.Name},
+				searchFieldDescription: {bs.Description},
+				searchFieldBucketName:  {bs.BucketName},
+			})
+			if len(matches) != 0 {
+				res.Results = append(res.Results, SearchResult{
+					Kind:        SearchResultKindBackupStorage,
+					Name:        bs.Name,
+					Description: pointer.ToStringOrNil(bs.Description),
+					Matches:     matches,
+				})
+			}
+		}
+	}
+
+	if q.includes(SearchResultKindMonitoringInstance) {
+		instances, _, err := e.storage.ListMonitoringInstances(ctx, model.ListMonitoringInstancesParams{})
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not list monitoring instances"))
+		}
+		for _, i := range instances {
+			matches := q.match(map[string][]string{
+				searchFieldName: {i.Name},
+				searchFieldURL:  {i.URL},
+			})
+			if len(matches) != 0 {
+				res.Results = append(res.Results, SearchResult{
+					Kind:    SearchResultKindMonitoringInstance,
+					Name:    i.Name,
+					Matches: matches,
+				})
+			}
+		}
+	}
+
+	if q.includes(SearchResultKindKubernetesCluster) || q.includes(SearchResultKindDatabaseCluster) {
+		clusters, err := e.storage.ListKubernetesClusters(ctx)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not list Kubernetes clusters"))
+		}
+		for i := range clusters {
+			k := &clusters[i]
+			if q.includes(SearchResultKindKubernetesCluster) {
+				e.searchKubernetesCluster(q, k, res)
+			}
+			if q.includes(SearchResultKindDatabaseCluster) {
+				e.searchDatabaseClusters(ctx, q, k, res)
+			}
+		}
+	}
+
+	res.Partial = len(res.UnreachableClusters) != 0
+	sortSearchResults(res.Results)
+	return res, nil
+}
+
+func (e *EverestServer) searchKubernetesCluster(q searchQuery, k *model.KubernetesCluster, res *SearchResults) {
+	labels, err := kubernetesClusterLabels(k)
+	if err != nil {
+		e.l.Error(err)
+	}
+	values := make([]string, 0, len(labels))
+	for key, value := range labels {
+		values = append(values, key+"="+value)
+	}
+	matches := q.match(map[string][]string{
+		searchFieldName:   {k.Name},
+		searchFieldLabels: values,
+	})
+	if len(matches) != 0 {
+		res.Results = append(res.Results, SearchResult{
+			Kind:         SearchResultKindKubernetesCluster,
+			Name:         k.Name,
+			KubernetesId: &k.ID,
+			Matches:      matches,
+		})
+	}
+}
+
+// searchDatabaseClusters searches the database clusters of the kubernetes cluster. They are fetched
+// only once the cached ones are older than the search cache TTL.
+func (e *EverestServer) searchDatabaseClusters(ctx context.Context, q searchQuery, k *model.KubernetesCluster, res *SearchResults) {
+	var dbs interface{}
+	if cached, ok := e.clusterStates.load(searchResource, k.ID); ok && time.Since(cached.fetchedAt) < e.config.SearchCacheTTL {
+		dbs = cached.value
+	} else {
+		var unreachable *UnreachableCluster
+		dbs, unreachable = e.clusterState(ctx, *k, searchResource, func(ctx context.Context) (interface{}, error) {
+			return e.searchedDatabaseClusters(ctx, k)
+		})
+		if unreachable != nil {
+			res.UnreachableClusters = append(res.UnreachableClusters, *unreachable)
+		}
+	}
+	if dbs == nil {
+		return
+	}
+
+	for _, db := range dbs.([]searchedDatabaseCluster) {
+		matches := q.match(map[string][]string{
+			searchFieldName:               {db.name},
+			searchFieldBackupStorage:      db.backupStorages,
+			searchFieldMonitoringInstance: {db.monitoringInstance},
+		})
+		if len(matches) != 0 {
+			res.Results = append(res.Results, SearchResult{
+				Kind:           SearchResultKindDatabaseCluster,
+				Name:           db.name,
+				KubernetesId:   &k.ID,
+				KubernetesName: &k.Name,
+				Matches:        matches,
+			})
+		}
+	}
+}
+
+func (e *EverestServer) searchedDatabaseClusters(ctx context.Context, k *model.KubernetesCluster) ([]searchedDatabaseCluster, error) {
+	kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+	if err != nil {
+		return nil, err
+	}
+	list, err := kubeClient.ListDatabaseClusters(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list database clusters"))
+	}
+
+	dbs := make([]searchedDatabaseCluster, 0, len(list.Items))
+	for i := range list.Items {
+		db := &list.Items[i]
+		searched := searchedDatabaseCluster{name: db.Name}
+		for name := range kubernetes.BackupStorageNamesFromDBCluster(db) {
+			searched.backupStorages = append(searched.backupStorages, name)
+		}
+		sort.Strings(searched.backupStorages)
+		if db.Spec.Monitoring != nil {
+			searched.monitoringInstance = db.Spec.Monitoring.MonitoringConfigName
+		}
+		dbs = append(dbs, searched)
+	}
+	return dbs, nil
+}
+
+// sortSearchResults puts the resources matching the name first. The others are sorted by kind and name.
+func sortSearchResults(results []SearchResult) {
+	matchesName := func(r SearchResult) bool {
+		for _, m := range r.Matches {
+			if m == searchFieldName {
+				return true
+			}
+		}
+		return false
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if ni, nj := matchesName(results[i]), matchesName(results[j]); ni != nj {
+			return ni
+		}
+		if results[i].Kind != results[j].Kind {
+			return results[i].Kind < results[j].Kind
+		}
+		return results[i].Name < results[j].Name
+	})
+}
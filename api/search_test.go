@@ -0,0 +1,95 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+	"github.com/percona/percona-everest-backend/model"
+)
+
+type searchStorage struct {
+	storage
+}
+
+func (searchStorage) ListBackupStorages(context.Context, model.ListBackupStoragesParams) ([]model.BackupStorage, int, error) {
+	return []model.BackupStorage{
+		{Name: "minio-eu", Description: "MinIO in Frankfurt", BucketName: "backups"},
+		{Name: "s3-us", BucketName: "minio-mirror"},
+	}, 2, nil
+}
+
+func (searchStorage) ListMonitoringInstances(context.Context, model.ListMonitoringInstancesParams) ([]model.MonitoringInstance, int, error) {
+	return []model.MonitoringInstance{{Name: "pmm", URL: "https://pmm.example.com"}}, 1, nil
+}
+
+func (searchStorage) ListKubernetesClusters(context.Context) ([]model.KubernetesCluster, error) {
+	return []model.KubernetesCluster{{ID: "k8s", Name: "prod", Labels: `{"region":"eu-minio"}`}}, nil
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	e := &EverestServer{
+		config:        &config.EverestConfig{SearchCacheTTL: time.Minute},
+		l:             zap.NewNop().Sugar(),
+		storage:       searchStorage{},
+		clusterStates: newClusterStateCache(),
+	}
+	e.clusterStates.store(searchResource, "k8s", []searchedDatabaseCluster{
+		{name: "orders", backupStorages: []string{"minio-eu"}, monitoringInstance: "pmm"},
+		{name: "users", backupStorages: []string{"s3-us"}},
+	}, time.Now())
+
+	type result struct {
+		kind    SearchResultKind
+		name    string
+		matches []string
+	}
+	search := func(q searchQuery) []result {
+		t.Helper()
+		res, err := e.search(context.Background(), q)
+		require.NoError(t, err)
+		assert.False(t, res.Partial)
+		results := make([]result, 0, len(res.Results))
+		for _, r := range res.Results {
+			results = append(results, result{kind: r.Kind, name: r.Name, matches: r.Matches})
+		}
+		return results
+	}
+
+	assert.Equal(t, []result{
+		{kind: SearchResultKindBackupStorage, name: "minio-eu", matches: []string{"description", "name"}},
+		{kind: SearchResultKindBackupStorage, name: "s3-us", matches: []string{"bucketName"}},
+		{kind: SearchResultKindDatabaseCluster, name: "orders", matches: []string{"backupStorage"}},
+		{kind: SearchResultKindKubernetesCluster, name: "prod", matches: []string{"labels"}},
+	}, search(searchQuery{text: "minio"}))
+	assert.Equal(t, []result{
+		{kind: SearchResultKindDatabaseCluster, name: "orders", matches: []string{"backupStorage"}},
+	}, search(searchQuery{text: "minio-eu", kind: string(SearchResultKindDatabaseCluster)}))
+	assert.Equal(t, []result{
+		{kind: SearchResultKindMonitoringInstance, name: "pmm", matches: []string{"name", "url"}},
+		{kind: SearchResultKindDatabaseCluster, name: "orders", matches: []string{"monitoringInstance"}},
+	}, search(searchQuery{text: "pmm"}))
+	assert.Empty(t, search(searchQuery{text: "missing"}))
+}
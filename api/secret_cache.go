@@ -0,0 +1,152 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+)
+
+type secretCacheKey struct{}
+
+// secretCache keeps the secrets fetched while handling a single request so that the secrets
+// referenced several times, e.g. the kubeconfig or the credentials of the backup storages
+// propagated to a Kubernetes cluster, are fetched from the secrets storage only once.
+type secretCache struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func (c *secretCache) get(id string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.values[id]
+	return v, ok
+}
+
+func (c *secretCache) set(id, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[id] = value
+}
+
+func (c *secretCache) delete(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, id)
+}
+
+func withSecretCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, secretCacheKey{}, &secretCache{values: make(map[string]string)})
+}
+
+func secretCacheFrom(ctx context.Context) *secretCache {
+	c, _ := ctx.Value(secretCacheKey{}).(*secretCache)
+	return c
+}
+
+// requestSecretCache attaches a secret cache to the context of the request.
+func (e *EverestServer) requestSecretCache(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		ctx.SetRequest(ctx.Request().WithContext(withSecretCache(ctx.Request().Context())))
+		return next(ctx)
+	}
+}
+
+// cachingSecretsStorage serves the secrets from the cache of the request the context belongs to.
+// The calls made outside of a request, e.g. by the background jobs, always reach the secrets storage.
+type cachingSecretsStorage struct {
+	secretsStorage
+}
+
+// CreateSecret creates a secret.
+func (s *cachingSecretsStorage) CreateSecret(ctx context.Context, id, value string) error {
+	if err := s.secretsStorage.CreateSecret(ctx, id, value); err != nil {
+		return err
+	}
+	if c := secretCacheFrom(ctx); c != nil {
+		c.set(id, value)
+	}
+	return nil
+}
+
+// GetSecret returns the secret by its id.
+func (s *cachingSecretsStorage) GetSecret(ctx context.Context, id string) (string, error) {
+	c := secretCacheFrom(ctx)
+	if c == nil {
+		return s.secretsStorage.GetSecret(ctx, id)
+	}
+	if v, ok := c.get(id); ok {
+		return v, nil
+	}
+
+	v, err := s.secretsStorage.GetSecret(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	c.set(id, v)
+	return v, nil
+}
+
+// GetSecrets returns the secrets by their ids. Only the secrets missing in the cache are fetched.
+func (s *cachingSecretsStorage) GetSecrets(ctx context.Context, ids []string) (map[string]string, error) {
+	c := secretCacheFrom(ctx)
+	if c == nil {
+		return s.secretsStorage.GetSecrets(ctx, ids)
+	}
+
+	res := make(map[string]string, len(ids))
+	var missing []string
+	for _, id := range ids {
+		if v, ok := c.get(id); ok {
+			res[id] = v
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return res, nil
+	}
+
+	fetched, err := s.secretsStorage.GetSecrets(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for id, v := range fetched {
+		c.set(id, v)
+		res[id] = v
+	}
+	return res, nil
+}
+
+// UpdateSecret updates the secret by its id.
+func (s *cachingSecretsStorage) UpdateSecret(ctx context.Context, id, value string) error {
+	if c := secretCacheFrom(ctx); c != nil {
+		c.delete(id)
+	}
+	return s.secretsStorage.UpdateSecret(ctx, id, value)
+}
+
+// DeleteSecret deletes the secret by its id. Returns the deleted secret.
+func (s *cachingSecretsStorage) DeleteSecret(ctx context.Context, id string) (string, error) {
+	if c := secretCacheFrom(ctx); c != nil {
+		c.delete(id)
+	}
+	return s.secretsStorage.DeleteSecret(ctx, id)
+}
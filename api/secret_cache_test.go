@@ -0,0 +1,84 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingSecretsStorage counts the secrets fetched from it.
+type countingSecretsStorage struct {
+	secretsStorage
+	values  map[string]string
+	fetched int
+}
+
+func (s *countingSecretsStorage) GetSecret(_ context.Context, id string) (string, error) {
+	s.fetched++
+	return s.values[id], nil
+}
+
+func (s *countingSecretsStorage) GetSecrets(_ context.Context, ids []string) (map[string]string, error) {
+	res := make(map[string]string, len(ids))
+	for _, id := range ids {
+		s.fetched++
+		res[id] = s.values[id]
+	}
+	return res, nil
+}
+
+func (s *countingSecretsStorage) UpdateSecret(_ context.Context, id, value string) error {
+	s.values[id] = value
+	return nil
+}
+
+func TestCachingSecretsStorage(t *testing.T) {
+	t.Parallel()
+
+	backend := &countingSecretsStorage{values: map[string]string{"a": "1", "b": "2", "c": "3"}}
+	s := &cachingSecretsStorage{secretsStorage: backend}
+
+	// Outside of a request every call reaches the secrets storage.
+	_, err := s.GetSecret(context.Background(), "a")
+	require.NoError(t, err)
+	_, err = s.GetSecret(context.Background(), "a")
+	require.NoError(t, err)
+	assert.Equal(t, 2, backend.fetched)
+
+	backend.fetched = 0
+	ctx := withSecretCache(context.Background())
+	values, err := s.GetSecrets(ctx, []string{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, values)
+	v, err := s.GetSecret(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "1", v)
+	values, err = s.GetSecrets(ctx, []string{"b", "c"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"b": "2", "c": "3"}, values)
+	assert.Equal(t, 3, backend.fetched)
+
+	// The updated secrets are fetched again.
+	require.NoError(t, s.UpdateSecret(ctx, "a", "4"))
+	v, err = s.GetSecret(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "4", v)
+	assert.Equal(t, 4, backend.fetched)
+}
@@ -0,0 +1,192 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/secrets"
+)
+
+// Secrets backends selectable with the SecretsBackend config option.
+const (
+	// secretsBackendPostgres is the secrets backend storing the secrets in the Everest database.
+	secretsBackendPostgres = "postgres"
+	secretsBackendAWS      = "aws"
+	secretsBackendGCP      = "gcp"
+	// secretsBackendKubernetes is the secrets backend storing the secrets as Kubernetes Secrets.
+	secretsBackendKubernetes = "kubernetes"
+)
+
+// newSecretsStorage returns the secrets storage selected by the config.
+func newSecretsStorage(ctx context.Context, c *config.EverestConfig, db *model.Database) (secretsStorage, error) {
+	switch c.SecretsBackend {
+	case secretsBackendPostgres:
+		keys, err := secretsEncryptionKeys(ctx, c)
+		if err != nil || keys == nil {
+			return db, err
+		}
+		return secrets.NewEncryptedStorage(db, keys, c.SecretsAllowPlaintext), nil
+	case secretsBackendAWS:
+		return secrets.NewAWSStorage(ctx, c.SecretsPrefix)
+	case secretsBackendGCP:
+		return secrets.NewGCPStorage(ctx, c.GCPProject, c.SecretsPrefix)
+	case secretsBackendKubernetes:
+		return secrets.NewKubernetesStorage(c.SecretsNamespace, c.SecretsPrefix)
+	default:
+		return nil, fmt.Errorf("unknown secrets backend '%s'", c.SecretsBackend)
+	}
+}
+
+// secretsEncryptionKeys returns the keys the secrets stored in the Everest database are encrypted with,
+// or nil if the encryption is not configured.
+func secretsEncryptionKeys(ctx context.Context, c *config.EverestConfig) (secrets.KeyWrapper, error) {
+	var keyring secrets.Keyring
+	if c.SecretsKMSKeyID != "" {
+		key, err := secrets.NewAWSKMSKey(ctx, c.SecretsKMSKeyID)
+		if err != nil {
+			return nil, err
+		}
+		keyring = append(keyring, key)
+	}
+	if c.SecretsEncryptionKeys != "" {
+		keys, err := secrets.NewLocalKeys(c.SecretsEncryptionKeys)
+		if err != nil {
+			return nil, err
+		}
+		keyring = append(keyring, keys)
+	}
+	if len(keyring) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	return keyring, nil
+}
+
+// MigrateSecrets copies the secrets stored in the Everest database to the secrets backend selected by the config.
+// The secrets already present in the backend are overwritten. If deleteMigrated is set, the migrated secrets
+// are deleted from the Everest database.
+func MigrateSecrets(ctx context.Context, c *config.EverestConfig, l *zap.SugaredLogger, deleteMigrated bool) error {
+	if c.SecretsBackend == secretsBackendPostgres {
+		return errors.New("the secrets backend shall be set to a different backend than postgres to migrate the secrets to")
+	}
+
+	db, err := newDatabase(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close() //nolint:errcheck
+	// The schema is migrated by the migrate command or by the server only.
+	if err := checkMigrations(db); err != nil {
+		return err
+	}
+
+	target, err := newSecretsStorage(ctx, c, db)
+	if err != nil {
+		return err
+	}
+	defer target.Close() //nolint:errcheck
+	// The secrets stored in the Everest database may be encrypted.
+	keys, err := secretsEncryptionKeys(ctx, c)
+	if err != nil {
+		return err
+	}
+	var source *secrets.EncryptedStorage
+	if keys != nil {
+		source = secrets.NewEncryptedStorage(db, keys, c.SecretsAllowPlaintext)
+	}
+
+	list, err := db.ListSecrets(ctx)
+	if err != nil {
+		return errors.Join(err, errors.New("could not list secrets"))
+	}
+	for _, secret := range list {
+		value := secret.Value
+		if source != nil {
+			if value, err = source.Decrypt(ctx, secret.ID, value); err != nil {
+				return errors.Join(err, fmt.Errorf("could not decrypt secret %s", secret.ID))
+			}
+		}
+		if err := target.UpdateSecret(ctx, secret.ID, value); err != nil {
+			return errors.Join(err, fmt.Errorf("could not migrate secret %s", secret.ID))
+		}
+		if !deleteMigrated {
+			continue
+		}
+		if _, err := db.DeleteSecret(ctx, secret.ID); err != nil {
+			return errors.Join(err, fmt.Errorf("could not delete migrated secret %s", secret.ID))
+		}
+	}
+
+	l.Infof("Migrated %d secrets to the %s secrets backend", len(list), c.SecretsBackend)
+	return nil
+}
+
+// ReencryptSecrets encrypts the secrets stored in the Everest database with the current encryption key.
+// The plaintext secrets and the secrets encrypted with the previous keys are re-encrypted so that
+// the previous keys and SecretsAllowPlaintext may be removed from the configuration afterwards.
+func ReencryptSecrets(ctx context.Context, c *config.EverestConfig, l *zap.SugaredLogger) error {
+	if c.SecretsBackend != secretsBackendPostgres {
+		return errors.New("only the secrets stored by the postgres secrets backend are encrypted")
+	}
+	keys, err := secretsEncryptionKeys(ctx, c)
+	if err != nil {
+		return err
+	}
+	if keys == nil {
+		return errors.New("the secrets encryption keys are not configured")
+	}
+
+	db, err := newDatabase(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close() //nolint:errcheck
+	// The schema is migrated by the migrate command or by the server only.
+	if err := checkMigrations(db); err != nil {
+		return err
+	}
+
+	list, err := db.ListSecrets(ctx)
+	if err != nil {
+		return errors.Join(err, errors.New("could not list secrets"))
+	}
+	// The plaintext secrets are read to be encrypted.
+	encrypted := secrets.NewEncryptedStorage(db, keys, true)
+	var count int
+	for _, secret := range list {
+		if !encrypted.NeedsReencryption(secret.Value) {
+			continue
+		}
+		value, err := encrypted.Decrypt(ctx, secret.ID, secret.Value)
+		if err != nil {
+			return errors.Join(err, fmt.Errorf("could not decrypt secret %s", secret.ID))
+		}
+		if err := encrypted.UpdateSecret(ctx, secret.ID, value); err != nil {
+			return errors.Join(err, fmt.Errorf("could not re-encrypt secret %s", secret.ID))
+		}
+		count++
+	}
+
+	l.Infof("Re-encrypted %d of %d secrets", count, len(list))
+	return nil
+}
@@ -0,0 +1,348 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+const (
+	sessionAccessTokenPrefix  = "evs_"
+	sessionRefreshTokenPrefix = "evr_"
+	sessionTokenLength        = 40
+
+	// oidcTimeout limits the requests to the OIDC identity provider.
+	oidcTimeout = 10 * time.Second
+)
+
+// errInvalidCredentials is returned if the credentials or the authorization code are rejected.
+var errInvalidCredentials = errors.New("invalid credentials")
+
+// sessionUser is the user a session is started for.
+type sessionUser struct {
+	username string
+	groups   []string
+	scope    string
+}
+
+// CreateSession logs a user in with the credentials of the admin user or with an authorization code
+// of the OIDC identity provider.
+func (e *EverestServer) CreateSession(ctx echo.Context) error {
+	var params SessionLogin
+	if err := e.getBodyFromContext(ctx, &params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	var user *sessionUser
+	var err error
+	switch {
+	case params.Code != nil:
+		if e.config.OIDCIssuerURL == "" {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("OIDC login is not configured")})
+		}
+		user, err = e.oidcUser(c, *params.Code, pointer.GetString(params.RedirectUri), pointer.GetString(params.CodeVerifier))
+	case params.Username != nil && params.Password != nil:
+		if e.config.OIDCIssuerURL != "" {
+			return ctx.JSON(http.StatusBadRequest, Error{
+				Message: pointer.ToString("The login with credentials is disabled since an OIDC identity provider is configured"),
+			})
+		}
+		user, err = e.adminUser(c, *params.Username, *params.Password)
+	default:
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Either username and password or code shall be provided"),
+		})
+	}
+	if err != nil {
+		if errors.Is(err, errInvalidCredentials) {
+			return ctx.JSON(http.StatusUnauthorized, Error{Message: pointer.ToString("Invalid credentials")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not log in")})
+	}
+
+	groups, err := json.Marshal(user.groups)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not log in")})
+	}
+	session := &model.Session{
+		ID:       uuid.NewString(),
+		Username: user.username,
+		Groups:   string(groups),
+		Scope:    user.scope,
+	}
+	tokens, err := e.issueSessionTokens(session)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not generate session tokens")})
+	}
+	if err := e.storage.CreateSession(c, session); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not create session")})
+	}
+	// The sessions nobody refreshed anymore are pruned on the way. It does not affect the login.
+	if err := e.storage.DeleteExpiredSessions(c, time.Now().UTC()); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not delete expired sessions")))
+	}
+
+	return ctx.JSON(http.StatusOK, tokens)
+}
+
+// RefreshSession exchanges the refresh token of a session for a new pair of tokens.
+func (e *EverestServer) RefreshSession(ctx echo.Context) error {
+	var params SessionRefresh
+	if err := e.getBodyFromContext(ctx, &params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	session := &model.Session{}
+	tokens, err := e.issueSessionTokens(session)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not generate session tokens")})
+	}
+	if err := e.storage.RotateSessionTokens(ctx.Request().Context(), hashAPIToken(params.RefreshToken), session); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusUnauthorized, Error{Message: pointer.ToString("Invalid or expired refresh token")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not refresh session")})
+	}
+
+	tokens.Username = session.Username
+	return ctx.JSON(http.StatusOK, tokens)
+}
+
+// DeleteSession logs out by revoking the session the request is authenticated with.
+func (e *EverestServer) DeleteSession(ctx echo.Context) error {
+	value, ok := bearerToken(ctx.Request().Header)
+	if !ok || !strings.HasPrefix(value, sessionAccessTokenPrefix) {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("The request is not authenticated with a session")})
+	}
+
+	c := ctx.Request().Context()
+	session, err := e.storage.GetSessionByAccessTokenHash(c, hashAPIToken(value))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusUnauthorized, Error{Message: pointer.ToString("Invalid session")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get session")})
+	}
+	if err := e.storage.RevokeSession(c, session.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusUnauthorized, Error{Message: pointer.ToString("Invalid session")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not revoke session")})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// authenticateSession authenticates a request sent with the access token of a session.
+func (e *EverestServer) authenticateSession(ctx echo.Context, value string) (userIdentity, string, error) {
+	session, err := e.storage.GetSessionByAccessTokenHash(ctx.Request().Context(), hashAPIToken(value))
+	if err != nil {
+		return userIdentity{}, "", err
+	}
+	if !sessionActive(session, time.Now().UTC()) {
+		return userIdentity{}, "", gorm.ErrRecordNotFound
+	}
+
+	id := userIdentity{Username: session.Username, ProjectScoped: session.Scope == model.APITokenScopeProject}
+	if err := json.Unmarshal([]byte(session.Groups), &id.Groups); err != nil {
+		return userIdentity{}, "", errors.Join(err, errors.New("could not decode session groups"))
+	}
+	return id, session.Scope, nil
+}
+
+// sessionActive reports whether the access token of the session is accepted at the time.
+func sessionActive(session *model.Session, now time.Time) bool {
+	return session.RevokedAt == nil && now.Before(session.AccessTokenExpiresAt)
+}
+
+// issueSessionTokens generates a new pair of tokens, stores their hashes and expiration times
+// in the session and returns them.
+func (e *EverestServer) issueSessionTokens(session *model.Session) (Session, error) {
+	access, err := randomString(sessionTokenLength)
+	if err != nil {
+		return Session{}, err
+	}
+	refresh, err := randomString(sessionTokenLength)
+	if err != nil {
+		return Session{}, err
+	}
+
+	now := time.Now().UTC()
+	session.AccessTokenHash = hashAPIToken(sessionAccessTokenPrefix + access)
+	session.AccessTokenExpiresAt = now.Add(e.config.SessionAccessTokenTTL)
+	session.RefreshTokenHash = hashAPIToken(sessionRefreshTokenPrefix + refresh)
+	session.RefreshTokenExpiresAt = now.Add(e.config.SessionRefreshTokenTTL)
+	return Session{
+		Username:              session.Username,
+		AccessToken:           sessionAccessTokenPrefix + access,
+		AccessTokenExpiresAt:  session.AccessTokenExpiresAt,
+		RefreshToken:          sessionRefreshTokenPrefix + refresh,
+		RefreshTokenExpiresAt: session.RefreshTokenExpiresAt,
+	}, nil
+}
+
+// adminUser checks the credentials of the built-in admin user.
+func (e *EverestServer) adminUser(ctx context.Context, username, password string) (*sessionUser, error) {
+	adminUsername, passwordHash, err := e.adminCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if adminUsername == "" || adminUsername != username {
+		return nil, errInvalidCredentials
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password)); err != nil {
+		return nil, errInvalidCredentials
+	}
+	return &sessionUser{username: username, scope: model.APITokenScopeAdmin}, nil
+}
+
+// oidcUser exchanges the authorization code for an access token of the OIDC identity provider
+// and returns the user the token is issued to.
+func (e *EverestServer) oidcUser(ctx context.Context, code, redirectURI, codeVerifier string) (*sessionUser, error) {
+	ctx, cancel := context.WithTimeout(ctx, oidcTimeout)
+	defer cancel()
+
+	var discovery struct {
+		TokenEndpoint    string `json:"token_endpoint"`
+		UserinfoEndpoint string `json:"userinfo_endpoint"`
+	}
+	discoveryURL := strings.TrimSuffix(e.config.OIDCIssuerURL, "/") + "/.well-known/openid-configuration"
+	if err := oidcRequest(ctx, http.MethodGet, discoveryURL, nil, "", &discovery); err != nil {
+		return nil, errors.Join(err, errors.New("could not get the OIDC provider configuration"))
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"client_id":     {e.config.OIDCClientID},
+		"client_secret": {e.config.OIDCClientSecret},
+	}
+	if redirectURI != "" {
+		form.Set("redirect_uri", redirectURI)
+	}
+	if codeVerifier != "" {
+		form.Set("code_verifier", codeVerifier)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := oidcRequest(ctx, http.MethodPost, discovery.TokenEndpoint, form, "", &token); err != nil {
+		// The identity provider rejects the invalid, expired and reused codes with a client error.
+		var statusErr *oidcStatusError
+		if errors.As(err, &statusErr) && statusErr.code < http.StatusInternalServerError {
+			return nil, errInvalidCredentials
+		}
+		return nil, errors.Join(err, errors.New("could not exchange the authorization code"))
+	}
+
+	claims := map[string]any{}
+	if err := oidcRequest(ctx, http.MethodGet, discovery.UserinfoEndpoint, nil, token.AccessToken, &claims); err != nil {
+		return nil, errors.Join(err, errors.New("could not get the OIDC user info"))
+	}
+	return e.oidcClaimsUser(claims)
+}
+
+// oidcClaimsUser returns the user described by the claims of the user info.
+func (e *EverestServer) oidcClaimsUser(claims map[string]any) (*sessionUser, error) {
+	user := &sessionUser{scope: model.APITokenScopeProject}
+	for _, claim := range []string{"preferred_username", "email", "sub"} {
+		if v, ok := claims[claim].(string); ok && v != "" {
+			user.username = v
+			break
+		}
+	}
+	if user.username == "" {
+		return nil, errors.New("the OIDC user info has no username")
+	}
+	if groups, ok := claims[e.config.OIDCGroupsClaim].([]any); ok {
+		for _, g := range groups {
+			if s, ok := g.(string); ok {
+				user.groups = append(user.groups, s)
+			}
+		}
+	}
+	if e.config.OIDCAdminGroup != "" && slices.Contains(user.groups, e.config.OIDCAdminGroup) {
+		user.scope = model.APITokenScopeAdmin
+	}
+	return user, nil
+}
+
+type oidcStatusError struct {
+	code int
+	body string
+}
+
+func (e *oidcStatusError) Error() string {
+	return fmt.Sprintf("OIDC provider returned HTTP status code %d: %s", e.code, e.body)
+}
+
+// oidcRequest sends a request to the OIDC identity provider and decodes the JSON response into out.
+// The form is sent url encoded and the token as a bearer token if they are set.
+func oidcRequest(ctx context.Context, method, endpoint string, form url.Values, token string, out any) error {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		data, _ := io.ReadAll(resp.Body)
+		return &oidcStatusError{code: resp.StatusCode, body: string(data)}
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
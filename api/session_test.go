@@ -0,0 +1,96 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestOIDCUser(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"token_endpoint":    srv.URL + "/token",
+			"userinfo_endpoint": srv.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("code") != "good" || r.PostFormValue("client_secret") != "secret" ||
+			r.PostFormValue("code_verifier") != "verifier" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "at"})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer at" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"sub": "1234", "email": "alice@example.com", "roles": []string{"dba", "everest-admins"},
+		})
+	})
+
+	e := &EverestServer{
+		config: &config.EverestConfig{
+			OIDCIssuerURL:    srv.URL + "/",
+			OIDCClientID:     "everest",
+			OIDCClientSecret: "secret",
+			OIDCGroupsClaim:  "roles",
+		},
+		l: zap.NewNop().Sugar(),
+	}
+
+	user, err := e.oidcUser(context.Background(), "good", "https://everest/callback", "verifier")
+	require.NoError(t, err)
+	assert.Equal(t, &sessionUser{
+		username: "alice@example.com", groups: []string{"dba", "everest-admins"}, scope: model.APITokenScopeProject,
+	}, user)
+
+	e.config.OIDCAdminGroup = "everest-admins"
+	user, err = e.oidcUser(context.Background(), "good", "", "verifier")
+	require.NoError(t, err)
+	assert.Equal(t, model.APITokenScopeAdmin, user.scope)
+
+	_, err = e.oidcUser(context.Background(), "bad", "", "verifier")
+	assert.ErrorIs(t, err, errInvalidCredentials)
+}
+
+func TestSessionActive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	assert.True(t, sessionActive(&model.Session{AccessTokenExpiresAt: now.Add(time.Minute)}, now))
+	assert.False(t, sessionActive(&model.Session{AccessTokenExpiresAt: now.Add(-time.Minute)}, now))
+	assert.False(t, sessionActive(&model.Session{AccessTokenExpiresAt: now.Add(time.Minute), RevokedAt: &now}, now))
+}
@@ -0,0 +1,61 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap/zapcore"
+)
+
+// logLevels are the levels the logger can be switched to at runtime.
+//
+//nolint:gochecknoglobals
+var logLevels = map[string]zapcore.Level{
+	zapcore.DebugLevel.String(): zapcore.DebugLevel,
+	zapcore.InfoLevel.String():  zapcore.InfoLevel,
+	zapcore.WarnLevel.String():  zapcore.WarnLevel,
+}
+
+// GetLogLevel returns the current log level.
+func (e *EverestServer) GetLogLevel(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, LogLevel{Level: e.logLevel.Level().String()})
+}
+
+// SetLogLevel changes the log level at runtime.
+func (e *EverestServer) SetLogLevel(ctx echo.Context) error {
+	var params LogLevel
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	level, ok := logLevels[params.Level]
+	if !ok {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("level shall be one of %s, %s or %s",
+				zapcore.DebugLevel, zapcore.InfoLevel, zapcore.WarnLevel)),
+		})
+	}
+
+	previous := e.logLevel.Level()
+	e.logLevel.SetLevel(level)
+	// Logged at the warn level so that the change is visible at every level.
+	e.l.Warnf("Log level changed from %s to %s", previous, level)
+	return ctx.JSON(http.StatusOK, LogLevel{Level: level.String()})
+}
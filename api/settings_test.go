@@ -0,0 +1,57 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSetLogLevel(t *testing.T) {
+	t.Parallel()
+
+	e := &EverestServer{l: zap.NewNop().Sugar(), logLevel: zap.NewAtomicLevelAt(zap.InfoLevel)}
+	set := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/v1/settings/log-level", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, e.SetLogLevel(echo.New().NewContext(req, rec)))
+		return rec
+	}
+
+	rec := set(`{"level":"debug"}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, zap.DebugLevel, e.logLevel.Level())
+
+	rec = set(`{"level":"error"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, zap.DebugLevel, e.logLevel.Level())
+
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/settings/log-level", nil)
+	require.NoError(t, e.GetLogLevel(echo.New().NewContext(req, rec)))
+	var level LogLevel
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &level))
+	assert.Equal(t, LogLevel{Level: "debug"}, level)
+}
@@ -0,0 +1,210 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// Steps of the first-run setup in the order they are passed.
+const (
+	setupStepAdmin                = "admin"
+	setupStepSecretsBackend       = "secrets-backend"
+	setupStepKubernetesCluster    = "kubernetes-cluster"
+	setupStepDefaultBackupStorage = "default-backup-storage"
+)
+
+// setupProbeSecretID is the ID of the secret the secrets backend is checked with.
+const setupProbeSecretID = "everest-setup-probe"
+
+// GetSetupState returns the state of the first-run setup.
+func (e *EverestServer) GetSetupState(ctx echo.Context) error {
+	return e.setupStateResponse(ctx)
+}
+
+// SetSetupAdmin sets the credentials of the admin user.
+func (e *EverestServer) SetSetupAdmin(ctx echo.Context) error {
+	var params SetupAdmin
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if e.config.AdminPasswordHash != "" {
+		return ctx.JSON(http.StatusConflict, Error{
+			Message: pointer.ToString("The admin credentials are set in the configuration"),
+		})
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(params.Password), bcrypt.DefaultCost)
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+			return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not hash the password")})
+	}
+	if err := e.storage.SetSetupAdmin(ctx.Request().Context(), params.Username, string(hash)); err != nil {
+		if errors.Is(err, model.ErrAdminAlreadySet) {
+			return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("The admin credentials are already set")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not set the admin credentials")})
+	}
+
+	return e.setupStateResponse(ctx)
+}
+
+// SetSetupSecretsBackend checks the secrets backend round-trips the secrets and records it as configured.
+func (e *EverestServer) SetSetupSecretsBackend(ctx echo.Context) error {
+	c := ctx.Request().Context()
+	if err := probeSecretsStorage(c, e.secretsStorage); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusServiceUnavailable, Error{
+			Message: pointer.ToString("The secrets backend is unavailable: " + err.Error()),
+		})
+	}
+	if err := e.storage.SetSetupSecretsBackend(c, e.config.SecretsBackend); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the secrets backend")})
+	}
+
+	return e.setupStateResponse(ctx)
+}
+
+// SetSetupDefaultBackupStorage picks the default backup storage.
+func (e *EverestServer) SetSetupDefaultBackupStorage(ctx echo.Context) error {
+	var params SetupDefaultBackupStorage
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	if _, err := e.storage.GetBackupStorage(c, nil, params.Name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusBadRequest, Error{
+				Message: pointer.ToString(fmt.Sprintf("BackupStorage '%s' is not found", params.Name)),
+			})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup storage")})
+	}
+	if err := e.storage.SetSetupDefaultBackupStorage(c, params.Name); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the default backup storage")})
+	}
+
+	return e.setupStateResponse(ctx)
+}
+
+func (e *EverestServer) setupStateResponse(ctx echo.Context) error {
+	c := ctx.Request().Context()
+	setup, err := e.storage.GetSetup(c)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the setup state")})
+	}
+	if e.config.AdminPasswordHash != "" {
+		setup.AdminUsername = e.config.AdminUsername
+	}
+	clusters, err := e.storage.ListKubernetesClusters(c)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list Kubernetes clusters")})
+	}
+
+	return ctx.JSON(http.StatusOK, setupState(setup, len(clusters)))
+}
+
+// setupState returns the steps of the setup. The Kubernetes cluster step is passed
+// by registering a Kubernetes cluster with the regular API.
+func setupState(setup *model.Setup, kubernetesClusters int) SetupState {
+	steps := []SetupStep{
+		{Name: setupStepAdmin, Completed: setup.AdminUsername != ""},
+		{Name: setupStepSecretsBackend, Completed: setup.SecretsBackend != ""},
+		{Name: setupStepKubernetesCluster, Completed: kubernetesClusters != 0},
+		{Name: setupStepDefaultBackupStorage, Completed: setup.DefaultBackupStorage != ""},
+	}
+
+	completed := true
+	for _, step := range steps {
+		completed = completed && step.Completed
+	}
+	return SetupState{Completed: completed, Steps: steps}
+}
+
+// adminCredentials returns the username and the bcrypt password hash of the built-in admin user.
+// The credentials set in the configuration take precedence over the ones set with the setup.
+// The username is empty if the admin credentials are not set yet.
+func (e *EverestServer) adminCredentials(ctx context.Context) (string, string, error) {
+	if e.config.AdminPasswordHash != "" {
+		return e.config.AdminUsername, e.config.AdminPasswordHash, nil
+	}
+	setup, err := e.storage.GetSetup(ctx)
+	if err != nil {
+		return "", "", errors.Join(err, errors.New("could not get the admin credentials"))
+	}
+	return setup.AdminUsername, setup.AdminPasswordHash, nil
+}
+
+// validateAdminConfig checks the admin credentials set in the configuration.
+func validateAdminConfig(c *config.EverestConfig) error {
+	if c.AdminPasswordHash == "" {
+		return nil
+	}
+	if c.AdminUsername == "" {
+		return errors.New("the admin username cannot be empty")
+	}
+	if _, err := bcrypt.Cost([]byte(c.AdminPasswordHash)); err != nil {
+		return errors.Join(err, errors.New("the admin password hash is not a valid bcrypt hash"))
+	}
+	return nil
+}
+
+// probeSecretsStorage checks the secrets storage stores, returns and deletes a secret.
+func probeSecretsStorage(ctx context.Context, s secretsStorage) error {
+	value, err := randomString(temporaryPasswordLength)
+	if err != nil {
+		return err
+	}
+	// A probe left behind by an interrupted check is not an error.
+	_, _ = s.DeleteSecret(ctx, setupProbeSecretID)
+
+	if err := s.CreateSecret(ctx, setupProbeSecretID, value); err != nil {
+		return errors.Join(err, errors.New("could not create secret"))
+	}
+	got, err := s.GetSecret(ctx, setupProbeSecretID)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get secret"))
+	}
+	if _, err := s.DeleteSecret(ctx, setupProbeSecretID); err != nil {
+		return errors.Join(err, errors.New("could not delete secret"))
+	}
+	if got != value {
+		return errors.New("the secret read back differs from the one stored")
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestSetupState(t *testing.T) {
+	t.Parallel()
+
+	type tCase struct {
+		name       string
+		setup      *model.Setup
+		clusters   int
+		completed  bool
+		incomplete []string
+	}
+
+	cases := []tCase{
+		{
+			name:       "not started",
+			setup:      &model.Setup{},
+			incomplete: []string{setupStepAdmin, setupStepSecretsBackend, setupStepKubernetesCluster, setupStepDefaultBackupStorage},
+		},
+		{
+			name:       "cluster registered",
+			setup:      &model.Setup{AdminUsername: "admin", SecretsBackend: secretsBackendPostgres},
+			clusters:   1,
+			incomplete: []string{setupStepDefaultBackupStorage},
+		},
+		{
+			name: "completed",
+			setup: &model.Setup{
+				AdminUsername:        "admin",
+				SecretsBackend:       secretsBackendPostgres,
+				DefaultBackupStorage: "s3",
+			},
+			clusters:  2,
+			completed: true,
+		},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			state := setupState(tc.setup, tc.clusters)
+			assert.Equal(t, tc.completed, state.Completed)
+			assert.Len(t, state.Steps, 4)
+
+			var incomplete []string
+			for _, step := range state.Steps {
+				if !step.Completed {
+					incomplete = append(incomplete, step.Name)
+				}
+			}
+			assert.Equal(t, tc.incomplete, incomplete)
+		})
+	}
+}
+
+func TestAdminUser(t *testing.T) {
+	t.Parallel()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+	c := &config.EverestConfig{AdminUsername: "root", AdminPasswordHash: string(hash)}
+	require.NoError(t, validateAdminConfig(c))
+	require.Error(t, validateAdminConfig(&config.EverestConfig{AdminUsername: "root", AdminPasswordHash: "secret"}))
+
+	e := &EverestServer{config: c, l: zap.NewNop().Sugar()}
+	user, err := e.adminUser(context.Background(), "root", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, &sessionUser{username: "root", scope: model.APITokenScopeAdmin}, user)
+
+	_, err = e.adminUser(context.Background(), "root", "wrong")
+	require.ErrorIs(t, err, errInvalidCredentials)
+	_, err = e.adminUser(context.Background(), "admin", "secret")
+	require.ErrorIs(t, err, errInvalidCredentials)
+}
@@ -0,0 +1,134 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// sizingPresetLabel is the label of a database cluster that references the sizing preset it was created from.
+const sizingPresetLabel = "everest.percona.com/sizing-preset"
+
+// sizingPresets is the single source of truth of the resource presets per engine type.
+//
+//nolint:gochecknoglobals
+var sizingPresets = []SizingPreset{
+	{EngineType: string(Pxc), Name: Small, Replicas: 1, Cpu: "1", Memory: "2G", Storage: "25G"},
+	{EngineType: string(Pxc), Name: Medium, Replicas: 3, Cpu: "4", Memory: "8G", Storage: "100G"},
+	{EngineType: string(Pxc), Name: Large, Replicas: 5, Cpu: "8", Memory: "32G", Storage: "200G"},
+	{EngineType: string(Psmdb), Name: Small, Replicas: 1, Cpu: "1", Memory: "2G", Storage: "25G"},
+	{EngineType: string(Psmdb), Name: Medium, Replicas: 3, Cpu: "4", Memory: "8G", Storage: "100G"},
+	{EngineType: string(Psmdb), Name: Large, Replicas: 5, Cpu: "8", Memory: "32G", Storage: "200G"},
+	{EngineType: string(Postgresql), Name: Small, Replicas: 1, Cpu: "1", Memory: "2G", Storage: "25G"},
+	{EngineType: string(Postgresql), Name: Medium, Replicas: 3, Cpu: "4", Memory: "8G", Storage: "100G"},
+	{EngineType: string(Postgresql), Name: Large, Replicas: 5, Cpu: "8", Memory: "32G", Storage: "200G"},
+}
+
+// ListSizingPresets returns the resource presets for database clusters.
+func (e *EverestServer) ListSizingPresets(ctx echo.Context, params ListSizingPresetsParams) error {
+	res := make([]SizingPreset, 0, len(sizingPresets))
+	for _, p := range sizingPresets {
+		if params.EngineType != nil && p.EngineType != string(*params.EngineType) {
+			continue
+		}
+		res = append(res, p)
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func findSizingPreset(engineType, name string) (SizingPreset, bool) {
+	for _, p := range sizingPresets {
+		if p.EngineType == engineType && string(p.Name) == name {
+			return p, true
+		}
+	}
+	return SizingPreset{}, false
+}
+
+// databaseClusterLabel returns the value of the label of the database cluster.
+func databaseClusterLabel(dbc *DatabaseCluster, key string) string {
+	if dbc.Metadata == nil {
+		return ""
+	}
+	labels, ok := (*dbc.Metadata)["labels"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	value, ok := labels[key].(string)
+	if !ok {
+		return ""
+	}
+	return value
+}
+
+// validateSizingPreset checks that the database cluster matches the sizing preset it is labeled with.
+func validateSizingPreset(dbc *DatabaseCluster) error {
+	name := databaseClusterLabel(dbc, sizingPresetLabel)
+	if name == "" {
+		return nil
+	}
+
+	engineType := string(dbc.Spec.Engine.Type)
+	preset, ok := findSizingPreset(engineType, name)
+	if !ok {
+		return fmt.Errorf("unknown sizing preset '%s' for engine type '%s'", name, engineType)
+	}
+
+	if dbc.Spec.Engine.Replicas == nil || *dbc.Spec.Engine.Replicas != preset.Replicas {
+		return fmt.Errorf("sizing preset '%s' requires %d replicas", name, preset.Replicas)
+	}
+	if dbc.Spec.Engine.Resources == nil || dbc.Spec.Engine.Resources.Cpu == nil || dbc.Spec.Engine.Resources.Memory == nil {
+		return errNoResourceDefined
+	}
+
+	cpu, err := dbc.Spec.Engine.Resources.Cpu.AsDatabaseClusterSpecEngineResourcesCpu1()
+	if err != nil {
+		return errInt64NotSupported
+	}
+	if err := matchPresetQuantity("CPU", cpu, preset.Cpu, name); err != nil {
+		return err
+	}
+	memory, err := dbc.Spec.Engine.Resources.Memory.AsDatabaseClusterSpecEngineResourcesMemory1()
+	if err != nil {
+		return errInt64NotSupported
+	}
+	if err := matchPresetQuantity("memory", memory, preset.Memory, name); err != nil {
+		return err
+	}
+	storage, err := dbc.Spec.Engine.Storage.Size.AsDatabaseClusterSpecEngineStorageSize1()
+	if err != nil {
+		return errInt64NotSupported
+	}
+	return matchPresetQuantity("storage size", storage, preset.Storage, name)
+}
+
+func matchPresetQuantity(field, value, expected, presetName string) error {
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return errors.Join(err, fmt.Errorf("invalid %s", field))
+	}
+	if q.Cmp(resource.MustParse(expected)) != 0 {
+		return fmt.Errorf("sizing preset '%s' requires %s of %s", presetName, field, expected)
+	}
+	return nil
+}
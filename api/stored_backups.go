@@ -0,0 +1,353 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+const (
+	storedBackupTypeFull        = "full"
+	storedBackupTypeIncremental = "incremental"
+
+	defaultDownloadURLTTLMinutes = 15
+	// maxDownloadURLTTLMinutes is the longest validity of a URL signed with the signature version 4.
+	maxDownloadURLTTLMinutes = 7 * 24 * 60
+)
+
+//nolint:gochecknoglobals
+var (
+	// pgBackRestBackupRe matches the pgBackRest backup labels, like 20230925-100000F for a full backup
+	// and 20230925-100000F_20230926-100000I for an incremental one based on it.
+	pgBackRestBackupRe = regexp.MustCompile(`^(?:(.*)/)?backup/[^/]+/(\d{8}-\d{6}F(?:_(\d{8}-\d{6})([DI]))?)/`)
+	// pxcBackupRe matches the PXC backup directories, like db1-2023-09-25-10:00:00-full.
+	pxcBackupRe = regexp.MustCompile(`^(?:(.*)/)?(([a-z0-9-]+?)-(\d{4}-\d{2}-\d{2}-\d{2}:\d{2}:\d{2})-(full|incr))/`)
+	// pbmBackupRe matches the PBM backup metadata files and directories, like 2023-09-25T10:00:00Z.pbm.json.
+	pbmBackupRe = regexp.MustCompile(`^(?:(.*)/)?(\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z)(\.pbm\.json$|/)`)
+)
+
+// s3Object is an object of a backup storage bucket.
+type s3Object struct {
+	key  string
+	size int64
+}
+
+// ListStoredBackups lists the backup artifacts found in the bucket of the backup storage.
+func (e *EverestServer) ListStoredBackups(ctx echo.Context, name string, params ListStoredBackupsParams) error {
+	c := ctx.Request().Context()
+	bs, err := e.storage.GetBackupStorage(c, nil, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup storage")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup storage")})
+	}
+	if bs.Type != string(BackupStorageTypeS3) {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Browsing backups is supported for the s3 backup storages only"),
+		})
+	}
+
+	svc, err := e.backupStorageS3Client(c, bs)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not create S3 client")})
+	}
+	objects, err := listS3Objects(c, svc, bs.BucketName, pointer.GetString(params.Prefix))
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list the objects of the bucket")})
+	}
+
+	backups := storedBackupsFrom(objects, func(key string) string {
+		return pbmBackupType(c, svc, bs.BucketName, key)
+	})
+	return ctx.JSON(http.StatusOK, backups)
+}
+
+// CreateStoredBackupDownloadURL generates the pre-signed URLs to download a stored backup.
+func (e *EverestServer) CreateStoredBackupDownloadURL(ctx echo.Context, name string, key string) error {
+	var params BackupDownloadURLParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	ttl := defaultDownloadURLTTLMinutes
+	if params.ExpiresInMinutes != nil {
+		ttl = *params.ExpiresInMinutes
+	}
+	if ttl < 1 || ttl > maxDownloadURLTTLMinutes {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("expiresInMinutes shall be between 1 and %d", maxDownloadURLTTLMinutes)),
+		})
+	}
+	key = strings.TrimSuffix(key, "/")
+	if key == "" {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString("The key shall not be empty")})
+	}
+
+	c := ctx.Request().Context()
+	bs, err := e.storage.GetBackupStorage(c, nil, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup storage")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup storage")})
+	}
+	if bs.Type != string(BackupStorageTypeS3) && bs.Type != string(BackupStorageTypeGcs) {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString("Download URLs are supported for the s3 and gcs backup storages only"),
+		})
+	}
+
+	svc, err := e.backupStorageS3Client(c, bs)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not create S3 client")})
+	}
+	objects, err := downloadedObjects(c, svc, bs.BucketName, key)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list the objects of the bucket")})
+	}
+	if len(objects) == 0 {
+		return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString(fmt.Sprintf("There is no object or backup '%s'", key))})
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Duration(ttl) * time.Minute).Truncate(time.Second)
+	// The URLs are valid as long as the credentials they are signed with.
+	if bs.CredentialsExpireAt != nil && bs.CredentialsExpireAt.Before(expiresAt) {
+		expiresAt = bs.CredentialsExpireAt.UTC()
+	}
+	if !expiresAt.After(now) {
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("The backup storage credentials have expired")})
+	}
+
+	res := BackupDownload{ExpiresAt: expiresAt, Objects: make([]BackupDownloadObject, 0, len(objects))}
+	for _, o := range objects {
+		req, _ := svc.GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String(bs.BucketName), Key: aws.String(o.key)})
+		u, err := req.Presign(expiresAt.Sub(now))
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not sign the download URL")})
+		}
+		res.Objects = append(res.Objects, BackupDownloadObject{Key: o.key, Size: o.size, Url: u})
+	}
+
+	if err := e.recordAudit(ctx, auditActionBackupDownloadURLIssued, "", "backup-storages/"+name, key); err != nil {
+		e.l.Error(err)
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// downloadedObjects returns the object with the key if it exists or the objects of the backup at the path otherwise.
+func downloadedObjects(ctx context.Context, svc *s3.S3, bucketName, key string) ([]s3Object, error) {
+	head, err := svc.HeadObjectWithContext(ctx, &s3.HeadObjectInput{Bucket: aws.String(bucketName), Key: aws.String(key)})
+	if err == nil {
+		return []s3Object{{key: key, size: aws.Int64Value(head.ContentLength)}}, nil
+	}
+	if !isS3NotFound(err) {
+		return nil, err
+	}
+	return listS3Objects(ctx, svc, bucketName, key+"/")
+}
+
+// backupStorageS3Client returns an S3 client with the credentials and the options of the backup storage.
+// The backup storages using IAM are accessed with the default credential chain of Everest.
+func (e *EverestServer) backupStorageS3Client(ctx context.Context, bs *model.BackupStorage) (*s3.S3, error) {
+	var creds *credentials.Credentials
+	if !bs.UsesIAM() {
+		accessKey, err := e.secretsStorage.GetSecret(ctx, bs.AccessKeyID)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not get access key"))
+		}
+		secretKey, err := e.secretsStorage.GetSecret(ctx, bs.SecretKeyID)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not get secret key"))
+		}
+		var sessionToken string
+		if bs.SessionTokenID != "" {
+			sessionToken, err = e.secretsStorage.GetSecret(ctx, bs.SessionTokenID)
+			if err != nil {
+				return nil, errors.Join(err, errors.New("could not get session token"))
+			}
+		}
+		creds = credentials.NewStaticCredentials(accessKey, secretKey, sessionToken)
+	}
+
+	var caCert string
+	if bs.CACertID != "" {
+		var err error
+		caCert, err = e.secretsStorage.GetSecret(ctx, bs.CACertID)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not get CA certificate"))
+		}
+	}
+	tlsConfig, err := s3TLSConfig(caCert, !bs.SkipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+
+	return newS3Client(&bs.URL, creds, bs.Region, s3Options{tlsConfig: tlsConfig, forcePathStyle: bs.ForcePathStyle})
+}
+
+func listS3Objects(ctx context.Context, svc *s3.S3, bucketName, prefix string) ([]s3Object, error) {
+	var objects []s3Object
+	input := &s3.ListObjectsV2Input{Bucket: aws.String(bucketName)}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	err := svc.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, o := range page.Contents {
+			objects = append(objects, s3Object{key: aws.StringValue(o.Key), size: aws.Int64Value(o.Size)})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// pbmBackupType reads the type of a PBM backup from its metadata file. The backups which type
+// cannot be read are reported as full ones.
+func pbmBackupType(ctx context.Context, svc *s3.S3, bucketName, key string) string {
+	out, err := svc.GetObjectWithContext(ctx, &s3.GetObjectInput{Bucket: aws.String(bucketName), Key: aws.String(key)})
+	if err != nil {
+		return storedBackupTypeFull
+	}
+	defer out.Body.Close() //nolint:errcheck
+
+	b, err := io.ReadAll(out.Body)
+	if err != nil {
+		return storedBackupTypeFull
+	}
+	var meta struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(b, &meta); err != nil || meta.Type != storedBackupTypeIncremental {
+		return storedBackupTypeFull
+	}
+	return storedBackupTypeIncremental
+}
+
+// storedBackupsFrom groups the objects of a bucket into the backup artifacts of the layouts
+// of the PXC, PSMDB and pgBackRest backups. The objects which belong to no backup are skipped.
+// The database cluster of a backup is the first directory of its path unless the layout names it.
+func storedBackupsFrom(objects []s3Object, pbmType func(metaKey string) string) StoredBackupList {
+	byPath := make(map[string]*StoredBackup)
+	pbmMetas := make(map[string]string)
+	add := func(path, dir, dbClusterName string, engine everestv1alpha1.EngineType, typ string, createdAt time.Time, size int64) {
+		b, ok := byPath[path]
+		if !ok {
+			if dbClusterName == "" {
+				dbClusterName = strings.Split(dir, "/")[0]
+			}
+			b = &StoredBackup{
+				Path:          path,
+				DbClusterName: dbClusterName,
+				EngineType:    string(engine),
+				Type:          typ,
+				CreatedAt:     createdAt,
+			}
+			byPath[path] = b
+		}
+		b.Size += size
+	}
+
+	for _, o := range objects {
+		if m := pgBackRestBackupRe.FindStringSubmatch(o.key); m != nil {
+			typ, stamp := storedBackupTypeFull, m[2][:15]
+			if m[3] != "" {
+				typ, stamp = storedBackupTypeIncremental, m[3]
+			}
+			createdAt, err := time.Parse("20060102-150405", stamp)
+			if err != nil {
+				continue
+			}
+			add(strings.TrimSuffix(m[0], "/"), m[1], "", everestv1alpha1.DatabaseEnginePostgresql, typ, createdAt, o.size)
+			continue
+		}
+		if m := pxcBackupRe.FindStringSubmatch(o.key); m != nil {
+			createdAt, err := time.Parse("2006-01-02-15:04:05", m[4])
+			if err != nil {
+				continue
+			}
+			typ := storedBackupTypeFull
+			if m[5] == "incr" {
+				typ = storedBackupTypeIncremental
+			}
+			add(strings.TrimSuffix(m[0], "/"), m[1], m[3], everestv1alpha1.DatabaseEnginePXC, typ, createdAt, o.size)
+			continue
+		}
+		if m := pbmBackupRe.FindStringSubmatch(o.key); m != nil {
+			createdAt, err := time.Parse(time.RFC3339, m[2])
+			if err != nil {
+				continue
+			}
+			path := m[2]
+			if m[1] != "" {
+				path = m[1] + "/" + m[2]
+			}
+			if m[3] != "/" {
+				pbmMetas[path] = o.key
+			}
+			add(path, m[1], "", everestv1alpha1.DatabaseEnginePSMDB, storedBackupTypeFull, createdAt, o.size)
+		}
+	}
+
+	res := make(StoredBackupList, 0, len(byPath))
+	for path, b := range byPath {
+		if b.EngineType == string(everestv1alpha1.DatabaseEnginePSMDB) {
+			meta, ok := pbmMetas[path]
+			if !ok {
+				// A PBM backup without the metadata file is incomplete and cannot be restored.
+				continue
+			}
+			b.Type = pbmType(meta)
+		}
+		res = append(res, *b)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].DbClusterName != res[j].DbClusterName {
+			return res[i].DbClusterName < res[j].DbClusterName
+		}
+		return res[i].CreatedAt.Before(res[j].CreatedAt)
+	})
+	return res
+}
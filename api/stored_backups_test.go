@@ -0,0 +1,123 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoredBackupsFrom(t *testing.T) {
+	t.Parallel()
+
+	objects := []s3Object{
+		{key: "pg1/backup/db/backup.info", size: 1},
+		{key: "pg1/backup/db/20230925-100000F/backup.manifest", size: 10},
+		{key: "pg1/backup/db/20230925-100000F/pg_data/base.gz", size: 90},
+		{key: "pg1/backup/db/20230925-100000F_20230926-110000I/backup.manifest", size: 5},
+		{key: "pg1/archive/db/16-1/000000010000000000000001.gz", size: 16},
+		{key: "pxc1-2023-09-24-08:00:00-full/xtrabackup.stream", size: 200},
+		{key: "pxc1-2023-09-24-08:00:00-full.sst_info/sst_info", size: 1},
+		{key: "mongo1/2023-09-23T06:00:00Z.pbm.json", size: 2},
+		{key: "mongo1/2023-09-23T06:00:00Z/rs0/local.oplog.gz", size: 30},
+		{key: "mongo1/2023-09-27T06:00:00Z/rs0/local.oplog.gz", size: 30},
+		{key: "unrelated.txt", size: 1},
+	}
+	backups := storedBackupsFrom(objects, func(string) string { return storedBackupTypeIncremental })
+
+	assert.Equal(t, StoredBackupList{
+		{
+			Path:          "mongo1/2023-09-23T06:00:00Z",
+			DbClusterName: "mongo1",
+			EngineType:    "psmdb",
+			Type:          storedBackupTypeIncremental,
+			CreatedAt:     time.Date(2023, time.September, 23, 6, 0, 0, 0, time.UTC),
+			Size:          32,
+		},
+		{
+			Path:          "pg1/backup/db/20230925-100000F",
+			DbClusterName: "pg1",
+			EngineType:    "postgresql",
+			Type:          storedBackupTypeFull,
+			CreatedAt:     time.Date(2023, time.September, 25, 10, 0, 0, 0, time.UTC),
+			Size:          100,
+		},
+		{
+			Path:          "pg1/backup/db/20230925-100000F_20230926-110000I",
+			DbClusterName: "pg1",
+			EngineType:    "postgresql",
+			Type:          storedBackupTypeIncremental,
+			CreatedAt:     time.Date(2023, time.September, 26, 11, 0, 0, 0, time.UTC),
+			Size:          5,
+		},
+		{
+			Path:          "pxc1-2023-09-24-08:00:00-full",
+			DbClusterName: "pxc1",
+			EngineType:    "pxc",
+			Type:          storedBackupTypeFull,
+			CreatedAt:     time.Date(2023, time.September, 24, 8, 0, 0, 0, time.UTC),
+			Size:          200,
+		},
+	}, backups)
+}
+
+func TestDownloadedObjects(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodHead && r.URL.Path == "/bucket/db1/backup.tar":
+			w.Header().Set("Content-Length", "42")
+		case r.Method == http.MethodHead:
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Query().Get("prefix") == "db1/2023-09-25T10:00:00Z/":
+			fmt.Fprint(w, `<ListBucketResult><IsTruncated>false</IsTruncated>`+
+				`<Contents><Key>db1/2023-09-25T10:00:00Z/rs0/oplog</Key><Size>10</Size></Contents>`+
+				`<Contents><Key>db1/2023-09-25T10:00:00Z/rs0/data</Key><Size>20</Size></Contents>`+
+				`</ListBucketResult>`)
+		default:
+			fmt.Fprint(w, `<ListBucketResult><IsTruncated>false</IsTruncated></ListBucketResult>`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	svc, err := newS3Client(&server.URL, credentials.NewStaticCredentials("key", "secret", ""), "us-east-1",
+		s3Options{forcePathStyle: true})
+	require.NoError(t, err)
+
+	objects, err := downloadedObjects(context.Background(), svc, "bucket", "db1/backup.tar")
+	require.NoError(t, err)
+	assert.Equal(t, []s3Object{{key: "db1/backup.tar", size: 42}}, objects)
+
+	objects, err = downloadedObjects(context.Background(), svc, "bucket", "db1/2023-09-25T10:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, []s3Object{
+		{key: "db1/2023-09-25T10:00:00Z/rs0/oplog", size: 10},
+		{key: "db1/2023-09-25T10:00:00Z/rs0/data", size: 20},
+	}, objects)
+
+	objects, err = downloadedObjects(context.Background(), svc, "bucket", "missing")
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
@@ -0,0 +1,167 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+// summaryWindow is the period the backups and the restores of the summary are counted over.
+const summaryWindow = 24 * time.Hour
+
+// summaryCache keeps the last computed summary so that the dashboard refreshing its home page
+// does not hit the Kubernetes clusters more often than the cache TTL allows.
+type summaryCache struct {
+	mu      sync.Mutex
+	summary *Summary
+}
+
+// GetSummary returns the counts and the health breakdowns aggregated across all the kubernetes clusters.
+func (e *EverestServer) GetSummary(ctx echo.Context) error {
+	e.summary.mu.Lock()
+	defer e.summary.mu.Unlock()
+
+	if s := e.summary.summary; s != nil && time.Since(s.UpdatedAt) < e.config.SummaryCacheTTL {
+		setPartialResultHeaders(ctx, s.UnreachableClusters)
+		return ctx.JSON(http.StatusOK, s)
+	}
+
+	summary, err := e.computeSummary(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get the summary")})
+	}
+	e.summary.summary = summary
+	setPartialResultHeaders(ctx, summary.UnreachableClusters)
+	return ctx.JSON(http.StatusOK, summary)
+}
+
+func (e *EverestServer) computeSummary(ctx context.Context) (*Summary, error) {
+	clusters, err := e.storage.ListKubernetesClusters(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list Kubernetes clusters"))
+	}
+
+	now := time.Now().UTC()
+	summary := &Summary{
+		DatabaseClusters: SummaryDatabaseClusters{
+			ByEngine: map[string]int{},
+			ByState:  map[string]int{},
+		},
+		UnreachableClusters: []UnreachableCluster{},
+		WindowHours:         int(summaryWindow.Hours()),
+		UpdatedAt:           now,
+	}
+	summary.KubernetesClusters.Total = len(clusters)
+	for i := range clusters {
+		k := &clusters[i]
+		if k.CompatibilityStatus == model.CompatibilityStatusIncompatible {
+			summary.KubernetesClusters.Incompatible++
+		}
+		state, unreachable := e.clusterState(ctx, *k, "summary", func(ctx context.Context) (interface{}, error) {
+			return e.summaryResources(ctx, k)
+		})
+		if unreachable != nil {
+			summary.KubernetesClusters.Unreachable++
+			summary.UnreachableClusters = append(summary.UnreachableClusters, *unreachable)
+		}
+		if state == nil {
+			continue
+		}
+		// The last known resources of an unreachable cluster are still counted.
+		summarizeResources(summary, state.(*summaryClusterResources), now.Add(-summaryWindow))
+	}
+	return summary, nil
+}
+
+type summaryClusterResources struct {
+	dbs      []everestv1alpha1.DatabaseCluster
+	backups  []everestv1alpha1.DatabaseClusterBackup
+	restores []everestv1alpha1.DatabaseClusterRestore
+}
+
+func (e *EverestServer) summaryResources(ctx context.Context, k *model.KubernetesCluster) (*summaryClusterResources, error) {
+	kubeClient, err := kubernetes.NewFromSecretsStorage(ctx, e.secretsStorage, k.ID, k.Namespace, e.l)
+	if err != nil {
+		return nil, err
+	}
+	dbs, err := kubeClient.ListDatabaseClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	backups, err := kubeClient.ListDatabaseClusterBackups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	restores, err := kubeClient.ListDatabaseClusterRestores(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &summaryClusterResources{dbs: dbs.Items, backups: backups.Items, restores: restores.Items}, nil
+}
+
+// summarizeResources adds the resources of a kubernetes cluster to the summary.
+// Only the backups and the restores started since the given time are counted.
+func summarizeResources(summary *Summary, r *summaryClusterResources, since time.Time) {
+	for _, db := range r.dbs {
+		summary.DatabaseClusters.Total++
+		summary.DatabaseClusters.ByEngine[string(db.Spec.Engine.Type)]++
+		state := db.Status.Status
+		if state == "" {
+			state = everestv1alpha1.AppStateUnknown
+		}
+		summary.DatabaseClusters.ByState[string(state)]++
+	}
+
+	for _, b := range r.backups {
+		if b.CreationTimestamp.Time.Before(since) {
+			continue
+		}
+		countOperationState(string(b.Status.State), &summary.Backups.Succeeded, &summary.Backups.Failed, &summary.Backups.Running)
+	}
+	for _, res := range r.restores {
+		if res.CreationTimestamp.Time.Before(since) {
+			continue
+		}
+		countOperationState(string(res.Status.State), &summary.Restores.Succeeded, &summary.Restores.Failed, &summary.Restores.Running)
+	}
+}
+
+// countOperationState counts a backup or a restore by its state. The operators report
+// the same states for both of them, so any state neither successful nor failed is running.
+func countOperationState(state string, succeeded, failed, running *int) {
+	state = strings.ToLower(state)
+	if _, ok := successfulBackupStates[state]; ok {
+		*succeeded++
+	} else if _, ok := failedBackupStates[state]; ok {
+		*failed++
+	} else {
+		*running++
+	}
+}
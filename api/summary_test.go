@@ -0,0 +1,79 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSummarizeResources(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	recent := metav1.NewTime(now.Add(-time.Hour))
+	old := metav1.NewTime(now.Add(-48 * time.Hour))
+
+	db := func(engine everestv1alpha1.EngineType, state everestv1alpha1.AppState) everestv1alpha1.DatabaseCluster {
+		d := everestv1alpha1.DatabaseCluster{}
+		d.Spec.Engine.Type = engine
+		d.Status.Status = state
+		return d
+	}
+	backup := func(state string, createdAt metav1.Time) everestv1alpha1.DatabaseClusterBackup {
+		b := everestv1alpha1.DatabaseClusterBackup{}
+		b.CreationTimestamp = createdAt
+		b.Status.State = everestv1alpha1.BackupState(state)
+		return b
+	}
+	restore := func(state string, createdAt metav1.Time) everestv1alpha1.DatabaseClusterRestore {
+		r := everestv1alpha1.DatabaseClusterRestore{}
+		r.CreationTimestamp = createdAt
+		r.Status.State = everestv1alpha1.RestoreState(state)
+		return r
+	}
+
+	summary := &Summary{DatabaseClusters: SummaryDatabaseClusters{ByEngine: map[string]int{}, ByState: map[string]int{}}}
+	summarizeResources(summary, &summaryClusterResources{
+		dbs: []everestv1alpha1.DatabaseCluster{
+			db(everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateReady),
+			db(everestv1alpha1.DatabaseEnginePXC, everestv1alpha1.AppStateError),
+			db(everestv1alpha1.DatabaseEnginePSMDB, ""),
+		},
+		backups: []everestv1alpha1.DatabaseClusterBackup{
+			backup("Succeeded", recent),
+			backup("ready", recent),
+			backup("Failed", recent),
+			backup("Running", recent),
+			backup("Failed", old),
+		},
+		restores: []everestv1alpha1.DatabaseClusterRestore{
+			restore("error", recent),
+			restore("Restoring", recent),
+			restore("Succeeded", old),
+		},
+	}, now.Add(-summaryWindow))
+
+	assert.Equal(t, 3, summary.DatabaseClusters.Total)
+	assert.Equal(t, map[string]int{"pxc": 2, "psmdb": 1}, summary.DatabaseClusters.ByEngine)
+	assert.Equal(t, map[string]int{"ready": 1, "error": 1, "unknown": 1}, summary.DatabaseClusters.ByState)
+	assert.Equal(t, SummaryBackups{Succeeded: 2, Failed: 1, Running: 1}, summary.Backups)
+	assert.Equal(t, SummaryRestores{Failed: 1, Running: 1}, summary.Restores)
+}
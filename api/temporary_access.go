@@ -0,0 +1,243 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const (
+	defaultTemporaryAccessTTLMinutes = 60
+	maxTemporaryAccessTTLMinutes     = 24 * 60
+
+	temporaryUsernamePrefix = "everest_tmp_"
+	temporaryPasswordLength = 24
+
+	credentialsAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// CreateDatabaseClusterTemporaryAccess creates a short-lived database user on the specified database cluster.
+func (e *EverestServer) CreateDatabaseClusterTemporaryAccess(ctx echo.Context, kubernetesID string, name string, _ CreateDatabaseClusterTemporaryAccessParams) error {
+	var params TemporaryAccessRequest
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	ttl := defaultTemporaryAccessTTLMinutes
+	if params.TtlMinutes != nil {
+		ttl = *params.TtlMinutes
+	}
+	if ttl < 1 || ttl > maxTemporaryAccessTTLMinutes {
+		return ctx.JSON(http.StatusBadRequest, Error{
+			Message: pointer.ToString(fmt.Sprintf("ttlMinutes shall be between 1 and %d", maxTemporaryAccessTTLMinutes)),
+		})
+	}
+
+	c := ctx.Request().Context()
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+	db, err := kubeClient.GetDatabaseCluster(c, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString(fmt.Sprintf("DatabaseCluster '%s' is not found", name))})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster")})
+	}
+	admin, err := databaseClusterAdmin(c, kubeClient, kubeClient.Namespace(), db)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database cluster credentials")})
+	}
+
+	user, err := temporaryDatabaseUser(params.ReadOnly == nil || *params.ReadOnly, time.Duration(ttl)*time.Minute)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not generate database user")})
+	}
+	access := &model.TemporaryAccess{
+		KubernetesID:  kubernetesID,
+		DBClusterName: name,
+		Username:      user.Username,
+		ReadOnly:      user.ReadOnly,
+		Namespace:     namespaceFrom(c),
+		ExpiresAt:     user.ValidUntil,
+	}
+	if id, ok := userIdentityFrom(ctx); ok {
+		access.CreatedBy = id.Username
+	}
+	// The access is stored before the user is created so that the user is dropped even if the server stops meanwhile.
+	if err := e.storage.CreateTemporaryAccess(c, access); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save temporary access")})
+	}
+	if err := kubeClient.CreateDatabaseUser(c, db, admin, user); err != nil {
+		e.l.Error(err)
+		if err := e.storage.DeleteTemporaryAccess(c, kubernetesID, name, user.Username); err != nil {
+			e.l.Error(err)
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not create database user")})
+	}
+
+	if err := e.recordAudit(ctx, auditActionTemporaryAccessGranted, kubernetesID, "database-clusters/"+name, user.Username); err != nil {
+		e.l.Error(err)
+	}
+
+	return ctx.JSON(http.StatusCreated, TemporaryAccess{
+		Username:  user.Username,
+		Password:  user.Password,
+		ReadOnly:  user.ReadOnly,
+		ExpiresAt: user.ValidUntil,
+	})
+}
+
+func temporaryDatabaseUser(readOnly bool, ttl time.Duration) (kubernetes.DatabaseUser, error) {
+	suffix, err := randomString(8)
+	if err != nil {
+		return kubernetes.DatabaseUser{}, err
+	}
+	password, err := randomString(temporaryPasswordLength)
+	if err != nil {
+		return kubernetes.DatabaseUser{}, err
+	}
+	return kubernetes.DatabaseUser{
+		Username:   temporaryUsernamePrefix + suffix,
+		Password:   password,
+		ReadOnly:   readOnly,
+		ValidUntil: time.Now().UTC().Add(ttl).Truncate(time.Second),
+	}, nil
+}
+
+func randomString(length int) (string, error) {
+	b := make([]byte, length)
+	alphabetSize := big.NewInt(int64(len(credentialsAlphabet)))
+	for i := range b {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+		b[i] = credentialsAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// databaseClusterAdmin returns the credentials of the administrative user of the database cluster.
+func databaseClusterAdmin(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, namespace string, db *everestv1alpha1.DatabaseCluster,
+) (kubernetes.DatabaseUser, error) {
+	secret, err := kubeClient.GetSecret(ctx, db.Spec.Engine.UserSecretsName, namespace)
+	if err != nil {
+		return kubernetes.DatabaseUser{}, err
+	}
+	return adminFromSecret(db.Spec.Engine.Type, secret)
+}
+
+func adminFromSecret(engineType everestv1alpha1.EngineType, secret *corev1.Secret) (kubernetes.DatabaseUser, error) {
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePXC:
+		return kubernetes.DatabaseUser{Username: "root", Password: string(secret.Data["root"])}, nil
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		return kubernetes.DatabaseUser{
+			Username: string(secret.Data["MONGODB_USER_ADMIN_USER"]),
+			Password: string(secret.Data["MONGODB_USER_ADMIN_PASSWORD"]),
+		}, nil
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		return kubernetes.DatabaseUser{Username: "postgres", Password: string(secret.Data["password"])}, nil
+	default:
+		return kubernetes.DatabaseUser{}, errors.New("unsupported database engine")
+	}
+}
+
+// runTemporaryAccessCleaner periodically drops the expired temporary database users
+// until the context is canceled.
+func (e *EverestServer) runTemporaryAccessCleaner(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.TemporaryAccessCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.dropExpiredTemporaryAccesses(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) dropExpiredTemporaryAccesses(ctx context.Context) {
+	accesses, err := e.storage.ListExpiredTemporaryAccesses(ctx, time.Now().UTC())
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list expired temporary accesses")))
+		return
+	}
+
+	for _, access := range accesses {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := e.dropTemporaryAccess(ctx, access); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not drop temporary user %s of database cluster %s", access.Username, access.DBClusterName)))
+			continue
+		}
+		if err := e.storage.DeleteTemporaryAccess(ctx, access.KubernetesID, access.DBClusterName, access.Username); err != nil {
+			e.l.Error(errors.Join(err, errors.New("could not delete temporary access")))
+		}
+	}
+}
+
+func (e *EverestServer) dropTemporaryAccess(ctx context.Context, access model.TemporaryAccess) error {
+	ctx = withNamespace(ctx, access.Namespace)
+	_, kubeClient, _, err := e.initDatabaseClusterKubeClient(ctx, access.KubernetesID)
+	if err != nil {
+		return err
+	}
+	db, err := kubeClient.GetDatabaseCluster(ctx, access.DBClusterName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// The user is gone together with the database cluster.
+			return nil
+		}
+		return err
+	}
+	admin, err := databaseClusterAdmin(ctx, kubeClient, kubeClient.Namespace(), db)
+	if err != nil {
+		return err
+	}
+	return kubeClient.DropDatabaseUser(ctx, db, admin, access.Username)
+}
@@ -0,0 +1,195 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+	"github.com/percona/percona-everest-backend/model"
+)
+
+const (
+	// redirectReadHeaderTimeout limits reading the headers of the plain HTTP requests which are only redirected.
+	redirectReadHeaderTimeout = 10 * time.Second
+	// defaultHTTPSPort is omitted from the redirect URLs.
+	defaultHTTPSPort = 443
+)
+
+// Modes of the client certificate authentication.
+const (
+	tlsClientAuthRequire  = "require"
+	tlsClientAuthOptional = "optional"
+)
+
+// validateTLSConfig checks the TLS configuration is either a certificate or ACME
+// and the client certificates are only verified if TLS is enabled.
+func validateTLSConfig(c *config.EverestConfig) error {
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return errors.New("both the TLS certificate and key files shall be set")
+	}
+	if c.TLSCertFile != "" && len(c.ACMEDomains) != 0 {
+		return errors.New("the TLS certificate files and the ACME domains cannot be set together")
+	}
+	if c.TLSClientCAFile == "" {
+		return nil
+	}
+	if !tlsEnabled(c) {
+		return errors.New("the client certificates can only be verified if TLS is enabled")
+	}
+	switch c.TLSClientAuth {
+	case tlsClientAuthRequire, tlsClientAuthOptional:
+	default:
+		return fmt.Errorf("the TLS client auth shall be either %s or %s", tlsClientAuthRequire, tlsClientAuthOptional)
+	}
+	switch c.ClientCertScope {
+	case model.APITokenScopeAdmin, model.APITokenScopeDashboard, model.APITokenScopeProject:
+	default:
+		return fmt.Errorf("the client certificate scope shall be one of %s, %s or %s",
+			model.APITokenScopeAdmin, model.APITokenScopeDashboard, model.APITokenScopeProject)
+	}
+	return nil
+}
+
+func tlsEnabled(c *config.EverestConfig) bool {
+	return c.TLSCertFile != "" || len(c.ACMEDomains) != 0
+}
+
+// startTLS serves the API over TLS with the certificate files or the certificate issued by ACME.
+// The plain HTTP port redirects to HTTPS and answers the ACME HTTP challenges.
+func (e *EverestServer) startTLS() error {
+	tlsConfig, err := e.tlsConfig()
+	if err != nil {
+		return err
+	}
+
+	acmeEnabled := len(e.config.ACMEDomains) != 0
+	if e.config.HTTPSRedirect || acmeEnabled {
+		var handler http.Handler = httpsRedirectHandler(e.config.HTTPSPort)
+		if acmeEnabled {
+			handler = e.echo.AutoTLSManager.HTTPHandler(handler)
+		}
+		e.redirectServer = &http.Server{
+			Addr:              fmt.Sprintf("0.0.0.0:%d", e.config.HTTPPort),
+			Handler:           handler,
+			ReadHeaderTimeout: redirectReadHeaderTimeout,
+		}
+		go func() {
+			if err := e.redirectServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				e.l.Error(errors.Join(err, errors.New("could not serve the HTTPS redirect")))
+			}
+		}()
+	}
+
+	s := e.echo.TLSServer
+	s.Addr = fmt.Sprintf("0.0.0.0:%d", e.config.HTTPSPort)
+	s.TLSConfig = tlsConfig
+	return e.echo.StartServer(s)
+}
+
+// tlsConfig returns the TLS configuration of the API listener.
+func (e *EverestServer) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+	if len(e.config.ACMEDomains) != 0 {
+		e.echo.AutoTLSManager = autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(e.config.ACMEDomains...),
+			Cache:      autocert.DirCache(e.config.ACMECacheDir),
+			Email:      e.config.ACMEEmail,
+		}
+		if e.config.ACMEDirectoryURL != "" {
+			e.echo.AutoTLSManager.Client = &acme.Client{DirectoryURL: e.config.ACMEDirectoryURL}
+		}
+		tlsConfig.GetCertificate = e.echo.AutoTLSManager.GetCertificate
+		tlsConfig.NextProtos = append(tlsConfig.NextProtos, acme.ALPNProto)
+	} else {
+		cert, err := tls.LoadX509KeyPair(e.config.TLSCertFile, e.config.TLSKeyFile)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not load the TLS certificate"))
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if e.config.TLSClientCAFile != "" {
+		pem, err := os.ReadFile(e.config.TLSClientCAFile)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("could not read the TLS client CA file"))
+		}
+		tlsConfig.ClientCAs = x509.NewCertPool()
+		if !tlsConfig.ClientCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("the TLS client CA file has no PEM encoded certificates")
+		}
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		if e.config.TLSClientAuth == tlsClientAuthOptional {
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+	return tlsConfig, nil
+}
+
+// clientCertIdentity returns the identity of the client authenticated with a verified certificate.
+func clientCertIdentity(state *tls.ConnectionState, scope string) (userIdentity, bool) {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return userIdentity{}, false
+	}
+	cert := state.VerifiedChains[0][0]
+	name := cert.Subject.CommonName
+	switch {
+	case name != "":
+	case len(cert.DNSNames) != 0:
+		name = cert.DNSNames[0]
+	case len(cert.EmailAddresses) != 0:
+		name = cert.EmailAddresses[0]
+	case len(cert.URIs) != 0:
+		name = cert.URIs[0].String()
+	default:
+		return userIdentity{}, false
+	}
+	return userIdentity{
+		Username:      "cert:" + name,
+		Groups:        cert.Subject.Organization,
+		ProjectScoped: scope == model.APITokenScopeProject,
+	}, true
+}
+
+// httpsRedirectHandler permanently redirects the requests to the same URL on the HTTPS port.
+func httpsRedirectHandler(httpsPort int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if httpsPort != defaultHTTPSPort {
+			host = net.JoinHostPort(host, strconv.Itoa(httpsPort))
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+	})
+}
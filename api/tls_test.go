@@ -0,0 +1,99 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/percona/percona-everest-backend/cmd/config"
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestValidateTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, validateTLSConfig(&config.EverestConfig{}))
+	assert.NoError(t, validateTLSConfig(&config.EverestConfig{TLSCertFile: "tls.crt", TLSKeyFile: "tls.key"}))
+	assert.NoError(t, validateTLSConfig(&config.EverestConfig{ACMEDomains: []string{"everest.example.com"}}))
+	assert.NoError(t, validateTLSConfig(&config.EverestConfig{
+		TLSCertFile: "tls.crt", TLSKeyFile: "tls.key", TLSClientCAFile: "ca.crt",
+		TLSClientAuth: tlsClientAuthOptional, ClientCertScope: model.APITokenScopeDashboard,
+	}))
+	assert.Error(t, validateTLSConfig(&config.EverestConfig{TLSCertFile: "tls.crt"}))
+	assert.Error(t, validateTLSConfig(&config.EverestConfig{TLSClientCAFile: "ca.crt"}))
+	assert.Error(t, validateTLSConfig(&config.EverestConfig{
+		TLSCertFile: "tls.crt", TLSKeyFile: "tls.key", TLSClientCAFile: "ca.crt",
+		TLSClientAuth: "never", ClientCertScope: model.APITokenScopeAdmin,
+	}))
+	assert.Error(t, validateTLSConfig(&config.EverestConfig{
+		TLSCertFile: "tls.crt", TLSKeyFile: "tls.key", ACMEDomains: []string{"everest.example.com"},
+	}))
+}
+
+func TestHTTPSRedirectHandler(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		port     int
+		host     string
+		location string
+	}{
+		{port: 443, host: "everest.example.com:8080", location: "https://everest.example.com/v1/kubernetes?limit=1"},
+		{port: 8443, host: "everest.example.com", location: "https://everest.example.com:8443/v1/kubernetes?limit=1"},
+		{port: 8443, host: "[::1]:8080", location: "https://[::1]:8443/v1/kubernetes?limit=1"},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/v1/kubernetes?limit=1", nil)
+		req.Host = tc.host
+		rec := httptest.NewRecorder()
+		httpsRedirectHandler(tc.port).ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+		assert.Equal(t, tc.location, rec.Header().Get("Location"))
+	}
+}
+
+func TestClientCertIdentity(t *testing.T) {
+	t.Parallel()
+
+	state := func(cert *x509.Certificate) *tls.ConnectionState {
+		return &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	}
+
+	id, ok := clientCertIdentity(state(&x509.Certificate{
+		Subject:  pkix.Name{CommonName: "ci-pipeline", Organization: []string{"platform"}},
+		DNSNames: []string{"ci.example.com"},
+	}), model.APITokenScopeAdmin)
+	assert.True(t, ok)
+	assert.Equal(t, userIdentity{Username: "cert:ci-pipeline", Groups: []string{"platform"}}, id)
+
+	id, ok = clientCertIdentity(state(&x509.Certificate{DNSNames: []string{"ci.example.com"}}), model.APITokenScopeProject)
+	assert.True(t, ok)
+	assert.Equal(t, userIdentity{Username: "cert:ci.example.com", ProjectScoped: true}, id)
+
+	_, ok = clientCertIdentity(state(&x509.Certificate{}), model.APITokenScopeAdmin)
+	assert.False(t, ok)
+	_, ok = clientCertIdentity(&tls.ConnectionState{}, model.APITokenScopeAdmin)
+	assert.False(t, ok)
+	_, ok = clientCertIdentity(nil, model.APITokenScopeAdmin)
+	assert.False(t, ok)
+}
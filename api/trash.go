@@ -0,0 +1,167 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+)
+
+// RestoreBackupStorage restores the specified backup storage from the trash.
+func (e *EverestServer) RestoreBackupStorage(ctx echo.Context, name string) error {
+	c := ctx.Request().Context()
+	if err := e.storage.RestoreBackupStorage(c, name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find backup storage in the trash")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not restore backup storage")})
+	}
+	bs, err := e.storage.GetBackupStorage(c, nil, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get backup storage")})
+	}
+	e.pushRestoredConfig(c, backupStorageSyncedConfig(bs))
+	e.emitWebhookEvent(ctx, BackupStorageRestored, "", "backup-storages/"+name)
+
+	return ctx.JSON(http.StatusOK, backupStorageToAPIJson(bs))
+}
+
+// RestoreMonitoringInstance restores the specified monitoring instance from the trash.
+func (e *EverestServer) RestoreMonitoringInstance(ctx echo.Context, name string) error {
+	c := ctx.Request().Context()
+	if err := e.storage.RestoreMonitoringInstance(c, name); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Could not find monitoring instance in the trash")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not restore monitoring instance")})
+	}
+	i, err := e.storage.GetMonitoringInstance(c, name)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get monitoring instance")})
+	}
+	e.pushRestoredConfig(c, monitoringInstanceSyncedConfig(i))
+	e.emitWebhookEvent(ctx, MonitoringInstanceRestored, "", "monitoring-instances/"+name)
+
+	return ctx.JSON(http.StatusOK, e.monitoringInstanceToAPIJson(i))
+}
+
+// pushRestoredConfig cancels the pending deletions of a config restored from the trash and pushes it
+// to all the Kubernetes clusters again since it may have been deleted from them meanwhile.
+// The failures are recorded per Kubernetes cluster and retried by the config syncer.
+func (e *EverestServer) pushRestoredConfig(ctx context.Context, cfg syncedConfig) {
+	if err := e.storage.DeleteConfigDeletions(ctx, cfg.kind, cfg.name); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not delete pending config deletions")))
+	}
+	if _, err := e.syncConfig(ctx, cfg, true); err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not sync restored config")))
+	}
+}
+
+// checkBackupStorageNotInTrash returns an error if a backup storage with the name is in the trash.
+// Its name cannot be reused until it is restored or purged.
+func (e *EverestServer) checkBackupStorageNotInTrash(ctx context.Context, name string) (int, error) {
+	_, err := e.storage.GetDeletedBackupStorage(ctx, name)
+	if err == nil {
+		return http.StatusConflict, fmt.Errorf("backup storage %s is in the trash, restore it or wait until it is purged", name)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not get backup storage from the trash")
+	}
+	return 0, nil
+}
+
+// checkMonitoringInstanceNotInTrash returns an error if a monitoring instance with the name is in the trash.
+// Its name cannot be reused until it is restored or purged.
+func (e *EverestServer) checkMonitoringInstanceNotInTrash(ctx context.Context, name string) (int, error) {
+	_, err := e.storage.GetDeletedMonitoringInstance(ctx, name)
+	if err == nil {
+		return http.StatusConflict, fmt.Errorf("monitoring instance %s is in the trash, restore it or wait until it is purged", name)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return http.StatusInternalServerError, errors.New("could not get monitoring instance from the trash")
+	}
+	return 0, nil
+}
+
+// runTrashPurger periodically purges the backup storages and monitoring instances kept in the trash
+// longer than the retention period until the context is canceled.
+func (e *EverestServer) runTrashPurger(ctx context.Context) {
+	defer e.waitGroup.Done()
+
+	ticker := time.NewTicker(e.config.TrashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		// The standby instance leaves it to the primary one.
+		if !e.isStandby() {
+			e.purgeTrash(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *EverestServer) purgeTrash(ctx context.Context) {
+	before := time.Now().Add(-e.config.TrashRetention)
+
+	storages, err := e.storage.ListBackupStoragesDeletedBefore(ctx, before)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list backup storages in the trash")))
+		return
+	}
+	for _, bs := range storages {
+		bs := bs
+		err := e.storage.Transaction(func(tx *gorm.DB) error {
+			return e.purgeBackupStorage(ctx, &bs, tx)
+		})
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not purge backup storage %s", bs.Name)))
+		}
+	}
+
+	instances, err := e.storage.ListMonitoringInstancesDeletedBefore(ctx, before)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list monitoring instances in the trash")))
+		return
+	}
+	for _, i := range instances {
+		i := i
+		err := e.storage.Transaction(func(tx *gorm.DB) error {
+			return e.purgeMonitoringConfig(ctx, &i, tx)
+		})
+		if err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not purge monitoring instance %s", i.Name)))
+		}
+	}
+}
@@ -0,0 +1,347 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/AlekSi/pointer"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/percona/percona-everest-backend/model"
+	"github.com/percona/percona-everest-backend/pkg/kubernetes"
+)
+
+const (
+	backupStorageAccessKeyName = "AWS_ACCESS_KEY_ID"
+	backupStorageSecretKeyName = "AWS_SECRET_ACCESS_KEY" //nolint:gosec
+	monitoringAPIKeyName       = "apiKey"
+)
+
+// ListUnmanagedConfigs lists BackupStorages and MonitoringConfigs which exist in the Kubernetes cluster
+// but are not stored in Everest.
+func (e *EverestServer) ListUnmanagedConfigs(ctx echo.Context, kubernetesID string) error {
+	c := ctx.Request().Context()
+	k, kubeClient, code, err := e.initKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	storages, err := e.unmanagedBackupStorages(c, kubeClient, k.Namespace)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list backup storages")})
+	}
+	configs, err := e.unmanagedMonitoringConfigs(c, kubeClient)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list monitoring configs")})
+	}
+
+	result := UnmanagedConfigs{
+		BackupStorages:      make([]UnmanagedBackupStorage, 0, len(storages)),
+		MonitoringInstances: make([]UnmanagedMonitoringInstance, 0, len(configs)),
+	}
+	for _, bs := range storages {
+		secretName := bs.Spec.CredentialsSecretName
+		result.BackupStorages = append(result.BackupStorages, UnmanagedBackupStorage{
+			Name:                  bs.Name,
+			Type:                  string(bs.Spec.Type),
+			BucketName:            bs.Spec.Bucket,
+			Region:                bs.Spec.Region,
+			Url:                   pointer.ToString(bs.Spec.EndpointURL),
+			CredentialsSecretName: secretName,
+			CredentialsFound:      hasSecretKeys(c, kubeClient, secretName, k.Namespace, backupStorageAccessKeyName, backupStorageSecretKeyName),
+		})
+	}
+	for _, mc := range configs {
+		secretName := mc.Spec.CredentialsSecretName
+		result.MonitoringInstances = append(result.MonitoringInstances, UnmanagedMonitoringInstance{
+			Name:                  mc.Name,
+			Type:                  string(mc.Spec.Type),
+			Url:                   mc.Spec.PMM.URL,
+			CredentialsSecretName: secretName,
+			CredentialsFound:      hasSecretKeys(c, kubeClient, secretName, k.Namespace, monitoringAPIKeyName),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// ImportUnmanagedConfigs imports BackupStorages and MonitoringConfigs existing in the Kubernetes cluster
+// into Everest. Configs are imported one by one, so the ones imported before a failure stay imported.
+func (e *EverestServer) ImportUnmanagedConfigs(ctx echo.Context, kubernetesID string) error {
+	params := ImportUnmanagedConfigsParams{}
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	c := ctx.Request().Context()
+	k, kubeClient, code, err := e.initKubeClient(c, kubernetesID)
+	if err != nil {
+		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+	}
+
+	result := ImportedConfigs{
+		BackupStorages:      make([]BackupStorage, 0, len(params.BackupStorages)),
+		MonitoringInstances: make([]MonitoringInstance, 0, len(params.MonitoringInstances)),
+	}
+	for _, p := range params.BackupStorages {
+		bs, code, err := e.importBackupStorage(c, kubeClient, k.Namespace, p, creatorFrom(ctx))
+		if err != nil {
+			return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+		}
+		result.BackupStorages = append(result.BackupStorages, backupStorageToAPIJson(bs))
+	}
+	for _, p := range params.MonitoringInstances {
+		i, code, err := e.importMonitoringConfig(c, kubeClient, k.Namespace, p, creatorFrom(ctx))
+		if err != nil {
+			return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
+		}
+		result.MonitoringInstances = append(result.MonitoringInstances, *e.monitoringInstanceToAPIJson(i))
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func (e *EverestServer) unmanagedBackupStorages(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, namespace string,
+) ([]everestv1alpha1.BackupStorage, error) {
+	list, err := kubeClient.ListBackupStorages(ctx, namespace)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list backup storages in Kubernetes"))
+	}
+	managed, _, err := e.storage.ListBackupStorages(ctx, model.ListBackupStoragesParams{})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list backup storages"))
+	}
+	names := make(map[string]struct{}, len(managed))
+	for _, bs := range managed {
+		names[bs.Name] = struct{}{}
+	}
+
+	res := make([]everestv1alpha1.BackupStorage, 0, len(list.Items))
+	for _, bs := range list.Items {
+		if _, ok := names[bs.Name]; !ok {
+			res = append(res, bs)
+		}
+	}
+	return res, nil
+}
+
+func (e *EverestServer) unmanagedMonitoringConfigs(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes,
+) ([]everestv1alpha1.MonitoringConfig, error) {
+	list, err := kubeClient.ListMonitoringConfigs(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list monitoring configs in Kubernetes"))
+	}
+	managed, _, err := e.storage.ListMonitoringInstances(ctx, model.ListMonitoringInstancesParams{})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list monitoring instances"))
+	}
+	names := make(map[string]struct{}, len(managed))
+	for _, i := range managed {
+		names[i.Name] = struct{}{}
+	}
+
+	res := make([]everestv1alpha1.MonitoringConfig, 0, len(list.Items))
+	for _, mc := range list.Items {
+		if _, ok := names[mc.Name]; !ok {
+			res = append(res, mc)
+		}
+	}
+	return res, nil
+}
+
+// hasSecretKeys returns true if the secret exists and contains all the provided keys.
+func hasSecretKeys(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, name, namespace string, keys ...string,
+) bool {
+	_, err := secretValues(ctx, kubeClient, name, namespace, keys...)
+	return err == nil
+}
+
+// secretValues returns the values of the provided keys from a secret.
+func secretValues(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, name, namespace string, keys ...string,
+) (map[string]string, error) {
+	if name == "" {
+		return nil, errors.New("no credentials secret is referenced")
+	}
+	secret, err := kubeClient.GetSecret(ctx, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string, len(keys))
+	for _, key := range keys {
+		value, ok := secret.Data[key]
+		if !ok || len(value) == 0 {
+			return nil, fmt.Errorf("secret %s does not contain %s", name, key)
+		}
+		res[key] = string(value)
+	}
+	return res, nil
+}
+
+func (e *EverestServer) importBackupStorage(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, namespace string, params ImportBackupStorageParams, createdBy string,
+) (*model.BackupStorage, int, error) {
+	existing, err := e.storage.GetBackupStorage(ctx, nil, params.Name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not get backup storage")
+	}
+	if existing != nil {
+		return nil, http.StatusConflict, fmt.Errorf("backup storage %s is already managed by Everest", params.Name)
+	}
+	if code, err := e.checkBackupStorageNotInTrash(ctx, params.Name); err != nil {
+		return nil, code, err
+	}
+
+	bs, err := kubeClient.GetBackupStorage(ctx, params.Name, namespace)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, http.StatusNotFound, fmt.Errorf("backup storage %s not found in the Kubernetes cluster", params.Name)
+		}
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not get backup storage from Kubernetes")
+	}
+
+	accessKey, secretKey := params.AccessKey, params.SecretKey
+	if accessKey == nil || secretKey == nil {
+		values, err := secretValues(ctx, kubeClient, bs.Spec.CredentialsSecretName, namespace, backupStorageAccessKeyName, backupStorageSecretKeyName)
+		if err != nil {
+			e.l.Error(err)
+			return nil, http.StatusBadRequest, fmt.Errorf("could not capture the credentials of backup storage %s, please provide accessKey and secretKey", params.Name)
+		}
+		if accessKey == nil {
+			accessKey = pointer.ToString(values[backupStorageAccessKeyName])
+		}
+		if secretKey == nil {
+			secretKey = pointer.ToString(values[backupStorageSecretKeyName])
+		}
+	}
+
+	var accessKeyID, secretKeyID *string
+	defer func() {
+		e.cleanUpNewSecretsOnUpdateError(err, accessKeyID, secretKeyID)
+	}()
+
+	accessKeyID, secretKeyID, err = e.createSecrets(ctx, accessKey, secretKey)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	s, err := e.createBackupStorage(ctx, &CreateBackupStorageParams{
+		Name:        bs.Name,
+		Description: params.Description,
+		Type:        CreateBackupStorageParamsType(bs.Spec.Type),
+		BucketName:  bs.Spec.Bucket,
+		Region:      bs.Spec.Region,
+		Url:         pointer.ToString(bs.Spec.EndpointURL),
+	}, backupStorageSecretIDs{accessKey: accessKeyID, secretKey: secretKeyID}, nil, createdBy)
+	if err != nil {
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not create a new backup storage")
+	}
+
+	if err = kubeClient.AdoptBackupStorage(ctx, bs, s, e.secretsStorage.GetSecret); err != nil {
+		e.l.Error(err)
+		if dErr := e.storage.PurgeBackupStorage(ctx, s.Name, nil); dErr != nil {
+			e.l.Error(errors.Join(dErr, fmt.Errorf("could not delete backup storage %s", s.Name)))
+		}
+		return nil, http.StatusInternalServerError, errors.New("could not update backup storage in Kubernetes")
+	}
+
+	return s, http.StatusOK, nil
+}
+
+func (e *EverestServer) importMonitoringConfig(
+	ctx context.Context, kubeClient *kubernetes.Kubernetes, namespace string, params ImportMonitoringInstanceParams, createdBy string,
+) (*model.MonitoringInstance, int, error) {
+	existing, err := e.storage.GetMonitoringInstance(ctx, params.Name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not get monitoring instance")
+	}
+	if existing != nil {
+		return nil, http.StatusConflict, fmt.Errorf("monitoring instance %s is already managed by Everest", params.Name)
+	}
+	if code, err := e.checkMonitoringInstanceNotInTrash(ctx, params.Name); err != nil {
+		return nil, code, err
+	}
+
+	mc, err := kubeClient.GetMonitoringConfig(ctx, params.Name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, http.StatusNotFound, fmt.Errorf("monitoring config %s not found in the Kubernetes cluster", params.Name)
+		}
+		e.l.Error(err)
+		return nil, http.StatusInternalServerError, errors.New("could not get monitoring config from Kubernetes")
+	}
+	if mc.Spec.Type != everestv1alpha1.PMMMonitoringType {
+		return nil, http.StatusBadRequest, fmt.Errorf("monitoring config %s has unsupported type %s", params.Name, mc.Spec.Type)
+	}
+
+	apiKey := pointer.GetString(params.ApiKey)
+	if apiKey == "" {
+		values, err := secretValues(ctx, kubeClient, mc.Spec.CredentialsSecretName, namespace, monitoringAPIKeyName)
+		if err != nil {
+			e.l.Error(err)
+			return nil, http.StatusBadRequest, fmt.Errorf("could not capture the API key of monitoring config %s, please provide apiKey", params.Name)
+		}
+		apiKey = values[monitoringAPIKeyName]
+	}
+
+	apiKeyID, err := e.createAndStorePMMApiKey(ctx, mc.Name, mc.Spec.PMM.URL, apiKey, "", "")
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	i, err := e.storage.CreateMonitoringInstance(ctx, &model.MonitoringInstance{
+		Type:           model.MonitoringInstanceType(mc.Spec.Type),
+		Name:           mc.Name,
+		URL:            mc.Spec.PMM.URL,
+		APIKeySecretID: apiKeyID,
+		CreatedBy:      createdBy,
+	})
+	if err != nil {
+		e.l.Error(err)
+		if _, err := e.secretsStorage.DeleteSecret(ctx, apiKeyID); err != nil {
+			e.l.Warnf("Could not delete secret %s from secret storage due to error: %s", apiKeyID, err)
+		}
+		return nil, http.StatusInternalServerError, errors.New("could not save monitoring instance")
+	}
+
+	if err := kubeClient.AdoptMonitoringConfig(ctx, mc, i, e.secretsStorage.GetSecret); err != nil {
+		e.l.Error(err)
+		if dErr := e.purgeMonitoringConfig(ctx, i, nil); dErr != nil {
+			e.l.Error(errors.Join(dErr, fmt.Errorf("could not delete monitoring instance %s", i.Name)))
+		}
+		return nil, http.StatusInternalServerError, errors.New("could not update monitoring config in Kubernetes")
+	}
+
+	return i, http.StatusOK, nil
+}
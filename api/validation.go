@@ -17,6 +17,8 @@ package api
 
 import (
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net/http"
@@ -25,6 +27,7 @@ import (
 
 	"github.com/AlekSi/pointer"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -63,6 +66,15 @@ var (
 	errNoNameInSchedule      = errors.New("'name' field for the backup schedules cannot be empty")
 	errNoBackupStorageName   = errors.New("'backupStorageName' field cannot be empty when schedule is enabled")
 	errNoResourceDefined     = errors.New("please specify resource limits for the cluster")
+	errStaticKeysRequired    = errors.New("accessKey and secretKey are required for the static credentials")
+	errIAMWithKeys           = errors.New("accessKey and secretKey cannot be set for the iam credentials")
+	errSTSWithKeys           = errors.New("accessKey, secretKey and sessionToken cannot be set for the sts credentials")
+	errRoleARNRequired       = errors.New("roleArn is required for the sts credentials")
+	errRoleARNWithoutSTS     = errors.New("roleArn can be set for the sts credentials only")
+	errInvalidCACert         = errors.New("caCert contains no valid PEM encoded certificates")
+	errPrometheusAuthBoth    = errors.New("either prometheus.username and prometheus.password or prometheus.bearerToken can be set")
+	errPrometheusBasicAuth   = errors.New("both prometheus.username and prometheus.password are required for basic auth")
+	errPrometheusNoAuth      = errors.New("one of prometheus.username and prometheus.password or prometheus.bearerToken is required")
 	//nolint:gochecknoglobals
 	operatorEngine = map[everestv1alpha1.EngineType]string{
 		everestv1alpha1.DatabaseEnginePXC:        pxcDeploymentName,
@@ -120,15 +132,81 @@ func validateURL(urlStr string) bool {
 	return err == nil
 }
 
+// validateS3Endpoint checks the endpoint of an S3-compatible storage is an absolute http or https URL.
+// A host with a port but without a scheme, like minio:9000, is parsed as the minio scheme otherwise.
+// An empty endpoint stands for AWS.
+func validateS3Endpoint(endpoint string) error {
+	if endpoint == "" {
+		return nil
+	}
+	u, err := url.ParseRequestURI(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidURL("url")
+	}
+	return nil
+}
+
 func validateStorageAccessByCreate(params CreateBackupStorageParams, l *zap.SugaredLogger) error {
 	switch params.Type { //nolint:exhaustive
 	case CreateBackupStorageParamsTypeS3:
-		return s3Access(l, params.Url, params.AccessKey, params.SecretKey, params.BucketName, params.Region)
+		switch credentialSourceOf(params) {
+		case model.CredentialSourceIAM:
+			// The pod identity belongs to the database clusters so the access cannot be checked from here.
+			return nil
+		case model.CredentialSourceSTS:
+			// The access is checked once the role is assumed.
+			return nil
+		}
+		opts, err := s3OptionsOf(params)
+		if err != nil {
+			return err
+		}
+		return s3Access(
+			l, params.Url, *params.AccessKey, *params.SecretKey, pointer.GetString(params.SessionToken),
+			params.BucketName, params.Region, opts,
+		)
 	default:
 		return ErrCreateStorageNotSupported(string(params.Type))
 	}
 }
 
+// validateCredentialSource checks the keys are set for the static credentials only
+// and the role is set for the sts credentials only.
+func validateCredentialSource(params CreateBackupStorageParams) error {
+	source := credentialSourceOf(params)
+	if params.RoleArn != nil && source != model.CredentialSourceSTS {
+		return errRoleARNWithoutSTS
+	}
+	switch source {
+	case model.CredentialSourceStatic:
+		if params.AccessKey == nil || params.SecretKey == nil {
+			return errStaticKeysRequired
+		}
+	case model.CredentialSourceIAM:
+		if params.AccessKey != nil || params.SecretKey != nil || params.SessionToken != nil {
+			return errIAMWithKeys
+		}
+	case model.CredentialSourceSTS:
+		if params.AccessKey != nil || params.SecretKey != nil || params.SessionToken != nil {
+			return errSTSWithKeys
+		}
+		if pointer.GetString(params.RoleArn) == "" {
+			return errRoleARNRequired
+		}
+	default:
+		return fmt.Errorf("'credentialSource' shall be one of %s, %s or %s",
+			model.CredentialSourceStatic, model.CredentialSourceIAM, model.CredentialSourceSTS)
+	}
+	return nil
+}
+
+func credentialSourceOf(params CreateBackupStorageParams) string {
+	if params.CredentialSource == nil || *params.CredentialSource == "" {
+		return model.CredentialSourceStatic
+	}
+	return *params.CredentialSource
+}
+
 func validateStorageAccessByUpdate(oldData *storageData, params UpdateBackupStorageParams, l *zap.SugaredLogger) error {
 	endpoint := &oldData.storage.URL
 	if params.Url != nil {
@@ -145,6 +223,11 @@ func validateStorageAccessByUpdate(oldData *storageData, params UpdateBackupStor
 		secretKey = *params.SecretKey
 	}
 
+	sessionToken := oldData.sessionToken
+	if params.SessionToken != nil {
+		sessionToken = *params.SessionToken
+	}
+
 	bucketName := oldData.storage.BucketName
 	if params.BucketName != nil {
 		bucketName = *params.BucketName
@@ -155,46 +238,118 @@ func validateStorageAccessByUpdate(oldData *storageData, params UpdateBackupStor
 		region = *params.Region
 	}
 
+	caCert := oldData.caCert
+	if params.CaCert != nil {
+		caCert = *params.CaCert
+	}
+
+	verifyTLS := !oldData.storage.SkipTLSVerify
+	if params.VerifyTLS != nil {
+		verifyTLS = *params.VerifyTLS
+	}
+	tlsConfig, err := s3TLSConfig(caCert, verifyTLS)
+	if err != nil {
+		return err
+	}
+
+	opts := s3Options{tlsConfig: tlsConfig, forcePathStyle: oldData.storage.ForcePathStyle}
+	if params.ForcePathStyle != nil {
+		opts.forcePathStyle = *params.ForcePathStyle
+	}
+
 	switch oldData.storage.Type {
 	case string(BackupStorageTypeS3):
-		return s3Access(l, endpoint, accessKey, secretKey, bucketName, region)
+		switch oldData.storage.CredentialSource {
+		case model.CredentialSourceIAM:
+			if params.AccessKey != nil || params.SecretKey != nil || params.SessionToken != nil {
+				return errIAMWithKeys
+			}
+			return nil
+		case model.CredentialSourceSTS:
+			if params.AccessKey != nil || params.SecretKey != nil || params.SessionToken != nil {
+				return errSTSWithKeys
+			}
+		}
+		return s3Access(l, endpoint, accessKey, secretKey, sessionToken, bucketName, region, opts)
 	default:
 		return ErrUpdateStorageNotSupported(oldData.storage.Type)
 	}
 }
 
 type storageData struct {
-	accessKey string
-	secretKey string
-	storage   model.BackupStorage
+	accessKey    string
+	secretKey    string
+	sessionToken string
+	caCert       string
+	storage      model.BackupStorage
 }
 
-func s3Access(l *zap.SugaredLogger, endpoint *string, accessKey, secretKey, bucketName, region string) error {
-	if config.Debug {
-		return nil
+// s3Options are the options of the client accessing an S3-compatible storage.
+type s3Options struct {
+	tlsConfig      *tls.Config
+	forcePathStyle bool
+	// createBucket creates the bucket if it does not exist, with versioning if bucketVersioning is set.
+	createBucket     bool
+	bucketVersioning bool
+}
+
+func s3OptionsOf(params CreateBackupStorageParams) (s3Options, error) {
+	tlsConfig, err := s3TLSConfig(pointer.GetString(params.CaCert), params.VerifyTLS == nil || *params.VerifyTLS)
+	if err != nil {
+		return s3Options{}, err
+	}
+	return s3Options{
+		tlsConfig:        tlsConfig,
+		forcePathStyle:   pointer.GetBool(params.ForcePathStyle),
+		createBucket:     pointer.GetBool(params.CreateBucketIfMissing),
+		bucketVersioning: pointer.GetBool(params.BucketVersioning),
+	}, nil
+}
+
+// s3TLSConfig returns the TLS config to access an S3-compatible storage with the custom CA bundle
+// or without the certificate verification. It is nil for the default TLS config.
+func s3TLSConfig(caCert string, verifyTLS bool) (*tls.Config, error) {
+	if caCert == "" && verifyTLS {
+		return nil, nil //nolint:nilnil
 	}
 
-	if endpoint != nil && *endpoint == "" {
-		endpoint = nil
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: !verifyTLS, //nolint:gosec
+	}
+	if caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, errInvalidCACert
+		}
+		cfg.RootCAs = pool
 	}
+	return cfg, nil
+}
 
-	// Create a new session with the provided credentials
-	sess, err := session.NewSession(&aws.Config{
-		Endpoint:    endpoint,
-		Region:      aws.String(region),
-		Credentials: credentials.NewStaticCredentials(accessKey, secretKey, ""),
-	})
+func s3Access(
+	l *zap.SugaredLogger, endpoint *string, accessKey, secretKey, sessionToken, bucketName, region string, opts s3Options,
+) error {
+	if config.Debug {
+		return nil
+	}
+
+	svc, err := newS3Client(endpoint, credentials.NewStaticCredentials(accessKey, secretKey, sessionToken), region, opts)
 	if err != nil {
 		l.Error(err)
 		return errors.New("could not initialize S3 session")
 	}
 
-	// Create a new S3 client with the session
-	svc := s3.New(sess)
-
 	_, err = svc.HeadBucket(&s3.HeadBucketInput{
 		Bucket: aws.String(bucketName),
 	})
+	if err != nil && opts.createBucket && isS3NotFound(err) {
+		err = createS3Bucket(svc, bucketName, region, opts.bucketVersioning)
+		if err != nil {
+			l.Error(err)
+			return errors.New("could not create S3 bucket")
+		}
+	}
 	if err != nil {
 		l.Error(err)
 		return errors.New("unable to connect to s3. Check your credentials")
@@ -232,6 +387,64 @@ func s3Access(l *zap.SugaredLogger, endpoint *string, accessKey, secretKey, buck
 	return nil
 }
 
+// newS3Client returns a client of an S3-compatible storage. The nil credentials stand for the default
+// credential chain of Everest itself.
+func newS3Client(endpoint *string, creds *credentials.Credentials, region string, opts s3Options) (*s3.S3, error) {
+	if endpoint != nil && *endpoint == "" {
+		endpoint = nil
+	}
+
+	awsConfig := &aws.Config{
+		Endpoint:         endpoint,
+		Region:           aws.String(region),
+		Credentials:      creds,
+		S3ForcePathStyle: aws.Bool(opts.forcePathStyle),
+	}
+	if opts.tlsConfig != nil {
+		awsConfig.HTTPClient = &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: opts.tlsConfig,
+			},
+		}
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return s3.New(sess), nil
+}
+
+// isS3NotFound returns true if the error is the response to a request for a missing bucket.
+func isS3NotFound(err error) bool {
+	var reqErr awserr.RequestFailure
+	return errors.As(err, &reqErr) && reqErr.StatusCode() == http.StatusNotFound
+}
+
+// createS3Bucket creates the bucket in the region. The region is not set as the location constraint
+// for us-east-1 since S3 rejects it there.
+func createS3Bucket(svc *s3.S3, bucketName, region string, versioning bool) error {
+	input := &s3.CreateBucketInput{Bucket: aws.String(bucketName)}
+	if region != "" && region != "us-east-1" {
+		input.CreateBucketConfiguration = &s3.CreateBucketConfiguration{LocationConstraint: aws.String(region)}
+	}
+	if _, err := svc.CreateBucket(input); err != nil {
+		return err
+	}
+	if err := svc.WaitUntilBucketExists(&s3.HeadBucketInput{Bucket: aws.String(bucketName)}); err != nil {
+		return err
+	}
+	if !versioning {
+		return nil
+	}
+	_, err := svc.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(bucketName),
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(s3.BucketVersioningStatusEnabled)},
+	})
+	return err
+}
+
 func validateUpdateBackupStorageRequest(ctx echo.Context) (*UpdateBackupStorageParams, error) {
 	var params UpdateBackupStorageParams
 	if err := ctx.Bind(&params); err != nil {
@@ -239,8 +452,7 @@ func validateUpdateBackupStorageRequest(ctx echo.Context) (*UpdateBackupStorageP
 	}
 
 	if params.Url != nil {
-		if ok := validateURL(*params.Url); !ok {
-			err := ErrInvalidURL("url")
+		if err := validateS3Endpoint(*params.Url); err != nil {
 			return nil, err
 		}
 	}
@@ -259,12 +471,19 @@ func validateCreateBackupStorageRequest(ctx echo.Context, l *zap.SugaredLogger)
 	}
 
 	if params.Url != nil {
-		if ok := validateURL(*params.Url); !ok {
-			err := ErrInvalidURL("url")
+		if err := validateS3Endpoint(*params.Url); err != nil {
 			return nil, err
 		}
 	}
 
+	if err := validateCredentialSource(params); err != nil {
+		return nil, err
+	}
+
+	if _, err := s3TLSConfig(pointer.GetString(params.CaCert), true); err != nil {
+		return nil, err
+	}
+
 	// check data access
 	if err := validateStorageAccessByCreate(params, l); err != nil {
 		l.Error(err)
@@ -297,6 +516,14 @@ func validateCreateMonitoringInstanceRequest(ctx echo.Context) (*CreateMonitorin
 		if params.Pmm.ApiKey == "" && params.Pmm.User == "" && params.Pmm.Password == "" {
 			return nil, errors.New("one of pmm.apiKey, pmm.user or pmm.password fields is required")
 		}
+	case MonitoringInstanceCreateParamsTypePrometheus:
+		if params.Prometheus == nil {
+			return nil, fmt.Errorf("prometheus key is required for type %s", params.Type)
+		}
+
+		if err := validatePrometheusMonitoringInstanceSpec(params.Prometheus); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("monitoring type %s is not supported", params.Type)
 	}
@@ -325,9 +552,29 @@ func validateUpdateMonitoringInstanceRequest(ctx echo.Context) (*UpdateMonitorin
 		return nil, errors.New("one of pmm.apiKey, pmm.user or pmm.password fields is required")
 	}
 
+	if params.Prometheus != nil {
+		if err := validatePrometheusMonitoringInstanceSpec(params.Prometheus); err != nil {
+			return nil, err
+		}
+	}
+
 	return &params, nil
 }
 
+func validatePrometheusMonitoringInstanceSpec(spec *PrometheusMonitoringInstanceSpec) error {
+	basicAuth := spec.Username != "" || spec.Password != ""
+	switch {
+	case basicAuth && spec.BearerToken != "":
+		return errPrometheusAuthBoth
+	case basicAuth && (spec.Username == "" || spec.Password == ""):
+		return errPrometheusBasicAuth
+	case !basicAuth && spec.BearerToken == "":
+		return errPrometheusNoAuth
+	}
+
+	return nil
+}
+
 func validateUpdateMonitoringInstanceType(params UpdateMonitoringInstanceJSONRequestBody) error {
 	switch params.Type {
 	case "":
@@ -336,6 +583,10 @@ func validateUpdateMonitoringInstanceType(params UpdateMonitoringInstanceJSONReq
 		if params.Pmm == nil {
 			return fmt.Errorf("pmm key is required for type %s", params.Type)
 		}
+	case MonitoringInstanceUpdateParamsTypePrometheus:
+		if params.Prometheus == nil {
+			return fmt.Errorf("prometheus key is required for type %s", params.Type)
+		}
 	default:
 		return errors.New("this monitoring type is not supported")
 	}
@@ -363,7 +614,7 @@ func validateCreateDatabaseClusterRequest(dbc DatabaseCluster) error {
 }
 
 func (e *EverestServer) validateDBClusterAccess(ctx echo.Context, kubernetesID, dbClusterName string) error {
-	_, kubeClient, code, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
+	_, kubeClient, code, err := e.initDatabaseClusterKubeClient(ctx.Request().Context(), kubernetesID)
 	if err != nil {
 		return ctx.JSON(code, Error{Message: pointer.ToString(err.Error())})
 	}
@@ -385,21 +636,24 @@ func (e *EverestServer) validateDatabaseClusterCR(ctx echo.Context, kubernetesID
 		return err
 	}
 
-	_, kubeClient, _, err := e.initKubeClient(ctx.Request().Context(), kubernetesID)
-	if err != nil {
-		return err
-	}
 	engineName, ok := operatorEngine[everestv1alpha1.EngineType(databaseCluster.Spec.Engine.Type)]
 	if !ok {
 		return errors.New("unsupported database engine")
 	}
-	engine, err := kubeClient.GetDatabaseEngine(ctx.Request().Context(), engineName)
+	cached, err := e.cachedDatabaseEngine(ctx.Request().Context(), kubernetesID, engineName)
+	if err != nil {
+		return err
+	}
+	engine, err := cached.K8sResource("")
 	if err != nil {
 		return err
 	}
 	if err := validateVersion(databaseCluster.Spec.Engine.Version, engine); err != nil {
 		return err
 	}
+	if err := e.validateVersionService(ctx.Request().Context(), databaseCluster.Spec.Engine.Version, engine); err != nil {
+		return err
+	}
 	if databaseCluster.Spec.Proxy != nil && databaseCluster.Spec.Proxy.Type != nil {
 		if err := validateProxy(databaseCluster.Spec.Engine.Type, string(*databaseCluster.Spec.Proxy.Type)); err != nil {
 			return err
@@ -408,7 +662,13 @@ func (e *EverestServer) validateDatabaseClusterCR(ctx echo.Context, kubernetesID
 	if err := validateBackupSpec(databaseCluster); err != nil {
 		return err
 	}
-	return validateResourceLimits(databaseCluster)
+	if err := validateResourceLimits(databaseCluster); err != nil {
+		return err
+	}
+	if err := validateSizingPreset(databaseCluster); err != nil {
+		return err
+	}
+	return e.validateGuardrails(ctx.Request().Context(), kubernetesID, databaseCluster)
 }
 
 func validateVersion(version *string, engine *everestv1alpha1.DatabaseEngine) error {
@@ -472,6 +732,11 @@ func validateBackupSpec(cluster *DatabaseCluster) error {
 		if schedule.Enabled && schedule.BackupStorageName == "" {
 			return errNoBackupStorageName
 		}
+		if schedule.TimeZone != nil {
+			if err := validateTimeZone(*schedule.TimeZone); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
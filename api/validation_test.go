@@ -15,11 +15,21 @@
 package api
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
+	"math/big"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/AlekSi/pointer"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -513,3 +523,250 @@ func TestValidateResourceLimits(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSizingPreset(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		cluster []byte
+		err     error
+	}{
+		{
+			name:    "no preset label",
+			cluster: []byte(`{"metadata": {"name": "db"}, "spec": {"engine": {"type": "pxc", "replicas": 2, "resources": {"cpu": "600m", "memory": "1G"}, "storage": {"size": "2G"}}}}`),
+			err:     nil,
+		},
+		{
+			name:    "matches preset",
+			cluster: []byte(`{"metadata": {"name": "db", "labels": {"everest.percona.com/sizing-preset": "medium"}}, "spec": {"engine": {"type": "psmdb", "replicas": 3, "resources": {"cpu": "4000m", "memory": "8G"}, "storage": {"size": "100G"}}}}`),
+			err:     nil,
+		},
+		{
+			name:    "unknown preset",
+			cluster: []byte(`{"metadata": {"name": "db", "labels": {"everest.percona.com/sizing-preset": "huge"}}, "spec": {"engine": {"type": "pxc", "replicas": 3, "resources": {"cpu": "4", "memory": "8G"}, "storage": {"size": "100G"}}}}`),
+			err:     errors.New("unknown sizing preset 'huge' for engine type 'pxc'"),
+		},
+		{
+			name:    "wrong replicas",
+			cluster: []byte(`{"metadata": {"name": "db", "labels": {"everest.percona.com/sizing-preset": "small"}}, "spec": {"engine": {"type": "pxc", "replicas": 3, "resources": {"cpu": "1", "memory": "2G"}, "storage": {"size": "25G"}}}}`),
+			err:     errors.New("sizing preset 'small' requires 1 replicas"),
+		},
+		{
+			name:    "wrong memory",
+			cluster: []byte(`{"metadata": {"name": "db", "labels": {"everest.percona.com/sizing-preset": "large"}}, "spec": {"engine": {"type": "postgresql", "replicas": 5, "resources": {"cpu": "8", "memory": "16G"}, "storage": {"size": "200G"}}}}`),
+			err:     errors.New("sizing preset 'large' requires memory of 32G"),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cluster := &DatabaseCluster{}
+			err := json.Unmarshal(tc.cluster, cluster)
+			require.NoError(t, err)
+			err = validateSizingPreset(cluster)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tc.err.Error(), err.Error())
+		})
+	}
+}
+
+func TestValidateCredentialSource(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		params []byte
+		err    error
+	}{
+		{
+			name:   "static by default",
+			params: []byte(`{"accessKey": "a", "secretKey": "s"}`),
+			err:    nil,
+		},
+		{
+			name:   "errStaticKeysRequired",
+			params: []byte(`{"credentialSource": "static", "accessKey": "a"}`),
+			err:    errStaticKeysRequired,
+		},
+		{
+			name:   "iam without keys",
+			params: []byte(`{"credentialSource": "iam"}`),
+			err:    nil,
+		},
+		{
+			name:   "errIAMWithKeys",
+			params: []byte(`{"credentialSource": "iam", "secretKey": "s"}`),
+			err:    errIAMWithKeys,
+		},
+		{
+			name:   "static with session token",
+			params: []byte(`{"accessKey": "a", "secretKey": "s", "sessionToken": "t"}`),
+			err:    nil,
+		},
+		{
+			name:   "sts with role",
+			params: []byte(`{"credentialSource": "sts", "roleArn": "arn:aws:iam::123456789012:role/backup"}`),
+			err:    nil,
+		},
+		{
+			name:   "errRoleARNRequired",
+			params: []byte(`{"credentialSource": "sts"}`),
+			err:    errRoleARNRequired,
+		},
+		{
+			name:   "errSTSWithKeys",
+			params: []byte(`{"credentialSource": "sts", "roleArn": "arn", "sessionToken": "t"}`),
+			err:    errSTSWithKeys,
+		},
+		{
+			name:   "errRoleARNWithoutSTS",
+			params: []byte(`{"accessKey": "a", "secretKey": "s", "roleArn": "arn"}`),
+			err:    errRoleARNWithoutSTS,
+		},
+		{
+			name:   "unknown source",
+			params: []byte(`{"credentialSource": "token"}`),
+			err:    errors.New("'credentialSource' shall be one of static, iam or sts"),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			params := CreateBackupStorageParams{}
+			err := json.Unmarshal(tc.params, &params)
+			require.NoError(t, err)
+			err = validateCredentialSource(params)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tc.err.Error(), err.Error())
+		})
+	}
+}
+
+func TestValidatePrometheusMonitoringInstanceSpec(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name string
+		spec PrometheusMonitoringInstanceSpec
+		err  error
+	}{
+		{
+			name: "basic auth",
+			spec: PrometheusMonitoringInstanceSpec{Username: "u", Password: "p"},
+			err:  nil,
+		},
+		{
+			name: "bearer token",
+			spec: PrometheusMonitoringInstanceSpec{BearerToken: "t"},
+			err:  nil,
+		},
+		{
+			name: "errPrometheusAuthBoth",
+			spec: PrometheusMonitoringInstanceSpec{Username: "u", Password: "p", BearerToken: "t"},
+			err:  errPrometheusAuthBoth,
+		},
+		{
+			name: "errPrometheusBasicAuth",
+			spec: PrometheusMonitoringInstanceSpec{Username: "u"},
+			err:  errPrometheusBasicAuth,
+		},
+		{
+			name: "errPrometheusNoAuth",
+			spec: PrometheusMonitoringInstanceSpec{},
+			err:  errPrometheusNoAuth,
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := validatePrometheusMonitoringInstanceSpec(&tc.spec)
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tc.err.Error(), err.Error())
+		})
+	}
+}
+
+func TestS3TLSConfig(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "minio-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	cfg, err := s3TLSConfig("", true)
+	require.NoError(t, err)
+	assert.Nil(t, cfg)
+
+	cfg, err = s3TLSConfig("", false)
+	require.NoError(t, err)
+	assert.True(t, cfg.InsecureSkipVerify)
+	assert.Nil(t, cfg.RootCAs)
+
+	cfg, err = s3TLSConfig(caCert, true)
+	require.NoError(t, err)
+	assert.False(t, cfg.InsecureSkipVerify)
+	assert.NotNil(t, cfg.RootCAs)
+
+	_, err = s3TLSConfig("not a certificate", true)
+	assert.ErrorIs(t, err, errInvalidCACert)
+}
+
+func TestValidateS3Endpoint(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		endpoint string
+		valid    bool
+	}{
+		{endpoint: "", valid: true},
+		{endpoint: "https://s3.us-west-2.amazonaws.com", valid: true},
+		{endpoint: "http://minio.minio.svc:9000", valid: true},
+		{endpoint: "https://rgw.example.com/", valid: true},
+		{endpoint: "minio:9000", valid: false},
+		{endpoint: "/bucket", valid: false},
+		{endpoint: "ftp://minio:21", valid: false},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.endpoint, func(t *testing.T) {
+			t.Parallel()
+			err := validateS3Endpoint(tc.endpoint)
+			if tc.valid {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestIsS3NotFound(t *testing.T) {
+	t.Parallel()
+
+	notFound := awserr.NewRequestFailure(awserr.New("NotFound", "Not Found", nil), http.StatusNotFound, "id")
+	forbidden := awserr.NewRequestFailure(awserr.New("Forbidden", "Forbidden", nil), http.StatusForbidden, "id")
+
+	assert.True(t, isS3NotFound(notFound))
+	assert.False(t, isS3NotFound(forbidden))
+	assert.False(t, isS3NotFound(errors.New("connection refused")))
+}
@@ -0,0 +1,123 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/AlekSi/pointer"
+	goversion "github.com/hashicorp/go-version"
+	"github.com/labstack/echo/v4"
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+
+	"github.com/percona/percona-everest-backend/pkg/versionservice"
+)
+
+// GetRecommendedVersions returns the engine versions supported by the operators installed in a kubernetes cluster.
+func (e *EverestServer) GetRecommendedVersions(ctx echo.Context, kubernetesID string) error {
+	if !e.versionService.Enabled() {
+		return ctx.JSON(http.StatusServiceUnavailable, Error{Message: pointer.ToString("Version service is disabled")})
+	}
+
+	engines, err := e.cachedDatabaseEngines(ctx.Request().Context(), kubernetesID)
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get database engines")})
+	}
+
+	result := make(RecommendedVersions, 0, len(engines))
+	for _, engine := range engines {
+		product, ok := versionservice.Products[everestv1alpha1.EngineType(engine.Type)]
+		if !ok || engine.OperatorVersion == "" {
+			continue
+		}
+		matrix, err := e.versionService.Matrix(ctx.Request().Context(), product.Operator, engine.OperatorVersion)
+		if err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusServiceUnavailable, Error{
+				Message: pointer.ToString(fmt.Sprintf("Could not get %s versions from the version service", engine.Type)),
+			})
+		}
+		result = append(result, EngineRecommendedVersions{
+			EngineType:      engine.Type,
+			OperatorVersion: engine.OperatorVersion,
+			Versions:        recommendedVersions(matrix[product.Component]),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// recommendedVersions returns the versions of a component starting with the most recent one.
+func recommendedVersions(components map[string]versionservice.Component) []RecommendedVersion {
+	versions := make([]RecommendedVersion, 0, len(components))
+	for v, c := range components {
+		versions = append(versions, RecommendedVersion{
+			Version:   v,
+			ImagePath: c.ImagePath,
+			ImageHash: pointer.ToStringOrNil(c.ImageHash),
+			Status:    c.Status,
+			Critical:  pointer.ToBool(c.Critical),
+		})
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versionGreater(versions[i].Version, versions[j].Version)
+	})
+	return versions
+}
+
+// versionGreater compares engine versions falling back to the lexical order if they cannot be parsed.
+func versionGreater(a, b string) bool {
+	va, errA := goversion.NewVersion(a)
+	vb, errB := goversion.NewVersion(b)
+	if errA != nil || errB != nil {
+		return a > b
+	}
+	return va.GreaterThan(vb)
+}
+
+// validateVersionService rejects the engine versions the version service does not support
+// for the installed operator. The version is not rejected if the version service is unavailable
+// so that air-gapped installations keep working.
+func (e *EverestServer) validateVersionService(ctx context.Context, version *string, engine *everestv1alpha1.DatabaseEngine) error {
+	if version == nil || !e.versionService.Enabled() || engine.Status.OperatorVersion == "" {
+		return nil
+	}
+	product, ok := versionservice.Products[engine.Spec.Type]
+	if !ok {
+		return nil
+	}
+
+	matrix, err := e.versionService.Matrix(ctx, product.Operator, engine.Status.OperatorVersion)
+	if err != nil {
+		e.l.Warn(errors.Join(err, errors.New("could not validate engine version with the version service")))
+		return nil
+	}
+	return checkSupportedVersion(matrix[product.Component], *version, engine)
+}
+
+func checkSupportedVersion(components map[string]versionservice.Component, version string, engine *everestv1alpha1.DatabaseEngine) error {
+	c, ok := components[version]
+	if !ok || c.Status == versionservice.StatusDisabled {
+		return fmt.Errorf("%s version %s is not supported by the %s operator %s", engine.Spec.Type, version, engine.Spec.Type, engine.Status.OperatorVersion)
+	}
+	return nil
+}
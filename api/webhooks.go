@@ -0,0 +1,303 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package api ...
+package api
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo/v4"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+// Headers of the webhook deliveries.
+const (
+	webhookEventHeader     = "X-Everest-Event"
+	webhookDeliveryHeader  = "X-Everest-Delivery"
+	webhookSignatureHeader = "X-Everest-Signature"
+)
+
+// webhookEvent is the payload of a resource lifecycle event posted to the webhooks.
+type webhookEvent struct {
+	ID           string            `json:"id"`
+	Type         WebhookEventTypes `json:"type"`
+	KubernetesID string            `json:"kubernetesId,omitempty"`
+	Resource     string            `json:"resource"`
+	Actor        string            `json:"actor,omitempty"`
+	Time         time.Time         `json:"time"`
+}
+
+// ListWebhooks lists the webhooks.
+func (e *EverestServer) ListWebhooks(ctx echo.Context) error {
+	webhooks, err := e.storage.ListWebhooks(ctx.Request().Context())
+	if err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not list webhooks")})
+	}
+
+	res := make([]Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		w := w
+		res = append(res, webhookToAPI(&w))
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// CreateWebhook creates a webhook.
+func (e *EverestServer) CreateWebhook(ctx echo.Context) error {
+	var params CreateWebhookParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if err := validateRFC1035(params.Name, "name"); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if !validateURL(params.Url) {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(ErrInvalidURL("url").Error())})
+	}
+
+	c := ctx.Request().Context()
+	if _, err := e.storage.GetWebhook(c, params.Name); err == nil {
+		return ctx.JSON(http.StatusConflict, Error{Message: pointer.ToString("Webhook with the same name already exists")})
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get webhook")})
+	}
+
+	webhook := &model.Webhook{
+		Name: params.Name,
+		URL:  params.Url,
+	}
+	if params.EventTypes != nil {
+		webhook.EventTypes = joinWebhookEventTypes(*params.EventTypes)
+	}
+	if secret := pointer.GetString(params.Secret); secret != "" {
+		webhook.SecretID = uuid.NewString()
+		if err := e.secretsStorage.CreateSecret(c, webhook.SecretID, secret); err != nil {
+			e.l.Error(err)
+			return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the secret to secrets storage")})
+		}
+	}
+	if err := e.storage.CreateWebhook(c, webhook); err != nil {
+		e.l.Error(err)
+		e.deleteWebhookSecret(c, webhook.SecretID)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not create webhook")})
+	}
+
+	return ctx.JSON(http.StatusOK, webhookToAPI(webhook))
+}
+
+// GetWebhook returns a webhook.
+func (e *EverestServer) GetWebhook(ctx echo.Context, name string) error {
+	webhook, err := e.storage.GetWebhook(ctx.Request().Context(), name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Webhook not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get webhook")})
+	}
+
+	return ctx.JSON(http.StatusOK, webhookToAPI(webhook))
+}
+
+// UpdateWebhook updates the URL, the secret or the event filter of a webhook.
+func (e *EverestServer) UpdateWebhook(ctx echo.Context, name string) error {
+	var params UpdateWebhookParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(err.Error())})
+	}
+	if params.Url != nil && !validateURL(*params.Url) {
+		return ctx.JSON(http.StatusBadRequest, Error{Message: pointer.ToString(ErrInvalidURL("url").Error())})
+	}
+
+	c := ctx.Request().Context()
+	webhook, err := e.storage.GetWebhook(c, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Webhook not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get webhook")})
+	}
+
+	update := model.UpdateWebhookParams{URL: params.Url}
+	if params.EventTypes != nil {
+		update.EventTypes = pointer.ToString(joinWebhookEventTypes(*params.EventTypes))
+	}
+	if params.Secret != nil {
+		// An empty secret disables signing.
+		update.SecretID = pointer.ToString("")
+		if *params.Secret != "" {
+			update.SecretID = pointer.ToString(uuid.NewString())
+			if err := e.secretsStorage.CreateSecret(c, *update.SecretID, *params.Secret); err != nil {
+				e.l.Error(err)
+				return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not save the secret to secrets storage")})
+			}
+		}
+	}
+	if err := e.storage.UpdateWebhook(c, name, update); err != nil {
+		e.l.Error(err)
+		if update.SecretID != nil {
+			e.deleteWebhookSecret(c, *update.SecretID)
+		}
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not update webhook")})
+	}
+	if update.SecretID != nil {
+		e.deleteWebhookSecret(c, webhook.SecretID)
+	}
+
+	return e.GetWebhook(ctx, name)
+}
+
+// DeleteWebhook deletes a webhook.
+func (e *EverestServer) DeleteWebhook(ctx echo.Context, name string) error {
+	c := ctx.Request().Context()
+	webhook, err := e.storage.GetWebhook(c, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ctx.JSON(http.StatusNotFound, Error{Message: pointer.ToString("Webhook not found")})
+		}
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not get webhook")})
+	}
+	if err := e.storage.DeleteWebhook(c, name); err != nil {
+		e.l.Error(err)
+		return ctx.JSON(http.StatusInternalServerError, Error{Message: pointer.ToString("Could not delete webhook")})
+	}
+	e.deleteWebhookSecret(c, webhook.SecretID)
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (e *EverestServer) deleteWebhookSecret(ctx context.Context, id string) {
+	if id == "" {
+		return
+	}
+	if _, err := e.secretsStorage.DeleteSecret(ctx, id); err != nil {
+		e.l.Warnf("Could not delete secret %s from secret storage due to error: %s", id, err)
+	}
+}
+
+func joinWebhookEventTypes[T ~string](types []T) string {
+	res := make([]string, 0, len(types))
+	for _, t := range types {
+		res = append(res, string(t))
+	}
+	return strings.Join(res, ",")
+}
+
+func webhookToAPI(w *model.Webhook) Webhook {
+	res := Webhook{
+		Name:       w.Name,
+		Url:        w.URL,
+		EventTypes: []WebhookEventTypes{},
+		CreatedAt:  w.CreatedAt,
+	}
+	if w.EventTypes != "" {
+		for _, t := range strings.Split(w.EventTypes, ",") {
+			res.EventTypes = append(res.EventTypes, WebhookEventTypes(t))
+		}
+	}
+	return res
+}
+
+// webhookMatches returns true if the event type shall be posted to the webhook.
+func webhookMatches(w model.Webhook, eventType WebhookEventTypes) bool {
+	if w.EventTypes == "" {
+		return true
+	}
+	for _, t := range strings.Split(w.EventTypes, ",") {
+		if t == string(eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// webhookSignature returns the hex encoded HMAC-SHA256 signature of the body.
+func webhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body) //nolint:errcheck
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// emitWebhookEvent posts the lifecycle event of the resource changed by the request
+// to the matching webhooks in the background.
+func (e *EverestServer) emitWebhookEvent(ctx echo.Context, eventType WebhookEventTypes, kubernetesID, resource string) {
+	event := webhookEvent{
+		ID:           uuid.NewString(),
+		Type:         eventType,
+		KubernetesID: kubernetesID,
+		Resource:     resource,
+		Time:         time.Now().UTC(),
+	}
+	if id, ok := userIdentityFrom(ctx); ok {
+		event.Actor = id.Username
+	}
+
+	e.waitGroup.Add(1)
+	go e.deliverWebhookEvent(context.Background(), event)
+}
+
+func (e *EverestServer) deliverWebhookEvent(ctx context.Context, event webhookEvent) {
+	defer e.waitGroup.Done()
+
+	webhooks, err := e.storage.ListWebhooks(ctx)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not list webhooks")))
+		return
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		e.l.Error(errors.Join(err, errors.New("could not encode webhook event")))
+		return
+	}
+
+	for _, w := range webhooks {
+		if !webhookMatches(w, event.Type) {
+			continue
+		}
+		headers := map[string]string{
+			webhookEventHeader:    string(event.Type),
+			webhookDeliveryHeader: event.ID,
+		}
+		if w.SecretID != "" {
+			secret, err := e.secretsStorage.GetSecret(ctx, w.SecretID)
+			if err != nil {
+				e.l.Error(errors.Join(err, fmt.Errorf("could not get the secret of webhook %s", w.Name)))
+				continue
+			}
+			headers[webhookSignatureHeader] = webhookSignature(secret, body)
+		}
+		if err := postBody(ctx, w.URL, body, headers); err != nil {
+			e.l.Error(errors.Join(err, fmt.Errorf("could not deliver %s event to webhook %s", event.Type, w.Name)))
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/percona-everest-backend/model"
+)
+
+func TestWebhookMatches(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		webhook  model.Webhook
+		expected bool
+	}
+	cases := []testCase{
+		{name: "all events", webhook: model.Webhook{}, expected: true},
+		{name: "filtered", webhook: model.Webhook{EventTypes: "backup-storage.created,database-cluster.deleted"}, expected: true},
+		{name: "filtered out", webhook: model.Webhook{EventTypes: "database-cluster.created"}, expected: false},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, webhookMatches(tc.webhook, DatabaseClusterDeleted))
+		})
+	}
+}
+
+func TestPostBodySignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"type":"database-cluster.created"}`)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, webhookSignature("secret", data), r.Header.Get(webhookSignatureHeader))
+		assert.Equal(t, string(DatabaseClusterCreated), r.Header.Get(webhookEventHeader))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	err := postBody(context.Background(), srv.URL, body, map[string]string{
+		webhookEventHeader:     string(DatabaseClusterCreated),
+		webhookSignatureHeader: webhookSignature("secret", body),
+	})
+	require.NoError(t, err)
+
+	// Known HMAC-SHA256 test vector.
+	assert.Equal(t,
+		"sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		webhookSignature("key", []byte("The quick brown fox jumps over the lazy dog")),
+	)
+}
@@ -18,9 +18,11 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/zapr"
@@ -33,7 +35,7 @@ import (
 )
 
 func main() {
-	logger := logger.MustInitLogger()
+	logger, level := logger.MustInitLogger()
 	defer logger.Sync() //nolint:errcheck
 	l := logger.Sugar()
 
@@ -47,12 +49,27 @@ func main() {
 		l.Fatalf("Failed parsing config: %+v", err)
 	}
 	if !c.Verbose {
-		logger = logger.WithOptions(zap.IncreaseLevel(zap.InfoLevel))
-		l = logger.Sugar()
+		level.SetLevel(zap.InfoLevel)
 	}
 	l.Debug("Debug logging enabled")
 
-	server, err := api.NewEverestServer(c, l)
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "migrate":
+			migrateDatabase(c, l)
+			return
+		case "migrate-secrets":
+			migrateSecrets(c, l)
+			return
+		case "reencrypt-secrets":
+			if err := api.ReencryptSecrets(context.Background(), c, l); err != nil {
+				l.Fatalf("Failed re-encrypting secrets: %+v", err)
+			}
+			return
+		}
+	}
+
+	server, err := api.NewEverestServer(c, l, level)
 	if err != nil {
 		l.Fatalf("Error creating Everest Server\n: %s", err)
 	}
@@ -78,3 +95,37 @@ func main() {
 
 	l.Info("Exiting")
 }
+
+// migrateDatabase runs the schema migrations of the Everest database: up applies the pending ones,
+// down N reverts the last N ones and status reports the applied and the pending ones.
+func migrateDatabase(c *config.EverestConfig, l *zap.SugaredLogger) {
+	var err error
+	switch args := os.Args[2:]; {
+	case len(args) == 1 && args[0] == "up":
+		err = api.MigrateDatabase(c, l)
+	case len(args) == 2 && args[0] == "down":
+		steps, convErr := strconv.Atoi(args[1])
+		if convErr != nil || steps <= 0 {
+			l.Fatalf("The number of migrations to revert shall be a positive integer, got %q", args[1])
+		}
+		err = api.RevertMigrations(c, l, steps)
+	case len(args) == 1 && args[0] == "status":
+		err = api.PrintMigrationStatus(c, l)
+	default:
+		l.Fatal("Usage: migrate up | migrate down N | migrate status")
+	}
+	if err != nil {
+		l.Fatalf("Failed migrating database: %+v", err)
+	}
+}
+
+// migrateSecrets moves the secrets stored in the Everest database to the configured secrets backend.
+func migrateSecrets(c *config.EverestConfig, l *zap.SugaredLogger) {
+	flags := flag.NewFlagSet("migrate-secrets", flag.ExitOnError)
+	deleteMigrated := flags.Bool("delete", false, "Delete the migrated secrets from the Everest database")
+	_ = flags.Parse(os.Args[2:])
+
+	if err := api.MigrateSecrets(context.Background(), c, l, *deleteMigrated); err != nil {
+		l.Fatalf("Failed migrating secrets: %+v", err)
+	}
+}
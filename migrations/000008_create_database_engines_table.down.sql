@@ -0,0 +1 @@
+DROP TABLE database_engines;
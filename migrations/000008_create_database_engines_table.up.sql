@@ -0,0 +1,15 @@
+CREATE TABLE database_engines
+(
+    kubernetes_id      uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    name               VARCHAR NOT NULL,
+    type               VARCHAR NOT NULL,
+    state              VARCHAR,
+    operator_version   VARCHAR,
+    allowed_versions   TEXT,
+    available_versions TEXT,
+
+    created_at         TIMESTAMP NOT NULL,
+    updated_at         TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, name)
+);
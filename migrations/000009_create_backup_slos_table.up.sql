@@ -0,0 +1,12 @@
+CREATE TABLE backup_slos
+(
+    kubernetes_id   uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name VARCHAR NOT NULL,
+    interval_hours  INTEGER NOT NULL,
+    last_status     VARCHAR,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
@@ -0,0 +1 @@
+DROP TABLE diagnostic_sessions;
@@ -0,0 +1,15 @@
+CREATE TABLE diagnostic_sessions
+(
+    kubernetes_id           uuid      NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name         VARCHAR   NOT NULL,
+    original_config         TEXT      NOT NULL,
+    general_log             BOOLEAN   NOT NULL DEFAULT FALSE,
+    slow_query_threshold_ms INTEGER,
+    profiling_level         INTEGER,
+    expires_at              TIMESTAMP NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
@@ -0,0 +1,4 @@
+DROP TABLE config_syncs;
+
+ALTER TABLE monitoring_instances DROP COLUMN secret_generation;
+ALTER TABLE backup_storages DROP COLUMN secret_generation;
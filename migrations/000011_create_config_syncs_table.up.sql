@@ -0,0 +1,17 @@
+ALTER TABLE backup_storages ADD COLUMN secret_generation BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE monitoring_instances ADD COLUMN secret_generation BIGINT NOT NULL DEFAULT 1;
+
+CREATE TABLE config_syncs
+(
+    kubernetes_id     uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    config_kind       VARCHAR NOT NULL,
+    config_name       VARCHAR NOT NULL,
+    synced_generation BIGINT  NOT NULL,
+    last_error        TEXT,
+    synced_at         TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, config_kind, config_name)
+);
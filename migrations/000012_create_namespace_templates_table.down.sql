@@ -0,0 +1 @@
+DROP TABLE namespace_templates;
@@ -0,0 +1,12 @@
+CREATE TABLE namespace_templates
+(
+    kubernetes_id uuid    NOT NULL PRIMARY KEY REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    template      VARCHAR NOT NULL,
+    labels        TEXT    NOT NULL DEFAULT '{}',
+    quota_cpu     VARCHAR NOT NULL DEFAULT '',
+    quota_memory  VARCHAR NOT NULL DEFAULT '',
+    quota_storage VARCHAR NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
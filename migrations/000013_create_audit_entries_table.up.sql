@@ -0,0 +1,13 @@
+CREATE TABLE audit_entries
+(
+    id            BIGSERIAL PRIMARY KEY,
+    action        VARCHAR NOT NULL,
+    resource      VARCHAR NOT NULL,
+    kubernetes_id VARCHAR NOT NULL DEFAULT '',
+    actor         VARCHAR NOT NULL DEFAULT '',
+    reason        TEXT    NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX audit_entries_action_idx ON audit_entries (action, created_at);
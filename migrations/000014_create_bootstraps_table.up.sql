@@ -0,0 +1,11 @@
+CREATE TABLE bootstraps
+(
+    kubernetes_id    uuid    NOT NULL PRIMARY KEY REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    state            VARCHAR NOT NULL,
+    operator_version VARCHAR NOT NULL,
+    message          TEXT    NOT NULL DEFAULT '',
+    finished_at      TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
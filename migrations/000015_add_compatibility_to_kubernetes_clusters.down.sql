@@ -0,0 +1,3 @@
+ALTER TABLE kubernetes_clusters DROP COLUMN compatibility_checked_at;
+ALTER TABLE kubernetes_clusters DROP COLUMN compatibility_message;
+ALTER TABLE kubernetes_clusters DROP COLUMN compatibility_status;
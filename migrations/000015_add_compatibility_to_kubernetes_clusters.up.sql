@@ -0,0 +1,3 @@
+ALTER TABLE kubernetes_clusters ADD COLUMN compatibility_status VARCHAR NOT NULL DEFAULT 'unknown';
+ALTER TABLE kubernetes_clusters ADD COLUMN compatibility_message TEXT NOT NULL DEFAULT '';
+ALTER TABLE kubernetes_clusters ADD COLUMN compatibility_checked_at TIMESTAMP;
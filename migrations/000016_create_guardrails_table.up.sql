@@ -0,0 +1,13 @@
+CREATE TABLE guardrails
+(
+    kubernetes_id           uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    engine_type             VARCHAR NOT NULL,
+    max_replicas            INT     NOT NULL DEFAULT 0,
+    max_connections         INT     NOT NULL DEFAULT 0,
+    allowed_storage_classes TEXT    NOT NULL DEFAULT '[]',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, engine_type)
+);
@@ -0,0 +1 @@
+DROP TABLE temporary_accesses;
@@ -0,0 +1,15 @@
+CREATE TABLE temporary_accesses
+(
+    kubernetes_id   uuid      NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name VARCHAR   NOT NULL,
+    username        VARCHAR   NOT NULL,
+    read_only       BOOLEAN   NOT NULL DEFAULT TRUE,
+    namespace       VARCHAR   NOT NULL DEFAULT '',
+    created_by      VARCHAR   NOT NULL DEFAULT '',
+    expires_at      TIMESTAMP NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name, username)
+);
@@ -0,0 +1,11 @@
+CREATE TABLE setups
+(
+    id                     INTEGER NOT NULL PRIMARY KEY CHECK (id = 1),
+    admin_username         VARCHAR NOT NULL DEFAULT '',
+    admin_password_hash    VARCHAR NOT NULL DEFAULT '',
+    secrets_backend        VARCHAR NOT NULL DEFAULT '',
+    default_backup_storage VARCHAR NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
@@ -0,0 +1 @@
+DROP TABLE engine_upgrades;
@@ -0,0 +1,18 @@
+CREATE TABLE engine_upgrades
+(
+    kubernetes_id       uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name     VARCHAR NOT NULL,
+    from_version        VARCHAR NOT NULL,
+    target_version      VARCHAR NOT NULL,
+    operator_versions   TEXT    NOT NULL DEFAULT '[]',
+    backup_storage_name VARCHAR NOT NULL DEFAULT '',
+    backup_name         VARCHAR NOT NULL DEFAULT '',
+    state               VARCHAR NOT NULL,
+    message             TEXT    NOT NULL DEFAULT '',
+    finished_at         TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
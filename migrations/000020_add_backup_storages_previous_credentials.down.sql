@@ -0,0 +1,2 @@
+ALTER TABLE backup_storages DROP COLUMN previous_secret_key_id;
+ALTER TABLE backup_storages DROP COLUMN previous_access_key_id;
@@ -0,0 +1,2 @@
+ALTER TABLE backup_storages ADD COLUMN previous_access_key_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE backup_storages ADD COLUMN previous_secret_key_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE backup_storages ADD COLUMN credential_source TEXT NOT NULL DEFAULT 'static';
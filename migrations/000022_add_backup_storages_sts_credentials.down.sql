@@ -0,0 +1,3 @@
+ALTER TABLE backup_storages DROP COLUMN credentials_expire_at;
+ALTER TABLE backup_storages DROP COLUMN role_arn;
+ALTER TABLE backup_storages DROP COLUMN session_token_id;
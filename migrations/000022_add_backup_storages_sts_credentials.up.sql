@@ -0,0 +1,3 @@
+ALTER TABLE backup_storages ADD COLUMN session_token_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE backup_storages ADD COLUMN role_arn TEXT NOT NULL DEFAULT '';
+ALTER TABLE backup_storages ADD COLUMN credentials_expire_at TIMESTAMP;
@@ -0,0 +1,2 @@
+ALTER TABLE backup_storages DROP COLUMN skip_tls_verify;
+ALTER TABLE backup_storages DROP COLUMN ca_cert_id;
@@ -0,0 +1,2 @@
+ALTER TABLE backup_storages ADD COLUMN ca_cert_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE backup_storages ADD COLUMN skip_tls_verify BOOLEAN NOT NULL DEFAULT FALSE;
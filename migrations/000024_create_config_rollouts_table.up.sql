@@ -0,0 +1,16 @@
+CREATE TABLE config_rollouts
+(
+    config_kind        VARCHAR NOT NULL,
+    config_name        VARCHAR NOT NULL,
+    generation         BIGINT  NOT NULL,
+    canary_clusters    TEXT    NOT NULL,
+    baseline_unhealthy TEXT    NOT NULL,
+    state              VARCHAR NOT NULL,
+    message            TEXT,
+    verify_at          TIMESTAMP NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (config_kind, config_name)
+);
@@ -0,0 +1 @@
+ALTER TABLE backup_storages DROP COLUMN force_path_style;
@@ -0,0 +1 @@
+ALTER TABLE backup_storages ADD COLUMN force_path_style BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,9 @@
+CREATE TABLE api_tokens
+(
+    name       VARCHAR NOT NULL PRIMARY KEY,
+    scope      VARCHAR NOT NULL,
+    token_hash VARCHAR NOT NULL UNIQUE,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
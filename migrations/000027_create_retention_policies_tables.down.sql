@@ -0,0 +1,2 @@
+DROP TABLE db_cluster_retention_policies;
+DROP TABLE backup_storage_retention_policies;
@@ -0,0 +1,22 @@
+CREATE TABLE backup_storage_retention_policies
+(
+    backup_storage_name VARCHAR NOT NULL PRIMARY KEY REFERENCES backup_storages (name) ON DELETE CASCADE,
+    max_age_days        INTEGER NOT NULL DEFAULT 0,
+    max_copies          INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE db_cluster_retention_policies
+(
+    kubernetes_id   uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name VARCHAR NOT NULL,
+    max_age_days    INTEGER NOT NULL DEFAULT 0,
+    max_copies      INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
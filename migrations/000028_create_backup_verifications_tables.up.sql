@@ -0,0 +1,29 @@
+CREATE TABLE backup_verification_policies
+(
+    kubernetes_id   uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name VARCHAR NOT NULL,
+    interval_hours  INTEGER NOT NULL,
+    last_run_at     TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
+
+CREATE TABLE backup_verifications
+(
+    id                   BIGSERIAL PRIMARY KEY,
+    kubernetes_id        uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name      VARCHAR NOT NULL,
+    backup_name          VARCHAR NOT NULL DEFAULT '',
+    verification_db_name VARCHAR NOT NULL DEFAULT '',
+    state                VARCHAR NOT NULL,
+    message              TEXT    NOT NULL DEFAULT '',
+    finished_at          TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX backup_verifications_db_cluster_idx ON backup_verifications (kubernetes_id, db_cluster_name, created_at);
@@ -0,0 +1 @@
+ALTER TABLE kubernetes_clusters DROP COLUMN default_monitoring_instance_name;
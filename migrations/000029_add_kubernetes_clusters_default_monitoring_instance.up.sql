@@ -0,0 +1 @@
+ALTER TABLE kubernetes_clusters ADD COLUMN default_monitoring_instance_name VARCHAR REFERENCES monitoring_instances (name) ON DELETE SET NULL;
@@ -0,0 +1 @@
+ALTER TABLE monitoring_instances DROP COLUMN username;
@@ -0,0 +1 @@
+ALTER TABLE monitoring_instances ADD COLUMN username VARCHAR NOT NULL DEFAULT '';
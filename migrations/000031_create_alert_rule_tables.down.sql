@@ -0,0 +1,2 @@
+DROP TABLE alert_rule_syncs;
+DROP TABLE alert_rule_templates;
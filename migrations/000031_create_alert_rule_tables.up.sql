@@ -0,0 +1,33 @@
+CREATE TABLE alert_rule_templates
+(
+    name        VARCHAR          NOT NULL PRIMARY KEY,
+    metric      VARCHAR          NOT NULL,
+    threshold   DOUBLE PRECISION NOT NULL,
+    for_minutes INT              NOT NULL,
+    severity    VARCHAR          NOT NULL,
+    enabled     BOOLEAN          NOT NULL DEFAULT TRUE,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+INSERT INTO alert_rule_templates (name, metric, threshold, for_minutes, severity, enabled, created_at, updated_at) VALUES
+    ('high-cpu', 'cpu', 80, 5, 'warning', TRUE, NOW(), NOW()),
+    ('replication-lag', 'replicationLag', 60, 5, 'warning', TRUE, NOW(), NOW()),
+    ('disk-usage', 'diskUsage', 85, 5, 'critical', TRUE, NOW(), NOW());
+
+CREATE TABLE alert_rule_syncs
+(
+    kubernetes_id            uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name          VARCHAR NOT NULL,
+    monitoring_instance_name VARCHAR NOT NULL,
+    fingerprint              VARCHAR NOT NULL DEFAULT '',
+    rules                    INT     NOT NULL DEFAULT 0,
+    last_error               TEXT    NOT NULL DEFAULT '',
+    synced_at                TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
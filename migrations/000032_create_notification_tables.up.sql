@@ -0,0 +1,22 @@
+CREATE TABLE notification_channels
+(
+    name      VARCHAR NOT NULL PRIMARY KEY,
+    type      VARCHAR NOT NULL,
+    target    VARCHAR NOT NULL DEFAULT '',
+    secret_id VARCHAR NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE notification_rules
+(
+    name          VARCHAR NOT NULL PRIMARY KEY,
+    channel_name  VARCHAR NOT NULL REFERENCES notification_channels (name) ON DELETE CASCADE,
+    event_types   TEXT    NOT NULL DEFAULT '',
+    min_severity  VARCHAR NOT NULL,
+    kubernetes_id uuid REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
@@ -0,0 +1,10 @@
+CREATE TABLE webhooks
+(
+    name        VARCHAR NOT NULL PRIMARY KEY,
+    url         VARCHAR NOT NULL,
+    secret_id   VARCHAR NOT NULL DEFAULT '',
+    event_types TEXT    NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
@@ -0,0 +1,2 @@
+DROP TABLE pending_operations;
+DROP TABLE maintenance_windows;
@@ -0,0 +1,31 @@
+CREATE TABLE maintenance_windows
+(
+    kubernetes_id    uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name  VARCHAR NOT NULL,
+    schedule         VARCHAR NOT NULL,
+    time_zone        VARCHAR NOT NULL,
+    duration_minutes INT     NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
+
+CREATE TABLE pending_operations
+(
+    id              VARCHAR NOT NULL PRIMARY KEY,
+    kubernetes_id   uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name VARCHAR NOT NULL,
+    namespace       VARCHAR NOT NULL DEFAULT '',
+    type            VARCHAR NOT NULL,
+    payload         TEXT    NOT NULL DEFAULT '',
+    state           VARCHAR NOT NULL,
+    message         TEXT    NOT NULL DEFAULT '',
+    created_by      VARCHAR NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX pending_operations_db_cluster_idx ON pending_operations (kubernetes_id, db_cluster_name);
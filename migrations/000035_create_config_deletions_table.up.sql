@@ -0,0 +1,13 @@
+CREATE TABLE config_deletions
+(
+    kubernetes_id uuid    NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    config_kind   VARCHAR NOT NULL,
+    config_name   VARCHAR NOT NULL,
+    secret_name   VARCHAR NOT NULL DEFAULT '',
+    last_error    TEXT,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, config_kind, config_name)
+);
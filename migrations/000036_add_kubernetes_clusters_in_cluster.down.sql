@@ -0,0 +1 @@
+ALTER TABLE kubernetes_clusters DROP COLUMN in_cluster;
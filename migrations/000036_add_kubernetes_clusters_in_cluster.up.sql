@@ -0,0 +1 @@
+ALTER TABLE kubernetes_clusters ADD COLUMN in_cluster BOOLEAN NOT NULL DEFAULT FALSE;
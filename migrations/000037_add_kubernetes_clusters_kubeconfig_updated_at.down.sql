@@ -0,0 +1 @@
+ALTER TABLE kubernetes_clusters DROP COLUMN kubeconfig_updated_at;
@@ -0,0 +1 @@
+ALTER TABLE kubernetes_clusters ADD COLUMN kubeconfig_updated_at TIMESTAMP;
@@ -0,0 +1 @@
+ALTER TABLE kubernetes_clusters ADD COLUMN labels TEXT NOT NULL DEFAULT '';
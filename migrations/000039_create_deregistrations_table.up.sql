@@ -0,0 +1,16 @@
+CREATE TABLE deregistrations
+(
+    -- The deregistration outlives the kubernetes cluster record so it does not reference it.
+    kubernetes_id               uuid    NOT NULL PRIMARY KEY,
+    state                       VARCHAR NOT NULL,
+    orphan_database_clusters    BOOLEAN NOT NULL DEFAULT FALSE,
+    database_clusters           INTEGER NOT NULL DEFAULT 0,
+    remaining_database_clusters INTEGER NOT NULL DEFAULT 0,
+    deleted_configs             INTEGER NOT NULL DEFAULT 0,
+    kept_configs                INTEGER NOT NULL DEFAULT 0,
+    message                     TEXT    NOT NULL DEFAULT '',
+    finished_at                 TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
@@ -0,0 +1,12 @@
+CREATE TABLE pricings
+(
+    id            INTEGER          NOT NULL PRIMARY KEY CHECK (id = 1),
+    currency      VARCHAR          NOT NULL DEFAULT '',
+    cpu_price     DOUBLE PRECISION NOT NULL DEFAULT 0,
+    memory_price  DOUBLE PRECISION NOT NULL DEFAULT 0,
+    storage_price DOUBLE PRECISION NOT NULL DEFAULT 0,
+    regions       TEXT             NOT NULL DEFAULT '{}',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
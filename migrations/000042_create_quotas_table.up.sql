@@ -0,0 +1,14 @@
+CREATE TABLE quotas
+(
+    subject_kind          VARCHAR NOT NULL,
+    subject               VARCHAR NOT NULL,
+    max_database_clusters INT     NOT NULL DEFAULT 0,
+    max_cpu_millis        BIGINT  NOT NULL DEFAULT 0,
+    max_memory_bytes      BIGINT  NOT NULL DEFAULT 0,
+    max_storage_bytes     BIGINT  NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (subject_kind, subject)
+);
@@ -0,0 +1,4 @@
+ALTER TABLE monitoring_instances DROP COLUMN project;
+ALTER TABLE backup_storages DROP COLUMN project;
+DROP TABLE project_members;
+DROP TABLE projects;
@@ -0,0 +1,24 @@
+CREATE TABLE projects
+(
+    name        VARCHAR NOT NULL PRIMARY KEY,
+    description VARCHAR NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE project_members
+(
+    project      VARCHAR NOT NULL REFERENCES projects (name) ON DELETE CASCADE,
+    subject_kind VARCHAR NOT NULL,
+    subject      VARCHAR NOT NULL,
+    role         VARCHAR NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (project, subject_kind, subject)
+);
+
+ALTER TABLE backup_storages ADD COLUMN project VARCHAR NOT NULL DEFAULT '';
+ALTER TABLE monitoring_instances ADD COLUMN project VARCHAR NOT NULL DEFAULT '';
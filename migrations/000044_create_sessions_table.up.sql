@@ -0,0 +1,15 @@
+CREATE TABLE sessions
+(
+    id                       VARCHAR NOT NULL PRIMARY KEY,
+    username                 VARCHAR NOT NULL,
+    groups                   TEXT    NOT NULL DEFAULT '[]',
+    scope                    VARCHAR NOT NULL,
+    access_token_hash        VARCHAR NOT NULL UNIQUE,
+    access_token_expires_at  TIMESTAMP NOT NULL,
+    refresh_token_hash       VARCHAR NOT NULL UNIQUE,
+    refresh_token_expires_at TIMESTAMP NOT NULL,
+    revoked_at               TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
@@ -0,0 +1,3 @@
+ALTER TABLE kubernetes_clusters DROP COLUMN version;
+ALTER TABLE monitoring_instances DROP COLUMN version;
+ALTER TABLE backup_storages DROP COLUMN version;
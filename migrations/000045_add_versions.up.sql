@@ -0,0 +1,3 @@
+ALTER TABLE backup_storages ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE monitoring_instances ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE kubernetes_clusters ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+ALTER TABLE monitoring_instances DROP COLUMN deleted_at;
+ALTER TABLE backup_storages DROP COLUMN deleted_at;
@@ -0,0 +1,2 @@
+ALTER TABLE backup_storages ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE monitoring_instances ADD COLUMN deleted_at TIMESTAMP;
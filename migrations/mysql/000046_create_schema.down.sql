@@ -0,0 +1,35 @@
+DROP TABLE sessions;
+DROP TABLE project_members;
+DROP TABLE projects;
+DROP TABLE quotas;
+DROP TABLE pricings;
+DROP TABLE deregistrations;
+DROP TABLE config_deletions;
+DROP TABLE pending_operations;
+DROP TABLE maintenance_windows;
+DROP TABLE webhooks;
+DROP TABLE notification_rules;
+DROP TABLE notification_channels;
+DROP TABLE alert_rule_syncs;
+DROP TABLE alert_rule_templates;
+DROP TABLE backup_verifications;
+DROP TABLE backup_verification_policies;
+DROP TABLE db_cluster_retention_policies;
+DROP TABLE backup_storage_retention_policies;
+DROP TABLE api_tokens;
+DROP TABLE config_rollouts;
+DROP TABLE engine_upgrades;
+DROP TABLE setups;
+DROP TABLE temporary_accesses;
+DROP TABLE guardrails;
+DROP TABLE bootstraps;
+DROP TABLE audit_entries;
+DROP TABLE namespace_templates;
+DROP TABLE config_syncs;
+DROP TABLE diagnostic_sessions;
+DROP TABLE backup_slos;
+DROP TABLE database_engines;
+DROP TABLE backup_storages;
+DROP TABLE kubernetes_clusters;
+DROP TABLE monitoring_instances;
+DROP TABLE secrets;
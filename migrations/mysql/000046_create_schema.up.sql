@@ -0,0 +1,553 @@
+-- The MySQL schema starts at the version of the PostgreSQL schema it matches
+-- so that the later migrations share the version numbers across the databases.
+-- The binary collation keeps the comparisons case sensitive as in PostgreSQL.
+
+CREATE TABLE secrets
+(
+    id         VARCHAR(255) UNIQUE NOT NULL,
+    value      TEXT         NOT NULL,
+    created_at DATETIME(6)  NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE monitoring_instances
+(
+    name              VARCHAR(255) NOT NULL PRIMARY KEY,
+    type              VARCHAR(255) NOT NULL,
+    url               TEXT         NOT NULL,
+    api_key_secret_id VARCHAR(255) NOT NULL,
+    username          VARCHAR(255) NOT NULL DEFAULT '',
+    secret_generation BIGINT       NOT NULL DEFAULT 1,
+    project           VARCHAR(255) NOT NULL DEFAULT '',
+    version           BIGINT       NOT NULL DEFAULT 1,
+    deleted_at        DATETIME(6),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE kubernetes_clusters
+(
+    id                               VARCHAR(36)  NOT NULL PRIMARY KEY,
+    name                             VARCHAR(255) UNIQUE NOT NULL,
+    namespace                        VARCHAR(255) NOT NULL CHECK (namespace <> ''),
+    uid                              VARCHAR(255),
+    compatibility_status             VARCHAR(255) NOT NULL DEFAULT 'unknown',
+    compatibility_message            TEXT         NOT NULL DEFAULT (''),
+    compatibility_checked_at         DATETIME(6),
+    default_monitoring_instance_name VARCHAR(255),
+    in_cluster                       BOOLEAN      NOT NULL DEFAULT FALSE,
+    kubeconfig_updated_at            DATETIME(6),
+    labels                           TEXT         NOT NULL DEFAULT (''),
+    version                          BIGINT       NOT NULL DEFAULT 1,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    FOREIGN KEY (default_monitoring_instance_name) REFERENCES monitoring_instances (name) ON DELETE SET NULL
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE backup_storages
+(
+    name                   VARCHAR(255) NOT NULL PRIMARY KEY,
+    type                   VARCHAR(255) NOT NULL,
+    description            TEXT,
+    bucket_name            VARCHAR(255) NOT NULL,
+    url                    TEXT,
+    region                 VARCHAR(255) NOT NULL,
+    access_key_id          VARCHAR(255) NOT NULL,
+    secret_key_id          VARCHAR(255) NOT NULL,
+    secret_generation      BIGINT       NOT NULL DEFAULT 1,
+    previous_access_key_id TEXT         NOT NULL DEFAULT (''),
+    previous_secret_key_id TEXT         NOT NULL DEFAULT (''),
+    credential_source      TEXT         NOT NULL DEFAULT ('static'),
+    session_token_id       TEXT         NOT NULL DEFAULT (''),
+    role_arn               TEXT         NOT NULL DEFAULT (''),
+    credentials_expire_at  DATETIME(6),
+    ca_cert_id             TEXT         NOT NULL DEFAULT (''),
+    skip_tls_verify        BOOLEAN      NOT NULL DEFAULT FALSE,
+    force_path_style       BOOLEAN      NOT NULL DEFAULT FALSE,
+    project                VARCHAR(255) NOT NULL DEFAULT '',
+    version                BIGINT       NOT NULL DEFAULT 1,
+    deleted_at             DATETIME(6),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE database_engines
+(
+    kubernetes_id      VARCHAR(36)  NOT NULL,
+    name               VARCHAR(255) NOT NULL,
+    type               VARCHAR(255) NOT NULL,
+    state              VARCHAR(255),
+    operator_version   VARCHAR(255),
+    allowed_versions   TEXT,
+    available_versions TEXT,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, name),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE backup_slos
+(
+    kubernetes_id   VARCHAR(36)  NOT NULL,
+    db_cluster_name VARCHAR(255) NOT NULL,
+    interval_hours  INTEGER      NOT NULL,
+    last_status     VARCHAR(255),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE diagnostic_sessions
+(
+    kubernetes_id           VARCHAR(36)  NOT NULL,
+    db_cluster_name         VARCHAR(255) NOT NULL,
+    original_config         TEXT         NOT NULL,
+    general_log             BOOLEAN      NOT NULL DEFAULT FALSE,
+    slow_query_threshold_ms INTEGER,
+    profiling_level         INTEGER,
+    expires_at              DATETIME(6)  NOT NULL,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE config_syncs
+(
+    kubernetes_id     VARCHAR(36)  NOT NULL,
+    config_kind       VARCHAR(255) NOT NULL,
+    config_name       VARCHAR(255) NOT NULL,
+    synced_generation BIGINT       NOT NULL,
+    last_error        TEXT,
+    synced_at         DATETIME(6),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, config_kind, config_name),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE namespace_templates
+(
+    kubernetes_id VARCHAR(36)  NOT NULL PRIMARY KEY,
+    template      VARCHAR(255) NOT NULL,
+    labels        TEXT         NOT NULL DEFAULT ('{}'),
+    quota_cpu     VARCHAR(255) NOT NULL DEFAULT '',
+    quota_memory  VARCHAR(255) NOT NULL DEFAULT '',
+    quota_storage VARCHAR(255) NOT NULL DEFAULT '',
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE audit_entries
+(
+    id            BIGINT       AUTO_INCREMENT PRIMARY KEY,
+    action        VARCHAR(255) NOT NULL,
+    resource      VARCHAR(255) NOT NULL,
+    kubernetes_id VARCHAR(36)  NOT NULL DEFAULT '',
+    actor         VARCHAR(255) NOT NULL DEFAULT '',
+    reason        TEXT         NOT NULL DEFAULT (''),
+
+    created_at DATETIME(6) NOT NULL
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX audit_entries_action_idx ON audit_entries (action, created_at);
+
+CREATE TABLE bootstraps
+(
+    kubernetes_id    VARCHAR(36)  NOT NULL PRIMARY KEY,
+    state            VARCHAR(255) NOT NULL,
+    operator_version VARCHAR(255) NOT NULL,
+    message          TEXT         NOT NULL DEFAULT (''),
+    finished_at      DATETIME(6),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE guardrails
+(
+    kubernetes_id           VARCHAR(36)  NOT NULL,
+    engine_type             VARCHAR(255) NOT NULL,
+    max_replicas            INT          NOT NULL DEFAULT 0,
+    max_connections         INT          NOT NULL DEFAULT 0,
+    allowed_storage_classes TEXT         NOT NULL DEFAULT ('[]'),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, engine_type),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE temporary_accesses
+(
+    kubernetes_id   VARCHAR(36)  NOT NULL,
+    db_cluster_name VARCHAR(255) NOT NULL,
+    username        VARCHAR(255) NOT NULL,
+    read_only       BOOLEAN      NOT NULL DEFAULT TRUE,
+    namespace       VARCHAR(255) NOT NULL DEFAULT '',
+    created_by      VARCHAR(255) NOT NULL DEFAULT '',
+    expires_at      DATETIME(6)  NOT NULL,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name, username),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE setups
+(
+    id                     INTEGER      NOT NULL PRIMARY KEY CHECK (id = 1),
+    admin_username         VARCHAR(255) NOT NULL DEFAULT '',
+    admin_password_hash    VARCHAR(255) NOT NULL DEFAULT '',
+    secrets_backend        VARCHAR(255) NOT NULL DEFAULT '',
+    default_backup_storage VARCHAR(255) NOT NULL DEFAULT '',
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE engine_upgrades
+(
+    kubernetes_id       VARCHAR(36)  NOT NULL,
+    db_cluster_name     VARCHAR(255) NOT NULL,
+    from_version        VARCHAR(255) NOT NULL,
+    target_version      VARCHAR(255) NOT NULL,
+    operator_versions   TEXT         NOT NULL DEFAULT ('[]'),
+    backup_storage_name VARCHAR(255) NOT NULL DEFAULT '',
+    backup_name         VARCHAR(255) NOT NULL DEFAULT '',
+    state               VARCHAR(255) NOT NULL,
+    message             TEXT         NOT NULL DEFAULT (''),
+    finished_at         DATETIME(6),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE config_rollouts
+(
+    config_kind        VARCHAR(255) NOT NULL,
+    config_name        VARCHAR(255) NOT NULL,
+    generation         BIGINT       NOT NULL,
+    canary_clusters    TEXT         NOT NULL,
+    baseline_unhealthy TEXT         NOT NULL,
+    state              VARCHAR(255) NOT NULL,
+    message            TEXT,
+    verify_at          DATETIME(6)  NOT NULL,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (config_kind, config_name)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE api_tokens
+(
+    name       VARCHAR(255) NOT NULL PRIMARY KEY,
+    scope      VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(255) NOT NULL UNIQUE,
+    team       VARCHAR(255) NOT NULL DEFAULT '',
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE backup_storage_retention_policies
+(
+    backup_storage_name VARCHAR(255) NOT NULL PRIMARY KEY,
+    max_age_days        INTEGER      NOT NULL DEFAULT 0,
+    max_copies          INTEGER      NOT NULL DEFAULT 0,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    FOREIGN KEY (backup_storage_name) REFERENCES backup_storages (name) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE db_cluster_retention_policies
+(
+    kubernetes_id   VARCHAR(36)  NOT NULL,
+    db_cluster_name VARCHAR(255) NOT NULL,
+    max_age_days    INTEGER      NOT NULL DEFAULT 0,
+    max_copies      INTEGER      NOT NULL DEFAULT 0,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE backup_verification_policies
+(
+    kubernetes_id   VARCHAR(36)  NOT NULL,
+    db_cluster_name VARCHAR(255) NOT NULL,
+    interval_hours  INTEGER      NOT NULL,
+    last_run_at     DATETIME(6),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE backup_verifications
+(
+    id                   BIGINT       AUTO_INCREMENT PRIMARY KEY,
+    kubernetes_id        VARCHAR(36)  NOT NULL,
+    db_cluster_name      VARCHAR(255) NOT NULL,
+    backup_name          VARCHAR(255) NOT NULL DEFAULT '',
+    verification_db_name VARCHAR(255) NOT NULL DEFAULT '',
+    state                VARCHAR(255) NOT NULL,
+    message              TEXT         NOT NULL DEFAULT (''),
+    finished_at          DATETIME(6),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX backup_verifications_db_cluster_idx ON backup_verifications (kubernetes_id, db_cluster_name, created_at);
+
+CREATE TABLE alert_rule_templates
+(
+    name        VARCHAR(255) NOT NULL PRIMARY KEY,
+    metric      VARCHAR(255) NOT NULL,
+    threshold   DOUBLE       NOT NULL,
+    for_minutes INT          NOT NULL,
+    severity    VARCHAR(255) NOT NULL,
+    enabled     BOOLEAN      NOT NULL DEFAULT TRUE,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+INSERT INTO alert_rule_templates (name, metric, threshold, for_minutes, severity, enabled, created_at, updated_at) VALUES
+    ('high-cpu', 'cpu', 80, 5, 'warning', TRUE, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('replication-lag', 'replicationLag', 60, 5, 'warning', TRUE, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('disk-usage', 'diskUsage', 85, 5, 'critical', TRUE, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+CREATE TABLE alert_rule_syncs
+(
+    kubernetes_id            VARCHAR(36)  NOT NULL,
+    db_cluster_name          VARCHAR(255) NOT NULL,
+    monitoring_instance_name VARCHAR(255) NOT NULL,
+    fingerprint              VARCHAR(255) NOT NULL DEFAULT '',
+    rules                    INT          NOT NULL DEFAULT 0,
+    last_error               TEXT         NOT NULL DEFAULT (''),
+    synced_at                DATETIME(6),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE notification_channels
+(
+    name      VARCHAR(255) NOT NULL PRIMARY KEY,
+    type      VARCHAR(255) NOT NULL,
+    target    TEXT         NOT NULL DEFAULT (''),
+    secret_id VARCHAR(255) NOT NULL DEFAULT '',
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE notification_rules
+(
+    name          VARCHAR(255) NOT NULL PRIMARY KEY,
+    channel_name  VARCHAR(255) NOT NULL,
+    event_types   TEXT         NOT NULL DEFAULT (''),
+    min_severity  VARCHAR(255) NOT NULL,
+    kubernetes_id VARCHAR(36),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    FOREIGN KEY (channel_name) REFERENCES notification_channels (name) ON DELETE CASCADE,
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE webhooks
+(
+    name        VARCHAR(255) NOT NULL PRIMARY KEY,
+    url         TEXT         NOT NULL,
+    secret_id   VARCHAR(255) NOT NULL DEFAULT '',
+    event_types TEXT         NOT NULL DEFAULT (''),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE maintenance_windows
+(
+    kubernetes_id    VARCHAR(36)  NOT NULL,
+    db_cluster_name  VARCHAR(255) NOT NULL,
+    schedule         VARCHAR(255) NOT NULL,
+    time_zone        VARCHAR(255) NOT NULL,
+    duration_minutes INT          NOT NULL,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE pending_operations
+(
+    id              VARCHAR(255) NOT NULL PRIMARY KEY,
+    kubernetes_id   VARCHAR(36)  NOT NULL,
+    db_cluster_name VARCHAR(255) NOT NULL,
+    namespace       VARCHAR(255) NOT NULL DEFAULT '',
+    type            VARCHAR(255) NOT NULL,
+    payload         TEXT         NOT NULL DEFAULT (''),
+    state           VARCHAR(255) NOT NULL,
+    message         TEXT         NOT NULL DEFAULT (''),
+    created_by      VARCHAR(255) NOT NULL DEFAULT '',
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE INDEX pending_operations_db_cluster_idx ON pending_operations (kubernetes_id, db_cluster_name);
+
+CREATE TABLE config_deletions
+(
+    kubernetes_id VARCHAR(36)  NOT NULL,
+    config_kind   VARCHAR(255) NOT NULL,
+    config_name   VARCHAR(255) NOT NULL,
+    secret_name   VARCHAR(255) NOT NULL DEFAULT '',
+    last_error    TEXT,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (kubernetes_id, config_kind, config_name),
+
+    FOREIGN KEY (kubernetes_id) REFERENCES kubernetes_clusters (id) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE deregistrations
+(
+    -- The deregistration outlives the kubernetes cluster record so it does not reference it.
+    kubernetes_id               VARCHAR(36)  NOT NULL PRIMARY KEY,
+    state                       VARCHAR(255) NOT NULL,
+    orphan_database_clusters    BOOLEAN      NOT NULL DEFAULT FALSE,
+    database_clusters           INTEGER      NOT NULL DEFAULT 0,
+    remaining_database_clusters INTEGER      NOT NULL DEFAULT 0,
+    deleted_configs             INTEGER      NOT NULL DEFAULT 0,
+    kept_configs                INTEGER      NOT NULL DEFAULT 0,
+    message                     TEXT         NOT NULL DEFAULT (''),
+    finished_at                 DATETIME(6),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE pricings
+(
+    id            INTEGER      NOT NULL PRIMARY KEY CHECK (id = 1),
+    currency      VARCHAR(255) NOT NULL DEFAULT '',
+    cpu_price     DOUBLE       NOT NULL DEFAULT 0,
+    memory_price  DOUBLE       NOT NULL DEFAULT 0,
+    storage_price DOUBLE       NOT NULL DEFAULT 0,
+    regions       TEXT         NOT NULL DEFAULT ('{}'),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE quotas
+(
+    subject_kind          VARCHAR(255) NOT NULL,
+    subject               VARCHAR(255) NOT NULL,
+    max_database_clusters INT          NOT NULL DEFAULT 0,
+    max_cpu_millis        BIGINT       NOT NULL DEFAULT 0,
+    max_memory_bytes      BIGINT       NOT NULL DEFAULT 0,
+    max_storage_bytes     BIGINT       NOT NULL DEFAULT 0,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (subject_kind, subject)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE projects
+(
+    name        VARCHAR(255) NOT NULL PRIMARY KEY,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE project_members
+(
+    project      VARCHAR(255) NOT NULL,
+    subject_kind VARCHAR(255) NOT NULL,
+    subject      VARCHAR(255) NOT NULL,
+    role         VARCHAR(255) NOT NULL,
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6),
+
+    PRIMARY KEY (project, subject_kind, subject),
+
+    FOREIGN KEY (project) REFERENCES projects (name) ON DELETE CASCADE
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
+
+CREATE TABLE sessions
+(
+    id                       VARCHAR(255) NOT NULL PRIMARY KEY,
+    username                 VARCHAR(255) NOT NULL,
+    `groups`                 TEXT         NOT NULL DEFAULT ('[]'),
+    scope                    VARCHAR(255) NOT NULL,
+    access_token_hash        VARCHAR(255) NOT NULL UNIQUE,
+    access_token_expires_at  DATETIME(6)  NOT NULL,
+    refresh_token_hash       VARCHAR(255) NOT NULL UNIQUE,
+    refresh_token_expires_at DATETIME(6)  NOT NULL,
+    revoked_at               DATETIME(6),
+
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_bin;
@@ -0,0 +1,3 @@
+ALTER TABLE monitoring_instances DROP COLUMN created_by;
+ALTER TABLE backup_storages DROP COLUMN created_by;
+ALTER TABLE kubernetes_clusters DROP COLUMN created_by;
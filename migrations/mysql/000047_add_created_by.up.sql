@@ -0,0 +1,3 @@
+ALTER TABLE kubernetes_clusters ADD COLUMN created_by VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE backup_storages ADD COLUMN created_by VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE monitoring_instances ADD COLUMN created_by VARCHAR(255) NOT NULL DEFAULT '';
@@ -0,0 +1,511 @@
+-- The SQLite schema starts at the version of the PostgreSQL schema it matches
+-- so that the later migrations share the version numbers across the databases.
+
+CREATE TABLE secrets
+(
+    id         VARCHAR UNIQUE NOT NULL,
+    value      TEXT           NOT NULL,
+    created_at TIMESTAMP      NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE monitoring_instances
+(
+    name              VARCHAR NOT NULL PRIMARY KEY,
+    type              VARCHAR NOT NULL,
+    url               VARCHAR NOT NULL,
+    api_key_secret_id VARCHAR NOT NULL,
+    username          VARCHAR NOT NULL DEFAULT '',
+    secret_generation BIGINT  NOT NULL DEFAULT 1,
+    project           VARCHAR NOT NULL DEFAULT '',
+    version           BIGINT  NOT NULL DEFAULT 1,
+    deleted_at        TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE kubernetes_clusters
+(
+    id                               VARCHAR NOT NULL PRIMARY KEY,
+    name                             VARCHAR UNIQUE NOT NULL,
+    namespace                        VARCHAR NOT NULL CHECK (namespace <> ''),
+    uid                              VARCHAR,
+    compatibility_status             VARCHAR NOT NULL DEFAULT 'unknown',
+    compatibility_message            TEXT    NOT NULL DEFAULT '',
+    compatibility_checked_at         TIMESTAMP,
+    default_monitoring_instance_name VARCHAR REFERENCES monitoring_instances (name) ON DELETE SET NULL,
+    in_cluster                       BOOLEAN NOT NULL DEFAULT FALSE,
+    kubeconfig_updated_at            TIMESTAMP,
+    labels                           TEXT    NOT NULL DEFAULT '',
+    version                          BIGINT  NOT NULL DEFAULT 1,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE backup_storages
+(
+    name                   VARCHAR NOT NULL PRIMARY KEY,
+    type                   VARCHAR NOT NULL,
+    description            TEXT,
+    bucket_name            VARCHAR NOT NULL,
+    url                    VARCHAR,
+    region                 VARCHAR NOT NULL,
+    access_key_id          VARCHAR NOT NULL,
+    secret_key_id          VARCHAR NOT NULL,
+    secret_generation      BIGINT  NOT NULL DEFAULT 1,
+    previous_access_key_id TEXT    NOT NULL DEFAULT '',
+    previous_secret_key_id TEXT    NOT NULL DEFAULT '',
+    credential_source      TEXT    NOT NULL DEFAULT 'static',
+    session_token_id       TEXT    NOT NULL DEFAULT '',
+    role_arn               TEXT    NOT NULL DEFAULT '',
+    credentials_expire_at  TIMESTAMP,
+    ca_cert_id             TEXT    NOT NULL DEFAULT '',
+    skip_tls_verify        BOOLEAN NOT NULL DEFAULT FALSE,
+    force_path_style       BOOLEAN NOT NULL DEFAULT FALSE,
+    project                VARCHAR NOT NULL DEFAULT '',
+    version                BIGINT  NOT NULL DEFAULT 1,
+    deleted_at             TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE database_engines
+(
+    kubernetes_id      VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    name               VARCHAR NOT NULL,
+    type               VARCHAR NOT NULL,
+    state              VARCHAR,
+    operator_version   VARCHAR,
+    allowed_versions   TEXT,
+    available_versions TEXT,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, name)
+);
+
+CREATE TABLE backup_slos
+(
+    kubernetes_id   VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name VARCHAR NOT NULL,
+    interval_hours  INTEGER NOT NULL,
+    last_status     VARCHAR,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
+
+CREATE TABLE diagnostic_sessions
+(
+    kubernetes_id           VARCHAR   NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name         VARCHAR   NOT NULL,
+    original_config         TEXT      NOT NULL,
+    general_log             BOOLEAN   NOT NULL DEFAULT FALSE,
+    slow_query_threshold_ms INTEGER,
+    profiling_level         INTEGER,
+    expires_at              TIMESTAMP NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
+
+CREATE TABLE config_syncs
+(
+    kubernetes_id     VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    config_kind       VARCHAR NOT NULL,
+    config_name       VARCHAR NOT NULL,
+    synced_generation BIGINT  NOT NULL,
+    last_error        TEXT,
+    synced_at         TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, config_kind, config_name)
+);
+
+CREATE TABLE namespace_templates
+(
+    kubernetes_id VARCHAR NOT NULL PRIMARY KEY REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    template      VARCHAR NOT NULL,
+    labels        TEXT    NOT NULL DEFAULT '{}',
+    quota_cpu     VARCHAR NOT NULL DEFAULT '',
+    quota_memory  VARCHAR NOT NULL DEFAULT '',
+    quota_storage VARCHAR NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE audit_entries
+(
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    action        VARCHAR NOT NULL,
+    resource      VARCHAR NOT NULL,
+    kubernetes_id VARCHAR NOT NULL DEFAULT '',
+    actor         VARCHAR NOT NULL DEFAULT '',
+    reason        TEXT    NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX audit_entries_action_idx ON audit_entries (action, created_at);
+
+CREATE TABLE bootstraps
+(
+    kubernetes_id    VARCHAR NOT NULL PRIMARY KEY REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    state            VARCHAR NOT NULL,
+    operator_version VARCHAR NOT NULL,
+    message          TEXT    NOT NULL DEFAULT '',
+    finished_at      TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE guardrails
+(
+    kubernetes_id           VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    engine_type             VARCHAR NOT NULL,
+    max_replicas            INT     NOT NULL DEFAULT 0,
+    max_connections         INT     NOT NULL DEFAULT 0,
+    allowed_storage_classes TEXT    NOT NULL DEFAULT '[]',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, engine_type)
+);
+
+CREATE TABLE temporary_accesses
+(
+    kubernetes_id   VARCHAR   NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name VARCHAR   NOT NULL,
+    username        VARCHAR   NOT NULL,
+    read_only       BOOLEAN   NOT NULL DEFAULT TRUE,
+    namespace       VARCHAR   NOT NULL DEFAULT '',
+    created_by      VARCHAR   NOT NULL DEFAULT '',
+    expires_at      TIMESTAMP NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name, username)
+);
+
+CREATE TABLE setups
+(
+    id                     INTEGER NOT NULL PRIMARY KEY CHECK (id = 1),
+    admin_username         VARCHAR NOT NULL DEFAULT '',
+    admin_password_hash    VARCHAR NOT NULL DEFAULT '',
+    secrets_backend        VARCHAR NOT NULL DEFAULT '',
+    default_backup_storage VARCHAR NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE engine_upgrades
+(
+    kubernetes_id       VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name     VARCHAR NOT NULL,
+    from_version        VARCHAR NOT NULL,
+    target_version      VARCHAR NOT NULL,
+    operator_versions   TEXT    NOT NULL DEFAULT '[]',
+    backup_storage_name VARCHAR NOT NULL DEFAULT '',
+    backup_name         VARCHAR NOT NULL DEFAULT '',
+    state               VARCHAR NOT NULL,
+    message             TEXT    NOT NULL DEFAULT '',
+    finished_at         TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
+
+CREATE TABLE config_rollouts
+(
+    config_kind        VARCHAR   NOT NULL,
+    config_name        VARCHAR   NOT NULL,
+    generation         BIGINT    NOT NULL,
+    canary_clusters    TEXT      NOT NULL,
+    baseline_unhealthy TEXT      NOT NULL,
+    state              VARCHAR   NOT NULL,
+    message            TEXT,
+    verify_at          TIMESTAMP NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (config_kind, config_name)
+);
+
+CREATE TABLE api_tokens
+(
+    name       VARCHAR NOT NULL PRIMARY KEY,
+    scope      VARCHAR NOT NULL,
+    token_hash VARCHAR NOT NULL UNIQUE,
+    team       VARCHAR NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE backup_storage_retention_policies
+(
+    backup_storage_name VARCHAR NOT NULL PRIMARY KEY REFERENCES backup_storages (name) ON DELETE CASCADE,
+    max_age_days        INTEGER NOT NULL DEFAULT 0,
+    max_copies          INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE db_cluster_retention_policies
+(
+    kubernetes_id   VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name VARCHAR NOT NULL,
+    max_age_days    INTEGER NOT NULL DEFAULT 0,
+    max_copies      INTEGER NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
+
+CREATE TABLE backup_verification_policies
+(
+    kubernetes_id   VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name VARCHAR NOT NULL,
+    interval_hours  INTEGER NOT NULL,
+    last_run_at     TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
+
+CREATE TABLE backup_verifications
+(
+    id                   INTEGER PRIMARY KEY AUTOINCREMENT,
+    kubernetes_id        VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name      VARCHAR NOT NULL,
+    backup_name          VARCHAR NOT NULL DEFAULT '',
+    verification_db_name VARCHAR NOT NULL DEFAULT '',
+    state                VARCHAR NOT NULL,
+    message              TEXT    NOT NULL DEFAULT '',
+    finished_at          TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX backup_verifications_db_cluster_idx ON backup_verifications (kubernetes_id, db_cluster_name, created_at);
+
+CREATE TABLE alert_rule_templates
+(
+    name        VARCHAR NOT NULL PRIMARY KEY,
+    metric      VARCHAR NOT NULL,
+    threshold   REAL    NOT NULL,
+    for_minutes INT     NOT NULL,
+    severity    VARCHAR NOT NULL,
+    enabled     BOOLEAN NOT NULL DEFAULT TRUE,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+INSERT INTO alert_rule_templates (name, metric, threshold, for_minutes, severity, enabled, created_at, updated_at) VALUES
+    ('high-cpu', 'cpu', 80, 5, 'warning', TRUE, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('replication-lag', 'replicationLag', 60, 5, 'warning', TRUE, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('disk-usage', 'diskUsage', 85, 5, 'critical', TRUE, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP);
+
+CREATE TABLE alert_rule_syncs
+(
+    kubernetes_id            VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name          VARCHAR NOT NULL,
+    monitoring_instance_name VARCHAR NOT NULL,
+    fingerprint              VARCHAR NOT NULL DEFAULT '',
+    rules                    INT     NOT NULL DEFAULT 0,
+    last_error               TEXT    NOT NULL DEFAULT '',
+    synced_at                TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
+
+CREATE TABLE notification_channels
+(
+    name      VARCHAR NOT NULL PRIMARY KEY,
+    type      VARCHAR NOT NULL,
+    target    VARCHAR NOT NULL DEFAULT '',
+    secret_id VARCHAR NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE notification_rules
+(
+    name          VARCHAR NOT NULL PRIMARY KEY,
+    channel_name  VARCHAR NOT NULL REFERENCES notification_channels (name) ON DELETE CASCADE,
+    event_types   TEXT    NOT NULL DEFAULT '',
+    min_severity  VARCHAR NOT NULL,
+    kubernetes_id VARCHAR REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE webhooks
+(
+    name        VARCHAR NOT NULL PRIMARY KEY,
+    url         VARCHAR NOT NULL,
+    secret_id   VARCHAR NOT NULL DEFAULT '',
+    event_types TEXT    NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE maintenance_windows
+(
+    kubernetes_id    VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name  VARCHAR NOT NULL,
+    schedule         VARCHAR NOT NULL,
+    time_zone        VARCHAR NOT NULL,
+    duration_minutes INT     NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, db_cluster_name)
+);
+
+CREATE TABLE pending_operations
+(
+    id              VARCHAR NOT NULL PRIMARY KEY,
+    kubernetes_id   VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    db_cluster_name VARCHAR NOT NULL,
+    namespace       VARCHAR NOT NULL DEFAULT '',
+    type            VARCHAR NOT NULL,
+    payload         TEXT    NOT NULL DEFAULT '',
+    state           VARCHAR NOT NULL,
+    message         TEXT    NOT NULL DEFAULT '',
+    created_by      VARCHAR NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX pending_operations_db_cluster_idx ON pending_operations (kubernetes_id, db_cluster_name);
+
+CREATE TABLE config_deletions
+(
+    kubernetes_id VARCHAR NOT NULL REFERENCES kubernetes_clusters (id) ON DELETE CASCADE,
+    config_kind   VARCHAR NOT NULL,
+    config_name   VARCHAR NOT NULL,
+    secret_name   VARCHAR NOT NULL DEFAULT '',
+    last_error    TEXT,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (kubernetes_id, config_kind, config_name)
+);
+
+CREATE TABLE deregistrations
+(
+    -- The deregistration outlives the kubernetes cluster record so it does not reference it.
+    kubernetes_id               VARCHAR NOT NULL PRIMARY KEY,
+    state                       VARCHAR NOT NULL,
+    orphan_database_clusters    BOOLEAN NOT NULL DEFAULT FALSE,
+    database_clusters           INTEGER NOT NULL DEFAULT 0,
+    remaining_database_clusters INTEGER NOT NULL DEFAULT 0,
+    deleted_configs             INTEGER NOT NULL DEFAULT 0,
+    kept_configs                INTEGER NOT NULL DEFAULT 0,
+    message                     TEXT    NOT NULL DEFAULT '',
+    finished_at                 TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE pricings
+(
+    id            INTEGER NOT NULL PRIMARY KEY CHECK (id = 1),
+    currency      VARCHAR NOT NULL DEFAULT '',
+    cpu_price     REAL    NOT NULL DEFAULT 0,
+    memory_price  REAL    NOT NULL DEFAULT 0,
+    storage_price REAL    NOT NULL DEFAULT 0,
+    regions       TEXT    NOT NULL DEFAULT '{}',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE quotas
+(
+    subject_kind          VARCHAR NOT NULL,
+    subject               VARCHAR NOT NULL,
+    max_database_clusters INT     NOT NULL DEFAULT 0,
+    max_cpu_millis        BIGINT  NOT NULL DEFAULT 0,
+    max_memory_bytes      BIGINT  NOT NULL DEFAULT 0,
+    max_storage_bytes     BIGINT  NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (subject_kind, subject)
+);
+
+CREATE TABLE projects
+(
+    name        VARCHAR NOT NULL PRIMARY KEY,
+    description VARCHAR NOT NULL DEFAULT '',
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE project_members
+(
+    project      VARCHAR NOT NULL REFERENCES projects (name) ON DELETE CASCADE,
+    subject_kind VARCHAR NOT NULL,
+    subject      VARCHAR NOT NULL,
+    role         VARCHAR NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP,
+
+    PRIMARY KEY (project, subject_kind, subject)
+);
+
+CREATE TABLE sessions
+(
+    id                       VARCHAR   NOT NULL PRIMARY KEY,
+    username                 VARCHAR   NOT NULL,
+    "groups"                 TEXT      NOT NULL DEFAULT '[]',
+    scope                    VARCHAR   NOT NULL,
+    access_token_hash        VARCHAR   NOT NULL UNIQUE,
+    access_token_expires_at  TIMESTAMP NOT NULL,
+    refresh_token_hash       VARCHAR   NOT NULL UNIQUE,
+    refresh_token_expires_at TIMESTAMP NOT NULL,
+    revoked_at               TIMESTAMP,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
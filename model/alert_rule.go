@@ -0,0 +1,61 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Metrics alerted on by the alert rule templates.
+const (
+	AlertRuleMetricCPU            = "cpu"
+	AlertRuleMetricReplicationLag = "replicationLag"
+	AlertRuleMetricDiskUsage      = "diskUsage"
+)
+
+// AlertRuleTemplate represents db model for a template of the alert rule
+// pushed to the monitoring instance of every monitored database cluster.
+type AlertRuleTemplate struct {
+	Name string `gorm:"primary_key"`
+	// Metric is one of cpu, replicationLag or diskUsage.
+	Metric string
+	// Threshold is a percentage for cpu and diskUsage and seconds for replicationLag.
+	Threshold float64
+	// ForMinutes is how long the threshold has to be exceeded before the alert fires.
+	ForMinutes int
+	// Severity is either warning or critical.
+	Severity string
+	Enabled  bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AlertRuleSync represents db model for the alert rules pushed
+// to the monitoring instance of a database cluster.
+type AlertRuleSync struct {
+	KubernetesID           string `gorm:"primary_key"`
+	DBClusterName          string `gorm:"primary_key"`
+	MonitoringInstanceName string
+	// Fingerprint identifies the pushed alert rules so that
+	// they are pushed again only once they change.
+	Fingerprint string
+	// Rules is the number of the pushed alert rules.
+	Rules     int
+	LastError string
+	SyncedAt  *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,129 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// UpdateAlertRuleTemplateParams stores fields to be updated in an alert rule template.
+type UpdateAlertRuleTemplateParams struct {
+	Threshold  *float64
+	ForMinutes *int
+	Severity   *string
+	Enabled    *bool
+}
+
+// CreateAlertRuleTemplate creates an alert rule template.
+func (db *Database) CreateAlertRuleTemplate(ctx context.Context, template *AlertRuleTemplate) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(template).Error
+	})
+}
+
+// ListAlertRuleTemplates lists the alert rule templates ordered by name.
+func (db *Database) ListAlertRuleTemplates(ctx context.Context) ([]AlertRuleTemplate, error) {
+	var templates []AlertRuleTemplate
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Order("name").Find(&templates).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// GetAlertRuleTemplate returns an alert rule template.
+func (db *Database) GetAlertRuleTemplate(ctx context.Context, name string) (*AlertRuleTemplate, error) {
+	template := &AlertRuleTemplate{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(template, "name = ?", name).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// UpdateAlertRuleTemplate updates fields of an alert rule template based on the provided fields.
+func (db *Database) UpdateAlertRuleTemplate(ctx context.Context, name string, params UpdateAlertRuleTemplateParams) error {
+	updates := map[string]interface{}{}
+	if params.Threshold != nil {
+		updates["threshold"] = *params.Threshold
+	}
+	if params.ForMinutes != nil {
+		updates["for_minutes"] = *params.ForMinutes
+	}
+	if params.Severity != nil {
+		updates["severity"] = *params.Severity
+	}
+	if params.Enabled != nil {
+		updates["enabled"] = *params.Enabled
+	}
+
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Model(&AlertRuleTemplate{}).Where("name = ?", name).Updates(updates)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// DeleteAlertRuleTemplate deletes an alert rule template.
+func (db *Database) DeleteAlertRuleTemplate(ctx context.Context, name string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&AlertRuleTemplate{}, "name = ?", name)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// ListAlertRuleSyncs lists the alert rules pushed for the database clusters.
+func (db *Database) ListAlertRuleSyncs(ctx context.Context) ([]AlertRuleSync, error) {
+	var syncs []AlertRuleSync
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Order("kubernetes_id, db_cluster_name").Find(&syncs).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return syncs, nil
+}
+
+// SaveAlertRuleSync creates or updates the alert rules pushed for a database cluster.
+func (db *Database) SaveAlertRuleSync(ctx context.Context, sync *AlertRuleSync) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Save(sync).Error
+	})
+}
+
+// DeleteAlertRuleSync deletes the alert rules pushed for a database cluster.
+func (db *Database) DeleteAlertRuleSync(ctx context.Context, kubernetesID, dbClusterName string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Delete(&AlertRuleSync{}, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName).Error
+	})
+}
@@ -0,0 +1,42 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Scopes of the API tokens.
+const (
+	// APITokenScopeAdmin grants access to the whole API.
+	APITokenScopeAdmin = "admin"
+	// APITokenScopeDashboard grants access only to the aggregate, non-secret read endpoints.
+	APITokenScopeDashboard = "dashboard"
+	// APITokenScopeProject grants access only to the resources of the projects the token,
+	// or the team of the token, is a member of.
+	APITokenScopeProject = "project"
+)
+
+// APIToken represents db model for a token the API is accessed with.
+// Only the hash of the token is stored.
+type APIToken struct {
+	Name      string `gorm:"primary_key"`
+	Scope     string
+	TokenHash string
+	// Team is the team the requests authenticated with the token are accounted to, if any.
+	Team string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,68 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// CreateAPIToken creates an APIToken record.
+func (db *Database) CreateAPIToken(ctx context.Context, token *APIToken) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(token).Error
+	})
+}
+
+// ListAPITokens returns all APIToken records.
+func (db *Database) ListAPITokens(ctx context.Context) ([]APIToken, error) {
+	var tokens []APIToken
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Order("name").Find(&tokens).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// GetAPITokenByHash returns the APIToken record with the token hash.
+func (db *Database) GetAPITokenByHash(ctx context.Context, hash string) (*APIToken, error) {
+	token := &APIToken{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(token, "token_hash = ?", hash).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// DeleteAPIToken deletes an APIToken record by its name.
+// gorm.ErrRecordNotFound is returned if there is no such record.
+func (db *Database) DeleteAPIToken(ctx context.Context, name string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&APIToken{}, "name = ?", name)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
@@ -0,0 +1,33 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// AuditEntry represents db model for an audited action.
+// The entries are kept after the resources they refer to are deleted.
+type AuditEntry struct {
+	ID       uint64 `gorm:"primary_key"`
+	Action   string
+	Resource string
+	// KubernetesID is the ID of the Kubernetes cluster the resource is located in if any.
+	KubernetesID string
+	// Actor is the user who performed the action. It is empty if the user is unknown.
+	Actor  string
+	Reason string
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,55 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ListAuditEntriesParams parameters for AuditEntry records listing.
+type ListAuditEntriesParams struct {
+	Action string
+	Pagination
+}
+
+// CreateAuditEntry creates an AuditEntry record.
+func (db *Database) CreateAuditEntry(ctx context.Context, entry *AuditEntry) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(entry).Error
+	})
+}
+
+// ListAuditEntries returns AuditEntry records matching the filters starting with the most recent ones.
+func (db *Database) ListAuditEntries(ctx context.Context, params ListAuditEntriesParams) ([]AuditEntry, int, error) {
+	var entries []AuditEntry
+	var total int
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		q := tx.Model(&AuditEntry{})
+		if params.Action != "" {
+			q = q.Where("action = ?", params.Action)
+		}
+		if err := q.Count(&total).Error; err != nil {
+			return err
+		}
+		return paginate(q.Order("created_at desc, id desc"), params.Pagination).Find(&entries).Error
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
@@ -0,0 +1,36 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// BackupSLO represents db model for a backup SLO of a database cluster.
+type BackupSLO struct {
+	KubernetesID  string `gorm:"primary_key"`
+	DBClusterName string `gorm:"primary_key"`
+	// IntervalHours defines how often a successful backup is expected.
+	IntervalHours int
+	// LastStatus is the compliance status reported by the last check.
+	LastStatus string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Interval returns the interval a successful backup is expected within.
+func (b *BackupSLO) Interval() time.Duration {
+	return time.Duration(b.IntervalHours) * time.Hour
+}
@@ -0,0 +1,97 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// SaveBackupSLOParams parameters for BackupSLO record creation or update.
+type SaveBackupSLOParams struct {
+	KubernetesID  string
+	DBClusterName string
+	IntervalHours int
+}
+
+// SaveBackupSLO creates or updates a BackupSLO record.
+func (db *Database) SaveBackupSLO(ctx context.Context, params SaveBackupSLOParams) (*BackupSLO, error) {
+	slo := &BackupSLO{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.
+			Where(BackupSLO{KubernetesID: params.KubernetesID, DBClusterName: params.DBClusterName}).
+			Assign(BackupSLO{IntervalHours: params.IntervalHours}).
+			FirstOrCreate(slo).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slo, nil
+}
+
+// ListBackupSLOs returns all BackupSLO records of a Kubernetes cluster.
+func (db *Database) ListBackupSLOs(ctx context.Context, kubernetesID string) ([]BackupSLO, error) {
+	var slos []BackupSLO
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Where("kubernetes_id = ?", kubernetesID).Order("db_cluster_name").Find(&slos).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slos, nil
+}
+
+// ListAllBackupSLOs returns all BackupSLO records.
+func (db *Database) ListAllBackupSLOs(ctx context.Context) ([]BackupSLO, error) {
+	var slos []BackupSLO
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Find(&slos).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slos, nil
+}
+
+// GetBackupSLO returns a BackupSLO record by its Kubernetes cluster ID and database cluster name.
+func (db *Database) GetBackupSLO(ctx context.Context, kubernetesID, dbClusterName string) (*BackupSLO, error) {
+	slo := &BackupSLO{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(slo, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return slo, nil
+}
+
+// SetBackupSLOLastStatus stores the compliance status reported by the last check.
+func (db *Database) SetBackupSLOLastStatus(ctx context.Context, kubernetesID, dbClusterName, status string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Model(&BackupSLO{}).
+			Where("kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName).
+			UpdateColumn("last_status", status).Error
+	})
+}
+
+// DeleteBackupSLO deletes a BackupSLO record.
+func (db *Database) DeleteBackupSLO(ctx context.Context, kubernetesID, dbClusterName string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Delete(&BackupSLO{}, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName).Error
+	})
+}
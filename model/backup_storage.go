@@ -26,6 +26,26 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const (
+	// CredentialSourceStatic stands for the access and secret keys stored in the secrets storage.
+	CredentialSourceStatic = "static"
+	// CredentialSourceIAM stands for the pod identity of the database clusters (IRSA, workload identity).
+	// No keys are stored for such backup storages.
+	CredentialSourceIAM = "iam"
+	// CredentialSourceSTS stands for the temporary credentials of an assumed role.
+	// They are stored like the static ones and refreshed before they expire.
+	CredentialSourceSTS = "sts"
+)
+
+const (
+	// BackupStorageCACertKey is the key of the CA bundle in the secret of the backup storage.
+	BackupStorageCACertKey = "ca.crt"
+	// BackupStorageVerifyTLSKey is the key of the TLS verification flag in the secret of the backup storage.
+	BackupStorageVerifyTLSKey = "VERIFY_TLS"
+	// BackupStorageForcePathStyleKey is the key of the path-style addressing flag in the secret of the backup storage.
+	BackupStorageForcePathStyleKey = "FORCE_PATH_STYLE"
+)
+
 // BackupStorage represents db model for BackupStorage.
 type BackupStorage struct {
 	Type        string
@@ -36,18 +56,81 @@ type BackupStorage struct {
 	Region      string
 	AccessKeyID string
 	SecretKeyID string
+	// SessionTokenID is the session token of temporary credentials. It is empty for the long-lived ones.
+	SessionTokenID string
+	// CredentialSource is one of CredentialSourceStatic, CredentialSourceIAM or CredentialSourceSTS.
+	CredentialSource string `gorm:"default:'static'"`
+	// RoleARN is the role assumed to get the CredentialSourceSTS credentials.
+	RoleARN string
+	// CredentialsExpireAt is when the CredentialSourceSTS credentials expire.
+	CredentialsExpireAt *time.Time
+	// CACertID is the CA bundle trusted by the S3-compatible storage. It is empty for the public CAs.
+	CACertID string
+	// SkipTLSVerify disables the verification of the storage certificate.
+	SkipTLSVerify bool
+	// ForcePathStyle addresses the bucket in the path instead of the host name.
+	ForcePathStyle bool
+	// SecretGeneration is incremented on every update so that the Kubernetes
+	// clusters lagging behind can be detected and synced.
+	SecretGeneration int64 `gorm:"default:1"`
+	// PreviousAccessKeyID and PreviousSecretKeyID are the secrets replaced by a credentials rotation.
+	// They are retired once all the Kubernetes clusters are synced with the new credentials.
+	PreviousAccessKeyID string
+	PreviousSecretKeyID string
+	// Project is the project the backup storage belongs to, if any.
+	Project string
+	// Version is incremented on every update by the users so that the concurrent updates can be detected.
+	Version int64 `gorm:"default:1"`
+	// CreatedBy is the Everest user who created the backup storage if known.
+	CreatedBy string
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// DeletedAt is set once the backup storage is moved to the trash. Such records are left out
+	// by the queries unless they are unscoped and purged after the trash retention period.
+	DeletedAt *time.Time
+}
+
+// UsesIAM returns true if the backup storage is accessed with the pod identity instead of the stored keys.
+func (b *BackupStorage) UsesIAM() bool {
+	return b.CredentialSource == CredentialSourceIAM
+}
+
+// hasClientOptions returns true if the backup storage is accessed with a custom CA bundle,
+// without TLS verification or with path-style addressing.
+func (b *BackupStorage) hasClientOptions() bool {
+	return b.CACertID != "" || b.SkipTLSVerify || b.ForcePathStyle
 }
 
 // SecretName returns the name of the k8s secret as referenced by the k8s MonitoringConfig resource.
+// It is empty for the backup storages using IAM without client options since they have no secret.
 func (b *BackupStorage) SecretName() string {
+	if b.UsesIAM() && !b.hasClientOptions() {
+		return ""
+	}
 	return fmt.Sprintf("%s-secret", b.Name)
 }
 
 // Secrets returns all monitoring instance secrets from secrets storage.
 func (b *BackupStorage) Secrets(ctx context.Context, getSecret func(ctx context.Context, id string) (string, error)) (map[string]string, error) {
+	secrets := make(map[string]string)
+	if b.CACertID != "" {
+		caCert, err := getSecret(ctx, b.CACertID)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to get caCert"))
+		}
+		secrets[BackupStorageCACertKey] = caCert
+	}
+	if b.SkipTLSVerify {
+		secrets[BackupStorageVerifyTLSKey] = "false"
+	}
+	if b.ForcePathStyle {
+		secrets[BackupStorageForcePathStyleKey] = "true"
+	}
+	if b.UsesIAM() {
+		return secrets, nil
+	}
+
 	secretKey, err := getSecret(ctx, b.SecretKeyID)
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to get secretKey"))
@@ -56,10 +139,16 @@ func (b *BackupStorage) Secrets(ctx context.Context, getSecret func(ctx context.
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to get accessKey"))
 	}
-	return map[string]string{
-		"AWS_SECRET_ACCESS_KEY": secretKey,
-		"AWS_ACCESS_KEY_ID":     accessKey,
-	}, nil
+	secrets["AWS_SECRET_ACCESS_KEY"] = secretKey
+	secrets["AWS_ACCESS_KEY_ID"] = accessKey
+	if b.SessionTokenID != "" {
+		sessionToken, err := getSecret(ctx, b.SessionTokenID)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed to get sessionToken"))
+		}
+		secrets["AWS_SESSION_TOKEN"] = sessionToken
+	}
+	return secrets, nil
 }
 
 // K8sResource returns a resource which shall be created when storing this struct in Kubernetes.
@@ -18,46 +18,107 @@ package model
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jinzhu/gorm"
 )
 
+// ErrCredentialsRotationInProgress is returned if the previous credentials of a backup storage are not retired yet.
+var ErrCredentialsRotationInProgress = errors.New("the previous credentials rotation is still in progress")
+
 // CreateBackupStorageParams parameters for BackupStorage record creation.
 type CreateBackupStorageParams struct {
-	Name        string
-	Description string
-	Type        string
-	BucketName  string
-	URL         string
-	Region      string
-	AccessKeyID string
-	SecretKeyID string
+	Name           string
+	Description    string
+	Type           string
+	BucketName     string
+	URL            string
+	Region         string
+	AccessKeyID    string
+	SecretKeyID    string
+	SessionTokenID string
+	// CredentialSource defaults to CredentialSourceStatic.
+	CredentialSource    string
+	RoleARN             string
+	CredentialsExpireAt *time.Time
+	CACertID            string
+	SkipTLSVerify       bool
+	ForcePathStyle      bool
+	Project             string
+	// CreatedBy is the Everest user who created the backup storage if known.
+	CreatedBy string
 }
 
 // UpdateBackupStorageParams parameters for BackupStorage record update.
 type UpdateBackupStorageParams struct {
-	Name        string
-	Description *string
-	BucketName  *string
-	URL         *string
-	Region      *string
-	AccessKeyID *string
-	SecretKeyID *string
+	Name           string
+	Description    *string
+	BucketName     *string
+	URL            *string
+	Region         *string
+	AccessKeyID    *string
+	SecretKeyID    *string
+	SessionTokenID *string
+	// CACertID set to empty removes the CA bundle.
+	CACertID       *string
+	SkipTLSVerify  *bool
+	ForcePathStyle *bool
+	// Version is the version the update is based on. ErrVersionMismatch is returned if the
+	// backup storage has been updated since. Any version is updated if it is nil.
+	Version *int64
+}
+
+// ListBackupStoragesParams parameters for BackupStorage records listing.
+type ListBackupStoragesParams struct {
+	// SortBy is one of name, type, region or createdAt. Defaults to name.
+	SortBy string
+	// Order is either asc or desc. Defaults to asc.
+	Order      string
+	Type       string
+	Region     string
+	NamePrefix string
+	// Projects limits the backup storages to the ones of the projects unless nil.
+	Projects []string
+	// Deleted lists the backup storages in the trash instead of the active ones.
+	Deleted bool
+	Pagination
+}
+
+//nolint:gochecknoglobals
+var backupStorageSortColumns = map[string]string{
+	"name":      "name",
+	"type":      "type",
+	"region":    "region",
+	"createdAt": "created_at",
 }
 
 // CreateBackupStorage creates a BackupStorage record.
-func (db *Database) CreateBackupStorage(_ context.Context, params CreateBackupStorageParams) (*BackupStorage, error) {
+func (db *Database) CreateBackupStorage(ctx context.Context, params CreateBackupStorageParams) (*BackupStorage, error) {
 	s := &BackupStorage{
-		Name:        params.Name,
-		Description: params.Description,
-		Type:        params.Type,
-		BucketName:  params.BucketName,
-		URL:         params.URL,
-		Region:      params.Region,
-		AccessKeyID: params.AccessKeyID,
-		SecretKeyID: params.SecretKeyID,
-	}
-	err := db.gormDB.Create(s).Error
+		Name:                params.Name,
+		Description:         params.Description,
+		Type:                params.Type,
+		BucketName:          params.BucketName,
+		URL:                 params.URL,
+		Region:              params.Region,
+		AccessKeyID:         params.AccessKeyID,
+		SecretKeyID:         params.SecretKeyID,
+		SessionTokenID:      params.SessionTokenID,
+		CredentialSource:    params.CredentialSource,
+		RoleARN:             params.RoleARN,
+		CredentialsExpireAt: params.CredentialsExpireAt,
+		CACertID:            params.CACertID,
+		SkipTLSVerify:       params.SkipTLSVerify,
+		ForcePathStyle:      params.ForcePathStyle,
+		Project:             params.Project,
+		CreatedBy:           params.CreatedBy,
+	}
+	if s.CredentialSource == "" {
+		s.CredentialSource = CredentialSourceStatic
+	}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(s).Error
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -65,43 +126,72 @@ func (db *Database) CreateBackupStorage(_ context.Context, params CreateBackupSt
 	return s, nil
 }
 
-// ListBackupStorages returns all available BackupStorages records.
-func (db *Database) ListBackupStorages(_ context.Context) ([]BackupStorage, error) {
+// ListBackupStorages returns BackupStorages records matching the filters in the requested order.
+func (db *Database) ListBackupStorages(ctx context.Context, params ListBackupStoragesParams) ([]BackupStorage, int, error) {
 	var storages []BackupStorage
-	err := db.gormDB.Find(&storages).Error
+	var total int
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		q := tx.Model(&BackupStorage{})
+		if params.Deleted {
+			q = q.Unscoped().Where("deleted_at IS NOT NULL")
+		}
+		if params.Type != "" {
+			q = q.Where("type = ?", params.Type)
+		}
+		if params.Region != "" {
+			q = q.Where("region = ?", params.Region)
+		}
+		q = wherePrefix(q, "name", params.NamePrefix)
+		if params.Projects != nil {
+			q = q.Where("project IN (?)", params.Projects)
+		}
+
+		if err := q.Count(&total).Error; err != nil {
+			return err
+		}
+
+		q, err := orderBy(q, backupStorageSortColumns, params.SortBy, params.Order)
+		if err != nil {
+			return err
+		}
+		return paginate(q, params.Pagination).Find(&storages).Error
+	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return storages, nil
+	return storages, total, nil
 }
 
 // GetBackupStorage returns BackupStorage record by its Name.
-func (db *Database) GetBackupStorage(_ context.Context, tx *gorm.DB, name string) (*BackupStorage, error) {
-	gormDB := db.gormDB
-	if tx != nil {
-		gormDB = tx
-	}
+func (db *Database) GetBackupStorage(ctx context.Context, tx *gorm.DB, name string) (*BackupStorage, error) {
 	storage := &BackupStorage{}
-	err := gormDB.First(storage, "name = ?", name).Error
+	err := db.withContext(ctx, tx, func(tx *gorm.DB) error {
+		return tx.First(storage, "name = ?", name).Error
+	})
 	if err != nil {
 		return nil, err
 	}
 	return storage, nil
 }
 
-// UpdateBackupStorage updates a BackupStorage record.
-func (db *Database) UpdateBackupStorage(_ context.Context, tx *gorm.DB, params UpdateBackupStorageParams) error {
-	target := db.gormDB
-	if tx != nil {
-		target = tx
-	}
+// UpdateBackupStorage updates a BackupStorage record and increments its secret generation.
+func (db *Database) UpdateBackupStorage(ctx context.Context, tx *gorm.DB, params UpdateBackupStorageParams) error {
+	return db.withContext(ctx, tx, func(tx *gorm.DB) error {
+		return updateBackupStorage(tx, params)
+	})
+}
+
+func updateBackupStorage(tx *gorm.DB, params UpdateBackupStorageParams) error {
 	old := &BackupStorage{}
-	err := target.First(old, "name = ?", params.Name).Error
+	err := tx.First(old, "name = ?", params.Name).Error
 	if err != nil {
 		return err
 	}
+	if err := incrementVersion(tx, &BackupStorage{}, "name", params.Name, params.Version); err != nil {
+		return err
+	}
 
-	record := BackupStorage{}
+	record := BackupStorage{SecretGeneration: old.SecretGeneration + 1}
 	if params.Description != nil {
 		record.Description = *params.Description
 	}
@@ -121,20 +211,149 @@ func (db *Database) UpdateBackupStorage(_ context.Context, tx *gorm.DB, params U
 	if params.SecretKeyID != nil {
 		record.SecretKeyID = *params.SecretKeyID
 	}
+	if params.SessionTokenID != nil {
+		record.SessionTokenID = *params.SessionTokenID
+	}
 
 	// Updates only non-empty fields defined in record
-	if err = target.Model(old).Where("name = ?", params.Name).Updates(record).Error; err != nil {
+	if err = tx.Model(old).Where("name = ?", params.Name).Updates(record).Error; err != nil {
 		return errors.Join(err, errors.New("could not update backup storage"))
 	}
 
+	// The client options may be set to the empty values so they are updated separately.
+	clientOptions := make(map[string]interface{})
+	if params.CACertID != nil {
+		clientOptions["ca_cert_id"] = *params.CACertID
+	}
+	if params.SkipTLSVerify != nil {
+		clientOptions["skip_tls_verify"] = *params.SkipTLSVerify
+	}
+	if params.ForcePathStyle != nil {
+		clientOptions["force_path_style"] = *params.ForcePathStyle
+	}
+	if len(clientOptions) != 0 {
+		if err = tx.Model(old).Where("name = ?", params.Name).Updates(clientOptions).Error; err != nil {
+			return errors.Join(err, errors.New("could not update backup storage client options"))
+		}
+	}
+
 	return nil
 }
 
-// DeleteBackupStorage returns BackupStorage record by its Name.
-func (db *Database) DeleteBackupStorage(_ context.Context, name string, tx *gorm.DB) error {
-	gormDB := db.gormDB
-	if tx != nil {
-		gormDB = tx
+// RotateBackupStorageCredentials replaces the credentials of a BackupStorage record, keeps the replaced ones
+// to be retired later and increments its secret generation.
+// ErrCredentialsRotationInProgress is returned if the credentials replaced by the previous rotation are not retired yet.
+func (db *Database) RotateBackupStorageCredentials(ctx context.Context, tx *gorm.DB, name, accessKeyID, secretKeyID string) error {
+	return db.withContext(ctx, tx, func(tx *gorm.DB) error {
+		old := &BackupStorage{}
+		if err := tx.First(old, "name = ?", name).Error; err != nil {
+			return err
+		}
+		res := tx.Model(old).Where("name = ? AND previous_access_key_id = '' AND previous_secret_key_id = ''", name).
+			Updates(map[string]interface{}{
+				"access_key_id":          accessKeyID,
+				"secret_key_id":          secretKeyID,
+				"previous_access_key_id": old.AccessKeyID,
+				"previous_secret_key_id": old.SecretKeyID,
+				"secret_generation":      old.SecretGeneration + 1,
+				"version":                gorm.Expr("version + ?", 1),
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrCredentialsRotationInProgress
+		}
+		return nil
+	})
+}
+
+// ClearBackupStoragePreviousCredentials forgets the credentials replaced by a rotation once they are retired.
+func (db *Database) ClearBackupStoragePreviousCredentials(ctx context.Context, name string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Model(&BackupStorage{}).Where("name = ?", name).Updates(map[string]interface{}{
+			"previous_access_key_id": "",
+			"previous_secret_key_id": "",
+		}).Error
+	})
+}
+
+// ListBackupStoragesExpiringBefore returns the BackupStorage records with the CredentialSourceSTS credentials
+// expiring before the provided time.
+func (db *Database) ListBackupStoragesExpiringBefore(ctx context.Context, before time.Time) ([]BackupStorage, error) {
+	var storages []BackupStorage
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Where("credential_source = ? AND (credentials_expire_at IS NULL OR credentials_expire_at < ?)", CredentialSourceSTS, before).
+			Find(&storages).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return storages, nil
+}
+
+// RefreshBackupStorageCredentials records the expiration of the refreshed CredentialSourceSTS credentials
+// of a BackupStorage record and increments its secret generation.
+func (db *Database) RefreshBackupStorageCredentials(ctx context.Context, name string, expireAt time.Time) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Model(&BackupStorage{}).Where("name = ?", name).Updates(map[string]interface{}{
+			"credentials_expire_at": expireAt,
+			"secret_generation":     gorm.Expr("secret_generation + 1"),
+		}).Error
+	})
+}
+
+// DeleteBackupStorage moves BackupStorage record to the trash.
+func (db *Database) DeleteBackupStorage(ctx context.Context, name string, tx *gorm.DB) error {
+	return db.withContext(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Delete(&BackupStorage{}, "name = ?", name).Error
+	})
+}
+
+// GetDeletedBackupStorage returns BackupStorage record in the trash by its Name.
+func (db *Database) GetDeletedBackupStorage(ctx context.Context, name string) (*BackupStorage, error) {
+	storage := &BackupStorage{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Unscoped().First(storage, "name = ? AND deleted_at IS NOT NULL", name).Error
+	})
+	if err != nil {
+		return nil, err
 	}
-	return gormDB.Delete(&BackupStorage{}, "name = ?", name).Error
+	return storage, nil
+}
+
+// ListBackupStoragesDeletedBefore returns BackupStorage records moved to the trash before the given time.
+func (db *Database) ListBackupStoragesDeletedBefore(ctx context.Context, before time.Time) ([]BackupStorage, error) {
+	var storages []BackupStorage
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Unscoped().Where("deleted_at < ?", before).Find(&storages).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return storages, nil
+}
+
+// RestoreBackupStorage takes BackupStorage record out of the trash.
+// gorm.ErrRecordNotFound is returned if it is not in the trash.
+func (db *Database) RestoreBackupStorage(ctx context.Context, name string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Unscoped().Model(&BackupStorage{}).
+			Where("name = ? AND deleted_at IS NOT NULL", name).
+			UpdateColumn("deleted_at", nil)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// PurgeBackupStorage deletes BackupStorage record permanently.
+func (db *Database) PurgeBackupStorage(ctx context.Context, name string, tx *gorm.DB) error {
+	return db.withContext(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Unscoped().Delete(&BackupStorage{}, "name = ?", name).Error
+	})
 }
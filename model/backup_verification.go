@@ -0,0 +1,60 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// States of a backup verification.
+const (
+	BackupVerificationStateRunning   = "running"
+	BackupVerificationStateSucceeded = "succeeded"
+	BackupVerificationStateFailed    = "failed"
+)
+
+// BackupVerificationPolicy represents db model for the opt-in verification of the backups of a database cluster.
+type BackupVerificationPolicy struct {
+	KubernetesID  string `gorm:"primary_key"`
+	DBClusterName string `gorm:"primary_key"`
+	IntervalHours int
+	// LastRunAt is nil until the first verification is started.
+	LastRunAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Due returns true if the next verification shall be started at the given time.
+func (p *BackupVerificationPolicy) Due(now time.Time) bool {
+	return p.LastRunAt == nil || !p.LastRunAt.Add(time.Duration(p.IntervalHours)*time.Hour).After(now)
+}
+
+// BackupVerification represents db model for a verification of the latest backup of a database cluster
+// restored into a temporary database cluster.
+type BackupVerification struct {
+	ID            uint64 `gorm:"primary_key"`
+	KubernetesID  string
+	DBClusterName string
+	BackupName    string
+	// VerificationDBName is the name of the temporary database cluster the backup is restored into.
+	VerificationDBName string
+	State              string
+	// Message describes the failure if the verification failed.
+	Message    string
+	FinishedAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
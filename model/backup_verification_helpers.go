@@ -0,0 +1,130 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ListBackupVerificationsParams parameters for BackupVerification records listing.
+type ListBackupVerificationsParams struct {
+	KubernetesID  string
+	DBClusterName string
+	State         string
+	Pagination
+}
+
+// SaveBackupVerificationPolicy creates or updates a BackupVerificationPolicy record.
+func (db *Database) SaveBackupVerificationPolicy(
+	ctx context.Context, kubernetesID, dbClusterName string, intervalHours int,
+) (*BackupVerificationPolicy, error) {
+	policy := &BackupVerificationPolicy{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.
+			Where(BackupVerificationPolicy{KubernetesID: kubernetesID, DBClusterName: dbClusterName}).
+			Assign(BackupVerificationPolicy{IntervalHours: intervalHours}).
+			FirstOrCreate(policy).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// ListBackupVerificationPolicies returns all BackupVerificationPolicy records.
+func (db *Database) ListBackupVerificationPolicies(ctx context.Context) ([]BackupVerificationPolicy, error) {
+	var policies []BackupVerificationPolicy
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Find(&policies).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// GetBackupVerificationPolicy returns a BackupVerificationPolicy record by its Kubernetes cluster ID
+// and database cluster name.
+func (db *Database) GetBackupVerificationPolicy(ctx context.Context, kubernetesID, dbClusterName string) (*BackupVerificationPolicy, error) {
+	policy := &BackupVerificationPolicy{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(policy, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// SetBackupVerificationPolicyLastRun records the time the latest verification of a database cluster was started at.
+func (db *Database) SetBackupVerificationPolicyLastRun(ctx context.Context, kubernetesID, dbClusterName string, at time.Time) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Model(&BackupVerificationPolicy{}).
+			Where("kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName).
+			Update("last_run_at", at).Error
+	})
+}
+
+// DeleteBackupVerificationPolicy deletes a BackupVerificationPolicy record.
+// gorm.ErrRecordNotFound is returned if there is no such record.
+func (db *Database) DeleteBackupVerificationPolicy(ctx context.Context, kubernetesID, dbClusterName string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&BackupVerificationPolicy{}, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// SaveBackupVerification creates or updates a BackupVerification record.
+func (db *Database) SaveBackupVerification(ctx context.Context, verification *BackupVerification) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Save(verification).Error
+	})
+}
+
+// ListBackupVerifications returns BackupVerification records matching the filters starting with the most recent ones.
+func (db *Database) ListBackupVerifications(ctx context.Context, params ListBackupVerificationsParams) ([]BackupVerification, int, error) {
+	var verifications []BackupVerification
+	var total int
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		q := tx.Model(&BackupVerification{})
+		if params.KubernetesID != "" {
+			q = q.Where("kubernetes_id = ?", params.KubernetesID)
+		}
+		if params.DBClusterName != "" {
+			q = q.Where("db_cluster_name = ?", params.DBClusterName)
+		}
+		if params.State != "" {
+			q = q.Where("state = ?", params.State)
+		}
+		if err := q.Count(&total).Error; err != nil {
+			return err
+		}
+		return paginate(q.Order("created_at desc, id desc"), params.Pagination).Find(&verifications).Error
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return verifications, total, nil
+}
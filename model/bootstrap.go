@@ -0,0 +1,47 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// States of a Kubernetes cluster bootstrap in the order they are passed.
+const (
+	BootstrapStatePending            = "pending"
+	BootstrapStateCreatingNamespace  = "creating-namespace"
+	BootstrapStateInstallingCRDs     = "installing-crds"
+	BootstrapStateInstallingOperator = "installing-operator"
+	BootstrapStateWaitingForOperator = "waiting-for-operator"
+	BootstrapStateCompleted          = "completed"
+	BootstrapStateFailed             = "failed"
+)
+
+// Bootstrap represents db model for the installation of Everest into a Kubernetes cluster.
+type Bootstrap struct {
+	KubernetesID    string `gorm:"primary_key"`
+	State           string
+	OperatorVersion string
+	// Message describes the failure if the bootstrap failed.
+	Message    string
+	FinishedAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// InProgress returns true if the bootstrap has neither completed nor failed yet.
+func (b *Bootstrap) InProgress() bool {
+	return b.State != BootstrapStateCompleted && b.State != BootstrapStateFailed
+}
@@ -0,0 +1,47 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// States of a canary rollout of a config generation.
+const (
+	RolloutStateCanary    = "canary"
+	RolloutStateCompleted = "completed"
+	RolloutStateHalted    = "halted"
+)
+
+// ConfigRollout represents db model for the latest canary rollout of a config generation
+// to the Kubernetes clusters.
+type ConfigRollout struct {
+	ConfigKind string `gorm:"primary_key"`
+	ConfigName string `gorm:"primary_key"`
+	Generation int64
+	// CanaryClusters is a JSON encoded list of the IDs of the Kubernetes clusters
+	// the generation is rolled out to first.
+	CanaryClusters string
+	// BaselineUnhealthy is a JSON encoded list of the database clusters on the canary
+	// Kubernetes clusters which were not ready before the rollout started.
+	BaselineUnhealthy string
+	State             string
+	// Message describes why the rollout was halted.
+	Message string
+	// VerifyAt is the time the canary Kubernetes clusters are verified at.
+	VerifyAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,60 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ListConfigRollouts returns all ConfigRollout records.
+func (db *Database) ListConfigRollouts(ctx context.Context) ([]ConfigRollout, error) {
+	var rollouts []ConfigRollout
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Order("config_kind, config_name").Find(&rollouts).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rollouts, nil
+}
+
+// GetConfigRollout returns the ConfigRollout record of a config.
+func (db *Database) GetConfigRollout(ctx context.Context, kind, name string) (*ConfigRollout, error) {
+	rollout := &ConfigRollout{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(rollout, "config_kind = ? AND config_name = ?", kind, name).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rollout, nil
+}
+
+// SaveConfigRollout creates or updates the ConfigRollout record of a config.
+func (db *Database) SaveConfigRollout(ctx context.Context, rollout *ConfigRollout) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Save(rollout).Error
+	})
+}
+
+// DeleteConfigRollout deletes the ConfigRollout record of a config.
+func (db *Database) DeleteConfigRollout(ctx context.Context, kind, name string, tx *gorm.DB) error {
+	return db.withContext(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Delete(&ConfigRollout{}, "config_kind = ? AND config_name = ?", kind, name).Error
+	})
+}
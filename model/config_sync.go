@@ -0,0 +1,54 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Kinds of the configs which secrets are synced to the Kubernetes clusters.
+const (
+	ConfigKindBackupStorage      = "backupStorage"
+	ConfigKindMonitoringInstance = "monitoringInstance"
+)
+
+// ConfigSync represents db model for the sync state of a config on a Kubernetes cluster.
+type ConfigSync struct {
+	KubernetesID string `gorm:"primary_key"`
+	ConfigKind   string `gorm:"primary_key"`
+	ConfigName   string `gorm:"primary_key"`
+	// SyncedGeneration is the secret generation of the config last pushed to the Kubernetes cluster.
+	SyncedGeneration int64
+	// LastError is the error of the last sync attempt. It is empty if the attempt succeeded.
+	LastError string
+	SyncedAt  *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ConfigDeletion represents db model for a config which could not be deleted from a Kubernetes cluster
+// when it was deleted from Everest. The deletion is retried until it succeeds.
+type ConfigDeletion struct {
+	KubernetesID string `gorm:"primary_key"`
+	ConfigKind   string `gorm:"primary_key"`
+	ConfigName   string `gorm:"primary_key"`
+	// SecretName is the name of the Kubernetes secret of the config. It is empty if the config has no secret.
+	SecretName string
+	// LastError is the error of the last deletion attempt.
+	LastError string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
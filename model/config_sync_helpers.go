@@ -0,0 +1,81 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ListConfigSyncs returns the ConfigSync records of a config on all Kubernetes clusters.
+func (db *Database) ListConfigSyncs(ctx context.Context, kind, name string) ([]ConfigSync, error) {
+	var syncs []ConfigSync
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Where("config_kind = ? AND config_name = ?", kind, name).Find(&syncs).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return syncs, nil
+}
+
+// SaveConfigSync creates or updates a ConfigSync record.
+func (db *Database) SaveConfigSync(ctx context.Context, sync *ConfigSync) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Save(sync).Error
+	})
+}
+
+// DeleteConfigSyncs deletes the ConfigSync records of a config on all Kubernetes clusters.
+func (db *Database) DeleteConfigSyncs(ctx context.Context, kind, name string, tx *gorm.DB) error {
+	return db.withContext(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Delete(&ConfigSync{}, "config_kind = ? AND config_name = ?", kind, name).Error
+	})
+}
+
+// ListConfigDeletions returns the pending deletions of the configs from the Kubernetes clusters.
+func (db *Database) ListConfigDeletions(ctx context.Context) ([]ConfigDeletion, error) {
+	var deletions []ConfigDeletion
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Order("created_at").Find(&deletions).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deletions, nil
+}
+
+// SaveConfigDeletion creates or updates a ConfigDeletion record.
+func (db *Database) SaveConfigDeletion(ctx context.Context, deletion *ConfigDeletion, tx *gorm.DB) error {
+	return db.withContext(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Save(deletion).Error
+	})
+}
+
+// DeleteConfigDeletion deletes a ConfigDeletion record once the config is deleted from the Kubernetes cluster.
+func (db *Database) DeleteConfigDeletion(ctx context.Context, kubernetesID, kind, name string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Delete(&ConfigDeletion{}, "kubernetes_id = ? AND config_kind = ? AND config_name = ?", kubernetesID, kind, name).Error
+	})
+}
+
+// DeleteConfigDeletions deletes the pending deletions of a config from all Kubernetes clusters.
+func (db *Database) DeleteConfigDeletions(ctx context.Context, kind, name string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Delete(&ConfigDeletion{}, "config_kind = ? AND config_name = ?", kind, name).Error
+	})
+}
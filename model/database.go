@@ -22,31 +22,64 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
-	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
 	_ "github.com/golang-migrate/migrate/v4/source/file" // driver for loading migrations files
 	"github.com/jinzhu/gorm"
-	_ "github.com/lib/pq" // postgresql driver
 	"go.uber.org/zap"
 
 	"github.com/percona/percona-everest-backend/cmd/config"
 )
 
+// ErrVersionMismatch is returned if a record has been updated since the version an update is based on.
+var ErrVersionMismatch = errors.New("the record has been updated in the meantime")
+
 // Database implements methods for interacting with database.
 type Database struct {
-	gormDB *gorm.DB
-	dir    string
-	l      *zap.Logger
+	gormDB  *gorm.DB
+	dialect *dialect
+	// source is the data source name of the driver.
+	source  string
+	dir     string
+	options PoolOptions
+	l       *zap.Logger
+}
+
+// PoolOptions tune the connections to the database and the retries of the failed transactions.
+type PoolOptions struct {
+	// MaxOpenConns limits the connections open to the database. 0 means no limit.
+	MaxOpenConns int
+	// MaxIdleConns is the number of idle connections kept open.
+	MaxIdleConns int
+	// ConnMaxLifetime closes the connections once they are reused after it so that the connections
+	// move over to the new database instance behind the same address after a failover. 0 keeps them.
+	ConnMaxLifetime time.Duration
+	// StatementTimeout limits the queries of every storage call on top of the deadline of its context.
+	// 0 means no limit.
+	StatementTimeout time.Duration
+	// Retries is the number of times a transaction failed on a transient error is retried.
+	Retries int
+	// RetryBackoff is the delay before the first retry. It is doubled for each next retry.
+	RetryBackoff time.Duration
 }
 
-// OpenDB opens a connection to a postgres database instance.
+// OpenDB opens a connection to a postgres database instance or to the database
+// of another dialect selected by the scheme of the DSN.
 func OpenDB(dsn string) (*gorm.DB, error) {
-	db, err := gorm.Open("postgres", dsn)
-	db.LogMode(config.Debug)
+	d, source := parseDSN(dsn)
+	return openDB(d, source)
+}
+
+func openDB(d *dialect, source string) (*gorm.DB, error) {
+	db, err := gorm.Open(d.name, source)
 	if err != nil {
-		return nil, errors.Join(err, errors.New("failed to create a connection pool to PostgreSQL"))
+		return nil, errors.Join(err, fmt.Errorf("failed to create a connection pool to %s", d.name))
 	}
+	db.LogMode(config.Debug)
 	return db, nil
 }
 
@@ -54,18 +87,30 @@ func OpenDB(dsn string) (*gorm.DB, error) {
 func NewDatabase(name, dsn, migrationsDir string) (*Database, error) {
 	l := zap.L().Named(fmt.Sprintf("DB.%s", name))
 
-	db, err := OpenDB(dsn)
+	d, source := parseDSN(dsn)
+	db, err := openDB(d, source)
 	if err != nil {
 		return nil, errors.Join(err, errors.New("failed to connect to database"))
 	}
 
 	return &Database{
-		gormDB: db,
-		dir:    migrationsDir,
-		l:      l,
+		gormDB:  db,
+		dialect: d,
+		source:  source,
+		dir:     migrationsDir,
+		l:       l,
 	}, nil
 }
 
+// SetPoolOptions tunes the connection pool and the retries of the failed transactions.
+func (db *Database) SetPoolOptions(opts PoolOptions) {
+	sqlDB := db.gormDB.DB()
+	sqlDB.SetMaxOpenConns(opts.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(opts.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(opts.ConnMaxLifetime)
+	db.options = opts
+}
+
 // Close closes underlying database connections.
 func (db *Database) Close() error {
 	return db.gormDB.Close()
@@ -87,22 +132,177 @@ func (db *Database) Transaction(fn func(tx *gorm.DB) error) error {
 	return db.gormDB.Transaction(fn)
 }
 
-// Migrate migrates database schema up and returns actual schema version number.
-func (db *Database) Migrate() (uint, error) {
-	pgInstace, err := postgres.WithInstance(db.gormDB.DB(), &postgres.Config{})
+// withContext runs fn in a transaction bound to the context so that the queries are canceled
+// once the context is done. If the context has a deadline, it is also set as the statement timeout
+// so that Postgres stops executing the queries on its side as well. The other dialects only interrupt
+// the queries once the context is done.
+// The transaction is retried if it fails on a transient error before it is committed, e.g. while the
+// database fails over, since fn only runs queries in it.
+// The given tx is used as is if it is not nil.
+func (db *Database) withContext(ctx context.Context, tx *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if tx != nil {
+		return fn(tx)
+	}
+
+	backoff := db.options.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		committing, err := db.runTransaction(ctx, fn)
+		if err == nil || committing || attempt >= db.options.Retries || !db.dialect.isTransient(err) {
+			return err
+		}
+		db.l.Warn("retrying a transaction failed on a transient error", zap.Int("attempt", attempt+1), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// runTransaction runs fn in a transaction bound to the context. It returns true if the transaction
+// has reached the commit so that an error leaves it unknown whether the transaction has been applied.
+func (db *Database) runTransaction(ctx context.Context, fn func(tx *gorm.DB) error) (bool, error) {
+	if db.options.StatementTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, db.options.StatementTimeout)
+		defer cancel()
+	}
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	tx := db.gormDB.BeginTx(ctx, nil)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && db.dialect.statementTimeout != nil {
+		timeout := time.Until(deadline)
+		if timeout.Milliseconds() <= 0 {
+			tx.Rollback()
+			return false, context.DeadlineExceeded
+		}
+		if err := tx.Exec(db.dialect.statementTimeout(timeout)).Error; err != nil {
+			tx.Rollback()
+			return false, err
+		}
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	return true, tx.Commit().Error
+}
+
+// MigrationStatus is the state of the schema migrations of the database.
+type MigrationStatus struct {
+	// Version is the version of the last migration applied, 0 if none is.
+	Version uint
+	// Dirty is set if the last migration failed halfway. It has to be fixed by hand then.
+	Dirty bool
+	// Latest is the version of the last migration available.
+	Latest uint
+	// Pending are the versions of the migrations not applied yet.
+	Pending []uint
+}
+
+// migrator returns the migrator of the database and the function releasing it.
+func (db *Database) migrator() (*migrate.Migrate, func(), error) {
+	driver, err := db.dialect.migrationDriver(db.gormDB.DB(), db.source)
 	if err != nil {
-		return 0, errors.Join(err, errors.New("failed to setup migrator driver"))
+		return nil, nil, errors.Join(err, errors.New("failed to setup migrator driver"))
+	}
+	release := func() {
+		if db.dialect.ownsMigrationConnection {
+			driver.Close() //nolint:errcheck,gosec
+		}
 	}
 
-	m, err := migrate.NewWithDatabaseInstance("file://"+db.dir, "", pgInstace)
+	// Each dialect other than PostgreSQL has migrations of its own.
+	m, err := migrate.NewWithDatabaseInstance(db.migrationsURL(), "", driver)
 	if err != nil {
-		return 0, errors.Join(err, errors.New("failed to setup migrator"))
+		release()
+		return nil, nil, errors.Join(err, errors.New("failed to setup migrator"))
 	}
+	return m, release, nil
+}
+
+func (db *Database) migrationsURL() string {
+	return "file://" + filepath.Join(db.dir, db.dialect.migrationsDir)
+}
+
+// Migrate migrates database schema up and returns actual schema version number.
+func (db *Database) Migrate() (uint, error) {
+	m, release, err := db.migrator()
+	if err != nil {
+		return 0, err
+	}
+	defer release()
 
 	if err = m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
 		return 0, errors.Join(err, errors.New("failed to apply"))
 	}
 
+	return checkedVersion(m)
+}
+
+// MigrateDown reverts the given number of the last applied migrations and returns the schema version
+// number they leave the database at.
+func (db *Database) MigrateDown(steps int) (uint, error) {
+	if steps <= 0 {
+		return 0, errors.New("the number of migrations to revert shall be positive")
+	}
+	m, release, err := db.migrator()
+	if err != nil {
+		return 0, err
+	}
+	defer release()
+
+	if err = m.Steps(-steps); err != nil {
+		return 0, errors.Join(err, errors.New("failed to revert"))
+	}
+
+	v, err := checkedVersion(m)
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, nil
+	}
+	return v, err
+}
+
+// MigrationStatus returns the state of the schema migrations without applying any.
+func (db *Database) MigrationStatus() (*MigrationStatus, error) {
+	m, release, err := db.migrator()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	status := &MigrationStatus{}
+	status.Version, status.Dirty, err = m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return nil, errors.Join(err, errors.New("failed to check version"))
+	}
+
+	src, err := source.Open(db.migrationsURL())
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to read migrations"))
+	}
+	defer src.Close() //nolint:errcheck
+	v, err := src.First()
+	for ; err == nil; v, err = src.Next(v) {
+		status.Latest = v
+		if v > status.Version {
+			status.Pending = append(status.Pending, v)
+		}
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Join(err, errors.New("failed to read migrations"))
+	}
+	return status, nil
+}
+
+func checkedVersion(m *migrate.Migrate) (uint, error) {
 	v, dirty, err := m.Version()
 	if err != nil {
 		return 0, errors.Join(err, errors.New("failed to check version"))
@@ -113,3 +313,21 @@ func (db *Database) Migrate() (uint, error) {
 
 	return v, nil
 }
+
+// incrementVersion increments the version of the record with the key. If the expected version is set,
+// ErrVersionMismatch is returned unless it is the current version of the record. The record is expected
+// to exist.
+func incrementVersion(tx *gorm.DB, record interface{}, keyColumn, key string, expected *int64) error {
+	q := tx.Model(record).Where(keyColumn+" = ?", key)
+	if expected != nil {
+		q = q.Where("version = ?", *expected)
+	}
+	res := q.UpdateColumn("version", gorm.Expr("version + ?", 1))
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 && expected != nil {
+		return ErrVersionMismatch
+	}
+	return nil
+}
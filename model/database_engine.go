@@ -0,0 +1,97 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatabaseEngine represents db model for a cached DatabaseEngine of a Kubernetes cluster.
+type DatabaseEngine struct {
+	KubernetesID    string `gorm:"primary_key"`
+	Name            string `gorm:"primary_key"`
+	Type            string
+	State           string
+	OperatorVersion string
+	// AllowedVersions is a JSON encoded list of allowed versions.
+	AllowedVersions string
+	// AvailableVersions is a JSON encoded map of available versions per component.
+	AvailableVersions string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewDatabaseEngine converts a DatabaseEngine CR to its db model.
+func NewDatabaseEngine(kubernetesID string, engine *everestv1alpha1.DatabaseEngine) (*DatabaseEngine, error) {
+	allowed, err := json.Marshal(engine.Spec.AllowedVersions)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not marshal allowed versions"))
+	}
+	available, err := json.Marshal(engine.Status.AvailableVersions)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not marshal available versions"))
+	}
+
+	return &DatabaseEngine{
+		KubernetesID:      kubernetesID,
+		Name:              engine.Name,
+		Type:              string(engine.Spec.Type),
+		State:             string(engine.Status.State),
+		OperatorVersion:   engine.Status.OperatorVersion,
+		AllowedVersions:   string(allowed),
+		AvailableVersions: string(available),
+	}, nil
+}
+
+// K8sResource returns the DatabaseEngine CR as it was cached from Kubernetes.
+func (d *DatabaseEngine) K8sResource(namespace string) (*everestv1alpha1.DatabaseEngine, error) {
+	engine := &everestv1alpha1.DatabaseEngine{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: everestv1alpha1.GroupVersion.String(),
+			Kind:       "DatabaseEngine",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.Name,
+			Namespace: namespace,
+		},
+		Spec: everestv1alpha1.DatabaseEngineSpec{
+			Type: everestv1alpha1.EngineType(d.Type),
+		},
+		Status: everestv1alpha1.DatabaseEngineStatus{
+			State:           everestv1alpha1.EngineState(d.State),
+			OperatorVersion: d.OperatorVersion,
+		},
+	}
+
+	if d.AllowedVersions != "" {
+		if err := json.Unmarshal([]byte(d.AllowedVersions), &engine.Spec.AllowedVersions); err != nil {
+			return nil, errors.Join(err, errors.New("could not unmarshal allowed versions"))
+		}
+	}
+	if d.AvailableVersions != "" {
+		if err := json.Unmarshal([]byte(d.AvailableVersions), &engine.Status.AvailableVersions); err != nil {
+			return nil, errors.Join(err, errors.New("could not unmarshal available versions"))
+		}
+	}
+
+	return engine, nil
+}
@@ -0,0 +1,78 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ListDatabaseEngines returns all cached DatabaseEngine records of a Kubernetes cluster.
+func (db *Database) ListDatabaseEngines(ctx context.Context, kubernetesID string) ([]DatabaseEngine, error) {
+	var engines []DatabaseEngine
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Where("kubernetes_id = ?", kubernetesID).Order("name").Find(&engines).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return engines, nil
+}
+
+// GetDatabaseEngine returns a cached DatabaseEngine record by its Kubernetes cluster ID and name.
+func (db *Database) GetDatabaseEngine(ctx context.Context, kubernetesID, name string) (*DatabaseEngine, error) {
+	engine := &DatabaseEngine{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(engine, "kubernetes_id = ? AND name = ?", kubernetesID, name).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return engine, nil
+}
+
+// SaveDatabaseEngine creates or updates a cached DatabaseEngine record.
+func (db *Database) SaveDatabaseEngine(ctx context.Context, engine *DatabaseEngine) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Save(engine).Error
+	})
+}
+
+// SetDatabaseEngines replaces all cached DatabaseEngine records of a Kubernetes cluster
+// with the provided ones.
+func (db *Database) SetDatabaseEngines(ctx context.Context, kubernetesID string, engines []DatabaseEngine) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		names := make([]string, 0, len(engines))
+		for _, engine := range engines {
+			engine := engine
+			if engine.KubernetesID != kubernetesID {
+				return errors.New("database engine belongs to a different Kubernetes cluster")
+			}
+			if err := tx.Save(&engine).Error; err != nil {
+				return err
+			}
+			names = append(names, engine.Name)
+		}
+
+		q := tx.Where("kubernetes_id = ?", kubernetesID)
+		if len(names) != 0 {
+			q = q.Where("name NOT IN (?)", names)
+		}
+		return q.Delete(&DatabaseEngine{}).Error
+	})
+}
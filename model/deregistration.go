@@ -0,0 +1,55 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// States of a Kubernetes cluster deregistration in the order they are passed.
+const (
+	DeregistrationStatePending                  = "pending"
+	DeregistrationStateDeletingDatabaseClusters = "deleting-database-clusters"
+	DeregistrationStateDeletingConfigs          = "deleting-configs"
+	DeregistrationStateRemovingCluster          = "removing-cluster"
+	DeregistrationStateCompleted                = "completed"
+	DeregistrationStateFailed                   = "failed"
+)
+
+// Deregistration represents db model for the cascade removal of a Kubernetes cluster from Everest.
+type Deregistration struct {
+	KubernetesID string `gorm:"primary_key"`
+	State        string
+	// OrphanDatabaseClusters is set to keep the database clusters running instead of deleting them.
+	OrphanDatabaseClusters bool
+	// DatabaseClusters is the number of the database clusters found on the Kubernetes cluster
+	// and RemainingDatabaseClusters is the number of those still being deleted.
+	DatabaseClusters          int
+	RemainingDatabaseClusters int
+	// DeletedConfigs is the number of the backup storages and monitoring configs deleted from
+	// the Kubernetes cluster and KeptConfigs is the number of those kept because they are in use.
+	DeletedConfigs int
+	KeptConfigs    int
+	// Message describes the failure if the deregistration failed.
+	Message    string
+	FinishedAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// InProgress returns true if the deregistration has neither completed nor failed yet.
+func (d *Deregistration) InProgress() bool {
+	return d.State != DeregistrationStateCompleted && d.State != DeregistrationStateFailed
+}
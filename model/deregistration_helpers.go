@@ -0,0 +1,41 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// SaveDeregistration creates or updates the Deregistration record of a Kubernetes cluster.
+func (db *Database) SaveDeregistration(ctx context.Context, deregistration *Deregistration) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Save(deregistration).Error
+	})
+}
+
+// GetDeregistration returns the Deregistration record of a Kubernetes cluster.
+func (db *Database) GetDeregistration(ctx context.Context, kubernetesID string) (*Deregistration, error) {
+	deregistration := &Deregistration{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(deregistration, "kubernetes_id = ?", kubernetesID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deregistration, nil
+}
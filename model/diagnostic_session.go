@@ -0,0 +1,34 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// DiagnosticSession represents db model for diagnostic settings temporarily enabled on a database cluster.
+type DiagnosticSession struct {
+	KubernetesID  string `gorm:"primary_key"`
+	DBClusterName string `gorm:"primary_key"`
+	// OriginalConfig is the engine config of the database cluster before the diagnostic settings were applied.
+	OriginalConfig       string
+	GeneralLog           bool
+	SlowQueryThresholdMs *int
+	ProfilingLevel       *int
+	// ExpiresAt is the time the engine config is reverted at.
+	ExpiresAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
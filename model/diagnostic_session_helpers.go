@@ -0,0 +1,61 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// SaveDiagnosticSession creates or updates a DiagnosticSession record.
+func (db *Database) SaveDiagnosticSession(ctx context.Context, session *DiagnosticSession) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Save(session).Error
+	})
+}
+
+// GetDiagnosticSession returns a DiagnosticSession record by its Kubernetes cluster ID and database cluster name.
+func (db *Database) GetDiagnosticSession(ctx context.Context, kubernetesID, dbClusterName string) (*DiagnosticSession, error) {
+	session := &DiagnosticSession{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(session, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// ListExpiredDiagnosticSessions returns DiagnosticSession records which expired before the given time.
+func (db *Database) ListExpiredDiagnosticSessions(ctx context.Context, before time.Time) ([]DiagnosticSession, error) {
+	var sessions []DiagnosticSession
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Where("expires_at <= ?", before).Order("expires_at").Find(&sessions).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// DeleteDiagnosticSession deletes a DiagnosticSession record.
+func (db *Database) DeleteDiagnosticSession(ctx context.Context, kubernetesID, dbClusterName string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Delete(&DiagnosticSession{}, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName).Error
+	})
+}
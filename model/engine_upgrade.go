@@ -0,0 +1,55 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// States of a database engine upgrade in the order they are passed.
+const (
+	EngineUpgradeStateDeferred          = "deferred"
+	EngineUpgradeStatePending           = "pending"
+	EngineUpgradeStateUpgradingOperator = "upgrading-operator"
+	EngineUpgradeStateWaitingForBackup  = "waiting-for-backup"
+	EngineUpgradeStateUpgradingEngine   = "upgrading-engine"
+	EngineUpgradeStateCompleted         = "completed"
+	EngineUpgradeStateFailed            = "failed"
+)
+
+// EngineUpgrade represents db model for the latest upgrade of the database engine of a database cluster.
+type EngineUpgrade struct {
+	KubernetesID  string `gorm:"primary_key"`
+	DBClusterName string `gorm:"primary_key"`
+	FromVersion   string
+	TargetVersion string
+	// OperatorVersions is a JSON encoded list of the versions the operator
+	// is upgraded to, in order, before the database engine.
+	OperatorVersions string
+	// BackupStorageName is set if a backup is taken before the database engine is upgraded.
+	BackupStorageName string
+	BackupName        string
+	State             string
+	// Message describes the failure if the upgrade failed.
+	Message    string
+	FinishedAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// InProgress returns true if the upgrade has neither completed nor failed yet.
+func (u *EngineUpgrade) InProgress() bool {
+	return u.State != EngineUpgradeStateCompleted && u.State != EngineUpgradeStateFailed
+}
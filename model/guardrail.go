@@ -0,0 +1,34 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Guardrail represents db model for the limits enforced on the database clusters
+// of an engine type on a Kubernetes cluster.
+type Guardrail struct {
+	KubernetesID string `gorm:"primary_key"`
+	EngineType   string `gorm:"primary_key"`
+	// MaxReplicas and MaxConnections are not limited if zero.
+	MaxReplicas    int
+	MaxConnections int
+	// AllowedStorageClasses is a JSON encoded list of the storage classes
+	// the database clusters may use. Empty list allows any storage class.
+	AllowedStorageClasses string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,60 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// SaveGuardrail creates or updates the Guardrail record of an engine type on a Kubernetes cluster.
+func (db *Database) SaveGuardrail(ctx context.Context, guardrail *Guardrail) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Save(guardrail).Error
+	})
+}
+
+// ListGuardrails returns the Guardrail records of a Kubernetes cluster.
+func (db *Database) ListGuardrails(ctx context.Context, kubernetesID string) ([]Guardrail, error) {
+	var guardrails []Guardrail
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Where("kubernetes_id = ?", kubernetesID).Order("engine_type").Find(&guardrails).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return guardrails, nil
+}
+
+// GetGuardrail returns the Guardrail record of an engine type on a Kubernetes cluster.
+func (db *Database) GetGuardrail(ctx context.Context, kubernetesID, engineType string) (*Guardrail, error) {
+	guardrail := &Guardrail{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(guardrail, "kubernetes_id = ? AND engine_type = ?", kubernetesID, engineType).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return guardrail, nil
+}
+
+// DeleteGuardrail deletes the Guardrail record of an engine type on a Kubernetes cluster.
+func (db *Database) DeleteGuardrail(ctx context.Context, kubernetesID, engineType string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Delete(&Guardrail{}, "kubernetes_id = ? AND engine_type = ?", kubernetesID, engineType).Error
+	})
+}
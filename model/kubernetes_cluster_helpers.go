@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
 )
 
 // CreateKubernetesClusterParams parameters for KubernetesCluster record creation.
@@ -28,6 +29,25 @@ type CreateKubernetesClusterParams struct {
 	Name      string
 	Namespace *string
 	UID       string
+	// DefaultMonitoringInstanceName is the monitoring instance the new database clusters are attached to if set.
+	DefaultMonitoringInstanceName *string
+	// InCluster is set for the Kubernetes cluster Everest runs in.
+	InCluster bool
+	// Labels is a JSON encoded map of the labels organizing the Kubernetes clusters.
+	Labels string
+	// CreatedBy is the Everest user who registered the Kubernetes cluster if known.
+	CreatedBy string
+}
+
+// UpdateKubernetesClusterParams parameters for KubernetesCluster record update.
+type UpdateKubernetesClusterParams struct {
+	// DefaultMonitoringInstanceName set to empty unsets the default monitoring instance.
+	DefaultMonitoringInstanceName *string
+	// Labels is a JSON encoded map of the labels organizing the Kubernetes clusters.
+	Labels *string
+	// Version is the version the update is based on. ErrVersionMismatch is returned if the
+	// Kubernetes cluster has been updated since. Any version is updated if it is nil.
+	Version *int64
 }
 
 // KubernetesCluster represents db model for KubernetesCluster.
@@ -37,15 +57,42 @@ type KubernetesCluster struct {
 	Namespace string
 	// UID is the k8s UID of the namespace
 	UID string
+	// CompatibilityStatus tells whether the Kubernetes cluster serves the APIs of the everest operator.
+	CompatibilityStatus string `gorm:"default:'unknown'"`
+	// CompatibilityMessage describes the incompatibility if any.
+	CompatibilityMessage   string
+	CompatibilityCheckedAt *time.Time
+	// DefaultMonitoringInstanceName is the monitoring instance the new database clusters are attached to
+	// unless they opt out. It is unset together with the monitoring instance.
+	DefaultMonitoringInstanceName *string
+	// InCluster is set for the Kubernetes cluster Everest runs in. It is accessed
+	// with the service account of Everest instead of a kubeconfig provided by the user.
+	InCluster bool
+	// KubeconfigUpdatedAt is when the kubeconfig was last replaced. It is unset if the kubeconfig
+	// provided at the registration is still used.
+	KubeconfigUpdatedAt *time.Time
+	// Labels is a JSON encoded map of the labels organizing the Kubernetes clusters, e.g. env=prod.
+	Labels string
+	// Version is incremented on every update by the users so that the concurrent updates can be detected.
+	Version int64 `gorm:"default:1"`
+	// CreatedBy is the Everest user who registered the Kubernetes cluster if known.
+	CreatedBy string
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// Compatibility statuses of a Kubernetes cluster.
+const (
+	CompatibilityStatusUnknown      = "unknown"
+	CompatibilityStatusCompatible   = "compatible"
+	CompatibilityStatusIncompatible = "incompatible"
+)
+
 const defaultK8sNamespace = "percona-everest"
 
 // CreateKubernetesCluster creates a KubernetesCluster record.
-func (db *Database) CreateKubernetesCluster(_ context.Context, params CreateKubernetesClusterParams) (*KubernetesCluster, error) {
+func (db *Database) CreateKubernetesCluster(ctx context.Context, params CreateKubernetesClusterParams) (*KubernetesCluster, error) {
 	namespace := defaultK8sNamespace
 	if params.Namespace != nil {
 		namespace = *params.Namespace
@@ -58,8 +105,15 @@ func (db *Database) CreateKubernetesCluster(_ context.Context, params CreateKube
 		UID:       params.UID,
 		CreatedAt: time.Time{},
 		UpdatedAt: time.Time{},
+
+		DefaultMonitoringInstanceName: params.DefaultMonitoringInstanceName,
+		InCluster:                     params.InCluster,
+		Labels:                        params.Labels,
+		CreatedBy:                     params.CreatedBy,
 	}
-	err := db.gormDB.Create(k).Error
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(k).Error
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -68,9 +122,11 @@ func (db *Database) CreateKubernetesCluster(_ context.Context, params CreateKube
 }
 
 // ListKubernetesClusters returns all available KubernetesCluster records.
-func (db *Database) ListKubernetesClusters(_ context.Context) ([]KubernetesCluster, error) {
+func (db *Database) ListKubernetesClusters(ctx context.Context) ([]KubernetesCluster, error) {
 	var clusters []KubernetesCluster
-	err := db.gormDB.Find(&clusters).Error
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Find(&clusters).Error
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -78,11 +134,13 @@ func (db *Database) ListKubernetesClusters(_ context.Context) ([]KubernetesClust
 }
 
 // GetKubernetesCluster returns KubernetesCluster record by its ID.
-func (db *Database) GetKubernetesCluster(_ context.Context, id string) (*KubernetesCluster, error) {
+func (db *Database) GetKubernetesCluster(ctx context.Context, id string) (*KubernetesCluster, error) {
 	cluster := &KubernetesCluster{
 		ID: id,
 	}
-	err := db.gormDB.First(cluster).Error
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(cluster).Error
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +148,59 @@ func (db *Database) GetKubernetesCluster(_ context.Context, id string) (*Kuberne
 }
 
 // DeleteKubernetesCluster deletes a Kubernetes cluster by its ID.
-func (db *Database) DeleteKubernetesCluster(_ context.Context, id string) error {
-	return db.gormDB.Delete(&KubernetesCluster{ID: id}).Error
+func (db *Database) DeleteKubernetesCluster(ctx context.Context, id string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Delete(&KubernetesCluster{ID: id}).Error
+	})
+}
+
+// UpdateKubernetesClusterCompatibility updates the compatibility status of a Kubernetes cluster.
+func (db *Database) UpdateKubernetesClusterCompatibility(ctx context.Context, id, status, message string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Model(&KubernetesCluster{ID: id}).Updates(map[string]interface{}{
+			"compatibility_status":     status,
+			"compatibility_message":    message,
+			"compatibility_checked_at": time.Now().UTC(),
+		}).Error
+	})
+}
+
+// SetKubernetesClusterKubeconfigUpdated records that the kubeconfig of a Kubernetes cluster was replaced.
+func (db *Database) SetKubernetesClusterKubeconfigUpdated(ctx context.Context, id string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Model(&KubernetesCluster{ID: id}).Update("kubeconfig_updated_at", time.Now().UTC()).Error
+	})
+}
+
+// UpdateKubernetesCluster updates the fields of a Kubernetes cluster set in the params and increments its version.
+func (db *Database) UpdateKubernetesCluster(ctx context.Context, id string, params UpdateKubernetesClusterParams) error {
+	updates := make(map[string]interface{})
+	if params.DefaultMonitoringInstanceName != nil {
+		var value *string
+		if *params.DefaultMonitoringInstanceName != "" {
+			value = params.DefaultMonitoringInstanceName
+		}
+		updates["default_monitoring_instance_name"] = value
+	}
+	if params.Labels != nil {
+		updates["labels"] = *params.Labels
+	}
+
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		if err := incrementVersion(tx, &KubernetesCluster{}, "id", id, params.Version); err != nil {
+			return err
+		}
+		if len(updates) == 0 {
+			return nil
+		}
+		return tx.Model(&KubernetesCluster{}).Where("id = ?", id).Updates(updates).Error
+	})
+}
+
+// KubeconfigStoredAt returns when the kubeconfig used for the Kubernetes cluster was stored.
+func (k *KubernetesCluster) KubeconfigStoredAt() time.Time {
+	if k.KubeconfigUpdatedAt != nil {
+		return *k.KubeconfigUpdatedAt
+	}
+	return k.CreatedAt
 }
@@ -0,0 +1,85 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Sort orders supported by the list queries.
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// Pagination limits the records returned by the list queries.
+type Pagination struct {
+	// Limit is the maximum number of records to return. All records are returned if it is 0.
+	Limit int
+	// Offset is the number of records to skip.
+	Offset int
+}
+
+//nolint:gochecknoglobals
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// orderBy sorts the query by the column which corresponds to the sortBy field.
+// The records are sorted by name when sortBy is empty; name is also used as a tie-breaker.
+func orderBy(q *gorm.DB, columns map[string]string, sortBy, order string) (*gorm.DB, error) {
+	if sortBy == "" {
+		sortBy = "name"
+	}
+	column, ok := columns[sortBy]
+	if !ok {
+		return nil, fmt.Errorf("sorting by %s is not supported", sortBy)
+	}
+
+	switch order {
+	case "", SortOrderAsc:
+		order = SortOrderAsc
+	case SortOrderDesc:
+	default:
+		return nil, fmt.Errorf("unknown sort order %s", order)
+	}
+
+	q = q.Order(fmt.Sprintf("%s %s", column, order))
+	if column != "name" {
+		q = q.Order("name " + order)
+	}
+	return q, nil
+}
+
+// wherePrefix filters the query by the records which column starts with the given prefix.
+func wherePrefix(q *gorm.DB, column, prefix string) *gorm.DB {
+	if prefix == "" {
+		return q
+	}
+	return q.Where(fmt.Sprintf(`%s LIKE ? ESCAPE %s`, column, dialectOf(q).likeEscape), likeEscaper.Replace(prefix)+"%")
+}
+
+// paginate limits the query to the requested page of records.
+func paginate(q *gorm.DB, p Pagination) *gorm.DB {
+	if p.Limit > 0 {
+		q = q.Limit(p.Limit)
+	}
+	if p.Offset > 0 {
+		q = q.Offset(p.Offset)
+	}
+	return q
+}
@@ -0,0 +1,64 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Types of the operations deferred to the maintenance window.
+const (
+	PendingOperationUpdateDatabaseCluster = "update-database-cluster"
+	PendingOperationUpgradeEngine         = "upgrade-engine"
+)
+
+// States of the operations deferred to the maintenance window.
+const (
+	PendingOperationStatePending = "pending"
+	PendingOperationStateFailed  = "failed"
+)
+
+// MaintenanceWindow represents db model for the recurring window the disruptive operations
+// of a database cluster are deferred to.
+type MaintenanceWindow struct {
+	KubernetesID  string `gorm:"primary_key"`
+	DBClusterName string `gorm:"primary_key"`
+	// Schedule is the cron expression of 5 fields the window starts at in the time zone.
+	Schedule        string
+	TimeZone        string
+	DurationMinutes int
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PendingOperation represents db model for an operation of a database cluster deferred to its maintenance window.
+// The operation is deleted once it is applied.
+type PendingOperation struct {
+	ID            string `gorm:"primary_key"`
+	KubernetesID  string
+	DBClusterName string
+	// Namespace is the namespace of the database cluster if it is not the one of the Kubernetes cluster.
+	Namespace string
+	Type      string
+	// Payload is the JSON encoded database cluster of the update-database-cluster type.
+	Payload string
+	State   string
+	// Message describes the failure if applying the operation failed.
+	Message   string
+	CreatedBy string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,109 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// SaveMaintenanceWindow creates or replaces the maintenance window of a database cluster.
+func (db *Database) SaveMaintenanceWindow(ctx context.Context, window *MaintenanceWindow) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Save(window).Error
+	})
+}
+
+// GetMaintenanceWindow returns the maintenance window of a database cluster.
+func (db *Database) GetMaintenanceWindow(ctx context.Context, kubernetesID, dbClusterName string) (*MaintenanceWindow, error) {
+	window := &MaintenanceWindow{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(window, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+// DeleteMaintenanceWindow deletes the maintenance window of a database cluster.
+func (db *Database) DeleteMaintenanceWindow(ctx context.Context, kubernetesID, dbClusterName string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&MaintenanceWindow{}, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// CreatePendingOperation queues an operation of a database cluster.
+func (db *Database) CreatePendingOperation(ctx context.Context, op *PendingOperation) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(op).Error
+	})
+}
+
+// ListPendingOperations lists the queued operations of a database cluster in the order they were queued.
+// All queued operations are listed if the Kubernetes cluster ID is empty.
+func (db *Database) ListPendingOperations(ctx context.Context, kubernetesID, dbClusterName string) ([]PendingOperation, error) {
+	var ops []PendingOperation
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		if kubernetesID != "" {
+			tx = tx.Where("kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName)
+		}
+		return tx.Order("created_at").Find(&ops).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+// UpdatePendingOperationState sets the state and the message of a queued operation.
+func (db *Database) UpdatePendingOperationState(ctx context.Context, id, state, message string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Model(&PendingOperation{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"state":   state,
+			"message": message,
+		}).Error
+	})
+}
+
+// DeletePendingOperation deletes a queued operation of a database cluster.
+func (db *Database) DeletePendingOperation(ctx context.Context, kubernetesID, dbClusterName, id string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&PendingOperation{}, "kubernetes_id = ? AND db_cluster_name = ? AND id = ?", kubernetesID, dbClusterName, id)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// DeletePendingOperations deletes all queued operations of a database cluster.
+func (db *Database) DeletePendingOperations(ctx context.Context, kubernetesID, dbClusterName string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Delete(&PendingOperation{}, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName).Error
+	})
+}
@@ -29,19 +29,39 @@ import (
 // MonitoringInstanceType defines type of monitoring used by an instance.
 type MonitoringInstanceType string
 
-// PMMMonitoringInstanceType refers to PMM as a monitoring type.
-const PMMMonitoringInstanceType = "pmm"
+const (
+	// PMMMonitoringInstanceType refers to PMM as a monitoring type.
+	PMMMonitoringInstanceType = "pmm"
+	// PrometheusMonitoringInstanceType refers to a Prometheus compatible
+	// remote write endpoint, e.g. VictoriaMetrics, as a monitoring type.
+	PrometheusMonitoringInstanceType = "prometheus"
+)
 
 // MonitoringInstance represents a monitoring instance.
 type MonitoringInstance struct {
 	Type MonitoringInstanceType
 	Name string `gorm:"primary_key"`
 	URL  string
-	// ID of API key in secret storage
+	// ID of API key in secret storage. For the prometheus type it is
+	// the basic auth password if Username is set or the bearer token otherwise.
 	APIKeySecretID string
+	// Username is the basic auth username of the prometheus type.
+	Username string
+	// SecretGeneration is incremented on every update so that the Kubernetes
+	// clusters lagging behind can be detected and synced.
+	SecretGeneration int64 `gorm:"default:1"`
+	// Project is the project the monitoring instance belongs to, if any.
+	Project string
+	// Version is incremented on every update by the users so that the concurrent updates can be detected.
+	Version int64 `gorm:"default:1"`
+	// CreatedBy is the Everest user who created the monitoring instance if known.
+	CreatedBy string
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// DeletedAt is set once the monitoring instance is moved to the trash. Such records are left out
+	// by the queries unless they are unscoped and purged after the trash retention period.
+	DeletedAt *time.Time
 }
 
 // SecretName returns the name of the k8s secret as referenced by the k8s MonitoringConfig resource.
@@ -56,8 +76,24 @@ func (m *MonitoringInstance) Secrets(ctx context.Context, getSecret func(ctx con
 		return nil, err
 	}
 
+	if m.Type != PrometheusMonitoringInstanceType {
+		return map[string]string{
+			"apiKey": apiKey,
+		}, nil
+	}
+
+	// MonitoringConfigSpec has no settings for the prometheus type
+	// so the remote write endpoint is provided along with the credentials.
+	if m.Username == "" {
+		return map[string]string{
+			"url":         m.URL,
+			"bearerToken": apiKey,
+		}, nil
+	}
 	return map[string]string{
-		"apiKey": apiKey,
+		"url":      m.URL,
+		"username": m.Username,
+		"password": apiKey,
 	}, nil
 }
 
@@ -82,6 +118,8 @@ func (m *MonitoringInstance) K8sResource( //nolint:ireturn
 			URL:   m.URL,
 			Image: "percona/pmm-client:2",
 		}
+	case PrometheusMonitoringInstanceType:
+		// The remote write endpoint and its credentials are provided in the secret.
 	default:
 		return nil, fmt.Errorf("monitoring instance type %s not supported", m.Type)
 	}
@@ -17,7 +17,9 @@
 package model
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/jinzhu/gorm"
 )
@@ -27,50 +29,153 @@ type UpdateMonitoringInstanceParams struct {
 	Type           *MonitoringInstanceType
 	URL            *string
 	APIKeySecretID *string
+	Username       *string
+	// Version is the version the update is based on. ErrVersionMismatch is returned if the
+	// monitoring instance has been updated since. Any version is updated if it is nil.
+	Version *int64
+}
+
+// ListMonitoringInstancesParams stores parameters for monitoring instances listing.
+type ListMonitoringInstancesParams struct {
+	// SortBy is one of name, type or createdAt. Defaults to name.
+	SortBy string
+	// Order is either asc or desc. Defaults to asc.
+	Order      string
+	Type       string
+	NamePrefix string
+	// Projects limits the monitoring instances to the ones of the projects unless nil.
+	Projects []string
+	// Deleted lists the monitoring instances in the trash instead of the active ones.
+	Deleted bool
+	Pagination
+}
+
+//nolint:gochecknoglobals
+var monitoringInstanceSortColumns = map[string]string{
+	"name":      "name",
+	"type":      "type",
+	"createdAt": "created_at",
 }
 
 // CreateMonitoringInstance creates a new monitoring instance.
-func (db *Database) CreateMonitoringInstance(i *MonitoringInstance) (*MonitoringInstance, error) {
+func (db *Database) CreateMonitoringInstance(ctx context.Context, i *MonitoringInstance) (*MonitoringInstance, error) {
 	if i == nil {
 		return nil, errors.New("i parameter cannot be empty")
 	}
 
-	if err := db.gormDB.Create(i).Error; err != nil {
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(i).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return i, nil
 }
 
-// ListMonitoringInstances lists all monitoring instances.
-func (db *Database) ListMonitoringInstances() ([]MonitoringInstance, error) {
+// ListMonitoringInstances lists monitoring instances matching the filters in the requested order.
+func (db *Database) ListMonitoringInstances(ctx context.Context, params ListMonitoringInstancesParams) ([]MonitoringInstance, int, error) {
 	var i []MonitoringInstance
-	if err := db.gormDB.Find(&i).Error; err != nil {
+	var total int
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		q := tx.Model(&MonitoringInstance{})
+		if params.Deleted {
+			q = q.Unscoped().Where("deleted_at IS NOT NULL")
+		}
+		if params.Type != "" {
+			q = q.Where("type = ?", params.Type)
+		}
+		q = wherePrefix(q, "name", params.NamePrefix)
+		if params.Projects != nil {
+			q = q.Where("project IN (?)", params.Projects)
+		}
+
+		if err := q.Count(&total).Error; err != nil {
+			return err
+		}
+
+		q, err := orderBy(q, monitoringInstanceSortColumns, params.SortBy, params.Order)
+		if err != nil {
+			return err
+		}
+		return paginate(q, params.Pagination).Find(&i).Error
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return i, total, nil
+}
+
+// GetMonitoringInstance retrieves a monitoring instance.
+func (db *Database) GetMonitoringInstance(ctx context.Context, name string) (*MonitoringInstance, error) {
+	i := &MonitoringInstance{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(i, "name = ?", name).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 	return i, nil
 }
 
-// GetMonitoringInstance retrieves a monitoring instance.
-func (db *Database) GetMonitoringInstance(name string) (*MonitoringInstance, error) {
+// DeleteMonitoringInstance moves a monitoring instance to the trash.
+func (db *Database) DeleteMonitoringInstance(ctx context.Context, name string, tx *gorm.DB) error {
+	return db.withContext(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Delete(&MonitoringInstance{}, "name = ?", name).Error
+	})
+}
+
+// GetDeletedMonitoringInstance retrieves a monitoring instance in the trash.
+func (db *Database) GetDeletedMonitoringInstance(ctx context.Context, name string) (*MonitoringInstance, error) {
 	i := &MonitoringInstance{}
-	if err := db.gormDB.First(i, "name = ?", name).Error; err != nil {
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Unscoped().First(i, "name = ? AND deleted_at IS NOT NULL", name).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 	return i, nil
 }
 
-// DeleteMonitoringInstance deletes a monitoring instance.
-func (db *Database) DeleteMonitoringInstance(name string, tx *gorm.DB) error {
-	gormDB := db.gormDB
-	if tx != nil {
-		gormDB = tx
+// ListMonitoringInstancesDeletedBefore lists the monitoring instances moved to the trash before the given time.
+func (db *Database) ListMonitoringInstancesDeletedBefore(ctx context.Context, before time.Time) ([]MonitoringInstance, error) {
+	var i []MonitoringInstance
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Unscoped().Where("deleted_at < ?", before).Find(&i).Error
+	})
+	if err != nil {
+		return nil, err
 	}
-	return gormDB.Delete(&MonitoringInstance{}, "name = ?", name).Error
+	return i, nil
+}
+
+// RestoreMonitoringInstance takes a monitoring instance out of the trash.
+// gorm.ErrRecordNotFound is returned if it is not in the trash.
+func (db *Database) RestoreMonitoringInstance(ctx context.Context, name string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Unscoped().Model(&MonitoringInstance{}).
+			Where("name = ? AND deleted_at IS NOT NULL", name).
+			UpdateColumn("deleted_at", nil)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// PurgeMonitoringInstance deletes a monitoring instance permanently.
+func (db *Database) PurgeMonitoringInstance(ctx context.Context, name string, tx *gorm.DB) error {
+	return db.withContext(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Unscoped().Delete(&MonitoringInstance{}, "name = ?", name).Error
+	})
 }
 
-// UpdateMonitoringInstance updates fields of a monitoring instance based on the provided fields.
-func (db *Database) UpdateMonitoringInstance(name string, params UpdateMonitoringInstanceParams) error {
+// UpdateMonitoringInstance updates fields of a monitoring instance based on the provided fields
+// and increments its secret generation and its version.
+func (db *Database) UpdateMonitoringInstance(ctx context.Context, name string, params UpdateMonitoringInstanceParams) error {
 	i := &MonitoringInstance{Name: name}
 	if params.Type != nil {
 		i.Type = *params.Type
@@ -82,5 +187,24 @@ func (db *Database) UpdateMonitoringInstance(name string, params UpdateMonitorin
 		i.APIKeySecretID = *params.APIKeySecretID
 	}
 
-	return db.gormDB.Model(&MonitoringInstance{}).Updates(i).Error
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		if err := incrementVersion(tx, &MonitoringInstance{}, "name", name, params.Version); err != nil {
+			return err
+		}
+		if err := tx.Model(&MonitoringInstance{}).Updates(i).Error; err != nil {
+			return err
+		}
+		// Updates skips zero values so an empty username switching to a bearer token is set explicitly.
+		if params.Username != nil {
+			err := tx.Model(&MonitoringInstance{}).
+				Where("name = ?", name).
+				UpdateColumn("username", *params.Username).Error
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Model(&MonitoringInstance{}).
+			Where("name = ?", name).
+			UpdateColumn("secret_generation", gorm.Expr("secret_generation + ?", 1)).Error
+	})
 }
@@ -0,0 +1,36 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// NamespaceTemplate represents db model for the template of the namespaces
+// the database clusters of a project are created in on a Kubernetes cluster.
+type NamespaceTemplate struct {
+	KubernetesID string `gorm:"primary_key"`
+	// Template is the name of the namespace with the {project} and {env} placeholders.
+	Template string
+	// Labels is a JSON encoded map of the labels set on the created namespaces.
+	Labels string
+	// QuotaCPU, QuotaMemory and QuotaStorage are the limits of the resource quota
+	// created in the namespaces. Empty values are not limited.
+	QuotaCPU     string
+	QuotaMemory  string
+	QuotaStorage string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
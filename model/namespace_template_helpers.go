@@ -0,0 +1,48 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// SaveNamespaceTemplate creates or updates the NamespaceTemplate record of a Kubernetes cluster.
+func (db *Database) SaveNamespaceTemplate(ctx context.Context, template *NamespaceTemplate) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Save(template).Error
+	})
+}
+
+// GetNamespaceTemplate returns the NamespaceTemplate record of a Kubernetes cluster.
+func (db *Database) GetNamespaceTemplate(ctx context.Context, kubernetesID string) (*NamespaceTemplate, error) {
+	template := &NamespaceTemplate{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(template, "kubernetes_id = ?", kubernetesID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// DeleteNamespaceTemplate deletes the NamespaceTemplate record of a Kubernetes cluster.
+func (db *Database) DeleteNamespaceTemplate(ctx context.Context, kubernetesID string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Delete(&NamespaceTemplate{}, "kubernetes_id = ?", kubernetesID).Error
+	})
+}
@@ -0,0 +1,56 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Types of the notification channels.
+const (
+	NotificationChannelSlack     = "slack"
+	NotificationChannelEmail     = "email"
+	NotificationChannelPagerDuty = "pagerduty"
+	NotificationChannelWebhook   = "webhook"
+)
+
+// NotificationChannel represents db model for a destination the notification events are delivered to.
+type NotificationChannel struct {
+	Name string `gorm:"primary_key"`
+	// Type is one of slack, email, pagerduty or webhook.
+	Type string
+	// Target is the comma separated list of the recipients of the email type
+	// and the URL of the webhook type.
+	Target string
+	// SecretID is the ID of the Slack webhook URL or the PagerDuty routing key in secrets storage.
+	SecretID string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NotificationRule represents db model for a rule delivering the matching notification events to a channel.
+type NotificationRule struct {
+	Name        string `gorm:"primary_key"`
+	ChannelName string
+	// EventTypes is the comma separated list of the matching event types. All of them match if it is empty.
+	EventTypes string
+	// MinSeverity is the lowest severity of the matching events.
+	MinSeverity string
+	// KubernetesID limits the matching events to a Kubernetes cluster if set.
+	KubernetesID *string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,133 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// UpdateNotificationChannelParams stores fields to be updated in a notification channel.
+type UpdateNotificationChannelParams struct {
+	Target   *string
+	SecretID *string
+}
+
+// CreateNotificationChannel creates a notification channel.
+func (db *Database) CreateNotificationChannel(ctx context.Context, channel *NotificationChannel) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(channel).Error
+	})
+}
+
+// ListNotificationChannels lists the notification channels ordered by name.
+func (db *Database) ListNotificationChannels(ctx context.Context) ([]NotificationChannel, error) {
+	var channels []NotificationChannel
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Order("name").Find(&channels).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+// GetNotificationChannel returns a notification channel.
+func (db *Database) GetNotificationChannel(ctx context.Context, name string) (*NotificationChannel, error) {
+	channel := &NotificationChannel{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(channel, "name = ?", name).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+// UpdateNotificationChannel updates fields of a notification channel based on the provided fields.
+func (db *Database) UpdateNotificationChannel(ctx context.Context, name string, params UpdateNotificationChannelParams) error {
+	updates := map[string]interface{}{}
+	if params.Target != nil {
+		updates["target"] = *params.Target
+	}
+	if params.SecretID != nil {
+		updates["secret_id"] = *params.SecretID
+	}
+
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Model(&NotificationChannel{}).Where("name = ?", name).Updates(updates).Error
+	})
+}
+
+// DeleteNotificationChannel deletes a notification channel along with its rules.
+func (db *Database) DeleteNotificationChannel(ctx context.Context, name string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&NotificationChannel{}, "name = ?", name)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// CreateNotificationRule creates a notification rule.
+func (db *Database) CreateNotificationRule(ctx context.Context, rule *NotificationRule) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(rule).Error
+	})
+}
+
+// ListNotificationRules lists the notification rules ordered by name.
+func (db *Database) ListNotificationRules(ctx context.Context) ([]NotificationRule, error) {
+	var rules []NotificationRule
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Order("name").Find(&rules).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// GetNotificationRule returns a notification rule.
+func (db *Database) GetNotificationRule(ctx context.Context, name string) (*NotificationRule, error) {
+	rule := &NotificationRule{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(rule, "name = ?", name).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// DeleteNotificationRule deletes a notification rule.
+func (db *Database) DeleteNotificationRule(ctx context.Context, name string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&NotificationRule{}, "name = ?", name)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
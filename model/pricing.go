@@ -0,0 +1,36 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Pricing represents db model for the prices the costs of the database clusters are estimated with.
+// There is only one Pricing record.
+type Pricing struct {
+	ID       int `gorm:"primary_key"`
+	Currency string
+	// CPUPrice is the monthly price of a CPU core, MemoryPrice and StoragePrice
+	// are the monthly prices of a GiB of memory and of storage.
+	CPUPrice     float64
+	MemoryPrice  float64
+	StoragePrice float64
+	// Regions is a JSON encoded map of the cloud regions to the prices overriding
+	// the default ones in the region.
+	Regions string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
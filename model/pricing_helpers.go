@@ -0,0 +1,54 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jinzhu/gorm"
+)
+
+// pricingID is the ID of the only Pricing record.
+const pricingID = 1
+
+// GetPricing returns the Pricing record. gorm.ErrRecordNotFound is returned if no prices have been set.
+func (db *Database) GetPricing(ctx context.Context) (*Pricing, error) {
+	pricing := &Pricing{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(pricing, "id = ?", pricingID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pricing, nil
+}
+
+// SavePricing creates or replaces the Pricing record.
+func (db *Database) SavePricing(ctx context.Context, pricing *Pricing) error {
+	pricing.ID = pricingID
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		existing := &Pricing{}
+		err := tx.First(existing, "id = ?", pricingID).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if err == nil {
+			pricing.CreatedAt = existing.CreatedAt
+		}
+		return tx.Save(pricing).Error
+	})
+}
@@ -0,0 +1,49 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Roles of the members of the projects.
+const (
+	// ProjectRoleViewer may read the resources of the project.
+	ProjectRoleViewer = "viewer"
+	// ProjectRoleEditor may also create, change and delete the resources of the project.
+	ProjectRoleEditor = "editor"
+	// ProjectRoleAdmin may also manage the members of the project.
+	ProjectRoleAdmin = "admin"
+)
+
+// Project represents db model for a project the database clusters, backup storages
+// and monitoring instances belong to.
+type Project struct {
+	Name        string `gorm:"primary_key"`
+	Description string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ProjectMember represents db model for the role of a user or a team in a project.
+type ProjectMember struct {
+	Project     string `gorm:"primary_key"`
+	SubjectKind string `gorm:"primary_key"`
+	Subject     string `gorm:"primary_key"`
+	Role        string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,128 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// CreateProject creates a Project record.
+func (db *Database) CreateProject(ctx context.Context, project *Project) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(project).Error
+	})
+}
+
+// ListProjects returns the Project records. Only the projects with the given names
+// are returned unless the names are nil.
+func (db *Database) ListProjects(ctx context.Context, names []string) ([]Project, error) {
+	var projects []Project
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		if names != nil {
+			tx = tx.Where("name IN (?)", names)
+		}
+		return tx.Order("name").Find(&projects).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// GetProject returns the Project record by its name.
+func (db *Database) GetProject(ctx context.Context, name string) (*Project, error) {
+	project := &Project{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(project, "name = ?", name).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// DeleteProject deletes the Project record and its members.
+// gorm.ErrRecordNotFound is returned if there is no such record.
+func (db *Database) DeleteProject(ctx context.Context, name string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&Project{}, "name = ?", name)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// SaveProjectMember creates or updates the ProjectMember record of a user or a team.
+func (db *Database) SaveProjectMember(ctx context.Context, member *ProjectMember) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		existing := &ProjectMember{}
+		err := tx.First(existing, "project = ? AND subject_kind = ? AND subject = ?",
+			member.Project, member.SubjectKind, member.Subject).Error
+		if err == nil {
+			member.CreatedAt = existing.CreatedAt
+		}
+		return tx.Save(member).Error
+	})
+}
+
+// ListProjectMembers returns the ProjectMember records of a project.
+func (db *Database) ListProjectMembers(ctx context.Context, project string) ([]ProjectMember, error) {
+	var members []ProjectMember
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Where("project = ?", project).Order("subject_kind, subject").Find(&members).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// ListProjectMemberships returns the ProjectMember records of a user and of the teams.
+func (db *Database) ListProjectMemberships(ctx context.Context, user string, teams []string) ([]ProjectMember, error) {
+	var members []ProjectMember
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		q := tx.Where("subject_kind = ? AND subject = ?", SubjectKindUser, user)
+		if len(teams) != 0 {
+			q = q.Or("subject_kind = ? AND subject IN (?)", SubjectKindTeam, teams)
+		}
+		return q.Order("project").Find(&members).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// DeleteProjectMember deletes the ProjectMember record of a user or a team.
+// gorm.ErrRecordNotFound is returned if there is no such record.
+func (db *Database) DeleteProjectMember(ctx context.Context, project, subjectKind, subject string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&ProjectMember{}, "project = ? AND subject_kind = ? AND subject = ?", project, subjectKind, subject)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
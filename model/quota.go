@@ -0,0 +1,44 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Kinds of the subjects of the quotas and of the project memberships.
+const (
+	SubjectKindUser = "user"
+	SubjectKindTeam = "team"
+)
+
+// Quota represents db model for the limits of the resources the database clusters
+// of a user or a team may request across all the Kubernetes clusters.
+// A limit is not enforced if zero.
+type Quota struct {
+	SubjectKind         string `gorm:"primary_key"`
+	Subject             string `gorm:"primary_key"`
+	MaxDatabaseClusters int
+	MaxCPUMillis        int64
+	MaxMemoryBytes      int64
+	MaxStorageBytes     int64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName returns the name of the quotas table which the naming of gorm does not pluralize.
+func (Quota) TableName() string {
+	return "quotas"
+}
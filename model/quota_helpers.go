@@ -0,0 +1,73 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// SaveQuota creates or replaces the Quota record of a subject.
+func (db *Database) SaveQuota(ctx context.Context, quota *Quota) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		existing := &Quota{}
+		err := tx.First(existing, "subject_kind = ? AND subject = ?", quota.SubjectKind, quota.Subject).Error
+		if err == nil {
+			quota.CreatedAt = existing.CreatedAt
+		}
+		return tx.Save(quota).Error
+	})
+}
+
+// ListQuotas returns all Quota records.
+func (db *Database) ListQuotas(ctx context.Context) ([]Quota, error) {
+	var quotas []Quota
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Order("subject_kind, subject").Find(&quotas).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return quotas, nil
+}
+
+// GetQuota returns the Quota record of a subject.
+func (db *Database) GetQuota(ctx context.Context, subjectKind, subject string) (*Quota, error) {
+	quota := &Quota{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(quota, "subject_kind = ? AND subject = ?", subjectKind, subject).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+// DeleteQuota deletes the Quota record of a subject.
+// gorm.ErrRecordNotFound is returned if there is no such record.
+func (db *Database) DeleteQuota(ctx context.Context, subjectKind, subject string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&Quota{}, "subject_kind = ? AND subject = ?", subjectKind, subject)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
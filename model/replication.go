@@ -0,0 +1,106 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package model ...
+package model
+
+// ReplicationSnapshot is the state of an Everest instance replicated to its standby instances.
+// The values of secrets are not replicated, only the references to them are.
+type ReplicationSnapshot struct {
+	KubernetesClusters             []KubernetesCluster            `json:"kubernetesClusters"`
+	BackupStorages                 []BackupStorage                `json:"backupStorages"`
+	MonitoringInstances            []MonitoringInstance           `json:"monitoringInstances"`
+	BackupSLOs                     []BackupSLO                    `json:"backupSLOs"`
+	DiagnosticSessions             []DiagnosticSession            `json:"diagnosticSessions"`
+	ConfigSyncs                    []ConfigSync                   `json:"configSyncs"`
+	ConfigDeletions                []ConfigDeletion               `json:"configDeletions"`
+	ConfigRollouts                 []ConfigRollout                `json:"configRollouts"`
+	NamespaceTemplates             []NamespaceTemplate            `json:"namespaceTemplates"`
+	Guardrails                     []Guardrail                    `json:"guardrails"`
+	TemporaryAccesses              []TemporaryAccess              `json:"temporaryAccesses"`
+	Bootstraps                     []Bootstrap                    `json:"bootstraps"`
+	Deregistrations                []Deregistration               `json:"deregistrations"`
+	EngineUpgrades                 []EngineUpgrade                `json:"engineUpgrades"`
+	MaintenanceWindows             []MaintenanceWindow            `json:"maintenanceWindows"`
+	PendingOperations              []PendingOperation             `json:"pendingOperations"`
+	BackupStorageRetentionPolicies []BackupStorageRetentionPolicy `json:"backupStorageRetentionPolicies"`
+	DBClusterRetentionPolicies     []DBClusterRetentionPolicy     `json:"dbClusterRetentionPolicies"`
+	BackupVerificationPolicies     []BackupVerificationPolicy     `json:"backupVerificationPolicies"`
+	AlertRuleTemplates             []AlertRuleTemplate            `json:"alertRuleTemplates"`
+	AlertRuleSyncs                 []AlertRuleSync                `json:"alertRuleSyncs"`
+	NotificationChannels           []NotificationChannel          `json:"notificationChannels"`
+	NotificationRules              []NotificationRule             `json:"notificationRules"`
+	Webhooks                       []Webhook                      `json:"webhooks"`
+	Pricings                       []Pricing                      `json:"pricings"`
+	Quotas                         []Quota                        `json:"quotas"`
+	Projects                       []Project                      `json:"projects"`
+	ProjectMembers                 []ProjectMember                `json:"projectMembers"`
+	APITokens                      []APIToken                     `json:"apiTokens"`
+	Sessions                       []Session                      `json:"sessions"`
+	Setups                         []Setup                        `json:"setups"`
+	// SecretIDs are the IDs of the secrets referenced by the replicated records.
+	SecretIDs []string `json:"secretIDs"`
+}
+
+// replicationExcludedTables are the tables left out of the replication snapshot by the reason.
+// Every other table shall be replicated.
+//
+//nolint:gochecknoglobals
+var replicationExcludedTables = map[string]string{
+	"schema_migrations":    "the standby instances run the migrations themselves",
+	"secrets":              "the values are replicated by the secrets storage and referenced by SecretIDs",
+	"audit_entries":        "every instance audits the operations it serves",
+	"database_engines":     "the cache is refreshed from the Kubernetes clusters",
+	"backup_verifications": "the results are kept by the instance running the verifications and their ids are generated",
+}
+
+// tables returns the replicated records by table in the order they are inserted in
+// so that the referenced records exist before the ones referencing them.
+func (s *ReplicationSnapshot) tables() []interface{} {
+	return []interface{}{
+		// Kubernetes clusters reference their default monitoring instances.
+		&s.MonitoringInstances,
+		&s.BackupStorages,
+		&s.KubernetesClusters,
+		&s.BackupSLOs,
+		&s.DiagnosticSessions,
+		&s.ConfigSyncs,
+		&s.ConfigDeletions,
+		&s.ConfigRollouts,
+		&s.NamespaceTemplates,
+		&s.Guardrails,
+		&s.TemporaryAccesses,
+		&s.Bootstraps,
+		&s.Deregistrations,
+		&s.EngineUpgrades,
+		&s.MaintenanceWindows,
+		&s.PendingOperations,
+		&s.BackupStorageRetentionPolicies,
+		&s.DBClusterRetentionPolicies,
+		&s.BackupVerificationPolicies,
+		&s.AlertRuleTemplates,
+		&s.AlertRuleSyncs,
+		&s.NotificationChannels,
+		&s.NotificationRules,
+		&s.Webhooks,
+		&s.Pricings,
+		&s.Quotas,
+		&s.Projects,
+		&s.ProjectMembers,
+		&s.APITokens,
+		&s.Sessions,
+		&s.Setups,
+	}
+}
@@ -0,0 +1,96 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package model ...
+package model
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/jinzhu/gorm"
+)
+
+// GetReplicationSnapshot returns the current state of the database to be replicated to standby instances.
+func (db *Database) GetReplicationSnapshot(ctx context.Context) (*ReplicationSnapshot, error) {
+	s := &ReplicationSnapshot{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		// The trashed records are replicated too for them to be restorable on the standby instances.
+		for _, dest := range s.tables() {
+			if err := tx.Unscoped().Find(dest).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bs := range s.BackupStorages {
+		s.SecretIDs = appendSecretIDs(s.SecretIDs, bs.AccessKeyID, bs.SecretKeyID, bs.SessionTokenID, bs.CACertID)
+		// The previous credentials are in use until the rotation is over.
+		s.SecretIDs = appendSecretIDs(s.SecretIDs, bs.PreviousAccessKeyID, bs.PreviousSecretKeyID)
+	}
+	for _, mi := range s.MonitoringInstances {
+		s.SecretIDs = appendSecretIDs(s.SecretIDs, mi.APIKeySecretID)
+	}
+	for _, k := range s.KubernetesClusters {
+		s.SecretIDs = appendSecretIDs(s.SecretIDs, k.ID)
+	}
+	for _, c := range s.NotificationChannels {
+		s.SecretIDs = appendSecretIDs(s.SecretIDs, c.SecretID)
+	}
+	for _, w := range s.Webhooks {
+		s.SecretIDs = appendSecretIDs(s.SecretIDs, w.SecretID)
+	}
+
+	return s, nil
+}
+
+// appendSecretIDs appends the set secret IDs, the empty ones stand for the secrets not in use.
+func appendSecretIDs(ids []string, secretIDs ...string) []string {
+	for _, id := range secretIDs {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// ApplyReplicationSnapshot replaces the replicated state of the database with the given snapshot.
+func (db *Database) ApplyReplicationSnapshot(ctx context.Context, s *ReplicationSnapshot) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		tables := s.tables()
+		// The records referencing the others are deleted first. The trashed records are deleted
+		// for good as well since the snapshot recreates them.
+		for i := len(tables) - 1; i >= 0; i-- {
+			model := reflect.New(reflect.TypeOf(tables[i]).Elem().Elem()).Interface()
+			if err := tx.Unscoped().Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, table := range tables {
+			records := reflect.ValueOf(table).Elem()
+			for i := 0; i < records.Len(); i++ {
+				if err := tx.Create(records.Index(i).Addr().Interface()).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
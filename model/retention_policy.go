@@ -0,0 +1,49 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// BackupStorageRetentionPolicy represents db model for the retention policy of the backups in a backup storage.
+type BackupStorageRetentionPolicy struct {
+	BackupStorageName string `gorm:"primary_key"`
+	RetentionLimits
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DBClusterRetentionPolicy represents db model for the retention policy of the backups of a database cluster.
+// It overrides the policy of the backup storage the backups are stored in.
+type DBClusterRetentionPolicy struct {
+	KubernetesID  string `gorm:"primary_key"`
+	DBClusterName string `gorm:"primary_key"`
+	RetentionLimits
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RetentionLimits defines which backups are expired. The zero values disable the limits.
+type RetentionLimits struct {
+	MaxAgeDays int
+	MaxCopies  int
+}
+
+// MaxAge returns the age the backups expire at.
+func (l RetentionLimits) MaxAge() time.Duration {
+	return time.Duration(l.MaxAgeDays) * 24 * time.Hour
+}
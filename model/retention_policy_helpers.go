@@ -0,0 +1,143 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// SaveBackupStorageRetentionPolicy creates or updates a BackupStorageRetentionPolicy record.
+func (db *Database) SaveBackupStorageRetentionPolicy(
+	ctx context.Context, backupStorageName string, limits RetentionLimits,
+) (*BackupStorageRetentionPolicy, error) {
+	policy := &BackupStorageRetentionPolicy{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.
+			Where(BackupStorageRetentionPolicy{BackupStorageName: backupStorageName}).
+			Assign(retentionLimitsColumns(limits)).
+			FirstOrCreate(policy).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// ListBackupStorageRetentionPolicies returns all BackupStorageRetentionPolicy records.
+func (db *Database) ListBackupStorageRetentionPolicies(ctx context.Context) ([]BackupStorageRetentionPolicy, error) {
+	var policies []BackupStorageRetentionPolicy
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Find(&policies).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// GetBackupStorageRetentionPolicy returns a BackupStorageRetentionPolicy record by its backup storage name.
+func (db *Database) GetBackupStorageRetentionPolicy(ctx context.Context, backupStorageName string) (*BackupStorageRetentionPolicy, error) {
+	policy := &BackupStorageRetentionPolicy{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(policy, "backup_storage_name = ?", backupStorageName).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// DeleteBackupStorageRetentionPolicy deletes a BackupStorageRetentionPolicy record.
+// gorm.ErrRecordNotFound is returned if there is no such record.
+func (db *Database) DeleteBackupStorageRetentionPolicy(ctx context.Context, backupStorageName string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&BackupStorageRetentionPolicy{}, "backup_storage_name = ?", backupStorageName)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// SaveDBClusterRetentionPolicy creates or updates a DBClusterRetentionPolicy record.
+func (db *Database) SaveDBClusterRetentionPolicy(
+	ctx context.Context, kubernetesID, dbClusterName string, limits RetentionLimits,
+) (*DBClusterRetentionPolicy, error) {
+	policy := &DBClusterRetentionPolicy{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.
+			Where(DBClusterRetentionPolicy{KubernetesID: kubernetesID, DBClusterName: dbClusterName}).
+			Assign(retentionLimitsColumns(limits)).
+			FirstOrCreate(policy).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// ListDBClusterRetentionPolicies returns all DBClusterRetentionPolicy records of a Kubernetes cluster.
+func (db *Database) ListDBClusterRetentionPolicies(ctx context.Context, kubernetesID string) ([]DBClusterRetentionPolicy, error) {
+	var policies []DBClusterRetentionPolicy
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Where("kubernetes_id = ?", kubernetesID).Find(&policies).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// GetDBClusterRetentionPolicy returns a DBClusterRetentionPolicy record by its Kubernetes cluster ID
+// and database cluster name.
+func (db *Database) GetDBClusterRetentionPolicy(ctx context.Context, kubernetesID, dbClusterName string) (*DBClusterRetentionPolicy, error) {
+	policy := &DBClusterRetentionPolicy{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(policy, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// DeleteDBClusterRetentionPolicy deletes a DBClusterRetentionPolicy record.
+// gorm.ErrRecordNotFound is returned if there is no such record.
+func (db *Database) DeleteDBClusterRetentionPolicy(ctx context.Context, kubernetesID, dbClusterName string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&DBClusterRetentionPolicy{}, "kubernetes_id = ? AND db_cluster_name = ?", kubernetesID, dbClusterName)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// retentionLimitsColumns lets the limits be updated to the zero values.
+func retentionLimitsColumns(limits RetentionLimits) map[string]interface{} {
+	return map[string]interface{}{
+		"max_age_days": limits.MaxAgeDays,
+		"max_copies":   limits.MaxCopies,
+	}
+}
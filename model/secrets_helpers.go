@@ -19,6 +19,8 @@ package model
 import (
 	"context"
 	"time"
+
+	"github.com/jinzhu/gorm"
 )
 
 // Secret represents a key-value secret. TODO: move secrets out of pg //nolint:godox.
@@ -31,52 +33,89 @@ type Secret struct {
 }
 
 // CreateSecret creates a new Secret record in db.
-func (db *Database) CreateSecret(_ context.Context, id, value string) error {
+func (db *Database) CreateSecret(ctx context.Context, id, value string) error {
 	secret := &Secret{
 		ID:    id,
 		Value: value,
 	}
-	return db.gormDB.Create(secret).Error
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(secret).Error
+	})
 }
 
 // GetSecret returns the secret by its id.
-func (db *Database) GetSecret(_ context.Context, id string) (string, error) {
+func (db *Database) GetSecret(ctx context.Context, id string) (string, error) {
 	secret := &Secret{
 		ID: id,
 	}
-	err := db.gormDB.First(secret).Error
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(secret).Error
+	})
 	if err != nil {
 		return "", err
 	}
 	return secret.Value, nil
 }
 
+// GetSecrets returns the secrets by their ids in a single query.
+// gorm.ErrRecordNotFound is returned if any of them does not exist.
+func (db *Database) GetSecrets(ctx context.Context, ids []string) (map[string]string, error) {
+	var secrets []Secret
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Where("id IN (?)", ids).Find(&secrets).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string, len(secrets))
+	for _, secret := range secrets {
+		res[secret.ID] = secret.Value
+	}
+	for _, id := range ids {
+		if _, ok := res[id]; !ok {
+			return nil, gorm.ErrRecordNotFound
+		}
+	}
+	return res, nil
+}
+
+// ListSecrets returns all Secret records.
+func (db *Database) ListSecrets(ctx context.Context) ([]Secret, error) {
+	var secrets []Secret
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Order("id").Find(&secrets).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
 // UpdateSecret updates the secret by its id.
-func (db *Database) UpdateSecret(_ context.Context, id, value string) error {
+func (db *Database) UpdateSecret(ctx context.Context, id, value string) error {
 	secret := &Secret{
 		ID:    id,
 		Value: value,
 	}
-	err := db.gormDB.Save(secret).Error
-	if err != nil {
-		return err
-	}
-	return nil
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Save(secret).Error
+	})
 }
 
 // DeleteSecret deletes the secret by its id. Returns the deleted secret.
-func (db *Database) DeleteSecret(c context.Context, id string) (string, error) {
+func (db *Database) DeleteSecret(ctx context.Context, id string) (string, error) {
 	secret := &Secret{
 		ID: id,
 	}
-	oldValue, err := db.GetSecret(c, id)
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		if err := tx.First(secret).Error; err != nil {
+			return err
+		}
+		return tx.Delete(secret).Error
+	})
 	if err != nil {
 		return "", err
 	}
-
-	err = db.gormDB.Delete(secret).Error
-	if err != nil {
-		return "", err
-	}
-	return oldValue, nil
+	return secret.Value, nil
 }
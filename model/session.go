@@ -0,0 +1,38 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Session represents db model for a session of a user logged in to Everest.
+// Only the hashes of the tokens are stored.
+type Session struct {
+	ID       string `gorm:"primary_key"`
+	Username string
+	// Groups is the JSON encoded list of the groups of the user.
+	Groups string
+	// Scope is the API token scope the requests authenticated with the session are limited to.
+	Scope                 string
+	AccessTokenHash       string
+	AccessTokenExpiresAt  time.Time
+	RefreshTokenHash      string
+	RefreshTokenExpiresAt time.Time
+	// RevokedAt is set once the user logs out.
+	RevokedAt *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
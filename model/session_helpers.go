@@ -0,0 +1,88 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// CreateSession creates a Session record.
+func (db *Database) CreateSession(ctx context.Context, session *Session) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(session).Error
+	})
+}
+
+// GetSessionByAccessTokenHash returns the Session record with the access token hash.
+func (db *Database) GetSessionByAccessTokenHash(ctx context.Context, hash string) (*Session, error) {
+	session := &Session{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(session, "access_token_hash = ?", hash).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// RotateSessionTokens replaces the tokens of the Session record with the refresh token hash
+// if the session is neither revoked nor expired. Since the lookup and the update are a single
+// statement, a refresh token can only be used once even if it is sent concurrently.
+// gorm.ErrRecordNotFound is returned if there is no such session.
+func (db *Database) RotateSessionTokens(ctx context.Context, refreshTokenHash string, session *Session) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Model(&Session{}).
+			Where("refresh_token_hash = ? AND revoked_at IS NULL AND refresh_token_expires_at > ?", refreshTokenHash, time.Now().UTC()).
+			Updates(map[string]interface{}{
+				"access_token_hash":        session.AccessTokenHash,
+				"access_token_expires_at":  session.AccessTokenExpiresAt,
+				"refresh_token_hash":       session.RefreshTokenHash,
+				"refresh_token_expires_at": session.RefreshTokenExpiresAt,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.First(session, "refresh_token_hash = ?", session.RefreshTokenHash).Error
+	})
+}
+
+// RevokeSession marks the Session record as revoked.
+// gorm.ErrRecordNotFound is returned if there is no such session or it is already revoked.
+func (db *Database) RevokeSession(ctx context.Context, id string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Model(&Session{}).Where("id = ? AND revoked_at IS NULL", id).Update("revoked_at", time.Now().UTC())
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// DeleteExpiredSessions deletes the Session records which refresh token expired before the time.
+func (db *Database) DeleteExpiredSessions(ctx context.Context, before time.Time) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Delete(&Session{}, "refresh_token_expires_at < ?", before).Error
+	})
+}
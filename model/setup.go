@@ -0,0 +1,31 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Setup represents db model for the first-run setup of Everest.
+// There is only one Setup record.
+type Setup struct {
+	ID                   int `gorm:"primary_key"`
+	AdminUsername        string
+	AdminPasswordHash    string
+	SecretsBackend       string
+	DefaultBackupStorage string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
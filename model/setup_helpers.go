@@ -0,0 +1,84 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jinzhu/gorm"
+)
+
+// setupID is the ID of the only Setup record.
+const setupID = 1
+
+// ErrAdminAlreadySet is returned if the admin credentials have already been set.
+var ErrAdminAlreadySet = errors.New("admin credentials are already set")
+
+// GetSetup returns the Setup record. An empty record is returned if the setup has not been started yet.
+func (db *Database) GetSetup(ctx context.Context) (*Setup, error) {
+	setup := &Setup{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(setup, "id = ?", setupID).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &Setup{ID: setupID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return setup, nil
+}
+
+// SetSetupAdmin sets the admin credentials unless they have already been set.
+func (db *Database) SetSetupAdmin(ctx context.Context, username, passwordHash string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		if err := tx.FirstOrCreate(&Setup{}, Setup{ID: setupID}).Error; err != nil {
+			return err
+		}
+		res := tx.Model(&Setup{}).Where("id = ? AND admin_username = ''", setupID).Updates(Setup{
+			AdminUsername:     username,
+			AdminPasswordHash: passwordHash,
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrAdminAlreadySet
+		}
+		return nil
+	})
+}
+
+// SetSetupSecretsBackend records the configured secrets backend.
+func (db *Database) SetSetupSecretsBackend(ctx context.Context, backend string) error {
+	return db.updateSetup(ctx, Setup{SecretsBackend: backend})
+}
+
+// SetSetupDefaultBackupStorage records the default backup storage.
+func (db *Database) SetSetupDefaultBackupStorage(ctx context.Context, name string) error {
+	return db.updateSetup(ctx, Setup{DefaultBackupStorage: name})
+}
+
+// updateSetup updates the non-empty fields of the Setup record creating the record if needed.
+func (db *Database) updateSetup(ctx context.Context, values Setup) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		if err := tx.FirstOrCreate(&Setup{}, Setup{ID: setupID}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&Setup{}).Where("id = ?", setupID).Updates(values).Error
+	})
+}
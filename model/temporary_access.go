@@ -0,0 +1,36 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// TemporaryAccess represents db model for a short-lived database user created for ad-hoc access to a database cluster.
+// The password is returned once and never stored.
+type TemporaryAccess struct {
+	KubernetesID  string `gorm:"primary_key"`
+	DBClusterName string `gorm:"primary_key"`
+	Username      string `gorm:"primary_key"`
+	ReadOnly      bool
+	// Namespace is the namespace of the database cluster if it is not the one of the Kubernetes cluster.
+	Namespace string
+	// CreatedBy is the Everest user who requested the access if known.
+	CreatedBy string
+	// ExpiresAt is the time the database user is dropped at.
+	ExpiresAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,50 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// CreateTemporaryAccess creates a TemporaryAccess record.
+func (db *Database) CreateTemporaryAccess(ctx context.Context, access *TemporaryAccess) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(access).Error
+	})
+}
+
+// ListExpiredTemporaryAccesses returns TemporaryAccess records which expired before the given time.
+func (db *Database) ListExpiredTemporaryAccesses(ctx context.Context, before time.Time) ([]TemporaryAccess, error) {
+	var accesses []TemporaryAccess
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Where("expires_at <= ?", before).Order("expires_at").Find(&accesses).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return accesses, nil
+}
+
+// DeleteTemporaryAccess deletes a TemporaryAccess record.
+func (db *Database) DeleteTemporaryAccess(ctx context.Context, kubernetesID, dbClusterName, username string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Delete(&TemporaryAccess{},
+			"kubernetes_id = ? AND db_cluster_name = ? AND username = ?", kubernetesID, dbClusterName, username).Error
+	})
+}
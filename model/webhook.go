@@ -0,0 +1,32 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// Webhook represents db model for a URL the resource lifecycle events are posted to.
+type Webhook struct {
+	Name string `gorm:"primary_key"`
+	URL  string
+	// SecretID is the ID of the key the payloads are signed with in secrets storage.
+	// The payloads are not signed if it is empty.
+	SecretID string
+	// EventTypes is the comma separated list of the posted event types. All of them are posted if it is empty.
+	EventTypes string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
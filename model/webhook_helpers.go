@@ -0,0 +1,92 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+)
+
+// UpdateWebhookParams stores fields to be updated in a webhook.
+type UpdateWebhookParams struct {
+	URL        *string
+	SecretID   *string
+	EventTypes *string
+}
+
+// CreateWebhook creates a webhook.
+func (db *Database) CreateWebhook(ctx context.Context, webhook *Webhook) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Create(webhook).Error
+	})
+}
+
+// ListWebhooks lists the webhooks ordered by name.
+func (db *Database) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	var webhooks []Webhook
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Order("name").Find(&webhooks).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// GetWebhook returns a webhook.
+func (db *Database) GetWebhook(ctx context.Context, name string) (*Webhook, error) {
+	webhook := &Webhook{}
+	err := db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.First(webhook, "name = ?", name).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// UpdateWebhook updates fields of a webhook based on the provided fields.
+func (db *Database) UpdateWebhook(ctx context.Context, name string, params UpdateWebhookParams) error {
+	updates := map[string]interface{}{}
+	if params.URL != nil {
+		updates["url"] = *params.URL
+	}
+	if params.SecretID != nil {
+		updates["secret_id"] = *params.SecretID
+	}
+	if params.EventTypes != nil {
+		updates["event_types"] = *params.EventTypes
+	}
+
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		return tx.Model(&Webhook{}).Where("name = ?", name).Updates(updates).Error
+	})
+}
+
+// DeleteWebhook deletes a webhook.
+func (db *Database) DeleteWebhook(ctx context.Context, name string) error {
+	return db.withContext(ctx, nil, func(tx *gorm.DB) error {
+		res := tx.Delete(&Webhook{}, "name = ?", name)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
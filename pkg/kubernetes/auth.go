@@ -0,0 +1,150 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+//nolint:gochecknoglobals
+var (
+	execCommandsMu sync.RWMutex
+	// allowedExecCommands are the credential plugins the kubeconfigs of the managed clouds rely on.
+	allowedExecCommands = []string{"aws", "aws-iam-authenticator", "gke-gcloud-auth-plugin", "kubelogin"}
+)
+
+// AllowExecCommands sets the binaries the exec credential plugins of the kubeconfigs may run.
+// The bare names are looked up in the PATH of Everest while the absolute paths allow only
+// the binary at that path.
+func AllowExecCommands(commands []string) {
+	execCommandsMu.Lock()
+	defer execCommandsMu.Unlock()
+	allowedExecCommands = commands
+}
+
+// execCommandAllowed returns true if the command is one of the allowed ones as it is. The paths
+// ending with an allowed name are not allowed since they may point to any binary.
+func execCommandAllowed(allowed []string, command string) bool {
+	if command != filepath.Base(command) && !filepath.IsAbs(command) {
+		return false
+	}
+	for _, c := range allowed {
+		if c == command {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAuth checks the users of the kubeconfig can authenticate from the backend.
+// The exec credential plugins are only allowed to run the allowlisted binaries, must
+// not set environment variables, e.g. PATH or LD_PRELOAD changing the binary that runs,
+// and must not require an interactive terminal.
+func ValidateAuth(config *clientcmdapi.Config) error {
+	execCommandsMu.RLock()
+	allowed := allowedExecCommands
+	execCommandsMu.RUnlock()
+
+	for name, authInfo := range config.AuthInfos {
+		if authInfo == nil || authInfo.Exec == nil {
+			continue
+		}
+		if !execCommandAllowed(allowed, authInfo.Exec.Command) {
+			return fmt.Errorf("exec credential plugin %q of user %q is not allowed", authInfo.Exec.Command, name)
+		}
+		if len(authInfo.Exec.Env) != 0 {
+			return fmt.Errorf("exec credential plugin %q of user %q shall not set environment variables", authInfo.Exec.Command, name)
+		}
+		if authInfo.Exec.InteractiveMode == clientcmdapi.AlwaysExecInteractiveMode {
+			return fmt.Errorf("exec credential plugin %q of user %q requires an interactive terminal", authInfo.Exec.Command, name)
+		}
+	}
+	return nil
+}
+
+type secretUpdater interface {
+	UpdateSecret(ctx context.Context, id, value string) error
+}
+
+// authProviderPersister stores the tokens refreshed by an auth provider, e.g. OIDC, in the
+// kubeconfig kept in the secrets storage so that the refresh token rotated by the identity
+// provider is not lost.
+type authProviderPersister struct {
+	mu           sync.Mutex
+	secrets      secretGetter
+	kubernetesID string
+	authInfoName string
+	providerName string
+	updater      secretUpdater
+}
+
+func newAuthProviderPersister(kubeconfig []byte, secrets secretGetter, kubernetesID string) *authProviderPersister {
+	updater, ok := secrets.(secretUpdater)
+	if !ok {
+		return nil
+	}
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil
+	}
+	name, authInfo := currentAuthInfo(config)
+	if authInfo == nil || authInfo.AuthProvider == nil {
+		return nil
+	}
+
+	return &authProviderPersister{
+		secrets:      secrets,
+		kubernetesID: kubernetesID,
+		authInfoName: name,
+		providerName: authInfo.AuthProvider.Name,
+		updater:      updater,
+	}
+}
+
+// Persist implements rest.AuthProviderConfigPersister. The kubeconfig is reloaded from the secrets
+// storage so that a kubeconfig replaced in the meantime is not overwritten with a stale one.
+func (p *authProviderPersister) Persist(providerConfig map[string]string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// The refresh happens while a request is sent and may outlive the context of the caller.
+	ctx := context.Background()
+	kubeconfigBase64, err := p.secrets.GetSecret(ctx, p.kubernetesID)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get kubeconfig from secrets storage"))
+	}
+	kubeconfig, err := base64.StdEncoding.DecodeString(kubeconfigBase64)
+	if err != nil {
+		return errors.Join(err, errors.New("could not decode base64 kubeconfig"))
+	}
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return err
+	}
+	authInfo, ok := config.AuthInfos[p.authInfoName]
+	if !ok || authInfo.AuthProvider == nil || authInfo.AuthProvider.Name != p.providerName {
+		// The kubeconfig has been replaced and does not use the auth provider anymore.
+		return nil
+	}
+	authInfo.AuthProvider.Config = providerConfig
+
+	updated, err := clientcmd.Write(*config)
+	if err != nil {
+		return err
+	}
+	return p.updater.UpdateSecret(ctx, p.kubernetesID, base64.StdEncoding.EncodeToString(updated))
+}
+
+func currentAuthInfo(config *clientcmdapi.Config) (string, *clientcmdapi.AuthInfo) {
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok || kubeContext == nil {
+		return "", nil
+	}
+	return kubeContext.AuthInfo, config.AuthInfos[kubeContext.AuthInfo]
+}
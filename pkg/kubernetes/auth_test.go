@@ -0,0 +1,115 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestValidateAuth(t *testing.T) {
+	t.Parallel()
+
+	withExec := func(command string, mode clientcmdapi.ExecInteractiveMode) *clientcmdapi.Config {
+		return &clientcmdapi.Config{AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"user": {Exec: &clientcmdapi.ExecConfig{Command: command, InteractiveMode: mode}},
+		}}
+	}
+
+	assert.NoError(t, ValidateAuth(&clientcmdapi.Config{AuthInfos: map[string]*clientcmdapi.AuthInfo{
+		"user": {Token: "token"},
+	}}))
+	assert.NoError(t, ValidateAuth(withExec("aws", clientcmdapi.NeverExecInteractiveMode)))
+	assert.NoError(t, ValidateAuth(withExec("gke-gcloud-auth-plugin", clientcmdapi.IfAvailableExecInteractiveMode)))
+	assert.Error(t, ValidateAuth(withExec("sh", clientcmdapi.NeverExecInteractiveMode)))
+	assert.Error(t, ValidateAuth(withExec("/tmp/evil/aws", clientcmdapi.NeverExecInteractiveMode)))
+	assert.Error(t, ValidateAuth(withExec("aws", clientcmdapi.AlwaysExecInteractiveMode)))
+
+	withEnv := withExec("aws", clientcmdapi.NeverExecInteractiveMode)
+	withEnv.AuthInfos["user"].Exec.Env = []clientcmdapi.ExecEnvVar{{Name: "PATH", Value: "/tmp/evil"}}
+	assert.Error(t, ValidateAuth(withEnv))
+	withEnv.AuthInfos["user"].Exec.Env = []clientcmdapi.ExecEnvVar{{Name: "LD_PRELOAD", Value: "/tmp/evil.so"}}
+	assert.Error(t, ValidateAuth(withEnv))
+}
+
+func TestExecCommandAllowed(t *testing.T) {
+	t.Parallel()
+
+	allowed := []string{"aws", "/usr/local/bin/gke-gcloud-auth-plugin"}
+	assert.True(t, execCommandAllowed(allowed, "aws"))
+	assert.True(t, execCommandAllowed(allowed, "/usr/local/bin/gke-gcloud-auth-plugin"))
+	assert.False(t, execCommandAllowed(allowed, "/tmp/evil/aws"))
+	assert.False(t, execCommandAllowed(allowed, "./aws"))
+	assert.False(t, execCommandAllowed(allowed, "bin/aws"))
+	assert.False(t, execCommandAllowed(allowed, "gke-gcloud-auth-plugin"))
+	assert.False(t, execCommandAllowed(allowed, "/tmp/evil/gke-gcloud-auth-plugin"))
+	assert.False(t, execCommandAllowed(allowed, "/usr/local/bin/../../../tmp/aws"))
+}
+
+type fakeSecrets struct {
+	secrets map[string]string
+}
+
+func (s *fakeSecrets) GetSecret(_ context.Context, id string) (string, error) {
+	return s.secrets[id], nil
+}
+
+func (s *fakeSecrets) UpdateSecret(_ context.Context, id, value string) error {
+	s.secrets[id] = value
+	return nil
+}
+
+func TestAuthProviderPersister(t *testing.T) {
+	t.Parallel()
+
+	config := clientcmdapi.Config{
+		CurrentContext: "ctx",
+		Contexts:       map[string]*clientcmdapi.Context{"ctx": {Cluster: "cluster", AuthInfo: "user"}},
+		Clusters:       map[string]*clientcmdapi.Cluster{"cluster": {Server: "https://127.0.0.1:6443"}},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{"user": {AuthProvider: &clientcmdapi.AuthProviderConfig{
+			Name:   "oidc",
+			Config: map[string]string{"refresh-token": "old"},
+		}}},
+	}
+	kubeconfig, err := clientcmd.Write(config)
+	require.NoError(t, err)
+	secrets := &fakeSecrets{secrets: map[string]string{"id": base64.StdEncoding.EncodeToString(kubeconfig)}}
+
+	p := newAuthProviderPersister(kubeconfig, secrets, "id")
+	require.NotNil(t, p)
+	require.NoError(t, p.Persist(map[string]string{"refresh-token": "new"}))
+
+	stored, err := base64.StdEncoding.DecodeString(secrets.secrets["id"])
+	require.NoError(t, err)
+	updated, err := clientcmd.Load(stored)
+	require.NoError(t, err)
+	assert.Equal(t, "new", updated.AuthInfos["user"].AuthProvider.Config["refresh-token"])
+
+	// A kubeconfig replaced with one not using the auth provider is left intact.
+	config.AuthInfos["user"] = &clientcmdapi.AuthInfo{Token: "token"}
+	replaced, err := clientcmd.Write(config)
+	require.NoError(t, err)
+	secrets.secrets["id"] = base64.StdEncoding.EncodeToString(replaced)
+	require.NoError(t, p.Persist(map[string]string{"refresh-token": "newer"}))
+	assert.Equal(t, base64.StdEncoding.EncodeToString(replaced), secrets.secrets["id"])
+	assert.Nil(t, newAuthProviderPersister(replaced, secrets, "id"))
+}
@@ -0,0 +1,130 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const crdKind = "CustomResourceDefinition"
+
+// clusterScopedKinds are the kinds of the cluster-wide resources which may appear in an operator bundle.
+//
+//nolint:gochecknoglobals
+var clusterScopedKinds = map[string]struct{}{
+	crdKind:                          {},
+	"Namespace":                      {},
+	"ClusterRole":                    {},
+	"ClusterRoleBinding":             {},
+	"ValidatingWebhookConfiguration": {},
+	"MutatingWebhookConfiguration":   {},
+}
+
+// ParseManifests parses a multi-document YAML bundle into resources.
+func ParseManifests(data []byte) ([]*unstructured.Unstructured, error) {
+	decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096) //nolint:gomnd
+	var objs []*unstructured.Unstructured
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, errors.Join(err, errors.New("could not decode manifest"))
+		}
+		if len(obj.Object) == 0 {
+			// Empty document.
+			continue
+		}
+		objs = append(objs, obj)
+	}
+}
+
+// SplitOperatorBundle moves the namespaced resources of an operator bundle into the namespace
+// and returns the CRDs separately from the rest of the resources. Namespaces defined in the bundle
+// are dropped as the resources are moved out of them.
+func SplitOperatorBundle(objs []*unstructured.Unstructured, namespace string) ([]*unstructured.Unstructured, []*unstructured.Unstructured) {
+	var crds, rest []*unstructured.Unstructured
+	for _, obj := range objs {
+		switch obj.GetKind() {
+		case crdKind:
+			crds = append(crds, obj)
+			continue
+		case "Namespace":
+			continue
+		case "ClusterRoleBinding", "RoleBinding":
+			setSubjectsNamespace(obj, namespace)
+		}
+		if _, ok := clusterScopedKinds[obj.GetKind()]; !ok {
+			obj.SetNamespace(namespace)
+		}
+		rest = append(rest, obj)
+	}
+	return crds, rest
+}
+
+func setSubjectsNamespace(obj *unstructured.Unstructured, namespace string) {
+	subjects, ok, err := unstructured.NestedSlice(obj.Object, "subjects")
+	if !ok || err != nil {
+		return
+	}
+	for _, s := range subjects {
+		subject, ok := s.(map[string]interface{})
+		if ok && subject["kind"] == "ServiceAccount" {
+			subject["namespace"] = namespace
+		}
+	}
+	_ = unstructured.SetNestedSlice(obj.Object, subjects, "subjects")
+}
+
+// ApplyManifests creates the resources or replaces them if they exist.
+func (k *Kubernetes) ApplyManifests(ctx context.Context, objs []*unstructured.Unstructured) error {
+	for _, obj := range objs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := k.client.ApplyObject(obj); err != nil {
+			return errors.Join(err, fmt.Errorf("could not apply %s %s", obj.GetKind(), obj.GetName()))
+		}
+	}
+	return nil
+}
+
+// EnsureNamespace creates the namespace of the client if it does not exist.
+func (k *Kubernetes) EnsureNamespace(ctx context.Context) error {
+	_, err := k.client.GetNamespace(ctx, k.namespace)
+	if err == nil {
+		return nil
+	}
+	if !k8serrors.IsNotFound(err) {
+		return err
+	}
+	_, err = k.client.CreateNamespace(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: k.namespace}})
+	return err
+}
+
+// IsDeploymentReady returns true if all the replicas of the deployment are updated and available.
+func (k *Kubernetes) IsDeploymentReady(ctx context.Context, name string) (bool, error) {
+	d, err := k.client.GetDeployment(ctx, name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	return d.Status.ObservedGeneration >= d.Generation &&
+		d.Status.UpdatedReplicas >= replicas &&
+		d.Status.AvailableReplicas >= replicas, nil
+}
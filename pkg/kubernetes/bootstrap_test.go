@@ -0,0 +1,82 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const testBundle = `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: everest-operator-system
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: databaseclusters.everest.percona.com
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: everest-operator-controller-manager
+  namespace: everest-operator-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: everest-operator-manager-rolebinding
+subjects:
+- kind: ServiceAccount
+  name: everest-operator-controller-manager
+  namespace: everest-operator-system
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: everest-operator-controller-manager
+  namespace: everest-operator-system
+`
+
+func TestSplitOperatorBundle(t *testing.T) {
+	t.Parallel()
+
+	objs, err := ParseManifests([]byte(testBundle))
+	require.NoError(t, err)
+	require.Len(t, objs, 5)
+
+	crds, rest := SplitOperatorBundle(objs, "percona-everest")
+	require.Len(t, crds, 1)
+	assert.Equal(t, "databaseclusters.everest.percona.com", crds[0].GetName())
+
+	require.Len(t, rest, 3)
+	assert.Equal(t, "ServiceAccount", rest[0].GetKind())
+	assert.Equal(t, "percona-everest", rest[0].GetNamespace())
+
+	assert.Equal(t, "ClusterRoleBinding", rest[1].GetKind())
+	assert.Empty(t, rest[1].GetNamespace())
+	subjects, _, err := unstructured.NestedSlice(rest[1].Object, "subjects")
+	require.NoError(t, err)
+	assert.Equal(t, "percona-everest", subjects[0].(map[string]interface{})["namespace"])
+
+	assert.Equal(t, "Deployment", rest[2].GetKind())
+	assert.Equal(t, "percona-everest", rest[2].GetNamespace())
+}
@@ -24,6 +24,11 @@ func (c *Client) GetBackupStorage(ctx context.Context, name, namespace string) (
 	return c.customClientSet.BackupStorage(namespace).Get(ctx, name, metav1.GetOptions{})
 }
 
+// ListBackupStorages returns list of backupStorages.
+func (c *Client) ListBackupStorages(ctx context.Context, namespace string) (*everestv1alpha1.BackupStorageList, error) {
+	return c.customClientSet.BackupStorage(namespace).List(ctx, metav1.ListOptions{})
+}
+
 // DeleteBackupStorage deletes the backupStorage.
 func (c *Client) DeleteBackupStorage(ctx context.Context, name, namespace string) error {
 	return c.customClientSet.BackupStorage(namespace).Delete(ctx, name, metav1.DeleteOptions{})
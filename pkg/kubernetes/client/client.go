@@ -18,7 +18,6 @@ package client
 
 import (
 	"errors"
-	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -47,14 +46,20 @@ const (
 type Client struct {
 	clientset       kubernetes.Interface
 	customClientSet *customresources.Client
-	restConfig      *rest.Config
-	restMapper      meta.RESTMapper
-	namespace       string
-	clusterName     string
+	// restConfig is used by the streaming clients like the exec one.
+	restConfig *rest.Config
+	// httpConfig is used by the HTTP clients. It sends the requests with the shared transport.
+	httpConfig  *rest.Config
+	restMapper  meta.RESTMapper
+	namespace   string
+	clusterName string
 }
 
-// NewFromKubeConfig returns new Client from a kubeconfig.
-func NewFromKubeConfig(kubeconfig []byte, namespace string) (*Client, error) {
+// NewFromKubeConfig returns new Client from a kubeconfig. The persister, if set, stores the
+// credentials refreshed by the auth provider of the kubeconfig.
+func NewFromKubeConfig(
+	kubeconfig []byte, namespace string, persister rest.AuthProviderConfigPersister,
+) (*Client, error) {
 	clientConfig, err := clientcmd.Load(kubeconfig)
 	if err != nil {
 		return nil, err
@@ -67,14 +72,20 @@ func NewFromKubeConfig(kubeconfig []byte, namespace string) (*Client, error) {
 
 	config.QPS = defaultQPSLimit
 	config.Burst = defaultBurstLimit
-	config.Timeout = 10 * time.Second
-	clientset, err := kubernetes.NewForConfig(config)
+	config.Timeout = currentTransportOptions().RequestTimeout
+	config.AuthConfigPersister = persister
+	httpConfig, err := withTransport(config)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(httpConfig)
 	if err != nil {
 		return nil, err
 	}
 	c := &Client{
 		clientset:   clientset,
 		restConfig:  config,
+		httpConfig:  httpConfig,
 		clusterName: clientConfig.Contexts[clientConfig.CurrentContext].Cluster,
 		namespace:   namespace,
 	}
@@ -91,7 +102,7 @@ func (c *Client) initOperatorClients() error {
 	}
 	c.restMapper = restmapper.NewDiscoveryRESTMapper(groupResources)
 
-	customClient, err := customresources.NewForConfig(c.restConfig, c.restMapper)
+	customClient, err := customresources.NewForConfig(c.httpConfig, c.restMapper)
 	if err != nil {
 		return err
 	}
@@ -114,6 +125,11 @@ func (c *Client) GetServerVersion() (*version.Info, error) {
 	return c.clientset.Discovery().ServerVersion()
 }
 
+// GetServerResources returns the resources served by the server for the given group version.
+func (c *Client) GetServerResources(groupVersion string) (*metav1.APIResourceList, error) {
+	return c.clientset.Discovery().ServerResourcesForGroupVersion(groupVersion)
+}
+
 // ApplyObject applies object.
 func (c *Client) ApplyObject(obj runtime.Object) error {
 	gvk := obj.GetObjectKind().GroupVersionKind()
@@ -167,7 +183,7 @@ func (c *Client) retrieveMetaFromObject(obj runtime.Object) (string, string, err
 func (c *Client) resourceClient( //nolint:ireturn,nolintlint
 	gv schema.GroupVersion,
 ) (rest.Interface, error) {
-	cfg := c.restConfig
+	cfg := c.httpConfig
 	cfg.ContentConfig = resource.UnstructuredPlusDefaultContentConfig()
 	cfg.GroupVersion = &gv
 	if len(gv.Group) == 0 {
@@ -40,6 +40,7 @@ func (c *Client) BackupStorage( //nolint:ireturn
 
 // BackupStoragesInterface supports methods to work with BackupStorages.
 type BackupStoragesInterface interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*everestv1alpha1.BackupStorageList, error)
 	Post(ctx context.Context, storage *everestv1alpha1.BackupStorage, opts metav1.CreateOptions) (*everestv1alpha1.BackupStorage, error)
 	Update(ctx context.Context, storage *everestv1alpha1.BackupStorage, opts metav1.UpdateOptions) (*everestv1alpha1.BackupStorage, error)
 	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
@@ -51,6 +52,19 @@ type client struct {
 	namespace  string
 }
 
+// List lists backup storages based on opts.
+func (c *client) List(ctx context.Context, opts metav1.ListOptions) (*everestv1alpha1.BackupStorageList, error) {
+	result := &everestv1alpha1.BackupStorageList{}
+	err := c.restClient.
+		Get().
+		Namespace(c.namespace).
+		Resource(backupStorageAPIKind).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
 // Post creates a resource.
 func (c *client) Post(
 	ctx context.Context,
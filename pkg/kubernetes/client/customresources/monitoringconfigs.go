@@ -40,6 +40,7 @@ func (c *Client) MonitoringConfig( //nolint:ireturn
 type MonitoringConfigsInterface interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*everestv1alpha1.MonitoringConfigList, error)
 	Post(ctx context.Context, storage *everestv1alpha1.MonitoringConfig, opts metav1.CreateOptions) (*everestv1alpha1.MonitoringConfig, error)
+	Update(ctx context.Context, storage *everestv1alpha1.MonitoringConfig, opts metav1.UpdateOptions) (*everestv1alpha1.MonitoringConfig, error)
 	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*everestv1alpha1.MonitoringConfig, error)
 }
@@ -78,6 +79,22 @@ func (c *monitoringConfigClient) Post(
 	return result, err
 }
 
+// Update updates a resource.
+func (c *monitoringConfigClient) Update(
+	ctx context.Context,
+	storage *everestv1alpha1.MonitoringConfig,
+	opts metav1.UpdateOptions,
+) (*everestv1alpha1.MonitoringConfig, error) {
+	result := &everestv1alpha1.MonitoringConfig{}
+	err := c.restClient.
+		Put().Name(storage.Name).
+		Namespace(c.namespace).
+		Resource(monitoringConfigAPIKind).Body(storage).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).Into(result)
+	return result, err
+}
+
 // Delete creates a resource.
 func (c *monitoringConfigClient) Delete(
 	ctx context.Context,
@@ -8,11 +8,26 @@ import (
 )
 
 // ListDatabaseClusters returns list of managed database clusters.
-func (c *Client) ListDatabaseClusters(ctx context.Context) (*everestv1alpha1.DatabaseClusterList, error) {
-	return c.customClientSet.DBClusters(c.namespace).List(ctx, metav1.ListOptions{})
+func (c *Client) ListDatabaseClusters(ctx context.Context, options metav1.ListOptions) (*everestv1alpha1.DatabaseClusterList, error) {
+	return c.customClientSet.DBClusters(c.namespace).List(ctx, options)
 }
 
 // GetDatabaseCluster returns database clusters by provided name.
 func (c *Client) GetDatabaseCluster(ctx context.Context, name string) (*everestv1alpha1.DatabaseCluster, error) {
 	return c.customClientSet.DBClusters(c.namespace).Get(ctx, name, metav1.GetOptions{})
 }
+
+// CreateDatabaseCluster creates the database cluster.
+func (c *Client) CreateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	return c.customClientSet.DBClusters(c.namespace).Create(ctx, cluster, metav1.CreateOptions{})
+}
+
+// UpdateDatabaseCluster updates the database cluster.
+func (c *Client) UpdateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	return c.customClientSet.DBClusters(c.namespace).Update(ctx, cluster, metav1.UpdateOptions{})
+}
+
+// DeleteDatabaseCluster deletes the database cluster.
+func (c *Client) DeleteDatabaseCluster(ctx context.Context, name string) error {
+	return c.customClientSet.DBClusters(c.namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
@@ -16,3 +16,22 @@ func (c *Client) ListDatabaseClusterBackups(ctx context.Context) (*everestv1alph
 func (c *Client) GetDatabaseClusterBackup(ctx context.Context, name string) (*everestv1alpha1.DatabaseClusterBackup, error) {
 	return c.customClientSet.DBClusterBackups(c.namespace).Get(ctx, name, metav1.GetOptions{})
 }
+
+// CreateDatabaseClusterBackup creates the database cluster backup.
+func (c *Client) CreateDatabaseClusterBackup(
+	ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup,
+) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	return c.customClientSet.DBClusterBackups(c.namespace).Create(ctx, backup, metav1.CreateOptions{})
+}
+
+// UpdateDatabaseClusterBackup updates the database cluster backup.
+func (c *Client) UpdateDatabaseClusterBackup(
+	ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup,
+) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	return c.customClientSet.DBClusterBackups(c.namespace).Update(ctx, backup, metav1.UpdateOptions{})
+}
+
+// DeleteDatabaseClusterBackup deletes the database cluster backup.
+func (c *Client) DeleteDatabaseClusterBackup(ctx context.Context, name string) error {
+	return c.customClientSet.DBClusterBackups(c.namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
@@ -4,8 +4,10 @@ package client
 
 import (
 	"context"
+	"io"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,12 +25,18 @@ type KubeClientConnector interface {
 	UpdateBackupStorage(ctx context.Context, storage *everestv1alpha1.BackupStorage) error
 	// GetBackupStorage returns the backupStorage.
 	GetBackupStorage(ctx context.Context, name, namespace string) (*everestv1alpha1.BackupStorage, error)
+	// ListBackupStorages returns list of backupStorages.
+	ListBackupStorages(ctx context.Context, namespace string) (*everestv1alpha1.BackupStorageList, error)
 	// DeleteBackupStorage deletes the backupStorage.
 	DeleteBackupStorage(ctx context.Context, name, namespace string) error
 	// ClusterName returns the name of the k8s cluster.
 	ClusterName() string
 	// GetServerVersion returns server version.
 	GetServerVersion() (*version.Info, error)
+	// Exec runs the command in the container of the pod.
+	Exec(ctx context.Context, namespace, pod, container string, command []string, stdin io.Reader, stdout, stderr io.Writer) error
+	// GetServerResources returns the resources served by the server for the given group version.
+	GetServerResources(groupVersion string) (*metav1.APIResourceList, error)
 	// ApplyObject applies object.
 	ApplyObject(obj runtime.Object) error
 	// DeleteObject deletes object from the k8s cluster.
@@ -38,13 +46,25 @@ type KubeClientConnector interface {
 	// GetObject retrieves an object by provided group, version, kind and name.
 	GetObject(gvk schema.GroupVersionKind, name string, into runtime.Object) error
 	// ListDatabaseClusters returns list of managed database clusters.
-	ListDatabaseClusters(ctx context.Context) (*everestv1alpha1.DatabaseClusterList, error)
+	ListDatabaseClusters(ctx context.Context, options metav1.ListOptions) (*everestv1alpha1.DatabaseClusterList, error)
 	// GetDatabaseCluster returns database clusters by provided name.
 	GetDatabaseCluster(ctx context.Context, name string) (*everestv1alpha1.DatabaseCluster, error)
+	// CreateDatabaseCluster creates the database cluster.
+	CreateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error)
+	// UpdateDatabaseCluster updates the database cluster.
+	UpdateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error)
+	// DeleteDatabaseCluster deletes the database cluster.
+	DeleteDatabaseCluster(ctx context.Context, name string) error
 	// ListDatabaseClusterBackups returns list of managed database clusters.
 	ListDatabaseClusterBackups(ctx context.Context) (*everestv1alpha1.DatabaseClusterBackupList, error)
 	// GetDatabaseClusterBackup returns database clusters by provided name.
 	GetDatabaseClusterBackup(ctx context.Context, name string) (*everestv1alpha1.DatabaseClusterBackup, error)
+	// CreateDatabaseClusterBackup creates the database cluster backup.
+	CreateDatabaseClusterBackup(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error)
+	// UpdateDatabaseClusterBackup updates the database cluster backup.
+	UpdateDatabaseClusterBackup(ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup) (*everestv1alpha1.DatabaseClusterBackup, error)
+	// DeleteDatabaseClusterBackup deletes the database cluster backup.
+	DeleteDatabaseClusterBackup(ctx context.Context, name string) error
 	// ListDatabaseClusterRestores returns list of managed database clusters.
 	ListDatabaseClusterRestores(ctx context.Context) (*everestv1alpha1.DatabaseClusterRestoreList, error)
 	// GetDatabaseClusterRestore returns database clusters by provided name.
@@ -57,14 +77,36 @@ type KubeClientConnector interface {
 	CreateMonitoringConfig(ctx context.Context, mc *everestv1alpha1.MonitoringConfig) error
 	// GetMonitoringConfig returns the MonitoringConfig.
 	GetMonitoringConfig(ctx context.Context, name string) (*everestv1alpha1.MonitoringConfig, error)
+	// UpdateMonitoringConfig updates an MonitoringConfig.
+	UpdateMonitoringConfig(ctx context.Context, mc *everestv1alpha1.MonitoringConfig) error
 	// DeleteMonitoringConfig deletes the MonitoringConfig.
 	DeleteMonitoringConfig(ctx context.Context, name string) error
 	// ListMonitoringConfigs returns list of MonitoringConfig.
 	ListMonitoringConfigs(ctx context.Context) (*everestv1alpha1.MonitoringConfigList, error)
 	// GetNamespace returns a namespace.
 	GetNamespace(ctx context.Context, name string) (*corev1.Namespace, error)
+	// ListNamespaces returns the namespaces.
+	ListNamespaces(ctx context.Context, opts metav1.ListOptions) (*corev1.NamespaceList, error)
+	// UpdateNamespace updates a namespace.
+	UpdateNamespace(ctx context.Context, namespace *corev1.Namespace) (*corev1.Namespace, error)
+	// CreateNamespace creates a namespace.
+	CreateNamespace(ctx context.Context, namespace *corev1.Namespace) (*corev1.Namespace, error)
+	// CreateResourceQuota creates a resource quota in the namespace of the quota.
+	CreateResourceQuota(ctx context.Context, quota *corev1.ResourceQuota) (*corev1.ResourceQuota, error)
+	// ListResourceQuotas returns the resource quotas of the namespace.
+	ListResourceQuotas(ctx context.Context, namespace string) (*corev1.ResourceQuotaList, error)
+	// GetDeployment returns a deployment by its name.
+	GetDeployment(ctx context.Context, name string) (*appsv1.Deployment, error)
+	// UpdateDeployment updates a deployment.
+	UpdateDeployment(ctx context.Context, deployment *appsv1.Deployment) (*appsv1.Deployment, error)
 	// GetNodes returns list of nodes.
 	GetNodes(ctx context.Context) (*corev1.NodeList, error)
+	// GetNodeStatsSummary returns the stats summary reported by the kubelet of the node.
+	GetNodeStatsSummary(ctx context.Context, name string) ([]byte, error)
+	// GetPodMetrics returns the CPU and memory usage of the pods served by the metrics API, e.g. by metrics-server.
+	GetPodMetrics(ctx context.Context, namespace string, labelSelector *metav1.LabelSelector) ([]byte, error)
+	// GetPodProxy sends a GET request to the port of the pod through the API server proxy.
+	GetPodProxy(ctx context.Context, namespace, name, port, path string) ([]byte, error)
 	// GetPods returns list of pods.
 	GetPods(ctx context.Context, namespace string, labelSelector *metav1.LabelSelector) (*corev1.PodList, error)
 	// GetResource returns a resource by its name.
@@ -85,6 +127,8 @@ type KubeClientConnector interface {
 	CreateSecret(ctx context.Context, secret *corev1.Secret) (*corev1.Secret, error)
 	// DeleteSecret deletes the k8s Secret.
 	DeleteSecret(ctx context.Context, name, namespace string) error
+	// ListSecrets returns the k8s Secrets of the namespace matching the label selector.
+	ListSecrets(ctx context.Context, namespace, labelSelector string) (*corev1.SecretList, error)
 	// GetStorageClasses returns all storage classes available in the cluster.
 	GetStorageClasses(ctx context.Context) (*storagev1.StorageClassList, error)
 	// GetPersistentVolumes returns Persistent Volumes available in the cluster.
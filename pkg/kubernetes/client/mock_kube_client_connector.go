@@ -4,9 +4,11 @@ package client
 
 import (
 	context "context"
+	io "io"
 
 	v1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
 	mock "github.com/stretchr/testify/mock"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -62,6 +64,58 @@ func (_m *MockKubeClientConnector) CreateBackupStorage(ctx context.Context, stor
 	return r0
 }
 
+// CreateDatabaseCluster provides a mock function with given fields: ctx, cluster
+func (_m *MockKubeClientConnector) CreateDatabaseCluster(ctx context.Context, cluster *v1alpha1.DatabaseCluster) (*v1alpha1.DatabaseCluster, error) {
+	ret := _m.Called(ctx, cluster)
+
+	var r0 *v1alpha1.DatabaseCluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseCluster) (*v1alpha1.DatabaseCluster, error)); ok {
+		return rf(ctx, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseCluster) *v1alpha1.DatabaseCluster); ok {
+		r0 = rf(ctx, cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseCluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DatabaseCluster) error); ok {
+		r1 = rf(ctx, cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateDatabaseClusterBackup provides a mock function with given fields: ctx, backup
+func (_m *MockKubeClientConnector) CreateDatabaseClusterBackup(ctx context.Context, backup *v1alpha1.DatabaseClusterBackup) (*v1alpha1.DatabaseClusterBackup, error) {
+	ret := _m.Called(ctx, backup)
+
+	var r0 *v1alpha1.DatabaseClusterBackup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterBackup) (*v1alpha1.DatabaseClusterBackup, error)); ok {
+		return rf(ctx, backup)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterBackup) *v1alpha1.DatabaseClusterBackup); ok {
+		r0 = rf(ctx, backup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterBackup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DatabaseClusterBackup) error); ok {
+		r1 = rf(ctx, backup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateMonitoringConfig provides a mock function with given fields: ctx, mc
 func (_m *MockKubeClientConnector) CreateMonitoringConfig(ctx context.Context, mc *v1alpha1.MonitoringConfig) error {
 	ret := _m.Called(ctx, mc)
@@ -76,6 +130,32 @@ func (_m *MockKubeClientConnector) CreateMonitoringConfig(ctx context.Context, m
 	return r0
 }
 
+// CreateNamespace provides a mock function with given fields: ctx, namespace
+func (_m *MockKubeClientConnector) CreateNamespace(ctx context.Context, namespace *corev1.Namespace) (*corev1.Namespace, error) {
+	ret := _m.Called(ctx, namespace)
+
+	var r0 *corev1.Namespace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *corev1.Namespace) (*corev1.Namespace, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *corev1.Namespace) *corev1.Namespace); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*corev1.Namespace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *corev1.Namespace) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateResource provides a mock function with given fields: ctx, obj, opts
 func (_m *MockKubeClientConnector) CreateResource(ctx context.Context, obj runtime.Object, opts *v1.CreateOptions) error {
 	ret := _m.Called(ctx, obj, opts)
@@ -90,6 +170,32 @@ func (_m *MockKubeClientConnector) CreateResource(ctx context.Context, obj runti
 	return r0
 }
 
+// CreateResourceQuota provides a mock function with given fields: ctx, quota
+func (_m *MockKubeClientConnector) CreateResourceQuota(ctx context.Context, quota *corev1.ResourceQuota) (*corev1.ResourceQuota, error) {
+	ret := _m.Called(ctx, quota)
+
+	var r0 *corev1.ResourceQuota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *corev1.ResourceQuota) (*corev1.ResourceQuota, error)); ok {
+		return rf(ctx, quota)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *corev1.ResourceQuota) *corev1.ResourceQuota); ok {
+		r0 = rf(ctx, quota)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*corev1.ResourceQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *corev1.ResourceQuota) error); ok {
+		r1 = rf(ctx, quota)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSecret provides a mock function with given fields: ctx, secret
 func (_m *MockKubeClientConnector) CreateSecret(ctx context.Context, secret *corev1.Secret) (*corev1.Secret, error) {
 	ret := _m.Called(ctx, secret)
@@ -130,6 +236,34 @@ func (_m *MockKubeClientConnector) DeleteBackupStorage(ctx context.Context, name
 	return r0
 }
 
+// DeleteDatabaseCluster provides a mock function with given fields: ctx, name
+func (_m *MockKubeClientConnector) DeleteDatabaseCluster(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteDatabaseClusterBackup provides a mock function with given fields: ctx, name
+func (_m *MockKubeClientConnector) DeleteDatabaseClusterBackup(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteMonitoringConfig provides a mock function with given fields: ctx, name
 func (_m *MockKubeClientConnector) DeleteMonitoringConfig(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
@@ -186,6 +320,20 @@ func (_m *MockKubeClientConnector) DeleteSecret(ctx context.Context, name string
 	return r0
 }
 
+// Exec provides a mock function with given fields: ctx, namespace, pod, container, command, stdin, stdout, stderr
+func (_m *MockKubeClientConnector) Exec(ctx context.Context, namespace string, pod string, container string, command []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	ret := _m.Called(ctx, namespace, pod, container, command, stdin, stdout, stderr)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, []string, io.Reader, io.Writer, io.Writer) error); ok {
+		r0 = rf(ctx, namespace, pod, container, command, stdin, stdout, stderr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetBackupStorage provides a mock function with given fields: ctx, name, namespace
 func (_m *MockKubeClientConnector) GetBackupStorage(ctx context.Context, name string, namespace string) (*v1alpha1.BackupStorage, error) {
 	ret := _m.Called(ctx, name, namespace)
@@ -316,6 +464,32 @@ func (_m *MockKubeClientConnector) GetDatabaseEngine(ctx context.Context, name s
 	return r0, r1
 }
 
+// GetDeployment provides a mock function with given fields: ctx, name
+func (_m *MockKubeClientConnector) GetDeployment(ctx context.Context, name string) (*appsv1.Deployment, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *appsv1.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*appsv1.Deployment, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *appsv1.Deployment); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*appsv1.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMonitoringConfig provides a mock function with given fields: ctx, name
 func (_m *MockKubeClientConnector) GetMonitoringConfig(ctx context.Context, name string) (*v1alpha1.MonitoringConfig, error) {
 	ret := _m.Called(ctx, name)
@@ -368,6 +542,32 @@ func (_m *MockKubeClientConnector) GetNamespace(ctx context.Context, name string
 	return r0, r1
 }
 
+// GetNodeStatsSummary provides a mock function with given fields: ctx, name
+func (_m *MockKubeClientConnector) GetNodeStatsSummary(ctx context.Context, name string) ([]byte, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNodes provides a mock function with given fields: ctx
 func (_m *MockKubeClientConnector) GetNodes(ctx context.Context) (*corev1.NodeList, error) {
 	ret := _m.Called(ctx)
@@ -434,6 +634,58 @@ func (_m *MockKubeClientConnector) GetPersistentVolumes(ctx context.Context) (*c
 	return r0, r1
 }
 
+// GetPodMetrics provides a mock function with given fields: ctx, namespace, labelSelector
+func (_m *MockKubeClientConnector) GetPodMetrics(ctx context.Context, namespace string, labelSelector *v1.LabelSelector) ([]byte, error) {
+	ret := _m.Called(ctx, namespace, labelSelector)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1.LabelSelector) ([]byte, error)); ok {
+		return rf(ctx, namespace, labelSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v1.LabelSelector) []byte); ok {
+		r0 = rf(ctx, namespace, labelSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *v1.LabelSelector) error); ok {
+		r1 = rf(ctx, namespace, labelSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPodProxy provides a mock function with given fields: ctx, namespace, name, port, path
+func (_m *MockKubeClientConnector) GetPodProxy(ctx context.Context, namespace string, name string, port string, path string) ([]byte, error) {
+	ret := _m.Called(ctx, namespace, name, port, path)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) ([]byte, error)); ok {
+		return rf(ctx, namespace, name, port, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) []byte); ok {
+		r0 = rf(ctx, namespace, name, port, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, namespace, name, port, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPods provides a mock function with given fields: ctx, namespace, labelSelector
 func (_m *MockKubeClientConnector) GetPods(ctx context.Context, namespace string, labelSelector *v1.LabelSelector) (*corev1.PodList, error) {
 	ret := _m.Called(ctx, namespace, labelSelector)
@@ -500,6 +752,32 @@ func (_m *MockKubeClientConnector) GetSecret(ctx context.Context, name string, n
 	return r0, r1
 }
 
+// GetServerResources provides a mock function with given fields: groupVersion
+func (_m *MockKubeClientConnector) GetServerResources(groupVersion string) (*v1.APIResourceList, error) {
+	ret := _m.Called(groupVersion)
+
+	var r0 *v1.APIResourceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*v1.APIResourceList, error)); ok {
+		return rf(groupVersion)
+	}
+	if rf, ok := ret.Get(0).(func(string) *v1.APIResourceList); ok {
+		r0 = rf(groupVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.APIResourceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(groupVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetServerVersion provides a mock function with given fields:
 func (_m *MockKubeClientConnector) GetServerVersion() (*version.Info, error) {
 	ret := _m.Called()
@@ -552,6 +830,32 @@ func (_m *MockKubeClientConnector) GetStorageClasses(ctx context.Context) (*stor
 	return r0, r1
 }
 
+// ListBackupStorages provides a mock function with given fields: ctx, namespace
+func (_m *MockKubeClientConnector) ListBackupStorages(ctx context.Context, namespace string) (*v1alpha1.BackupStorageList, error) {
+	ret := _m.Called(ctx, namespace)
+
+	var r0 *v1alpha1.BackupStorageList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*v1alpha1.BackupStorageList, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *v1alpha1.BackupStorageList); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.BackupStorageList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListDatabaseClusterBackups provides a mock function with given fields: ctx
 func (_m *MockKubeClientConnector) ListDatabaseClusterBackups(ctx context.Context) (*v1alpha1.DatabaseClusterBackupList, error) {
 	ret := _m.Called(ctx)
@@ -604,25 +908,25 @@ func (_m *MockKubeClientConnector) ListDatabaseClusterRestores(ctx context.Conte
 	return r0, r1
 }
 
-// ListDatabaseClusters provides a mock function with given fields: ctx
-func (_m *MockKubeClientConnector) ListDatabaseClusters(ctx context.Context) (*v1alpha1.DatabaseClusterList, error) {
-	ret := _m.Called(ctx)
+// ListDatabaseClusters provides a mock function with given fields: ctx, options
+func (_m *MockKubeClientConnector) ListDatabaseClusters(ctx context.Context, options v1.ListOptions) (*v1alpha1.DatabaseClusterList, error) {
+	ret := _m.Called(ctx, options)
 
 	var r0 *v1alpha1.DatabaseClusterList
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*v1alpha1.DatabaseClusterList, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, v1.ListOptions) (*v1alpha1.DatabaseClusterList, error)); ok {
+		return rf(ctx, options)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *v1alpha1.DatabaseClusterList); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, v1.ListOptions) *v1alpha1.DatabaseClusterList); ok {
+		r0 = rf(ctx, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterList)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, v1.ListOptions) error); ok {
+		r1 = rf(ctx, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -682,6 +986,32 @@ func (_m *MockKubeClientConnector) ListMonitoringConfigs(ctx context.Context) (*
 	return r0, r1
 }
 
+// ListNamespaces provides a mock function with given fields: ctx, opts
+func (_m *MockKubeClientConnector) ListNamespaces(ctx context.Context, opts v1.ListOptions) (*corev1.NamespaceList, error) {
+	ret := _m.Called(ctx, opts)
+
+	var r0 *corev1.NamespaceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, v1.ListOptions) (*corev1.NamespaceList, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, v1.ListOptions) *corev1.NamespaceList); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*corev1.NamespaceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, v1.ListOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListObjects provides a mock function with given fields: gvk, into
 func (_m *MockKubeClientConnector) ListObjects(gvk schema.GroupVersionKind, into runtime.Object) error {
 	ret := _m.Called(gvk, into)
@@ -696,6 +1026,32 @@ func (_m *MockKubeClientConnector) ListObjects(gvk schema.GroupVersionKind, into
 	return r0
 }
 
+// ListResourceQuotas provides a mock function with given fields: ctx, namespace
+func (_m *MockKubeClientConnector) ListResourceQuotas(ctx context.Context, namespace string) (*corev1.ResourceQuotaList, error) {
+	ret := _m.Called(ctx, namespace)
+
+	var r0 *corev1.ResourceQuotaList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*corev1.ResourceQuotaList, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *corev1.ResourceQuotaList); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*corev1.ResourceQuotaList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListResources provides a mock function with given fields: ctx, into, opts
 func (_m *MockKubeClientConnector) ListResources(ctx context.Context, into runtime.Object, opts *v1.ListOptions) error {
 	ret := _m.Called(ctx, into, opts)
@@ -710,6 +1066,32 @@ func (_m *MockKubeClientConnector) ListResources(ctx context.Context, into runti
 	return r0
 }
 
+// ListSecrets provides a mock function with given fields: ctx, namespace, labelSelector
+func (_m *MockKubeClientConnector) ListSecrets(ctx context.Context, namespace string, labelSelector string) (*corev1.SecretList, error) {
+	ret := _m.Called(ctx, namespace, labelSelector)
+
+	var r0 *corev1.SecretList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*corev1.SecretList, error)); ok {
+		return rf(ctx, namespace, labelSelector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *corev1.SecretList); ok {
+		r0 = rf(ctx, namespace, labelSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*corev1.SecretList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, namespace, labelSelector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateBackupStorage provides a mock function with given fields: ctx, storage
 func (_m *MockKubeClientConnector) UpdateBackupStorage(ctx context.Context, storage *v1alpha1.BackupStorage) error {
 	ret := _m.Called(ctx, storage)
@@ -724,6 +1106,124 @@ func (_m *MockKubeClientConnector) UpdateBackupStorage(ctx context.Context, stor
 	return r0
 }
 
+// UpdateDatabaseCluster provides a mock function with given fields: ctx, cluster
+func (_m *MockKubeClientConnector) UpdateDatabaseCluster(ctx context.Context, cluster *v1alpha1.DatabaseCluster) (*v1alpha1.DatabaseCluster, error) {
+	ret := _m.Called(ctx, cluster)
+
+	var r0 *v1alpha1.DatabaseCluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseCluster) (*v1alpha1.DatabaseCluster, error)); ok {
+		return rf(ctx, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseCluster) *v1alpha1.DatabaseCluster); ok {
+		r0 = rf(ctx, cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseCluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DatabaseCluster) error); ok {
+		r1 = rf(ctx, cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateDatabaseClusterBackup provides a mock function with given fields: ctx, backup
+func (_m *MockKubeClientConnector) UpdateDatabaseClusterBackup(ctx context.Context, backup *v1alpha1.DatabaseClusterBackup) (*v1alpha1.DatabaseClusterBackup, error) {
+	ret := _m.Called(ctx, backup)
+
+	var r0 *v1alpha1.DatabaseClusterBackup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterBackup) (*v1alpha1.DatabaseClusterBackup, error)); ok {
+		return rf(ctx, backup)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.DatabaseClusterBackup) *v1alpha1.DatabaseClusterBackup); ok {
+		r0 = rf(ctx, backup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.DatabaseClusterBackup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *v1alpha1.DatabaseClusterBackup) error); ok {
+		r1 = rf(ctx, backup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateDeployment provides a mock function with given fields: ctx, deployment
+func (_m *MockKubeClientConnector) UpdateDeployment(ctx context.Context, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
+	ret := _m.Called(ctx, deployment)
+
+	var r0 *appsv1.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *appsv1.Deployment) (*appsv1.Deployment, error)); ok {
+		return rf(ctx, deployment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *appsv1.Deployment) *appsv1.Deployment); ok {
+		r0 = rf(ctx, deployment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*appsv1.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *appsv1.Deployment) error); ok {
+		r1 = rf(ctx, deployment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateMonitoringConfig provides a mock function with given fields: ctx, mc
+func (_m *MockKubeClientConnector) UpdateMonitoringConfig(ctx context.Context, mc *v1alpha1.MonitoringConfig) error {
+	ret := _m.Called(ctx, mc)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *v1alpha1.MonitoringConfig) error); ok {
+		r0 = rf(ctx, mc)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateNamespace provides a mock function with given fields: ctx, namespace
+func (_m *MockKubeClientConnector) UpdateNamespace(ctx context.Context, namespace *corev1.Namespace) (*corev1.Namespace, error) {
+	ret := _m.Called(ctx, namespace)
+
+	var r0 *corev1.Namespace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *corev1.Namespace) (*corev1.Namespace, error)); ok {
+		return rf(ctx, namespace)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *corev1.Namespace) *corev1.Namespace); ok {
+		r0 = rf(ctx, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*corev1.Namespace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *corev1.Namespace) error); ok {
+		r1 = rf(ctx, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateResource provides a mock function with given fields: ctx, obj, opts
 func (_m *MockKubeClientConnector) UpdateResource(ctx context.Context, obj runtime.Object, opts *v1.UpdateOptions) error {
 	ret := _m.Called(ctx, obj, opts)
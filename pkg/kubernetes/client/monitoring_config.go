@@ -18,6 +18,12 @@ func (c *Client) GetMonitoringConfig(ctx context.Context, name string) (*everest
 	return c.customClientSet.MonitoringConfig(c.namespace).Get(ctx, name, metav1.GetOptions{})
 }
 
+// UpdateMonitoringConfig updates an MonitoringConfig.
+func (c *Client) UpdateMonitoringConfig(ctx context.Context, mc *everestv1alpha1.MonitoringConfig) error {
+	_, err := c.customClientSet.MonitoringConfig(c.namespace).Update(ctx, mc, metav1.UpdateOptions{})
+	return err
+}
+
 // DeleteMonitoringConfig deletes the MonitoringConfig.
 func (c *Client) DeleteMonitoringConfig(ctx context.Context, name string) error {
 	return c.customClientSet.MonitoringConfig(c.namespace).Delete(ctx, name, metav1.DeleteOptions{})
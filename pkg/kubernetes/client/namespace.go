@@ -11,3 +11,28 @@ import (
 func (c *Client) GetNamespace(ctx context.Context, name string) (*corev1.Namespace, error) {
 	return c.clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
 }
+
+// ListNamespaces returns the namespaces.
+func (c *Client) ListNamespaces(ctx context.Context, opts metav1.ListOptions) (*corev1.NamespaceList, error) {
+	return c.clientset.CoreV1().Namespaces().List(ctx, opts)
+}
+
+// UpdateNamespace updates a namespace.
+func (c *Client) UpdateNamespace(ctx context.Context, namespace *corev1.Namespace) (*corev1.Namespace, error) {
+	return c.clientset.CoreV1().Namespaces().Update(ctx, namespace, metav1.UpdateOptions{})
+}
+
+// CreateNamespace creates a namespace.
+func (c *Client) CreateNamespace(ctx context.Context, namespace *corev1.Namespace) (*corev1.Namespace, error) {
+	return c.clientset.CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{})
+}
+
+// CreateResourceQuota creates a resource quota in the namespace of the quota.
+func (c *Client) CreateResourceQuota(ctx context.Context, quota *corev1.ResourceQuota) (*corev1.ResourceQuota, error) {
+	return c.clientset.CoreV1().ResourceQuotas(quota.Namespace).Create(ctx, quota, metav1.CreateOptions{})
+}
+
+// ListResourceQuotas returns the resource quotas of the namespace.
+func (c *Client) ListResourceQuotas(ctx context.Context, namespace string) (*corev1.ResourceQuotaList, error) {
+	return c.clientset.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{})
+}
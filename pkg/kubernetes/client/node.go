@@ -11,3 +11,10 @@ import (
 func (c *Client) GetNodes(ctx context.Context) (*corev1.NodeList, error) {
 	return c.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 }
+
+// GetNodeStatsSummary returns the stats summary reported by the kubelet of the node.
+func (c *Client) GetNodeStatsSummary(ctx context.Context, name string) ([]byte, error) {
+	return c.clientset.CoreV1().RESTClient().Get().
+		Resource("nodes").Name(name).SubResource("proxy", "stats", "summary").
+		DoRaw(ctx)
+}
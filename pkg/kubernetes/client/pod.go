@@ -16,3 +16,20 @@ func (c *Client) GetPods(ctx context.Context, namespace string, labelSelector *m
 
 	return c.clientset.CoreV1().Pods(namespace).List(ctx, options)
 }
+
+// GetPodProxy sends a GET request to the port of the pod through the API server proxy.
+func (c *Client) GetPodProxy(ctx context.Context, namespace, name, port, path string) ([]byte, error) {
+	return c.clientset.CoreV1().RESTClient().Get().
+		Namespace(namespace).Resource("pods").Name(name + ":" + port).SubResource("proxy").Suffix(path).
+		DoRaw(ctx)
+}
+
+// GetPodMetrics returns the CPU and memory usage of the pods served by the metrics API, e.g. by metrics-server.
+func (c *Client) GetPodMetrics(ctx context.Context, namespace string, labelSelector *metav1.LabelSelector) ([]byte, error) {
+	req := c.clientset.CoreV1().RESTClient().Get().
+		AbsPath("/apis/metrics.k8s.io/v1beta1/namespaces", namespace, "pods")
+	if labelSelector != nil && (labelSelector.MatchLabels != nil || labelSelector.MatchExpressions != nil) {
+		req = req.Param("labelSelector", metav1.FormatLabelSelector(labelSelector))
+	}
+	return req.DoRaw(ctx)
+}
@@ -26,3 +26,8 @@ func (c *Client) CreateSecret(ctx context.Context, secret *corev1.Secret) (*core
 func (c *Client) DeleteSecret(ctx context.Context, name, namespace string) error {
 	return c.clientset.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 }
+
+// ListSecrets returns the k8s Secrets of the namespace matching the label selector.
+func (c *Client) ListSecrets(ctx context.Context, namespace, labelSelector string) (*corev1.SecretList, error) {
+	return c.clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/transport"
+)
+
+// transportKeepAlive is the keep-alive period of the connections to the Kubernetes API servers.
+const transportKeepAlive = 30 * time.Second
+
+// TransportOptions limit the connections and the requests to the Kubernetes API servers.
+type TransportOptions struct {
+	// DialTimeout limits establishing a connection.
+	DialTimeout time.Duration
+	// TLSHandshakeTimeout limits the TLS handshake of a connection.
+	TLSHandshakeTimeout time.Duration
+	// ResponseHeaderTimeout limits waiting for the response headers once the request is sent.
+	ResponseHeaderTimeout time.Duration
+	// RequestTimeout limits the whole requests sent by the clients. It does not apply to the proxied requests.
+	RequestTimeout time.Duration
+	// MaxIdleConnsPerCluster is the number of idle connections kept open to a Kubernetes API server.
+	MaxIdleConnsPerCluster int
+}
+
+//nolint:gochecknoglobals
+var (
+	transportsMu sync.Mutex
+	// transportOptions are the options the transports are created with.
+	transportOptions = TransportOptions{
+		DialTimeout:            5 * time.Second,
+		TLSHandshakeTimeout:    5 * time.Second,
+		ResponseHeaderTimeout:  15 * time.Second,
+		RequestTimeout:         10 * time.Second,
+		MaxIdleConnsPerCluster: 25,
+	}
+	// transports are the transports shared by the clients with the same TLS settings
+	// so that the idle connections to a Kubernetes API server are reused.
+	transports = make(map[string]*http.Transport)
+)
+
+// SetTransportOptions sets the options of the connections to the Kubernetes API servers.
+// The transports created with the previous options are dropped.
+func SetTransportOptions(opts TransportOptions) {
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+	for _, t := range transports {
+		t.CloseIdleConnections()
+	}
+	transportOptions = opts
+	transports = make(map[string]*http.Transport)
+}
+
+func currentTransportOptions() TransportOptions {
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+	return transportOptions
+}
+
+// TransportFor returns a round tripper sending the requests to the Kubernetes API server
+// of the config with the authentication of the config over a connection limited by the
+// transport options.
+func TransportFor(config *rest.Config) (http.RoundTripper, error) {
+	cfg, err := config.TransportConfig()
+	if err != nil {
+		return nil, err
+	}
+	t, err := sharedTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return transport.HTTPWrappersForConfig(cfg, t)
+}
+
+// withTransport returns a copy of the config the HTTP clients are created with.
+// The TLS settings and the credentials of the config are applied by the transport instead.
+func withTransport(config *rest.Config) (*rest.Config, error) {
+	rt, err := TransportFor(config)
+	if err != nil {
+		return nil, err
+	}
+	c := rest.AnonymousClientConfig(config)
+	c.TLSClientConfig = rest.TLSClientConfig{}
+	c.Transport = rt
+	return c, nil
+}
+
+func sharedTransport(cfg *transport.Config) (*http.Transport, error) {
+	tlsConfig, err := transport.TLSConfigFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	key := transportKey(cfg)
+
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+	if t, ok := transports[key]; ok && cfg.Proxy == nil {
+		return t, nil
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if cfg.Proxy != nil {
+		proxy = cfg.Proxy
+	}
+	t := utilnet.SetTransportDefaults(&http.Transport{
+		Proxy:                 proxy,
+		TLSClientConfig:       tlsConfig,
+		DialContext:           (&net.Dialer{Timeout: transportOptions.DialTimeout, KeepAlive: transportKeepAlive}).DialContext,
+		TLSHandshakeTimeout:   transportOptions.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: transportOptions.ResponseHeaderTimeout,
+		MaxIdleConnsPerHost:   transportOptions.MaxIdleConnsPerCluster,
+		DisableCompression:    cfg.DisableCompression,
+	})
+	// The proxy functions cannot be compared so that such transports are not shared.
+	if cfg.Proxy == nil {
+		transports[key] = t
+	}
+	return t, nil
+}
+
+// transportKey identifies the TLS settings of the transport config.
+func transportKey(cfg *transport.Config) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%t\x00%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00%t\x00%p",
+		cfg.TLS.Insecure, cfg.TLS.CAData, cfg.TLS.CertData, cfg.TLS.KeyData,
+		cfg.TLS.CAFile, cfg.TLS.CertFile, cfg.TLS.ServerName, cfg.DisableCompression, cfg.TLS.GetCertHolder)
+	return hex.EncodeToString(h.Sum(nil))
+}
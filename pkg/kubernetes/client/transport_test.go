@@ -0,0 +1,75 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+//nolint:paralleltest
+func TestTransportFor(t *testing.T) {
+	defaults := currentTransportOptions()
+	t.Cleanup(func() { SetTransportOptions(defaults) })
+	SetTransportOptions(TransportOptions{
+		DialTimeout:            time.Second,
+		TLSHandshakeTimeout:    2 * time.Second,
+		ResponseHeaderTimeout:  3 * time.Second,
+		MaxIdleConnsPerCluster: 4,
+	})
+
+	var authorization string
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	t.Cleanup(srv.Close)
+	config := &rest.Config{
+		Host:            srv.URL,
+		BearerToken:     "token",
+		TLSClientConfig: rest.TLSClientConfig{Insecure: true},
+	}
+
+	rt, err := TransportFor(config)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil) //nolint:noctx
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, "Bearer token", authorization)
+
+	cfg, err := config.TransportConfig()
+	require.NoError(t, err)
+	shared, err := sharedTransport(cfg)
+	require.NoError(t, err)
+	assert.Len(t, transports, 1)
+	assert.Equal(t, 2*time.Second, shared.TLSHandshakeTimeout)
+	assert.Equal(t, 3*time.Second, shared.ResponseHeaderTimeout)
+	assert.Equal(t, 4, shared.MaxIdleConnsPerHost)
+
+	httpConfig, err := withTransport(config)
+	require.NoError(t, err)
+	assert.Empty(t, httpConfig.BearerToken)
+	assert.False(t, httpConfig.Insecure)
+	_, err = rest.HTTPClientFor(httpConfig)
+	require.NoError(t, err)
+}
@@ -0,0 +1,50 @@
+package kubernetes
+
+import (
+	"errors"
+	"sort"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RequiredEverestKinds are the kinds the everest operator has to serve for Everest to work.
+//
+//nolint:gochecknoglobals
+var RequiredEverestKinds = []string{
+	"BackupStorage",
+	"DatabaseCluster",
+	"DatabaseClusterBackup",
+	"DatabaseClusterRestore",
+	"DatabaseEngine",
+	"MonitoringConfig",
+}
+
+// MissingEverestKinds returns the required everest kinds the Kubernetes cluster does not serve.
+func (k *Kubernetes) MissingEverestKinds() ([]string, error) {
+	resources, err := k.client.GetServerResources(everestv1alpha1.GroupVersion.String())
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return RequiredEverestKinds, nil
+		}
+		return nil, errors.Join(err, errors.New("could not discover everest resources"))
+	}
+	return missingKinds(resources, RequiredEverestKinds), nil
+}
+
+func missingKinds(resources *metav1.APIResourceList, required []string) []string {
+	served := make(map[string]struct{}, len(resources.APIResources))
+	for _, r := range resources.APIResources {
+		served[r.Kind] = struct{}{}
+	}
+
+	var missing []string
+	for _, kind := range required {
+		if _, ok := served[kind]; !ok {
+			missing = append(missing, kind)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
@@ -0,0 +1,63 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMissingKinds(t *testing.T) {
+	t.Parallel()
+
+	resources := func(kinds ...string) *metav1.APIResourceList {
+		l := &metav1.APIResourceList{}
+		for _, k := range kinds {
+			l.APIResources = append(l.APIResources, metav1.APIResource{Kind: k})
+		}
+		return l
+	}
+	tests := []struct {
+		name      string
+		resources *metav1.APIResourceList
+		missing   []string
+	}{
+		{
+			name:      "all served",
+			resources: resources(RequiredEverestKinds...),
+			missing:   nil,
+		},
+		{
+			name:      "none served",
+			resources: resources(),
+			missing:   RequiredEverestKinds,
+		},
+		{
+			name:      "older operator",
+			resources: resources("DatabaseCluster", "DatabaseEngine", "DatabaseClusterBackup", "Unrelated"),
+			missing:   []string{"BackupStorage", "DatabaseClusterRestore", "MonitoringConfig"},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.missing, missingKinds(tc.resources, RequiredEverestKinds))
+		})
+	}
+}
@@ -15,6 +15,14 @@ import (
 
 type isInUseFn func(ctx context.Context, name string) (bool, error)
 
+const (
+	// ConfigLabel is set on the config resources created by Everest and their secrets to the name of the config.
+	ConfigLabel = "everest.percona.com/config"
+
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedByValue = "everest"
+)
+
 // ConfigK8sResourcer defines interface for config structs which support storage in Kubernetes.
 // The struct is representeed in Kubernetes by:
 //   - The structure itself as a resource
@@ -25,6 +33,7 @@ type ConfigK8sResourcer interface {
 	// Secrets returns all monitoring instance secrets from secrets storage.
 	Secrets(ctx context.Context, getSecret func(ctx context.Context, id string) (string, error)) (map[string]string, error)
 	// SecretName returns the name of the k8s secret as referenced by the k8s config resource.
+	// It is empty if the config has no secret.
 	SecretName() string
 }
 
@@ -67,6 +76,9 @@ func (k *Kubernetes) EnsureConfigExists(
 		return errors.Join(err, errors.New("could not get config secrets from secrets storage"))
 	}
 
+	if err := labelConfigObject(config, name); err != nil {
+		return err
+	}
 	err = k.createConfigWithSecret(ctx, cfg.SecretName(), config, cfgSecrets)
 	if err != nil {
 		return errors.Join(err, errors.New("could not create a config with secret"))
@@ -75,6 +87,64 @@ func (k *Kubernetes) EnsureConfigExists(
 	return nil
 }
 
+// redactedSecretValue replaces the values of the secrets returned by ConfigManifests.
+const redactedSecretValue = "<redacted>"
+
+// ConfigManifests returns the config resource and its secret EnsureConfigExists creates
+// for the provided object along with whether the config resource already exists.
+// The values of the secret are redacted. The secret is nil if the config has none.
+func (k *Kubernetes) ConfigManifests(
+	ctx context.Context, cfg ConfigK8sResourcer,
+	getSecret func(ctx context.Context, id string) (string, error),
+) (runtime.Object, *corev1.Secret, bool, error) {
+	config, err := cfg.K8sResource(k.namespace)
+	if err != nil {
+		return nil, nil, false, errors.Join(err, errors.New("could not get Kubernetes resource object"))
+	}
+	name, err := meta.NewAccessor().Name(config)
+	if err != nil {
+		return nil, nil, false, errors.Join(err, errors.New("could not get name from a config object"))
+	}
+
+	r, err := cfg.K8sResource(k.namespace)
+	if err != nil {
+		return nil, nil, false, errors.Join(err, errors.New("could not get Kubernetes resource object"))
+	}
+	exists := true
+	if err := k.client.GetResource(ctx, name, r, &metav1.GetOptions{}); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return nil, nil, false, errors.Join(err, errors.New("could not get config from Kubernetes"))
+		}
+		exists = false
+	}
+	if err := labelConfigObject(config, name); err != nil {
+		return nil, nil, false, err
+	}
+	if cfg.SecretName() == "" {
+		return config, nil, exists, nil
+	}
+
+	cfgSecrets, err := cfg.Secrets(ctx, getSecret)
+	if err != nil {
+		return nil, nil, false, errors.Join(err, errors.New("could not get config secrets from secrets storage"))
+	}
+	for key := range cfgSecrets {
+		cfgSecrets[key] = redactedSecretValue
+	}
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cfg.SecretName(),
+			Namespace: k.namespace,
+			Labels:    configLabels(name),
+		},
+		StringData: cfgSecrets,
+		Type:       corev1.SecretTypeOpaque,
+	}
+
+	return config, secret, exists, nil
+}
+
 // UpdateConfig updates the config resources based on the provided config object.
 func (k *Kubernetes) UpdateConfig(
 	ctx context.Context, cfg ConfigK8sResourcer,
@@ -110,6 +180,9 @@ func (k *Kubernetes) UpdateConfig(
 		return errors.Join(err, errors.New("could not get config secrets from secrets storage"))
 	}
 
+	if err := labelConfigObject(config, name); err != nil {
+		return err
+	}
 	err = k.updateConfigWithSecret(ctx, cfg.SecretName(), config, cfgSecrets)
 	if err != nil {
 		return errors.Join(err, errors.New("could not update config with secrets in Kubernetes"))
@@ -118,6 +191,66 @@ func (k *Kubernetes) UpdateConfig(
 	return nil
 }
 
+// ApplyConfig creates the config resource and its secret for the provided object
+// or replaces them if the config resource already exists.
+// It returns true if the config resource was created.
+func (k *Kubernetes) ApplyConfig(
+	ctx context.Context, cfg ConfigK8sResourcer,
+	getSecret func(ctx context.Context, id string) (string, error),
+) (bool, error) {
+	config, err := cfg.K8sResource(k.namespace)
+	if err != nil {
+		return false, errors.Join(err, errors.New("could not get Kubernetes resource object"))
+	}
+
+	acc := meta.NewAccessor()
+	name, err := acc.Name(config)
+	if err != nil {
+		return false, errors.Join(err, errors.New("could not get name from a config object"))
+	}
+
+	r, err := cfg.K8sResource(k.namespace)
+	if err != nil {
+		return false, errors.Join(err, errors.New("could not get Kubernetes resource object"))
+	}
+
+	exists := true
+	if err := k.client.GetResource(ctx, name, r, &metav1.GetOptions{}); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return false, errors.Join(err, errors.New("could not get config from Kubernetes"))
+		}
+		exists = false
+	}
+
+	if err := labelConfigObject(config, name); err != nil {
+		return false, err
+	}
+	// The secret may be gone even if the config resource exists, so it is applied in any case.
+	if err := k.applyConfigSecret(ctx, cfg, getSecret); err != nil {
+		return false, err
+	}
+
+	if !exists {
+		if err := k.client.CreateResource(ctx, config, &metav1.CreateOptions{}); err != nil {
+			return false, errors.Join(err, errors.New("could not create config in Kubernetes"))
+		}
+		return true, nil
+	}
+
+	resourceVersion, err := acc.ResourceVersion(r)
+	if err != nil {
+		return false, errors.Join(err, errors.New("could not get resource version from a config object"))
+	}
+	if err := acc.SetResourceVersion(config, resourceVersion); err != nil {
+		return false, errors.Join(err, errors.New("could not set resource version of a config object"))
+	}
+	if err := k.client.UpdateResource(ctx, config, &metav1.UpdateOptions{}); err != nil {
+		return false, errors.Join(err, errors.New("could not update config in Kubernetes"))
+	}
+
+	return false, nil
+}
+
 // DeleteConfig deletes the config and secret resources from k8s
 // for the provided config object.
 // If the config is in use, ErrConfigInUse is returned.
@@ -172,12 +305,120 @@ func (k *Kubernetes) GetBackupStorage(ctx context.Context, name, namespace strin
 	return k.client.GetBackupStorage(ctx, name, namespace)
 }
 
+// ListBackupStorages returns the BackupStorages of the namespace.
+func (k *Kubernetes) ListBackupStorages(ctx context.Context, namespace string) (*everestv1alpha1.BackupStorageList, error) {
+	return k.client.ListBackupStorages(ctx, namespace)
+}
+
+// DeleteBackupStorage deletes the BackupStorage of the namespace.
+func (k *Kubernetes) DeleteBackupStorage(ctx context.Context, name, namespace string) error {
+	return k.client.DeleteBackupStorage(ctx, name, namespace)
+}
+
+// AdoptBackupStorage makes an existing BackupStorage use the secret managed by Everest
+// for the provided config object.
+func (k *Kubernetes) AdoptBackupStorage(
+	ctx context.Context, storage *everestv1alpha1.BackupStorage, cfg ConfigK8sResourcer,
+	getSecret func(ctx context.Context, id string) (string, error),
+) error {
+	if err := k.applyConfigSecret(ctx, cfg, getSecret); err != nil {
+		return err
+	}
+	if storage.Spec.CredentialsSecretName == cfg.SecretName() {
+		return nil
+	}
+
+	storage.Spec.CredentialsSecretName = cfg.SecretName()
+	if err := k.client.UpdateBackupStorage(ctx, storage); err != nil {
+		return errors.Join(err, errors.New("could not update backup storage in Kubernetes"))
+	}
+
+	return nil
+}
+
+// AdoptMonitoringConfig makes an existing MonitoringConfig use the secret managed by Everest
+// for the provided config object.
+func (k *Kubernetes) AdoptMonitoringConfig(
+	ctx context.Context, mc *everestv1alpha1.MonitoringConfig, cfg ConfigK8sResourcer,
+	getSecret func(ctx context.Context, id string) (string, error),
+) error {
+	if err := k.applyConfigSecret(ctx, cfg, getSecret); err != nil {
+		return err
+	}
+	if mc.Spec.CredentialsSecretName == cfg.SecretName() {
+		return nil
+	}
+
+	mc.Spec.CredentialsSecretName = cfg.SecretName()
+	if err := k.client.UpdateMonitoringConfig(ctx, mc); err != nil {
+		return errors.Join(err, errors.New("could not update monitoring config in Kubernetes"))
+	}
+
+	return nil
+}
+
+// applyConfigSecret creates the secret of the provided config object or replaces
+// its data if the secret already exists.
+func (k *Kubernetes) applyConfigSecret(
+	ctx context.Context, cfg ConfigK8sResourcer,
+	getSecret func(ctx context.Context, id string) (string, error),
+) error {
+	if cfg.SecretName() == "" {
+		return nil
+	}
+	cfgSecrets, err := cfg.Secrets(ctx, getSecret)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get config secrets from secrets storage"))
+	}
+	config, err := cfg.K8sResource(k.namespace)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get Kubernetes resource object"))
+	}
+	name, err := meta.NewAccessor().Name(config)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get name from a config object"))
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cfg.SecretName(),
+			Namespace: k.namespace,
+			Labels:    configLabels(name),
+		},
+		StringData: cfgSecrets,
+		Type:       corev1.SecretTypeOpaque,
+	}
+	_, err = k.CreateSecret(ctx, secret)
+	if err == nil {
+		return nil
+	}
+	if !k8serrors.IsAlreadyExists(err) {
+		return errors.Join(err, fmt.Errorf("could not create secret %s", secret.Name))
+	}
+
+	if _, err := k.UpdateSecret(ctx, secret); err != nil {
+		return errors.Join(err, fmt.Errorf("could not update secret %s", secret.Name))
+	}
+
+	return nil
+}
+
 // CreateConfigWithSecret creates a resource and the linked secret.
+// Only the resource is created if the secret name is empty.
 func (k *Kubernetes) createConfigWithSecret(ctx context.Context, secretName string, cfg runtime.Object, secretData map[string]string) error {
+	if secretName == "" {
+		err := k.client.CreateResource(ctx, cfg, &metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return err
+		}
+		return nil
+	}
+
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      secretName,
 			Namespace: k.namespace,
+			Labels:    objectLabels(cfg),
 		},
 		StringData: secretData,
 		Type:       corev1.SecretTypeOpaque,
@@ -202,9 +443,17 @@ func (k *Kubernetes) createConfigWithSecret(ctx context.Context, secretName stri
 }
 
 // updateConfigWithSecret creates a resource and the linked secret.
+// Only the resource is updated if the secret name is empty.
 func (k *Kubernetes) updateConfigWithSecret(
 	ctx context.Context, secretName string, obj runtime.Object, secretData map[string]string,
 ) error {
+	if secretName == "" {
+		if err := k.client.UpdateResource(ctx, obj, &metav1.UpdateOptions{}); err != nil {
+			return errors.Join(err, errors.New("could not update config in Kubernetes"))
+		}
+		return nil
+	}
+
 	oldSecret, err := k.GetSecret(ctx, secretName, k.namespace)
 	if err != nil {
 		return errors.Join(err, fmt.Errorf("failed to read secret %s", secretName))
@@ -214,6 +463,7 @@ func (k *Kubernetes) updateConfigWithSecret(
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      secretName,
 			Namespace: k.namespace,
+			Labels:    objectLabels(obj),
 		},
 		StringData: secretData,
 		Type:       corev1.SecretTypeOpaque,
@@ -235,3 +485,35 @@ func (k *Kubernetes) updateConfigWithSecret(
 
 	return nil
 }
+
+func configLabels(name string) map[string]string {
+	return map[string]string{
+		managedByLabel: managedByValue,
+		ConfigLabel:    name,
+	}
+}
+
+// objectLabels returns the labels of the config resource for its secret to share them.
+func objectLabels(obj runtime.Object) map[string]string {
+	labels, err := meta.NewAccessor().Labels(obj)
+	if err != nil {
+		return nil
+	}
+	return labels
+}
+
+// labelConfigObject marks the config resource as created by Everest.
+func labelConfigObject(obj runtime.Object, name string) error {
+	acc := meta.NewAccessor()
+	labels, err := acc.Labels(obj)
+	if err != nil {
+		return errors.Join(err, errors.New("could not get labels of a config object"))
+	}
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	for k, v := range configLabels(name) {
+		labels[k] = v
+	}
+	return acc.SetLabels(obj, labels)
+}
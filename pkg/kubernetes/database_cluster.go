@@ -20,14 +20,55 @@ import (
 	"context"
 
 	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ListDatabaseClusters returns list of managed database clusters.
 func (k *Kubernetes) ListDatabaseClusters(ctx context.Context) (*everestv1alpha1.DatabaseClusterList, error) {
-	return k.client.ListDatabaseClusters(ctx)
+	return k.client.ListDatabaseClusters(ctx, metav1.ListOptions{})
+}
+
+// ListDatabaseClustersBySelector returns list of managed database clusters matching the label selector.
+func (k *Kubernetes) ListDatabaseClustersBySelector(ctx context.Context, labelSelector string) (*everestv1alpha1.DatabaseClusterList, error) {
+	return k.client.ListDatabaseClusters(ctx, metav1.ListOptions{LabelSelector: labelSelector})
 }
 
 // GetDatabaseCluster returns database clusters by provided name.
 func (k *Kubernetes) GetDatabaseCluster(ctx context.Context, name string) (*everestv1alpha1.DatabaseCluster, error) {
 	return k.client.GetDatabaseCluster(ctx, name)
 }
+
+// CreateDatabaseCluster creates the database cluster.
+func (k *Kubernetes) CreateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	return k.client.CreateDatabaseCluster(ctx, cluster)
+}
+
+// UpdateDatabaseCluster updates the database cluster.
+func (k *Kubernetes) UpdateDatabaseCluster(ctx context.Context, cluster *everestv1alpha1.DatabaseCluster) (*everestv1alpha1.DatabaseCluster, error) {
+	return k.client.UpdateDatabaseCluster(ctx, cluster)
+}
+
+// DeleteDatabaseCluster deletes the database cluster.
+func (k *Kubernetes) DeleteDatabaseCluster(ctx context.Context, name string) error {
+	return k.client.DeleteDatabaseCluster(ctx, name)
+}
+
+// AdoptDatabaseCluster labels the database cluster as managed by Everest and sets the annotations
+// recording its ownership. The existing annotations are not overwritten.
+func (k *Kubernetes) AdoptDatabaseCluster(
+	ctx context.Context, db *everestv1alpha1.DatabaseCluster, annotations map[string]string,
+) (*everestv1alpha1.DatabaseCluster, error) {
+	if db.Labels == nil {
+		db.Labels = map[string]string{}
+	}
+	db.Labels[managedByLabel] = managedByValue
+	if db.Annotations == nil {
+		db.Annotations = map[string]string{}
+	}
+	for key, value := range annotations {
+		if _, ok := db.Annotations[key]; !ok && value != "" {
+			db.Annotations[key] = value
+		}
+	}
+	return k.client.UpdateDatabaseCluster(ctx, db)
+}
@@ -30,3 +30,22 @@ func (k *Kubernetes) GetDatabaseClusterBackup(ctx context.Context, name string)
 func (k *Kubernetes) ListDatabaseClusterBackups(ctx context.Context) (*everestv1alpha1.DatabaseClusterBackupList, error) {
 	return k.client.ListDatabaseClusterBackups(ctx)
 }
+
+// CreateDatabaseClusterBackup creates database cluster backup.
+func (k *Kubernetes) CreateDatabaseClusterBackup(
+	ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup,
+) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	return k.client.CreateDatabaseClusterBackup(ctx, backup)
+}
+
+// UpdateDatabaseClusterBackup updates database cluster backup.
+func (k *Kubernetes) UpdateDatabaseClusterBackup(
+	ctx context.Context, backup *everestv1alpha1.DatabaseClusterBackup,
+) (*everestv1alpha1.DatabaseClusterBackup, error) {
+	return k.client.UpdateDatabaseClusterBackup(ctx, backup)
+}
+
+// DeleteDatabaseClusterBackup deletes database cluster backup.
+func (k *Kubernetes) DeleteDatabaseClusterBackup(ctx context.Context, name string) error {
+	return k.client.DeleteDatabaseClusterBackup(ctx, name)
+}
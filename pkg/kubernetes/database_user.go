@@ -0,0 +1,226 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatabaseUser is a user of a database cluster.
+type DatabaseUser struct {
+	Username string
+	Password string
+	// ReadOnly limits the grants of the user to reading data and monitoring.
+	ReadOnly bool
+	// ValidUntil is enforced by the engines supporting expiring users in addition to dropping the user.
+	ValidUntil time.Time
+}
+
+// databaseShell describes where the engine client of a database cluster runs.
+type databaseShell struct {
+	labels    func(name string) map[string]string
+	container string
+}
+
+var (
+	//nolint:gochecknoglobals
+	databaseShells = map[everestv1alpha1.EngineType]databaseShell{
+		everestv1alpha1.DatabaseEnginePXC: {
+			labels: func(name string) map[string]string {
+				return map[string]string{"app.kubernetes.io/instance": name, "app.kubernetes.io/component": "pxc"}
+			},
+			container: "pxc",
+		},
+		everestv1alpha1.DatabaseEnginePSMDB: {
+			labels: func(name string) map[string]string {
+				return map[string]string{"app.kubernetes.io/instance": name, "app.kubernetes.io/component": "mongod"}
+			},
+			container: "mongod",
+		},
+		everestv1alpha1.DatabaseEnginePostgresql: {
+			labels: func(name string) map[string]string {
+				return map[string]string{
+					"postgres-operator.crunchydata.com/cluster": name,
+					"postgres-operator.crunchydata.com/role":    "master",
+				}
+			},
+			container: "database",
+		},
+	}
+
+	// databaseUsernameRegexp is restrictive so that the usernames and passwords can be embedded into scripts.
+	databaseUsernameRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+	errUnsupportedEngine = errors.New("unsupported database engine")
+)
+
+// CreateDatabaseUser creates the user in the database cluster with the admin credentials.
+func (k *Kubernetes) CreateDatabaseUser(ctx context.Context, db *everestv1alpha1.DatabaseCluster, admin, user DatabaseUser) error {
+	script, err := createDatabaseUserScript(db.Spec.Engine.Type, admin, user)
+	if err != nil {
+		return err
+	}
+	return k.execInDatabase(ctx, db, script)
+}
+
+// DropDatabaseUser drops the user from the database cluster with the admin credentials.
+func (k *Kubernetes) DropDatabaseUser(ctx context.Context, db *everestv1alpha1.DatabaseCluster, admin DatabaseUser, username string) error {
+	script, err := dropDatabaseUserScript(db.Spec.Engine.Type, admin, username)
+	if err != nil {
+		return err
+	}
+	return k.execInDatabase(ctx, db, script)
+}
+
+// CheckDatabase checks the database cluster accepts the admin credentials and its catalog can be read.
+func (k *Kubernetes) CheckDatabase(ctx context.Context, db *everestv1alpha1.DatabaseCluster, admin DatabaseUser) error {
+	script, err := checkDatabaseScript(db.Spec.Engine.Type, admin)
+	if err != nil {
+		return err
+	}
+	return k.execInDatabase(ctx, db, script)
+}
+
+// execInDatabase runs the shell script in the database pods until it succeeds on one of them.
+// The script is passed on the standard input so that the credentials do not show up in the command.
+func (k *Kubernetes) execInDatabase(ctx context.Context, db *everestv1alpha1.DatabaseCluster, script string) error {
+	shell, ok := databaseShells[db.Spec.Engine.Type]
+	if !ok {
+		return errUnsupportedEngine
+	}
+	pods, err := k.GetPods(ctx, db.Namespace, &metav1.LabelSelector{MatchLabels: shell.labels(db.Name)})
+	if err != nil {
+		return errors.Join(err, errors.New("could not list database pods"))
+	}
+
+	var errs []error
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		var stderr bytes.Buffer
+		err := k.client.Exec(ctx, db.Namespace, pod.Name, shell.container, []string{"sh"}, strings.NewReader(script), io.Discard, &stderr)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, errors.Join(err, fmt.Errorf("%s: %s", pod.Name, strings.TrimSpace(stderr.String()))))
+	}
+	if len(errs) == 0 {
+		return errors.New("no running database pods found")
+	}
+	return errors.Join(errs...)
+}
+
+func createDatabaseUserScript(engineType everestv1alpha1.EngineType, admin, user DatabaseUser) (string, error) {
+	if !databaseUsernameRegexp.MatchString(user.Username) || !databaseUsernameRegexp.MatchString(user.Password) {
+		return "", errors.New("username and password shall contain only letters, digits and underscores")
+	}
+
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePXC:
+		grants := "SELECT, SHOW VIEW, PROCESS"
+		if !user.ReadOnly {
+			grants = "SELECT, INSERT, UPDATE, DELETE, SHOW VIEW, PROCESS"
+		}
+		return mysqlScript(admin, fmt.Sprintf(
+			"CREATE USER '%[1]s'@'%%' IDENTIFIED BY '%[2]s';\nGRANT %[3]s ON *.* TO '%[1]s'@'%%';",
+			user.Username, user.Password, grants,
+		)), nil
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		roles := `"readAnyDatabase", "clusterMonitor"`
+		if !user.ReadOnly {
+			roles = `"readWriteAnyDatabase", "clusterMonitor"`
+		}
+		return mongoScript(admin, fmt.Sprintf(
+			`db.getSiblingDB("admin").createUser({user: "%s", pwd: "%s", roles: [%s]})`,
+			user.Username, user.Password, roles,
+		)), nil
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		roles := "pg_read_all_data, pg_monitor"
+		if !user.ReadOnly {
+			roles = "pg_read_all_data, pg_write_all_data, pg_monitor"
+		}
+		return postgresScript(admin, fmt.Sprintf(
+			"CREATE ROLE \"%[1]s\" LOGIN PASSWORD '%[2]s' VALID UNTIL '%[3]s';\nGRANT %[4]s TO \"%[1]s\";",
+			user.Username, user.Password, user.ValidUntil.UTC().Format(time.RFC3339), roles,
+		)), nil
+	default:
+		return "", errUnsupportedEngine
+	}
+}
+
+func dropDatabaseUserScript(engineType everestv1alpha1.EngineType, admin DatabaseUser, username string) (string, error) {
+	if !databaseUsernameRegexp.MatchString(username) {
+		return "", errors.New("username shall contain only letters, digits and underscores")
+	}
+
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePXC:
+		return mysqlScript(admin, fmt.Sprintf("DROP USER IF EXISTS '%s'@'%%';", username)), nil
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		return mongoScript(admin, fmt.Sprintf(
+			`try { db.getSiblingDB("admin").dropUser("%s") } catch (e) { if (e.codeName !== "UserNotFound") { throw e } }`,
+			username,
+		)), nil
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		return postgresScript(admin, fmt.Sprintf(`SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE usename = '%[1]s';
+DO $$ BEGIN
+  IF EXISTS (SELECT FROM pg_roles WHERE rolname = '%[1]s') THEN
+    EXECUTE 'REASSIGN OWNED BY "%[1]s" TO CURRENT_USER';
+    EXECUTE 'DROP OWNED BY "%[1]s"';
+    EXECUTE 'DROP ROLE "%[1]s"';
+  END IF;
+END $$;`, username)), nil
+	default:
+		return "", errUnsupportedEngine
+	}
+}
+
+func checkDatabaseScript(engineType everestv1alpha1.EngineType, admin DatabaseUser) (string, error) {
+	switch engineType {
+	case everestv1alpha1.DatabaseEnginePXC:
+		return mysqlScript(admin, "SELECT COUNT(*) FROM information_schema.tables;"), nil
+	case everestv1alpha1.DatabaseEnginePSMDB:
+		return mongoScript(admin,
+			`db.adminCommand({listDatabases: 1}).databases.forEach(d => db.getSiblingDB(d.name).getCollectionInfos())`,
+		), nil
+	case everestv1alpha1.DatabaseEnginePostgresql:
+		return postgresScript(admin, "SELECT datname FROM pg_database WHERE datallowconn;\nSELECT COUNT(*) FROM pg_class;"), nil
+	default:
+		return "", errUnsupportedEngine
+	}
+}
+
+func mysqlScript(admin DatabaseUser, sql string) string {
+	return fmt.Sprintf("export MYSQL_PWD=%s\nmysql -u %s <<'EOSQL'\n%s\nEOSQL\n",
+		shellQuote(admin.Password), shellQuote(admin.Username), sql)
+}
+
+func postgresScript(admin DatabaseUser, sql string) string {
+	return fmt.Sprintf("export PGPASSWORD=%s\npsql -v ON_ERROR_STOP=1 -h localhost -U %s -d postgres <<'EOSQL'\n%s\nEOSQL\n",
+		shellQuote(admin.Password), shellQuote(admin.Username), sql)
+}
+
+// mongoScript runs the script on the primary member only; the other members exit with an error.
+func mongoScript(admin DatabaseUser, js string) string {
+	return fmt.Sprintf(`MONGO=$(command -v mongosh || command -v mongo)
+"$MONGO" --quiet -u %s -p %s --authenticationDatabase admin admin <<'EOJS'
+if (!db.isMaster().ismaster) { quit(3) }
+%s
+EOJS
+`, shellQuote(admin.Username), shellQuote(admin.Password), js)
+}
+
+// shellQuote quotes the string for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
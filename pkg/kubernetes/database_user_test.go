@@ -0,0 +1,91 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDatabaseUserScript(t *testing.T) {
+	t.Parallel()
+
+	admin := DatabaseUser{Username: "root", Password: "it's secret"}
+	user := DatabaseUser{
+		Username:   "everest_tmp_abc",
+		Password:   "Passw0rd",
+		ReadOnly:   true,
+		ValidUntil: time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	script, err := createDatabaseUserScript(everestv1alpha1.DatabaseEnginePXC, admin, user)
+	require.NoError(t, err)
+	assert.Equal(t, `export MYSQL_PWD='it'\''s secret'
+mysql -u 'root' <<'EOSQL'
+CREATE USER 'everest_tmp_abc'@'%' IDENTIFIED BY 'Passw0rd';
+GRANT SELECT, SHOW VIEW, PROCESS ON *.* TO 'everest_tmp_abc'@'%';
+EOSQL
+`, script)
+
+	script, err = createDatabaseUserScript(everestv1alpha1.DatabaseEnginePostgresql, admin, user)
+	require.NoError(t, err)
+	assert.Contains(t, script, `CREATE ROLE "everest_tmp_abc" LOGIN PASSWORD 'Passw0rd' VALID UNTIL '2023-10-01T12:00:00Z';`)
+	assert.Contains(t, script, `GRANT pg_read_all_data, pg_monitor TO "everest_tmp_abc";`)
+
+	user.ReadOnly = false
+	script, err = createDatabaseUserScript(everestv1alpha1.DatabaseEnginePSMDB, admin, user)
+	require.NoError(t, err)
+	assert.Contains(t, script, `roles: ["readWriteAnyDatabase", "clusterMonitor"]`)
+	assert.Contains(t, script, "if (!db.isMaster().ismaster) { quit(3) }")
+
+	user.Password = "'; DROP TABLE users; --"
+	_, err = createDatabaseUserScript(everestv1alpha1.DatabaseEnginePXC, admin, user)
+	assert.Error(t, err)
+}
+
+func TestDropDatabaseUserScript(t *testing.T) {
+	t.Parallel()
+
+	admin := DatabaseUser{Username: "postgres", Password: "secret"}
+	script, err := dropDatabaseUserScript(everestv1alpha1.DatabaseEnginePostgresql, admin, "everest_tmp_abc")
+	require.NoError(t, err)
+	assert.Contains(t, script, "pg_terminate_backend(pid) FROM pg_stat_activity WHERE usename = 'everest_tmp_abc'")
+	assert.Contains(t, script, `EXECUTE 'DROP ROLE "everest_tmp_abc"';`)
+
+	_, err = dropDatabaseUserScript(everestv1alpha1.DatabaseEnginePXC, admin, "root'@'%")
+	assert.Error(t, err)
+}
+
+func TestCheckDatabaseScript(t *testing.T) {
+	t.Parallel()
+
+	admin := DatabaseUser{Username: "root", Password: "secret"}
+	script, err := checkDatabaseScript(everestv1alpha1.DatabaseEnginePXC, admin)
+	require.NoError(t, err)
+	assert.Contains(t, script, "SELECT COUNT(*) FROM information_schema.tables;")
+
+	script, err = checkDatabaseScript(everestv1alpha1.DatabaseEnginePSMDB, admin)
+	require.NoError(t, err)
+	assert.Contains(t, script, "if (!db.isMaster().ismaster) { quit(3) }")
+	assert.Contains(t, script, "getCollectionInfos()")
+
+	_, err = checkDatabaseScript("unknown", admin)
+	assert.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package kubernetes
+
+import (
+	"errors"
+	"net"
+	"os"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	inClusterName = "in-cluster"
+	//nolint:gosec
+	serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	serviceAccountCAFile    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+)
+
+// ErrNotInCluster is returned when Everest does not run in a Kubernetes cluster.
+var ErrNotInCluster = errors.New("everest does not run in a Kubernetes cluster")
+
+// InClusterKubeconfig returns the kubeconfig of the Kubernetes cluster Everest runs in.
+// It holds no credentials but refers to the service account token mounted into the pod,
+// so the rotated tokens are picked up by the clients.
+func InClusterKubeconfig() ([]byte, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return nil, ErrNotInCluster
+	}
+	if _, err := os.Stat(serviceAccountTokenFile); err != nil {
+		return nil, errors.Join(ErrNotInCluster, err)
+	}
+
+	return clientcmd.Write(inClusterConfig("https://"+net.JoinHostPort(host, port), serviceAccountTokenFile, serviceAccountCAFile))
+}
+
+func inClusterConfig(server, tokenFile, caFile string) clientcmdapi.Config {
+	return clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			inClusterName: {Server: server, CertificateAuthority: caFile},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			inClusterName: {TokenFile: tokenFile},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			inClusterName: {Cluster: inClusterName, AuthInfo: inClusterName},
+		},
+		CurrentContext: inClusterName,
+	}
+}
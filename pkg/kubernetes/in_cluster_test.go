@@ -0,0 +1,45 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestInClusterConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	tokenFile, caFile := filepath.Join(dir, "token"), filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token"), 0o600))
+	require.NoError(t, os.WriteFile(caFile, []byte("ca"), 0o600))
+
+	kubeconfig, err := clientcmd.Write(inClusterConfig("https://10.0.0.1:443", tokenFile, caFile))
+	require.NoError(t, err)
+	assert.NotContains(t, string(kubeconfig), "token: token")
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	require.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.1:443", config.Host)
+	assert.Equal(t, tokenFile, config.BearerTokenFile)
+	assert.Equal(t, caFile, config.TLSClientConfig.CAFile)
+}
@@ -0,0 +1,97 @@
+package kubernetes
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Component kinds of the images running in a database cluster.
+const (
+	ComponentEngine = "engine"
+	ComponentProxy  = "proxy"
+	ComponentBackup = "backup"
+	ComponentOther  = "other"
+)
+
+//nolint:gochecknoglobals
+var (
+	// proxyComponents are the app.kubernetes.io/component label values of the proxy pods.
+	proxyComponents = map[string]struct{}{
+		"haproxy":   {},
+		"proxysql":  {},
+		"mongos":    {},
+		"pgbouncer": {},
+	}
+	// backupContainers are the name fragments of the backup tools containers.
+	backupContainers = []string{"backup", "pbm", "pgbackrest", "xtrabackup"}
+)
+
+// ComponentImage is an image running in a database cluster.
+type ComponentImage struct {
+	Kind      string
+	Container string
+	Image     string
+	Version   string
+}
+
+// DatabaseClusterImages returns the distinct images running in the pods of the database cluster.
+func (k *Kubernetes) DatabaseClusterImages(ctx context.Context, db *everestv1alpha1.DatabaseCluster) ([]ComponentImage, error) {
+	pods, err := k.DatabaseClusterPods(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	return componentImages(pods), nil
+}
+
+func componentImages(pods []corev1.Pod) []ComponentImage {
+	seen := make(map[ComponentImage]struct{})
+	res := make([]ComponentImage, 0)
+	for _, pod := range pods {
+		component := pod.Labels["app.kubernetes.io/component"]
+		for _, c := range pod.Spec.Containers {
+			_, tag := SplitImage(c.Image)
+			img := ComponentImage{
+				Kind:      componentKind(component, c.Name),
+				Container: c.Name,
+				Image:     c.Image,
+				Version:   strings.TrimPrefix(tag, "v"),
+			}
+			if _, ok := seen[img]; ok {
+				continue
+			}
+			seen[img] = struct{}{}
+			res = append(res, img)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Kind != res[j].Kind {
+			return res[i].Kind < res[j].Kind
+		}
+		return res[i].Container < res[j].Container
+	})
+	return res
+}
+
+// componentKind tells the kind of the container by the component label of its pod and its name.
+func componentKind(component, container string) string {
+	for _, name := range backupContainers {
+		if strings.Contains(container, name) {
+			return ComponentBackup
+		}
+	}
+	if _, ok := proxyComponents[component]; ok {
+		return ComponentProxy
+	}
+	if _, ok := proxyComponents[container]; ok {
+		return ComponentProxy
+	}
+	switch container {
+	case "pxc", "mongod", "database":
+		return ComponentEngine
+	}
+	return ComponentOther
+}
@@ -0,0 +1,71 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestComponentKind(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		component string
+		container string
+		kind      string
+	}{
+		{component: "pxc", container: "pxc", kind: ComponentEngine},
+		{component: "pxc", container: "logcollector", kind: ComponentOther},
+		{component: "haproxy", container: "pxc-monit", kind: ComponentProxy},
+		{component: "mongod", container: "backup-agent", kind: ComponentBackup},
+		{component: "mongos", container: "mongos", kind: ComponentProxy},
+		{component: "", container: "database", kind: ComponentEngine},
+		{component: "", container: "pgbackrest", kind: ComponentBackup},
+		{component: "", container: "pgbouncer", kind: ComponentProxy},
+	}
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.component+"/"+tc.container, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.kind, componentKind(tc.component, tc.container))
+		})
+	}
+}
+
+func TestComponentImages(t *testing.T) {
+	t.Parallel()
+	pod := func(name, component string, containers ...corev1.Container) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"app.kubernetes.io/component": component}},
+			Spec:       corev1.PodSpec{Containers: containers},
+		}
+	}
+	pxc := corev1.Container{Name: "pxc", Image: "percona/percona-xtradb-cluster:8.0.32-24.2"}
+	haproxy := corev1.Container{Name: "haproxy", Image: "percona/haproxy:2.6.12"}
+
+	images := componentImages([]corev1.Pod{
+		pod("db-pxc-0", "pxc", pxc),
+		pod("db-pxc-1", "pxc", pxc),
+		pod("db-haproxy-0", "haproxy", haproxy),
+	})
+	assert.Equal(t, []ComponentImage{
+		{Kind: ComponentEngine, Container: "pxc", Image: pxc.Image, Version: "8.0.32-24.2"},
+		{Kind: ComponentProxy, Container: "haproxy", Image: haproxy.Image, Version: "2.6.12"},
+	}, images)
+}
@@ -23,6 +23,9 @@ import (
 	"strings"
 
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/percona/percona-everest-backend/pkg/kubernetes/client"
 )
@@ -57,7 +60,20 @@ type secretGetter interface {
 
 // New returns new Kubernetes object.
 func New(kubeconfig []byte, namespace string, l *zap.SugaredLogger) (*Kubernetes, error) {
-	client, err := client.NewFromKubeConfig(kubeconfig, namespace)
+	return newKubernetes(kubeconfig, namespace, nil, l)
+}
+
+func newKubernetes(
+	kubeconfig []byte, namespace string, persister rest.AuthProviderConfigPersister, l *zap.SugaredLogger,
+) (*Kubernetes, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateAuth(config); err != nil {
+		return nil, err
+	}
+	client, err := client.NewFromKubeConfig(kubeconfig, namespace, persister)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +87,8 @@ func New(kubeconfig []byte, namespace string, l *zap.SugaredLogger) (*Kubernetes
 }
 
 // NewFromSecretsStorage returns a new Kubernetes object by retrieving the kubeconfig from a
-// secrets storage.
+// secrets storage. If the secrets storage supports updating the secrets, the tokens refreshed
+// by the auth provider of the kubeconfig, e.g. OIDC, are written back to it.
 func NewFromSecretsStorage(
 	ctx context.Context, secretGetter secretGetter,
 	kubernetesID string, namespace string, l *zap.SugaredLogger,
@@ -85,7 +102,11 @@ func NewFromSecretsStorage(
 		return nil, errors.Join(err, errors.New("could not decode base64 kubeconfig"))
 	}
 
-	return New(kubeconfig, namespace, l)
+	var persister rest.AuthProviderConfigPersister
+	if p := newAuthProviderPersister(kubeconfig, secretGetter, kubernetesID); p != nil {
+		persister = p
+	}
+	return newKubernetes(kubeconfig, namespace, persister, l)
 }
 
 // ClusterName returns the name of the k8s cluster.
@@ -93,6 +114,16 @@ func (k *Kubernetes) ClusterName() string {
 	return k.client.ClusterName()
 }
 
+// GetServerVersion returns the version of the Kubernetes API server.
+func (k *Kubernetes) GetServerVersion() (*version.Info, error) {
+	return k.client.GetServerVersion()
+}
+
+// Namespace returns the namespace the client operates in.
+func (k *Kubernetes) Namespace() string {
+	return k.namespace
+}
+
 // GetClusterType tries to guess the underlying kubernetes cluster based on storage class.
 func (k *Kubernetes) GetClusterType(ctx context.Context) (ClusterType, error) {
 	storageClasses, err := k.client.GetStorageClasses(ctx)
@@ -49,6 +49,16 @@ func (k *Kubernetes) DeleteMonitoringConfig(ctx context.Context, name, secretNam
 	return k.DeleteSecret(ctx, secretName, k.namespace)
 }
 
+// GetMonitoringConfig returns the MonitoringConfig.
+func (k *Kubernetes) GetMonitoringConfig(ctx context.Context, name string) (*everestv1alpha1.MonitoringConfig, error) {
+	return k.client.GetMonitoringConfig(ctx, name)
+}
+
+// ListMonitoringConfigs returns the MonitoringConfigs.
+func (k *Kubernetes) ListMonitoringConfigs(ctx context.Context) (*everestv1alpha1.MonitoringConfigList, error) {
+	return k.client.ListMonitoringConfigs(ctx)
+}
+
 // GetMonitoringConfigsBySecretName returns a list of monitoring configs which use
 // the provided secret name.
 func (k *Kubernetes) GetMonitoringConfigsBySecretName(
@@ -73,7 +83,7 @@ func (k *Kubernetes) GetMonitoringConfigsBySecretName(
 // IsMonitoringConfigInUse returns true if a monitoring config is in use
 // by the provided Kubernetes cluster.
 func IsMonitoringConfigInUse(ctx context.Context, name string, kubeClient *Kubernetes) (bool, error) {
-	inUse, err := kubeClient.isMonitoringConfigUsedByVMAgent(ctx, name)
+	inUse, err := kubeClient.IsMonitoringConfigUsedByVMAgent(ctx, name)
 	if err != nil {
 		return false, err
 	}
@@ -96,7 +106,8 @@ func IsMonitoringConfigInUse(ctx context.Context, name string, kubeClient *Kuber
 	return false, nil
 }
 
-func (k *Kubernetes) isMonitoringConfigUsedByVMAgent(ctx context.Context, name string) (bool, error) {
+// IsMonitoringConfigUsedByVMAgent returns true if the default VMAgent writes to the monitoring config.
+func (k *Kubernetes) IsMonitoringConfigUsedByVMAgent(ctx context.Context, name string) (bool, error) {
 	vmAgents, err := k.ListVMAgents()
 	if err != nil {
 		return false, errors.Join(err, errors.New("could not list VM agents in Kubernetes"))
@@ -124,7 +135,8 @@ func (k *Kubernetes) isMonitoringConfigUsedByVMAgent(ctx context.Context, name s
 	return false, nil
 }
 
-// SecretNamesFromVMAgent returns a list of secret names as used by VMAgent's remoteWrite password field.
+// SecretNamesFromVMAgent returns a list of secret names as used by VMAgent's remoteWrite password
+// or bearer token fields.
 func (k *Kubernetes) SecretNamesFromVMAgent(vmAgent *unstructured.Unstructured) []string {
 	rws, ok, err := unstructured.NestedSlice(vmAgent.Object, "spec", "remoteWrite")
 	if err != nil {
@@ -148,6 +160,13 @@ func (k *Kubernetes) SecretNamesFromVMAgent(vmAgent *unstructured.Unstructured)
 			k.l.Debug(err)
 			continue
 		}
+		if !ok {
+			secretName, ok, err = unstructured.NestedString(rw, "bearerTokenSecret", "name")
+			if err != nil {
+				k.l.Debug(err)
+				continue
+			}
+		}
 		if !ok {
 			continue
 		}
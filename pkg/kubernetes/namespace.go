@@ -2,11 +2,172 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// watchNamespaceEnv is the environment variable of the operators listing the namespaces they watch.
+// The operators watch all namespaces if it is empty.
+const watchNamespaceEnv = "WATCH_NAMESPACE"
+
 // GetNamespace returns a namespace.
 func (k *Kubernetes) GetNamespace(ctx context.Context, name string) (*corev1.Namespace, error) {
 	return k.client.GetNamespace(ctx, name)
 }
+
+// ListNamespaces returns the namespaces.
+func (k *Kubernetes) ListNamespaces(ctx context.Context) ([]corev1.Namespace, error) {
+	list, err := k.client.ListNamespaces(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// IsManagedNamespace returns true if the namespace was prepared for Everest.
+func IsManagedNamespace(ns *corev1.Namespace) bool {
+	return ns.Labels[managedByLabel] == managedByValue
+}
+
+// EnsureManagedNamespace creates the namespace prepared for Everest with the labels if it does not exist,
+// or labels the existing one. It returns true if the namespace was created.
+func (k *Kubernetes) EnsureManagedNamespace(ctx context.Context, name string, labels map[string]string) (*corev1.Namespace, bool, error) {
+	ns, err := k.client.GetNamespace(ctx, name)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return nil, false, err
+		}
+		ns = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}}}
+		for key, value := range labels {
+			ns.Labels[key] = value
+		}
+		ns.Labels[managedByLabel] = managedByValue
+		ns, err = k.client.CreateNamespace(ctx, ns)
+		return ns, err == nil, err
+	}
+
+	if ns.Labels == nil {
+		ns.Labels = map[string]string{}
+	}
+	for key, value := range labels {
+		ns.Labels[key] = value
+	}
+	ns.Labels[managedByLabel] = managedByValue
+	ns, err = k.client.UpdateNamespace(ctx, ns)
+	return ns, false, err
+}
+
+// CopySecret copies the secret from the namespace of the client into the namespace
+// replacing the secret with the same name if it exists.
+func (k *Kubernetes) CopySecret(ctx context.Context, name, namespace string) error {
+	src, err := k.client.GetSecret(ctx, name, k.namespace)
+	if err != nil {
+		return errors.Join(err, fmt.Errorf("could not get secret %s", name))
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      src.Labels,
+			Annotations: src.Annotations,
+		},
+		Type: src.Type,
+		Data: src.Data,
+	}
+
+	existing, err := k.client.GetSecret(ctx, name, namespace)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+		_, err = k.client.CreateSecret(ctx, secret)
+		return err
+	}
+	secret.ResourceVersion = existing.ResourceVersion
+	_, err = k.client.UpdateSecret(ctx, secret)
+	return err
+}
+
+// WatchNamespace adds the namespace to the namespaces watched by the operator.
+// It returns false if the operator watches the namespace already.
+func (k *Kubernetes) WatchNamespace(ctx context.Context, operatorName, namespace string) (bool, error) {
+	deploymentName, ok := OperatorDeployments[operatorName]
+	if !ok {
+		return false, fmt.Errorf("unknown operator %s", operatorName)
+	}
+	deployment, err := k.client.GetDeployment(ctx, deploymentName)
+	if err != nil {
+		return false, err
+	}
+	if len(deployment.Spec.Template.Spec.Containers) == 0 {
+		return false, errors.New("operator deployment has no containers")
+	}
+
+	container := &deployment.Spec.Template.Spec.Containers[0]
+	for i := range container.Env {
+		env := &container.Env[i]
+		if env.Name != watchNamespaceEnv {
+			continue
+		}
+		watched, all := watchedNamespaces(*env, deployment.Namespace)
+		if all {
+			return false, nil
+		}
+		for _, ns := range watched {
+			if ns == namespace {
+				return false, nil
+			}
+		}
+		env.Value = strings.Join(append(watched, namespace), ",")
+		env.ValueFrom = nil
+		_, err = k.client.UpdateDeployment(ctx, deployment)
+		return err == nil, err
+	}
+	// The operator watches all namespaces without the variable.
+	return false, nil
+}
+
+// watchedNamespaces returns the namespaces listed by the WATCH_NAMESPACE variable
+// or true if the operator watches all namespaces.
+func watchedNamespaces(env corev1.EnvVar, ownNamespace string) ([]string, bool) {
+	if env.ValueFrom != nil {
+		if env.ValueFrom.FieldRef != nil && env.ValueFrom.FieldRef.FieldPath == "metadata.namespace" {
+			return []string{ownNamespace}, false
+		}
+		return nil, false
+	}
+	if strings.TrimSpace(env.Value) == "" {
+		return nil, true
+	}
+	var res []string
+	for _, ns := range strings.Split(env.Value, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			res = append(res, ns)
+		}
+	}
+	return res, false
+}
+
+// CreateNamespace creates a namespace.
+func (k *Kubernetes) CreateNamespace(ctx context.Context, namespace *corev1.Namespace) (*corev1.Namespace, error) {
+	return k.client.CreateNamespace(ctx, namespace)
+}
+
+// CreateResourceQuota creates a resource quota in the namespace of the quota.
+func (k *Kubernetes) CreateResourceQuota(ctx context.Context, quota *corev1.ResourceQuota) (*corev1.ResourceQuota, error) {
+	return k.client.CreateResourceQuota(ctx, quota)
+}
+
+// ListResourceQuotas returns the resource quotas of the namespace.
+func (k *Kubernetes) ListResourceQuotas(ctx context.Context, namespace string) ([]corev1.ResourceQuota, error) {
+	quotas, err := k.client.ListResourceQuotas(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return quotas.Items, nil
+}
@@ -0,0 +1,60 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestWatchedNamespaces(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		env     corev1.EnvVar
+		watched []string
+		all     bool
+	}{
+		{
+			name: "empty value watches all namespaces",
+			env:  corev1.EnvVar{Name: watchNamespaceEnv},
+			all:  true,
+		},
+		{
+			name:    "own namespace",
+			env:     corev1.EnvVar{Name: watchNamespaceEnv, ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}},
+			watched: []string{"everest"},
+		},
+		{
+			name:    "list of namespaces",
+			env:     corev1.EnvVar{Name: watchNamespaceEnv, Value: "everest, team-a,,team-b"},
+			watched: []string{"everest", "team-a", "team-b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			watched, all := watchedNamespaces(tc.env, "everest")
+			assert.Equal(t, tc.watched, watched)
+			assert.Equal(t, tc.all, all)
+		})
+	}
+}
@@ -7,6 +7,15 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+// GetNodes returns all the nodes of the cluster.
+func (k *Kubernetes) GetNodes(ctx context.Context) ([]corev1.Node, error) {
+	nodes, err := k.client.GetNodes(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not get nodes of Kubernetes cluster"))
+	}
+	return nodes.Items, nil
+}
+
 // GetWorkerNodes returns list of cluster workers nodes.
 func (k *Kubernetes) GetWorkerNodes(ctx context.Context) ([]corev1.Node, error) {
 	nodes, err := k.client.GetNodes(ctx)
@@ -0,0 +1,95 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// EverestOperatorName is the name of the everest operator.
+const EverestOperatorName = "everest-operator"
+
+// OperatorDeployments maps the operators managed by Everest to the names of their deployments.
+//
+//nolint:gochecknoglobals
+var OperatorDeployments = map[string]string{
+	EverestOperatorName:                              "everest-operator-controller-manager",
+	string(everestv1alpha1.DatabaseEnginePXC):        "percona-xtradb-cluster-operator",
+	string(everestv1alpha1.DatabaseEnginePSMDB):      "percona-server-mongodb-operator",
+	string(everestv1alpha1.DatabaseEnginePostgresql): "percona-postgresql-operator",
+}
+
+// Operator contains the installed version of an operator.
+type Operator struct {
+	Name       string
+	Deployment string
+	Image      string
+	Version    string
+}
+
+// GetOperator returns the operator installed by its deployment.
+func (k *Kubernetes) GetOperator(ctx context.Context, name string) (*Operator, error) {
+	deploymentName, ok := OperatorDeployments[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown operator %s", name)
+	}
+	deployment, err := k.client.GetDeployment(ctx, deploymentName)
+	if err != nil {
+		return nil, err
+	}
+	image, err := operatorImage(deployment)
+	if err != nil {
+		return nil, err
+	}
+	_, tag := SplitImage(image)
+	return &Operator{
+		Name:       name,
+		Deployment: deploymentName,
+		Image:      image,
+		Version:    strings.TrimPrefix(tag, "v"),
+	}, nil
+}
+
+// UpgradeOperator sets the image tag of the operator deployment to the version.
+// The rollout itself is done by Kubernetes.
+func (k *Kubernetes) UpgradeOperator(ctx context.Context, name, version string) error {
+	deploymentName, ok := OperatorDeployments[name]
+	if !ok {
+		return fmt.Errorf("unknown operator %s", name)
+	}
+	deployment, err := k.client.GetDeployment(ctx, deploymentName)
+	if err != nil {
+		return err
+	}
+	image, err := operatorImage(deployment)
+	if err != nil {
+		return err
+	}
+	repository, _ := SplitImage(image)
+	deployment.Spec.Template.Spec.Containers[0].Image = repository + ":" + version
+	_, err = k.client.UpdateDeployment(ctx, deployment)
+	return err
+}
+
+// operatorImage returns the image of the operator container which is the first one in the deployment.
+func operatorImage(deployment *appsv1.Deployment) (string, error) {
+	containers := deployment.Spec.Template.Spec.Containers
+	if len(containers) == 0 {
+		return "", errors.New("operator deployment has no containers")
+	}
+	return containers[0].Image, nil
+}
+
+// SplitImage splits an image reference into the repository and the tag.
+func SplitImage(image string) (string, string) {
+	image, _, _ = strings.Cut(image, "@")
+	i := strings.LastIndex(image, ":")
+	if i == -1 || strings.Contains(image[i:], "/") {
+		return image, ""
+	}
+	return image[:i], image[i+1:]
+}
@@ -215,3 +215,104 @@ func (k *Kubernetes) GetConsumedDiskBytes(
 
 	return 0, nil
 }
+
+// NodeResources contains allocatable resources of a node and resources requested by the pods scheduled on it.
+type NodeResources struct {
+	Name          string
+	Unschedulable bool
+	Allocatable   ResourceAmounts
+	Requested     ResourceAmounts
+}
+
+// ResourceAmounts contains amounts of CPU in millicpus, memory and ephemeral storage in bytes.
+type ResourceAmounts struct {
+	CPUMillis             uint64
+	MemoryBytes           uint64
+	EphemeralStorageBytes uint64
+}
+
+// GetNodesResources returns allocatable and requested resources of every worker node.
+func (k *Kubernetes) GetNodesResources(ctx context.Context) ([]NodeResources, error) {
+	nodes, err := k.GetWorkerNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := k.GetPods(ctx, "", nil)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not get a list of pods"))
+	}
+	return nodesResources(nodes, pods.Items)
+}
+
+func nodesResources(nodes []corev1.Node, pods []corev1.Pod) ([]NodeResources, error) {
+	requested := make(map[string]ResourceAmounts, len(nodes))
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		amounts, err := podRequests(pod)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("could not get requested resources of pod %s/%s", pod.Namespace, pod.Name))
+		}
+		r := requested[pod.Spec.NodeName]
+		r.CPUMillis += amounts.CPUMillis
+		r.MemoryBytes += amounts.MemoryBytes
+		r.EphemeralStorageBytes += amounts.EphemeralStorageBytes
+		requested[pod.Spec.NodeName] = r
+	}
+
+	res := make([]NodeResources, 0, len(nodes))
+	for _, node := range nodes {
+		allocatable, err := resourceAmounts(node.Status.Allocatable)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("could not get allocatable resources of node %s", node.Name))
+		}
+		res = append(res, NodeResources{
+			Name:          node.Name,
+			Unschedulable: node.Spec.Unschedulable,
+			Allocatable:   allocatable,
+			Requested:     requested[node.Name],
+		})
+	}
+	return res, nil
+}
+
+// podRequests returns resources requested by a pod the same way the scheduler accounts them:
+// the sum of the containers requests or the largest init container request, whichever is greater.
+func podRequests(pod corev1.Pod) (ResourceAmounts, error) {
+	var res ResourceAmounts
+	for _, container := range pod.Spec.Containers {
+		r, err := resourceAmounts(container.Resources.Requests)
+		if err != nil {
+			return ResourceAmounts{}, err
+		}
+		res.CPUMillis += r.CPUMillis
+		res.MemoryBytes += r.MemoryBytes
+		res.EphemeralStorageBytes += r.EphemeralStorageBytes
+	}
+	for _, container := range pod.Spec.InitContainers {
+		r, err := resourceAmounts(container.Resources.Requests)
+		if err != nil {
+			return ResourceAmounts{}, err
+		}
+		res.CPUMillis = max(res.CPUMillis, r.CPUMillis)
+		res.MemoryBytes = max(res.MemoryBytes, r.MemoryBytes)
+		res.EphemeralStorageBytes = max(res.EphemeralStorageBytes, r.EphemeralStorageBytes)
+	}
+	return res, nil
+}
+
+func resourceAmounts(resources corev1.ResourceList) (ResourceAmounts, error) {
+	cpuMillis, memoryBytes, err := getResources(resources)
+	if err != nil {
+		return ResourceAmounts{}, err
+	}
+	res := ResourceAmounts{CPUMillis: cpuMillis, MemoryBytes: memoryBytes}
+	if storage, ok := resources[corev1.ResourceEphemeralStorage]; ok {
+		res.EphemeralStorageBytes, err = convertors.StrToBytes(storage.String())
+		if err != nil {
+			return ResourceAmounts{}, errors.Join(err, fmt.Errorf("failed to convert '%s' to bytes", storage.String()))
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,94 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNodesResources(t *testing.T) {
+	t.Parallel()
+
+	requests := func(cpu, memory string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		}}
+	}
+	nodes := []corev1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:              resource.MustParse("4"),
+				corev1.ResourceMemory:           resource.MustParse("8G"),
+				corev1.ResourceEphemeralStorage: resource.MustParse("100G"),
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+			Spec:       corev1.NodeSpec{Unschedulable: true},
+			Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("4G"),
+			}},
+		},
+	}
+	pods := []corev1.Pod{
+		{
+			Spec: corev1.PodSpec{
+				NodeName: "node-1",
+				Containers: []corev1.Container{
+					{Resources: requests("500m", "1G")},
+					{Resources: requests("250m", "512M")},
+				},
+				InitContainers: []corev1.Container{{Resources: requests("1", "256M")}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+		{
+			Spec: corev1.PodSpec{
+				NodeName:   "node-1",
+				Containers: []corev1.Container{{Resources: requests("2", "2G")}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+		},
+		{
+			Spec:   corev1.PodSpec{Containers: []corev1.Container{{Resources: requests("2", "2G")}}},
+			Status: corev1.PodStatus{Phase: corev1.PodPending},
+		},
+	}
+
+	res, err := nodesResources(nodes, pods)
+	require.NoError(t, err)
+	assert.Equal(t, []NodeResources{
+		{
+			Name:        "node-1",
+			Allocatable: ResourceAmounts{CPUMillis: 4000, MemoryBytes: 8e9, EphemeralStorageBytes: 100e9},
+			Requested:   ResourceAmounts{CPUMillis: 1000, MemoryBytes: 1512e6},
+		},
+		{
+			Name:          "node-2",
+			Unschedulable: true,
+			Allocatable:   ResourceAmounts{CPUMillis: 2000, MemoryBytes: 4e9},
+		},
+	}, res)
+}
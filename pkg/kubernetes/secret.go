@@ -25,3 +25,8 @@ func (k *Kubernetes) UpdateSecret(ctx context.Context, secret *corev1.Secret) (*
 func (k *Kubernetes) DeleteSecret(ctx context.Context, name, namespace string) error {
 	return k.client.DeleteSecret(ctx, name, namespace)
 }
+
+// ListConfigSecrets returns the secrets of the config resources created by Everest in the namespace.
+func (k *Kubernetes) ListConfigSecrets(ctx context.Context, namespace string) (*corev1.SecretList, error) {
+	return k.client.ListSecrets(ctx, namespace, ConfigLabel)
+}
@@ -0,0 +1,24 @@
+package kubernetes
+
+import (
+	"net/http"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/percona/percona-everest-backend/pkg/kubernetes/client"
+)
+
+// TransportOptions limit the connections and the requests to the Kubernetes API servers.
+type TransportOptions = client.TransportOptions
+
+// SetTransportOptions sets the options of the connections to the Kubernetes API servers
+// used by the clients and the proxied requests.
+func SetTransportOptions(opts TransportOptions) {
+	client.SetTransportOptions(opts)
+}
+
+// TransportFor returns a round tripper sending the requests to the Kubernetes API server
+// of the config over the connections shared with the clients.
+func TransportFor(config *rest.Config) (http.RoundTripper, error) {
+	return client.TransportFor(config)
+}
@@ -0,0 +1,102 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ErrMetricsUnavailable is returned when the Kubernetes cluster does not serve the metrics API,
+// e.g. metrics-server is not installed.
+var ErrMetricsUnavailable = errors.New("metrics API is not available")
+
+// podMetricsList is the part of the metrics API pod list describing the usage of the containers.
+type podMetricsList struct {
+	Items []struct {
+		Containers []struct {
+			Usage map[corev1.ResourceName]resource.Quantity `json:"usage"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+// DatabaseClusterPods returns the pods of the database cluster.
+func (k *Kubernetes) DatabaseClusterPods(ctx context.Context, db *everestv1alpha1.DatabaseCluster) ([]corev1.Pod, error) {
+	pods, err := k.GetPods(ctx, db.Namespace, databaseClusterPodsSelector(db))
+	if err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// GetDatabaseClusterUsage returns the CPU and memory used by the pods of the database cluster
+// as reported by the metrics API. ErrMetricsUnavailable is returned if the metrics API is not served.
+func (k *Kubernetes) GetDatabaseClusterUsage(ctx context.Context, db *everestv1alpha1.DatabaseCluster) (ResourceAmounts, error) {
+	b, err := k.client.GetPodMetrics(ctx, db.Namespace, databaseClusterPodsSelector(db))
+	if err != nil {
+		if k8serrors.IsNotFound(err) || k8serrors.IsServiceUnavailable(err) {
+			return ResourceAmounts{}, errors.Join(ErrMetricsUnavailable, err)
+		}
+		return ResourceAmounts{}, err
+	}
+	return usageFromPodMetrics(b)
+}
+
+// PodsRequests returns the sum of the resources requested by the pods.
+func PodsRequests(pods []corev1.Pod) (ResourceAmounts, error) {
+	var res ResourceAmounts
+	for _, pod := range pods {
+		r, err := podRequests(pod)
+		if err != nil {
+			return ResourceAmounts{}, err
+		}
+		res.CPUMillis += r.CPUMillis
+		res.MemoryBytes += r.MemoryBytes
+		res.EphemeralStorageBytes += r.EphemeralStorageBytes
+	}
+	return res, nil
+}
+
+// PodsVolumeClaims returns the names of the persistent volume claims mounted by the pods.
+func PodsVolumeClaims(pods []corev1.Pod) map[string]struct{} {
+	res := make(map[string]struct{})
+	for _, pod := range pods {
+		for _, v := range pod.Spec.Volumes {
+			if v.PersistentVolumeClaim != nil {
+				res[v.PersistentVolumeClaim.ClaimName] = struct{}{}
+			}
+		}
+	}
+	return res
+}
+
+func databaseClusterPodsSelector(db *everestv1alpha1.DatabaseCluster) *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchLabels: map[string]string{"app.kubernetes.io/instance": db.Name},
+	}
+}
+
+func usageFromPodMetrics(b []byte) (ResourceAmounts, error) {
+	var metrics podMetricsList
+	if err := json.Unmarshal(b, &metrics); err != nil {
+		return ResourceAmounts{}, errors.Join(err, errors.New("could not parse pod metrics"))
+	}
+
+	var res ResourceAmounts
+	for _, pod := range metrics.Items {
+		for _, c := range pod.Containers {
+			if cpu, ok := c.Usage[corev1.ResourceCPU]; ok {
+				res.CPUMillis += uint64(cpu.MilliValue())
+			}
+			if memory, ok := c.Usage[corev1.ResourceMemory]; ok {
+				res.MemoryBytes += uint64(memory.Value())
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,62 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestUsageFromPodMetrics(t *testing.T) {
+	t.Parallel()
+
+	usage, err := usageFromPodMetrics([]byte(`{"items": [
+		{"containers": [
+			{"name": "pxc", "usage": {"cpu": "250m", "memory": "512Mi"}},
+			{"name": "pmm-client", "usage": {"cpu": "1500000n", "memory": "64Mi"}}
+		]},
+		{"containers": [{"name": "haproxy", "usage": {"cpu": "1", "memory": "1Gi"}}]}
+	]}`))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1252), usage.CPUMillis)
+	assert.Equal(t, uint64((512+64+1024)<<20), usage.MemoryBytes)
+
+	_, err = usageFromPodMetrics([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestPodsVolumeClaims(t *testing.T) {
+	t.Parallel()
+
+	pod := func(claims ...string) corev1.Pod {
+		p := corev1.Pod{}
+		p.Spec.Volumes = append(p.Spec.Volumes, corev1.Volume{Name: "config"})
+		for _, c := range claims {
+			p.Spec.Volumes = append(p.Spec.Volumes, corev1.Volume{
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: c},
+				},
+			})
+		}
+		return p
+	}
+
+	assert.Equal(t, map[string]struct{}{"datadir-db-pxc-0": {}, "datadir-db-pxc-1": {}},
+		PodsVolumeClaims([]corev1.Pod{pod("datadir-db-pxc-0"), pod("datadir-db-pxc-1"), pod()}))
+}
@@ -20,6 +20,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -35,25 +37,40 @@ const (
 	vmAgentUsernameSecretName = "everest-cluster-vmagent-username"
 )
 
+// VMAgentRemoteWrite defines where the default VMAgent sends the collected metrics.
+type VMAgentRemoteWrite struct {
+	// URL is the PMM address or the remote write endpoint if Prometheus is set.
+	URL string
+	// SecretName is the name of the secret with the monitoring instance credentials.
+	SecretName string
+	// Prometheus is set for a Prometheus compatible remote write endpoint.
+	Prometheus bool
+	// BearerToken is set if the Prometheus endpoint authenticates
+	// with a bearer token rather than with basic auth.
+	BearerToken bool
+}
+
 // DeployVMAgent deploys a default VMAgent used by Everest.
-func (k *Kubernetes) DeployVMAgent(ctx context.Context, secretName, monitoringURL string) error {
-	k.l.Debug("Creating VMAgent username secret")
-	_, err := k.CreateSecret(ctx, &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      vmAgentUsernameSecretName,
-			Namespace: k.namespace,
-		},
-		StringData: map[string]string{
-			"username": "api_key",
-		},
-	})
+func (k *Kubernetes) DeployVMAgent(ctx context.Context, remoteWrite VMAgentRemoteWrite) error {
+	if !remoteWrite.Prometheus {
+		k.l.Debug("Creating VMAgent username secret")
+		_, err := k.CreateSecret(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vmAgentUsernameSecretName,
+				Namespace: k.namespace,
+			},
+			StringData: map[string]string{
+				"username": "api_key",
+			},
+		})
 
-	if err != nil && !k8serrors.IsAlreadyExists(err) {
-		return errors.Join(err, errors.New("could not create VMAgent username secret"))
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return errors.Join(err, errors.New("could not create VMAgent username secret"))
+		}
 	}
 
 	k.l.Debug("Applying VMAgent spec")
-	vmagent, err := vmAgentSpec(k.namespace, secretName, monitoringURL)
+	vmagent, err := vmAgentSpec(k.namespace, remoteWrite)
 	if err != nil {
 		return errors.Join(err, errors.New("cannot generate VMAgent spec"))
 	}
@@ -69,7 +86,7 @@ func (k *Kubernetes) DeployVMAgent(ctx context.Context, secretName, monitoringUR
 
 // DeleteVMAgent deletes the default VMAgent as installed by Everest.
 func (k *Kubernetes) DeleteVMAgent() error {
-	vmagent, err := vmAgentSpec(k.namespace, "", "")
+	vmagent, err := vmAgentSpec(k.namespace, VMAgentRemoteWrite{})
 	if err != nil {
 		return errors.Join(err, errors.New("cannot generate VMAgent spec"))
 	}
@@ -98,13 +115,87 @@ func (k *Kubernetes) GetVMAgent(name string) (*unstructured.Unstructured, error)
 	return vmAgent, err
 }
 
+// ErrNoVMAgentPods is returned when no pods of the default VMAgent are running.
+var ErrNoVMAgentPods = errors.New("no running VMAgent pods")
+
+const vmAgentMetricsPort = "8429"
+
+// VMAgentRemoteWriteStats holds the remote write counters reported by
+// the default VMAgent pods since they started.
+type VMAgentRemoteWriteStats struct {
+	// Requests is the number of remote write requests by their HTTP status code.
+	Requests map[string]int64
+	// Errors is the number of remote write requests which failed without an HTTP response.
+	Errors int64
+}
+
+// GetVMAgentRemoteWriteStats returns the remote write counters of the running default VMAgent pods.
+func (k *Kubernetes) GetVMAgentRemoteWriteStats(ctx context.Context) (*VMAgentRemoteWriteStats, error) {
+	pods, err := k.client.GetPods(ctx, k.namespace, &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"app.kubernetes.io/name":     "vmagent",
+			"app.kubernetes.io/instance": VMAgentResourceName,
+		},
+	})
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not list VMAgent pods"))
+	}
+
+	stats := &VMAgentRemoteWriteStats{Requests: map[string]int64{}}
+	running := 0
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		metrics, err := k.client.GetPodProxy(ctx, k.namespace, pod.Name, vmAgentMetricsPort, "/metrics")
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("could not get metrics of VMAgent pod %s", pod.Name))
+		}
+		addRemoteWriteStats(stats, string(metrics))
+		running++
+	}
+	if running == 0 {
+		return nil, ErrNoVMAgentPods
+	}
+
+	return stats, nil
+}
+
+// addRemoteWriteStats adds the remote write counters found in the metrics
+// in the Prometheus text exposition format to the stats.
+func addRemoteWriteStats(stats *VMAgentRemoteWriteStats, metrics string) {
+	for _, line := range strings.Split(metrics, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			continue
+		}
+
+		name, labels, _ := strings.Cut(fields[0], "{")
+		switch name {
+		case "vmagent_remotewrite_requests_total":
+			_, code, ok := strings.Cut(labels, `status_code="`)
+			if !ok {
+				continue
+			}
+			code, _, _ = strings.Cut(code, `"`)
+			stats.Requests[code] += int64(value)
+		case "vmagent_remotewrite_errors_total":
+			stats.Errors += int64(value)
+		}
+	}
+}
+
 const specVMAgent = `
 {
 	"kind": "VMAgent",
 	"apiVersion": "operator.victoriametrics.com/v1beta1",
 	"metadata": {
-		"name": %[4]s,
-		"namespace": %[3]s,
+		"name": %[3]s,
+		"namespace": %[2]s,
 		"creationTimestamp": null,
 		"labels": {
 			"app.kubernetes.io/managed-by": "everest",
@@ -125,24 +216,7 @@ const specVMAgent = `
 			}
 		},
 		"remoteWrite": [
-			{
-				"url": %[2]s,
-				"basicAuth": {
-					"username": {
-						"name": %[5]s,
-						"key": "username"
-					},
-					"password": {
-						"name": %[1]s,
-						"key": "apiKey"
-					}
-				},
-				"tlsConfig": {
-					"ca": {},
-					"cert": {},
-					"insecureSkipVerify": true
-				}
-			}
+			%[1]s
 		],
 		"selectAllByDefault": true,
 		"serviceScrapeSelector": {},
@@ -160,38 +234,64 @@ const specVMAgent = `
 	}
 }`
 
-func vmAgentSpec(namespace, secretName, address string) (runtime.Object, error) { //nolint:ireturn
+func vmAgentSpec(namespace string, remoteWrite VMAgentRemoteWrite) (runtime.Object, error) { //nolint:ireturn
 	jName, err := json.Marshal(VMAgentResourceName)
 	if err != nil {
 		return nil, err
 	}
 
-	jSecret, err := json.Marshal(secretName)
+	jRemoteWrite, err := json.Marshal(vmAgentRemoteWriteSpec(remoteWrite))
 	if err != nil {
 		return nil, err
 	}
 
-	jAddress, err := json.Marshal(address + "/victoriametrics/api/v1/write")
+	jNamespace, err := json.Marshal(namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	jNamespace, err := json.Marshal(namespace)
+	manifest := fmt.Sprintf(specVMAgent, jRemoteWrite, jNamespace, jName)
+
+	o, _, err := unstructured.UnstructuredJSONScheme.Decode([]byte(manifest), nil, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	jUser, err := json.Marshal(vmAgentUsernameSecretName)
-	if err != nil {
-		return nil, err
+	return o, nil
+}
+
+func vmAgentRemoteWriteSpec(remoteWrite VMAgentRemoteWrite) map[string]interface{} {
+	secretKey := func(name, key string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "key": key}
 	}
 
-	manifest := fmt.Sprintf(specVMAgent, jSecret, jAddress, jNamespace, jName, jUser)
+	if !remoteWrite.Prometheus {
+		return map[string]interface{}{
+			"url": remoteWrite.URL + "/victoriametrics/api/v1/write",
+			"basicAuth": map[string]interface{}{
+				"username": secretKey(vmAgentUsernameSecretName, "username"),
+				"password": secretKey(remoteWrite.SecretName, "apiKey"),
+			},
+			"tlsConfig": map[string]interface{}{
+				"ca":                 map[string]interface{}{},
+				"cert":               map[string]interface{}{},
+				"insecureSkipVerify": true,
+			},
+		}
+	}
 
-	o, _, err := unstructured.UnstructuredJSONScheme.Decode([]byte(manifest), nil, nil)
-	if err != nil {
-		return nil, err
+	if remoteWrite.BearerToken {
+		return map[string]interface{}{
+			"url":               remoteWrite.URL,
+			"bearerTokenSecret": secretKey(remoteWrite.SecretName, "bearerToken"),
+		}
 	}
 
-	return o, nil
+	return map[string]interface{}{
+		"url": remoteWrite.URL,
+		"basicAuth": map[string]interface{}{
+			"username": secretKey(remoteWrite.SecretName, "username"),
+			"password": secretKey(remoteWrite.SecretName, "password"),
+		},
+	}
 }
@@ -0,0 +1,96 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestVMAgentSpecRemoteWrite(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		remoteWrite VMAgentRemoteWrite
+		url         string
+		auth        []string
+	}{
+		{
+			name:        "pmm",
+			remoteWrite: VMAgentRemoteWrite{URL: "https://pmm", SecretName: "pmm-secret"},
+			url:         "https://pmm/victoriametrics/api/v1/write",
+			auth:        []string{"basicAuth", "password", "name"},
+		},
+		{
+			name:        "prometheus basic auth",
+			remoteWrite: VMAgentRemoteWrite{URL: "http://vm:8428/api/v1/write", SecretName: "vm-secret", Prometheus: true},
+			url:         "http://vm:8428/api/v1/write",
+			auth:        []string{"basicAuth", "password", "name"},
+		},
+		{
+			name: "prometheus bearer token",
+			remoteWrite: VMAgentRemoteWrite{
+				URL: "http://vm:8428/api/v1/write", SecretName: "vm-secret", Prometheus: true, BearerToken: true,
+			},
+			url:  "http://vm:8428/api/v1/write",
+			auth: []string{"bearerTokenSecret", "name"},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			o, err := vmAgentSpec("everest", tc.remoteWrite)
+			require.NoError(t, err)
+			vmAgent, ok := o.(*unstructured.Unstructured)
+			require.True(t, ok)
+
+			rws, _, err := unstructured.NestedSlice(vmAgent.Object, "spec", "remoteWrite")
+			require.NoError(t, err)
+			require.Len(t, rws, 1)
+			rw, ok := rws[0].(map[string]interface{})
+			require.True(t, ok)
+
+			url, _, err := unstructured.NestedString(rw, "url")
+			require.NoError(t, err)
+			assert.Equal(t, tc.url, url)
+			secretName, _, err := unstructured.NestedString(rw, tc.auth...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.remoteWrite.SecretName, secretName)
+		})
+	}
+}
+
+func TestAddRemoteWriteStats(t *testing.T) {
+	t.Parallel()
+
+	metrics := `# HELP vmagent_remotewrite_requests_total
+vmagent_remotewrite_requests_total{url="1:secret-url",status_code="204"} 12
+vmagent_remotewrite_requests_total{url="1:secret-url",status_code="401"} 3
+vmagent_remotewrite_errors_total{url="1:secret-url"} 2
+vmagent_remotewrite_pending_data_bytes{url="1:secret-url"} 100
+`
+	stats := &VMAgentRemoteWriteStats{Requests: map[string]int64{}}
+	addRemoteWriteStats(stats, metrics)
+	addRemoteWriteStats(stats, `vmagent_remotewrite_requests_total{url="1:secret-url",status_code="204"} 1`)
+
+	assert.Equal(t, map[string]int64{"204": 13, "401": 3}, stats.Requests)
+	assert.Equal(t, int64(2), stats.Errors)
+}
@@ -0,0 +1,82 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// VolumeUsage is the usage of a persistent volume claim reported by the kubelet.
+type VolumeUsage struct {
+	PVCName       string
+	UsedBytes     uint64
+	CapacityBytes uint64
+}
+
+// statsSummary is the part of the kubelet stats summary describing the volumes of the pods.
+type statsSummary struct {
+	Pods []struct {
+		Volumes []struct {
+			UsedBytes     *uint64 `json:"usedBytes"`
+			CapacityBytes *uint64 `json:"capacityBytes"`
+			PVCRef        *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+// GetVolumeUsage returns the usage of the persistent volume claims of the namespace
+// mounted by the running pods, as reported by the kubelets of all nodes.
+func (k *Kubernetes) GetVolumeUsage(ctx context.Context, namespace string) ([]VolumeUsage, error) {
+	nodes, err := k.client.GetNodes(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not get nodes of Kubernetes cluster"))
+	}
+
+	var usage []VolumeUsage
+	seen := make(map[string]struct{})
+	for _, node := range nodes.Items {
+		summary, err := k.client.GetNodeStatsSummary(ctx, node.Name)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("could not get stats summary of node %s", node.Name))
+		}
+		volumes, err := volumeUsageFromSummary(summary, namespace)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("could not parse stats summary of node %s", node.Name))
+		}
+		for _, v := range volumes {
+			// A volume mounted by several pods is reported by each of them.
+			if _, ok := seen[v.PVCName]; ok {
+				continue
+			}
+			seen[v.PVCName] = struct{}{}
+			usage = append(usage, v)
+		}
+	}
+	return usage, nil
+}
+
+func volumeUsageFromSummary(b []byte, namespace string) ([]VolumeUsage, error) {
+	var summary statsSummary
+	if err := json.Unmarshal(b, &summary); err != nil {
+		return nil, err
+	}
+
+	var usage []VolumeUsage
+	for _, pod := range summary.Pods {
+		for _, v := range pod.Volumes {
+			if v.PVCRef == nil || v.PVCRef.Namespace != namespace || v.UsedBytes == nil || v.CapacityBytes == nil {
+				continue
+			}
+			usage = append(usage, VolumeUsage{
+				PVCName:       v.PVCRef.Name,
+				UsedBytes:     *v.UsedBytes,
+				CapacityBytes: *v.CapacityBytes,
+			})
+		}
+	}
+	return usage, nil
+}
@@ -0,0 +1,42 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVolumeUsageFromSummary(t *testing.T) {
+	t.Parallel()
+
+	summary := `{"node":{"nodeName":"node-1"},"pods":[
+		{"podRef":{"name":"db-0","namespace":"everest"},"volume":[
+			{"name":"datadir","usedBytes":80,"capacityBytes":100,"pvcRef":{"name":"datadir-db-0","namespace":"everest"}},
+			{"name":"tmp","usedBytes":1,"capacityBytes":10}
+		]},
+		{"podRef":{"name":"app-0","namespace":"other"},"volume":[
+			{"name":"data","usedBytes":1,"capacityBytes":10,"pvcRef":{"name":"data-app-0","namespace":"other"}}
+		]},
+		{"podRef":{"name":"db-1","namespace":"everest"}}
+	]}`
+
+	usage, err := volumeUsageFromSummary([]byte(summary), "everest")
+	require.NoError(t, err)
+	assert.Equal(t, []VolumeUsage{{PVCName: "datadir-db-0", UsedBytes: 80, CapacityBytes: 100}}, usage)
+}
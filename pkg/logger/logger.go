@@ -23,23 +23,20 @@ import (
 )
 
 // MustInitLogger initializes logger and panics in case of an error.
-func MustInitLogger() *zap.Logger {
-	var (
-		logger *zap.Logger
-		err    error
-	)
-
+// The returned level changes the level of the logger at runtime.
+func MustInitLogger() (*zap.Logger, zap.AtomicLevel) {
+	var loggerCfg zap.Config
 	if config.Debug {
-		loggerCfg := zap.NewDevelopmentConfig()
+		loggerCfg = zap.NewDevelopmentConfig()
 		loggerCfg.DisableCaller = true
-		logger, err = loggerCfg.Build()
 	} else {
-		logger, err = zap.NewProduction()
+		loggerCfg = zap.NewProductionConfig()
 	}
 
+	logger, err := loggerCfg.Build()
 	if err != nil {
 		panic("cannot initialize logger")
 	}
 
-	return logger
+	return logger, loggerCfg.Level
 }
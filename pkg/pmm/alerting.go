@@ -0,0 +1,187 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pmm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// AlertRule is an alert rule created in PMM from an alert template.
+type AlertRule struct {
+	// TemplateName is the name of the alert template the rule is created from.
+	TemplateName string
+	// Name is the name of the rule.
+	Name string
+	// Group is the name of the rule group in the folder.
+	Group string
+	// FolderUID is the UID of the folder the rule is created in.
+	FolderUID string
+	// Threshold is the value of the threshold parameter of the template.
+	Threshold float64
+	// ForSeconds is how long the expression has to be true before the alert fires.
+	ForSeconds int
+	// Severity is one of the PMM severities, e.g. warning or critical.
+	Severity string
+	// Filters are the regular expressions the labels of the alerts have to match.
+	Filters map[string]string
+}
+
+// apiError is returned when PMM responds with an error HTTP status code.
+type apiError struct {
+	statusCode int
+	message    string
+}
+
+func (e *apiError) Error() string {
+	if e.message == "" {
+		return fmt.Sprintf("PMM returned an unknown error. HTTP status code %d", e.statusCode)
+	}
+	return fmt.Sprintf("PMM returned an error with message: %s", e.message)
+}
+
+func hasStatusCode(err error, code int) bool {
+	var apiErr *apiError
+	return errors.As(err, &apiErr) && apiErr.statusCode == code
+}
+
+// SaveAlertTemplate creates the alert template described by the YAML document
+// in PMM or updates it if the template already exists.
+func SaveAlertTemplate(ctx context.Context, hostname, apiKey, name, yaml string) error {
+	err := doRequest(ctx, hostname, apiKey, http.MethodPost, "/v1/management/alerting/Templates/Create",
+		map[string]string{"yaml": yaml}, nil,
+	)
+	if !hasStatusCode(err, http.StatusConflict) {
+		return err
+	}
+
+	return doRequest(ctx, hostname, apiKey, http.MethodPost, "/v1/management/alerting/Templates/Update",
+		map[string]string{"name": name, "yaml": yaml}, nil,
+	)
+}
+
+// EnsureAlertFolder returns the UID of the alerting folder with the title
+// creating the folder if it does not exist.
+func EnsureAlertFolder(ctx context.Context, hostname, apiKey, title string) (string, error) {
+	var folders []struct {
+		UID   string `json:"uid"`
+		Title string `json:"title"`
+	}
+	if err := doRequest(ctx, hostname, apiKey, http.MethodGet, "/graph/api/folders", nil, &folders); err != nil {
+		return "", err
+	}
+	for _, f := range folders {
+		if f.Title == title {
+			return f.UID, nil
+		}
+	}
+
+	var folder struct {
+		UID string `json:"uid"`
+	}
+	err := doRequest(ctx, hostname, apiKey, http.MethodPost, "/graph/api/folders", map[string]string{"title": title}, &folder)
+	if err != nil {
+		return "", err
+	}
+	return folder.UID, nil
+}
+
+// CreateAlertRule creates an alert rule from an alert template.
+func CreateAlertRule(ctx context.Context, hostname, apiKey string, rule AlertRule) error {
+	filters := make([]map[string]string, 0, len(rule.Filters))
+	for label, re := range rule.Filters {
+		filters = append(filters, map[string]string{
+			"type":   "MATCH",
+			"label":  label,
+			"regexp": re,
+		})
+	}
+
+	return doRequest(ctx, hostname, apiKey, http.MethodPost, "/v1/management/alerting/Rules/Create",
+		map[string]interface{}{
+			"template_name": rule.TemplateName,
+			"name":          rule.Name,
+			"group":         rule.Group,
+			"folder_uid":    rule.FolderUID,
+			"params": []map[string]interface{}{
+				{"name": "threshold", "type": "FLOAT", "float": rule.Threshold},
+			},
+			"for":      fmt.Sprintf("%ds", rule.ForSeconds),
+			"severity": "SEVERITY_" + strings.ToUpper(rule.Severity),
+			"filters":  filters,
+		}, nil,
+	)
+}
+
+// DeleteAlertRuleGroup deletes the rule group with all its rules from the folder.
+// A missing rule group is not an error.
+func DeleteAlertRuleGroup(ctx context.Context, hostname, apiKey, folderUID, group string) error {
+	path := fmt.Sprintf("/graph/api/ruler/grafana/api/v1/rules/%s/%s", url.PathEscape(folderUID), url.PathEscape(group))
+	err := doRequest(ctx, hostname, apiKey, http.MethodDelete, path, nil, nil)
+	if hasStatusCode(err, http.StatusNotFound) {
+		return nil
+	}
+	return err
+}
+
+func doRequest(ctx context.Context, hostname, apiKey, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, hostname+path, body)
+	if err != nil {
+		return err
+	}
+	req.Close = true
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var pmmErr *pmmErrorMessage
+		if err := json.Unmarshal(data, &pmmErr); err != nil || pmmErr == nil {
+			return &apiError{statusCode: resp.StatusCode}
+		}
+		return &apiError{statusCode: resp.StatusCode, message: pmmErr.Message}
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,59 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pmm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// queryResponse is the part of the Prometheus HTTP API response of an instant query with a vector result.
+type queryResponse struct {
+	Data struct {
+		Result []struct {
+			// Value is a pair of the timestamp and the value encoded as a string.
+			Value [2]interface{} `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// QuerySum runs the instant PromQL query against the metrics stored in PMM and returns the sum
+// of the values of the resulting series. The returned bool is false if the query matched no series.
+func QuerySum(ctx context.Context, hostname, apiKey, query string) (float64, bool, error) {
+	var resp queryResponse
+	path := "/prometheus/api/v1/query?query=" + url.QueryEscape(query)
+	if err := doRequest(ctx, hostname, apiKey, http.MethodGet, path, nil, &resp); err != nil {
+		return 0, false, err
+	}
+
+	var sum float64
+	for _, r := range resp.Data.Result {
+		s, ok := r.Value[1].(string)
+		if !ok {
+			return 0, false, errors.New("could not parse the value of the query result")
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, false, errors.Join(err, fmt.Errorf("could not parse the value %s of the query result", s))
+		}
+		sum += v
+	}
+	return sum, len(resp.Data.Result) != 0, nil
+}
@@ -0,0 +1,112 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secrets provides the secrets storages backed by the cloud secret managers and Kubernetes.
+package secrets
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+// awsClient is the part of the AWS Secrets Manager API used by AWSStorage.
+type awsClient interface {
+	CreateSecret(ctx context.Context, params *secretsmanager.CreateSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.CreateSecretOutput, error)
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+	PutSecretValue(ctx context.Context, params *secretsmanager.PutSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error)
+	DeleteSecret(ctx context.Context, params *secretsmanager.DeleteSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.DeleteSecretOutput, error)
+}
+
+// AWSStorage stores the secrets in AWS Secrets Manager.
+type AWSStorage struct {
+	client awsClient
+	prefix string
+}
+
+// NewAWSStorage returns a secrets storage backed by AWS Secrets Manager.
+// The region and the credentials are taken from the default AWS configuration chain,
+// i.e. the AWS_* environment variables, the shared config files and the instance or pod role.
+// The secret names are prefixed with the given prefix.
+func NewAWSStorage(ctx context.Context, prefix string) (*AWSStorage, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not load AWS configuration"))
+	}
+	return &AWSStorage{client: secretsmanager.NewFromConfig(cfg), prefix: prefix}, nil
+}
+
+// CreateSecret creates a secret.
+func (s *AWSStorage) CreateSecret(ctx context.Context, id, value string) error {
+	_, err := s.client.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:         aws.String(s.prefix + id),
+		SecretString: aws.String(value),
+	})
+	return err
+}
+
+// GetSecret returns the secret by its id.
+func (s *AWSStorage) GetSecret(ctx context.Context, id string) (string, error) {
+	out, err := s.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(s.prefix + id),
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(out.SecretString), nil
+}
+
+// GetSecrets returns the secrets by their ids.
+func (s *AWSStorage) GetSecrets(ctx context.Context, ids []string) (map[string]string, error) {
+	return getEach(ctx, s.GetSecret, ids)
+}
+
+// UpdateSecret updates the secret by its id. The secret is created if it does not exist.
+func (s *AWSStorage) UpdateSecret(ctx context.Context, id, value string) error {
+	_, err := s.client.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(s.prefix + id),
+		SecretString: aws.String(value),
+	})
+	var notFound *types.ResourceNotFoundException
+	if errors.As(err, &notFound) {
+		return s.CreateSecret(ctx, id, value)
+	}
+	return err
+}
+
+// DeleteSecret deletes the secret by its id without a recovery window. Returns the deleted secret.
+func (s *AWSStorage) DeleteSecret(ctx context.Context, id string) (string, error) {
+	value, err := s.GetSecret(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	_, err = s.client.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(s.prefix + id),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	})
+	if err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// Close does nothing, the AWS client holds no resources.
+func (s *AWSStorage) Close() error {
+	return nil
+}
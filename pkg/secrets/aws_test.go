@@ -0,0 +1,94 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAWSClient keeps the secrets in memory.
+type fakeAWSClient map[string]string
+
+func (f fakeAWSClient) CreateSecret(
+	_ context.Context, in *secretsmanager.CreateSecretInput, _ ...func(*secretsmanager.Options),
+) (*secretsmanager.CreateSecretOutput, error) {
+	if _, ok := f[*in.Name]; ok {
+		return nil, &types.ResourceExistsException{}
+	}
+	f[*in.Name] = *in.SecretString
+	return &secretsmanager.CreateSecretOutput{}, nil
+}
+
+func (f fakeAWSClient) GetSecretValue(
+	_ context.Context, in *secretsmanager.GetSecretValueInput, _ ...func(*secretsmanager.Options),
+) (*secretsmanager.GetSecretValueOutput, error) {
+	v, ok := f[*in.SecretId]
+	if !ok {
+		return nil, &types.ResourceNotFoundException{}
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(v)}, nil
+}
+
+func (f fakeAWSClient) PutSecretValue(
+	_ context.Context, in *secretsmanager.PutSecretValueInput, _ ...func(*secretsmanager.Options),
+) (*secretsmanager.PutSecretValueOutput, error) {
+	if _, ok := f[*in.SecretId]; !ok {
+		return nil, &types.ResourceNotFoundException{}
+	}
+	f[*in.SecretId] = *in.SecretString
+	return &secretsmanager.PutSecretValueOutput{}, nil
+}
+
+func (f fakeAWSClient) DeleteSecret(
+	_ context.Context, in *secretsmanager.DeleteSecretInput, _ ...func(*secretsmanager.Options),
+) (*secretsmanager.DeleteSecretOutput, error) {
+	delete(f, *in.SecretId)
+	return &secretsmanager.DeleteSecretOutput{}, nil
+}
+
+func TestAWSStorage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fakeAWSClient{}
+	s := &AWSStorage{client: client, prefix: "everest-"}
+
+	require.NoError(t, s.CreateSecret(ctx, "a", "1"))
+	assert.Equal(t, "1", client["everest-a"])
+	assert.Error(t, s.CreateSecret(ctx, "a", "2"))
+
+	require.NoError(t, s.UpdateSecret(ctx, "a", "2"))
+	v, err := s.GetSecret(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "2", v)
+
+	// UpdateSecret creates missing secrets so that the migrations may be repeated.
+	require.NoError(t, s.UpdateSecret(ctx, "b", "3"))
+	assert.Equal(t, "3", client["everest-b"])
+
+	v, err = s.DeleteSecret(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "2", v)
+	_, err = s.GetSecret(ctx, "a")
+	assert.Error(t, err)
+}
@@ -0,0 +1,241 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// envelopePrefix marks the secret values encrypted by EncryptedStorage.
+// The ciphertexts are bound to the IDs of their secrets.
+const envelopePrefix = "everest-envelope:v1:"
+
+// dataKeySize is the size of the AES-256 keys the secret values are encrypted with.
+const dataKeySize = 32
+
+// ErrUnknownKey is returned when a secret is encrypted with a key which is not configured.
+var ErrUnknownKey = errors.New("the secret is encrypted with an unknown key")
+
+// ErrNotEncrypted is returned when a secret is not encrypted and the plaintext secrets are not allowed.
+var ErrNotEncrypted = errors.New("the secret is not encrypted")
+
+// KeyWrapper encrypts and decrypts the data keys the secret values are encrypted with.
+type KeyWrapper interface {
+	// KeyID returns the ID of the key new data keys are encrypted with.
+	KeyID() string
+	// HasKey returns true if the data keys encrypted with the key can be decrypted.
+	HasKey(keyID string) bool
+	// WrapKey encrypts the data key with the key returned by KeyID.
+	WrapKey(ctx context.Context, dataKey []byte) ([]byte, error)
+	// UnwrapKey decrypts the data key encrypted with the given key.
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// envelope is an encrypted secret value together with its encrypted data key.
+type envelope struct {
+	KeyID      string `json:"keyId"`
+	DataKey    []byte `json:"dataKey"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// EncryptedStorage encrypts the secret values stored in another storage.
+// Every value is encrypted with its own AES-GCM data key which is in turn encrypted with a key of the KeyWrapper.
+// The ID of the secret is authenticated with the value so that the values cannot be swapped between the secrets.
+// The values stored before the encryption was enabled are rejected unless allowPlaintext is set,
+// which is meant for the time until they are re-encrypted.
+type EncryptedStorage struct {
+	storage        Storage
+	keys           KeyWrapper
+	allowPlaintext bool
+}
+
+// NewEncryptedStorage returns a storage encrypting the secret values stored in the given storage.
+func NewEncryptedStorage(storage Storage, keys KeyWrapper, allowPlaintext bool) *EncryptedStorage {
+	return &EncryptedStorage{storage: storage, keys: keys, allowPlaintext: allowPlaintext}
+}
+
+// CreateSecret creates a secret.
+func (s *EncryptedStorage) CreateSecret(ctx context.Context, id, value string) error {
+	encrypted, err := s.Encrypt(ctx, id, value)
+	if err != nil {
+		return err
+	}
+	return s.storage.CreateSecret(ctx, id, encrypted)
+}
+
+// GetSecret returns the secret by its id.
+func (s *EncryptedStorage) GetSecret(ctx context.Context, id string) (string, error) {
+	value, err := s.storage.GetSecret(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return s.Decrypt(ctx, id, value)
+}
+
+// GetSecrets returns the secrets by their ids.
+func (s *EncryptedStorage) GetSecrets(ctx context.Context, ids []string) (map[string]string, error) {
+	values, err := s.storage.GetSecrets(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for id, value := range values {
+		if values[id], err = s.Decrypt(ctx, id, value); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// UpdateSecret updates the secret by its id.
+func (s *EncryptedStorage) UpdateSecret(ctx context.Context, id, value string) error {
+	encrypted, err := s.Encrypt(ctx, id, value)
+	if err != nil {
+		return err
+	}
+	return s.storage.UpdateSecret(ctx, id, encrypted)
+}
+
+// DeleteSecret deletes the secret by its id. Returns the deleted secret.
+func (s *EncryptedStorage) DeleteSecret(ctx context.Context, id string) (string, error) {
+	value, err := s.storage.DeleteSecret(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return s.Decrypt(ctx, id, value)
+}
+
+// Close closes the underlying storage.
+func (s *EncryptedStorage) Close() error {
+	return s.storage.Close()
+}
+
+// Encrypt encrypts the value of the secret with the given ID with a new data key.
+func (s *EncryptedStorage) Encrypt(ctx context.Context, id, value string) (string, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(dataKey, []byte(value), []byte(id))
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := s.keys.WrapKey(ctx, dataKey)
+	if err != nil {
+		return "", errors.Join(err, errors.New("could not encrypt data key"))
+	}
+
+	b, err := json.Marshal(envelope{KeyID: s.keys.KeyID(), DataKey: wrapped, Ciphertext: ciphertext})
+	if err != nil {
+		return "", err
+	}
+	return envelopePrefix + base64.StdEncoding.EncodeToString(b), nil
+}
+
+// Decrypt decrypts the value of the secret with the given ID encrypted by Encrypt.
+// Values which are not encrypted are returned as they are if the plaintext values are allowed.
+func (s *EncryptedStorage) Decrypt(ctx context.Context, id, value string) (string, error) {
+	env, ok, err := parseEnvelope(value)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		if !s.allowPlaintext {
+			return "", ErrNotEncrypted
+		}
+		return value, nil
+	}
+	if !s.keys.HasKey(env.KeyID) {
+		return "", errors.Join(ErrUnknownKey, fmt.Errorf("key %s is not configured", env.KeyID))
+	}
+	dataKey, err := s.keys.UnwrapKey(ctx, env.KeyID, env.DataKey)
+	if err != nil {
+		return "", errors.Join(err, errors.New("could not decrypt data key"))
+	}
+	plaintext, err := open(dataKey, env.Ciphertext, []byte(id))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsReencryption returns true if the value is not encrypted or is encrypted with another key than the current one.
+func (s *EncryptedStorage) NeedsReencryption(value string) bool {
+	env, ok, err := parseEnvelope(value)
+	if err != nil || !ok {
+		return true
+	}
+	return env.KeyID != s.keys.KeyID()
+}
+
+func parseEnvelope(value string) (*envelope, bool, error) {
+	encoded, ok := strings.CutPrefix(value, envelopePrefix)
+	if !ok {
+		return nil, false, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, true, errors.Join(err, errors.New("could not decode encrypted secret"))
+	}
+	env := &envelope{}
+	if err := json.Unmarshal(b, env); err != nil {
+		return nil, true, errors.Join(err, errors.New("could not decode encrypted secret"))
+	}
+	return env, true, nil
+}
+
+// seal encrypts the plaintext with AES-GCM and prepends the nonce to the ciphertext.
+// The additional data is authenticated but not encrypted, it shall be passed to open as well.
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts the ciphertext produced by seal with the same additional data.
+func open(key, ciphertext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce, ciphertext := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,141 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStorage keeps the secrets in memory.
+type fakeStorage map[string]string
+
+func (f fakeStorage) CreateSecret(_ context.Context, id, value string) error {
+	f[id] = value
+	return nil
+}
+
+func (f fakeStorage) GetSecret(_ context.Context, id string) (string, error) {
+	return f[id], nil
+}
+
+func (f fakeStorage) GetSecrets(ctx context.Context, ids []string) (map[string]string, error) {
+	return getEach(ctx, f.GetSecret, ids)
+}
+
+func (f fakeStorage) UpdateSecret(_ context.Context, id, value string) error {
+	f[id] = value
+	return nil
+}
+
+func (f fakeStorage) DeleteSecret(_ context.Context, id string) (string, error) {
+	v := f[id]
+	delete(f, id)
+	return v, nil
+}
+
+func (f fakeStorage) Close() error {
+	return nil
+}
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(b), dataKeySize)))
+}
+
+func TestEncryptedStorage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	oldKeys, err := NewLocalKeys("old:" + testKey('a'))
+	require.NoError(t, err)
+	storage := fakeStorage{"plain": "kubeconfig"}
+	s := NewEncryptedStorage(storage, oldKeys, true)
+
+	require.NoError(t, s.CreateSecret(ctx, "a", "secret"))
+	assert.True(t, strings.HasPrefix(storage["a"], envelopePrefix))
+	assert.NotContains(t, storage["a"], "secret")
+	v, err := s.GetSecret(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", v)
+
+	// The secrets stored before the encryption was enabled are readable.
+	v, err = s.GetSecret(ctx, "plain")
+	require.NoError(t, err)
+	assert.Equal(t, "kubeconfig", v)
+	assert.True(t, s.NeedsReencryption(storage["plain"]))
+	assert.False(t, s.NeedsReencryption(storage["a"]))
+	// Unless the plaintext secrets are not allowed anymore.
+	_, err = NewEncryptedStorage(storage, oldKeys, false).GetSecret(ctx, "plain")
+	assert.ErrorIs(t, err, ErrNotEncrypted)
+
+	// The values are bound to their secrets.
+	storage["swapped"] = storage["a"]
+	_, err = s.GetSecret(ctx, "swapped")
+	assert.Error(t, err)
+	delete(storage, "swapped")
+
+	// The secrets encrypted with the previous key are readable after a rotation.
+	rotated, err := NewLocalKeys("new:" + testKey('b') + ",old:" + testKey('a'))
+	require.NoError(t, err)
+	s = NewEncryptedStorage(storage, rotated, false)
+	assert.True(t, s.NeedsReencryption(storage["a"]))
+	v, err = s.DeleteSecret(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", v)
+
+	// The secrets encrypted with a removed key are not.
+	require.NoError(t, s.CreateSecret(ctx, "b", "secret"))
+	s = NewEncryptedStorage(storage, oldKeys, false)
+	_, err = s.GetSecret(ctx, "b")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestNewLocalKeys(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name    string
+		spec    string
+		keyID   string
+		wantErr bool
+	}
+	cases := []testCase{
+		{name: "single", spec: "a:" + testKey('a'), keyID: "a"},
+		{name: "rotated", spec: "b:" + testKey('b') + ", a:" + testKey('a'), keyID: "b"},
+		{name: "missing id", spec: testKey('a'), wantErr: true},
+		{name: "short key", spec: "a:" + base64.StdEncoding.EncodeToString([]byte("short")), wantErr: true},
+		{name: "duplicate", spec: "a:" + testKey('a') + ",a:" + testKey('b'), wantErr: true},
+	}
+
+	for _, testCase := range cases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			keys, err := NewLocalKeys(tc.spec)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.keyID, keys.KeyID())
+		})
+	}
+}
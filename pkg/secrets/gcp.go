@@ -0,0 +1,135 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gcpClient is the part of the GCP Secret Manager API used by GCPStorage.
+type gcpClient interface {
+	CreateSecret(ctx context.Context, req *secretmanagerpb.CreateSecretRequest, opts ...gax.CallOption) (*secretmanagerpb.Secret, error)
+	AddSecretVersion(ctx context.Context, req *secretmanagerpb.AddSecretVersionRequest, opts ...gax.CallOption) (*secretmanagerpb.SecretVersion, error)
+	AccessSecretVersion(ctx context.Context, req *secretmanagerpb.AccessSecretVersionRequest, opts ...gax.CallOption) (*secretmanagerpb.AccessSecretVersionResponse, error)
+	DeleteSecret(ctx context.Context, req *secretmanagerpb.DeleteSecretRequest, opts ...gax.CallOption) error
+	Close() error
+}
+
+// GCPStorage stores the secrets in GCP Secret Manager.
+// A secret is a Secret Manager secret, and its value is the latest version of the secret.
+type GCPStorage struct {
+	client  gcpClient
+	project string
+	prefix  string
+}
+
+// NewGCPStorage returns a secrets storage backed by the GCP Secret Manager of the given project.
+// The credentials are taken from the Application Default Credentials, i.e. GOOGLE_APPLICATION_CREDENTIALS
+// or the workload identity. The secret IDs are prefixed with the given prefix.
+func NewGCPStorage(ctx context.Context, project, prefix string) (*GCPStorage, error) {
+	if project == "" {
+		return nil, errors.New("GCP project is not set")
+	}
+	client, err := secretmanager.NewClient(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not create GCP Secret Manager client"))
+	}
+	return &GCPStorage{client: client, project: project, prefix: prefix}, nil
+}
+
+// CreateSecret creates a secret.
+func (s *GCPStorage) CreateSecret(ctx context.Context, id, value string) error {
+	_, err := s.client.CreateSecret(ctx, &secretmanagerpb.CreateSecretRequest{
+		Parent:   "projects/" + s.project,
+		SecretId: s.prefix + id,
+		Secret: &secretmanagerpb.Secret{
+			Replication: &secretmanagerpb.Replication{
+				Replication: &secretmanagerpb.Replication_Automatic_{Automatic: &secretmanagerpb.Replication_Automatic{}},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.addVersion(ctx, id, value); err != nil {
+		// Do not leave a secret without a value behind.
+		_ = s.client.DeleteSecret(ctx, &secretmanagerpb.DeleteSecretRequest{Name: s.name(id)})
+		return err
+	}
+	return nil
+}
+
+// GetSecret returns the secret by its id.
+func (s *GCPStorage) GetSecret(ctx context.Context, id string) (string, error) {
+	res, err := s.client.AccessSecretVersion(ctx, &secretmanagerpb.AccessSecretVersionRequest{
+		Name: s.name(id) + "/versions/latest",
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(res.GetPayload().GetData()), nil
+}
+
+// GetSecrets returns the secrets by their ids.
+func (s *GCPStorage) GetSecrets(ctx context.Context, ids []string) (map[string]string, error) {
+	return getEach(ctx, s.GetSecret, ids)
+}
+
+// UpdateSecret updates the secret by its id. The secret is created if it does not exist.
+func (s *GCPStorage) UpdateSecret(ctx context.Context, id, value string) error {
+	err := s.addVersion(ctx, id, value)
+	if status.Code(err) == codes.NotFound {
+		return s.CreateSecret(ctx, id, value)
+	}
+	return err
+}
+
+// DeleteSecret deletes the secret with all its versions by its id. Returns the deleted secret.
+func (s *GCPStorage) DeleteSecret(ctx context.Context, id string) (string, error) {
+	value, err := s.GetSecret(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if err := s.client.DeleteSecret(ctx, &secretmanagerpb.DeleteSecretRequest{Name: s.name(id)}); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// Close closes the connection to GCP Secret Manager.
+func (s *GCPStorage) Close() error {
+	return s.client.Close()
+}
+
+func (s *GCPStorage) addVersion(ctx context.Context, id, value string) error {
+	_, err := s.client.AddSecretVersion(ctx, &secretmanagerpb.AddSecretVersionRequest{
+		Parent:  s.name(id),
+		Payload: &secretmanagerpb.SecretPayload{Data: []byte(value)},
+	})
+	return err
+}
+
+func (s *GCPStorage) name(id string) string {
+	return fmt.Sprintf("projects/%s/secrets/%s%s", s.project, s.prefix, id)
+}
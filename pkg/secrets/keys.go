@@ -0,0 +1,171 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+)
+
+// LocalKeys encrypts the data keys with AES-GCM keys provided in the configuration.
+type LocalKeys struct {
+	current string
+	keys    map[string][]byte
+}
+
+// NewLocalKeys parses a comma-separated list of id:key pairs where the keys are base64-encoded 32-byte AES keys.
+// The first key encrypts the new data keys, the others are kept to decrypt the data keys encrypted before a rotation.
+func NewLocalKeys(spec string) (*LocalKeys, error) {
+	l := &LocalKeys{keys: make(map[string][]byte)}
+	for _, pair := range strings.Split(spec, ",") {
+		id, encoded, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || id == "" {
+			return nil, errors.New("encryption keys shall be id:key pairs")
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("could not decode encryption key %s", id))
+		}
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("encryption key %s shall be %d bytes long", id, dataKeySize)
+		}
+		if _, ok := l.keys[id]; ok {
+			return nil, fmt.Errorf("encryption key %s is defined twice", id)
+		}
+		if l.current == "" {
+			l.current = id
+		}
+		l.keys[id] = key
+	}
+	return l, nil
+}
+
+// KeyID returns the ID of the first key.
+func (l *LocalKeys) KeyID() string {
+	return l.current
+}
+
+// HasKey returns true if the key is configured.
+func (l *LocalKeys) HasKey(keyID string) bool {
+	_, ok := l.keys[keyID]
+	return ok
+}
+
+// WrapKey encrypts the data key with the first key.
+func (l *LocalKeys) WrapKey(_ context.Context, dataKey []byte) ([]byte, error) {
+	return seal(l.keys[l.current], dataKey, nil)
+}
+
+// UnwrapKey decrypts the data key with the given key.
+func (l *LocalKeys) UnwrapKey(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	key, ok := l.keys[keyID]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return open(key, wrapped, nil)
+}
+
+// kmsClient is the part of the AWS KMS API used by AWSKMSKey.
+type kmsClient interface {
+	Encrypt(ctx context.Context, params *kms.EncryptInput, optFns ...func(*kms.Options)) (*kms.EncryptOutput, error)
+	Decrypt(ctx context.Context, params *kms.DecryptInput, optFns ...func(*kms.Options)) (*kms.DecryptOutput, error)
+}
+
+// AWSKMSKey encrypts the data keys with a key managed by AWS KMS.
+// The rotation of the key material is left to KMS.
+type AWSKMSKey struct {
+	client kmsClient
+	keyID  string
+}
+
+// NewAWSKMSKey returns a KeyWrapper using the given AWS KMS key.
+// The region and the credentials are taken from the default AWS configuration chain.
+func NewAWSKMSKey(ctx context.Context, keyID string) (*AWSKMSKey, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not load AWS configuration"))
+	}
+	return &AWSKMSKey{client: kms.NewFromConfig(cfg), keyID: keyID}, nil
+}
+
+// KeyID returns the ID of the KMS key.
+func (k *AWSKMSKey) KeyID() string {
+	return k.keyID
+}
+
+// HasKey returns true if the key is the KMS key.
+func (k *AWSKMSKey) HasKey(keyID string) bool {
+	return keyID == k.keyID
+}
+
+// WrapKey encrypts the data key with the KMS key.
+func (k *AWSKMSKey) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	out, err := k.client.Encrypt(ctx, &kms.EncryptInput{KeyId: aws.String(k.keyID), Plaintext: dataKey})
+	if err != nil {
+		return nil, err
+	}
+	return out.CiphertextBlob, nil
+}
+
+// UnwrapKey decrypts the data key with the KMS key.
+func (k *AWSKMSKey) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	out, err := k.client.Decrypt(ctx, &kms.DecryptInput{KeyId: aws.String(keyID), CiphertextBlob: wrapped})
+	if err != nil {
+		return nil, err
+	}
+	return out.Plaintext, nil
+}
+
+// Keyring encrypts the data keys with its first KeyWrapper and decrypts them with any of them.
+// It allows rotating from one kind of keys to another, e.g. from local keys to a KMS key.
+type Keyring []KeyWrapper
+
+// KeyID returns the ID of the key of the first KeyWrapper.
+func (r Keyring) KeyID() string {
+	return r[0].KeyID()
+}
+
+// HasKey returns true if any of the KeyWrappers has the key.
+func (r Keyring) HasKey(keyID string) bool {
+	for _, k := range r {
+		if k.HasKey(keyID) {
+			return true
+		}
+	}
+	return false
+}
+
+// WrapKey encrypts the data key with the first KeyWrapper.
+func (r Keyring) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	return r[0].WrapKey(ctx, dataKey)
+}
+
+// UnwrapKey decrypts the data key with the KeyWrapper having the key.
+func (r Keyring) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	for _, k := range r {
+		if k.HasKey(keyID) {
+			return k.UnwrapKey(ctx, keyID, wrapped)
+		}
+	}
+	return nil, ErrUnknownKey
+}
@@ -0,0 +1,138 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// kubernetesSecretKey is the key of the Kubernetes Secret data the secret value is stored under.
+	kubernetesSecretKey = "value"
+	// serviceAccountNamespaceFile holds the namespace of the pod the backend runs in.
+	serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace" //nolint:gosec
+)
+
+// KubernetesStorage stores the secrets as Kubernetes Secrets in a single namespace.
+type KubernetesStorage struct {
+	client    kubernetes.Interface
+	namespace string
+	prefix    string
+}
+
+// NewKubernetesStorage returns a secrets storage backed by the Kubernetes Secrets.
+// The cluster is the one the backend runs in, or the one of the current context of the
+// default kubeconfig when it runs outside of a cluster. An empty namespace stands for
+// the namespace the backend runs in. The secret names are prefixed with the given prefix.
+func NewKubernetesStorage(namespace, prefix string) (*KubernetesStorage, error) {
+	loader := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{},
+	)
+	config, err := loader.ClientConfig()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not load Kubernetes configuration"))
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if namespace == "" {
+		namespace, err = ownNamespace(loader)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &KubernetesStorage{client: client, namespace: namespace, prefix: prefix}, nil
+}
+
+func ownNamespace(loader clientcmd.ClientConfig) (string, error) {
+	if b, err := os.ReadFile(serviceAccountNamespaceFile); err == nil {
+		return strings.TrimSpace(string(b)), nil
+	}
+	namespace, _, err := loader.Namespace()
+	if err != nil {
+		return "", errors.Join(err, errors.New("could not detect the namespace to store the secrets in"))
+	}
+	return namespace, nil
+}
+
+// CreateSecret creates a secret.
+func (s *KubernetesStorage) CreateSecret(ctx context.Context, id, value string) error {
+	_, err := s.client.CoreV1().Secrets(s.namespace).Create(ctx, s.secret(id, value), metav1.CreateOptions{})
+	return err
+}
+
+// GetSecret returns the secret by its id.
+func (s *KubernetesStorage) GetSecret(ctx context.Context, id string) (string, error) {
+	secret, err := s.client.CoreV1().Secrets(s.namespace).Get(ctx, s.prefix+id, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[kubernetesSecretKey]), nil
+}
+
+// GetSecrets returns the secrets by their ids.
+func (s *KubernetesStorage) GetSecrets(ctx context.Context, ids []string) (map[string]string, error) {
+	return getEach(ctx, s.GetSecret, ids)
+}
+
+// UpdateSecret updates the secret by its id. The secret is created if it does not exist.
+func (s *KubernetesStorage) UpdateSecret(ctx context.Context, id, value string) error {
+	_, err := s.client.CoreV1().Secrets(s.namespace).Update(ctx, s.secret(id, value), metav1.UpdateOptions{})
+	if k8serrors.IsNotFound(err) {
+		return s.CreateSecret(ctx, id, value)
+	}
+	return err
+}
+
+// DeleteSecret deletes the secret by its id. Returns the deleted secret.
+func (s *KubernetesStorage) DeleteSecret(ctx context.Context, id string) (string, error) {
+	value, err := s.GetSecret(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if err := s.client.CoreV1().Secrets(s.namespace).Delete(ctx, s.prefix+id, metav1.DeleteOptions{}); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// Close does nothing, the Kubernetes client holds no resources.
+func (s *KubernetesStorage) Close() error {
+	return nil
+}
+
+func (s *KubernetesStorage) secret(id, value string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.prefix + id,
+			Namespace: s.namespace,
+			Labels:    map[string]string{"app.kubernetes.io/managed-by": "everest"},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{kubernetesSecretKey: []byte(value)},
+	}
+}
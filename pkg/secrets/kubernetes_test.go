@@ -0,0 +1,57 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestKubernetesStorage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+	s := &KubernetesStorage{client: client, namespace: "everest", prefix: "everest-"}
+
+	require.NoError(t, s.CreateSecret(ctx, "a", "1"))
+	secret, err := client.CoreV1().Secrets("everest").Get(ctx, "everest-a", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(secret.Data["value"]))
+	assert.Error(t, s.CreateSecret(ctx, "a", "2"))
+
+	require.NoError(t, s.UpdateSecret(ctx, "a", "2"))
+	v, err := s.GetSecret(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "2", v)
+
+	// UpdateSecret creates missing secrets so that the migrations may be repeated.
+	require.NoError(t, s.UpdateSecret(ctx, "b", "3"))
+	v, err = s.GetSecret(ctx, "b")
+	require.NoError(t, err)
+	assert.Equal(t, "3", v)
+
+	v, err = s.DeleteSecret(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, "2", v)
+	_, err = s.GetSecret(ctx, "a")
+	assert.Error(t, err)
+}
@@ -0,0 +1,44 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import "context"
+
+// Storage stores the secrets.
+type Storage interface {
+	CreateSecret(ctx context.Context, id, value string) error
+	GetSecret(ctx context.Context, id string) (string, error)
+	GetSecrets(ctx context.Context, ids []string) (map[string]string, error)
+	UpdateSecret(ctx context.Context, id, value string) error
+	DeleteSecret(ctx context.Context, id string) (string, error)
+	Close() error
+}
+
+// getEach returns the secrets one by one for the backends which have no batch retrieval.
+func getEach(ctx context.Context, get func(ctx context.Context, id string) (string, error), ids []string) (map[string]string, error) {
+	res := make(map[string]string, len(ids))
+	for _, id := range ids {
+		if _, ok := res[id]; ok {
+			continue
+		}
+		value, err := get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		res[id] = value
+	}
+	return res, nil
+}
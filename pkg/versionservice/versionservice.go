@@ -0,0 +1,201 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package versionservice provides a client of the Percona version service.
+package versionservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	everestv1alpha1 "github.com/percona/everest-operator/api/v1alpha1"
+)
+
+// cacheTTL defines how long the version matrices are cached for.
+const cacheTTL = time.Hour
+
+// Statuses of a component version.
+const (
+	StatusRecommended = "recommended"
+	StatusAvailable   = "available"
+	StatusDisabled    = "disabled"
+)
+
+// Product identifies the operator of an engine type in the version service
+// and the component of the engine in the version matrix of the operator.
+type Product struct {
+	Operator  string
+	Component string
+}
+
+// Products are the version service products of the engine types.
+//
+//nolint:gochecknoglobals
+var Products = map[everestv1alpha1.EngineType]Product{
+	everestv1alpha1.DatabaseEnginePXC:        {Operator: "pxc-operator", Component: "pxc"},
+	everestv1alpha1.DatabaseEnginePSMDB:      {Operator: "psmdb-operator", Component: "mongod"},
+	everestv1alpha1.DatabaseEnginePostgresql: {Operator: "pg-operator", Component: "postgresql"},
+}
+
+// Component is a version of a component supported by an operator release.
+type Component struct {
+	ImagePath string `json:"imagePath"`
+	ImageHash string `json:"imageHash"`
+	Status    string `json:"status"`
+	Critical  bool   `json:"critical"`
+}
+
+// Matrix contains the versions of the components supported by an operator release by the component name.
+type Matrix map[string]map[string]Component
+
+type response struct {
+	Versions []struct {
+		Product  string `json:"product"`
+		Operator string `json:"operator"`
+		Matrix   Matrix `json:"matrix"`
+	} `json:"versions"`
+}
+
+type cachedMatrix struct {
+	matrix    Matrix
+	fetchedAt time.Time
+}
+
+type cachedReleases struct {
+	releases  map[string]Matrix
+	fetchedAt time.Time
+}
+
+// Client fetches the version matrices from the version service.
+type Client struct {
+	url string
+
+	mu       sync.Mutex
+	cache    map[string]cachedMatrix
+	releases map[string]cachedReleases
+}
+
+// New returns a new version service client. An empty URL disables the client.
+func New(url string) *Client {
+	return &Client{
+		url:      url,
+		cache:    make(map[string]cachedMatrix),
+		releases: make(map[string]cachedReleases),
+	}
+}
+
+// Enabled returns true if the version service is configured.
+func (c *Client) Enabled() bool {
+	return c != nil && c.url != ""
+}
+
+// Matrix returns the version matrix of the release of the operator.
+func (c *Client) Matrix(ctx context.Context, operator, operatorVersion string) (Matrix, error) {
+	key := operator + "/" + operatorVersion
+	c.mu.Lock()
+	cached, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < cacheTTL {
+		return cached.matrix, nil
+	}
+
+	matrix, err := c.fetchMatrix(ctx, operator, operatorVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.cache[key] = cachedMatrix{matrix: matrix, fetchedAt: time.Now()}
+	c.mu.Unlock()
+	return matrix, nil
+}
+
+// Releases returns the version matrices of all the releases of the operator by the operator version.
+func (c *Client) Releases(ctx context.Context, operator string) (map[string]Matrix, error) {
+	c.mu.Lock()
+	cached, ok := c.releases[operator]
+	c.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < cacheTTL {
+		return cached.releases, nil
+	}
+
+	r, err := c.fetch(ctx, operator)
+	if err != nil {
+		return nil, err
+	}
+	releases := make(map[string]Matrix, len(r.Versions))
+	for _, v := range r.Versions {
+		releases[v.Operator] = v.Matrix
+	}
+
+	c.mu.Lock()
+	c.releases[operator] = cachedReleases{releases: releases, fetchedAt: time.Now()}
+	c.mu.Unlock()
+	return releases, nil
+}
+
+func (c *Client) fetchMatrix(ctx context.Context, operator, operatorVersion string) (Matrix, error) {
+	r, err := c.fetch(ctx, operator, operatorVersion)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range r.Versions {
+		if v.Operator == operatorVersion {
+			return v.Matrix, nil
+		}
+	}
+	return nil, fmt.Errorf("version service does not know %s %s", operator, operatorVersion)
+}
+
+func (c *Client) fetch(ctx context.Context, elem ...string) (*response, error) {
+	if !c.Enabled() {
+		return nil, errors.New("version service is disabled")
+	}
+
+	u, err := url.JoinPath(c.url, append([]string{"versions/v1"}, elem...)...)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not build version service URL"))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("could not reach version service"))
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("version service returned HTTP status code %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	r := &response{}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, errors.Join(err, errors.New("could not decode version service response"))
+	}
+	return r, nil
+}
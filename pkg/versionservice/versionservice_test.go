@@ -0,0 +1,88 @@
+// percona-everest-backend
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versionservice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatrix(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/versions/v1/pxc-operator/1.13.0" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"versions": [{"product": "pxc-operator", "operator": "1.13.0", "matrix": {
+			"pxc": {
+				"8.0.32-24.2": {"imagePath": "percona/percona-xtradb-cluster:8.0.32-24.2", "imageHash": "abc", "status": "recommended", "critical": false},
+				"8.0.31-23.2": {"imagePath": "percona/percona-xtradb-cluster:8.0.31-23.2", "imageHash": "def", "status": "available", "critical": true}
+			}
+		}}]}`))
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL)
+	matrix, err := c.Matrix(context.Background(), "pxc-operator", "1.13.0")
+	require.NoError(t, err)
+	require.Len(t, matrix["pxc"], 2)
+	assert.Equal(t, Component{
+		ImagePath: "percona/percona-xtradb-cluster:8.0.32-24.2",
+		ImageHash: "abc",
+		Status:    StatusRecommended,
+	}, matrix["pxc"]["8.0.32-24.2"])
+
+	// The matrix is cached.
+	_, err = c.Matrix(context.Background(), "pxc-operator", "1.13.0")
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	_, err = c.Matrix(context.Background(), "pxc-operator", "1.12.0")
+	assert.Error(t, err)
+
+	_, err = New("").Matrix(context.Background(), "pxc-operator", "1.13.0")
+	assert.EqualError(t, err, "version service is disabled")
+}
+
+func TestReleases(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/versions/v1/psmdb-operator" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"versions": [
+			{"product": "psmdb-operator", "operator": "1.14.0", "matrix": {"mongod": {"6.0.4-3": {"status": "recommended"}}}},
+			{"product": "psmdb-operator", "operator": "1.15.0", "matrix": {"mongod": {"7.0.2-1": {"status": "available"}}}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	releases, err := New(srv.URL).Releases(context.Background(), "psmdb-operator")
+	require.NoError(t, err)
+	require.Len(t, releases, 2)
+	assert.Equal(t, StatusAvailable, releases["1.15.0"]["mongod"]["7.0.2-1"].Status)
+}